@@ -0,0 +1,298 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/dolthub/vitess/go/mysql"
+	querypb "github.com/dolthub/vitess/go/vt/proto/query"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// PrivilegedAuth is an Auth which keeps MySQL accounts and privileges in a sql.PrivilegeStore, so they can be managed
+// with CREATE USER, GRANT and similar statements.
+type PrivilegedAuth interface {
+	Auth
+	// PrivilegeStore returns the store holding the accounts and their privileges.
+	PrivilegeStore() sql.PrivilegeStore
+	// AllowedPrivileges checks that the current user holds every privilege given. If not, it returns an error of
+	// kind sql.ErrPrivilegeCheckFailed.
+	AllowedPrivileges(ctx *sql.Context, checks []sql.PrivilegeCheck) error
+}
+
+// Accounts is a PrivilegedAuth which authenticates against the accounts in a sql.PrivilegeStore.
+type Accounts struct {
+	store sql.PrivilegeStore
+}
+
+var _ PrivilegedAuth = (*Accounts)(nil)
+
+// NewAccounts creates an Accounts using the store given.
+func NewAccounts(store sql.PrivilegeStore) *Accounts {
+	return &Accounts{store: store}
+}
+
+// NewAccountsSingle creates an Accounts with an in-memory store holding a single superuser with the name and password
+// given, which may connect from any host.
+func NewAccountsSingle(name, password string) (*Accounts, error) {
+	store, err := singleAccountStore(name, sql.DefaultAuthPlugin, NativePassword(password))
+	if err != nil {
+		return nil, err
+	}
+	return NewAccounts(store), nil
+}
+
+// singleAccountStore returns an in-memory store holding a single superuser which may connect from any host.
+func singleAccountStore(name, plugin, authString string) (sql.PrivilegeStore, error) {
+	ctx := sql.NewEmptyContext()
+	store := sql.NewMemoryPrivilegeStore()
+	root := sql.NewUserIdentity(name, "%")
+
+	err := store.SetAccount(ctx, sql.Account{
+		UserIdentity: root,
+		Plugin:       plugin,
+		AuthString:   authString,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = store.SetGrant(ctx, sql.PrivilegeGrant{
+		Grantee:    root,
+		Privileges: sql.PrivilegeType_All | sql.PrivilegeType_GrantOption,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+// PrivilegeStore implements the PrivilegedAuth interface.
+func (a *Accounts) PrivilegeStore() sql.PrivilegeStore {
+	return a.store
+}
+
+// Mysql implements Auth interface.
+func (a *Accounts) Mysql() mysql.AuthServer {
+	return &accountsAuthServer{store: a.store}
+}
+
+// Allowed implements Auth interface. Reading only requires an account, while writing requires a privilege which
+// modifies data or schema somewhere. Use AllowedPrivileges for precise checks.
+func (a *Accounts) Allowed(ctx *sql.Context, permission Permission) error {
+	account, ok, err := a.currentAccount(ctx)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrNotAuthorized.Wrap(ErrNoPermission.New(permission))
+	}
+
+	if permission&WritePerm == 0 {
+		return nil
+	}
+
+	grants, err := sql.GrantsFor(ctx, a.store, account.UserIdentity)
+	if err != nil {
+		return err
+	}
+	const writePrivileges = sql.PrivilegeType_Insert | sql.PrivilegeType_Update | sql.PrivilegeType_Delete |
+		sql.PrivilegeType_Create | sql.PrivilegeType_Drop | sql.PrivilegeType_Alter
+	for _, g := range grants {
+		if g.Privileges&writePrivileges != 0 {
+			return nil
+		}
+	}
+	return ErrNotAuthorized.Wrap(ErrNoPermission.New(WritePerm))
+}
+
+// AllowedPrivileges implements the PrivilegedAuth interface. A check by column also passes when the user holds the
+// privileges on every column it lists.
+func (a *Accounts) AllowedPrivileges(ctx *sql.Context, checks []sql.PrivilegeCheck) error {
+	account, ok, err := a.currentAccount(ctx)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return sql.ErrPrivilegeCheckFailed.New(ctx.Client().User, "no such account")
+	}
+
+	roles := sql.SessionActiveRoles(ctx)
+	for _, check := range checks {
+		privs, err := sql.EffectivePrivileges(ctx, a.store, account.UserIdentity, roles, check.Target)
+		if err != nil {
+			return err
+		}
+		if privs.Has(check.Privileges) {
+			continue
+		}
+		if !check.ByColumn {
+			missing := check.Privileges &^ privs
+			return sql.ErrPrivilegeCheckFailed.New(account.Quoted(), missing.String()+" on "+check.Target.String())
+		}
+
+		if len(check.Columns) == 0 {
+			ok, err := a.someColumnAllowed(ctx, account.UserIdentity, roles, check)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				missing := check.Privileges &^ privs
+				return sql.ErrPrivilegeCheckFailed.New(account.Quoted(), missing.String()+" on "+check.Target.String())
+			}
+			continue
+		}
+		for _, column := range check.Columns {
+			target := check.Target
+			target.Column = column
+			privs, err := sql.EffectivePrivileges(ctx, a.store, account.UserIdentity, roles, target)
+			if err != nil {
+				return err
+			}
+			if !privs.Has(check.Privileges) {
+				missing := check.Privileges &^ privs
+				return sql.ErrPrivilegeCheckFailed.New(account.Quoted(),
+					fmt.Sprintf("%s on column `%s` of %s", missing, column, check.Target))
+			}
+		}
+	}
+	return nil
+}
+
+// someColumnAllowed returns whether the user given holds the privileges of the check given on any column of its table,
+// either directly or through the roles given.
+func (a *Accounts) someColumnAllowed(ctx *sql.Context, user sql.UserIdentity, roles []sql.UserIdentity, check sql.PrivilegeCheck) (bool, error) {
+	grants, err := a.store.Grants(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, g := range grants {
+		if g.Target.Column == "" || !strings.EqualFold(g.Target.Database, check.Target.Database) ||
+			!strings.EqualFold(g.Target.Table, check.Target.Table) {
+			continue
+		}
+		privs, err := sql.EffectivePrivileges(ctx, a.store, user, roles, g.Target)
+		if err != nil {
+			return false, err
+		}
+		if privs.Has(check.Privileges) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (a *Accounts) currentAccount(ctx *sql.Context) (sql.Account, bool, error) {
+	return sql.FindAccount(ctx, a.store, ctx.Client().User, ctx.Client().Address)
+}
+
+// accountsAuthServer is a mysql.AuthServer which validates connections against the accounts in a sql.PrivilegeStore.
+type accountsAuthServer struct {
+	store sql.PrivilegeStore
+}
+
+var _ mysql.AuthServer = (*accountsAuthServer)(nil)
+
+// AuthMethod implements the mysql.AuthServer interface.
+func (s *accountsAuthServer) AuthMethod(user string) (string, error) {
+	return mysql.MysqlNativePassword, nil
+}
+
+// Salt implements the mysql.AuthServer interface.
+func (s *accountsAuthServer) Salt() ([]byte, error) {
+	return mysql.NewSalt()
+}
+
+// ValidateHash implements the mysql.AuthServer interface.
+func (s *accountsAuthServer) ValidateHash(salt []byte, user string, authResponse []byte, remoteAddr net.Addr) (mysql.Getter, error) {
+	account, err := s.account(user, remoteAddr)
+	if err != nil {
+		return nil, err
+	}
+	if account.Plugin != sql.DefaultAuthPlugin || !validNativeScramble(salt, authResponse, account.AuthString) {
+		return nil, accessDenied(user)
+	}
+	return accountUserData{account}, nil
+}
+
+// Negotiate implements the mysql.AuthServer interface. Only mysql_native_password is supported, which never
+// negotiates.
+func (s *accountsAuthServer) Negotiate(c *mysql.Conn, user string, remoteAddr net.Addr) (mysql.Getter, error) {
+	return nil, accessDenied(user)
+}
+
+// account returns the account a user connecting from the address given authenticates as, or an access denied error
+// if there's no such account or it's locked.
+func (s *accountsAuthServer) account(user string, remoteAddr net.Addr) (sql.Account, error) {
+	var address string
+	if remoteAddr != nil {
+		address = remoteAddr.String()
+	}
+	account, ok, err := sql.FindAccount(sql.NewEmptyContext(), s.store, user, address)
+	if err != nil {
+		return sql.Account{}, err
+	}
+	if !ok || account.Locked {
+		return sql.Account{}, accessDenied(user)
+	}
+	return account, nil
+}
+
+func accessDenied(user string) error {
+	return mysql.NewSQLError(mysql.ERAccessDeniedError, mysql.SSAccessDeniedError, "Access denied for user '%v'", user)
+}
+
+// validNativeScramble returns whether the scramble sent by a client matches the mysql_native_password hash given,
+// which is stored as '*' followed by hex(SHA1(SHA1(password))).
+func validNativeScramble(salt, scramble []byte, authString string) bool {
+	if authString == "" {
+		return len(scramble) == 0
+	}
+	hash, err := hex.DecodeString(strings.TrimPrefix(authString, "*"))
+	if err != nil || len(hash) != sha1.Size || len(scramble) != sha1.Size {
+		return false
+	}
+
+	// scramble = SHA1(password) XOR SHA1(salt + hash), so XOR-ing again recovers SHA1(password)
+	crypt := sha1.New()
+	crypt.Write(salt)
+	crypt.Write(hash)
+	mask := crypt.Sum(nil)
+
+	stage1 := make([]byte, sha1.Size)
+	for i := range stage1 {
+		stage1[i] = scramble[i] ^ mask[i]
+	}
+	candidate := sha1.Sum(stage1)
+	return bytes.Equal(candidate[:], hash)
+}
+
+// accountUserData is the mysql.Getter for connections authenticated against an account.
+type accountUserData struct {
+	account sql.Account
+}
+
+// Get implements the mysql.Getter interface.
+func (d accountUserData) Get() *querypb.VTGateCallerID {
+	return &querypb.VTGateCallerID{Username: d.account.User}
+}
@@ -0,0 +1,296 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sqle "github.com/linanh/go-mysql-server"
+	"github.com/linanh/go-mysql-server/auth"
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+func accountsEngine(t *testing.T) (*sqle.Engine, map[string]*sql.Context) {
+	t.Helper()
+	a, err := auth.NewAccountsSingle("root", "")
+	require.NoError(t, err)
+
+	e, idxReg, err := authEngine(a)
+	require.NoError(t, err)
+
+	sessions := make(map[string]*sql.Context)
+	for i, user := range []string{"root", "bob", "carol"} {
+		session := sql.NewSession("localhost", sql.Client{Address: "127.0.0.1:3306", User: user}, uint32(i))
+		sessions[user] = sql.NewContext(context.TODO(),
+			sql.WithSession(session),
+			sql.WithIndexRegistry(idxReg),
+			sql.WithViewRegistry(sql.NewViewRegistry())).WithCurrentDB("test")
+	}
+	return e, sessions
+}
+
+func queryRows(ctx *sql.Context, e *sqle.Engine, query string) ([]sql.Row, error) {
+	_, iter, err := e.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowIterToRows(ctx, iter)
+}
+
+func TestAccountsAuthentication(t *testing.T) {
+	a, err := auth.NewAccountsSingle("root", "secret")
+	require.NoError(t, err)
+
+	ctx := sql.NewEmptyContext()
+	store := a.PrivilegeStore()
+	for _, acc := range []sql.Account{
+		{UserIdentity: sql.NewUserIdentity("user", "%"), Plugin: sql.DefaultAuthPlugin, AuthString: auth.NativePassword("password")},
+		{UserIdentity: sql.NewUserIdentity("empty", "%"), Plugin: sql.DefaultAuthPlugin},
+		{UserIdentity: sql.NewUserIdentity("locked", "%"), Plugin: sql.DefaultAuthPlugin, Locked: true},
+		{UserIdentity: sql.NewUserIdentity("remote", "10.%"), Plugin: sql.DefaultAuthPlugin},
+		{UserIdentity: sql.NewUserIdentity("role", "%"), Plugin: sql.DefaultAuthPlugin, Locked: true, IsRole: true},
+	} {
+		require.NoError(t, store.SetAccount(ctx, acc))
+		require.NoError(t, store.SetGrant(ctx, sql.PrivilegeGrant{Grantee: acc.UserIdentity, Privileges: sql.PrivilegeType_Select}))
+	}
+
+	tests := []authenticationTest{
+		{"root", "", false},
+		{"root", "secret", true},
+		{"user", "password", true},
+		{"user", "other", false},
+		{"user", "", false},
+		{"empty", "", true},
+		{"empty", "password", false},
+		{"locked", "", false},
+		{"remote", "", false},
+		{"role", "", false},
+		{"unknown", "", false},
+	}
+
+	testAuthentication(t, a, tests, nil)
+}
+
+func TestAccountsPrivileges(t *testing.T) {
+	require := require.New(t)
+	e, sessions := accountsEngine(t)
+	root, bob, carol := sessions["root"], sessions["bob"], sessions["carol"]
+
+	for _, q := range []string{
+		"CREATE USER bob IDENTIFIED BY 'bob', carol@'127.0.0.1'",
+		"GRANT SELECT ON test.* TO bob",
+		"CREATE ROLE writer",
+		"GRANT INSERT, UPDATE ON test TO writer",
+		"GRANT writer TO carol@'127.0.0.1'",
+		"GRANT SELECT (name) ON test.test TO carol@'127.0.0.1'",
+	} {
+		_, err := queryRows(root, e, q)
+		require.NoError(err, q)
+	}
+
+	_, err := queryRows(bob, e, "SELECT * FROM test")
+	require.NoError(err)
+	_, err = queryRows(bob, e, "INSERT INTO test VALUES ('1', 'bob')")
+	require.Error(err)
+	require.True(sql.ErrPrivilegeCheckFailed.Is(err), "unexpected error %v", err)
+	// Prepared statements are checked when they're executed
+	_, err = queryRows(bob, e, "PREPARE ins FROM 'INSERT INTO test VALUES (''1'', ''bob'')'")
+	require.NoError(err)
+	_, err = queryRows(bob, e, "EXECUTE ins")
+	require.True(sql.ErrPrivilegeCheckFailed.Is(err), "unexpected error %v", err)
+	_, err = queryRows(bob, e, "CREATE USER mallory")
+	require.True(sql.ErrPrivilegeCheckFailed.Is(err), "unexpected error %v", err)
+	_, err = queryRows(bob, e, "SELECT * FROM test WHERE id IN (SELECT name FROM mysql.user)")
+	require.True(sql.ErrPrivilegeCheckFailed.Is(err), "unexpected error %v", err)
+
+	// Roles only apply once they're activated
+	_, err = queryRows(carol, e, "INSERT INTO test VALUES ('1', 'carol')")
+	require.True(sql.ErrPrivilegeCheckFailed.Is(err), "unexpected error %v", err)
+	_, err = queryRows(carol, e, "SET ROLE writer")
+	require.NoError(err)
+	_, err = queryRows(carol, e, "INSERT INTO test VALUES ('1', 'carol')")
+	require.NoError(err)
+	_, err = queryRows(carol, e, "DELETE FROM test")
+	require.True(sql.ErrPrivilegeCheckFailed.Is(err), "unexpected error %v", err)
+	_, err = queryRows(carol, e, "SELECT name FROM test")
+	require.NoError(err)
+
+	_, err = queryRows(root, e, "REVOKE SELECT ON test.* FROM bob")
+	require.NoError(err)
+	_, err = queryRows(bob, e, "SELECT * FROM test")
+	require.True(sql.ErrPrivilegeCheckFailed.Is(err), "unexpected error %v", err)
+
+	rows, err := queryRows(root, e, "SHOW GRANTS FOR carol@'127.0.0.1'")
+	require.NoError(err)
+	require.Equal([]sql.Row{
+		{"GRANT USAGE ON *.* TO `carol`@`127.0.0.1`"},
+		{"GRANT SELECT (`name`) ON `test`.`test` TO `carol`@`127.0.0.1`"},
+		{"GRANT `writer`@`%` TO `carol`@`127.0.0.1`"},
+	}, rows)
+
+	rows, err = queryRows(root, e, "SELECT User, Host, Select_priv, account_locked FROM mysql.user ORDER BY User")
+	require.NoError(err)
+	require.Equal([]sql.Row{
+		{"bob", "%", "N", "N"},
+		{"carol", "127.0.0.1", "N", "N"},
+		{"root", "%", "Y", "N"},
+		{"writer", "%", "N", "Y"},
+	}, rows)
+
+	rows, err = queryRows(root, e, "SELECT * FROM mysql.role_edges")
+	require.NoError(err)
+	require.Equal([]sql.Row{{"%", "writer", "127.0.0.1", "carol", "N"}}, rows)
+}
+
+func TestAccountsColumnPrivileges(t *testing.T) {
+	require := require.New(t)
+	e, sessions := accountsEngine(t)
+	root, carol := sessions["root"], sessions["carol"]
+
+	for _, q := range []string{
+		"CREATE USER carol@'127.0.0.1'",
+		"GRANT SELECT (name), INSERT (id), UPDATE (name) ON test.test TO carol@'127.0.0.1'",
+		"INSERT INTO test VALUES ('1', 'root')",
+	} {
+		_, err := queryRows(root, e, q)
+		require.NoError(err, q)
+	}
+
+	for _, q := range []string{
+		"SELECT name FROM test",
+		"SELECT t.name FROM test t ORDER BY name",
+		"SELECT COUNT(*) FROM test",
+		"SELECT upper(name) AS n FROM test ORDER BY n",
+		"SELECT name FROM test WHERE name IN (SELECT name FROM test)",
+		"UPDATE test SET name = 'carol'",
+		"UPDATE test SET name = upper(name) WHERE name = 'carol'",
+	} {
+		_, err := queryRows(carol, e, q)
+		require.NoError(err, q)
+	}
+	// The statement is allowed, but name has no default value
+	_, err := queryRows(carol, e, "INSERT INTO test (id) VALUES ('2')")
+	require.Error(err)
+	require.False(sql.ErrPrivilegeCheckFailed.Is(err), "unexpected error %v", err)
+
+	for _, q := range []string{
+		"SELECT * FROM test",
+		"SELECT t.* FROM test t",
+		"SELECT id FROM test",
+		"SELECT name FROM test WHERE id = '1'",
+		"SELECT name FROM test t WHERE name IN (SELECT u.name FROM test u WHERE u.id = t.name)",
+		"SELECT s.name FROM (SELECT * FROM test) s",
+		"INSERT INTO test VALUES ('3', 'carol')",
+		"INSERT INTO test (id, name) VALUES ('3', 'carol')",
+		"UPDATE test SET id = '4'",
+		"UPDATE test SET name = 'carol' WHERE id = '1'",
+		"DELETE FROM test",
+	} {
+		_, err := queryRows(carol, e, q)
+		require.True(sql.ErrPrivilegeCheckFailed.Is(err), "unexpected error %v for %s", err, q)
+	}
+}
+
+func TestAccountsGlobalPrivileges(t *testing.T) {
+	require := require.New(t)
+	e, sessions := accountsEngine(t)
+	root, bob, carol := sessions["root"], sessions["bob"], sessions["carol"]
+
+	for _, q := range []string{
+		"CREATE USER bob, carol@'127.0.0.1'",
+		"GRANT INSERT ON test.* TO bob",
+		"GRANT INSERT ON test.* TO carol@'127.0.0.1'",
+		"GRANT SUPER, FILE ON *.* TO carol@'127.0.0.1'",
+	} {
+		_, err := queryRows(root, e, q)
+		require.NoError(err, q)
+	}
+
+	// Global system variables need SUPER, while session ones need no privilege
+	for _, q := range []string{
+		"SET GLOBAL max_connections = 151",
+		"SET @@GLOBAL.max_connections = 151",
+		"SET @@global.max_connections = 151, autocommit = 1",
+	} {
+		_, err := queryRows(bob, e, q)
+		require.True(sql.ErrPrivilegeCheckFailed.Is(err), "unexpected error %v for %s", err, q)
+		_, err = queryRows(carol, e, q)
+		require.NoError(err, q)
+	}
+	_, err := queryRows(bob, e, "SET SESSION autocommit = 1")
+	require.NoError(err)
+
+	// LOAD DATA reads files of the server with FILE, and needs no SELECT privilege on the table it loads
+	_, err = queryRows(bob, e, "LOAD DATA INFILE 'test.csv' INTO TABLE test")
+	require.True(sql.ErrPrivilegeCheckFailed.Is(err), "unexpected error %v", err)
+	_, err = queryRows(bob, e, "LOAD DATA LOCAL INFILE 'test.csv' INTO TABLE test")
+	require.Error(err)
+	require.False(sql.ErrPrivilegeCheckFailed.Is(err), "unexpected error %v", err)
+	_, err = queryRows(carol, e, "LOAD DATA INFILE 'test.csv' INTO TABLE test")
+	require.Error(err)
+	require.False(sql.ErrPrivilegeCheckFailed.Is(err), "unexpected error %v", err)
+}
+
+func TestAccountsKill(t *testing.T) {
+	require := require.New(t)
+	e, sessions := accountsEngine(t)
+	root, bob, carol := sessions["root"], sessions["bob"], sessions["carol"]
+
+	for _, q := range []string{
+		"CREATE USER bob, carol@'127.0.0.1'",
+		"GRANT PROCESS ON *.* TO carol@'127.0.0.1'",
+	} {
+		_, err := queryRows(root, e, q)
+		require.NoError(err, q)
+	}
+
+	closed := make(map[uint32]bool)
+	for _, ctx := range sessions {
+		id := ctx.ID()
+		e.Catalog.ProcessList.AddConnection(id, func() { closed[id] = true })
+		e.Catalog.ProcessList.SetConnectionClient(id, ctx.Client())
+	}
+
+	// Users can only kill their own connections without the PROCESS privilege
+	_, err := queryRows(bob, e, "KILL QUERY "+fmt.Sprint(root.ID()))
+	require.True(sql.ErrKillDenied.Is(err), "unexpected error %v", err)
+	_, err = queryRows(bob, e, "KILL QUERY "+fmt.Sprint(bob.ID()))
+	require.NoError(err)
+	require.False(closed[bob.ID()])
+
+	_, err = queryRows(carol, e, "KILL "+fmt.Sprint(bob.ID()))
+	require.NoError(err)
+	require.True(closed[bob.ID()])
+	_, err = queryRows(root, e, "KILL CONNECTION "+fmt.Sprint(carol.ID()))
+	require.NoError(err)
+	require.True(closed[carol.ID()])
+
+	// Connections of the same user are only their own when they come from the same host
+	e.Catalog.ProcessList.AddConnection(50, func() {})
+	e.Catalog.ProcessList.SetConnectionClient(50, sql.Client{User: "bob", Address: "10.0.0.1:3306"})
+	e.Catalog.ProcessList.AddConnection(51, func() {})
+	e.Catalog.ProcessList.SetConnectionClient(51, sql.Client{User: "bob", Address: "127.0.0.1:4000"})
+	_, err = queryRows(bob, e, "KILL QUERY 50")
+	require.True(sql.ErrKillDenied.Is(err), "unexpected error %v", err)
+	_, err = queryRows(bob, e, "KILL QUERY 51")
+	require.NoError(err)
+
+	_, err = queryRows(root, e, "KILL 100")
+	require.True(sql.ErrUnknownThread.Is(err), "unexpected error %v", err)
+}
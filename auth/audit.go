@@ -34,6 +34,12 @@ type AuditMethod interface {
 	Query(ctx *sql.Context, d time.Duration, err error)
 }
 
+// AuditQuery is implemented by Auth proxies which log query executions.
+type AuditQuery interface {
+	// Query logs a query execution.
+	Query(ctx *sql.Context, d time.Duration, err error)
+}
+
 // MysqlAudit wraps mysql.AuthServer to emit audit trails.
 type MysqlAudit struct {
 	mysql.AuthServer
@@ -53,9 +59,24 @@ func (m *MysqlAudit) ValidateHash(
 	return getter, err
 }
 
+// Negotiate sends authentication calls to an AuditMethod.
+func (m *MysqlAudit) Negotiate(c *mysql.Conn, user string, addr net.Addr) (mysql.Getter, error) {
+	getter, err := m.AuthServer.Negotiate(c, user, addr)
+	m.audit.Authentication(user, addr.String(), err)
+
+	return getter, err
+}
+
 // NewAudit creates a wrapped Auth that sends audit trails to the specified
 // method.
 func NewAudit(auth Auth, method AuditMethod) Auth {
+	if pa, ok := auth.(PrivilegedAuth); ok {
+		return &PrivilegedAudit{
+			Audit: Audit{auth: auth, method: method},
+			auth:  pa,
+		}
+	}
+
 	return &Audit{
 		auth:   auth,
 		method: method,
@@ -85,9 +106,42 @@ func (a *Audit) Allowed(ctx *sql.Context, permission Permission) error {
 	return err
 }
 
+// PrivilegedAudit is an Audit proxy for a PrivilegedAuth, which also sends the fine grained privilege checks to the
+// AuditMethod.
+type PrivilegedAudit struct {
+	Audit
+	auth PrivilegedAuth
+}
+
+var _ PrivilegedAuth = (*PrivilegedAudit)(nil)
+
+// PrivilegeStore implements the PrivilegedAuth interface.
+func (a *PrivilegedAudit) PrivilegeStore() sql.PrivilegeStore {
+	return a.auth.PrivilegeStore()
+}
+
+// AllowedPrivileges implements the PrivilegedAuth interface. The checks are sent to the AuditMethod as the Permission
+// they correspond to: read for those of SELECT only, and read and write otherwise.
+func (a *PrivilegedAudit) AllowedPrivileges(ctx *sql.Context, checks []sql.PrivilegeCheck) error {
+	err := a.auth.AllowedPrivileges(ctx, checks)
+	a.method.Authorization(ctx, privilegeChecksPermission(checks), err)
+
+	return err
+}
+
+// privilegeChecksPermission returns the coarse Permission corresponding to the privilege checks given.
+func privilegeChecksPermission(checks []sql.PrivilegeCheck) Permission {
+	for _, c := range checks {
+		if c.Privileges&^sql.PrivilegeType_Select != 0 {
+			return ReadPerm | WritePerm
+		}
+	}
+	return ReadPerm
+}
+
 // Query implements AuditQuery interface.
 func (a *Audit) Query(ctx *sql.Context, d time.Duration, err error) {
-	if q, ok := a.auth.(*Audit); ok {
+	if q, ok := a.auth.(AuditQuery); ok {
 		q.Query(ctx, d, err)
 	}
 
@@ -0,0 +1,340 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/linanh/go-mysql-server/sql"
+	"github.com/linanh/go-mysql-server/sql/parse"
+)
+
+// AuditEventClass is a set of classes of events written to an AuditFile.
+type AuditEventClass int
+
+const (
+	// AuditEventConnect is the class of authentication attempts.
+	AuditEventConnect AuditEventClass = 1 << iota
+	// AuditEventQuery is the class of every query.
+	AuditEventQuery
+	// AuditEventDDL is the class of queries which change schemas, users or privileges.
+	AuditEventDDL
+	// AuditEventDML is the class of queries which change data.
+	AuditEventDML
+	// AuditEventDenied is the class of failed authentications and queries which weren't authorized.
+	AuditEventDenied
+
+	// AuditAllEvents holds every class of events.
+	AuditAllEvents = AuditEventConnect | AuditEventQuery | AuditEventDDL | AuditEventDML | AuditEventDenied
+)
+
+var (
+	// AuditEventClassNames is used to translate from human to machine representations.
+	AuditEventClassNames = map[string]AuditEventClass{
+		"connect": AuditEventConnect,
+		"query":   AuditEventQuery,
+		"ddl":     AuditEventDDL,
+		"dml":     AuditEventDML,
+		"denied":  AuditEventDenied,
+	}
+
+	// ErrUnknownAuditEventClass is returned when parsing an event class which doesn't exist.
+	ErrUnknownAuditEventClass = errors.NewKind("unknown audit event class, %s")
+)
+
+// ParseAuditEventClasses parses a comma separated list of event class names, such as "connect,ddl,denied".
+func ParseAuditEventClasses(s string) (AuditEventClass, error) {
+	var classes AuditEventClass
+	for _, name := range strings.Split(s, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if name == "all" {
+			classes |= AuditAllEvents
+			continue
+		}
+		class, ok := AuditEventClassNames[name]
+		if !ok {
+			return 0, ErrUnknownAuditEventClass.New(name)
+		}
+		classes |= class
+	}
+	return classes, nil
+}
+
+// AuditFileConfig configures an AuditFile.
+type AuditFileConfig struct {
+	// Path of the audit file. Rotated files get a numeric suffix, such as audit.log.1 for the most recent one.
+	Path string
+	// Events are the classes of events written. Defaults to AuditAllEvents.
+	Events AuditEventClass
+	// IncludeUsers, if not empty, limits the events written to those of the users given.
+	IncludeUsers []string
+	// ExcludeUsers are users whose events are never written.
+	ExcludeUsers []string
+	// MaxSize is the size in bytes the file can reach before it's rotated. Zero disables rotation.
+	MaxSize int64
+	// MaxBackups is the number of rotated files kept. Zero keeps all of them.
+	MaxBackups int
+	// RedactLiterals writes the normalized text of queries, as in statement digests, in which literals are replaced
+	// with ?. Passwords are replaced whatever this setting, see parse.RedactPasswords.
+	RedactLiterals bool
+}
+
+// auditRecord is a line of an AuditFile.
+type auditRecord struct {
+	Time         string  `json:"timestamp"`
+	Class        string  `json:"class"`
+	Action       string  `json:"action"`
+	User         string  `json:"user"`
+	Address      string  `json:"address,omitempty"`
+	ConnectionID uint32  `json:"connection_id,omitempty"`
+	Query        string  `json:"query,omitempty"`
+	Permission   string  `json:"permission,omitempty"`
+	DurationMs   float64 `json:"duration_ms,omitempty"`
+	Success      bool    `json:"success"`
+	Error        string  `json:"error,omitempty"`
+}
+
+// AuditFile is an AuditMethod which appends events as JSON lines to a file, rotating it when it grows too large.
+type AuditFile struct {
+	config  AuditFileConfig
+	include map[string]bool
+	exclude map[string]bool
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+}
+
+var _ AuditMethod = (*AuditFile)(nil)
+
+// NewAuditFile opens the audit file of the configuration given, creating it if needed.
+func NewAuditFile(config AuditFileConfig) (*AuditFile, error) {
+	if config.Events == 0 {
+		config.Events = AuditAllEvents
+	}
+
+	a := &AuditFile{config: config}
+	if len(config.IncludeUsers) > 0 {
+		a.include = make(map[string]bool)
+		for _, u := range config.IncludeUsers {
+			a.include[u] = true
+		}
+	}
+	a.exclude = make(map[string]bool)
+	for _, u := range config.ExcludeUsers {
+		a.exclude[u] = true
+	}
+
+	if err := a.open(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Authentication implements AuditMethod interface.
+func (a *AuditFile) Authentication(user string, address string, err error) {
+	a.write(AuditEventConnect, err, auditRecord{
+		Action:  "authentication",
+		User:    user,
+		Address: address,
+	})
+}
+
+// Authorization implements AuditMethod interface. Only denied authorizations are written, as the queries allowed are
+// written as query events.
+func (a *AuditFile) Authorization(ctx *sql.Context, p Permission, err error) {
+	if err == nil {
+		return
+	}
+	record := a.contextRecord(ctx)
+	record.Action = "authorization"
+	record.Permission = p.String()
+	a.write(AuditEventDenied, err, record)
+}
+
+// Query implements AuditMethod interface.
+func (a *AuditFile) Query(ctx *sql.Context, d time.Duration, err error) {
+	record := a.contextRecord(ctx)
+	record.Action = "query"
+	record.DurationMs = float64(d) / float64(time.Millisecond)
+	a.write(AuditEventQuery|queryEventClass(ctx.Query()), err, record)
+}
+
+// Close closes the audit file.
+func (a *AuditFile) Close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.file.Close()
+}
+
+func (a *AuditFile) contextRecord(ctx *sql.Context) auditRecord {
+	query := ctx.Query()
+	if a.config.RedactLiterals {
+		query = parse.NormalizeQuery(query)
+	} else {
+		query = parse.RedactPasswords(query)
+	}
+	return auditRecord{
+		User:         ctx.Client().User,
+		Address:      ctx.Client().Address,
+		ConnectionID: ctx.Session.ID(),
+		Query:        query,
+	}
+}
+
+// write writes the record of an event in the classes given, if any of them is enabled for its user. Failed events are
+// in the AuditEventDenied class too, when the failure is an authentication or authorization one.
+func (a *AuditFile) write(classes AuditEventClass, err error, record auditRecord) {
+	if err != nil && (classes&AuditEventConnect != 0 || ErrNotAuthorized.Is(err) || sql.ErrPrivilegeCheckFailed.Is(err)) {
+		classes |= AuditEventDenied
+	}
+	classes &= a.config.Events
+	if classes == 0 || a.exclude[record.User] || (a.include != nil && !a.include[record.User]) {
+		return
+	}
+
+	record.Time = time.Now().UTC().Format(time.RFC3339Nano)
+	record.Class = auditClassName(classes)
+	record.Success = err == nil
+	if err != nil {
+		record.Error = err.Error()
+	}
+
+	line, jsonErr := json.Marshal(record)
+	if jsonErr != nil {
+		logrus.WithError(jsonErr).Error("unable to encode audit record")
+		return
+	}
+	line = append(line, '\n')
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.config.MaxSize > 0 && a.size > 0 && a.size+int64(len(line)) > a.config.MaxSize {
+		if err := a.rotate(); err != nil {
+			logrus.WithError(err).Error("unable to rotate audit file")
+		}
+	}
+	n, writeErr := a.file.Write(line)
+	a.size += int64(n)
+	if writeErr != nil {
+		logrus.WithError(writeErr).Error("unable to write audit record")
+	}
+}
+
+// auditClassName returns the name of the most specific class of the ones given.
+func auditClassName(classes AuditEventClass) string {
+	for _, c := range []AuditEventClass{AuditEventDenied, AuditEventConnect, AuditEventDDL, AuditEventDML, AuditEventQuery} {
+		if classes&c != 0 {
+			for name, class := range AuditEventClassNames {
+				if class == c {
+					return name
+				}
+			}
+		}
+	}
+	return ""
+}
+
+func (a *AuditFile) open() error {
+	f, err := os.OpenFile(a.config.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	a.file = f
+	a.size = info.Size()
+	return nil
+}
+
+// rotate renames the current file to the first backup, shifting the existing backups, and opens a new file. The file is
+// reopened even if it couldn't be rotated, so that the records following are still written.
+func (a *AuditFile) rotate() error {
+	err := a.file.Close()
+	if err == nil {
+		err = a.shiftBackups()
+	}
+	if openErr := a.open(); err == nil {
+		err = openErr
+	}
+	return err
+}
+
+// shiftBackups renames the closed current file to the first backup, shifting the existing backups.
+func (a *AuditFile) shiftBackups() error {
+	// Find the backups which exist, dropping the oldest one if there are too many
+	last := 0
+	for {
+		if _, err := os.Stat(backupPath(a.config.Path, last+1)); err != nil {
+			break
+		}
+		last++
+	}
+	if a.config.MaxBackups > 0 && last >= a.config.MaxBackups {
+		for i := a.config.MaxBackups; i <= last; i++ {
+			if err := os.Remove(backupPath(a.config.Path, i)); err != nil {
+				return err
+			}
+		}
+		last = a.config.MaxBackups - 1
+	}
+	for i := last; i > 0; i-- {
+		if err := os.Rename(backupPath(a.config.Path, i), backupPath(a.config.Path, i+1)); err != nil {
+			return err
+		}
+	}
+	return os.Rename(a.config.Path, backupPath(a.config.Path, 1))
+}
+
+func backupPath(path string, n int) string {
+	return fmt.Sprintf("%s.%d", path, n)
+}
+
+var (
+	ddlStatements = map[string]bool{
+		"create": true, "alter": true, "drop": true, "rename": true, "truncate": true, "grant": true, "revoke": true,
+	}
+	// Procedures are DML, as the statements they run aren't classified
+	dmlStatements = map[string]bool{
+		"insert": true, "update": true, "delete": true, "replace": true, "load": true, "call": true,
+	}
+)
+
+// queryEventClass returns whether a query is DDL or DML, judging by its statement type.
+func queryEventClass(query string) AuditEventClass {
+	typ := parse.StatementType(query)
+	switch {
+	case ddlStatements[typ]:
+		return AuditEventDDL
+	case dmlStatements[typ]:
+		return AuditEventDML
+	default:
+		return 0
+	}
+}
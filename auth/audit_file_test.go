@@ -0,0 +1,286 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth_test
+
+import (
+	"bufio"
+	"context"
+	dsql "database/sql"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/linanh/go-mysql-server/auth"
+	"github.com/linanh/go-mysql-server/server"
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+type auditRecord struct {
+	Class   string `json:"class"`
+	Action  string `json:"action"`
+	User    string `json:"user"`
+	Query   string `json:"query"`
+	Success bool   `json:"success"`
+}
+
+func readAuditFile(t *testing.T, path string) []auditRecord {
+	t.Helper()
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var records []auditRecord
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var r auditRecord
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &r))
+		records = append(records, r)
+	}
+	require.NoError(t, scanner.Err())
+	return records
+}
+
+func TestAuditFile(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.log")
+
+	a := auth.NewNativeSingle("user", "password", auth.ReadPerm)
+	engine, _, err := authEngine(a)
+	require.NoError(err)
+	s, err := server.NewDefaultServer(server.Config{
+		Protocol:       "tcp",
+		Address:        fmt.Sprintf("localhost:%d", port),
+		Auth:           a,
+		MaxConnections: 1000,
+		AuditLog: &auth.AuditFileConfig{
+			Path:           path,
+			Events:         auth.AuditEventConnect | auth.AuditEventDML | auth.AuditEventDenied,
+			RedactLiterals: true,
+		},
+	}, engine)
+	require.NoError(err)
+	go s.Start()
+
+	db, err := dsql.Open("mysql", connString("user", "password"))
+	require.NoError(err)
+	_, err = db.Exec("SELECT * FROM test WHERE name = 'secret'")
+	require.NoError(err)
+	_, err = db.Exec("INSERT INTO test (id, name) VALUES ('1', 'secret')")
+	require.Error(err)
+	require.NoError(db.Close())
+
+	db, err = dsql.Open("mysql", connString("user", "wrong"))
+	require.NoError(err)
+	require.Error(db.Ping())
+	require.NoError(db.Close())
+
+	require.NoError(s.Close())
+
+	records := readAuditFile(t, path)
+	require.Len(records, 3)
+
+	require.Equal("connect", records[0].Class)
+	require.Equal("user", records[0].User)
+	require.True(records[0].Success)
+
+	// The SELECT isn't written, and the denied INSERT is
+	require.Equal("denied", records[1].Class)
+	require.Equal("query", records[1].Action)
+	require.Equal("INSERT INTO `test` ( `id` , `name` ) VALUES (...)", records[1].Query)
+	require.False(records[1].Success)
+
+	require.Equal("denied", records[2].Class)
+	require.Equal("authentication", records[2].Action)
+	require.False(records[2].Success)
+
+	// The Auth of the engine isn't changed
+	require.Equal(a, engine.Auth)
+}
+
+func TestAuditFileEngineAuth(t *testing.T) {
+	require := require.New(t)
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	f, err := auth.NewAuditFile(auth.AuditFileConfig{Path: path, Events: auth.AuditEventDenied})
+	require.NoError(err)
+	a := auth.NewNativeSingle("user", "password", auth.ReadPerm)
+	engine, _, err := authEngine(auth.NewAudit(a, f))
+	require.NoError(err)
+	s, err := server.NewDefaultServer(server.Config{
+		Protocol:       "tcp",
+		Address:        fmt.Sprintf("localhost:%d", port),
+		Auth:           auth.NewAudit(a, f),
+		MaxConnections: 1000,
+	}, engine)
+	require.NoError(err)
+	go s.Start()
+
+	db, err := dsql.Open("mysql", connString("user", "password"))
+	require.NoError(err)
+	_, err = db.Exec("INSERT INTO test (id, name) VALUES ('1', 'secret')")
+	require.Error(err)
+	require.NoError(db.Close())
+	require.NoError(s.Close())
+	require.NoError(f.Close())
+
+	// The Auth of the engine writes the authorization checks, along with the queries
+	records := readAuditFile(t, path)
+	require.Len(records, 2)
+	require.Equal("authorization", records[0].Action)
+	require.Equal("INSERT INTO test (id, name) VALUES ('1', 'secret')", records[0].Query)
+	require.Equal("query", records[1].Action)
+}
+
+func TestAuditFileUsers(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.log")
+
+	f, err := auth.NewAuditFile(auth.AuditFileConfig{
+		Path:         path,
+		IncludeUsers: []string{"alice", "bob"},
+		ExcludeUsers: []string{"bob"},
+	})
+	require.NoError(err)
+	for _, user := range []string{"alice", "bob", "carol"} {
+		f.Authentication(user, "127.0.0.1:1234", nil)
+	}
+	require.NoError(f.Close())
+
+	records := readAuditFile(t, path)
+	require.Len(records, 1)
+	require.Equal("alice", records[0].User)
+}
+
+func TestAuditFileRotation(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.log")
+
+	f, err := auth.NewAuditFile(auth.AuditFileConfig{
+		Path:       path,
+		MaxSize:    300,
+		MaxBackups: 2,
+	})
+	require.NoError(err)
+	for i := 0; i < 20; i++ {
+		f.Authentication(fmt.Sprintf("user%d", i), "127.0.0.1:1234", nil)
+	}
+	require.NoError(f.Close())
+
+	files, err := filepath.Glob(path + "*")
+	require.NoError(err)
+	require.ElementsMatch([]string{path, path + ".1", path + ".2"}, files)
+
+	for _, p := range files {
+		info, err := os.Stat(p)
+		require.NoError(err)
+		require.True(info.Size() <= 300, "%s is too large", p)
+	}
+
+	// The newest events are in the current file, and the ones before in the first backup
+	current := readAuditFile(t, path)
+	require.Equal("user19", current[len(current)-1].User)
+	backup := readAuditFile(t, path+".1")
+	require.Equal(fmt.Sprintf("user%d", 19-len(current)), backup[len(backup)-1].User)
+}
+
+func TestAuditFilePasswords(t *testing.T) {
+	require := require.New(t)
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	f, err := auth.NewAuditFile(auth.AuditFileConfig{Path: path})
+	require.NoError(err)
+	ctx := sql.NewContext(context.Background(),
+		sql.WithSession(sql.NewSession("localhost", sql.Client{User: "root", Address: "127.0.0.1"}, 1)),
+		sql.WithQuery("CREATE USER bob IDENTIFIED BY 'hunter2'"))
+	f.Query(ctx, time.Millisecond, nil)
+	require.NoError(f.Close())
+
+	// Passwords are redacted even if literals aren't
+	records := readAuditFile(t, path)
+	require.Len(records, 1)
+	require.Equal("CREATE USER bob IDENTIFIED BY ?", records[0].Query)
+}
+
+func TestAuditFileClasses(t *testing.T) {
+	require := require.New(t)
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	f, err := auth.NewAuditFile(auth.AuditFileConfig{Path: path, Events: auth.AuditEventDML})
+	require.NoError(err)
+	for _, query := range []string{
+		"SELECT 'DELETE FROM t'",
+		"WITH cte AS (SELECT 1 AS a) DELETE FROM t WHERE a IN (SELECT a FROM cte)",
+		"/* UPDATE */ WITH cte AS (SELECT 1) SELECT * FROM cte",
+		"WITH cte AS (SELECT 1 AS a) UPDATE t, cte SET t.a = cte.a",
+		"CALL p()",
+		"CREATE TABLE t (a INT)",
+	} {
+		ctx := sql.NewContext(context.Background(),
+			sql.WithSession(sql.NewSession("localhost", sql.Client{User: "root", Address: "127.0.0.1"}, 1)),
+			sql.WithQuery(query))
+		f.Query(ctx, time.Millisecond, nil)
+	}
+	require.NoError(f.Close())
+
+	var queries []string
+	for _, r := range readAuditFile(t, path) {
+		require.Equal("dml", r.Class)
+		queries = append(queries, r.Query)
+	}
+	require.Equal([]string{
+		"WITH cte AS (SELECT 1 AS a) DELETE FROM t WHERE a IN (SELECT a FROM cte)",
+		"WITH cte AS (SELECT 1 AS a) UPDATE t, cte SET t.a = cte.a",
+		"CALL p()",
+	}, queries)
+}
+
+func TestAuditFileRotationError(t *testing.T) {
+	require := require.New(t)
+	dir := t.TempDir()
+	path := filepath.Join(dir, "audit.log")
+
+	// The oldest backup to drop is a directory which can't be removed, so the file can't be rotated
+	require.NoError(os.MkdirAll(filepath.Join(path+".1", "dir"), 0700))
+	f, err := auth.NewAuditFile(auth.AuditFileConfig{
+		Path:       path,
+		MaxSize:    100,
+		MaxBackups: 1,
+	})
+	require.NoError(err)
+	for i := 0; i < 5; i++ {
+		f.Authentication(fmt.Sprintf("user%d", i), "127.0.0.1:1234", nil)
+	}
+	require.NoError(f.Close())
+
+	// Every record is still written to the current file
+	records := readAuditFile(t, path)
+	require.Len(records, 5)
+	require.Equal("user4", records[4].User)
+}
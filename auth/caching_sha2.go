@@ -0,0 +1,320 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/pem"
+	"net"
+	"sync"
+
+	"github.com/dolthub/vitess/go/mysql"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+const (
+	// rsaKeyBits is the size of the key generated for RSA password exchange when none is given.
+	rsaKeyBits = 2048
+
+	// authMoreData starts the packets which a server sends during a plugin's authentication exchange.
+	authMoreData = 0x01
+
+	// cachingSha2FastAuthSuccess tells a caching_sha2_password client that its scramble matched the cache.
+	cachingSha2FastAuthSuccess = 0x03
+	// cachingSha2FullAuth tells a caching_sha2_password client to send its password over TLS or encrypted.
+	cachingSha2FullAuth = 0x04
+	// cachingSha2RequestPublicKey is sent by a caching_sha2_password client to ask for the server's RSA key.
+	cachingSha2RequestPublicKey = 0x02
+	// sha256RequestPublicKey is sent by a sha256_password client to ask for the server's RSA key.
+	sha256RequestPublicKey = 0x01
+)
+
+// CachingSha2 is a PrivilegedAuth which authenticates the accounts in a sql.PrivilegeStore with the plugin set for each
+// of them: caching_sha2_password, sha256_password or mysql_native_password.
+//
+// caching_sha2_password clients first send a scramble of their password, which is verified against a cache of
+// SHA256(SHA256(password)) for accounts which already logged in since the server started. Otherwise the client is
+// asked for its password, which it sends in clear text over TLS. On the connections without TLS which the server
+// allows, the password is only accepted encrypted with the server's RSA key. sha256_password always works the latter
+// way.
+type CachingSha2 struct {
+	*Accounts
+	server *sha2AuthServer
+}
+
+var _ PrivilegedAuth = (*CachingSha2)(nil)
+
+// NewCachingSha2 creates a CachingSha2 using the store given. The key is used to exchange passwords over connections
+// without TLS. If it's nil, a key is generated the first time it's needed.
+func NewCachingSha2(store sql.PrivilegeStore, key *rsa.PrivateKey) *CachingSha2 {
+	return &CachingSha2{
+		Accounts: NewAccounts(store),
+		server: &sha2AuthServer{
+			accountsAuthServer: &accountsAuthServer{store: store},
+			key:                key,
+			cache:              make(map[sql.UserIdentity]sha2CacheEntry),
+		},
+	}
+}
+
+// NewCachingSha2Single creates a CachingSha2 with an in-memory store holding a single superuser with the name and
+// password given, which may connect from any host and authenticates with caching_sha2_password.
+func NewCachingSha2Single(name, password string) (*CachingSha2, error) {
+	authString, err := CachingSha2PasswordHash(password)
+	if err != nil {
+		return nil, err
+	}
+	store, err := singleAccountStore(name, CachingSha2Password, authString)
+	if err != nil {
+		return nil, err
+	}
+	return NewCachingSha2(store, nil), nil
+}
+
+// Mysql implements Auth interface.
+func (a *CachingSha2) Mysql() mysql.AuthServer {
+	return a.server
+}
+
+// sha2CacheEntry is the SHA256(SHA256(password)) of an account which authenticated successfully. It's only valid while
+// the authentication string of the account is the one recorded with it.
+type sha2CacheEntry struct {
+	authString string
+	digest     [sha256.Size]byte
+}
+
+// sha2AuthServer is a mysql.AuthServer for the accounts in a sql.PrivilegeStore, which supports the
+// caching_sha2_password and sha256_password plugins as well as mysql_native_password.
+type sha2AuthServer struct {
+	*accountsAuthServer
+
+	keyOnce sync.Once
+	key     *rsa.PrivateKey
+	keyErr  error
+
+	mu    sync.Mutex
+	cache map[sql.UserIdentity]sha2CacheEntry
+}
+
+// AuthMethod implements the mysql.AuthServer interface. The host a user connects from isn't known yet, so when there
+// are several accounts with the same user name, the plugin of the first one is used.
+func (s *sha2AuthServer) AuthMethod(user string) (string, error) {
+	accounts, err := s.store.Accounts(sql.NewEmptyContext())
+	if err != nil {
+		return "", err
+	}
+	for _, a := range accounts {
+		if a.IsRole || a.User != user {
+			continue
+		}
+		if a.Plugin == CachingSha2Password || a.Plugin == Sha256Password {
+			return a.Plugin, nil
+		}
+		break
+	}
+	return mysql.MysqlNativePassword, nil
+}
+
+// Negotiate implements the mysql.AuthServer interface. The client has been switched to the plugin of its account,
+// and the rest of the exchange for that plugin happens here.
+func (s *sha2AuthServer) Negotiate(c *mysql.Conn, user string, remoteAddr net.Addr) (mysql.Getter, error) {
+	response, err := c.ReadPacket()
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.account(user, remoteAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := authConn{
+		c:      c,
+		secure: c.TLSEnabled(),
+		nonce:  c.Salt(),
+	}
+
+	var ok bool
+	switch account.Plugin {
+	case CachingSha2Password:
+		ok, err = s.cachingSha2(conn, account, response)
+	case Sha256Password:
+		ok, err = s.sha256(conn, account, response)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, accessDenied(user)
+	}
+	return accountUserData{account}, nil
+}
+
+// cachingSha2 completes a caching_sha2_password exchange, given the scramble the client sent first.
+func (s *sha2AuthServer) cachingSha2(conn authConn, account sql.Account, scramble []byte) (bool, error) {
+	if account.AuthString == "" {
+		return len(scramble) == 0, nil
+	}
+
+	if entry, ok := s.cached(account); ok && validSha2Scramble(conn.nonce, scramble, entry.digest) {
+		return true, conn.write([]byte{authMoreData, cachingSha2FastAuthSuccess})
+	}
+
+	if err := conn.write([]byte{authMoreData, cachingSha2FullAuth}); err != nil {
+		return false, err
+	}
+	response, err := conn.c.ReadPacket()
+	if err != nil {
+		return false, err
+	}
+
+	password, err := s.password(conn, response, cachingSha2RequestPublicKey)
+	if err != nil || password == nil {
+		return false, err
+	}
+	if !validSha2Password(password, account.AuthString) {
+		return false, nil
+	}
+
+	s.remember(account, password)
+	return true, nil
+}
+
+// sha256 completes a sha256_password exchange, given the first response of the client.
+func (s *sha2AuthServer) sha256(conn authConn, account sql.Account, response []byte) (bool, error) {
+	// Clients send a single zero byte for an empty password
+	if bytes.Equal(response, []byte{0}) {
+		return account.AuthString == "", nil
+	}
+
+	password, err := s.password(conn, response, sha256RequestPublicKey)
+	if err != nil || password == nil {
+		return false, err
+	}
+	return validSha2Password(password, account.AuthString), nil
+}
+
+// password returns the password a client sent in the response given: in clear text over TLS, or encrypted with the
+// server's public key otherwise. Clients without the key ask for it first by sending the byte given. A nil password
+// means the client sent something it shouldn't have.
+func (s *sha2AuthServer) password(conn authConn, response []byte, requestPublicKey byte) ([]byte, error) {
+	if conn.secure {
+		return bytes.TrimSuffix(response, []byte{0}), nil
+	}
+
+	// The password is XOR-ed with the nonce before encryption, so without one there's no way to recover it
+	if len(conn.nonce) == 0 {
+		return nil, nil
+	}
+
+	key, err := s.privateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.Equal(response, []byte{requestPublicKey}) {
+		der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		pemKey := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+		if err := conn.write(append([]byte{authMoreData}, pemKey...)); err != nil {
+			return nil, err
+		}
+		if response, err = conn.c.ReadPacket(); err != nil {
+			return nil, err
+		}
+	}
+
+	plain, err := rsa.DecryptOAEP(sha1.New(), rand.Reader, key, response, nil)
+	if err != nil {
+		return nil, nil
+	}
+	for i := range plain {
+		plain[i] ^= conn.nonce[i%len(conn.nonce)]
+	}
+	return bytes.TrimSuffix(plain, []byte{0}), nil
+}
+
+func (s *sha2AuthServer) privateKey() (*rsa.PrivateKey, error) {
+	s.keyOnce.Do(func() {
+		if s.key == nil {
+			s.key, s.keyErr = rsa.GenerateKey(rand.Reader, rsaKeyBits)
+		}
+	})
+	return s.key, s.keyErr
+}
+
+func (s *sha2AuthServer) cached(account sql.Account) (sha2CacheEntry, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry, ok := s.cache[account.UserIdentity]
+	if !ok || entry.authString != account.AuthString {
+		return sha2CacheEntry{}, false
+	}
+	return entry, true
+}
+
+func (s *sha2AuthServer) remember(account sql.Account, password []byte) {
+	stage1 := sha256.Sum256(password)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cache[account.UserIdentity] = sha2CacheEntry{
+		authString: account.AuthString,
+		digest:     sha256.Sum256(stage1[:]),
+	}
+}
+
+// validSha2Scramble returns whether the scramble sent by a caching_sha2_password client matches the digest given,
+// which is SHA256(SHA256(password)).
+func validSha2Scramble(nonce, scramble []byte, digest [sha256.Size]byte) bool {
+	if len(scramble) != sha256.Size {
+		return false
+	}
+
+	// scramble = SHA256(password) XOR SHA256(digest + nonce), so XOR-ing again recovers SHA256(password)
+	crypt := sha256.New()
+	crypt.Write(digest[:])
+	crypt.Write(nonce)
+	mask := crypt.Sum(nil)
+
+	stage1 := make([]byte, sha256.Size)
+	for i := range stage1 {
+		stage1[i] = scramble[i] ^ mask[i]
+	}
+	candidate := sha256.Sum256(stage1)
+	return subtle.ConstantTimeCompare(candidate[:], digest[:]) == 1
+}
+
+// authConn is the state of a connection whose authentication is being negotiated.
+type authConn struct {
+	c *mysql.Conn
+	// secure is whether the connection uses TLS
+	secure bool
+	// nonce is the salt sent in the handshake and in the auth switch request
+	nonce []byte
+}
+
+// write writes the next packet of the exchange.
+func (conn authConn) write(data []byte) error {
+	return mysql.AuthServerWritePacket(conn.c, data)
+}
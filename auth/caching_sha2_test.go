@@ -0,0 +1,158 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth_test
+
+import (
+	"context"
+	dsql "database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/linanh/go-mysql-server/auth"
+	"github.com/linanh/go-mysql-server/server"
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+func cachingSha2Auth(t *testing.T) *auth.CachingSha2 {
+	t.Helper()
+	a, err := auth.NewCachingSha2Single("root", "secret")
+	require.NoError(t, err)
+
+	sha256Hash, err := auth.Sha256PasswordHash("password")
+	require.NoError(t, err)
+
+	ctx := sql.NewEmptyContext()
+	store := a.PrivilegeStore()
+	for _, acc := range []sql.Account{
+		{UserIdentity: sql.NewUserIdentity("sha256", "%"), Plugin: auth.Sha256Password, AuthString: sha256Hash},
+		{UserIdentity: sql.NewUserIdentity("native", "%"), Plugin: sql.DefaultAuthPlugin, AuthString: auth.NativePassword("password")},
+		{UserIdentity: sql.NewUserIdentity("empty", "%"), Plugin: auth.CachingSha2Password},
+		// A hash created by another implementation of SHA-256 crypt
+		{UserIdentity: sql.NewUserIdentity("imported", "%"), Plugin: auth.Sha256Password, AuthString: "$5$saltstring$5B8vYYiY.CVt1RlTTf8KbXBH3hsxY/GNooZaBBGWEc5"},
+	} {
+		require.NoError(t, store.SetAccount(ctx, acc))
+		require.NoError(t, store.SetGrant(ctx, sql.PrivilegeGrant{Grantee: acc.UserIdentity, Privileges: sql.PrivilegeType_Select}))
+	}
+	return a
+}
+
+func TestCachingSha2Authentication(t *testing.T) {
+	a := cachingSha2Auth(t)
+
+	tests := []authenticationTest{
+		{"root", "secret", true},
+		// The second time around the password is in the cache
+		{"root", "secret", true},
+		{"root", "", false},
+		{"root", "other", false},
+		{"sha256", "password", true},
+		{"sha256", "other", false},
+		{"sha256", "", false},
+		{"native", "password", true},
+		{"native", "other", false},
+		{"empty", "", true},
+		{"empty", "password", false},
+		{"imported", "Hello world!", true},
+		{"imported", "Hello world", false},
+		{"unknown", "", false},
+	}
+
+	testAuthentication(t, a, tests, nil, allowClearTextWithoutTLS)
+}
+
+// allowClearTextWithoutTLS lets users authenticate with plugins other than mysql_native_password without TLS.
+func allowClearTextWithoutTLS(cfg *server.Config) {
+	cfg.AllowClearTextWithoutTLS = true
+}
+
+func TestCachingSha2AuthenticationRequiresTLS(t *testing.T) {
+	require := require.New(t)
+	a := cachingSha2Auth(t)
+
+	s, _, err := authServer(a)
+	require.NoError(err)
+	defer func() {
+		require.NoError(s.Close())
+	}()
+
+	// The users of mysql_native_password still connect without TLS
+	db, err := dsql.Open("mysql", connString("native", "password"))
+	require.NoError(err)
+	_, err = db.Query("SELECT 1")
+	require.NoError(err)
+	require.NoError(db.Close())
+
+	for _, user := range []string{"root", "sha256"} {
+		db, err := dsql.Open("mysql", connString(user, "password"))
+		require.NoError(err)
+		_, err = db.Query("SELECT 1")
+		require.Error(err)
+		require.Contains(err.Error(), "Cannot use clear text authentication over non-SSL connections")
+		require.NoError(db.Close())
+	}
+}
+
+func TestCachingSha2AuthenticationTLS(t *testing.T) {
+	require := require.New(t)
+	a := cachingSha2Auth(t)
+
+	s := tlsAuthServer(t, a)
+	defer func() {
+		require.NoError(s.Close())
+	}()
+
+	for _, c := range []authenticationTest{
+		{"root", "secret", true},
+		{"root", "secret", true},
+		{"root", "other", false},
+		{"sha256", "password", true},
+		{"sha256", "other", false},
+		{"empty", "", true},
+	} {
+		db, err := dsql.Open("mysql", connString(c.user, c.password)+"?tls=skip-verify")
+		require.NoError(err)
+		_, err = db.Query("SELECT 1")
+		if c.success {
+			require.NoError(err, "%s:%s", c.user, c.password)
+		} else {
+			require.Error(err)
+			require.Contains(err.Error(), "Access denied")
+		}
+		require.NoError(db.Close())
+	}
+}
+
+func TestCachingSha2PasswordHash(t *testing.T) {
+	require := require.New(t)
+	a := cachingSha2Auth(t)
+	e, idxReg, err := authEngine(a)
+	require.NoError(err)
+
+	session := sql.NewSession("localhost", sql.Client{Address: "127.0.0.1:3306", User: "root"}, 1)
+	ctx := sql.NewContext(context.TODO(),
+		sql.WithSession(session),
+		sql.WithIndexRegistry(idxReg),
+		sql.WithViewRegistry(sql.NewViewRegistry())).WithCurrentDB("test")
+	_, err = queryRows(ctx, e, "CREATE USER bob IDENTIFIED WITH caching_sha2_password BY 'bob', carol IDENTIFIED WITH sha256_password BY 'carol'")
+	require.NoError(err)
+
+	rows, err := queryRows(ctx, e, "SELECT User, plugin, SUBSTRING(authentication_string, 1, 3), LENGTH(authentication_string) FROM mysql.user WHERE User IN ('bob', 'carol') ORDER BY User")
+	require.NoError(err)
+	require.Equal([]sql.Row{
+		{"bob", auth.CachingSha2Password, "$A$", int32(70)},
+		{"carol", auth.Sha256Password, "$5$", int32(67)},
+	}, rows)
+}
@@ -16,9 +16,16 @@ package auth_test
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
 	dsql "database/sql"
 	"fmt"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -53,7 +60,7 @@ func authEngine(au auth.Auth) (*sqle.Engine, *sql.IndexRegistry, error) {
 	return sqle.New(catalog, a, config), idxReg, nil
 }
 
-func authServer(a auth.Auth) (*server.Server, *sql.IndexRegistry, error) {
+func authServer(a auth.Auth, options ...func(*server.Config)) (*server.Server, *sql.IndexRegistry, error) {
 	engine, idxReg, err := authEngine(a)
 	if err != nil {
 		return nil, nil, err
@@ -65,6 +72,9 @@ func authServer(a auth.Auth) (*server.Server, *sql.IndexRegistry, error) {
 		Auth:           a,
 		MaxConnections: 1000,
 	}
+	for _, option := range options {
+		option(&config)
+	}
 
 	s, err := server.NewDefaultServer(config, engine)
 	if err != nil {
@@ -76,6 +86,41 @@ func authServer(a auth.Auth) (*server.Server, *sql.IndexRegistry, error) {
 	return s, idxReg, nil
 }
 
+// tlsAuthServer starts a server which supports TLS with a self-signed certificate.
+func tlsAuthServer(t *testing.T, a auth.Auth) *server.Server {
+	t.Helper()
+	engine, _, err := authEngine(a)
+	require.NoError(t, err)
+
+	s, err := server.NewDefaultServer(server.Config{
+		Protocol:       "tcp",
+		Address:        fmt.Sprintf("localhost:%d", port),
+		Auth:           a,
+		MaxConnections: 1000,
+		TLSConfig:      &tls.Config{Certificates: []tls.Certificate{selfSignedCertificate(t)}},
+	}, engine)
+	require.NoError(t, err)
+	go s.Start()
+	return s
+}
+
+// selfSignedCertificate returns a certificate for localhost, which clients can use without verifying it.
+func selfSignedCertificate(t *testing.T) tls.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
 func connString(user, password string) string {
 	return fmt.Sprintf("%s:%s@tcp(127.0.0.1:%d)/test", user, password, port)
 }
@@ -91,11 +136,12 @@ func testAuthentication(
 	a auth.Auth,
 	tests []authenticationTest,
 	extra func(t *testing.T, c authenticationTest),
+	options ...func(*server.Config),
 ) {
 	t.Helper()
 	req := require.New(t)
 
-	s, _, err := authServer(a)
+	s, _, err := authServer(a, options...)
 	req.NoError(err)
 
 	for _, c := range tests {
@@ -134,6 +180,7 @@ var queries = map[string]string{
 	"insert":       "insert into test (id, name) values ('id', 'name')",
 	"lock":         "lock tables test read",
 	"unlock":       "unlock tables",
+	"set_global":   "set global max_connections = 151",
 }
 
 type authorizationTest struct {
@@ -0,0 +1,205 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"net"
+	"sync"
+	"time"
+
+	"github.com/dolthub/vitess/go/mysql"
+	querypb "github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// ErrInvalidCredentials is returned by an ExternalVerifier when the user doesn't exist or the password is wrong.
+var ErrInvalidCredentials = errors.NewKind("invalid credentials for user %s")
+
+// ExternalVerifier verifies passwords against a service outside of the server, such as a directory.
+type ExternalVerifier interface {
+	// Verify checks the password of a user and returns the names of the groups the user belongs to. If the user
+	// doesn't exist or the password is wrong, it returns an error of kind ErrInvalidCredentials. Other errors mean the
+	// service couldn't be used.
+	Verify(user, password string) ([]string, error)
+}
+
+// DefaultExternalTTL is how long an External keeps the permissions of users after they authenticate, by default.
+const DefaultExternalTTL = time.Hour
+
+// External is an Auth which delegates password verification to an ExternalVerifier, and grants users the permissions
+// mapped to the groups they belong to. Users who aren't in any mapped group can't connect.
+//
+// The permissions of a user are the ones of the groups the user belonged to on the last authentication from the same
+// host. They expire some time after it, so that the changes of the groups of a user, or the removal of the user, apply
+// to the connections already open: once they expire, the user needs to connect again.
+//
+// Clients send their password in clear text with the mysql_clear_password plugin, so the server only accepts them
+// over TLS, unless its Config.AllowClearTextWithoutTLS is set. Clients need to enable that plugin too, as it's off by
+// default in most of them.
+type External struct {
+	verifier ExternalVerifier
+	groups   map[string]Permission
+	ttl      time.Duration
+
+	mu    sync.Mutex
+	users map[externalUser]externalPermissions
+}
+
+// externalUser is a user authenticated from a host.
+type externalUser struct {
+	user string
+	host string
+}
+
+// externalPermissions are the permissions of a user, until they expire.
+type externalPermissions struct {
+	perms   Permission
+	expires time.Time
+}
+
+var _ Auth = (*External)(nil)
+
+// NewExternal creates an External which verifies passwords with the verifier given. The groups map the names of
+// external groups to the permissions of their members, which users keep for the time given after they authenticate.
+// Zero means DefaultExternalTTL.
+func NewExternal(verifier ExternalVerifier, groups map[string]Permission, ttl time.Duration) *External {
+	if ttl == 0 {
+		ttl = DefaultExternalTTL
+	}
+	return &External{
+		verifier: verifier,
+		groups:   groups,
+		ttl:      ttl,
+		users:    make(map[externalUser]externalPermissions),
+	}
+}
+
+// Mysql implements Auth interface.
+func (e *External) Mysql() mysql.AuthServer {
+	return &externalAuthServer{e}
+}
+
+// Allowed implements Auth interface. Users have the permissions of the groups they belonged to when they last
+// authenticated from the host of the client, unless they expired.
+func (e *External) Allowed(ctx *sql.Context, permission Permission) error {
+	client := ctx.Client()
+	key := externalUser{user: client.User, host: addressHost(client.Address)}
+	e.mu.Lock()
+	entry, ok := e.users[key]
+	e.mu.Unlock()
+	if !ok || !time.Now().Before(entry.expires) {
+		return ErrNotAuthorized.Wrap(ErrNoPermission.New(permission))
+	}
+
+	if entry.perms&permission == permission {
+		return nil
+	}
+	return ErrNotAuthorized.Wrap(ErrNoPermission.New((^entry.perms) & permission))
+}
+
+// addressHost returns the host of an address of a client, without its port.
+func addressHost(address string) string {
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		return host
+	}
+	return address
+}
+
+// authenticate verifies the password of a user connecting from the address given and records the permissions of the
+// user's groups.
+func (e *External) authenticate(user, password string, addr net.Addr) error {
+	groups, err := e.verifier.Verify(user, password)
+	if ErrInvalidCredentials.Is(err) {
+		return accessDenied(user)
+	}
+	if err != nil {
+		logrus.WithError(err).Errorf("external authentication of user %s failed", user)
+		return mysql.NewSQLError(mysql.ERAccessDeniedError, mysql.SSAccessDeniedError, "Access denied for user '%v': authentication service unavailable", user)
+	}
+
+	var perms Permission
+	for _, g := range groups {
+		perms |= e.groups[g]
+	}
+	if perms == 0 {
+		return accessDenied(user)
+	}
+
+	key := externalUser{user: user, host: addressHost(addr.String())}
+	now := time.Now()
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	// Forget the users whose permissions expired, so that the ones who don't connect anymore aren't kept forever
+	for k, entry := range e.users {
+		if !now.Before(entry.expires) {
+			delete(e.users, k)
+		}
+	}
+	e.users[key] = externalPermissions{perms: perms, expires: now.Add(e.ttl)}
+	return nil
+}
+
+// externalAuthServer is a mysql.AuthServer which asks clients for their password in clear text and verifies it with
+// the ExternalVerifier of an External.
+type externalAuthServer struct {
+	e *External
+}
+
+var _ mysql.AuthServer = (*externalAuthServer)(nil)
+
+// AuthMethod implements the mysql.AuthServer interface.
+func (s *externalAuthServer) AuthMethod(user string) (string, error) {
+	return mysql.MysqlClearPassword, nil
+}
+
+// Salt implements the mysql.AuthServer interface.
+func (s *externalAuthServer) Salt() ([]byte, error) {
+	return mysql.NewSalt()
+}
+
+// ValidateHash implements the mysql.AuthServer interface. Scrambled passwords can't be verified externally, and clients
+// are always switched to mysql_clear_password before this is called.
+func (s *externalAuthServer) ValidateHash(salt []byte, user string, authResponse []byte, remoteAddr net.Addr) (mysql.Getter, error) {
+	return nil, accessDenied(user)
+}
+
+// Negotiate implements the mysql.AuthServer interface.
+func (s *externalAuthServer) Negotiate(c *mysql.Conn, user string, remoteAddr net.Addr) (mysql.Getter, error) {
+	password, err := mysql.AuthServerReadPacketString(c)
+	if err != nil {
+		return nil, err
+	}
+	// An empty password would be an unauthenticated bind for most directories, which always succeeds
+	if password == "" {
+		return nil, accessDenied(user)
+	}
+	if err := s.e.authenticate(user, password, remoteAddr); err != nil {
+		return nil, err
+	}
+	return externalUserData{user}, nil
+}
+
+// externalUserData is the mysql.Getter for connections authenticated externally.
+type externalUserData struct {
+	user string
+}
+
+// Get implements the mysql.Getter interface.
+func (d externalUserData) Get() *querypb.VTGateCallerID {
+	return &querypb.VTGateCallerID{Username: d.user}
+}
@@ -0,0 +1,239 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth_test
+
+import (
+	"context"
+	dsql "database/sql"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/linanh/go-mysql-server/auth"
+	"github.com/linanh/go-mysql-server/auth/ldaptest"
+)
+
+func ldapDirectory(t *testing.T) *ldaptest.Directory {
+	t.Helper()
+	d, err := ldaptest.NewDirectory()
+	require.NoError(t, err)
+
+	for _, e := range []ldaptest.Entry{
+		{DN: "uid=alice,ou=people,dc=example,dc=com", Password: "alice"},
+		{DN: "uid=bob,ou=people,dc=example,dc=com", Password: "bob"},
+		{DN: "uid=carol,ou=people,dc=example,dc=com", Password: "carol"},
+		{DN: "cn=dba,ou=groups,dc=example,dc=com", Attributes: map[string][]string{
+			"cn":     {"dba"},
+			"member": {"uid=alice,ou=people,dc=example,dc=com"},
+		}},
+		{DN: "cn=analysts,ou=groups,dc=example,dc=com", Attributes: map[string][]string{
+			"cn":     {"analysts"},
+			"member": {"uid=alice,ou=people,dc=example,dc=com", "uid=bob,ou=people,dc=example,dc=com"},
+		}},
+	} {
+		d.Add(e)
+	}
+	return d
+}
+
+func ldapAuth(d *ldaptest.Directory) *auth.External {
+	return auth.NewExternal(auth.NewLDAP(auth.LDAPConfig{
+		Address:     d.Address(),
+		UserDN:      "uid=%s,ou=people,dc=example,dc=com",
+		GroupBaseDN: "ou=groups,dc=example,dc=com",
+	}), map[string]auth.Permission{
+		"dba":      auth.AllPermissions,
+		"analysts": auth.ReadPerm,
+	}, 0)
+}
+
+func TestLDAPVerify(t *testing.T) {
+	require := require.New(t)
+	d := ldapDirectory(t)
+	defer d.Close()
+
+	l := auth.NewLDAP(auth.LDAPConfig{
+		Address:     d.Address(),
+		UserDN:      "uid=%s,ou=people,dc=example,dc=com",
+		GroupBaseDN: "ou=groups,dc=example,dc=com",
+	})
+
+	groups, err := l.Verify("alice", "alice")
+	require.NoError(err)
+	require.ElementsMatch([]string{"dba", "analysts"}, groups)
+
+	groups, err = l.Verify("carol", "carol")
+	require.NoError(err)
+	require.Empty(groups)
+
+	for _, c := range []struct{ user, password string }{
+		{"alice", "bob"},
+		{"alice", ""},
+		{"dave", "dave"},
+		{"alice,ou=people", "alice"},
+	} {
+		_, err = l.Verify(c.user, c.password)
+		require.True(auth.ErrInvalidCredentials.Is(err), "unexpected error %v", err)
+	}
+
+	// Empty passwords never reach the directory, where they would be unauthenticated binds
+	require.Equal(5, d.Binds())
+
+	require.Equal(`a\,b\=c\+d\\e`, auth.EscapeDN(`a,b=c+d\e`))
+	require.Equal(`\#a \ `, auth.EscapeDN(`#a  `))
+}
+
+type failingVerifier struct{}
+
+func (failingVerifier) Verify(user, password string) ([]string, error) {
+	return nil, fmt.Errorf("directory is down")
+}
+
+func TestExternalAuthentication(t *testing.T) {
+	d := ldapDirectory(t)
+	defer d.Close()
+	a := ldapAuth(d)
+
+	s := tlsAuthServer(t, a)
+	defer func() {
+		require.NoError(t, s.Close())
+	}()
+
+	for _, c := range []authenticationTest{
+		{"alice", "alice", true},
+		{"bob", "bob", true},
+		{"bob", "alice", false},
+		{"bob", "", false},
+		// carol isn't in any group with permissions
+		{"carol", "carol", false},
+		{"dave", "dave", false},
+	} {
+		t.Run(fmt.Sprintf("%s-%s", c.user, c.password), func(t *testing.T) {
+			require := require.New(t)
+			db, err := dsql.Open("mysql", connString(c.user, c.password)+"?tls=skip-verify&allowCleartextPasswords=true")
+			require.NoError(err)
+			defer db.Close()
+
+			_, err = db.Exec("SELECT 1")
+			if !c.success {
+				require.Error(err)
+				require.Contains(err.Error(), "Access denied")
+				return
+			}
+			require.NoError(err)
+
+			// Group permissions apply to the queries of the user
+			_, err = db.Exec("INSERT INTO test (id, name) VALUES ('1', 'name')")
+			if c.user == "alice" {
+				require.NoError(err)
+			} else {
+				require.Error(err)
+				require.Contains(err.Error(), "not authorized")
+			}
+		})
+	}
+
+	t.Run("without TLS", func(t *testing.T) {
+		db, err := dsql.Open("mysql", connString("alice", "alice")+"?allowCleartextPasswords=true")
+		require.NoError(t, err)
+		defer db.Close()
+		_, err = db.Exec("SELECT 1")
+		require.Error(t, err)
+	})
+}
+
+// groupsVerifier is an ExternalVerifier whose users have their name as password, and belong to the groups of a map
+// which can be changed.
+type groupsVerifier struct {
+	mu     sync.Mutex
+	groups map[string][]string
+}
+
+func (v *groupsVerifier) Verify(user, password string) ([]string, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if password != user {
+		return nil, auth.ErrInvalidCredentials.New(user)
+	}
+	return v.groups[user], nil
+}
+
+func (v *groupsVerifier) setGroups(user string, groups ...string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.groups[user] = groups
+}
+
+func TestExternalPermissionsExpire(t *testing.T) {
+	require := require.New(t)
+	v := &groupsVerifier{groups: map[string][]string{"alice": {"dba"}}}
+	a := auth.NewExternal(v, map[string]auth.Permission{
+		"dba":      auth.AllPermissions,
+		"analysts": auth.ReadPerm,
+	}, 500*time.Millisecond)
+	s := tlsAuthServer(t, a)
+	defer func() {
+		require.NoError(s.Close())
+	}()
+
+	db, err := dsql.Open("mysql", connString("alice", "alice")+"?tls=skip-verify&allowCleartextPasswords=true")
+	require.NoError(err)
+	defer db.Close()
+	conn, err := db.Conn(context.Background())
+	require.NoError(err)
+	defer conn.Close()
+	_, err = conn.ExecContext(context.Background(), "INSERT INTO test (id, name) VALUES ('1', 'name')")
+	require.NoError(err)
+
+	// The groups of a user on the last authentication from a host apply to the connections from that host
+	v.setGroups("alice", "analysts")
+	other, err := db.Conn(context.Background())
+	require.NoError(err)
+	defer other.Close()
+	_, err = other.ExecContext(context.Background(), "SELECT 1")
+	require.NoError(err)
+	_, err = conn.ExecContext(context.Background(), "INSERT INTO test (id, name) VALUES ('2', 'name')")
+	require.Error(err)
+	require.Contains(err.Error(), "not authorized")
+
+	// Once the permissions expire, the user needs to connect again
+	time.Sleep(600 * time.Millisecond)
+	_, err = conn.ExecContext(context.Background(), "SELECT 1")
+	require.Error(err)
+	require.Contains(err.Error(), "not authorized")
+	v.setGroups("alice", "dba")
+	third, err := db.Conn(context.Background())
+	require.NoError(err)
+	defer third.Close()
+	_, err = third.ExecContext(context.Background(), "INSERT INTO test (id, name) VALUES ('3', 'name')")
+	require.NoError(err)
+}
+
+func TestExternalVerifierFailure(t *testing.T) {
+	s := tlsAuthServer(t, auth.NewExternal(failingVerifier{}, nil, 0))
+	defer func() {
+		require.NoError(t, s.Close())
+	}()
+
+	db, err := dsql.Open("mysql", connString("alice", "alice")+"?tls=skip-verify&allowCleartextPasswords=true")
+	require.NoError(t, err)
+	defer db.Close()
+	_, err = db.Exec("SELECT 1")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "authentication service unavailable")
+}
@@ -0,0 +1,331 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"bufio"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/linanh/go-mysql-server/internal/ber"
+)
+
+// ErrLDAP is returned when a directory answers a request with an error.
+var ErrLDAP = errors.NewKind("LDAP %s failed with result code %d: %s")
+
+// LDAP protocol operations.
+const (
+	ldapBindRequest       = ber.ClassApplication | ber.Constructed | 0
+	ldapBindResponse      = ber.ClassApplication | ber.Constructed | 1
+	ldapUnbindRequest     = ber.ClassApplication | 2
+	ldapSearchRequest     = ber.ClassApplication | ber.Constructed | 3
+	ldapSearchResultEntry = ber.ClassApplication | ber.Constructed | 4
+	ldapSearchResultDone  = ber.ClassApplication | ber.Constructed | 5
+
+	// ldapSimpleAuth is the simple authentication choice of a bind request
+	ldapSimpleAuth = ber.ClassContext | 0
+	// ldapEqualityMatch is the equality match choice of a search filter
+	ldapEqualityMatch = ber.ClassContext | ber.Constructed | 3
+
+	ldapVersion            = 3
+	ldapScopeWholeSubtree  = 2
+	ldapNeverDerefAliases  = 0
+	ldapSuccess            = 0
+	ldapInvalidCredentials = 49
+
+	defaultLDAPTimeout = 10 * time.Second
+)
+
+// LDAPConfig configures an LDAP verifier.
+type LDAPConfig struct {
+	// Address is the host and port of the directory server.
+	Address string
+	// TLSConfig makes the verifier connect with LDAPS if it isn't nil.
+	TLSConfig *tls.Config
+	// UserDN is the DN users bind as, with %s standing for their escaped user name, such as
+	// "uid=%s,ou=people,dc=example,dc=com".
+	UserDN string
+	// GroupBaseDN is the DN under which groups are searched for, such as "ou=groups,dc=example,dc=com". If it's
+	// empty, users don't belong to any group.
+	GroupBaseDN string
+	// MemberAttribute is the attribute of groups which holds the DNs of their members. Defaults to member.
+	MemberAttribute string
+	// GroupNameAttribute is the attribute of groups which holds their name. Defaults to cn.
+	GroupNameAttribute string
+	// Timeout limits the whole exchange with the directory. Defaults to 10 seconds.
+	Timeout time.Duration
+}
+
+// LDAP is an ExternalVerifier which verifies passwords by binding to a directory as the user, and finds their groups
+// by searching for the groups which list the user as a member.
+type LDAP struct {
+	config LDAPConfig
+}
+
+var _ ExternalVerifier = (*LDAP)(nil)
+
+// NewLDAP creates an LDAP verifier with the configuration given.
+func NewLDAP(config LDAPConfig) *LDAP {
+	if config.MemberAttribute == "" {
+		config.MemberAttribute = "member"
+	}
+	if config.GroupNameAttribute == "" {
+		config.GroupNameAttribute = "cn"
+	}
+	if config.Timeout == 0 {
+		config.Timeout = defaultLDAPTimeout
+	}
+	return &LDAP{config: config}
+}
+
+// Verify implements the ExternalVerifier interface.
+func (l *LDAP) Verify(user, password string) ([]string, error) {
+	if password == "" {
+		return nil, ErrInvalidCredentials.New(user)
+	}
+
+	conn, err := l.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.close()
+
+	dn := fmt.Sprintf(l.config.UserDN, EscapeDN(user))
+	code, msg, err := conn.bind(dn, password)
+	if err != nil {
+		return nil, err
+	}
+	if code == ldapInvalidCredentials {
+		return nil, ErrInvalidCredentials.New(user)
+	}
+	if code != ldapSuccess {
+		return nil, ErrLDAP.New("bind", code, msg)
+	}
+
+	if l.config.GroupBaseDN == "" {
+		return nil, nil
+	}
+	return conn.searchValues(l.config.GroupBaseDN, l.config.MemberAttribute, dn, l.config.GroupNameAttribute)
+}
+
+func (l *LDAP) dial() (*ldapConn, error) {
+	dialer := &net.Dialer{Timeout: l.config.Timeout}
+	var conn net.Conn
+	var err error
+	if l.config.TLSConfig != nil {
+		conn, err = tls.DialWithDialer(dialer, "tcp", l.config.Address, l.config.TLSConfig)
+	} else {
+		conn, err = dialer.Dial("tcp", l.config.Address)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := conn.SetDeadline(time.Now().Add(l.config.Timeout)); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &ldapConn{conn: conn, r: bufio.NewReader(conn)}, nil
+}
+
+// EscapeDN escapes a value to be used in a distinguished name, as described in RFC 4514.
+func EscapeDN(value string) string {
+	var sb strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case c == '"' || c == '+' || c == ',' || c == ';' || c == '<' || c == '>' || c == '\\' || c == '=',
+			c == '#' && i == 0,
+			c == ' ' && (i == 0 || i == len(value)-1):
+			sb.WriteByte('\\')
+			sb.WriteByte(c)
+		case c == 0:
+			sb.WriteString(`\00`)
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String()
+}
+
+// ldapConn is a connection to a directory server.
+type ldapConn struct {
+	conn   net.Conn
+	r      *bufio.Reader
+	lastID int64
+}
+
+func (c *ldapConn) send(op []byte) (int64, error) {
+	c.lastID++
+	msg := ber.EncodeConstructed(ber.TagSequence, ber.EncodeInt(ber.TagInteger, c.lastID), op)
+	_, err := c.conn.Write(msg)
+	return c.lastID, err
+}
+
+// receive reads the next message for the request with the id given, returning its protocol operation.
+func (c *ldapConn) receive(id int64) (ber.Element, error) {
+	for {
+		msg, err := ber.Read(c.r)
+		if err != nil {
+			return ber.Element{}, err
+		}
+		parts, err := msg.Children()
+		if err != nil {
+			return ber.Element{}, err
+		}
+		if msg.Tag != ber.TagSequence || len(parts) < 2 {
+			return ber.Element{}, ber.ErrMalformed
+		}
+		msgID, err := parts[0].Int()
+		if err != nil {
+			return ber.Element{}, err
+		}
+		// Unsolicited notifications have id 0, and mean the server is about to close the connection
+		if msgID == 0 {
+			return ber.Element{}, fmt.Errorf("LDAP server sent an unsolicited notification")
+		}
+		if msgID == id {
+			return parts[1], nil
+		}
+	}
+}
+
+// ldapResult decodes an LDAPResult, returning its result code and diagnostic message.
+func ldapResult(op ber.Element) (int64, string, error) {
+	parts, err := op.Children()
+	if err != nil {
+		return 0, "", err
+	}
+	if len(parts) < 3 {
+		return 0, "", ber.ErrMalformed
+	}
+	code, err := parts[0].Int()
+	if err != nil {
+		return 0, "", err
+	}
+	return code, parts[2].String(), nil
+}
+
+func (c *ldapConn) bind(dn, password string) (int64, string, error) {
+	id, err := c.send(ber.EncodeConstructed(ldapBindRequest,
+		ber.EncodeInt(ber.TagInteger, ldapVersion),
+		ber.EncodeString(ber.TagOctetString, dn),
+		ber.EncodeString(ldapSimpleAuth, password),
+	))
+	if err != nil {
+		return 0, "", err
+	}
+
+	op, err := c.receive(id)
+	if err != nil {
+		return 0, "", err
+	}
+	if op.Tag != ldapBindResponse {
+		return 0, "", ber.ErrMalformed
+	}
+	return ldapResult(op)
+}
+
+// searchValues searches the subtree under the base DN for entries whose attribute equals the value given, and returns
+// the values of the result attribute of all of them.
+func (c *ldapConn) searchValues(baseDN, attribute, value, result string) ([]string, error) {
+	id, err := c.send(ber.EncodeConstructed(ldapSearchRequest,
+		ber.EncodeString(ber.TagOctetString, baseDN),
+		ber.EncodeInt(ber.TagEnumerated, ldapScopeWholeSubtree),
+		ber.EncodeInt(ber.TagEnumerated, ldapNeverDerefAliases),
+		ber.EncodeInt(ber.TagInteger, 0),
+		ber.EncodeInt(ber.TagInteger, 0),
+		ber.EncodeBool(ber.TagBoolean, false),
+		ber.EncodeConstructed(ldapEqualityMatch,
+			ber.EncodeString(ber.TagOctetString, attribute),
+			ber.EncodeString(ber.TagOctetString, value),
+		),
+		ber.EncodeConstructed(ber.TagSequence, ber.EncodeString(ber.TagOctetString, result)),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	var values []string
+	for {
+		op, err := c.receive(id)
+		if err != nil {
+			return nil, err
+		}
+
+		switch op.Tag {
+		case ldapSearchResultEntry:
+			entryValues, err := ldapEntryValues(op, result)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, entryValues...)
+		case ldapSearchResultDone:
+			code, msg, err := ldapResult(op)
+			if err != nil {
+				return nil, err
+			}
+			if code != ldapSuccess {
+				return nil, ErrLDAP.New("search", code, msg)
+			}
+			return values, nil
+		}
+		// Anything else, such as referrals, is ignored
+	}
+}
+
+// ldapEntryValues returns the values of an attribute in a search result entry.
+func ldapEntryValues(entry ber.Element, attribute string) ([]string, error) {
+	parts, err := entry.Children()
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) < 2 {
+		return nil, ber.ErrMalformed
+	}
+	attributes, err := parts[1].Children()
+	if err != nil {
+		return nil, err
+	}
+
+	var values []string
+	for _, a := range attributes {
+		fields, err := a.Children()
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) < 2 || !strings.EqualFold(fields[0].String(), attribute) {
+			continue
+		}
+		vals, err := fields[1].Children()
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range vals {
+			values = append(values, v.String())
+		}
+	}
+	return values, nil
+}
+
+func (c *ldapConn) close() {
+	// Unbind has no response
+	_, _ = c.send(ber.Encode(ldapUnbindRequest, nil))
+	c.conn.Close()
+}
@@ -0,0 +1,311 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ldaptest provides an in-process LDAP directory, to test auth.LDAP configurations without a directory
+// server. It only supports simple binds and searches with a single equality filter.
+package ldaptest
+
+import (
+	"bufio"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/linanh/go-mysql-server/internal/ber"
+)
+
+const (
+	bindRequest       = ber.ClassApplication | ber.Constructed | 0
+	bindResponse      = ber.ClassApplication | ber.Constructed | 1
+	unbindRequest     = ber.ClassApplication | 2
+	searchRequest     = ber.ClassApplication | ber.Constructed | 3
+	searchResultEntry = ber.ClassApplication | ber.Constructed | 4
+	searchResultDone  = ber.ClassApplication | ber.Constructed | 5
+	equalityMatch     = ber.ClassContext | ber.Constructed | 3
+
+	success                  = 0
+	protocolError            = 2
+	noSuchObject             = 32
+	invalidCredentials       = 49
+	insufficientAccessRights = 50
+	unwillingToPerform       = 53
+)
+
+// Entry is an entry of a Directory.
+type Entry struct {
+	// DN is the distinguished name of the entry.
+	DN string
+	// Password is the password to bind as the entry with. Entries without one can't be bound to.
+	Password string
+	// Attributes holds the values of the attributes of the entry, by name.
+	Attributes map[string][]string
+}
+
+// Directory is an LDAP server holding a fixed set of entries, listening on a local port.
+type Directory struct {
+	listener net.Listener
+	wg       sync.WaitGroup
+
+	mu      sync.Mutex
+	entries []Entry
+	conns   map[net.Conn]bool
+	binds   int
+}
+
+// NewDirectory starts a Directory listening on a random local port.
+func NewDirectory() (*Directory, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	d := &Directory{listener: l, conns: make(map[net.Conn]bool)}
+	d.wg.Add(1)
+	go d.serve()
+	return d, nil
+}
+
+// Address returns the address the directory listens on.
+func (d *Directory) Address() string {
+	return d.listener.Addr().String()
+}
+
+// Add adds an entry to the directory.
+func (d *Directory) Add(entry Entry) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.entries = append(d.entries, entry)
+}
+
+// Binds returns the number of bind requests the directory received.
+func (d *Directory) Binds() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.binds
+}
+
+// Close stops the directory and closes the connections to it.
+func (d *Directory) Close() error {
+	err := d.listener.Close()
+	d.mu.Lock()
+	for c := range d.conns {
+		c.Close()
+	}
+	d.mu.Unlock()
+	d.wg.Wait()
+	return err
+}
+
+func (d *Directory) serve() {
+	defer d.wg.Done()
+	for {
+		conn, err := d.listener.Accept()
+		if err != nil {
+			return
+		}
+		d.mu.Lock()
+		d.conns[conn] = true
+		d.mu.Unlock()
+
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+			d.handle(conn)
+			d.mu.Lock()
+			delete(d.conns, conn)
+			d.mu.Unlock()
+			conn.Close()
+		}()
+	}
+}
+
+// handle serves the requests of a connection until it's unbound or closed.
+func (d *Directory) handle(conn net.Conn) {
+	r := bufio.NewReader(conn)
+	// bound is the DN the connection is bound as, empty for anonymous
+	var bound string
+	for {
+		msg, err := ber.Read(r)
+		if err != nil {
+			return
+		}
+		parts, err := msg.Children()
+		if err != nil || len(parts) < 2 {
+			return
+		}
+		id, err := parts[0].Int()
+		if err != nil {
+			return
+		}
+
+		op := parts[1]
+		var responses [][]byte
+		switch op.Tag {
+		case bindRequest:
+			var code int64
+			code, bound = d.bind(op)
+			responses = append(responses, result(bindResponse, code))
+		case searchRequest:
+			responses = d.search(op, bound)
+		case unbindRequest:
+			return
+		default:
+			return
+		}
+
+		for _, response := range responses {
+			msg := ber.EncodeConstructed(ber.TagSequence, ber.EncodeInt(ber.TagInteger, id), response)
+			if _, err := conn.Write(msg); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// bind handles a bind request, returning its result code and the DN bound as.
+func (d *Directory) bind(op ber.Element) (int64, string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.binds++
+
+	fields, err := op.Children()
+	if err != nil || len(fields) < 3 {
+		return protocolError, ""
+	}
+	dn, password := fields[1].String(), fields[2].String()
+	if password == "" {
+		// An unauthenticated bind, which real servers allow unless told not to
+		return success, ""
+	}
+
+	for _, e := range d.entries {
+		if sameDN(e.DN, dn) {
+			if e.Password != "" && e.Password == password {
+				return success, e.DN
+			}
+			break
+		}
+	}
+	return invalidCredentials, ""
+}
+
+// search handles a search request, returning the entries found followed by the result.
+func (d *Directory) search(op ber.Element, bound string) [][]byte {
+	if bound == "" {
+		return [][]byte{result(searchResultDone, insufficientAccessRights)}
+	}
+
+	fields, err := op.Children()
+	if err != nil || len(fields) < 8 {
+		return [][]byte{result(searchResultDone, protocolError)}
+	}
+	base := fields[0].String()
+	filter := fields[6]
+	if filter.Tag != equalityMatch {
+		return [][]byte{result(searchResultDone, unwillingToPerform)}
+	}
+	assertion, err := filter.Children()
+	if err != nil || len(assertion) != 2 {
+		return [][]byte{result(searchResultDone, protocolError)}
+	}
+	attribute, value := assertion[0].String(), assertion[1].String()
+
+	requested, err := fields[7].Children()
+	if err != nil {
+		return [][]byte{result(searchResultDone, protocolError)}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var baseFound bool
+	var responses [][]byte
+	for _, e := range d.entries {
+		if !underDN(e.DN, base) {
+			continue
+		}
+		// Parent entries don't need to be added, the base exists as long as something is under it
+		baseFound = true
+		if !hasValue(e, attribute, value) {
+			continue
+		}
+
+		var attributes [][]byte
+		for _, r := range requested {
+			for name, values := range e.Attributes {
+				if !strings.EqualFold(name, r.String()) {
+					continue
+				}
+				var encoded [][]byte
+				for _, v := range values {
+					encoded = append(encoded, ber.EncodeString(ber.TagOctetString, v))
+				}
+				attributes = append(attributes, ber.EncodeConstructed(ber.TagSequence,
+					ber.EncodeString(ber.TagOctetString, name),
+					ber.EncodeConstructed(ber.TagSet, encoded...),
+				))
+			}
+		}
+		responses = append(responses, ber.EncodeConstructed(searchResultEntry,
+			ber.EncodeString(ber.TagOctetString, e.DN),
+			ber.EncodeConstructed(ber.TagSequence, attributes...),
+		))
+	}
+
+	if !baseFound {
+		return [][]byte{result(searchResultDone, noSuchObject)}
+	}
+	return append(responses, result(searchResultDone, success))
+}
+
+func result(tag byte, code int64) []byte {
+	return ber.EncodeConstructed(tag,
+		ber.EncodeInt(ber.TagEnumerated, code),
+		ber.EncodeString(ber.TagOctetString, ""),
+		ber.EncodeString(ber.TagOctetString, ""),
+	)
+}
+
+func hasValue(e Entry, attribute, value string) bool {
+	for name, values := range e.Attributes {
+		if !strings.EqualFold(name, attribute) {
+			continue
+		}
+		for _, v := range values {
+			if strings.EqualFold(v, value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// normalizeDN lower cases a DN and removes the spaces around its components, which is enough for the plain DNs used
+// in tests.
+func normalizeDN(dn string) string {
+	parts := strings.Split(dn, ",")
+	for i, p := range parts {
+		parts[i] = strings.TrimSpace(p)
+	}
+	return strings.ToLower(strings.Join(parts, ","))
+}
+
+func sameDN(a, b string) bool {
+	return normalizeDN(a) == normalizeDN(b)
+}
+
+// underDN returns whether a DN is the base DN given or one of its descendants.
+func underDN(dn, base string) bool {
+	dn, base = normalizeDN(dn), normalizeDN(base)
+	return dn == base || strings.HasSuffix(dn, ","+base)
+}
@@ -15,10 +15,7 @@
 package auth
 
 import (
-	"crypto/sha1"
-	"encoding/hex"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"regexp"
 	"strings"
@@ -62,23 +59,7 @@ func (u nativeUser) Allowed(p Permission) error {
 
 // NativePassword generates a mysql_native_password string.
 func NativePassword(password string) string {
-	if len(password) == 0 {
-		return ""
-	}
-
-	// native = sha1(sha1(password))
-
-	hash := sha1.New()
-	hash.Write([]byte(password))
-	s1 := hash.Sum(nil)
-
-	hash.Reset()
-	hash.Write(s1)
-	s2 := hash.Sum(nil)
-
-	s := strings.ToUpper(hex.EncodeToString(s2))
-
-	return fmt.Sprintf("*%s", s)
+	return sql.NativePasswordHash(password)
 }
 
 // Native holds mysql_native_password users.
@@ -149,6 +149,8 @@ func TestNativeAuthorizationSingleAll(t *testing.T) {
 		{"user", queries["unlock"], true},
 		{"root", queries["unlock"], false},
 		{"", queries["unlock"], false},
+
+		{"user", queries["set_global"], true},
 	}
 
 	testAuthorization(t, a, tests, nil)
@@ -181,6 +183,8 @@ func TestNativeAuthorizationSingleRead(t *testing.T) {
 		{"user", queries["unlock"], false},
 		{"root", queries["unlock"], false},
 		{"", queries["unlock"], false},
+
+		{"user", queries["set_global"], false},
 	}
 
 	testAuthorization(t, a, tests, nil)
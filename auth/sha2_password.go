@@ -0,0 +1,222 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+const (
+	// CachingSha2Password is the name of the caching_sha2_password plugin, the default one of MySQL 8.
+	CachingSha2Password = "caching_sha2_password"
+	// Sha256Password is the name of the sha256_password plugin.
+	Sha256Password = "sha256_password"
+
+	// sha2SaltLength is the length of the salts MySQL uses for both plugins.
+	sha2SaltLength = 20
+	// sha2Rounds is the number of SHA-256 crypt rounds used for new hashes, which is MySQL's default.
+	sha2Rounds = 5000
+	// sha2HashLength is the length of an encoded SHA-256 crypt digest.
+	sha2HashLength = 43
+
+	cryptAlphabet = "./0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+func init() {
+	sql.RegisterPasswordHasher(CachingSha2Password, CachingSha2PasswordHash)
+	sql.RegisterPasswordHasher(Sha256Password, Sha256PasswordHash)
+}
+
+// CachingSha2PasswordHash generates a caching_sha2_password string, in the format MySQL stores in the
+// authentication_string column: $A$ followed by the rounds in thousands, a 20 character salt and the SHA-256 crypt
+// digest of the password.
+func CachingSha2PasswordHash(password string) (string, error) {
+	if password == "" {
+		return "", nil
+	}
+	salt, err := newCryptSalt()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("$A$%03X$%s%s", sha2Rounds/1000, salt, sha256Crypt([]byte(password), []byte(salt), sha2Rounds)), nil
+}
+
+// Sha256PasswordHash generates a sha256_password string, which is a standard $5$ SHA-256 crypt string.
+func Sha256PasswordHash(password string) (string, error) {
+	if password == "" {
+		return "", nil
+	}
+	salt, err := newCryptSalt()
+	if err != nil {
+		return "", err
+	}
+	return "$5$" + salt + "$" + sha256Crypt([]byte(password), []byte(salt), sha2Rounds), nil
+}
+
+// validSha2Password returns whether the password matches a caching_sha2_password or sha256_password string.
+func validSha2Password(password []byte, authString string) bool {
+	if authString == "" {
+		return len(password) == 0
+	}
+
+	var salt, digest string
+	rounds := sha2Rounds
+	switch {
+	case strings.HasPrefix(authString, "$A$"):
+		// $A$005$<salt><digest>
+		if len(authString) != 7+sha2SaltLength+sha2HashLength || authString[6] != '$' {
+			return false
+		}
+		r, err := strconv.ParseUint(authString[3:6], 16, 16)
+		if err != nil {
+			return false
+		}
+		rounds = int(r) * 1000
+		salt = authString[7 : 7+sha2SaltLength]
+		digest = authString[7+sha2SaltLength:]
+	case strings.HasPrefix(authString, "$5$"):
+		// $5$[rounds=N$]<salt>$<digest>
+		parts := strings.Split(authString[3:], "$")
+		if len(parts) == 3 && strings.HasPrefix(parts[0], "rounds=") {
+			r, err := strconv.Atoi(strings.TrimPrefix(parts[0], "rounds="))
+			if err != nil {
+				return false
+			}
+			rounds = r
+			parts = parts[1:]
+		}
+		if len(parts) != 2 {
+			return false
+		}
+		salt, digest = parts[0], parts[1]
+	default:
+		return false
+	}
+
+	if rounds < 1000 || rounds > 999999999 || len(salt) > sha2SaltLength {
+		return false
+	}
+	candidate := sha256Crypt(password, []byte(salt), rounds)
+	return subtle.ConstantTimeCompare([]byte(candidate), []byte(digest)) == 1
+}
+
+// newCryptSalt returns a random salt made of characters which are valid in crypt strings.
+func newCryptSalt() (string, error) {
+	b := make([]byte, sha2SaltLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	for i := range b {
+		b[i] = cryptAlphabet[int(b[i])%len(cryptAlphabet)]
+	}
+	return string(b), nil
+}
+
+// sha256Crypt computes the SHA-256 crypt digest of a password, as described in
+// https://www.akkadia.org/drepper/SHA-crypt.txt, and returns it encoded.
+func sha256Crypt(password, salt []byte, rounds int) string {
+	alternate := sha256.New()
+	alternate.Write(password)
+	alternate.Write(salt)
+	alternate.Write(password)
+	altSum := alternate.Sum(nil)
+
+	a := sha256.New()
+	a.Write(password)
+	a.Write(salt)
+	i := len(password)
+	for ; i > sha256.Size; i -= sha256.Size {
+		a.Write(altSum)
+	}
+	a.Write(altSum[:i])
+	for i = len(password); i > 0; i >>= 1 {
+		if i&1 != 0 {
+			a.Write(altSum)
+		} else {
+			a.Write(password)
+		}
+	}
+	result := a.Sum(nil)
+
+	dp := sha256.New()
+	for range password {
+		dp.Write(password)
+	}
+	p := repeatDigest(dp.Sum(nil), len(password))
+
+	ds := sha256.New()
+	for i := 0; i < 16+int(result[0]); i++ {
+		ds.Write(salt)
+	}
+	s := repeatDigest(ds.Sum(nil), len(salt))
+
+	for r := 0; r < rounds; r++ {
+		c := sha256.New()
+		if r&1 != 0 {
+			c.Write(p)
+		} else {
+			c.Write(result)
+		}
+		if r%3 != 0 {
+			c.Write(s)
+		}
+		if r%7 != 0 {
+			c.Write(p)
+		}
+		if r&1 != 0 {
+			c.Write(result)
+		} else {
+			c.Write(p)
+		}
+		result = c.Sum(nil)
+	}
+
+	var sb strings.Builder
+	encode := func(b2, b1, b0 byte, n int) {
+		w := uint(b2)<<16 | uint(b1)<<8 | uint(b0)
+		for ; n > 0; n-- {
+			sb.WriteByte(cryptAlphabet[w&0x3f])
+			w >>= 6
+		}
+	}
+	for i := 0; i < 10; i++ {
+		// The bytes are encoded in groups of three, taken from positions ten apart and rotating between groups
+		j, k, l := i, i+10, i+20
+		if i%3 == 1 {
+			j, k, l = l, j, k
+		} else if i%3 == 2 {
+			j, k, l = k, l, j
+		}
+		encode(result[j], result[k], result[l], 4)
+	}
+	encode(0, result[31], result[30], 3)
+	return sb.String()
+}
+
+// repeatDigest returns a sequence of n bytes made by repeating the digest given.
+func repeatDigest(digest []byte, n int) []byte {
+	out := make([]byte, 0, n)
+	for len(out)+len(digest) < n {
+		out = append(out, digest...)
+	}
+	return append(out, digest[:n-len(out)]...)
+}
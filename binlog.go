@@ -0,0 +1,45 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// binlogging returns whether the statements of the session are logged to the binary log of the engine: whether it has
+// one, and @@sql_log_bin is on.
+func (e *Engine) binlogging(ctx *sql.Context) bool {
+	return e.Catalog.Binlog != nil && sqlLogBin(ctx)
+}
+
+// sqlLogBin returns whether @@sql_log_bin is on in the session.
+func sqlLogBin(ctx *sql.Context) bool {
+	val, err := ctx.GetSessionVariable(ctx, "sql_log_bin")
+	if err != nil {
+		return false
+	}
+	on, err := sql.ConvertToBool(val)
+	return err == nil && on
+}
+
+// initLogBin sets @@log_bin in the session to whether the engine has a binary log, as the variable is global but the
+// engines of a process may not all have one.
+func (e *Engine) initLogBin(ctx *sql.Context) error {
+	s, ok := ctx.Session.(sql.SessionVariableInitializer)
+	if !ok || e.Catalog.Binlog == nil {
+		return nil
+	}
+	return s.InitSessionVariable(ctx, "log_bin", int8(1))
+}
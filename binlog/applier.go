@@ -0,0 +1,482 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlog
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"gopkg.in/src-d/go-errors.v1"
+
+	sqle "github.com/linanh/go-mysql-server"
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// ErrInvalidEvent is returned when applying an event which can't be decoded.
+var ErrInvalidEvent = errors.NewKind("invalid binary log event: %s")
+
+// ErrChecksumMismatch is returned when applying an event whose checksum doesn't match its content.
+var ErrChecksumMismatch = errors.NewKind("checksum mismatch of binary log event at position %d")
+
+// ErrUnknownTableID is returned when applying a rows event of a table which no table map event mapped.
+var ErrUnknownTableID = errors.NewKind("rows event of table id %d which wasn't mapped")
+
+// ErrColumnCountMismatch is returned when applying the rows of a table whose number of columns differs from the one of
+// the table in the source.
+var ErrColumnCountMismatch = errors.NewKind("table %s.%s has %d columns, but its rows in the binary log have %d")
+
+// ErrPartialRowImage is returned when applying rows events which don't hold every column of their rows.
+var ErrPartialRowImage = errors.NewKind("rows of table %s.%s don't hold every column; the source must log them with binlog_row_image=FULL")
+
+// ErrTableNotEditable is returned when applying the rows of a table which can't be changed that way.
+var ErrTableNotEditable = errors.NewKind("table %s.%s doesn't support %s")
+
+// Applier applies the events of a row-based binary log to the databases of an engine: rows events are applied through
+// the editors of their tables, and statements, such as the ones changing the schema, are executed by the engine.
+// Tables are expected to have the same columns as their counterparts in the source, in the same order, and rows events
+// to hold full row images.
+//
+// The changes of a transaction are made in a transaction of each database supporting them, which are committed once
+// its XID event or COMMIT is applied.
+type Applier struct {
+	e *sqle.Engine
+
+	format    mysql.BinlogFormat
+	hasFormat bool
+	tables    map[uint64]*mysql.TableMap
+	// transactions are the transactions of the databases changed by the transaction being applied, by lowercase name.
+	transactions  map[string]*applierTransaction
+	inTransaction bool
+
+	file string
+	pos  uint64
+}
+
+// applierTransaction is a transaction of a database, along with the context it's made in.
+type applierTransaction struct {
+	ctx *sql.Context
+	db  sql.TransactionDatabase
+	tx  sql.Transaction
+}
+
+// NewApplier returns an applier of the events of a binary log to the databases of the engine given, starting at the
+// position given.
+func NewApplier(e *sqle.Engine, file string, pos uint64) *Applier {
+	return &Applier{
+		e:            e,
+		tables:       make(map[uint64]*mysql.TableMap),
+		transactions: make(map[string]*applierTransaction),
+		file:         file,
+		pos:          pos,
+	}
+}
+
+// Position returns the position in the binary log following the last transaction or statement applied.
+func (a *Applier) Position() (string, uint64) {
+	return a.file, a.pos
+}
+
+// ApplyFile applies the events of a file of a binary log.
+func (a *Applier) ApplyFile(ctx *sql.Context, name string, data []byte) error {
+	if !bytes.HasPrefix(data, Magic) {
+		return ErrInvalidEvent.New("not a binary log file")
+	}
+	a.file, a.pos = name, uint64(len(Magic))
+	data = data[len(Magic):]
+	for len(data) > 0 {
+		if len(data) < headerLength {
+			return ErrInvalidEvent.New("truncated event header")
+		}
+		size := binary.LittleEndian.Uint32(data[9:])
+		if size < headerLength || uint64(size) > uint64(len(data)) {
+			return ErrInvalidEvent.New("truncated event")
+		}
+		if err := a.Apply(ctx, data[:size]); err != nil {
+			return err
+		}
+		data = data[size:]
+	}
+	return nil
+}
+
+// Apply applies an event of a binary log, which the context given executes statements with.
+func (a *Applier) Apply(ctx *sql.Context, data []byte) error {
+	if len(data) < headerLength {
+		return ErrInvalidEvent.New("truncated event header")
+	}
+	typ := data[4]
+	endPos := uint64(binary.LittleEndian.Uint32(data[13:]))
+
+	// The format description event tells whether the events following it end with a checksum, and always has one
+	if typ == eventFormatDescription {
+		format, err := mysql.NewMysql56BinlogEvent(data).Format()
+		if err != nil {
+			return ErrInvalidEvent.New(err.Error())
+		}
+		a.format, a.hasFormat = format, true
+		a.advance(endPos)
+		return nil
+	}
+	if a.hasFormat && a.format.ChecksumAlgorithm == mysql.BinlogChecksumAlgCRC32 {
+		if len(data) < headerLength+4 {
+			return ErrInvalidEvent.New("truncated event")
+		}
+		checksum := binary.LittleEndian.Uint32(data[len(data)-4:])
+		data = data[:len(data)-4]
+		if crc32.ChecksumIEEE(data) != checksum {
+			return ErrChecksumMismatch.New(endPos - uint64(len(data)) - 4)
+		}
+	}
+
+	headerLen := headerLength
+	if a.hasFormat {
+		headerLen = int(a.format.HeaderLength)
+	}
+	ev := mysql.NewMysql56BinlogEvent(data)
+	switch {
+	case ev.IsRotate():
+		if len(data) < headerLen+8 {
+			return ErrInvalidEvent.New("truncated rotate event")
+		}
+		a.file = string(data[headerLen+8:])
+		a.pos = binary.LittleEndian.Uint64(data[headerLen:])
+		return nil
+	case !a.hasFormat:
+		return ErrInvalidEvent.New("event before the format description event")
+	case ev.IsQuery():
+		query, err := ev.Query(a.format)
+		if err != nil {
+			return ErrInvalidEvent.New(err.Error())
+		}
+		return a.applyQuery(ctx, query, endPos)
+	case ev.IsXID():
+		if err := a.commit(); err != nil {
+			return err
+		}
+		a.advance(endPos)
+		return nil
+	case ev.IsTableMap():
+		tm, err := ev.TableMap(a.format)
+		if err != nil {
+			return ErrInvalidEvent.New(err.Error())
+		}
+		a.tables[ev.TableID(a.format)] = tm
+		return nil
+	case ev.IsWriteRows(), ev.IsUpdateRows(), ev.IsDeleteRows():
+		return a.applyRows(ctx, ev)
+	default:
+		// Other events, such as GTID and heartbeat events, don't change anything
+		a.advance(endPos)
+		return nil
+	}
+}
+
+// advance moves the position past an event ending at the position given, unless it's in a transaction, whose events
+// are applied as a whole. Events which aren't in a file, such as the ones generated for a replica, have no position.
+func (a *Applier) advance(endPos uint64) {
+	if endPos != 0 && !a.inTransaction {
+		a.pos = endPos
+	}
+}
+
+// applyQuery applies a statement: the start or the end of a transaction, or a statement executed by the engine.
+func (a *Applier) applyQuery(ctx *sql.Context, query mysql.Query, endPos uint64) error {
+	switch strings.ToUpper(strings.TrimSpace(query.SQL)) {
+	case "BEGIN":
+		a.inTransaction = true
+		return nil
+	case "COMMIT":
+		if err := a.commit(); err != nil {
+			return err
+		}
+	case "ROLLBACK":
+		if err := a.Rollback(); err != nil {
+			return err
+		}
+	default:
+		if query.Database != "" {
+			ctx.SetCurrentDatabase(query.Database)
+		}
+		_, iter, err := a.e.Query(ctx, query.SQL)
+		if err != nil {
+			return err
+		}
+		if _, err := sql.RowIterToRows(ctx, iter); err != nil {
+			return err
+		}
+	}
+	a.advance(endPos)
+	return nil
+}
+
+// commit commits the transaction being applied.
+func (a *Applier) commit() error {
+	a.inTransaction = false
+	transactions := a.transactions
+	a.transactions = make(map[string]*applierTransaction)
+	for _, t := range transactions {
+		if err := t.db.CommitTransaction(t.ctx, t.tx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Rollback discards the changes of the transaction being applied, if any, such as when a replica stops in the middle
+// of one. The changes of databases which don't support transactions are kept.
+func (a *Applier) Rollback() error {
+	a.inTransaction = false
+	transactions := a.transactions
+	a.transactions = make(map[string]*applierTransaction)
+	for _, t := range transactions {
+		if err := t.db.Rollback(t.ctx, t.tx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// editContext returns the context the tables of a database are changed with: the one of its transaction if it supports
+// them, which is started if needed, or the context given otherwise.
+func (a *Applier) editContext(ctx *sql.Context, db sql.Database) (*sql.Context, error) {
+	tdb, ok := db.(sql.TransactionDatabase)
+	if !ok {
+		return ctx, nil
+	}
+	key := strings.ToLower(db.Name())
+	if t, ok := a.transactions[key]; ok {
+		return t.ctx, nil
+	}
+	txCtx := sql.NewContext(ctx, sql.WithSession(sql.NewBaseSession()))
+	txCtx.SetCurrentDatabase(db.Name())
+	tx, err := tdb.StartTransaction(txCtx)
+	if err != nil {
+		return nil, err
+	}
+	txCtx.SetTransaction(tx)
+	a.transactions[key] = &applierTransaction{ctx: txCtx, db: tdb, tx: tx}
+	return txCtx, nil
+}
+
+// applyRows applies the rows of a rows event through the editors of their table.
+func (a *Applier) applyRows(ctx *sql.Context, ev mysql.BinlogEvent) error {
+	tableID := ev.TableID(a.format)
+	tm, ok := a.tables[tableID]
+	if !ok {
+		return ErrUnknownTableID.New(tableID)
+	}
+	rows, err := ev.Rows(a.format, tm)
+	if err != nil {
+		return ErrInvalidEvent.New(err.Error())
+	}
+
+	db, err := a.e.Catalog.Database(tm.Database)
+	if err != nil {
+		return err
+	}
+	table, ok, err := db.GetTableInsensitive(ctx, tm.Name)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return sql.ErrTableNotFound.New(tm.Name)
+	}
+	schema := table.Schema()
+	if len(schema) != len(tm.Types) {
+		return ErrColumnCountMismatch.New(tm.Database, tm.Name, len(schema), len(tm.Types))
+	}
+	for _, columns := range []mysql.Bitmap{rows.IdentifyColumns, rows.DataColumns} {
+		if columns.Count() > 0 && columns.BitCount() != len(schema) {
+			return ErrPartialRowImage.New(tm.Database, tm.Name)
+		}
+	}
+
+	editCtx, err := a.editContext(ctx, db)
+	if err != nil {
+		return err
+	}
+	editor, err := newRowsEditor(editCtx, ev, table, tm)
+	if err != nil {
+		return err
+	}
+	editor.StatementBegin(editCtx)
+	err = func() error {
+		for _, row := range rows.Rows {
+			var old, new sql.Row
+			if ev.IsUpdateRows() || ev.IsDeleteRows() {
+				if old, err = decodeRowImage(tm, schema, row.NullIdentifyColumns, row.Identify); err != nil {
+					return err
+				}
+			}
+			if ev.IsUpdateRows() || ev.IsWriteRows() {
+				if new, err = decodeRowImage(tm, schema, row.NullColumns, row.Data); err != nil {
+					return err
+				}
+			}
+			if err := editor.apply(editCtx, old, new); err != nil {
+				return err
+			}
+		}
+		return nil
+	}()
+	if err != nil {
+		editor.DiscardChanges(editCtx, err)
+		editor.Close(editCtx)
+		return err
+	}
+	if err := editor.StatementComplete(editCtx); err != nil {
+		editor.Close(editCtx)
+		return err
+	}
+	return editor.Close(editCtx)
+}
+
+// rowsEditor is the editor of a table applying the rows of a rows event.
+type rowsEditor struct {
+	sql.TableEditor
+	sql.Closer
+	apply func(ctx *sql.Context, old, new sql.Row) error
+}
+
+func newRowsEditor(ctx *sql.Context, ev mysql.BinlogEvent, table sql.Table, tm *mysql.TableMap) (*rowsEditor, error) {
+	switch {
+	case ev.IsWriteRows():
+		insertable, ok := table.(sql.InsertableTable)
+		if !ok {
+			return nil, ErrTableNotEditable.New(tm.Database, tm.Name, "inserts")
+		}
+		inserter := insertable.Inserter(ctx)
+		return &rowsEditor{inserter, inserter, func(ctx *sql.Context, old, new sql.Row) error {
+			return inserter.Insert(ctx, new)
+		}}, nil
+	case ev.IsUpdateRows():
+		updatable, ok := table.(sql.UpdatableTable)
+		if !ok {
+			return nil, ErrTableNotEditable.New(tm.Database, tm.Name, "updates")
+		}
+		updater := updatable.Updater(ctx)
+		return &rowsEditor{updater, updater, updater.Update}, nil
+	default:
+		deletable, ok := table.(sql.DeletableTable)
+		if !ok {
+			return nil, ErrTableNotEditable.New(tm.Database, tm.Name, "deletes")
+		}
+		deleter := deletable.Deleter(ctx)
+		return &rowsEditor{deleter, deleter, func(ctx *sql.Context, old, new sql.Row) error {
+			return deleter.Delete(ctx, old)
+		}}, nil
+	}
+}
+
+// decodeRowImage decodes the image of a row of a rows event, whose NULL values are the ones of the bitmap given, into
+// a row of the schema given.
+func decodeRowImage(tm *mysql.TableMap, schema sql.Schema, nulls mysql.Bitmap, data []byte) (sql.Row, error) {
+	row := make(sql.Row, len(schema))
+	pos := 0
+	for i, col := range schema {
+		if nulls.Bit(i) {
+			continue
+		}
+		v, n, err := decodeValue(tm.Types[i], tm.Metadata[i], col.Type, data, pos)
+		if err != nil {
+			return nil, ErrInvalidEvent.New(fmt.Sprintf("column %s of %s.%s: %s", col.Name, tm.Database, tm.Name, err))
+		}
+		row[i] = v
+		pos += n
+	}
+	return row, nil
+}
+
+// decodeValue decodes a value of a column of the type given in a table map event, returning it as a value of the type
+// of the column of the table it's applied to, along with the number of bytes it's held in.
+func decodeValue(typ byte, metadata uint16, colType sql.Type, data []byte, pos int) (interface{}, int, error) {
+	switch {
+	case typ == typeJSON || typ == typeGeometry:
+		size := int(metadata)
+		if size < 1 || size > 4 || len(data) < pos+size {
+			return nil, 0, fmt.Errorf("truncated value")
+		}
+		var length int
+		for i := size - 1; i >= 0; i-- {
+			length = length<<8 | int(data[pos+i])
+		}
+		if len(data) < pos+size+length {
+			return nil, 0, fmt.Errorf("truncated value")
+		}
+		value := data[pos+size : pos+size+length]
+		if typ == typeJSON {
+			doc, err := decodeJSON(value)
+			if err != nil {
+				return nil, 0, err
+			}
+			v, err := colType.Convert(sql.JSONDocument{Val: doc})
+			return v, size + length, err
+		}
+		if len(value) < 4 {
+			return nil, 0, fmt.Errorf("truncated geometry")
+		}
+		g, ok := sql.GeometryFromWKB(value[4:], binary.LittleEndian.Uint32(value))
+		if !ok {
+			return nil, 0, fmt.Errorf("invalid geometry")
+		}
+		v, err := colType.Convert(g)
+		return v, size + length, err
+	case typ == typeNewDecimal:
+		d, n, err := decodeDecimal(data[pos:], int(metadata>>8), int(metadata&0xff))
+		if err != nil {
+			return nil, 0, err
+		}
+		v, err := colType.Convert(d)
+		return v, n, err
+	}
+
+	cell, n, err := mysql.CellValue(data, pos, typ, metadata, colType.Type())
+	if err != nil {
+		return nil, 0, err
+	}
+	raw := cell.Raw()
+	switch {
+	case typ == typeBit:
+		var bits uint64
+		for _, b := range raw {
+			bits = bits<<8 | uint64(b)
+		}
+		v, err := colType.Convert(bits)
+		return v, n, err
+	case typ == typeString && metadata>>8 == typeEnum:
+		// Enums and sets are logged as the index of their value and the bitmap of their values
+		index, err := strconv.ParseUint(string(raw), 10, 16)
+		if err != nil {
+			return nil, 0, err
+		}
+		v, err := colType.Convert(int(index))
+		return v, n, err
+	case typ == typeString && metadata>>8 == typeSet:
+		bits, err := strconv.ParseUint(string(raw), 10, 64)
+		if err != nil {
+			return nil, 0, err
+		}
+		v, err := colType.Convert(bits)
+		return v, n, err
+	default:
+		v, err := colType.Convert(string(raw))
+		return v, n, err
+	}
+}
@@ -0,0 +1,338 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlog_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io/ioutil"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sqle "github.com/linanh/go-mysql-server"
+	"github.com/linanh/go-mysql-server/auth"
+	"github.com/linanh/go-mysql-server/binlog"
+	"github.com/linanh/go-mysql-server/disk"
+	"github.com/linanh/go-mysql-server/memory"
+	"github.com/linanh/go-mysql-server/server"
+	"github.com/linanh/go-mysql-server/sql"
+	"github.com/linanh/go-mysql-server/sql/analyzer"
+)
+
+// newReplicaEngine returns an engine without a binary log, to apply the events of another one to.
+func newReplicaEngine(t *testing.T, db sql.Database) *testEngine {
+	c := sql.NewCatalog()
+	e := sqle.New(c, analyzer.NewDefault(c), nil)
+	e.AddDatabase(db)
+	return &testEngine{t: t, e: e}
+}
+
+// sourceQueries make the changes applied to replicas by the tests.
+var sourceQueries = []string{
+	"CREATE TABLE t (id int primary key, name varchar(20), price decimal(10,2), added datetime, " +
+		"kind enum('Small','Large'), tags json, data blob, u bigint unsigned, f double, d date, s set('a','b'), " +
+		"c char(3), ts timestamp, tm time, y year, i8 tinyint, m mediumint, b bit(10))",
+	"INSERT INTO t VALUES (1, 'abc', 1.50, '2021-01-02 03:04:05', 'Large', '{\"a\": [1, 2.5, \"x\", null, true]}', " +
+		"'xyz', 18446744073709551615, 2.25, '2021-03-04', 'a,b', 'ab', '2021-01-02 03:04:05', '-12:34:56', " +
+		"2021, -5, -70000, 513), (2, null, null, null, null, null, null, null, null, null, null, null, null, null, " +
+		"null, null, null, null), (3, 'ghi', -7.25, null, 'Small', '[]', '', 0, -1, null, '', '', null, null, null, " +
+		"null, null, 0)",
+	"UPDATE t SET name = 'def', kind = 'Small' WHERE id = 1",
+	"DELETE FROM t WHERE id = 2",
+	"START TRANSACTION",
+	"INSERT INTO t (id, name) VALUES (4, 'jkl')",
+	"UPDATE t SET price = price * 2 WHERE id = 3",
+	"COMMIT",
+	"ALTER TABLE t ADD COLUMN extra int",
+	"INSERT INTO t (id, extra) VALUES (5, 42)",
+}
+
+// requireSameRows requires the table t of both engines to hold the same rows.
+func requireSameRows(t *testing.T, source, replica *testEngine) {
+	expected, err := source.query(source.newContext(), "SELECT * FROM t ORDER BY id")
+	require.NoError(t, err)
+	rows, err := replica.query(replica.newContext(), "SELECT * FROM t ORDER BY id")
+	require.NoError(t, err)
+	require.Equal(t, expected, rows)
+}
+
+func TestApplyFile(t *testing.T) {
+	source := newTestEngine(t, memory.NewDatabase("mydb"))
+	source.exec(source.newContext(), sourceQueries...)
+	data, ok := source.log.File("binlog.000001")
+	require.True(t, ok)
+
+	db, err := disk.NewDatabase("mydb", t.TempDir())
+	require.NoError(t, err)
+	for _, db := range []sql.Database{memory.NewDatabase("mydb"), db} {
+		t.Run(fmt.Sprintf("%T", db), func(t *testing.T) {
+			require := require.New(t)
+			replica := newReplicaEngine(t, db)
+			applier := binlog.NewApplier(replica.e, "", 0)
+			require.NoError(applier.ApplyFile(replica.newContext(), "binlog.000001", data))
+			requireSameRows(t, source, replica)
+
+			file, pos := applier.Position()
+			require.Equal("binlog.000001", file)
+			require.Equal(uint64(len(data)), pos)
+		})
+	}
+}
+
+// TestApplyRecordedFile applies a file of a binary log recorded from an engine which made the changes of sourceQueries,
+// whose events were given CRC-32 checksums afterwards, as MySQL writes them by default.
+func TestApplyRecordedFile(t *testing.T) {
+	require := require.New(t)
+	data, err := ioutil.ReadFile("testdata/binlog.000001")
+	require.NoError(err)
+
+	replica := newReplicaEngine(t, memory.NewDatabase("mydb"))
+	applier := binlog.NewApplier(replica.e, "", 0)
+	require.NoError(applier.ApplyFile(replica.newContext(), "binlog.000001", data))
+	file, pos := applier.Position()
+	require.Equal("binlog.000001", file)
+	require.Equal(uint64(len(data)), pos)
+
+	rows, err := replica.query(replica.newContext(), "SELECT id, name, price, added, kind, tags, u, s, tm, b, extra "+
+		"FROM t ORDER BY id")
+	require.NoError(err)
+	var printed []string
+	for _, row := range rows {
+		printed = append(printed, fmt.Sprint(row))
+	}
+	require.Equal([]string{
+		"[1 def 1.50 2021-01-02 03:04:05 +0000 UTC Small {map[a:[1 2.5 x <nil> true]]} 18446744073709551615 a,b -12:34:56 513 <nil>]",
+		"[3 ghi -14.50 <nil> Small {[]} 0  <nil> 0 <nil>]",
+		"[4 jkl <nil> <nil> <nil> <nil> <nil> <nil> <nil> <nil> <nil>]",
+		"[5 <nil> <nil> <nil> <nil> <nil> <nil> <nil> <nil> <nil> 42]",
+	}, printed)
+}
+
+func TestApplyRollback(t *testing.T) {
+	require := require.New(t)
+	source := newTestEngine(t, memory.NewDatabase("mydb"))
+	source.exec(source.newContext(), sourceQueries[:2]...)
+	data, _ := source.log.File("binlog.000001")
+
+	db, err := disk.NewDatabase("mydb", t.TempDir())
+	require.NoError(err)
+	replica := newReplicaEngine(t, db)
+	applier := binlog.NewApplier(replica.e, "", 0)
+	ctx := replica.newContext()
+
+	// The events of the insert, without its XID event
+	events := splitEvents(data)
+	require.Equal("Xid", eventType(events[len(events)-1]))
+	for _, event := range events[:len(events)-1] {
+		require.NoError(applier.Apply(ctx, event))
+	}
+	_, pos := applier.Position()
+	require.Equal(uint64(len(data)-len(events[len(events)-1])-len(events[len(events)-2])-
+		len(events[len(events)-3])-len(events[len(events)-4])), pos)
+
+	require.NoError(applier.Rollback())
+	rows, err := replica.query(ctx, "SELECT id FROM t")
+	require.NoError(err)
+	require.Empty(rows)
+}
+
+func TestApplyChecksums(t *testing.T) {
+	require := require.New(t)
+	source := newTestEngine(t, memory.NewDatabase("mydb"))
+	source.exec(source.newContext(), sourceQueries...)
+	data, _ := source.log.File("binlog.000001")
+	data = withChecksums(data)
+
+	replica := newReplicaEngine(t, memory.NewDatabase("mydb"))
+	applier := binlog.NewApplier(replica.e, "", 0)
+	require.NoError(applier.ApplyFile(replica.newContext(), "binlog.000001", data))
+	requireSameRows(t, source, replica)
+
+	// The last byte of the row of the last insert
+	data[len(data)-4-31-10] ^= 1
+	replica = newReplicaEngine(t, memory.NewDatabase("mydb"))
+	applier = binlog.NewApplier(replica.e, "", 0)
+	err := applier.ApplyFile(replica.newContext(), "binlog.000001", data)
+	require.True(binlog.ErrChecksumMismatch.Is(err), "%v", err)
+}
+
+func TestApplyUnknownTable(t *testing.T) {
+	source := newTestEngine(t, memory.NewDatabase("mydb"))
+	source.exec(source.newContext(), sourceQueries[:2]...)
+	data, _ := source.log.File("binlog.000001")
+	events := splitEvents(data)
+
+	replica := newReplicaEngine(t, memory.NewDatabase("mydb"))
+	applier := binlog.NewApplier(replica.e, "", 0)
+	ctx := replica.newContext()
+	require.Equal(t, "Query", eventType(events[1]))
+	var err error
+	// The events following the CREATE TABLE statement
+	for _, event := range append(events[:1:1], events[2:]...) {
+		if err = applier.Apply(ctx, event); err != nil {
+			break
+		}
+	}
+	require.True(t, sql.ErrTableNotFound.Is(err), "%v", err)
+}
+
+func TestReplica(t *testing.T) {
+	require := require.New(t)
+	source := newTestEngine(t, memory.NewDatabase("mydb"))
+	ctx := source.newContext()
+	source.exec(ctx, sourceQueries[:2]...)
+
+	s, err := server.NewDefaultServer(server.Config{
+		Protocol: "tcp",
+		Address:  "localhost:0",
+		Auth:     new(auth.None),
+	}, source.e)
+	require.NoError(err)
+	go s.Start()
+	defer s.Close()
+
+	replica := newReplicaEngine(t, memory.NewDatabase("mydb"))
+	replica.e.Catalog.Replica = binlog.NewReplica(replica.e, 2)
+	replicaCtx := replica.newContext()
+
+	_, err = replica.query(replicaCtx, "START REPLICA")
+	require.True(sql.ErrReplicaNotConfigured.Is(err), "%v", err)
+	rows, err := replica.query(replicaCtx, "SHOW REPLICA STATUS")
+	require.NoError(err)
+	require.Empty(rows)
+
+	_, port, err := net.SplitHostPort(s.Listener.Addr().String())
+	require.NoError(err)
+	portNumber, err := strconv.Atoi(port)
+	require.NoError(err)
+	replica.exec(replicaCtx,
+		"CHANGE REPLICATION SOURCE TO SOURCE_HOST = '127.0.0.1', SOURCE_PORT = "+port+", SOURCE_USER = 'root', "+
+			"SOURCE_CONNECT_RETRY = 1",
+		"START REPLICA",
+	)
+	_, err = replica.query(replicaCtx, "CHANGE REPLICATION SOURCE TO SOURCE_PORT = 1")
+	require.True(sql.ErrReplicaRunning.Is(err), "%v", err)
+
+	// The tables of the replica are read once it applied every event, since memory tables can't be read while they're
+	// changed
+	waitForRows := func() {
+		files := source.log.Files()
+		end := files[len(files)-1].Size
+		require.Eventually(func() bool {
+			return replica.e.Catalog.Replica.Status().ExecLogPos == end
+		}, 5*time.Second, 10*time.Millisecond)
+		requireSameRows(t, source, replica)
+	}
+	waitForRows()
+
+	// The changes made while the replica is running are streamed to it
+	source.exec(ctx, sourceQueries[2:]...)
+	waitForRows()
+
+	rows, err = replica.query(replicaCtx, "SHOW REPLICA STATUS")
+	require.NoError(err)
+	require.Len(rows, 1)
+	files := source.log.Files()
+	end := files[len(files)-1].Size
+	require.Equal(sql.Row{
+		"Waiting for source to send event", "127.0.0.1", "root", uint16(portNumber), uint32(1),
+		"binlog.000001", end, "binlog.000001", end, "Yes", "Yes", "", "", uint32(1),
+	}, rows[0])
+
+	// Stopping the replica keeps its position, which it resumes from once started again
+	replica.exec(replicaCtx, "STOP REPLICA")
+	source.exec(ctx, "INSERT INTO t (id) VALUES (6)")
+	replica.exec(replicaCtx, "START REPLICA")
+	waitForRows()
+	replica.exec(replicaCtx, "STOP REPLICA")
+
+	rows, err = replica.query(replicaCtx, "SHOW REPLICA STATUS")
+	require.NoError(err)
+	require.Equal("No", rows[0][9])
+	require.Equal("No", rows[0][10])
+
+	// Errors applying events stop the replica
+	replica.exec(replicaCtx, "DROP TABLE t", "START REPLICA")
+	source.exec(ctx, "INSERT INTO t (id) VALUES (7)")
+	require.Eventually(func() bool {
+		return !replica.e.Catalog.Replica.Status().SQLRunning
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Contains(replica.e.Catalog.Replica.Status().LastSQLError, "table not found: t")
+}
+
+// splitEvents returns the events of a file of a binary log.
+func splitEvents(data []byte) [][]byte {
+	var events [][]byte
+	data = data[len(binlog.Magic):]
+	for len(data) > 0 {
+		size := binary.LittleEndian.Uint32(data[9:])
+		events = append(events, data[:size])
+		data = data[size:]
+	}
+	return events
+}
+
+// eventType returns the name of the type of an event.
+func eventType(event []byte) string {
+	switch event[4] {
+	case 2:
+		return "Query"
+	case 4:
+		return "Rotate"
+	case 15:
+		return "Format_desc"
+	case 16:
+		return "Xid"
+	case 19:
+		return "Table_map"
+	case 30:
+		return "Write_rows"
+	case 31:
+		return "Update_rows"
+	case 32:
+		return "Delete_rows"
+	case 33:
+		return "Gtid"
+	case 35:
+		return "Previous_gtids"
+	default:
+		return fmt.Sprint(event[4])
+	}
+}
+
+// withChecksums returns the events of a file of a binary log without checksums as if they had CRC-32 checksums, as
+// MySQL writes them by default.
+func withChecksums(data []byte) []byte {
+	result := append([]byte(nil), binlog.Magic...)
+	for _, event := range splitEvents(data) {
+		event = append([]byte(nil), event...)
+		if event[4] == 15 {
+			// The checksum algorithm precedes the checksum of the format description event
+			event[len(event)-5] = 1
+			event = event[:len(event)-4]
+		}
+		binary.LittleEndian.PutUint32(event[9:], uint32(len(event)+4))
+		binary.LittleEndian.PutUint32(event[13:], uint32(len(result)+len(event)+4))
+		event = append(event, 0, 0, 0, 0)
+		binary.LittleEndian.PutUint32(event[len(event)-4:], crc32.ChecksumIEEE(event[:len(event)-4]))
+		result = append(result, event...)
+	}
+	return result
+}
@@ -0,0 +1,486 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlog
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/shopspring/decimal"
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// ErrUnsupportedColumnType is returned when logging the changes of a table with a column whose type can't be logged.
+var ErrUnsupportedColumnType = errors.NewKind("column %s of type %s can't be written to the binary log")
+
+// ErrInvalidDecimal is returned when applying a rows event holding a decimal which can't be decoded.
+var ErrInvalidDecimal = errors.NewKind("invalid binary decimal of precision %d and scale %d")
+
+// The types of the columns of table map events.
+const (
+	typeTiny       = 1
+	typeShort      = 2
+	typeLong       = 3
+	typeFloat      = 4
+	typeDouble     = 5
+	typeLongLong   = 8
+	typeInt24      = 9
+	typeDate       = 10
+	typeYear       = 13
+	typeVarchar    = 15
+	typeBit        = 16
+	typeTimestamp2 = 17
+	typeDatetime2  = 18
+	typeTime2      = 19
+	typeJSON       = 245
+	typeNewDecimal = 246
+	typeEnum       = 247
+	typeSet        = 248
+	typeBlob       = 252
+	typeString     = 254
+	typeGeometry   = 255
+)
+
+// fractionalPrecision is the precision of the fractional seconds of the temporal columns.
+const fractionalPrecision = 6
+
+// column describes how the values of a column are written to rows events.
+type column struct {
+	name     string
+	sqlType  sql.Type
+	typ      byte
+	metadata []byte
+	nullable bool
+	numeric  bool
+	unsigned bool
+	// size is the size of the values of the column, for the types whose values have a fixed size, or the size of their
+	// length for the ones whose values have a variable size.
+	size int
+}
+
+// newColumns returns how the values of the columns of the schema given are written to rows events.
+func newColumns(schema sql.Schema) ([]column, error) {
+	columns := make([]column, len(schema))
+	for i, col := range schema {
+		c, err := newColumn(col)
+		if err != nil {
+			return nil, err
+		}
+		columns[i] = c
+	}
+	return columns, nil
+}
+
+func newColumn(col *sql.Column) (column, error) {
+	c := column{name: col.Name, sqlType: col.Type, nullable: col.Nullable}
+	switch typ := col.Type.Type(); typ {
+	case sqltypes.Int8, sqltypes.Uint8:
+		c.typ, c.size, c.numeric = typeTiny, 1, true
+	case sqltypes.Int16, sqltypes.Uint16:
+		c.typ, c.size, c.numeric = typeShort, 2, true
+	case sqltypes.Int24, sqltypes.Uint24:
+		c.typ, c.size, c.numeric = typeInt24, 3, true
+	case sqltypes.Int32, sqltypes.Uint32:
+		c.typ, c.size, c.numeric = typeLong, 4, true
+	case sqltypes.Int64, sqltypes.Uint64:
+		c.typ, c.size, c.numeric = typeLongLong, 8, true
+	case sqltypes.Float32:
+		c.typ, c.size, c.numeric = typeFloat, 4, true
+		c.metadata = []byte{4}
+	case sqltypes.Float64:
+		c.typ, c.size, c.numeric = typeDouble, 8, true
+		c.metadata = []byte{8}
+	case sqltypes.Decimal:
+		dt := col.Type.(sql.DecimalType)
+		c.typ, c.numeric = typeNewDecimal, true
+		c.metadata = []byte{dt.Precision(), dt.Scale()}
+	case sqltypes.Year:
+		c.typ, c.size = typeYear, 1
+	case sqltypes.Date:
+		c.typ, c.size = typeDate, 3
+	case sqltypes.Datetime:
+		c.typ = typeDatetime2
+		c.metadata = []byte{fractionalPrecision}
+	case sqltypes.Timestamp:
+		c.typ = typeTimestamp2
+		c.metadata = []byte{fractionalPrecision}
+	case sqltypes.Time:
+		c.typ = typeTime2
+		c.metadata = []byte{fractionalPrecision}
+	case sqltypes.Char, sqltypes.Binary:
+		// Values of fixed length strings have a length of one byte if they're shorter than 256 bytes, and two bytes
+		// otherwise, which is told by the bits 8 and 9 of their maximum length being stored in the type byte
+		length := col.Type.(sql.StringType).MaxByteLength()
+		c.typ = typeString
+		c.metadata = []byte{byte(typeString ^ ((length & 0x300) >> 4)), byte(length)}
+		c.size = lengthSize(length)
+	case sqltypes.VarChar, sqltypes.VarBinary:
+		length := col.Type.(sql.StringType).MaxByteLength()
+		c.typ = typeVarchar
+		c.metadata = appendUint16(nil, uint16(length))
+		c.size = lengthSize(length)
+	case sqltypes.Text, sqltypes.Blob:
+		length := col.Type.(sql.StringType).MaxByteLength()
+		c.typ = typeBlob
+		switch {
+		case length <= math.MaxUint8:
+			c.size = 1
+		case length <= math.MaxUint16:
+			c.size = 2
+		case length <= 1<<24-1:
+			c.size = 3
+		default:
+			c.size = 4
+		}
+		c.metadata = []byte{byte(c.size)}
+	case sqltypes.Enum:
+		c.typ = typeString
+		c.size = lengthSize(int64(col.Type.(sql.EnumType).NumberOfElements()))
+		c.metadata = []byte{typeEnum, byte(c.size)}
+	case sqltypes.Set:
+		c.typ = typeString
+		c.size = (int(col.Type.(sql.SetType).NumberOfElements()) + 7) / 8
+		if c.size > 4 {
+			c.size = 8
+		}
+		c.metadata = []byte{typeSet, byte(c.size)}
+	case sqltypes.Bit:
+		bits := col.Type.(sql.BitType).NumberOfBits()
+		c.typ = typeBit
+		c.metadata = []byte{bits % 8, bits / 8}
+		c.size = (int(bits) + 7) / 8
+	case sqltypes.TypeJSON:
+		c.typ, c.size = typeJSON, 4
+		c.metadata = []byte{4}
+	case sqltypes.Geometry:
+		c.typ, c.size = typeGeometry, 4
+		c.metadata = []byte{4}
+	default:
+		return column{}, ErrUnsupportedColumnType.New(col.Name, col.Type.String())
+	}
+	if c.numeric {
+		c.unsigned = sqltypes.IsUnsigned(col.Type.Type())
+	}
+	return c, nil
+}
+
+// lengthSize returns the size of the length of strings of the maximum length given.
+func lengthSize(maxLength int64) int {
+	if maxLength > math.MaxUint8 {
+		return 2
+	}
+	return 1
+}
+
+// appendRow appends the image of a row to a rows event: a bitmap of its NULL values, followed by its other values.
+func appendRow(b []byte, columns []column, row sql.Row) ([]byte, error) {
+	nulls := make([]bool, len(columns))
+	for i := range columns {
+		nulls[i] = row[i] == nil
+	}
+	b = appendBitmap(b, nulls)
+	for i, c := range columns {
+		if row[i] == nil {
+			continue
+		}
+		var err error
+		if b, err = c.appendValue(b, row[i]); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// appendValue appends a value of the column, which isn't NULL, to a rows event.
+func (c column) appendValue(b []byte, v interface{}) ([]byte, error) {
+	switch c.typ {
+	case typeTiny, typeShort, typeInt24, typeLong, typeLongLong:
+		var n uint64
+		if c.unsigned {
+			u, err := sql.Uint64.Convert(v)
+			if err != nil {
+				return nil, err
+			}
+			n = u.(uint64)
+		} else {
+			i, err := sql.Int64.Convert(v)
+			if err != nil {
+				return nil, err
+			}
+			n = uint64(i.(int64))
+		}
+		return appendUintN(b, n, c.size), nil
+	case typeFloat:
+		f, err := sql.Float32.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		return appendUint32(b, math.Float32bits(f.(float32))), nil
+	case typeDouble:
+		f, err := sql.Float64.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		return appendUint64(b, math.Float64bits(f.(float64))), nil
+	case typeNewDecimal:
+		d, err := c.sqlType.(sql.DecimalType).ConvertToDecimal(v)
+		if err != nil {
+			return nil, err
+		}
+		return appendDecimal(b, d.Decimal, int(c.metadata[0]), int(c.metadata[1])), nil
+	case typeYear:
+		y, err := c.sqlType.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		year := y.(int16)
+		if year == 0 {
+			return append(b, 0), nil
+		}
+		return append(b, byte(year-1900)), nil
+	case typeDate:
+		t, err := c.convertTime(v)
+		if err != nil {
+			return nil, err
+		}
+		return appendUint24(b, uint32(t.Day()|int(t.Month())<<5|t.Year()<<9)), nil
+	case typeDatetime2:
+		t, err := c.convertTime(v)
+		if err != nil {
+			return nil, err
+		}
+		ym := uint64(t.Year()*13 + int(t.Month()))
+		packed := (ym<<5|uint64(t.Day()))<<17 | uint64(t.Hour()<<12|t.Minute()<<6|t.Second())
+		b = appendUintBigEndian(b, packed+0x8000000000, 5)
+		return appendUintBigEndian(b, uint64(t.Nanosecond()/1000), 3), nil
+	case typeTimestamp2:
+		t, err := c.convertTime(v)
+		if err != nil {
+			return nil, err
+		}
+		b = appendUintBigEndian(b, uint64(t.Unix()), 4)
+		return appendUintBigEndian(b, uint64(t.Nanosecond()/1000), 3), nil
+	case typeTime2:
+		micros, err := c.sqlType.(sql.TimeType).Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		abs := micros
+		if abs < 0 {
+			abs = -abs
+		}
+		seconds := abs / 1000000
+		packed := (seconds/3600<<12 | seconds/60%60<<6 | seconds%60) << 24
+		packed |= abs % 1000000
+		if micros < 0 {
+			packed = -packed
+		}
+		return appendUintBigEndian(b, uint64(packed+0x800000000000), 6), nil
+	case typeString:
+		switch c.metadata[0] {
+		case typeEnum:
+			i, err := c.sqlType.(sql.EnumType).Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			return appendUintN(b, uint64(i), c.size), nil
+		case typeSet:
+			bits, err := c.sqlType.(sql.SetType).Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			return appendUintN(b, bits, c.size), nil
+		default:
+			s, err := c.convertString(v)
+			if err != nil {
+				return nil, err
+			}
+			// Trailing spaces of fixed length strings aren't kept
+			if c.sqlType.Type() == sqltypes.Char {
+				s = strings.TrimRight(s, " ")
+			}
+			return append(appendUintN(b, uint64(len(s)), c.size), s...), nil
+		}
+	case typeVarchar, typeBlob:
+		s, err := c.convertString(v)
+		if err != nil {
+			return nil, err
+		}
+		return append(appendUintN(b, uint64(len(s)), c.size), s...), nil
+	case typeBit:
+		bits, err := c.sqlType.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		return appendUintBigEndian(b, bits.(uint64), c.size), nil
+	case typeJSON:
+		doc, err := c.sqlType.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		val, err := doc.(sql.JSONValue).Unmarshall(sql.NewEmptyContext())
+		if err != nil {
+			return nil, err
+		}
+		data, err := encodeJSON(val.Val)
+		if err != nil {
+			return nil, err
+		}
+		return append(appendUint32(b, uint32(len(data))), data...), nil
+	case typeGeometry:
+		g, err := c.sqlType.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		geometry := g.(sql.GeometryValue)
+		data := append(appendUint32(nil, geometry.GetSRID()), sql.GeometryToWKB(geometry)...)
+		return append(appendUint32(b, uint32(len(data))), data...), nil
+	default:
+		return nil, fmt.Errorf("unexpected column type %d", c.typ)
+	}
+}
+
+func (c column) convertTime(v interface{}) (time.Time, error) {
+	t, err := c.sqlType.Convert(v)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.(time.Time).UTC(), nil
+}
+
+func (c column) convertString(v interface{}) (string, error) {
+	s, err := c.sqlType.Convert(v)
+	if err != nil {
+		return "", err
+	}
+	switch s := s.(type) {
+	case string:
+		return s, nil
+	case []byte:
+		return string(s), nil
+	default:
+		return fmt.Sprint(s), nil
+	}
+}
+
+// appendUintN appends the n least significant bytes of a value, little-endian.
+func appendUintN(b []byte, v uint64, n int) []byte {
+	for i := 0; i < n; i++ {
+		b = append(b, byte(v>>(8*uint(i))))
+	}
+	return b
+}
+
+// appendUintBigEndian appends the n least significant bytes of a value, big-endian.
+func appendUintBigEndian(b []byte, v uint64, n int) []byte {
+	for i := n - 1; i >= 0; i-- {
+		b = append(b, byte(v>>(8*uint(i))))
+	}
+	return b
+}
+
+// decimalGroupSizes are the sizes of groups of 0 to 9 digits of binary decimals.
+var decimalGroupSizes = [10]int{0, 1, 1, 2, 2, 3, 3, 4, 4, 4}
+
+// appendDecimal appends a decimal in the binary format of MySQL for the precision and scale given: its integral and
+// fractional digits in groups of nine stored in four bytes big-endian, the leftmost integral group and rightmost
+// fractional group holding the remaining digits in fewer bytes. The bits of negative decimals are inverted, and the
+// most significant bit is inverted for every decimal.
+func appendDecimal(b []byte, d decimal.Decimal, precision, scale int) []byte {
+	digits := d.Abs().StringFixed(int32(scale))
+	intDigits, fracDigits := digits, ""
+	if i := strings.IndexByte(digits, '.'); i >= 0 {
+		intDigits, fracDigits = digits[:i], digits[i+1:]
+	}
+	intLen := precision - scale
+	if len(intDigits) < intLen {
+		intDigits = strings.Repeat("0", intLen-len(intDigits)) + intDigits
+	}
+	intDigits = intDigits[len(intDigits)-intLen:]
+
+	var mask uint64
+	if d.Sign() < 0 {
+		mask = math.MaxUint64
+	}
+	start := len(b)
+	appendGroup := func(group string, size int) {
+		var v uint64
+		for _, digit := range group {
+			v = v*10 + uint64(digit-'0')
+		}
+		b = appendUintBigEndian(b, v^mask, size)
+	}
+
+	leading := intLen % 9
+	appendGroup(intDigits[:leading], decimalGroupSizes[leading])
+	for i := leading; i < intLen; i += 9 {
+		appendGroup(intDigits[i:i+9], 4)
+	}
+	full := scale / 9 * 9
+	for i := 0; i < full; i += 9 {
+		appendGroup(fracDigits[i:i+9], 4)
+	}
+	trailing := scale % 9
+	appendGroup(fracDigits[full:], decimalGroupSizes[trailing])
+
+	b[start] ^= 0x80
+	return b
+}
+
+// decodeDecimal decodes a decimal in the binary format of MySQL for the precision and scale given, as written by
+// appendDecimal, returning it along with the number of bytes it's held in.
+func decodeDecimal(data []byte, precision, scale int) (decimal.Decimal, int, error) {
+	intLen := precision - scale
+	leading, trailing := intLen%9, scale%9
+	size := decimalGroupSizes[leading] + intLen/9*4 + scale/9*4 + decimalGroupSizes[trailing]
+	if size == 0 || len(data) < size {
+		return decimal.Decimal{}, 0, ErrInvalidDecimal.New(precision, scale)
+	}
+	b := append([]byte(nil), data[:size]...)
+	b[0] ^= 0x80
+	var mask byte
+	var digits strings.Builder
+	if b[0]&0x80 != 0 {
+		mask = 0xff
+		digits.WriteByte('-')
+	}
+	readGroup := func(size, width int) {
+		var v uint64
+		for _, c := range b[:size] {
+			v = v<<8 | uint64(c^mask)
+		}
+		b = b[size:]
+		fmt.Fprintf(&digits, "%0*d", width, v)
+	}
+
+	readGroup(decimalGroupSizes[leading], leading)
+	for i := leading; i < intLen; i += 9 {
+		readGroup(4, 9)
+	}
+	if scale > 0 {
+		digits.WriteByte('.')
+		for i := 0; i < scale/9; i++ {
+			readGroup(4, 9)
+		}
+		readGroup(decimalGroupSizes[trailing], trailing)
+	}
+	d, err := decimal.NewFromString(digits.String())
+	return d, size, err
+}
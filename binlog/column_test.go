@@ -0,0 +1,127 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlog
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppendDecimal(t *testing.T) {
+	tests := []struct {
+		value            string
+		precision, scale int
+		expected         []byte
+	}{
+		// The example of the documentation of MySQL
+		{"1234567890.1234", 14, 4, []byte{0x81, 0x0d, 0xfb, 0x38, 0xd2, 0x04, 0xd2}},
+		{"-1234567890.1234", 14, 4, []byte{0x7e, 0xf2, 0x04, 0xc7, 0x2d, 0xfb, 0x2d}},
+		{"1.50", 10, 2, []byte{0x80, 0x00, 0x00, 0x01, 0x32}},
+		{"0", 5, 0, []byte{0x80, 0x00, 0x00}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			d, err := decimal.NewFromString(tt.value)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, appendDecimal(nil, d, tt.precision, tt.scale))
+		})
+	}
+}
+
+func TestEncodeJSONLargeContainer(t *testing.T) {
+	require := require.New(t)
+	// A container whose values don't fit in 64KB is a large one, with offsets of four bytes
+	values := make([]interface{}, 10)
+	for i := range values {
+		values[i] = string(make([]byte, 8000))
+	}
+	doc, err := encodeJSON(values)
+	require.NoError(err)
+	require.Equal(byte(jsonLargeArray), doc[0])
+	require.Equal(uint32(10), uint32(doc[1])|uint32(doc[2])<<8|uint32(doc[3])<<16|uint32(doc[4])<<24)
+	require.Equal(len(doc)-1, int(uint32(doc[5])|uint32(doc[6])<<8|uint32(doc[7])<<16|uint32(doc[8])<<24))
+
+	doc, err = encodeJSON(map[string]interface{}{"bb": 1, "a": 2, "c": int64(1) << 40})
+	require.NoError(err)
+	require.Equal(byte(jsonSmallObject), doc[0])
+	// Keys are sorted by length, then by their bytes
+	require.Equal("acbb", string(doc[len(doc)-4-8:len(doc)-8]))
+}
+
+func TestDecodeDecimal(t *testing.T) {
+	tests := []struct {
+		value            string
+		precision, scale int
+	}{
+		{"1234567890.1234", 14, 4},
+		{"-1234567890.1234", 14, 4},
+		{"1.5", 10, 2},
+		{"0", 5, 0},
+		{"-123456789012345678.123456789", 30, 9},
+		{"0.000000001", 65, 30},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			require := require.New(t)
+			d, err := decimal.NewFromString(tt.value)
+			require.NoError(err)
+			data := appendDecimal(nil, d, tt.precision, tt.scale)
+			decoded, n, err := decodeDecimal(append(data, 0xff), tt.precision, tt.scale)
+			require.NoError(err)
+			require.Equal(len(data), n)
+			require.True(d.Equal(decoded), "%s != %s", d, decoded)
+		})
+	}
+
+	_, _, err := decodeDecimal([]byte{0x80}, 10, 2)
+	require.True(t, ErrInvalidDecimal.Is(err))
+}
+
+func TestDecodeJSON(t *testing.T) {
+	large := make([]interface{}, 10)
+	for i := range large {
+		large[i] = string(make([]byte, 8000))
+	}
+	tests := []struct {
+		name     string
+		doc      interface{}
+		expected interface{}
+	}{
+		{"null", nil, nil},
+		{"literals", []interface{}{true, false, nil}, []interface{}{true, false, nil}},
+		{"numbers", []interface{}{int64(-5), 70000, int64(1) << 40, 2.5}, []interface{}{-5.0, 70000.0, float64(int64(1) << 40), 2.5}},
+		{"string", "abc", "abc"},
+		{
+			"object",
+			map[string]interface{}{"a": []interface{}{1, "x"}, "bb": map[string]interface{}{}},
+			map[string]interface{}{"a": []interface{}{1.0, "x"}, "bb": map[string]interface{}{}},
+		},
+		{"large", large, large},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := encodeJSON(tt.doc)
+			require.NoError(t, err)
+			doc, err := decodeJSON(data)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, doc)
+		})
+	}
+
+	_, err := decodeJSON([]byte{jsonSmallArray, 5, 0})
+	require.True(t, ErrInvalidJSONData.Is(err))
+}
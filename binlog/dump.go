@@ -0,0 +1,146 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlog
+
+import (
+	"context"
+	"encoding/binary"
+
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+// ErrDumpUnknownFile is returned when a replica asks for the events of a file which isn't one of the log.
+var ErrDumpUnknownFile = errors.NewKind("Could not find first log file name in binary log index file")
+
+// ErrDumpInvalidPosition is returned when a replica asks for the events of a file starting at a position which isn't
+// the one of an event.
+var ErrDumpInvalidPosition = errors.NewKind("Client requested master to start replication from impossible position %d in file '%s'")
+
+// Dump sends the events of the log to a replica, starting at the position given of the file with the name given, or
+// at the start of the log if the name is empty. The events are preceded by an artificial rotate event naming the
+// file, and by the format description event of the file when they start after it. The events of the files following
+// it are sent next, each file starting with its format description event.
+//
+// Once it sent the last event of the log, Dump returns if nonBlock is true. Otherwise, it waits for new events to send
+// until the context is done or send fails. The file being sent isn't removed until Dump moves on to the next one.
+func (l *Log) Dump(ctx context.Context, file string, pos uint64, nonBlock bool, send func(event []byte) error) error {
+	l.mu.Lock()
+	var f *logFile
+	if file == "" {
+		f = l.files[0]
+	} else {
+		f, _ = l.file(file)
+	}
+	if f == nil {
+		l.mu.Unlock()
+		return ErrDumpUnknownFile.New()
+	}
+	if pos < uint64(len(Magic)) {
+		pos = uint64(len(Magic))
+	}
+	if !f.isEventPosition(pos) {
+		l.mu.Unlock()
+		return ErrDumpInvalidPosition.New(pos, f.name)
+	}
+	formatDescription, err := f.read(uint64(len(Magic)), f.events[0].EndLogPos)
+	if err != nil {
+		l.mu.Unlock()
+		return err
+	}
+	formatDescription = append([]byte(nil), formatDescription...)
+	f.readers++
+	l.mu.Unlock()
+	defer func() {
+		l.mu.Lock()
+		f.readers--
+		l.mu.Unlock()
+	}()
+
+	rotate := rotateEvent(f.name, pos).finish(eventRotate, 0, serverID(), 0, flagArtificial)
+	if err := send(rotate); err != nil {
+		return err
+	}
+	// The format description event sent before events following it has no position, so that replicas don't take it
+	// for the position they're at
+	if pos > uint64(len(Magic)) {
+		binary.LittleEndian.PutUint32(formatDescription[13:], 0)
+		if err := send(withChecksum(formatDescription[:len(formatDescription)-4])); err != nil {
+			return err
+		}
+	}
+
+	for {
+		l.mu.Lock()
+		size := f.size
+		next := l.next(f)
+		appended := l.appended
+		l.mu.Unlock()
+		data, err := f.read(pos, size)
+		if err != nil {
+			return err
+		}
+
+		for len(data) > 0 {
+			size := binary.LittleEndian.Uint32(data[9:])
+			if err := send(data[:size]); err != nil {
+				return err
+			}
+			data = data[size:]
+			pos += uint64(size)
+		}
+
+		switch {
+		case next != nil:
+			// The file ends with a rotate event, and the events of the next one follow
+			l.mu.Lock()
+			f.readers--
+			f = next
+			f.readers++
+			l.mu.Unlock()
+			pos = uint64(len(Magic))
+		case nonBlock:
+			return nil
+		default:
+			select {
+			case <-appended:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+}
+
+// next returns the file following the one given, or nil if it's the file currently written to.
+func (l *Log) next(f *logFile) *logFile {
+	for i, file := range l.files[:len(l.files)-1] {
+		if file == f {
+			return l.files[i+1]
+		}
+	}
+	return nil
+}
+
+// isEventPosition returns whether the position given is the one of an event of the file, or its end.
+func (f *logFile) isEventPosition(pos uint64) bool {
+	if pos == uint64(len(Magic)) || pos == f.size {
+		return true
+	}
+	for _, ev := range f.events {
+		if ev.Pos == pos {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,109 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlog_test
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linanh/go-mysql-server/binlog"
+	"github.com/linanh/go-mysql-server/memory"
+)
+
+func TestDump(t *testing.T) {
+	require := require.New(t)
+	e := newTestEngine(t, memory.NewDatabase("mydb"))
+	ctx := e.newContext()
+	e.exec(ctx,
+		"CREATE TABLE t (id int primary key)",
+		"INSERT INTO t VALUES (1)",
+	)
+	events, err := e.log.Events("binlog.000001", 0)
+	require.NoError(err)
+
+	var sent [][]byte
+	send := func(event []byte) error {
+		sent = append(sent, event)
+		return nil
+	}
+
+	// The events of the log follow an artificial rotate event naming its file
+	require.NoError(e.log.Dump(ctx, "", 0, true, send))
+	require.Len(sent, len(events)+1)
+	rotate := mysql.NewMysql56BinlogEvent(sent[0])
+	require.True(rotate.IsRotate())
+	require.Equal(uint16(0x20), binary.LittleEndian.Uint16(sent[0][17:]))
+	data, _ := e.log.File("binlog.000001")
+	require.Equal(data[4:], concat(sent[1:]))
+
+	// Events starting after the format description event follow it, with no position
+	sent = nil
+	require.NoError(e.log.Dump(ctx, "binlog.000001", events[2].Pos, true, send))
+	require.Len(sent, len(events)-2+2)
+	fde := mysql.NewMysql56BinlogEvent(sent[1])
+	require.True(fde.IsFormatDescription())
+	require.True(fde.IsValid())
+	require.Equal(uint32(0), binary.LittleEndian.Uint32(sent[1][13:]))
+	_, err = fde.Format()
+	require.NoError(err)
+	require.Equal(data[events[2].Pos:], concat(sent[2:]))
+
+	err = e.log.Dump(ctx, "binlog.000009", 4, true, send)
+	require.True(binlog.ErrDumpUnknownFile.Is(err))
+	err = e.log.Dump(ctx, "binlog.000001", events[2].Pos+1, true, send)
+	require.True(binlog.ErrDumpInvalidPosition.Is(err))
+
+	// Without the non-block flag, events are sent as they're logged
+	dumpCtx, cancel := context.WithCancel(context.Background())
+	received := make(chan []byte, 16)
+	done := make(chan error)
+	go func() {
+		done <- e.log.Dump(dumpCtx, "binlog.000001", uint64(len(data)), false, func(event []byte) error {
+			received <- event
+			return nil
+		})
+	}()
+	require.True(mysql.NewMysql56BinlogEvent(<-received).IsRotate())
+	require.True(mysql.NewMysql56BinlogEvent(<-received).IsFormatDescription())
+	e.exec(ctx, "INSERT INTO t VALUES (2)")
+	for _, check := range []func(mysql.BinlogEvent) bool{
+		mysql.BinlogEvent.IsQuery,
+		mysql.BinlogEvent.IsTableMap,
+		mysql.BinlogEvent.IsWriteRows,
+		mysql.BinlogEvent.IsXID,
+	} {
+		select {
+		case ev := <-received:
+			require.True(check(mysql.NewMysql56BinlogEvent(ev)))
+		case <-time.After(5 * time.Second):
+			require.Fail("timed out waiting for events")
+		}
+	}
+	cancel()
+	require.Equal(context.Canceled, <-done)
+}
+
+func concat(events [][]byte) []byte {
+	var b []byte
+	for _, ev := range events {
+		b = append(b, ev...)
+	}
+	return b
+}
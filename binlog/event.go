@@ -0,0 +1,270 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlog
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+)
+
+// ServerVersion is the server version written in the format description event of the files of a log.
+const ServerVersion = "8.0.11"
+
+// Magic is the header every binary log file starts with.
+var Magic = []byte{0xfe, 'b', 'i', 'n'}
+
+// The types of the events written to a log.
+const (
+	eventQuery             = 2
+	eventRotate            = 4
+	eventFormatDescription = 15
+	eventXID               = 16
+	eventTableMap          = 19
+	eventWriteRows         = 30
+	eventUpdateRows        = 31
+	eventDeleteRows        = 32
+)
+
+// eventTypeNames are the names of the types of events, as shown by SHOW BINLOG EVENTS.
+var eventTypeNames = map[byte]string{
+	eventQuery:             "Query",
+	eventRotate:            "Rotate",
+	eventFormatDescription: "Format_desc",
+	eventXID:               "Xid",
+	eventTableMap:          "Table_map",
+	eventWriteRows:         "Write_rows",
+	eventUpdateRows:        "Update_rows",
+	eventDeleteRows:        "Delete_rows",
+}
+
+const (
+	// headerLength is the length of the header of every event.
+	headerLength = 19
+	// flagArtificial is the header flag of the events which aren't in a file, but generated for a replica.
+	flagArtificial = 0x20
+	// rowsFlagStatementEnd is the flag of the rows events ending a statement, after which a replica releases the
+	// tables it mapped.
+	rowsFlagStatementEnd = 0x01
+	// checksumAlgOff is the checksum algorithm of the events of a log, whose events have no checksum.
+	checksumAlgOff = 0
+)
+
+// postHeaderLengths are the lengths of the post-header of each event type, starting with type 1, written in the format
+// description event.
+var postHeaderLengths = []byte{
+	56, 13, 0, 8, 0, 18, 0, 4, 4, 4,
+	4, 18, 0, 0, 96, 0, 4, 26, 8, 0,
+	0, 0, 8, 8, 8, 2, 0, 0, 0, 10,
+	10, 10, 42, 42, 0, 18, 52, 0, 10,
+}
+
+// The codes of the status variables written in query events.
+const (
+	queryFlags2Code  = 0
+	querySQLModeCode = 1
+	queryCharsetCode = 4
+	queryCatalogNZ   = 6
+)
+
+const (
+	binlogVersion    = 4
+	collationUTF8MB4 = 255
+	defaultCatalog   = "std"
+	// tableMapFlags are the flags of table map events, telling the bitmaps of their columns have exactly as many
+	// bits as there are columns.
+	tableMapFlags = 1
+	// signednessMetadata is the type of the optional metadata of a table map event holding the signedness of its
+	// numeric columns.
+	signednessMetadata = 1
+)
+
+// event is an event being built, starting with space for its header.
+type event []byte
+
+func newEvent() event {
+	return make(event, headerLength, 64)
+}
+
+// finish fills in the header of the event, which ends at the position given of its file, and returns its bytes.
+func (e event) finish(typ byte, timestamp, serverID uint32, endPos uint32, flags uint16) []byte {
+	binary.LittleEndian.PutUint32(e[0:], timestamp)
+	e[4] = typ
+	binary.LittleEndian.PutUint32(e[5:], serverID)
+	binary.LittleEndian.PutUint32(e[9:], uint32(len(e)))
+	binary.LittleEndian.PutUint32(e[13:], endPos)
+	binary.LittleEndian.PutUint16(e[17:], flags)
+	return e
+}
+
+func appendUint16(b []byte, v uint16) []byte {
+	return append(b, byte(v), byte(v>>8))
+}
+
+func appendUint24(b []byte, v uint32) []byte {
+	return append(b, byte(v), byte(v>>8), byte(v>>16))
+}
+
+func appendUint32(b []byte, v uint32) []byte {
+	return append(b, byte(v), byte(v>>8), byte(v>>16), byte(v>>24))
+}
+
+func appendUint48(b []byte, v uint64) []byte {
+	return append(b, byte(v), byte(v>>8), byte(v>>16), byte(v>>24), byte(v>>32), byte(v>>40))
+}
+
+func appendUint64(b []byte, v uint64) []byte {
+	return append(b, byte(v), byte(v>>8), byte(v>>16), byte(v>>24), byte(v>>32), byte(v>>40), byte(v>>48), byte(v>>56))
+}
+
+// appendLengthEncoded appends a length-encoded integer, as used by the MySQL protocol.
+func appendLengthEncoded(b []byte, v uint64) []byte {
+	switch {
+	case v < 251:
+		return append(b, byte(v))
+	case v < 1<<16:
+		return appendUint16(append(b, 0xfc), uint16(v))
+	case v < 1<<24:
+		return appendUint24(append(b, 0xfd), uint32(v))
+	default:
+		return appendUint64(append(b, 0xfe), v)
+	}
+}
+
+// appendBitmap appends a bitmap of the bits given, the first one being the least significant bit of the first byte.
+func appendBitmap(b []byte, bits []bool) []byte {
+	bitmap := make([]byte, (len(bits)+7)/8)
+	for i, set := range bits {
+		if set {
+			bitmap[i/8] |= 1 << uint(i%8)
+		}
+	}
+	return append(b, bitmap...)
+}
+
+// formatDescriptionEvent returns the body of the event starting every file, describing the format of its events.
+func formatDescriptionEvent(timestamp uint32) event {
+	e := newEvent()
+	e = appendUint16(e, binlogVersion)
+	var version [50]byte
+	copy(version[:], ServerVersion)
+	e = append(e, version[:]...)
+	e = appendUint32(e, timestamp)
+	e = append(e, headerLength)
+	e = append(e, postHeaderLengths...)
+	// The format description event always has a checksum, whatever the algorithm of the other events
+	return append(e, checksumAlgOff)
+}
+
+// withChecksum appends the CRC-32 checksum of a finished event to it, and returns it.
+func withChecksum(e []byte) []byte {
+	binary.LittleEndian.PutUint32(e[9:], uint32(len(e)+4))
+	return appendUint32(e, crc32.ChecksumIEEE(e))
+}
+
+// rotateEvent returns the body of an event naming the file following the one it ends.
+func rotateEvent(nextFile string, pos uint64) event {
+	return append(appendUint64(newEvent(), pos), nextFile...)
+}
+
+// queryEvent returns the body of an event holding a statement executed by a session with the current database given.
+func queryEvent(threadID uint32, database, query string) event {
+	var vars []byte
+	vars = appendUint32(append(vars, queryFlags2Code), 0)
+	vars = appendUint64(append(vars, querySQLModeCode), 0)
+	vars = append(append(vars, queryCatalogNZ, byte(len(defaultCatalog))), defaultCatalog...)
+	vars = append(vars, queryCharsetCode)
+	vars = appendUint16(vars, collationUTF8MB4)
+	vars = appendUint16(vars, collationUTF8MB4)
+	vars = appendUint16(vars, collationUTF8MB4)
+
+	e := newEvent()
+	e = appendUint32(e, threadID)
+	e = appendUint32(e, 0) // execution time
+	e = append(e, byte(len(database)))
+	e = appendUint16(e, 0) // error code
+	e = appendUint16(e, uint16(len(vars)))
+	e = append(e, vars...)
+	e = append(append(e, database...), 0)
+	return append(e, query...)
+}
+
+// xidEvent returns the body of the event committing a transaction.
+func xidEvent(xid uint64) event {
+	return appendUint64(newEvent(), xid)
+}
+
+// tableMapEvent returns the body of the event mapping the id given to a table, which the rows events following it
+// refer to it with.
+func tableMapEvent(tableID uint64, database, table string, columns []column) event {
+	e := newEvent()
+	e = appendUint48(e, tableID)
+	e = appendUint16(e, tableMapFlags)
+	e = append(append(append(e, byte(len(database))), database...), 0)
+	e = append(append(append(e, byte(len(table))), table...), 0)
+	e = appendLengthEncoded(e, uint64(len(columns)))
+
+	var metadata []byte
+	nullable := make([]bool, len(columns))
+	var signedness []bool
+	for i, c := range columns {
+		e = append(e, c.typ)
+		metadata = append(metadata, c.metadata...)
+		nullable[i] = c.nullable
+		if c.numeric {
+			signedness = append(signedness, c.unsigned)
+		}
+	}
+	e = appendLengthEncoded(e, uint64(len(metadata)))
+	e = append(e, metadata...)
+	e = appendBitmap(e, nullable)
+
+	// The optional metadata holds the signedness of the numeric columns, most significant bit first
+	if len(signedness) > 0 {
+		bitmap := make([]byte, (len(signedness)+7)/8)
+		for i, unsigned := range signedness {
+			if unsigned {
+				bitmap[i/8] |= 0x80 >> uint(i%8)
+			}
+		}
+		e = append(e, signednessMetadata)
+		e = appendLengthEncoded(e, uint64(len(bitmap)))
+		e = append(e, bitmap...)
+	}
+	return e
+}
+
+// rowsEventHeader returns the start of the body of a rows event of the table with the id given, with every column of
+// its rows present, which its rows are appended to.
+func rowsEventHeader(typ byte, tableID uint64, columnCount int) event {
+	e := newEvent()
+	e = appendUint48(e, tableID)
+	e = appendUint16(e, 0) // flags, set by setRowsFlags
+	e = appendUint16(e, 2) // length of the extra data, which holds only this length
+	e = appendLengthEncoded(e, uint64(columnCount))
+	present := make([]bool, columnCount)
+	for i := range present {
+		present[i] = true
+	}
+	e = appendBitmap(e, present)
+	if typ == eventUpdateRows {
+		e = appendBitmap(e, present)
+	}
+	return e
+}
+
+// setRowsFlags sets the flags of a rows event.
+func setRowsFlags(e event, flags uint16) {
+	binary.LittleEndian.PutUint16(e[headerLength+6:], flags)
+}
@@ -0,0 +1,395 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlog
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+// ErrUnsupportedJSONValue is returned when logging a JSON document holding a value which isn't a JSON value.
+var ErrUnsupportedJSONValue = errors.NewKind("unsupported JSON value %v of type %T")
+
+// ErrInvalidJSONData is returned when applying a rows event holding a JSON document which can't be decoded.
+var ErrInvalidJSONData = errors.NewKind("invalid binary JSON document: %s")
+
+// The types of the values of binary JSON documents.
+const (
+	jsonSmallObject = 0x00
+	jsonLargeObject = 0x01
+	jsonSmallArray  = 0x02
+	jsonLargeArray  = 0x03
+	jsonLiteral     = 0x04
+	jsonInt16       = 0x05
+	jsonUint16      = 0x06
+	jsonInt32       = 0x07
+	jsonUint32      = 0x08
+	jsonInt64       = 0x09
+	jsonUint64      = 0x0a
+	jsonDouble      = 0x0b
+	jsonString      = 0x0c
+)
+
+// The values of JSON literals.
+const (
+	jsonNull  = 0x00
+	jsonTrue  = 0x01
+	jsonFalse = 0x02
+)
+
+// encodeJSON returns the encoding of a JSON document in the binary format of MySQL: the type of its value followed by
+// the encoding of the value.
+func encodeJSON(doc interface{}) ([]byte, error) {
+	typ, value, err := encodeJSONValue(doc)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{typ}, value...), nil
+}
+
+// encodeJSONValue returns the type and encoding of a JSON value.
+func encodeJSONValue(v interface{}) (byte, []byte, error) {
+	switch v := v.(type) {
+	case nil:
+		return jsonLiteral, []byte{jsonNull}, nil
+	case bool:
+		if v {
+			return jsonLiteral, []byte{jsonTrue}, nil
+		}
+		return jsonLiteral, []byte{jsonFalse}, nil
+	case string:
+		return jsonString, append(appendVariableLength(nil, len(v)), v...), nil
+	case float64:
+		// Numbers are held as float64s, and written as integers when they're integral, like MySQL does
+		if v == math.Trunc(v) && v >= math.MinInt64 && v < math.MaxInt64 {
+			typ, value := encodeJSONInt(int64(v))
+			return typ, value, nil
+		}
+		return jsonDouble, appendUint64(nil, math.Float64bits(v)), nil
+	case float32:
+		return encodeJSONValue(float64(v))
+	case int:
+		typ, value := encodeJSONInt(int64(v))
+		return typ, value, nil
+	case int8:
+		typ, value := encodeJSONInt(int64(v))
+		return typ, value, nil
+	case int16:
+		typ, value := encodeJSONInt(int64(v))
+		return typ, value, nil
+	case int32:
+		typ, value := encodeJSONInt(int64(v))
+		return typ, value, nil
+	case int64:
+		typ, value := encodeJSONInt(v)
+		return typ, value, nil
+	case uint8:
+		typ, value := encodeJSONInt(int64(v))
+		return typ, value, nil
+	case uint16:
+		typ, value := encodeJSONInt(int64(v))
+		return typ, value, nil
+	case uint32:
+		typ, value := encodeJSONInt(int64(v))
+		return typ, value, nil
+	case uint64:
+		if v > math.MaxInt64 {
+			return jsonUint64, appendUint64(nil, v), nil
+		}
+		typ, value := encodeJSONInt(int64(v))
+		return typ, value, nil
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		// Keys are sorted by length first, as MySQL looks them up with a binary search in that order
+		sort.Slice(keys, func(i, j int) bool {
+			if len(keys[i]) != len(keys[j]) {
+				return len(keys[i]) < len(keys[j])
+			}
+			return keys[i] < keys[j]
+		})
+		values := make([]interface{}, len(keys))
+		for i, key := range keys {
+			values[i] = v[key]
+		}
+		return encodeJSONContainer(keys, values)
+	case []interface{}:
+		return encodeJSONContainer(nil, v)
+	default:
+		return 0, nil, ErrUnsupportedJSONValue.New(v, v)
+	}
+}
+
+// encodeJSONInt returns the type and encoding of a JSON integer, in the smallest type holding it.
+func encodeJSONInt(i int64) (byte, []byte) {
+	switch {
+	case i >= math.MinInt16 && i <= math.MaxInt16:
+		return jsonInt16, appendUint16(nil, uint16(i))
+	case i >= math.MinInt32 && i <= math.MaxInt32:
+		return jsonInt32, appendUint32(nil, uint32(i))
+	default:
+		return jsonInt64, appendUint64(nil, uint64(i))
+	}
+}
+
+// encodeJSONContainer returns the type and encoding of an object with the keys and values given, or of an array of
+// the values given if there are no keys. Containers are small ones, whose offsets and sizes are held in two bytes,
+// unless they're too large for it.
+func encodeJSONContainer(keys []string, values []interface{}) (byte, []byte, error) {
+	typ := byte(jsonSmallObject)
+	if keys == nil {
+		typ = jsonSmallArray
+	}
+	data, ok, err := encodeJSONContainerWithOffsets(keys, values, false)
+	if err != nil {
+		return 0, nil, err
+	}
+	if ok {
+		return typ, data, nil
+	}
+	data, _, err = encodeJSONContainerWithOffsets(keys, values, true)
+	return typ + 1, data, err
+}
+
+// encodeJSONContainerWithOffsets encodes a container with offsets of four bytes if large, and two bytes otherwise, in
+// which case it returns false if the container is too large for them. A container starts with the number of its
+// elements and its size, followed by an entry for each of its keys holding their offset and length, and an entry for
+// each of its values holding their type along with their offset, or the values themselves if they fit in it.
+func encodeJSONContainerWithOffsets(keys []string, values []interface{}, large bool) ([]byte, bool, error) {
+	offsetSize := 2
+	if large {
+		offsetSize = 4
+	}
+	putOffset := func(b []byte, v int) {
+		if large {
+			binary.LittleEndian.PutUint32(b, uint32(v))
+		} else {
+			binary.LittleEndian.PutUint16(b, uint16(v))
+		}
+	}
+
+	keyEntries := 2 * offsetSize
+	valueEntries := keyEntries + len(keys)*(offsetSize+2)
+	data := make([]byte, valueEntries+len(values)*(1+offsetSize))
+	putOffset(data, len(values))
+	for i, key := range keys {
+		if len(key) > math.MaxUint16 {
+			return nil, false, ErrUnsupportedJSONValue.New(key, key)
+		}
+		entry := data[keyEntries+i*(offsetSize+2):]
+		putOffset(entry, len(data))
+		binary.LittleEndian.PutUint16(entry[offsetSize:], uint16(len(key)))
+		data = append(data, key...)
+	}
+	for i, v := range values {
+		typ, value, err := encodeJSONValue(v)
+		if err != nil {
+			return nil, false, err
+		}
+		entry := data[valueEntries+i*(1+offsetSize):]
+		entry[0] = typ
+		if jsonInlined(typ, large) {
+			copy(entry[1:], value)
+		} else {
+			putOffset(entry[1:], len(data))
+			data = append(data, value...)
+		}
+	}
+
+	if !large && len(data) > math.MaxUint16 {
+		return nil, false, nil
+	}
+	putOffset(data[offsetSize:], len(data))
+	return data, true, nil
+}
+
+// jsonInlined returns whether values of the type given are held in the value entries of containers.
+func jsonInlined(typ byte, large bool) bool {
+	switch typ {
+	case jsonLiteral, jsonInt16, jsonUint16:
+		return true
+	case jsonInt32, jsonUint32:
+		return large
+	default:
+		return false
+	}
+}
+
+// appendVariableLength appends a length in the variable length format of binary JSON strings: seven bits per byte,
+// least significant first, with the most significant bit of every byte but the last one set.
+func appendVariableLength(b []byte, length int) []byte {
+	for length >= 0x80 {
+		b = append(b, byte(length)|0x80)
+		length >>= 7
+	}
+	return append(b, byte(length))
+}
+
+// decodeJSON decodes a JSON document in the binary format of MySQL, returning its value as json.Unmarshal would: nil,
+// a bool, a float64, a string, a map[string]interface{} or an []interface{}. An empty document is a JSON null.
+func decodeJSON(data []byte) (interface{}, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	return decodeJSONValue(data[0], data[1:])
+}
+
+// decodeJSONValue decodes a JSON value of the type given, whose encoding starts the data given.
+func decodeJSONValue(typ byte, data []byte) (interface{}, error) {
+	switch typ {
+	case jsonSmallObject, jsonLargeObject:
+		return decodeJSONContainer(data, true, typ == jsonLargeObject)
+	case jsonSmallArray, jsonLargeArray:
+		return decodeJSONContainer(data, false, typ == jsonLargeArray)
+	case jsonLiteral:
+		if len(data) < 1 {
+			return nil, ErrInvalidJSONData.New("truncated literal")
+		}
+		switch data[0] {
+		case jsonNull:
+			return nil, nil
+		case jsonTrue:
+			return true, nil
+		case jsonFalse:
+			return false, nil
+		default:
+			return nil, ErrInvalidJSONData.New(fmt.Sprintf("unknown literal %d", data[0]))
+		}
+	case jsonInt16, jsonUint16:
+		if len(data) < 2 {
+			return nil, ErrInvalidJSONData.New("truncated integer")
+		}
+		v := binary.LittleEndian.Uint16(data)
+		if typ == jsonInt16 {
+			return float64(int16(v)), nil
+		}
+		return float64(v), nil
+	case jsonInt32, jsonUint32:
+		if len(data) < 4 {
+			return nil, ErrInvalidJSONData.New("truncated integer")
+		}
+		v := binary.LittleEndian.Uint32(data)
+		if typ == jsonInt32 {
+			return float64(int32(v)), nil
+		}
+		return float64(v), nil
+	case jsonInt64, jsonUint64, jsonDouble:
+		if len(data) < 8 {
+			return nil, ErrInvalidJSONData.New("truncated number")
+		}
+		v := binary.LittleEndian.Uint64(data)
+		switch typ {
+		case jsonInt64:
+			return float64(int64(v)), nil
+		case jsonUint64:
+			return float64(v), nil
+		default:
+			return math.Float64frombits(v), nil
+		}
+	case jsonString:
+		length, n, err := readVariableLength(data)
+		if err != nil {
+			return nil, err
+		}
+		if len(data) < n+length {
+			return nil, ErrInvalidJSONData.New("truncated string")
+		}
+		return string(data[n : n+length]), nil
+	default:
+		// Opaque values, such as dates, can't be told apart from strings once read, and are unsupported
+		return nil, ErrInvalidJSONData.New(fmt.Sprintf("unsupported value type %d", typ))
+	}
+}
+
+// decodeJSONContainer decodes an object or an array, whose offsets are held in four bytes if large, and two bytes
+// otherwise.
+func decodeJSONContainer(data []byte, object, large bool) (interface{}, error) {
+	offsetSize := 2
+	if large {
+		offsetSize = 4
+	}
+	readOffset := func(b []byte) int {
+		if large {
+			return int(binary.LittleEndian.Uint32(b))
+		}
+		return int(binary.LittleEndian.Uint16(b))
+	}
+
+	if len(data) < 2*offsetSize {
+		return nil, ErrInvalidJSONData.New("truncated container")
+	}
+	count := readOffset(data)
+	size := readOffset(data[offsetSize:])
+	keyEntries := 2 * offsetSize
+	valueEntries := keyEntries
+	if object {
+		valueEntries += count * (offsetSize + 2)
+	}
+	if size > len(data) || valueEntries+count*(1+offsetSize) > size {
+		return nil, ErrInvalidJSONData.New("truncated container")
+	}
+	data = data[:size]
+
+	values := make([]interface{}, count)
+	for i := range values {
+		entry := data[valueEntries+i*(1+offsetSize):]
+		typ := entry[0]
+		var err error
+		if jsonInlined(typ, large) {
+			values[i], err = decodeJSONValue(typ, entry[1:1+offsetSize])
+		} else if offset := readOffset(entry[1:]); offset < len(data) {
+			values[i], err = decodeJSONValue(typ, data[offset:])
+		} else {
+			err = ErrInvalidJSONData.New("value out of its container")
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !object {
+		return values, nil
+	}
+
+	doc := make(map[string]interface{}, count)
+	for i, v := range values {
+		entry := data[keyEntries+i*(offsetSize+2):]
+		offset := readOffset(entry)
+		length := int(binary.LittleEndian.Uint16(entry[offsetSize:]))
+		if offset+length > len(data) {
+			return nil, ErrInvalidJSONData.New("key out of its container")
+		}
+		doc[string(data[offset:offset+length])] = v
+	}
+	return doc, nil
+}
+
+// readVariableLength reads a length in the variable length format of binary JSON strings, returning it along with the
+// number of bytes it's held in.
+func readVariableLength(data []byte) (int, int, error) {
+	length := 0
+	for i := 0; i < len(data) && i < 5; i++ {
+		length |= int(data[i]&0x7f) << (7 * uint(i))
+		if data[i]&0x80 == 0 {
+			return length, i + 1, nil
+		}
+	}
+	return 0, 0, ErrInvalidJSONData.New("invalid string length")
+}
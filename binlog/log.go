@@ -0,0 +1,413 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package binlog implements a binary log of the changes committed through an engine, in the row-based format of
+// MySQL, which can be read with SHOW BINLOG EVENTS and streamed to replicas and change data capture tools with
+// COM_BINLOG_DUMP.
+package binlog
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// DefaultBaseName is the name of the files of a log, which is followed by their sequence number.
+const DefaultBaseName = "binlog"
+
+// maxRowsEventSize is the size above which the rows changed by a statement are split across several rows events.
+const maxRowsEventSize = 8192
+
+// Log is a binary log made of files named after a base name and a sequence number, such as binlog.000001, which are
+// held in memory or written to a directory. Transactions are logged as a BEGIN query event, followed by a table map
+// event and a rows event for each run of changes of the same kind to a table, and an XID event. Rows events hold the
+// full image of the rows. Statements changing the schema are logged as query events. Events have no checksum, as
+// @@binlog_checksum is NONE.
+//
+// A new file is started once the current one grows above @@max_binlog_size. Files are kept until they're purged with
+// PURGE BINARY LOGS, or expire: once a new file is started, the files last written to more than
+// @@binlog_expire_logs_seconds ago are removed, and so are the oldest files while the size of the log is above
+// @@binlog_space_limit. Either is disabled when it's 0. Files being read by replicas are never removed.
+type Log struct {
+	baseName string
+	// dir is the directory the files are written to, or empty if they're held in memory.
+	dir string
+
+	mu    sync.Mutex
+	files []*logFile
+	// nextSeq is the sequence number of the next file started.
+	nextSeq int
+	// appended is closed and replaced once events are appended to the log.
+	appended    chan struct{}
+	tableIDs    map[string]uint64
+	nextTableID uint64
+	nextXID     uint64
+}
+
+var _ sql.Binlog = (*Log)(nil)
+
+// NewLog returns an empty binary log held in memory, whose files are named after the base name given, or
+// DefaultBaseName if it's empty.
+func NewLog(baseName string) *Log {
+	l := newLog(baseName, "")
+	// Files held in memory are written without errors
+	_ = l.startFile()
+	return l
+}
+
+// NewFileLog returns a binary log whose files are written to the directory given, which is created if it doesn't
+// exist, and named after the base name given, or DefaultBaseName if it's empty. The files of the log already in the
+// directory are kept, and a new file is started after them, as MySQL does when it starts. A transaction which wasn't
+// entirely written to the last of them is removed from it. Close the log once it's no longer written to.
+func NewFileLog(dir, baseName string) (*Log, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	l := newLog(baseName, dir)
+	if err := l.openFiles(); err != nil {
+		l.Close()
+		return nil, err
+	}
+	if err := l.startFile(); err != nil {
+		l.Close()
+		return nil, err
+	}
+	l.expire()
+	return l, nil
+}
+
+func newLog(baseName, dir string) *Log {
+	if baseName == "" {
+		baseName = DefaultBaseName
+	}
+	return &Log{
+		baseName:    baseName,
+		dir:         dir,
+		nextSeq:     1,
+		appended:    make(chan struct{}),
+		tableIDs:    make(map[string]uint64),
+		nextTableID: 1,
+		nextXID:     1,
+	}
+}
+
+// Close closes the files of the log written to a directory. The log can't be written to once it's closed.
+func (l *Log) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var firstErr error
+	for _, f := range l.files {
+		if err := f.close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func (l *Log) current() *logFile {
+	return l.files[len(l.files)-1]
+}
+
+// startFile starts the file with the next sequence number.
+func (l *Log) startFile() error {
+	f, err := l.createFile(fmt.Sprintf("%s.%06d", l.baseName, l.nextSeq))
+	if err != nil {
+		return err
+	}
+	l.nextSeq++
+	l.files = append(l.files, f)
+	return l.write(formatDescriptionEvent(now()), eventFormatDescription, 0, true,
+		fmt.Sprintf("Server ver: %s, Binlog ver: %d", ServerVersion, binlogVersion))
+}
+
+// write finishes an event and appends it to the current file.
+func (l *Log) write(e event, typ byte, flags uint16, checksum bool, info string) error {
+	f := l.current()
+	pos := f.size
+	end := pos + uint64(len(e))
+	if checksum {
+		end += 4
+	}
+	serverID := serverID()
+	b := e.finish(typ, now(), serverID, uint32(end), flags)
+	if checksum {
+		b = withChecksum(b)
+	}
+	if err := f.append(b); err != nil {
+		return err
+	}
+	f.events = append(f.events, sql.BinlogEvent{
+		LogName:   f.name,
+		Pos:       pos,
+		EventType: eventTypeNames[typ],
+		ServerID:  serverID,
+		EndLogPos: end,
+		Info:      info,
+	})
+	return nil
+}
+
+// LogTransaction implements the interface sql.Binlog.
+func (l *Log) LogTransaction(ctx *sql.Context, changes []sql.RowChange) error {
+	// Resets are logged as the statements which made them
+	var rows []sql.RowChange
+	for _, change := range changes {
+		if !change.Reset {
+			rows = append(rows, change)
+		}
+	}
+	changes = rows
+	if len(changes) == 0 {
+		return nil
+	}
+
+	// Events are built before any is written, so that a transaction is logged entirely or not at all
+	type rowsEvent struct {
+		tableMap     event
+		tableMapInfo string
+		rows         []event
+		typ          byte
+		tableID      uint64
+	}
+	var events []rowsEvent
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for i := 0; i < len(changes); {
+		change := changes[i]
+		typ := rowsEventType(change)
+		columns, err := newColumns(change.Schema)
+		if err != nil {
+			return err
+		}
+		tableID := l.tableID(change.Database, change.Table)
+		ev := rowsEvent{
+			tableMap:     tableMapEvent(tableID, change.Database, change.Table, columns),
+			tableMapInfo: fmt.Sprintf("table_id: %d (%s.%s)", tableID, change.Database, change.Table),
+			typ:          typ,
+			tableID:      tableID,
+		}
+
+		// Consecutive changes of the same kind to a table are held in the same rows events
+		current := rowsEventHeader(typ, tableID, len(columns))
+		header := len(current)
+		for ; i < len(changes) && sameRowsEvent(change, changes[i]); i++ {
+			if len(current) > maxRowsEventSize {
+				ev.rows = append(ev.rows, current)
+				current = rowsEventHeader(typ, tableID, len(columns))
+			}
+			if changes[i].Old != nil {
+				if current, err = appendRow(current, columns, changes[i].Old); err != nil {
+					return err
+				}
+			}
+			if changes[i].New != nil {
+				if current, err = appendRow(current, columns, changes[i].New); err != nil {
+					return err
+				}
+			}
+		}
+		if len(current) > header {
+			ev.rows = append(ev.rows, current)
+		}
+		setRowsFlags(ev.rows[len(ev.rows)-1], rowsFlagStatementEnd)
+		events = append(events, ev)
+	}
+
+	// A transaction which couldn't be written entirely is removed from the file
+	f := l.current()
+	start := f.size
+	err := l.write(queryEvent(ctx.ID(), "", "BEGIN"), eventQuery, 0, false, "BEGIN")
+	for _, ev := range events {
+		if err == nil {
+			err = l.write(ev.tableMap, eventTableMap, 0, false, ev.tableMapInfo)
+		}
+		for i, rows := range ev.rows {
+			info := fmt.Sprintf("table_id: %d", ev.tableID)
+			if i == len(ev.rows)-1 {
+				info += " flags: STMT_END_F"
+			}
+			if err == nil {
+				err = l.write(rows, ev.typ, 0, false, info)
+			}
+		}
+	}
+	xid := l.nextXID
+	if err == nil {
+		err = l.write(xidEvent(xid), eventXID, 0, false, fmt.Sprintf("COMMIT /* xid=%d */", xid))
+	}
+	if err != nil {
+		if terr := f.truncate(start); terr != nil {
+			return terr
+		}
+		return err
+	}
+	l.nextXID++
+	return l.appendedEvents()
+}
+
+// rowsEventType returns the type of the rows event holding a change.
+func rowsEventType(change sql.RowChange) byte {
+	switch {
+	case change.Old == nil:
+		return eventWriteRows
+	case change.New == nil:
+		return eventDeleteRows
+	default:
+		return eventUpdateRows
+	}
+}
+
+func sameRowsEvent(a, b sql.RowChange) bool {
+	return a.Database == b.Database && a.Table == b.Table && rowsEventType(a) == rowsEventType(b) &&
+		len(a.Schema) == len(b.Schema)
+}
+
+// tableID returns the id of the table with the name given in the database given, which table map events map it to.
+func (l *Log) tableID(database, table string) uint64 {
+	key := strings.ToLower(database) + "." + strings.ToLower(table)
+	id, ok := l.tableIDs[key]
+	if !ok {
+		id = l.nextTableID
+		l.nextTableID++
+		l.tableIDs[key] = id
+	}
+	return id
+}
+
+// LogQuery implements the interface sql.Binlog.
+func (l *Log) LogQuery(ctx *sql.Context, database, query string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	info := query
+	if database != "" {
+		info = fmt.Sprintf("use `%s`; %s", database, query)
+	}
+	if err := l.write(queryEvent(ctx.ID(), database, query), eventQuery, 0, false, info); err != nil {
+		return err
+	}
+	return l.appendedEvents()
+}
+
+// appendedEvents starts a new file if the current one grew above the maximum size, removing the files which expired,
+// and wakes up the readers waiting for new events.
+func (l *Log) appendedEvents() error {
+	defer func() {
+		close(l.appended)
+		l.appended = make(chan struct{})
+	}()
+	if l.current().size < maxFileSize() {
+		return nil
+	}
+	if err := l.rotate(); err != nil {
+		return err
+	}
+	l.expire()
+	return nil
+}
+
+// rotate ends the current file with a rotate event naming the next one, and starts it.
+func (l *Log) rotate() error {
+	next := fmt.Sprintf("%s.%06d", l.baseName, l.nextSeq)
+	if err := l.write(rotateEvent(next, uint64(len(Magic))), eventRotate, 0, false, next+";pos=4"); err != nil {
+		return err
+	}
+	return l.startFile()
+}
+
+// Files implements the interface sql.Binlog.
+func (l *Log) Files() []sql.BinlogFile {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	files := make([]sql.BinlogFile, len(l.files))
+	for i, f := range l.files {
+		files[i] = sql.BinlogFile{Name: f.name, Size: f.size}
+	}
+	return files
+}
+
+// Events implements the interface sql.Binlog.
+func (l *Log) Events(file string, pos uint64) ([]sql.BinlogEvent, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	f, ok := l.file(file)
+	if !ok {
+		return nil, sql.ErrUnknownBinlogFile.New()
+	}
+	var events []sql.BinlogEvent
+	for _, ev := range f.events {
+		if ev.Pos >= pos {
+			events = append(events, ev)
+		}
+	}
+	return events, nil
+}
+
+// File returns the content of the file with the name given, which is the same as the one of a file written by MySQL
+// holding the same events.
+func (l *Log) File(name string) ([]byte, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	f, ok := l.file(name)
+	if !ok {
+		return nil, false
+	}
+	data, err := f.read(0, f.size)
+	if err != nil {
+		return nil, false
+	}
+	return append([]byte(nil), data...), true
+}
+
+func (l *Log) file(name string) (*logFile, bool) {
+	for _, f := range l.files {
+		if f.name == name {
+			return f, true
+		}
+	}
+	return nil, false
+}
+
+func now() uint32 {
+	return uint32(time.Now().Unix())
+}
+
+// serverID returns the value of @@server_id, which events are written with.
+func serverID() uint32 {
+	_, val, ok := sql.SystemVariables.GetGlobal("server_id")
+	if !ok {
+		return 0
+	}
+	id, err := sql.Uint32.Convert(val)
+	if err != nil {
+		return 0
+	}
+	return id.(uint32)
+}
+
+// maxFileSize returns the value of @@max_binlog_size, the size above which a new file is started.
+func maxFileSize() uint64 {
+	_, val, ok := sql.SystemVariables.GetGlobal("max_binlog_size")
+	if !ok {
+		return 1 << 30
+	}
+	size, err := sql.Uint64.Convert(val)
+	if err != nil {
+		return 1 << 30
+	}
+	return size.(uint64)
+}
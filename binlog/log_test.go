@@ -0,0 +1,359 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlog_test
+
+import (
+	"context"
+	"encoding/binary"
+	"strconv"
+	"testing"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/stretchr/testify/require"
+
+	sqle "github.com/linanh/go-mysql-server"
+	"github.com/linanh/go-mysql-server/binlog"
+	"github.com/linanh/go-mysql-server/disk"
+	"github.com/linanh/go-mysql-server/memory"
+	"github.com/linanh/go-mysql-server/sql"
+	"github.com/linanh/go-mysql-server/sql/analyzer"
+)
+
+// testEngine is an engine logging to a binary log.
+type testEngine struct {
+	t   *testing.T
+	e   *sqle.Engine
+	log *binlog.Log
+}
+
+func newTestEngine(t *testing.T, db sql.Database) *testEngine {
+	return newTestEngineWithLog(t, db, binlog.NewLog(""))
+}
+
+func newTestEngineWithLog(t *testing.T, db sql.Database, log *binlog.Log) *testEngine {
+	c := sql.NewCatalog()
+	e := sqle.New(c, analyzer.NewDefault(c), &sqle.Config{Binlog: log})
+	e.AddDatabase(db)
+	return &testEngine{t: t, e: e, log: log}
+}
+
+func (e *testEngine) newContext() *sql.Context {
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession()))
+	ctx.SetCurrentDatabase("mydb")
+	return ctx
+}
+
+func (e *testEngine) query(ctx *sql.Context, query string) ([]sql.Row, error) {
+	_, iter, err := e.e.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowIterToRows(ctx, iter)
+}
+
+func (e *testEngine) exec(ctx *sql.Context, queries ...string) {
+	for _, query := range queries {
+		_, err := e.query(ctx, query)
+		require.NoError(e.t, err, query)
+	}
+}
+
+// decodedEvent is an event of a file decoded by vitess, with the values of the rows of rows events.
+type decodedEvent struct {
+	typ   string
+	query mysql.Query
+	table *mysql.TableMap
+	rows  [][]string
+}
+
+// decodeFile decodes the events of a file of the log with vitess, reading the values of the rows of rows events as
+// the types of the schema given.
+func (e *testEngine) decodeFile(name string, schema sql.Schema) []decodedEvent {
+	require := require.New(e.t)
+	data, ok := e.log.File(name)
+	require.True(ok)
+	require.Equal(binlog.Magic, data[:4])
+	data = data[4:]
+
+	events, err := e.log.Events(name, 0)
+	require.NoError(err)
+	var decoded []decodedEvent
+	var f mysql.BinlogFormat
+	var tm *mysql.TableMap
+	for i := 0; len(data) > 0; i++ {
+		size := binary.LittleEndian.Uint32(data[9:])
+		ev := mysql.NewMysql56BinlogEvent(data[:size])
+		data = data[size:]
+		require.True(ev.IsValid())
+
+		d := decodedEvent{typ: events[i].EventType}
+		switch {
+		case ev.IsFormatDescription():
+			f, err = ev.Format()
+			require.NoError(err)
+			require.Equal(binlog.ServerVersion, f.ServerVersion)
+		case ev.IsQuery():
+			d.query, err = ev.Query(f)
+			require.NoError(err)
+		case ev.IsTableMap():
+			tm, err = ev.TableMap(f)
+			require.NoError(err)
+			d.table = tm
+		case ev.IsWriteRows(), ev.IsUpdateRows(), ev.IsDeleteRows():
+			rows, err := ev.Rows(f, tm)
+			require.NoError(err)
+			for _, row := range rows.Rows {
+				if ev.IsUpdateRows() || ev.IsDeleteRows() {
+					d.rows = append(d.rows, decodeRow(e.t, tm, schema, row.NullIdentifyColumns, row.Identify))
+				}
+				if ev.IsUpdateRows() || ev.IsWriteRows() {
+					d.rows = append(d.rows, decodeRow(e.t, tm, schema, row.NullColumns, row.Data))
+				}
+			}
+		}
+		decoded = append(decoded, d)
+	}
+	require.Len(decoded, len(events))
+	return decoded
+}
+
+func decodeRow(t *testing.T, tm *mysql.TableMap, schema sql.Schema, nulls mysql.Bitmap, data []byte) []string {
+	var values []string
+	pos := 0
+	for i, col := range schema {
+		if nulls.Bit(i) {
+			values = append(values, "NULL")
+			continue
+		}
+		v, n, err := mysql.CellValue(data, pos, tm.Types[i], tm.Metadata[i], col.Type.Type())
+		require.NoError(t, err)
+		values = append(values, string(v.Raw()))
+		pos += n
+	}
+	return values
+}
+
+func eventTypes(events []decodedEvent) []string {
+	types := make([]string, len(events))
+	for i, ev := range events {
+		types[i] = ev.typ
+	}
+	return types
+}
+
+func TestLogTransaction(t *testing.T) {
+	require := require.New(t)
+	e := newTestEngine(t, memory.NewDatabase("mydb"))
+	ctx := e.newContext()
+
+	e.exec(ctx,
+		"CREATE TABLE t (id int primary key, name varchar(20), price decimal(10,2), added datetime, "+
+			"kind enum('Small','Large'), tags json, data blob, u bigint unsigned, f double, d date, s set('a','b'), "+
+			"c char(3), ts timestamp(3), tm time, y year, i8 tinyint, m mediumint)",
+		"INSERT INTO t VALUES (1, 'abc', 1.50, '2021-01-02 03:04:05', 'Large', '{\"a\": [1, 2.5, \"x\", null, true]}', "+
+			"'xyz', 18446744073709551615, 2.25, '2021-03-04', 'a,b', 'ab', '2021-01-02 03:04:05.123', '-12:34:56', "+
+			"2021, -5, -70000), (2, null, null, null, null, null, null, null, null, null, null, null, null, null, null, null, null)",
+		"UPDATE t SET name = 'def' WHERE id = 1",
+		"DELETE FROM t WHERE id = 2",
+	)
+
+	table, _, err := e.e.Catalog.Table(ctx, "mydb", "t")
+	require.NoError(err)
+	events := e.decodeFile("binlog.000001", table.Schema())
+	require.Equal([]string{
+		"Format_desc",
+		"Query",
+		"Query", "Table_map", "Write_rows", "Xid",
+		"Query", "Table_map", "Update_rows", "Xid",
+		"Query", "Table_map", "Delete_rows", "Xid",
+	}, eventTypes(events))
+
+	require.Equal("mydb", events[1].query.Database)
+	require.Contains(events[1].query.SQL, "CREATE TABLE t")
+	require.Equal("BEGIN", events[2].query.SQL)
+	require.Equal("mydb", events[3].table.Database)
+	require.Equal("t", events[3].table.Name)
+
+	row1 := []string{"1", "abc", "1.50", "2021-01-02 03:04:05.000000", "2", `JSON_OBJECT('a',JSON_ARRAY(1,2.5E+00,'x',null,true))`,
+		"xyz", "18446744073709551615", "2.25E+00", "2021-03-04", "3", "ab", "2021-01-02 03:04:05.123000",
+		"-12:34:56.000000", "2021", "-5", "-70000"}
+	row2 := []string{"2", "NULL", "NULL", "NULL", "NULL", "NULL", "NULL", "NULL", "NULL", "NULL", "NULL", "NULL",
+		"NULL", "NULL", "NULL", "NULL", "NULL"}
+	require.Equal([][]string{row1, row2}, events[4].rows)
+	updated := append([]string{"1", "def"}, row1[2:]...)
+	require.Equal([][]string{row1, updated}, events[8].rows)
+	require.Equal([][]string{row2}, events[12].rows)
+}
+
+func TestLogTransactions(t *testing.T) {
+	require := require.New(t)
+	db, err := disk.NewDatabase("mydb", t.TempDir())
+	require.NoError(err)
+	defer db.Close()
+	e := newTestEngine(t, db)
+	ctx := e.newContext()
+	schema := sql.Schema{{Name: "id", Type: sql.Int32}}
+
+	e.exec(ctx,
+		"CREATE TABLE t (id int primary key)",
+		// Changes rolled back aren't logged
+		"START TRANSACTION",
+		"INSERT INTO t VALUES (1)",
+		"ROLLBACK",
+		// Nor are the ones rolled back to a savepoint
+		"START TRANSACTION",
+		"INSERT INTO t VALUES (2)",
+		"SAVEPOINT s",
+		"INSERT INTO t VALUES (3)",
+		"DELETE FROM t WHERE id = 2",
+		"ROLLBACK TO SAVEPOINT s",
+		"INSERT INTO t VALUES (4), (5)",
+		"UPDATE t SET id = 6 WHERE id = 5",
+		"COMMIT",
+	)
+	// A failed statement logs nothing
+	_, err = e.query(ctx, "INSERT INTO t VALUES (7), (2)")
+	require.Error(err)
+	// Nor do statements while sql_log_bin is off
+	e.exec(ctx,
+		"SET sql_log_bin = 0",
+		"INSERT INTO t VALUES (8)",
+		"SET sql_log_bin = 1",
+		"INSERT INTO t VALUES (9)",
+	)
+
+	events := e.decodeFile("binlog.000001", schema)
+	require.Equal([]string{
+		"Format_desc",
+		"Query",
+		"Query", "Table_map", "Write_rows", "Table_map", "Update_rows", "Xid",
+		"Query", "Table_map", "Write_rows", "Xid",
+	}, eventTypes(events))
+	require.Equal([][]string{{"2"}, {"4"}, {"5"}}, events[4].rows)
+	require.Equal([][]string{{"5"}, {"6"}}, events[6].rows)
+	require.Equal([][]string{{"9"}}, events[10].rows)
+
+	rows, err := e.query(ctx, "SELECT id FROM t ORDER BY id")
+	require.NoError(err)
+	require.Equal([]sql.Row{{int32(2)}, {int32(4)}, {int32(6)}, {int32(8)}, {int32(9)}}, rows)
+}
+
+func TestRotate(t *testing.T) {
+	require := require.New(t)
+	e := newTestEngine(t, memory.NewDatabase("mydb"))
+	ctx := e.newContext()
+	defer func() {
+		require.NoError(sql.SystemVariables.SetGlobal("max_binlog_size", 1073741824))
+	}()
+
+	e.exec(ctx,
+		"SET GLOBAL max_binlog_size = 4096",
+		"CREATE TABLE t (id int primary key, name varchar(1000))",
+	)
+	for i := 0; i < 10; i++ {
+		e.exec(ctx, "INSERT INTO t VALUES ("+strconv.Itoa(i)+", repeat('x', 1000))")
+	}
+
+	files := e.log.Files()
+	require.Len(files, 3)
+	require.Equal("binlog.000001", files[0].Name)
+	require.Equal("binlog.000002", files[1].Name)
+	require.Equal("binlog.000003", files[2].Name)
+	for _, f := range files[:2] {
+		require.True(f.Size >= 4096)
+	}
+
+	events, err := e.log.Events("binlog.000001", 0)
+	require.NoError(err)
+	last := events[len(events)-1]
+	require.Equal("Rotate", last.EventType)
+	require.Equal("binlog.000002;pos=4", last.Info)
+	require.Equal(files[0].Size, last.EndLogPos)
+	schema := sql.Schema{{Name: "id", Type: sql.Int32}, {Name: "name", Type: sql.MustCreateStringWithDefaults(sqltypes.VarChar, 1000)}}
+	decoded := e.decodeFile("binlog.000002", schema)
+	require.Equal("Format_desc", decoded[0].typ)
+	require.Equal("Query", decoded[1].typ)
+	require.Equal("BEGIN", decoded[1].query.SQL)
+
+	// SHOW statements read the log
+	rows, err := e.query(ctx, "SHOW BINARY LOGS")
+	require.NoError(err)
+	require.Equal([]sql.Row{
+		{"binlog.000001", files[0].Size, "No"},
+		{"binlog.000002", files[1].Size, "No"},
+		{"binlog.000003", files[2].Size, "No"},
+	}, rows)
+	rows, err = e.query(ctx, "SHOW MASTER STATUS")
+	require.NoError(err)
+	require.Equal([]sql.Row{{"binlog.000003", files[2].Size, "", "", ""}}, rows)
+
+	rows, err = e.query(ctx, "SHOW BINLOG EVENTS LIMIT 2")
+	require.NoError(err)
+	require.Equal([]sql.Row{
+		{"binlog.000001", events[0].Pos, "Format_desc", uint32(1), events[0].EndLogPos, events[0].Info},
+		{"binlog.000001", events[1].Pos, "Query", uint32(1), events[1].EndLogPos, events[1].Info},
+	}, rows)
+	rows, err = e.query(ctx, "SHOW BINLOG EVENTS IN 'binlog.000001' FROM "+strconv.FormatUint(events[2].Pos, 10)+" LIMIT 1, 2")
+	require.NoError(err)
+	require.Equal([]sql.Row{
+		{"binlog.000001", events[3].Pos, events[3].EventType, uint32(1), events[3].EndLogPos, events[3].Info},
+		{"binlog.000001", events[4].Pos, events[4].EventType, uint32(1), events[4].EndLogPos, events[4].Info},
+	}, rows)
+	_, err = e.query(ctx, "SHOW BINLOG EVENTS IN 'binlog.000009'")
+	require.True(sql.ErrUnknownBinlogFile.Is(err))
+}
+
+func TestShowWithoutBinlog(t *testing.T) {
+	require := require.New(t)
+	e := sqle.NewDefault()
+	ctx := sql.NewEmptyContext()
+
+	for _, query := range []string{"SHOW BINARY LOGS", "SHOW MASTER LOGS", "SHOW BINLOG EVENTS"} {
+		_, iter, err := e.Query(ctx, query)
+		if err == nil {
+			_, err = sql.RowIterToRows(ctx, iter)
+		}
+		require.True(sql.ErrNoBinaryLogging.Is(err), query)
+	}
+
+	_, iter, err := e.Query(ctx, "SHOW MASTER STATUS")
+	require.NoError(err)
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Empty(rows)
+}
+
+func TestLogBinVariable(t *testing.T) {
+	require := require.New(t)
+
+	// @@log_bin reflects the engine running the query, even with others in the same process
+	e := newTestEngine(t, memory.NewDatabase("mydb"))
+	ctx := e.newContext()
+	rows, err := e.query(ctx, "SELECT @@log_bin")
+	require.NoError(err)
+	require.Equal([]sql.Row{{int8(1)}}, rows)
+	rows, err = e.query(ctx, "SHOW VARIABLES LIKE 'log_bin'")
+	require.NoError(err)
+	require.Equal([]sql.Row{{"log_bin", int8(1)}}, rows)
+
+	other := sqle.NewDefault()
+	ctx = sql.NewEmptyContext()
+	_, iter, err := other.Query(ctx, "SELECT @@log_bin")
+	require.NoError(err)
+	rows, err = sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Equal([]sql.Row{{int8(0)}}, rows)
+}
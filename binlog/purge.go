@@ -0,0 +1,102 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlog
+
+import (
+	"time"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// PurgeTo implements the interface sql.Binlog.
+func (l *Log) PurgeTo(file string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for i, f := range l.files {
+		if f.name == file {
+			return l.purge(i)
+		}
+	}
+	return sql.ErrBinlogTargetNotFound.New()
+}
+
+// PurgeBefore implements the interface sql.Binlog.
+func (l *Log) PurgeBefore(t time.Time) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.purge(l.modifiedBefore(t))
+}
+
+// modifiedBefore returns the number of files, from the oldest one, which were last written to before the time given.
+func (l *Log) modifiedBefore(t time.Time) int {
+	n := 0
+	for n < len(l.files) && l.files[n].modified.Before(t) {
+		n++
+	}
+	return n
+}
+
+// purge removes the first n files of the log, stopping at the first one being read by a replica. The file currently
+// written to is never removed.
+func (l *Log) purge(n int) error {
+	if n > len(l.files)-1 {
+		n = len(l.files) - 1
+	}
+	for i := 0; i < n; i++ {
+		if l.files[0].readers > 0 {
+			return nil
+		}
+		if err := l.files[0].remove(); err != nil {
+			return err
+		}
+		l.files = l.files[1:]
+	}
+	return nil
+}
+
+// expire removes the files last written to more than @@binlog_expire_logs_seconds ago, and the oldest files while the
+// size of the log is above @@binlog_space_limit. A file which can't be removed is tried again the next time files
+// expire, so that logging isn't stopped by it.
+func (l *Log) expire() {
+	if seconds := globalUint("binlog_expire_logs_seconds"); seconds > 0 {
+		if err := l.purge(l.modifiedBefore(time.Now().Add(-time.Duration(seconds) * time.Second))); err != nil {
+			return
+		}
+	}
+	if limit := globalUint("binlog_space_limit"); limit > 0 {
+		var size uint64
+		for _, f := range l.files {
+			size += f.size
+		}
+		n := 0
+		for ; n < len(l.files) && size > limit; n++ {
+			size -= l.files[n].size
+		}
+		_ = l.purge(n)
+	}
+}
+
+// globalUint returns the global value of the unsigned system variable with the name given, or 0 if it has none.
+func globalUint(name string) uint64 {
+	_, val, ok := sql.SystemVariables.GetGlobal(name)
+	if !ok {
+		return 0
+	}
+	v, err := sql.Uint64.Convert(val)
+	if err != nil {
+		return 0
+	}
+	return v.(uint64)
+}
@@ -0,0 +1,124 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlog_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/linanh/go-mysql-server/memory"
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// rotateFiles inserts rows until the log of the engine writes to the file with the name given.
+func rotateFiles(e *testEngine, ctx *sql.Context, name string) {
+	e.exec(ctx,
+		"SET GLOBAL max_binlog_size = 4096",
+		"CREATE TABLE IF NOT EXISTS t (id int primary key auto_increment, name varchar(1000))",
+	)
+	for {
+		files := e.log.Files()
+		if files[len(files)-1].Name == name {
+			return
+		}
+		e.exec(ctx, "INSERT INTO t (name) VALUES (repeat('x', 1000))")
+	}
+}
+
+func fileNames(files []sql.BinlogFile) []string {
+	var names []string
+	for _, f := range files {
+		names = append(names, f.Name)
+	}
+	return names
+}
+
+func TestPurge(t *testing.T) {
+	require := require.New(t)
+	e := newTestEngine(t, memory.NewDatabase("mydb"))
+	ctx := e.newContext()
+	defer func() {
+		require.NoError(sql.SystemVariables.SetGlobal("max_binlog_size", 1073741824))
+	}()
+	rotateFiles(e, ctx, "binlog.000004")
+
+	e.exec(ctx, "PURGE BINARY LOGS TO 'binlog.000002'")
+	require.Equal([]string{"binlog.000002", "binlog.000003", "binlog.000004"}, fileNames(e.log.Files()))
+	_, ok := e.log.File("binlog.000001")
+	require.False(ok)
+	_, err := e.query(ctx, "PURGE BINARY LOGS TO 'binlog.000009'")
+	require.True(sql.ErrBinlogTargetNotFound.Is(err))
+
+	// Files being read by a replica are kept, along with the ones following them
+	dumpCtx, cancel := context.WithCancel(context.Background())
+	reading, release := make(chan struct{}), make(chan struct{})
+	done := make(chan error)
+	go func() {
+		first := true
+		done <- e.log.Dump(dumpCtx, "binlog.000003", 4, false, func(event []byte) error {
+			if first {
+				first = false
+				close(reading)
+				<-release
+			}
+			return nil
+		})
+	}()
+	<-reading
+	require.NoError(e.log.PurgeBefore(time.Now().Add(time.Hour)))
+	require.Equal([]string{"binlog.000003", "binlog.000004"}, fileNames(e.log.Files()))
+	close(release)
+	cancel()
+	require.Equal(context.Canceled, <-done)
+
+	// The file currently written to is always kept
+	e.exec(ctx, "PURGE MASTER LOGS BEFORE NOW() + INTERVAL 1 HOUR")
+	require.Equal([]string{"binlog.000004"}, fileNames(e.log.Files()))
+	rows, err := e.query(ctx, "SHOW BINLOG EVENTS LIMIT 1")
+	require.NoError(err)
+	require.Equal("binlog.000004", rows[0][0])
+}
+
+func TestExpire(t *testing.T) {
+	require := require.New(t)
+	e := newTestEngine(t, memory.NewDatabase("mydb"))
+	ctx := e.newContext()
+	defer func() {
+		require.NoError(sql.SystemVariables.SetGlobal("max_binlog_size", 1073741824))
+		require.NoError(sql.SystemVariables.SetGlobal("binlog_space_limit", 0))
+		require.NoError(sql.SystemVariables.SetGlobal("binlog_expire_logs_seconds", 2592000))
+	}()
+
+	// The oldest files are removed once a new file is started while the log is above the space limit
+	e.exec(ctx, "SET GLOBAL binlog_space_limit = 10000")
+	rotateFiles(e, ctx, "binlog.000004")
+	files := e.log.Files()
+	require.NotEqual("binlog.000001", files[0].Name)
+	var size uint64
+	for _, f := range files {
+		size += f.Size
+	}
+	require.True(size <= 10000, strconv.FormatUint(size, 10))
+
+	// Files expire once they weren't written to for long enough
+	e.exec(ctx, "SET GLOBAL binlog_space_limit = 0", "SET GLOBAL binlog_expire_logs_seconds = 1")
+	time.Sleep(1100 * time.Millisecond)
+	rotateFiles(e, ctx, "binlog.000006")
+	require.Equal([]string{"binlog.000004", "binlog.000005", "binlog.000006"}, fileNames(e.log.Files()))
+}
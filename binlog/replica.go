@@ -0,0 +1,252 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlog
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dolthub/vitess/go/mysql"
+
+	sqle "github.com/linanh/go-mysql-server"
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// defaultConnectRetry is the time between attempts to connect to the source of a replica when its ConnectRetry isn't
+// set, as in MySQL.
+const defaultConnectRetry = 60 * time.Second
+
+// Replica is a sql.Replica applying the events of the binary log of a MySQL source to the databases of an engine, as
+// they're streamed by the source. It's set on the catalog of the engine, once created:
+//
+//	e.Catalog.Replica = binlog.NewReplica(e, 2)
+//
+// The changes it applies aren't logged to the binary log of the engine. When the connection to the source is lost, it
+// connects again after the ConnectRetry of the source, and resumes from the last transaction applied. It stops when an
+// event can't be applied.
+type Replica struct {
+	e        *sqle.Engine
+	serverID uint32
+
+	mu      sync.Mutex
+	source  sql.ReplicationSource
+	status  sql.ReplicaStatus
+	running bool
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+var _ sql.Replica = (*Replica)(nil)
+
+// NewReplica returns a replica applying events to the databases of the engine given, which identifies itself to its
+// source with the server id given. It must differ from the ones of the source and of its other replicas.
+func NewReplica(e *sqle.Engine, serverID uint32) *Replica {
+	return &Replica{e: e, serverID: serverID}
+}
+
+// Source implements sql.Replica.
+func (r *Replica) Source() sql.ReplicationSource {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.source
+}
+
+// SetSource implements sql.Replica.
+func (r *Replica) SetSource(ctx *sql.Context, source sql.ReplicationSource) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.running {
+		return sql.ErrReplicaRunning.New()
+	}
+	r.source = source
+	return nil
+}
+
+// Status implements sql.Replica.
+func (r *Replica) Status() sql.ReplicaStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	status := r.status
+	status.Source = r.source
+	return status
+}
+
+// Start implements sql.Replica.
+func (r *Replica) Start(ctx *sql.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.running {
+		return nil
+	}
+	if r.source.Host == "" {
+		return sql.ErrReplicaNotConfigured.New()
+	}
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	r.running, r.cancel, r.done = true, cancel, make(chan struct{})
+	r.status.IORunning, r.status.SQLRunning = true, true
+	r.status.LastIOError, r.status.LastSQLError = "", ""
+	go r.run(runCtx, r.source, r.done)
+	return nil
+}
+
+// Stop implements sql.Replica. The changes of the transaction being applied, if any, are rolled back.
+func (r *Replica) Stop(ctx *sql.Context) error {
+	r.mu.Lock()
+	if !r.running {
+		r.mu.Unlock()
+		return nil
+	}
+	r.cancel()
+	done := r.done
+	r.mu.Unlock()
+
+	<-done
+	return nil
+}
+
+// errApply wraps the errors applying an event, which stop the replica, as opposed to the errors reading it.
+type errApply struct {
+	error
+}
+
+// run replicates from the source given until the context given is cancelled or an event can't be applied.
+func (r *Replica) run(ctx context.Context, source sql.ReplicationSource, done chan struct{}) {
+	defer close(done)
+
+	pos := source.LogPos
+	if pos < uint64(len(Magic)) {
+		pos = uint64(len(Magic))
+	}
+	applier := NewApplier(r.e, source.LogFile, pos)
+	sqlCtx := sql.NewContext(ctx, sql.WithSession(sql.NewBaseSession()))
+	err := sqlCtx.SetSessionVariable(sqlCtx, "sql_log_bin", int8(0))
+
+	retry := time.Duration(source.ConnectRetry) * time.Second
+	if source.ConnectRetry == 0 {
+		retry = defaultConnectRetry
+	}
+	for err == nil {
+		err = r.replicate(ctx, sqlCtx, source, applier)
+		// The source resends the transaction being applied when connected to again
+		rollbackErr := applier.Rollback()
+		if ctx.Err() != nil {
+			err = rollbackErr
+			break
+		}
+		if _, ok := err.(errApply); ok {
+			break
+		}
+		if rollbackErr != nil {
+			err = rollbackErr
+			break
+		}
+
+		r.mu.Lock()
+		r.status.LastIOError = err.Error()
+		r.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			err = nil
+		case <-time.After(retry):
+			err = nil
+			continue
+		}
+		break
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err != nil {
+		r.status.LastSQLError = err.Error()
+	}
+	r.running, r.cancel, r.done = false, nil, nil
+	r.status.IORunning, r.status.SQLRunning = false, false
+}
+
+// replicate connects to the source and applies the events it streams from the position of the applier given, until
+// the connection is lost, an event can't be applied, or the context given is cancelled.
+func (r *Replica) replicate(ctx context.Context, sqlCtx *sql.Context, source sql.ReplicationSource, applier *Applier) error {
+	conn, err := mysql.Connect(ctx, &mysql.ConnParams{
+		Host:  source.Host,
+		Port:  int(source.Port),
+		Uname: source.User,
+		Pass:  source.Password,
+	})
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	// Reading a packet can't be cancelled, but closing the connection ends it
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-stop:
+		}
+	}()
+
+	// The source only sends events with checksums to replicas telling they can verify them
+	_, err = conn.ExecuteFetch("SET @master_binlog_checksum = @@global.binlog_checksum, "+
+		"@source_binlog_checksum = @@global.binlog_checksum", 0, false)
+	if err != nil {
+		return err
+	}
+	file, pos := applier.Position()
+	if err := conn.WriteComBinlogDump(r.serverID, file, uint32(pos), 0); err != nil {
+		return err
+	}
+
+	for {
+		packet, err := conn.ReadPacket()
+		if err != nil {
+			return err
+		}
+		switch {
+		case len(packet) > 0 && packet[0] == mysql.ErrPacket:
+			return mysql.ParseErrorPacket(packet)
+		case len(packet) < 1+headerLength || packet[0] != mysql.OKPacket:
+			return fmt.Errorf("unexpected packet from the source of %d bytes", len(packet))
+		}
+
+		event := packet[1:]
+		if err := applier.Apply(sqlCtx, event); err != nil {
+			return errApply{err}
+		}
+		r.applied(event, applier)
+	}
+}
+
+// applied updates the status of the replica once the event given was applied by the applier given.
+func (r *Replica) applied(event []byte, applier *Applier) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	file, pos := applier.Position()
+	r.status.SourceServerID = binary.LittleEndian.Uint32(event[5:])
+	r.status.ReadLogFile = file
+	if endPos := binary.LittleEndian.Uint32(event[13:]); endPos != 0 {
+		r.status.ReadLogPos = uint64(endPos)
+	} else if event[4] == eventRotate {
+		r.status.ReadLogPos = pos
+	}
+	r.status.ExecLogFile, r.status.ExecLogPos = file, pos
+	// Replication resumes from the last transaction applied once the replica is started again
+	r.source.LogFile, r.source.LogPos = file, pos
+}
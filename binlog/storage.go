@@ -0,0 +1,305 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlog
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// logFile is a file of a log, along with a description of each of its events. Its content is held in memory, or in a
+// file of the directory of the log.
+type logFile struct {
+	name string
+	// data is the content of the file held in memory, and file the file holding it otherwise
+	data []byte
+	file *os.File
+	// size is the size of the file, which is the position the next event is written at
+	size   uint64
+	events []sql.BinlogEvent
+	// modified is when the file was last written to
+	modified time.Time
+	// readers is the number of replicas reading the file, which keep it from being removed
+	readers int
+}
+
+// createFile creates a file of the log with the name given, starting with the magic header.
+func (l *Log) createFile(name string) (*logFile, error) {
+	f := &logFile{name: name}
+	if l.dir != "" {
+		file, err := os.OpenFile(filepath.Join(l.dir, name), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+		if err != nil {
+			return nil, err
+		}
+		f.file = file
+	}
+	if err := f.append(Magic); err != nil {
+		f.close()
+		return nil, err
+	}
+	return f, nil
+}
+
+// append appends the bytes given to the file.
+func (f *logFile) append(b []byte) error {
+	if f.file != nil {
+		if _, err := f.file.WriteAt(b, int64(f.size)); err != nil {
+			return err
+		}
+	} else {
+		f.data = append(f.data, b...)
+	}
+	f.size += uint64(len(b))
+	f.modified = time.Now()
+	return nil
+}
+
+// read returns the bytes of the file between the positions given. The bytes of a file held in memory aren't copied,
+// and must not be changed.
+func (f *logFile) read(from, to uint64) ([]byte, error) {
+	if f.file == nil {
+		return f.data[from:to], nil
+	}
+	b := make([]byte, to-from)
+	if _, err := f.file.ReadAt(b, int64(from)); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// truncate removes the events of the file after the position given.
+func (f *logFile) truncate(size uint64) error {
+	if f.file != nil {
+		if err := f.file.Truncate(int64(size)); err != nil {
+			return err
+		}
+	} else {
+		f.data = f.data[:size]
+	}
+	f.size = size
+	for i, ev := range f.events {
+		if ev.Pos >= size {
+			f.events = f.events[:i]
+			break
+		}
+	}
+	return nil
+}
+
+func (f *logFile) close() error {
+	if f.file == nil {
+		return nil
+	}
+	return f.file.Close()
+}
+
+// remove closes the file and removes it from the directory of the log, if it's written to one.
+func (f *logFile) remove() error {
+	if f.file == nil {
+		return nil
+	}
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+	return os.Remove(f.file.Name())
+}
+
+// openFiles opens the files of the log already in its directory, oldest first. The last one is ended with a rotate
+// event naming the file started next, if it doesn't end with one already.
+func (l *Log) openFiles() error {
+	entries, err := ioutil.ReadDir(l.dir)
+	if err != nil {
+		return err
+	}
+	type seqFile struct {
+		seq  int
+		name string
+	}
+	var names []seqFile
+	for _, entry := range entries {
+		suffix := strings.TrimPrefix(entry.Name(), l.baseName+".")
+		seq, err := strconv.Atoi(suffix)
+		if entry.IsDir() || suffix == entry.Name() || len(suffix) != 6 || err != nil {
+			continue
+		}
+		names = append(names, seqFile{seq: seq, name: entry.Name()})
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i].seq < names[j].seq })
+
+	for _, name := range names {
+		f, err := l.openFile(name.name)
+		if err != nil {
+			return err
+		}
+		l.files = append(l.files, f)
+		l.nextSeq = name.seq + 1
+	}
+	if len(l.files) == 0 {
+		return nil
+	}
+	last := l.current()
+	if len(last.events) > 0 && last.events[len(last.events)-1].EventType == eventTypeNames[eventRotate] {
+		return nil
+	}
+	next := fmt.Sprintf("%s.%06d", l.baseName, l.nextSeq)
+	return l.write(rotateEvent(next, uint64(len(Magic))), eventRotate, 0, false, next+";pos=4")
+}
+
+// openFile opens a file of the log already in its directory, and reads the description of its events. The events
+// following the last one which doesn't belong to a transaction, or ends one, are removed.
+func (l *Log) openFile(name string) (*logFile, error) {
+	file, err := os.OpenFile(filepath.Join(l.dir, name), os.O_RDWR, 0)
+	if err != nil {
+		return nil, err
+	}
+	f := &logFile{name: name, file: file}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	f.modified = info.ModTime()
+	data, err := ioutil.ReadAll(file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	if !bytes.HasPrefix(data, Magic) {
+		file.Close()
+		return nil, fmt.Errorf("%s is not a binary log file", name)
+	}
+
+	// end is the end of the last event which doesn't belong to a transaction, or ends one
+	pos, end := uint64(len(Magic)), uint64(len(Magic))
+	inTransaction := false
+	for pos+headerLength <= uint64(len(data)) {
+		size := uint64(binary.LittleEndian.Uint32(data[pos+9:]))
+		if size < headerLength || pos+size > uint64(len(data)) {
+			break
+		}
+		ev := data[pos : pos+size]
+		typ, info, xid := describeEvent(ev)
+		f.events = append(f.events, sql.BinlogEvent{
+			LogName:   name,
+			Pos:       pos,
+			EventType: eventTypeNames[typ],
+			ServerID:  binary.LittleEndian.Uint32(ev[5:]),
+			EndLogPos: pos + size,
+			Info:      info,
+		})
+		pos += size
+
+		switch {
+		case typ == eventQuery && info == "BEGIN":
+			inTransaction = true
+		case typ == eventXID:
+			inTransaction = false
+			if xid >= l.nextXID {
+				l.nextXID = xid + 1
+			}
+		}
+		if !inTransaction {
+			end = pos
+		}
+	}
+	f.data = nil
+	f.size = uint64(len(data))
+	if end < f.size {
+		if err := f.truncate(end); err != nil {
+			file.Close()
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+// describeEvent returns the type of an event written by a log and the description of its content, as shown by SHOW
+// BINLOG EVENTS, along with its xid if it's an XID event.
+func describeEvent(ev []byte) (byte, string, uint64) {
+	typ := ev[4]
+	body := ev[headerLength:]
+	switch typ {
+	case eventFormatDescription:
+		if len(body) < 52 {
+			break
+		}
+		version := string(bytes.TrimRight(body[2:52], "\x00"))
+		return typ, fmt.Sprintf("Server ver: %s, Binlog ver: %d", version, binary.LittleEndian.Uint16(body)), 0
+	case eventQuery:
+		if len(body) < 13 {
+			break
+		}
+		dbLen := int(body[8])
+		varsLen := int(binary.LittleEndian.Uint16(body[11:]))
+		start := 13 + varsLen
+		if len(body) < start+dbLen+1 {
+			break
+		}
+		database := string(body[start : start+dbLen])
+		query := string(body[start+dbLen+1:])
+		if database != "" {
+			return typ, fmt.Sprintf("use `%s`; %s", database, query), 0
+		}
+		return typ, query, 0
+	case eventXID:
+		if len(body) < 8 {
+			break
+		}
+		xid := binary.LittleEndian.Uint64(body)
+		return typ, fmt.Sprintf("COMMIT /* xid=%d */", xid), xid
+	case eventRotate:
+		if len(body) < 8 {
+			break
+		}
+		return typ, fmt.Sprintf("%s;pos=%d", body[8:], binary.LittleEndian.Uint64(body)), 0
+	case eventTableMap:
+		if len(body) < 9 {
+			break
+		}
+		tableID := uint64(binary.LittleEndian.Uint32(body)) | uint64(binary.LittleEndian.Uint16(body[4:]))<<32
+		dbLen := int(body[8])
+		if len(body) < 9+dbLen+2 {
+			break
+		}
+		database := string(body[9 : 9+dbLen])
+		tableLen := int(body[9+dbLen+1])
+		if len(body) < 9+dbLen+2+tableLen {
+			break
+		}
+		table := string(body[9+dbLen+2 : 9+dbLen+2+tableLen])
+		return typ, fmt.Sprintf("table_id: %d (%s.%s)", tableID, database, table), 0
+	case eventWriteRows, eventUpdateRows, eventDeleteRows:
+		if len(body) < 8 {
+			break
+		}
+		tableID := uint64(binary.LittleEndian.Uint32(body)) | uint64(binary.LittleEndian.Uint16(body[4:]))<<32
+		info := fmt.Sprintf("table_id: %d", tableID)
+		if binary.LittleEndian.Uint16(body[6:])&rowsFlagStatementEnd != 0 {
+			info += " flags: STMT_END_F"
+		}
+		return typ, info, 0
+	}
+	return typ, "", 0
+}
@@ -0,0 +1,98 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlog_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/linanh/go-mysql-server/binlog"
+	"github.com/linanh/go-mysql-server/memory"
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+func TestFileLog(t *testing.T) {
+	require := require.New(t)
+	dir := t.TempDir()
+	log, err := binlog.NewFileLog(dir, "")
+	require.NoError(err)
+	e := newTestEngineWithLog(t, memory.NewDatabase("mydb"), log)
+	ctx := e.newContext()
+	e.exec(ctx,
+		"CREATE TABLE t (id int primary key)",
+		"INSERT INTO t VALUES (1)",
+		"INSERT INTO t VALUES (2)",
+	)
+
+	// The files are written to the directory
+	data, ok := log.File("binlog.000001")
+	require.True(ok)
+	written, err := ioutil.ReadFile(filepath.Join(dir, "binlog.000001"))
+	require.NoError(err)
+	require.Equal(data, written)
+	events, err := log.Events("binlog.000001", 0)
+	require.NoError(err)
+	require.NoError(log.Close())
+
+	// A transaction which wasn't written entirely is removed from the file once it's opened again
+	begin := events[len(events)-4]
+	require.Equal("BEGIN", begin.Info)
+	f, err := os.OpenFile(filepath.Join(dir, "binlog.000001"), os.O_WRONLY|os.O_APPEND, 0)
+	require.NoError(err)
+	_, err = f.Write(data[begin.Pos : begin.EndLogPos+10])
+	require.NoError(err)
+	require.NoError(f.Close())
+
+	// The files already in the directory are kept, ending with a rotate event, and a new file is started
+	log, err = binlog.NewFileLog(dir, "")
+	require.NoError(err)
+	defer func() {
+		require.NoError(log.Close())
+	}()
+	files := log.Files()
+	require.Equal([]string{"binlog.000001", "binlog.000002"}, fileNames(files))
+	reopened, err := log.Events("binlog.000001", 0)
+	require.NoError(err)
+	require.Len(reopened, len(events)+1)
+	for i, ev := range events {
+		require.Equal(ev.EventType, reopened[i].EventType)
+		require.Equal(ev.Pos, reopened[i].Pos)
+		require.Equal(ev.EndLogPos, reopened[i].EndLogPos)
+		require.Equal(ev.Info, reopened[i].Info)
+	}
+	rotate := reopened[len(reopened)-1]
+	require.Equal("Rotate", rotate.EventType)
+	require.Equal("binlog.000002;pos=4", rotate.Info)
+	require.Equal(files[0].Size, rotate.EndLogPos)
+
+	// Transactions are numbered after the ones of the files already in the directory
+	e = newTestEngineWithLog(t, memory.NewDatabase("mydb"), log)
+	ctx = e.newContext()
+	e.exec(ctx, "CREATE TABLE t (id int primary key)", "INSERT INTO t VALUES (3)")
+	events, err = log.Events("binlog.000002", 0)
+	require.NoError(err)
+	require.Equal("COMMIT /* xid=3 */", events[len(events)-1].Info)
+
+	// Purged files are removed from the directory
+	require.NoError(log.PurgeTo("binlog.000002"))
+	_, err = os.Stat(filepath.Join(dir, "binlog.000001"))
+	require.True(os.IsNotExist(err))
+	_, err = e.query(ctx, "SHOW BINLOG EVENTS IN 'binlog.000001'")
+	require.True(sql.ErrUnknownBinlogFile.Is(err))
+}
@@ -0,0 +1,221 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disk
+
+import (
+	"os"
+	"sync"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/linanh/go-mysql-server/memory"
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// ErrTransactionConflict is returned when a transaction can't be committed because its changes conflict with the ones
+// committed by other transactions since it began changing the same tables.
+var ErrTransactionConflict = errors.NewKind("transaction %d of database %s conflicts with a committed transaction, try restarting it: %s")
+
+// DefaultCheckpointSize is the size of the write-ahead log above which a commit writes a new snapshot.
+const DefaultCheckpointSize = 16 << 20
+
+// Database is a database persisted in a directory of the local file system. Its tables are held in memory, like
+// the ones of memory.Database, and the directory holds a snapshot of the database along with a write-ahead log of the
+// transactions committed since the snapshot was written. Commits return once their changes are on stable storage, and
+// a database reopened after a crash recovers every committed transaction.
+//
+// The uncommitted changes of a transaction are only seen by the transaction itself. Transactions changing the same
+// table can both commit, unless a row updated by one was updated or deleted by the other, or their changes violate a
+// unique key; the last one to commit fails with ErrTransactionConflict then.
+//
+// A new snapshot is written by every change of the schema, and by commits once the log grows above the checkpoint
+// size. A change of the schema commits the session's transaction. The transactions of other sessions which changed a
+// table whose columns changed, or which was dropped, fail to commit.
+type Database struct {
+	*memory.Database
+	dir string
+
+	mu         sync.Mutex
+	wal        *wal
+	generation uint64
+	nextTxID   uint64
+	// versions are the number of commits which changed each table, by lower cased name
+	versions       map[string]uint64
+	checkpointSize int64
+	// recovering is whether the database is being loaded from its files, in which case changes aren't recorded
+	recovering bool
+}
+
+var _ sql.Database = (*Database)(nil)
+var _ sql.TableCreator = (*Database)(nil)
+var _ sql.TableDropper = (*Database)(nil)
+var _ sql.TableRenamer = (*Database)(nil)
+var _ sql.TriggerDatabase = (*Database)(nil)
+var _ sql.StoredProcedureDatabase = (*Database)(nil)
+var _ sql.TransactionDatabase = (*Database)(nil)
+
+// NewDatabase opens the database with the given name stored in the directory given, which is created if it doesn't
+// exist. The database must be closed once it's no longer used.
+func NewDatabase(name, dir string) (*Database, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	d := &Database{
+		Database:       memory.NewDatabase(name),
+		dir:            dir,
+		versions:       make(map[string]uint64),
+		checkpointSize: DefaultCheckpointSize,
+	}
+	if err := d.recover(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// SetCheckpointSize sets the size of the write-ahead log above which a commit writes a new snapshot.
+func (d *Database) SetCheckpointSize(size int64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.checkpointSize = size
+}
+
+// Checkpoint writes a new snapshot of the database and empties its write-ahead log. The snapshot holds the changes of
+// committed transactions only.
+func (d *Database) Checkpoint(ctx *sql.Context) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.checkpoint(ctx)
+}
+
+// Close closes the files of the database. Uncommitted changes are lost.
+func (d *Database) Close() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.wal == nil {
+		return nil
+	}
+	err := d.wal.close()
+	d.wal = nil
+	return err
+}
+
+// schemaChange runs a change of the schema of the database and writes a snapshot with its result. The transaction of
+// the session is committed first.
+func (d *Database) schemaChange(ctx *sql.Context, change func() error) error {
+	if d.isRecovering() {
+		return change()
+	}
+
+	if tx := d.sessionTransaction(ctx); tx != nil {
+		if err := d.commit(ctx, tx); err != nil {
+			return err
+		}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := change(); err != nil {
+		return err
+	}
+	return d.checkpoint(ctx)
+}
+
+// GetTableInsensitive implements the sql.Database interface. The tables changed by the transaction of the session are
+// its own copies of them.
+func (d *Database) GetTableInsensitive(ctx *sql.Context, name string) (sql.Table, bool, error) {
+	if tx := d.sessionTransaction(ctx); tx != nil {
+		if t, ok := d.txTable(tx, name); ok {
+			return t, true, nil
+		}
+	}
+	return d.Database.GetTableInsensitive(ctx, name)
+}
+
+// committedTable returns the memory table of the table with the name given, which holds the committed changes.
+func (d *Database) committedTable(ctx *sql.Context, name string) (*memory.Table, error) {
+	return memoryTable(ctx, d.Database, name)
+}
+
+func (d *Database) isRecovering() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.recovering
+}
+
+// CreateTable implements the sql.TableCreator interface.
+func (d *Database) CreateTable(ctx *sql.Context, name string, schema sql.Schema) error {
+	return d.schemaChange(ctx, func() error {
+		if _, ok := d.Tables()[name]; ok {
+			return sql.ErrTableAlreadyExists.New(name)
+		}
+		d.AddTable(name, &Table{Table: memory.NewTable(name, schema), db: d})
+		return nil
+	})
+}
+
+// DropTable implements the sql.TableDropper interface.
+func (d *Database) DropTable(ctx *sql.Context, name string) error {
+	return d.schemaChange(ctx, func() error {
+		return d.Database.DropTable(ctx, name)
+	})
+}
+
+// RenameTable implements the sql.TableRenamer interface.
+func (d *Database) RenameTable(ctx *sql.Context, oldName, newName string) error {
+	return d.schemaChange(ctx, func() error {
+		tables := d.Tables()
+		tbl, ok := tables[oldName]
+		if !ok {
+			return sql.ErrTableNotFound.New(oldName)
+		}
+		if _, ok := tables[newName]; ok {
+			return sql.ErrTableAlreadyExists.New(newName)
+		}
+
+		tbl.(*Table).Rename(newName)
+		tables[newName] = tbl
+		delete(tables, oldName)
+		return nil
+	})
+}
+
+// CreateTrigger implements the sql.TriggerDatabase interface.
+func (d *Database) CreateTrigger(ctx *sql.Context, definition sql.TriggerDefinition) error {
+	return d.schemaChange(ctx, func() error {
+		return d.Database.CreateTrigger(ctx, definition)
+	})
+}
+
+// DropTrigger implements the sql.TriggerDatabase interface.
+func (d *Database) DropTrigger(ctx *sql.Context, name string) error {
+	return d.schemaChange(ctx, func() error {
+		return d.Database.DropTrigger(ctx, name)
+	})
+}
+
+// SaveStoredProcedure implements the sql.StoredProcedureDatabase interface.
+func (d *Database) SaveStoredProcedure(ctx *sql.Context, spd sql.StoredProcedureDetails) error {
+	return d.schemaChange(ctx, func() error {
+		return d.Database.SaveStoredProcedure(ctx, spd)
+	})
+}
+
+// DropStoredProcedure implements the sql.StoredProcedureDatabase interface.
+func (d *Database) DropStoredProcedure(ctx *sql.Context, name string) error {
+	return d.schemaChange(ctx, func() error {
+		return d.Database.DropStoredProcedure(ctx, name)
+	})
+}
@@ -0,0 +1,477 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disk_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sqle "github.com/linanh/go-mysql-server"
+	"github.com/linanh/go-mysql-server/disk"
+	"github.com/linanh/go-mysql-server/enginetest"
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// testDB is a disk database along with an engine to query it.
+type testDB struct {
+	t   *testing.T
+	dir string
+	db  *disk.Database
+	e   *sqle.Engine
+}
+
+func openTestDB(t *testing.T, dir string) *testDB {
+	db, err := disk.NewDatabase("mydb", dir)
+	require.NoError(t, err)
+	e := sqle.NewDefault()
+	e.AddDatabase(db)
+	return &testDB{t: t, dir: dir, db: db, e: e}
+}
+
+// reopen closes the database and opens it again from its files.
+func (d *testDB) reopen() *testDB {
+	require.NoError(d.t, d.db.Close())
+	return openTestDB(d.t, d.dir)
+}
+
+func (d *testDB) newContext() *sql.Context {
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession()))
+	ctx.SetCurrentDatabase("mydb")
+	return ctx
+}
+
+func (d *testDB) query(ctx *sql.Context, query string) ([]sql.Row, error) {
+	_, iter, err := d.e.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowIterToRows(ctx, iter)
+}
+
+func (d *testDB) exec(ctx *sql.Context, queries ...string) {
+	for _, query := range queries {
+		_, err := d.query(ctx, query)
+		require.NoError(d.t, err, query)
+	}
+}
+
+func (d *testDB) rows(ctx *sql.Context, query string) []sql.Row {
+	rows, err := d.query(ctx, query)
+	require.NoError(d.t, err, query)
+	return rows
+}
+
+func TestPersistence(t *testing.T) {
+	require := require.New(t)
+	d := openTestDB(t, t.TempDir())
+	ctx := d.newContext()
+
+	d.exec(ctx,
+		"CREATE TABLE items (id int primary key auto_increment, name varchar(20) not null, price decimal(10,2), "+
+			"added datetime default '2021-01-01 00:00:00', kind enum('Small','Large'), tags json, data blob, "+
+			"index name_idx (name), check (price >= 0))",
+		"CREATE TABLE parts (id int primary key, item_id int, CONSTRAINT fk_item FOREIGN KEY (item_id) REFERENCES items (id))",
+		"INSERT INTO items (name, price, kind, tags, data) VALUES ('a', 1.50, 'Small', '[1, \"x\"]', 'abc'), ('b', 2, 'Large', null, null), ('c', 3, null, '{}', null)",
+		"INSERT INTO parts VALUES (1, 1), (2, 3)",
+		"UPDATE items SET price = price * 2 WHERE name = 'b'",
+		"DELETE FROM items WHERE name = 'c'",
+		"CREATE TRIGGER price_trigger BEFORE INSERT ON items FOR EACH ROW SET new.price = new.price + 1",
+		"CREATE PROCEDURE count_items() SELECT count(*) FROM items",
+	)
+	createStatement := d.rows(ctx, "SHOW CREATE TABLE items")
+	partsStatement := d.rows(ctx, "SHOW CREATE TABLE parts")
+
+	d = d.reopen()
+	ctx = d.newContext()
+	require.Equal(createStatement, d.rows(ctx, "SHOW CREATE TABLE items"))
+	require.Equal(partsStatement, d.rows(ctx, "SHOW CREATE TABLE parts"))
+	require.Equal([]sql.Row{
+		{int32(1), "a", "1.50", "Small", sql.MustJSON(`[1, "x"]`), "abc"},
+		{int32(2), "b", "4.00", "Large", nil, nil},
+	}, d.rows(ctx, "SELECT id, name, price, kind, tags, data FROM items ORDER BY id"))
+	var indexes []interface{}
+	for _, row := range d.rows(ctx, "SHOW INDEXES FROM items") {
+		indexes = append(indexes, row[2])
+	}
+	require.Equal([]interface{}{"name_idx"}, indexes)
+
+	// The AUTO_INCREMENT value, the check, the trigger and the procedure are restored
+	d.exec(ctx, "INSERT INTO items (name, price) VALUES ('d', 1)")
+	require.Equal([]sql.Row{{int32(4), "2.00"}}, d.rows(ctx, "SELECT id, price FROM items WHERE name = 'd'"))
+	_, err := d.query(ctx, "INSERT INTO items (name, price) VALUES ('e', -5)")
+	require.True(sql.ErrCheckConstraintViolated.Is(err), "unexpected error %v", err)
+	require.Equal([]sql.Row{{int64(3)}}, d.rows(ctx, "CALL count_items()"))
+
+	d.exec(ctx, "ALTER TABLE items ADD COLUMN stock int default 10", "RENAME TABLE parts TO pieces")
+	d = d.reopen()
+	ctx = d.newContext()
+	require.Equal([]sql.Row{{"a", int32(10)}, {"b", int32(10)}, {"d", int32(10)}}, d.rows(ctx, "SELECT name, stock FROM items ORDER BY id"))
+	require.Equal([]sql.Row{{int32(1), int32(1)}, {int32(2), int32(3)}}, d.rows(ctx, "SELECT * FROM pieces ORDER BY id"))
+	require.NoError(d.db.Close())
+}
+
+// The statements of a BaseSession, which doesn't track transactions, are committed with autocommit, and the tables
+// they create keep the SHOW CREATE TABLE output the engine tests expect once the database is opened again.
+func TestBaseSessionAutocommit(t *testing.T) {
+	scripts := map[string]bool{
+		"Run SHOW CREATE TABLE with different types of check constraints": true,
+		"SHOW CREATE TABLE keeps the case of ENUM/SET values":             true,
+		"Table partitioning": true,
+	}
+	var tested int
+	for _, script := range append(enginetest.CreateCheckConstraintsScripts, enginetest.ScriptTests...) {
+		if !scripts[script.Name] {
+			continue
+		}
+		tested++
+		d := openTestDB(t, t.TempDir())
+		ctx := d.newContext()
+		d.exec(ctx, script.SetUpScript...)
+
+		d = d.reopen()
+		ctx = d.newContext()
+		// The expectations hold until the first statement changing the tables
+		for _, a := range script.Assertions {
+			if a.ExpectedErr == nil && !strings.HasPrefix(a.Query, "SELECT") && !strings.HasPrefix(a.Query, "SHOW") {
+				break
+			}
+			if strings.HasPrefix(a.Query, "SHOW CREATE TABLE") {
+				require.Equal(t, a.Expected, d.rows(ctx, a.Query), a.Query)
+			}
+		}
+		require.NoError(t, d.db.Close())
+	}
+	require.Equal(t, len(scripts), tested)
+
+	d := openTestDB(t, t.TempDir())
+	ctx := d.newContext()
+	d.exec(ctx,
+		"CREATE TABLE sizes (pk int PRIMARY KEY, size ENUM('Small', 'LARGE') NOT NULL, colors SET('Red', 'blue'))",
+		"INSERT INTO sizes VALUES (1, 'LARGE', 'Red,blue'), (2, 'Small', NULL)",
+		"UPDATE sizes SET colors = 'blue' WHERE pk = 2",
+	)
+	d = d.reopen()
+	ctx = d.newContext()
+	require.Equal(t, []sql.Row{{int32(1), "LARGE", "Red,blue"}, {int32(2), "Small", "blue"}},
+		d.rows(ctx, "SELECT * FROM sizes ORDER BY pk"))
+	require.NoError(t, d.db.Close())
+}
+
+func TestPartitionedTablePersistence(t *testing.T) {
+	require := require.New(t)
+	d := openTestDB(t, t.TempDir())
+	ctx := d.newContext()
+
+	d.exec(ctx,
+		"CREATE TABLE sales (id int primary key) PARTITION BY RANGE (id) (PARTITION p0 VALUES LESS THAN (10), PARTITION p1 VALUES LESS THAN MAXVALUE)",
+		"INSERT INTO sales VALUES (1), (15), (20)",
+	)
+
+	d = d.reopen()
+	ctx = d.newContext()
+	require.Equal([]sql.Row{{int32(15)}, {int32(20)}}, d.rows(ctx, "SELECT id FROM sales PARTITION (p1) ORDER BY id"))
+	require.NoError(d.db.Close())
+}
+
+func TestRecoverFromTornLog(t *testing.T) {
+	require := require.New(t)
+	d := openTestDB(t, t.TempDir())
+	ctx := d.newContext()
+
+	d.exec(ctx, "CREATE TABLE t (a int primary key)", "INSERT INTO t VALUES (1), (2)", "INSERT INTO t VALUES (3)")
+	require.NoError(d.db.Close())
+
+	// A crash in the middle of a write leaves a partial frame at the end of the log
+	wal := filepath.Join(d.dir, "wal")
+	f, err := os.OpenFile(wal, os.O_WRONLY|os.O_APPEND, 0644)
+	require.NoError(err)
+	_, err = f.Write([]byte{40, 0, 0, 0, 1, 2, 3, 4, 5})
+	require.NoError(err)
+	require.NoError(f.Close())
+
+	d = openTestDB(t, d.dir)
+	ctx = d.newContext()
+	require.Equal([]sql.Row{{int32(1)}, {int32(2)}, {int32(3)}}, d.rows(ctx, "SELECT * FROM t ORDER BY a"))
+
+	// The last commit was torn: only the ones before it are recovered
+	d.exec(ctx, "INSERT INTO t VALUES (4)", "INSERT INTO t VALUES (5)")
+	require.NoError(d.db.Close())
+	b, err := ioutil.ReadFile(wal)
+	require.NoError(err)
+	require.NoError(ioutil.WriteFile(wal, b[:len(b)-1], 0644))
+
+	d = openTestDB(t, d.dir)
+	ctx = d.newContext()
+	require.Equal([]sql.Row{{int32(1)}, {int32(2)}, {int32(3)}, {int32(4)}}, d.rows(ctx, "SELECT * FROM t ORDER BY a"))
+	require.NoError(d.db.Close())
+}
+
+func TestTransactions(t *testing.T) {
+	require := require.New(t)
+	d := openTestDB(t, t.TempDir())
+	ctx := d.newContext()
+
+	d.exec(ctx,
+		"CREATE TABLE t (a int primary key, b int)",
+		"INSERT INTO t VALUES (1, 1)",
+		"START TRANSACTION",
+		"INSERT INTO t VALUES (2, 2)",
+		"UPDATE t SET b = 10 WHERE a = 1",
+		"ROLLBACK",
+	)
+	require.Equal([]sql.Row{{int32(1), int32(1)}}, d.rows(ctx, "SELECT * FROM t"))
+
+	d.exec(ctx,
+		"START TRANSACTION",
+		"INSERT INTO t VALUES (2, 2)",
+		"SAVEPOINT sp1",
+		"DELETE FROM t WHERE a = 1",
+		"SAVEPOINT sp2",
+		"INSERT INTO t VALUES (3, 3)",
+		"ROLLBACK TO SAVEPOINT sp1",
+		"UPDATE t SET b = 20 WHERE a = 2",
+		"COMMIT",
+	)
+	require.Equal([]sql.Row{{int32(1), int32(1)}, {int32(2), int32(20)}}, d.rows(ctx, "SELECT * FROM t ORDER BY a"))
+	_, err := d.query(ctx, "RELEASE SAVEPOINT sp2")
+	require.Error(err)
+
+	// Uncommitted changes are lost when the database is closed
+	d.exec(ctx, "START TRANSACTION", "INSERT INTO t VALUES (4, 4)")
+	d = d.reopen()
+	ctx = d.newContext()
+	require.Equal([]sql.Row{{int32(1), int32(1)}, {int32(2), int32(20)}}, d.rows(ctx, "SELECT * FROM t ORDER BY a"))
+
+	// A failed statement leaves no change
+	_, err = d.query(ctx, "INSERT INTO t VALUES (5, 5), (1, 1)")
+	require.Error(err)
+	d = d.reopen()
+	ctx = d.newContext()
+	require.Equal([]sql.Row{{int32(1), int32(1)}, {int32(2), int32(20)}}, d.rows(ctx, "SELECT * FROM t ORDER BY a"))
+	require.NoError(d.db.Close())
+}
+
+func TestRowChangeListeners(t *testing.T) {
+	require := require.New(t)
+	d := openTestDB(t, t.TempDir())
+	ctx := d.newContext()
+	var committed []sql.CommittedTransaction
+	d.e.Catalog.RowChangeListeners.Add(sql.RowChangeListenerFunc(func(ctx *sql.Context, tx sql.CommittedTransaction) error {
+		committed = append(committed, tx)
+		return nil
+	}))
+
+	// The changes of a transaction are batched once it's committed, without the ones rolled back
+	d.exec(ctx,
+		"CREATE TABLE t (a int primary key, b int)",
+		"START TRANSACTION",
+		"INSERT INTO t VALUES (1, 1)",
+		"ROLLBACK",
+		"START TRANSACTION",
+		"INSERT INTO t VALUES (2, 2)",
+		"SAVEPOINT sp1",
+		"INSERT INTO t VALUES (3, 3)",
+		"ROLLBACK TO SAVEPOINT sp1",
+		"UPDATE t SET b = 20 WHERE a = 2",
+	)
+	require.Empty(committed)
+	d.exec(ctx, "COMMIT")
+	d.exec(ctx, "DELETE FROM t")
+
+	// Truncating a table isn't rolled back, so its reset is committed on its own
+	d.exec(ctx, "START TRANSACTION", "INSERT INTO t VALUES (4, 4)", "TRUNCATE TABLE t", "ROLLBACK")
+
+	require.Len(committed, 3)
+	require.Equal(uint64(1), committed[0].ID)
+	require.Equal([]sql.RowChange{
+		{Database: "mydb", Table: "t", Schema: committed[0].Changes[0].Schema, New: sql.NewRow(int32(2), int32(2))},
+		{
+			Database: "mydb", Table: "t", Schema: committed[0].Changes[0].Schema,
+			Old: sql.NewRow(int32(2), int32(2)), New: sql.NewRow(int32(2), int32(20)),
+		},
+	}, committed[0].Changes)
+	require.Equal(uint64(2), committed[1].ID)
+	require.Len(committed[1].Changes, 1)
+	require.Equal(sql.NewRow(int32(2), int32(20)), committed[1].Changes[0].Old)
+	require.Nil(committed[1].Changes[0].New)
+	require.Equal(uint64(3), committed[2].ID)
+	require.Equal([]sql.RowChange{
+		{Database: "mydb", Table: "t", Schema: committed[0].Changes[0].Schema, Reset: true},
+	}, committed[2].Changes)
+	require.NoError(d.db.Close())
+}
+
+func TestTransactionIsolation(t *testing.T) {
+	require := require.New(t)
+	d := openTestDB(t, t.TempDir())
+	ctx := d.newContext()
+	other := d.newContext()
+
+	d.exec(ctx, "CREATE TABLE t (a int primary key, b int)", "INSERT INTO t VALUES (1, 1)")
+	d.exec(other, "START TRANSACTION", "INSERT INTO t VALUES (2, 2)", "UPDATE t SET b = 10 WHERE a = 1")
+
+	// Uncommitted changes are only seen by their own transaction
+	require.Equal([]sql.Row{{int32(1), int32(10)}, {int32(2), int32(2)}}, d.rows(other, "SELECT * FROM t ORDER BY a"))
+	require.Equal([]sql.Row{{int32(1), int32(1)}}, d.rows(ctx, "SELECT * FROM t ORDER BY a"))
+
+	// They don't prevent schema changes and checkpoints, which don't persist them
+	d.exec(ctx, "CREATE TABLE u (a int primary key)", "INSERT INTO u VALUES (1)")
+	require.NoError(d.db.Checkpoint(ctx))
+	d.exec(other, "INSERT INTO t VALUES (3, 3)")
+	require.Equal([]sql.Row{{int32(1), int32(1)}}, d.rows(ctx, "SELECT * FROM t ORDER BY a"))
+
+	d.exec(other, "COMMIT")
+	expected := []sql.Row{{int32(1), int32(10)}, {int32(2), int32(2)}, {int32(3), int32(3)}}
+	require.Equal(expected, d.rows(ctx, "SELECT * FROM t ORDER BY a"))
+
+	d = d.reopen()
+	ctx = d.newContext()
+	require.Equal(expected, d.rows(ctx, "SELECT * FROM t ORDER BY a"))
+	require.Equal([]sql.Row{{int32(1)}}, d.rows(ctx, "SELECT * FROM u"))
+	require.NoError(d.db.Close())
+}
+
+func TestTransactionConflicts(t *testing.T) {
+	require := require.New(t)
+	d := openTestDB(t, t.TempDir())
+	ctx := d.newContext()
+	other := d.newContext()
+
+	d.exec(ctx,
+		"CREATE TABLE t (a int primary key, b int)",
+		"CREATE TABLE ids (id int primary key auto_increment, name varchar(10))",
+		"INSERT INTO t VALUES (1, 1), (2, 2)",
+	)
+
+	// Transactions changing different rows of a table both commit
+	d.exec(ctx, "START TRANSACTION", "UPDATE t SET b = 10 WHERE a = 1", "INSERT INTO t VALUES (3, 3)")
+	d.exec(other, "START TRANSACTION", "UPDATE t SET b = 20 WHERE a = 2", "INSERT INTO t VALUES (4, 4)")
+	d.exec(other, "COMMIT")
+	d.exec(ctx, "COMMIT")
+	require.Equal([]sql.Row{{int32(1), int32(10)}, {int32(2), int32(20)}, {int32(3), int32(3)}, {int32(4), int32(4)}},
+		d.rows(ctx, "SELECT * FROM t ORDER BY a"))
+
+	// A row updated by a transaction that commits can't be updated by another one
+	d.exec(ctx, "START TRANSACTION", "UPDATE t SET b = 100 WHERE a = 1")
+	d.exec(other, "START TRANSACTION", "UPDATE t SET b = 200 WHERE a = 1", "INSERT INTO t VALUES (5, 5)")
+	d.exec(other, "COMMIT")
+	_, err := d.query(ctx, "COMMIT")
+	require.True(disk.ErrTransactionConflict.Is(err), "unexpected error %v", err)
+	require.Equal([]sql.Row{{int32(1), int32(200)}, {int32(5), int32(5)}}, d.rows(ctx, "SELECT * FROM t WHERE a IN (1, 5) ORDER BY a"))
+
+	// Neither can the same key be inserted by both
+	d.exec(ctx, "START TRANSACTION", "INSERT INTO t VALUES (6, 1)")
+	d.exec(other, "START TRANSACTION", "INSERT INTO t VALUES (6, 2)", "COMMIT")
+	_, err = d.query(ctx, "COMMIT")
+	require.True(disk.ErrTransactionConflict.Is(err), "unexpected error %v", err)
+	require.Equal([]sql.Row{{int32(6), int32(2)}}, d.rows(ctx, "SELECT * FROM t WHERE a = 6"))
+
+	// Transactions inserting at the same time get different AUTO_INCREMENT values
+	d.exec(ctx, "START TRANSACTION", "INSERT INTO ids (name) VALUES ('ctx')")
+	d.exec(other, "START TRANSACTION", "INSERT INTO ids (name) VALUES ('other')", "COMMIT")
+	d.exec(ctx, "COMMIT")
+	require.Equal([]sql.Row{{int32(1), "ctx"}, {int32(2), "other"}}, d.rows(ctx, "SELECT * FROM ids ORDER BY id"))
+
+	// A transaction which changed a table whose columns changed since can't commit
+	d.exec(other, "START TRANSACTION", "INSERT INTO t VALUES (7, 7)")
+	d.exec(ctx, "ALTER TABLE t ADD COLUMN c int")
+	_, err = d.query(other, "COMMIT")
+	require.True(disk.ErrTransactionConflict.Is(err), "unexpected error %v", err)
+
+	d = d.reopen()
+	ctx = d.newContext()
+	require.Equal([]sql.Row{{int64(6)}}, d.rows(ctx, "SELECT count(*) FROM t"))
+	require.Equal([]sql.Row{{int64(2)}}, d.rows(ctx, "SELECT count(*) FROM ids"))
+	require.NoError(d.db.Close())
+}
+
+func TestRecoverInterleavedTransactions(t *testing.T) {
+	require := require.New(t)
+	d := openTestDB(t, t.TempDir())
+	ctx := d.newContext()
+	first, second, third := d.newContext(), d.newContext(), d.newContext()
+
+	d.exec(ctx, "CREATE TABLE t (a int primary key, b int)", "INSERT INTO t VALUES (1, 0), (2, 0)")
+	d.exec(first, "START TRANSACTION", "INSERT INTO t VALUES (10, 1)")
+	d.exec(second, "START TRANSACTION", "INSERT INTO t VALUES (20, 2)")
+	d.exec(first, "UPDATE t SET b = 1 WHERE a = 1")
+	d.exec(second, "UPDATE t SET b = 2 WHERE a = 2", "DELETE FROM t WHERE a = 20")
+	d.exec(first, "INSERT INTO t VALUES (11, 1)")
+	d.exec(second, "INSERT INTO t VALUES (21, 2)", "COMMIT")
+	d.exec(third, "START TRANSACTION", "INSERT INTO t VALUES (30, 3)")
+	d.exec(first, "COMMIT")
+	d.exec(third, "DELETE FROM t WHERE a = 1")
+
+	// The database is closed as if the process crashed, with the third transaction still running
+	require.NoError(d.db.Close())
+	d = openTestDB(t, d.dir)
+	ctx = d.newContext()
+	require.Equal([]sql.Row{
+		{int32(1), int32(1)}, {int32(2), int32(2)}, {int32(10), int32(1)}, {int32(11), int32(1)}, {int32(21), int32(2)},
+	}, d.rows(ctx, "SELECT * FROM t ORDER BY a"))
+	require.NoError(d.db.Close())
+}
+
+func TestRecoverInterleavedTransactionsFromTornLog(t *testing.T) {
+	require := require.New(t)
+	d := openTestDB(t, t.TempDir())
+	ctx := d.newContext()
+	first, second := d.newContext(), d.newContext()
+
+	d.exec(ctx, "CREATE TABLE t (a int primary key, b int)", "INSERT INTO t VALUES (1, 0)")
+	d.exec(first, "START TRANSACTION", "INSERT INTO t VALUES (10, 1)")
+	d.exec(second, "START TRANSACTION", "INSERT INTO t VALUES (20, 2)", "UPDATE t SET b = 2 WHERE a = 1")
+	d.exec(first, "INSERT INTO t VALUES (11, 1)")
+	d.exec(second, "COMMIT")
+	d.exec(first, "COMMIT")
+	require.NoError(d.db.Close())
+
+	// The crash tore the commit of the first transaction, which is the last one logged
+	wal := filepath.Join(d.dir, "wal")
+	b, err := ioutil.ReadFile(wal)
+	require.NoError(err)
+	require.NoError(ioutil.WriteFile(wal, b[:len(b)-1], 0644))
+
+	d = openTestDB(t, d.dir)
+	ctx = d.newContext()
+	require.Equal([]sql.Row{{int32(1), int32(2)}, {int32(20), int32(2)}}, d.rows(ctx, "SELECT * FROM t ORDER BY a"))
+	require.NoError(d.db.Close())
+}
+
+func TestCheckpointBySize(t *testing.T) {
+	require := require.New(t)
+	d := openTestDB(t, t.TempDir())
+	d.db.SetCheckpointSize(64)
+	ctx := d.newContext()
+
+	d.exec(ctx, "CREATE TABLE t (a int primary key, b text)")
+	for i := 0; i < 10; i++ {
+		d.exec(ctx, "INSERT INTO t SELECT coalesce(max(a), 0) + 1, 'some text to fill the log' FROM t")
+	}
+	info, err := os.Stat(filepath.Join(d.dir, "wal"))
+	require.NoError(err)
+	require.Less(info.Size(), int64(128))
+
+	d = d.reopen()
+	ctx = d.newContext()
+	require.Equal([]sql.Row{{int64(10)}}, d.rows(ctx, "SELECT count(*) FROM t"))
+	require.NoError(d.db.Close())
+}
@@ -0,0 +1,96 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disk_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sqle "github.com/linanh/go-mysql-server"
+	"github.com/linanh/go-mysql-server/disk"
+	"github.com/linanh/go-mysql-server/memory"
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+func TestDumpAndLoad(t *testing.T) {
+	require := require.New(t)
+	src := &testDB{t: t, e: sqle.NewDefault()}
+	db := memory.NewDatabase("mydb")
+	src.e.AddDatabase(db)
+	ctx := src.newContext()
+
+	src.exec(ctx,
+		"CREATE TABLE items (id int primary key auto_increment, name varchar(20), index name_idx (name), check (id < 100))",
+		"INSERT INTO items (name) VALUES ('a'), ('b'), ('c')",
+		"DELETE FROM items WHERE name = 'c'",
+		"CREATE TRIGGER upper_name BEFORE INSERT ON items FOR EACH ROW SET new.name = upper(new.name)",
+		"CREATE PROCEDURE count_items() SELECT count(*) FROM items",
+	)
+	createStatement := src.rows(ctx, "SHOW CREATE TABLE items")
+
+	var buf bytes.Buffer
+	require.NoError(disk.Dump(ctx, db, &buf))
+	dump := buf.Bytes()
+
+	// A dump loaded into a memory database
+	dst := &testDB{t: t, e: sqle.NewDefault()}
+	loaded := memory.NewDatabase("mydb")
+	dst.e.AddDatabase(loaded)
+	require.NoError(disk.Load(ctx, loaded, bytes.NewReader(dump)))
+	require.Equal(createStatement, dst.rows(ctx, "SHOW CREATE TABLE items"))
+	dst.exec(ctx, "INSERT INTO items (name) VALUES ('d')")
+	require.Equal([]sql.Row{{int32(1), "a"}, {int32(2), "b"}, {int32(4), "D"}}, dst.rows(ctx, "SELECT * FROM items ORDER BY id"))
+	require.Equal([]sql.Row{{int64(3)}}, dst.rows(ctx, "CALL count_items()"))
+
+	// Loading into a database with tables fails
+	err := disk.Load(ctx, loaded, bytes.NewReader(dump))
+	require.True(disk.ErrDatabaseNotEmpty.Is(err), "unexpected error %v", err)
+
+	// A dump loaded into a disk database is persisted
+	d := openTestDB(t, t.TempDir())
+	require.NoError(disk.Load(ctx, d.db, bytes.NewReader(dump)))
+	d = d.reopen()
+	require.Equal([]sql.Row{{int32(1), "a"}, {int32(2), "b"}}, d.rows(ctx, "SELECT * FROM items ORDER BY id"))
+	require.NoError(d.db.Close())
+}
+
+func TestLoadInvalidDump(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	var buf bytes.Buffer
+	require.NoError(disk.Dump(ctx, memory.NewDatabase("mydb"), &buf))
+	dump := buf.Bytes()
+	require.NoError(disk.Load(ctx, memory.NewDatabase("mydb"), bytes.NewReader(dump)))
+
+	newer := append([]byte(nil), dump...)
+	binary.LittleEndian.PutUint64(newer[8:], disk.DumpVersion+1)
+	err := disk.Load(ctx, memory.NewDatabase("mydb"), bytes.NewReader(newer))
+	require.True(disk.ErrUnsupportedDumpVersion.Is(err), "unexpected error %v", err)
+
+	err = disk.Load(ctx, memory.NewDatabase("mydb"), bytes.NewReader(dump[:len(dump)-1]))
+	require.True(disk.ErrCorruptData.Is(err), "unexpected error %v", err)
+
+	err = disk.Load(ctx, memory.NewDatabase("mydb"), bytes.NewReader([]byte("not a dump")))
+	require.True(disk.ErrCorruptData.Is(err), "unexpected error %v", err)
+
+	unsupported := memory.NewDatabase("mydb")
+	unsupported.AddTable("t", memory.NewFilteredTable("t", nil))
+	err = disk.Dump(ctx, unsupported, &buf)
+	require.True(disk.ErrUnsupportedTable.Is(err), "unexpected error %v", err)
+}
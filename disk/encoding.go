@@ -0,0 +1,354 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disk
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// ErrCorruptData is returned when the data of a file can't be decoded.
+var ErrCorruptData = errors.NewKind("corrupt data in %s: %s")
+
+// Tags identifying the Go type of an encoded value. They are part of the file format and must never be reordered.
+const (
+	tagNull byte = iota
+	tagInt
+	tagInt8
+	tagInt16
+	tagInt32
+	tagInt64
+	tagUint
+	tagUint8
+	tagUint16
+	tagUint32
+	tagUint64
+	tagFloat32
+	tagFloat64
+	tagString
+	tagBytes
+	tagBool
+	tagTime
+	tagDecimal
+	tagJSON
+	tagGeometry
+)
+
+// encoder appends values to a byte slice.
+type encoder struct {
+	buf []byte
+}
+
+func (e *encoder) byte(b byte) {
+	e.buf = append(e.buf, b)
+}
+
+func (e *encoder) uvarint(v uint64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(b[:], v)
+	e.buf = append(e.buf, b[:n]...)
+}
+
+func (e *encoder) varint(v int64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(b[:], v)
+	e.buf = append(e.buf, b[:n]...)
+}
+
+func (e *encoder) bytes(b []byte) {
+	e.uvarint(uint64(len(b)))
+	e.buf = append(e.buf, b...)
+}
+
+func (e *encoder) string(s string) {
+	e.uvarint(uint64(len(s)))
+	e.buf = append(e.buf, s...)
+}
+
+func (e *encoder) strings(s []string) {
+	e.uvarint(uint64(len(s)))
+	for _, str := range s {
+		e.string(str)
+	}
+}
+
+func (e *encoder) bool(b bool) {
+	if b {
+		e.byte(1)
+	} else {
+		e.byte(0)
+	}
+}
+
+func (e *encoder) time(t time.Time) error {
+	b, err := t.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	e.bytes(b)
+	return nil
+}
+
+// value encodes a value of any of the types that column values are stored as.
+func (e *encoder) value(v interface{}) error {
+	switch v := v.(type) {
+	case nil:
+		e.byte(tagNull)
+	case int:
+		e.byte(tagInt)
+		e.varint(int64(v))
+	case int8:
+		e.byte(tagInt8)
+		e.varint(int64(v))
+	case int16:
+		e.byte(tagInt16)
+		e.varint(int64(v))
+	case int32:
+		e.byte(tagInt32)
+		e.varint(int64(v))
+	case int64:
+		e.byte(tagInt64)
+		e.varint(v)
+	case uint:
+		e.byte(tagUint)
+		e.uvarint(uint64(v))
+	case uint8:
+		e.byte(tagUint8)
+		e.uvarint(uint64(v))
+	case uint16:
+		e.byte(tagUint16)
+		e.uvarint(uint64(v))
+	case uint32:
+		e.byte(tagUint32)
+		e.uvarint(uint64(v))
+	case uint64:
+		e.byte(tagUint64)
+		e.uvarint(v)
+	case float32:
+		e.byte(tagFloat32)
+		e.uvarint(uint64(math.Float32bits(v)))
+	case float64:
+		e.byte(tagFloat64)
+		e.uvarint(math.Float64bits(v))
+	case string:
+		e.byte(tagString)
+		e.string(v)
+	case []byte:
+		e.byte(tagBytes)
+		e.bytes(v)
+	case bool:
+		e.byte(tagBool)
+		e.bool(v)
+	case time.Time:
+		e.byte(tagTime)
+		return e.time(v)
+	case decimal.Decimal:
+		e.byte(tagDecimal)
+		e.string(v.String())
+	case sql.JSONDocument:
+		b, err := json.Marshal(v.Val)
+		if err != nil {
+			return err
+		}
+		e.byte(tagJSON)
+		e.bytes(b)
+	case sql.GeometryValue:
+		e.byte(tagGeometry)
+		e.bytes(sql.SerializeGeometry(v))
+	default:
+		return fmt.Errorf("cannot store value of type %T", v)
+	}
+	return nil
+}
+
+func (e *encoder) row(row sql.Row) error {
+	e.uvarint(uint64(len(row)))
+	for _, v := range row {
+		if err := e.value(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decoder reads the values written by an encoder. The first error encountered is kept in err, after which every read
+// returns a zero value.
+type decoder struct {
+	buf []byte
+	err error
+}
+
+func (d *decoder) fail(format string, args ...interface{}) {
+	if d.err == nil {
+		d.err = fmt.Errorf(format, args...)
+	}
+	d.buf = nil
+}
+
+func (d *decoder) byte() byte {
+	if len(d.buf) < 1 {
+		d.fail("unexpected end of data")
+		return 0
+	}
+	b := d.buf[0]
+	d.buf = d.buf[1:]
+	return b
+}
+
+func (d *decoder) uvarint() uint64 {
+	v, n := binary.Uvarint(d.buf)
+	if n <= 0 {
+		d.fail("invalid unsigned integer")
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *decoder) varint() int64 {
+	v, n := binary.Varint(d.buf)
+	if n <= 0 {
+		d.fail("invalid integer")
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+// length reads a length, checking that there are at least as many bytes left
+func (d *decoder) length() int {
+	l := d.uvarint()
+	if l > uint64(len(d.buf)) {
+		d.fail("length %d exceeds the %d remaining bytes", l, len(d.buf))
+		return 0
+	}
+	return int(l)
+}
+
+func (d *decoder) bytes() []byte {
+	l := d.length()
+	b := make([]byte, l)
+	copy(b, d.buf[:l])
+	d.buf = d.buf[l:]
+	return b
+}
+
+func (d *decoder) string() string {
+	l := d.length()
+	s := string(d.buf[:l])
+	d.buf = d.buf[l:]
+	return s
+}
+
+func (d *decoder) strings() []string {
+	n := d.length()
+	if n == 0 {
+		return nil
+	}
+	s := make([]string, n)
+	for i := range s {
+		s[i] = d.string()
+	}
+	return s
+}
+
+func (d *decoder) bool() bool {
+	return d.byte() != 0
+}
+
+func (d *decoder) time() time.Time {
+	var t time.Time
+	if err := t.UnmarshalBinary(d.bytes()); err != nil {
+		d.fail("invalid time: %s", err)
+	}
+	return t
+}
+
+func (d *decoder) value() interface{} {
+	switch tag := d.byte(); tag {
+	case tagNull:
+		return nil
+	case tagInt:
+		return int(d.varint())
+	case tagInt8:
+		return int8(d.varint())
+	case tagInt16:
+		return int16(d.varint())
+	case tagInt32:
+		return int32(d.varint())
+	case tagInt64:
+		return d.varint()
+	case tagUint:
+		return uint(d.uvarint())
+	case tagUint8:
+		return uint8(d.uvarint())
+	case tagUint16:
+		return uint16(d.uvarint())
+	case tagUint32:
+		return uint32(d.uvarint())
+	case tagUint64:
+		return d.uvarint()
+	case tagFloat32:
+		return math.Float32frombits(uint32(d.uvarint()))
+	case tagFloat64:
+		return math.Float64frombits(d.uvarint())
+	case tagString:
+		return d.string()
+	case tagBytes:
+		return d.bytes()
+	case tagBool:
+		return d.bool()
+	case tagTime:
+		return d.time()
+	case tagDecimal:
+		dec, err := decimal.NewFromString(d.string())
+		if err != nil {
+			d.fail("invalid decimal: %s", err)
+		}
+		return dec
+	case tagJSON:
+		var val interface{}
+		if err := json.Unmarshal(d.bytes(), &val); err != nil {
+			d.fail("invalid JSON: %s", err)
+		}
+		return sql.JSONDocument{Val: val}
+	case tagGeometry:
+		g, ok := sql.DeserializeGeometry(d.bytes())
+		if !ok {
+			d.fail("invalid geometry")
+		}
+		return g
+	default:
+		d.fail("unknown value tag %d", tag)
+		return nil
+	}
+}
+
+func (d *decoder) row() sql.Row {
+	n := d.length()
+	row := make(sql.Row, n)
+	for i := range row {
+		row[i] = d.value()
+	}
+	return row
+}
@@ -0,0 +1,89 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disk
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+func TestEncodeRow(t *testing.T) {
+	require := require.New(t)
+
+	row := sql.NewRow(
+		nil,
+		int(-1), int8(-2), int16(-3), int32(-4), int64(-5),
+		uint(1), uint8(2), uint16(3), uint32(4), uint64(5),
+		float32(1.5), float64(-2.25),
+		"text", []byte{0, 1, 2}, true,
+		time.Date(2021, 8, 2, 10, 30, 0, 123000, time.UTC),
+		decimal.RequireFromString("-12.345"),
+		sql.JSONDocument{Val: map[string]interface{}{"a": []interface{}{float64(1), "b", nil}}},
+		sql.Point{SRID: 4326, X: 1, Y: 2},
+	)
+
+	var e encoder
+	require.NoError(e.row(row))
+	d := decoder{buf: e.buf}
+	decoded := d.row()
+	require.NoError(d.err)
+	require.Empty(d.buf)
+	require.Equal(row, decoded)
+
+	require.Error(e.value(struct{}{}))
+}
+
+func TestDecodeTruncated(t *testing.T) {
+	var e encoder
+	require.NoError(t, e.row(sql.NewRow(int64(1), "some text")))
+
+	for i := 0; i < len(e.buf); i++ {
+		d := decoder{buf: e.buf[:i]}
+		d.row()
+		require.Error(t, d.err, "decoding %d bytes", i)
+	}
+}
+
+func TestReadFrame(t *testing.T) {
+	require := require.New(t)
+
+	b := appendFrame(nil, []byte("first"))
+	b = appendFrame(b, []byte("second"))
+
+	payload, rest, ok := readFrame(b)
+	require.True(ok)
+	require.Equal("first", string(payload))
+	payload, rest, ok = readFrame(rest)
+	require.True(ok)
+	require.Equal("second", string(payload))
+	require.Empty(rest)
+
+	// Torn frame
+	_, _, ok = readFrame(b[:len(b)-1])
+	require.True(ok)
+	_, _, ok = readFrame(b[len("first")+frameHeaderSize : len(b)-1])
+	require.False(ok)
+
+	// Corrupt payload
+	corrupt := append([]byte(nil), b...)
+	corrupt[frameHeaderSize] ^= 0xff
+	_, _, ok = readFrame(corrupt)
+	require.False(ok)
+}
@@ -0,0 +1,424 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disk
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	sqle "github.com/linanh/go-mysql-server"
+	"github.com/linanh/go-mysql-server/memory"
+	"github.com/linanh/go-mysql-server/sql"
+	"github.com/linanh/go-mysql-server/sql/plan"
+)
+
+// tableSnapshot is the state of a table in a snapshot. Tables are created by running their CREATE TABLE statement,
+// which doesn't declare their foreign keys and checks, as those may refer to tables created after them.
+type tableSnapshot struct {
+	name            string
+	createStatement string
+	autoIncrement   interface{}
+	rows            []sql.Row
+	checks          []sql.CheckDefinition
+	foreignKeys     []sql.ForeignKeyConstraint
+}
+
+// checkpoint writes a snapshot of the database as the next generation, and replaces the write-ahead log with an
+// empty one of the same generation. It must be called with the lock held.
+func (d *Database) checkpoint(ctx *sql.Context) error {
+	generation := d.generation + 1
+	// The tables of the memory database hold the committed changes only
+	payload, err := encodeDatabase(ctx, d.Database)
+	if err != nil {
+		return err
+	}
+
+	data := appendFrame(header(snapshotMagic, generation), payload)
+	if err := writeFileAtomic(d.dir, snapshotFileName, data); err != nil {
+		return err
+	}
+
+	w, err := createWAL(d.dir, generation)
+	if err != nil {
+		return err
+	}
+	if d.wal != nil {
+		_ = d.wal.close()
+	}
+	d.wal = w
+	d.generation = generation
+	return nil
+}
+
+// encodeDatabase encodes the tables, triggers and stored procedures of a database whose tables are held in memory.
+func encodeDatabase(ctx *sql.Context, db sql.Database) ([]byte, error) {
+	names, err := db.GetTableNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+
+	var e encoder
+	e.uvarint(uint64(len(names)))
+	for _, name := range names {
+		table, err := memoryTable(ctx, db, name)
+		if err != nil {
+			return nil, err
+		}
+		ts, err := snapshotTable(ctx, table)
+		if err != nil {
+			return nil, err
+		}
+		if err := ts.encode(&e); err != nil {
+			return nil, err
+		}
+	}
+
+	var triggers []sql.TriggerDefinition
+	if tdb, ok := db.(sql.TriggerDatabase); ok {
+		if triggers, err = tdb.GetTriggers(ctx); err != nil {
+			return nil, err
+		}
+	}
+	e.uvarint(uint64(len(triggers)))
+	for _, trigger := range triggers {
+		e.string(trigger.Name)
+		e.string(trigger.CreateStatement)
+	}
+
+	var procedures []sql.StoredProcedureDetails
+	if spdb, ok := db.(sql.StoredProcedureDatabase); ok {
+		if procedures, err = spdb.GetStoredProcedures(ctx); err != nil {
+			return nil, err
+		}
+	}
+	e.uvarint(uint64(len(procedures)))
+	for _, spd := range procedures {
+		e.string(spd.Name)
+		e.string(spd.CreateStatement)
+		if err := e.time(spd.CreatedAt); err != nil {
+			return nil, err
+		}
+		if err := e.time(spd.ModifiedAt); err != nil {
+			return nil, err
+		}
+	}
+
+	return e.buf, nil
+}
+
+// memoryTable returns the memory table holding the rows of the named table of a database.
+func memoryTable(ctx *sql.Context, db sql.Database, name string) (*memory.Table, error) {
+	table, ok, err := db.GetTableInsensitive(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, sql.ErrTableNotFound.New(name)
+	}
+	switch table := table.(type) {
+	case *memory.Table:
+		return table, nil
+	case *Table:
+		return table.Table, nil
+	default:
+		return nil, ErrUnsupportedTable.New(name)
+	}
+}
+
+func snapshotTable(ctx *sql.Context, t *memory.Table) (*tableSnapshot, error) {
+	indexes, err := t.GetIndexes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var declared []sql.Index
+	for _, index := range indexes {
+		if !index.IsGenerated() {
+			declared = append(declared, index)
+		}
+	}
+
+	stmt, err := plan.CreateTableStatement(ctx, t, declared, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	ts := &tableSnapshot{name: t.Name(), createStatement: stmt}
+
+	if t.Schema().HasAutoIncrement() {
+		ts.autoIncrement, err = t.PeekNextAutoIncrementValue(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	partitions, err := t.Partitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		p, err := partitions.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		iter, err := t.PartitionRows(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		rows, err := sql.RowIterToRows(ctx, iter)
+		if err != nil {
+			return nil, err
+		}
+		ts.rows = append(ts.rows, rows...)
+	}
+	if err := partitions.Close(ctx); err != nil {
+		return nil, err
+	}
+
+	if ts.checks, err = t.GetChecks(ctx); err != nil {
+		return nil, err
+	}
+	if ts.foreignKeys, err = t.GetForeignKeys(ctx); err != nil {
+		return nil, err
+	}
+	return ts, nil
+}
+
+func (ts *tableSnapshot) encode(e *encoder) error {
+	e.string(ts.name)
+	e.string(ts.createStatement)
+	if err := e.value(ts.autoIncrement); err != nil {
+		return err
+	}
+	e.uvarint(uint64(len(ts.rows)))
+	for _, row := range ts.rows {
+		if err := e.row(row); err != nil {
+			return err
+		}
+	}
+
+	e.uvarint(uint64(len(ts.checks)))
+	for _, check := range ts.checks {
+		e.string(check.Name)
+		e.string(check.CheckExpression)
+		e.bool(check.Enforced)
+	}
+
+	e.uvarint(uint64(len(ts.foreignKeys)))
+	for _, fk := range ts.foreignKeys {
+		e.string(fk.Name)
+		e.strings(fk.Columns)
+		e.string(fk.ReferencedTable)
+		e.strings(fk.ReferencedColumns)
+		e.string(string(fk.OnUpdate))
+		e.string(string(fk.OnDelete))
+	}
+	return nil
+}
+
+func decodeTableSnapshot(d *decoder) *tableSnapshot {
+	ts := &tableSnapshot{
+		name:            d.string(),
+		createStatement: d.string(),
+		autoIncrement:   d.value(),
+	}
+	ts.rows = make([]sql.Row, d.length())
+	for i := range ts.rows {
+		ts.rows[i] = d.row()
+	}
+
+	ts.checks = make([]sql.CheckDefinition, d.length())
+	for i := range ts.checks {
+		ts.checks[i] = sql.CheckDefinition{
+			Name:            d.string(),
+			CheckExpression: d.string(),
+			Enforced:        d.bool(),
+		}
+	}
+
+	ts.foreignKeys = make([]sql.ForeignKeyConstraint, d.length())
+	for i := range ts.foreignKeys {
+		ts.foreignKeys[i] = sql.ForeignKeyConstraint{
+			Name:              d.string(),
+			Columns:           d.strings(),
+			ReferencedTable:   d.string(),
+			ReferencedColumns: d.strings(),
+			OnUpdate:          sql.ForeignKeyReferenceOption(d.string()),
+			OnDelete:          sql.ForeignKeyReferenceOption(d.string()),
+		}
+	}
+	return ts
+}
+
+// recover loads the database from its snapshot, applies the transactions committed in its write-ahead log, and
+// writes a new snapshot of the result.
+func (d *Database) recover() error {
+	d.recovering = true
+	ctx := sql.NewEmptyContext()
+	ctx.SetCurrentDatabase(d.Name())
+
+	b, err := ioutil.ReadFile(filepath.Join(d.dir, snapshotFileName))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err == nil {
+		generation, rest, ok := readHeader(b, snapshotMagic)
+		if !ok {
+			return ErrCorruptData.New(snapshotFileName, "invalid header")
+		}
+		payload, _, ok := readFrame(rest)
+		if !ok {
+			return ErrCorruptData.New(snapshotFileName, "invalid checksum")
+		}
+		if err := loadDatabase(ctx, d, payload, snapshotFileName); err != nil {
+			return err
+		}
+		d.generation = generation
+	}
+
+	records, err := readWAL(d.dir, d.generation)
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		ops, err := decodeCommit(record)
+		if err != nil {
+			return ErrCorruptData.New(walFileName, err)
+		}
+		for _, o := range ops {
+			if err := d.apply(ctx, o); err != nil {
+				return err
+			}
+		}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.recovering = false
+	return d.checkpoint(ctx)
+}
+
+// loadDatabase loads the tables, triggers and stored procedures encoded in the payload given into an empty database,
+// whose tables are held in memory. The name of the file the payload was read from is used in errors.
+func loadDatabase(ctx *sql.Context, db sql.Database, payload []byte, fileName string) error {
+	dec := decoder{buf: payload}
+	tables := make([]*tableSnapshot, dec.length())
+	for i := range tables {
+		tables[i] = decodeTableSnapshot(&dec)
+	}
+	triggers := make([]sql.TriggerDefinition, dec.length())
+	for i := range triggers {
+		triggers[i] = sql.TriggerDefinition{Name: dec.string(), CreateStatement: dec.string()}
+	}
+	procedures := make([]sql.StoredProcedureDetails, dec.length())
+	for i := range procedures {
+		procedures[i] = sql.StoredProcedureDetails{
+			Name:            dec.string(),
+			CreateStatement: dec.string(),
+			CreatedAt:       dec.time(),
+			ModifiedAt:      dec.time(),
+		}
+	}
+	if dec.err != nil {
+		return ErrCorruptData.New(fileName, dec.err)
+	}
+
+	e := sqle.NewDefault()
+	e.AddDatabase(db)
+	for _, ts := range tables {
+		_, iter, err := e.Query(ctx, ts.createStatement)
+		if err != nil {
+			return err
+		}
+		if _, err := sql.RowIterToRows(ctx, iter); err != nil {
+			return err
+		}
+	}
+
+	for _, ts := range tables {
+		table, err := memoryTable(ctx, db, ts.name)
+		if err != nil {
+			return err
+		}
+		if err := ts.restore(ctx, table); err != nil {
+			return err
+		}
+	}
+
+	if len(triggers) > 0 {
+		tdb, ok := db.(sql.TriggerDatabase)
+		if !ok {
+			return sql.ErrTriggersNotSupported.New(db.Name())
+		}
+		for _, trigger := range triggers {
+			if err := tdb.CreateTrigger(ctx, trigger); err != nil {
+				return err
+			}
+		}
+	}
+	if len(procedures) > 0 {
+		spdb, ok := db.(sql.StoredProcedureDatabase)
+		if !ok {
+			return sql.ErrStoredProceduresNotSupported.New(db.Name())
+		}
+		for _, spd := range procedures {
+			if err := spdb.SaveStoredProcedure(ctx, spd); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// restore loads the rows, AUTO_INCREMENT value, checks and foreign keys of a table created by its CREATE TABLE
+// statement.
+func (ts *tableSnapshot) restore(ctx *sql.Context, t *memory.Table) error {
+	inserter := t.Inserter(ctx)
+	for _, row := range ts.rows {
+		if err := inserter.Insert(ctx, row); err != nil {
+			return err
+		}
+	}
+	if err := inserter.Close(ctx); err != nil {
+		return err
+	}
+
+	if ts.autoIncrement != nil {
+		setter := t.AutoIncrementSetter(ctx)
+		if err := setter.SetAutoIncrementValue(ctx, ts.autoIncrement); err != nil {
+			return err
+		}
+		if err := setter.Close(ctx); err != nil {
+			return err
+		}
+	}
+
+	for i := range ts.checks {
+		if err := t.CreateCheck(ctx, &ts.checks[i]); err != nil {
+			return err
+		}
+	}
+	for _, fk := range ts.foreignKeys {
+		if err := t.CreateForeignKey(ctx, fk.Name, fk.Columns, fk.ReferencedTable, fk.ReferencedColumns, fk.OnUpdate, fk.OnDelete); err != nil {
+			return err
+		}
+	}
+	return nil
+}
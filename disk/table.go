@@ -0,0 +1,390 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disk
+
+import (
+	"github.com/linanh/go-mysql-server/memory"
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// Table is a table of a Database. Its rows and indexes are those of the memory.Table it wraps, and its changes are
+// made to the copies of the transactions of the database, which record them. Its schema is changed right away.
+type Table struct {
+	*memory.Table
+	db *Database
+	// tx is the transaction this table is a copy of a table of the database for, if any
+	tx *Transaction
+}
+
+var _ sql.Table = (*Table)(nil)
+var _ sql.InsertableTable = (*Table)(nil)
+var _ sql.UpdatableTable = (*Table)(nil)
+var _ sql.DeletableTable = (*Table)(nil)
+var _ sql.ReplaceableTable = (*Table)(nil)
+var _ sql.TruncateableTable = (*Table)(nil)
+var _ sql.AlterableTable = (*Table)(nil)
+var _ sql.IndexAlterableTable = (*Table)(nil)
+var _ sql.IndexedTable = (*Table)(nil)
+var _ sql.ForeignKeyAlterableTable = (*Table)(nil)
+var _ sql.CheckAlterableTable = (*Table)(nil)
+var _ sql.AutoIncrementTable = (*Table)(nil)
+var _ sql.ProjectedTable = (*Table)(nil)
+var _ sql.PrimaryKeyAlterableTable = (*Table)(nil)
+var _ sql.PartitionAlterableTable = (*Table)(nil)
+
+// memoryEditor is the editor of a memory.Table, which implements all the row editing interfaces.
+type memoryEditor interface {
+	sql.RowReplacer
+	Update(ctx *sql.Context, old sql.Row, new sql.Row) error
+}
+
+// wrap returns the table given, which is a copy of the memory table of this table, wrapped in a Table.
+func (t *Table) wrap(table sql.Table) sql.Table {
+	return &Table{Table: table.(*memory.Table), db: t.db, tx: t.tx}
+}
+
+// WithProjection implements the sql.ProjectedTable interface.
+func (t *Table) WithProjection(colNames []string) sql.Table {
+	return t.wrap(t.Table.WithProjection(colNames))
+}
+
+// WithIndexLookup implements the sql.IndexedTable interface.
+func (t *Table) WithIndexLookup(lookup sql.IndexLookup) sql.Table {
+	return t.wrap(t.Table.WithIndexLookup(lookup))
+}
+
+// WithPartitions implements the sql.PartitionedTable interface.
+func (t *Table) WithPartitions(names []string) sql.Table {
+	return t.wrap(t.Table.WithPartitions(names))
+}
+
+func (t *Table) editor(ctx *sql.Context) *tableEditor {
+	tx, implicit := t.db.transaction(ctx)
+	table := t.db.tableForWrite(ctx, tx, t)
+	return &tableEditor{
+		table:    table,
+		editor:   table.Table.Replacer(ctx).(memoryEditor),
+		tx:       tx,
+		implicit: implicit,
+	}
+}
+
+// Inserter implements the sql.InsertableTable interface.
+func (t *Table) Inserter(ctx *sql.Context) sql.RowInserter {
+	return t.editor(ctx)
+}
+
+// Updater implements the sql.UpdatableTable interface.
+func (t *Table) Updater(ctx *sql.Context) sql.RowUpdater {
+	return t.editor(ctx)
+}
+
+// Replacer implements the sql.ReplaceableTable interface.
+func (t *Table) Replacer(ctx *sql.Context) sql.RowReplacer {
+	return t.editor(ctx)
+}
+
+// Deleter implements the sql.DeletableTable interface.
+func (t *Table) Deleter(ctx *sql.Context) sql.RowDeleter {
+	return t.editor(ctx)
+}
+
+// schemaChange runs a change of the schema of the table, which is made to the table of the database rather than to a
+// copy of a transaction.
+func (t *Table) schemaChange(ctx *sql.Context, change func(table *memory.Table) error) error {
+	return t.db.schemaChange(ctx, func() error {
+		table, err := t.db.committedTable(ctx, t.Name())
+		if err != nil {
+			return err
+		}
+		return change(table)
+	})
+}
+
+// PeekNextAutoIncrementValue implements the sql.AutoIncrementTable interface.
+func (t *Table) PeekNextAutoIncrementValue(ctx *sql.Context) (interface{}, error) {
+	t.db.mu.Lock()
+	defer t.db.mu.Unlock()
+	table, err := t.db.committedTable(ctx, t.Name())
+	if err != nil {
+		return nil, err
+	}
+	return table.PeekNextAutoIncrementValue(ctx)
+}
+
+// GetNextAutoIncrementValue implements the sql.AutoIncrementTable interface. Values are taken from the table of the
+// database, whatever the transaction, so that transactions inserting into the table at the same time get different
+// ones. A value is never given again, even if the transaction it was given to rolls back.
+func (t *Table) GetNextAutoIncrementValue(ctx *sql.Context, insertVal interface{}) (interface{}, error) {
+	t.db.mu.Lock()
+	defer t.db.mu.Unlock()
+	table, err := t.db.committedTable(ctx, t.Name())
+	if err != nil {
+		return nil, err
+	}
+	val, err := table.GetNextAutoIncrementValue(ctx, insertVal)
+	if err != nil {
+		return nil, err
+	}
+
+	var next interface{}
+	for _, col := range table.Schema() {
+		if col.AutoIncrement {
+			next, err = nextAutoIncrement(col.Type, val)
+			break
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	setter := table.AutoIncrementSetter(ctx)
+	if err := setter.SetAutoIncrementValue(ctx, next); err != nil {
+		return nil, err
+	}
+	return val, setter.Close(ctx)
+}
+
+// nextAutoIncrement returns the AUTO_INCREMENT value following the one given for a column of the type given.
+func nextAutoIncrement(typ sql.Type, val interface{}) (interface{}, error) {
+	if sql.IsUnsigned(typ) {
+		v, err := sql.Uint64.Convert(val)
+		if err != nil {
+			return nil, err
+		}
+		return typ.Convert(v.(uint64) + 1)
+	}
+	v, err := sql.Int64.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	return typ.Convert(v.(int64) + 1)
+}
+
+// AutoIncrementSetter implements the sql.AutoIncrementTable interface.
+func (t *Table) AutoIncrementSetter(ctx *sql.Context) sql.AutoIncrementSetter {
+	return &autoIncrementSetter{table: t}
+}
+
+// Truncate implements the sql.TruncateableTable interface.
+func (t *Table) Truncate(ctx *sql.Context) (int, error) {
+	var count int
+	err := t.schemaChange(ctx, func(table *memory.Table) error {
+		var err error
+		count, err = table.Truncate(ctx)
+		return err
+	})
+	return count, err
+}
+
+// AddColumn implements the sql.AlterableTable interface.
+func (t *Table) AddColumn(ctx *sql.Context, column *sql.Column, order *sql.ColumnOrder) error {
+	return t.schemaChange(ctx, func(table *memory.Table) error {
+		return table.AddColumn(ctx, column, order)
+	})
+}
+
+// DropColumn implements the sql.AlterableTable interface.
+func (t *Table) DropColumn(ctx *sql.Context, columnName string) error {
+	return t.schemaChange(ctx, func(table *memory.Table) error {
+		return table.DropColumn(ctx, columnName)
+	})
+}
+
+// ModifyColumn implements the sql.AlterableTable interface.
+func (t *Table) ModifyColumn(ctx *sql.Context, columnName string, column *sql.Column, order *sql.ColumnOrder) error {
+	return t.schemaChange(ctx, func(table *memory.Table) error {
+		return table.ModifyColumn(ctx, columnName, column, order)
+	})
+}
+
+// CreateIndex implements the sql.IndexAlterableTable interface.
+func (t *Table) CreateIndex(ctx *sql.Context, indexName string, using sql.IndexUsing, constraint sql.IndexConstraint, columns []sql.IndexColumn, comment string) error {
+	return t.schemaChange(ctx, func(table *memory.Table) error {
+		return table.CreateIndex(ctx, indexName, using, constraint, columns, comment)
+	})
+}
+
+// DropIndex implements the sql.IndexAlterableTable interface.
+func (t *Table) DropIndex(ctx *sql.Context, indexName string) error {
+	return t.schemaChange(ctx, func(table *memory.Table) error {
+		return table.DropIndex(ctx, indexName)
+	})
+}
+
+// RenameIndex implements the sql.IndexAlterableTable interface.
+func (t *Table) RenameIndex(ctx *sql.Context, fromIndexName string, toIndexName string) error {
+	return t.schemaChange(ctx, func(table *memory.Table) error {
+		return table.RenameIndex(ctx, fromIndexName, toIndexName)
+	})
+}
+
+// CreateForeignKey implements the sql.ForeignKeyAlterableTable interface.
+func (t *Table) CreateForeignKey(ctx *sql.Context, fkName string, columns []string, referencedTable string, referencedColumns []string, onUpdate, onDelete sql.ForeignKeyReferenceOption) error {
+	return t.schemaChange(ctx, func(table *memory.Table) error {
+		return table.CreateForeignKey(ctx, fkName, columns, referencedTable, referencedColumns, onUpdate, onDelete)
+	})
+}
+
+// DropForeignKey implements the sql.ForeignKeyAlterableTable interface.
+func (t *Table) DropForeignKey(ctx *sql.Context, fkName string) error {
+	return t.schemaChange(ctx, func(table *memory.Table) error {
+		return table.DropForeignKey(ctx, fkName)
+	})
+}
+
+// CreateCheck implements the sql.CheckAlterableTable interface.
+func (t *Table) CreateCheck(ctx *sql.Context, check *sql.CheckDefinition) error {
+	return t.schemaChange(ctx, func(table *memory.Table) error {
+		return table.CreateCheck(ctx, check)
+	})
+}
+
+// DropCheck implements the sql.CheckAlterableTable interface.
+func (t *Table) DropCheck(ctx *sql.Context, chName string) error {
+	return t.schemaChange(ctx, func(table *memory.Table) error {
+		return table.DropCheck(ctx, chName)
+	})
+}
+
+// CreatePrimaryKey implements the sql.PrimaryKeyAlterableTable interface.
+func (t *Table) CreatePrimaryKey(ctx *sql.Context, columns []sql.IndexColumn) error {
+	return t.schemaChange(ctx, func(table *memory.Table) error {
+		return table.CreatePrimaryKey(ctx, columns)
+	})
+}
+
+// DropPrimaryKey implements the sql.PrimaryKeyAlterableTable interface.
+func (t *Table) DropPrimaryKey(ctx *sql.Context) error {
+	return t.schemaChange(ctx, func(table *memory.Table) error {
+		return table.DropPrimaryKey(ctx)
+	})
+}
+
+// SetPartitioning implements the sql.PartitionAlterableTable interface.
+func (t *Table) SetPartitioning(ctx *sql.Context, partitioning *sql.Partitioning) error {
+	return t.schemaChange(ctx, func(table *memory.Table) error {
+		return table.SetPartitioning(ctx, partitioning)
+	})
+}
+
+// DropPartitions implements the sql.PartitionAlterableTable interface.
+func (t *Table) DropPartitions(ctx *sql.Context, names []string) error {
+	return t.schemaChange(ctx, func(table *memory.Table) error {
+		return table.DropPartitions(ctx, names)
+	})
+}
+
+// TruncatePartitions implements the sql.PartitionAlterableTable interface.
+func (t *Table) TruncatePartitions(ctx *sql.Context, names []string) error {
+	return t.schemaChange(ctx, func(table *memory.Table) error {
+		return table.TruncatePartitions(ctx, names)
+	})
+}
+
+// tableEditor makes the changes of a statement to the memory table of a Table, recording them in a transaction.
+type tableEditor struct {
+	table  *Table
+	editor memoryEditor
+	tx     *Transaction
+	// implicit is whether tx was started for this statement, and must be committed when it completes
+	implicit bool
+	// statement is the number of ops of the transaction when the current statement began
+	statement int
+}
+
+var _ sql.RowReplacer = (*tableEditor)(nil)
+var _ sql.RowUpdater = (*tableEditor)(nil)
+var _ sql.RowInserter = (*tableEditor)(nil)
+var _ sql.RowDeleter = (*tableEditor)(nil)
+
+// StatementBegin implements the sql.TableEditor interface.
+func (e *tableEditor) StatementBegin(ctx *sql.Context) {
+	e.statement = e.table.db.position(e.tx)
+	e.editor.StatementBegin(ctx)
+}
+
+// DiscardChanges implements the sql.TableEditor interface.
+func (e *tableEditor) DiscardChanges(ctx *sql.Context, errorEncountered error) error {
+	e.table.db.discard(e.tx, e.table.Name(), e.statement)
+	return e.editor.DiscardChanges(ctx, errorEncountered)
+}
+
+// StatementComplete implements the sql.TableEditor interface.
+func (e *tableEditor) StatementComplete(ctx *sql.Context) error {
+	return e.editor.StatementComplete(ctx)
+}
+
+// Insert implements the sql.RowInserter interface.
+func (e *tableEditor) Insert(ctx *sql.Context, row sql.Row) error {
+	if err := e.editor.Insert(ctx, row); err != nil {
+		return err
+	}
+	e.table.db.record(e.tx, op{kind: opInsert, table: e.table.Name(), row: row})
+	return nil
+}
+
+// Delete implements the sql.RowDeleter interface.
+func (e *tableEditor) Delete(ctx *sql.Context, row sql.Row) error {
+	if err := e.editor.Delete(ctx, row); err != nil {
+		return err
+	}
+	e.table.db.record(e.tx, op{kind: opDelete, table: e.table.Name(), row: row})
+	return nil
+}
+
+// Update implements the sql.RowUpdater interface.
+func (e *tableEditor) Update(ctx *sql.Context, oldRow sql.Row, newRow sql.Row) error {
+	if err := e.editor.Update(ctx, oldRow, newRow); err != nil {
+		return err
+	}
+	e.table.db.record(e.tx, op{kind: opUpdate, table: e.table.Name(), row: oldRow, newRow: newRow})
+	return nil
+}
+
+// Close implements the sql.Closer interface. The changes of a statement that isn't part of a transaction of the
+// database are committed.
+func (e *tableEditor) Close(ctx *sql.Context) error {
+	if err := e.editor.Close(ctx); err != nil {
+		if e.implicit {
+			_ = e.table.db.rollback(ctx, e.tx, 0)
+		}
+		return err
+	}
+	if e.implicit {
+		return e.table.db.commit(ctx, e.tx)
+	}
+	return nil
+}
+
+// autoIncrementSetter sets the AUTO_INCREMENT value of a Table, which is a change of its schema.
+type autoIncrementSetter struct {
+	table *Table
+}
+
+// SetAutoIncrementValue implements the sql.AutoIncrementSetter interface.
+func (s *autoIncrementSetter) SetAutoIncrementValue(ctx *sql.Context, val interface{}) error {
+	return s.table.schemaChange(ctx, func(table *memory.Table) error {
+		setter := table.AutoIncrementSetter(ctx)
+		if err := setter.SetAutoIncrementValue(ctx, val); err != nil {
+			return err
+		}
+		return setter.Close(ctx)
+	})
+}
+
+// Close implements the sql.Closer interface.
+func (s *autoIncrementSetter) Close(ctx *sql.Context) error {
+	return nil
+}
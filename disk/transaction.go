@@ -0,0 +1,474 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disk
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/linanh/go-mysql-server/memory"
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+type opKind byte
+
+const (
+	opInsert opKind = iota + 1
+	opDelete
+	opUpdate
+)
+
+// op is a change of a row of a table. For updates, row is the old row.
+type op struct {
+	kind   opKind
+	table  string
+	row    sql.Row
+	newRow sql.Row
+}
+
+// inverse returns the op undoing this one.
+func (o op) inverse() op {
+	switch o.kind {
+	case opInsert:
+		return op{kind: opDelete, table: o.table, row: o.row}
+	case opDelete:
+		return op{kind: opInsert, table: o.table, row: o.row}
+	default:
+		return op{kind: opUpdate, table: o.table, row: o.newRow, newRow: o.row}
+	}
+}
+
+func (o op) encode(e *encoder) error {
+	e.byte(byte(o.kind))
+	e.string(o.table)
+	if err := e.row(o.row); err != nil {
+		return err
+	}
+	if o.kind == opUpdate {
+		return e.row(o.newRow)
+	}
+	return nil
+}
+
+func decodeOp(d *decoder) op {
+	o := op{kind: opKind(d.byte()), table: d.string(), row: d.row()}
+	switch o.kind {
+	case opInsert, opDelete:
+	case opUpdate:
+		o.newRow = d.row()
+	default:
+		d.fail("unknown operation %d", o.kind)
+	}
+	return o
+}
+
+// encodeCommit returns the record of the write-ahead log committing the ops given.
+func encodeCommit(id uint64, ops []op) ([]byte, error) {
+	var e encoder
+	e.uvarint(id)
+	e.uvarint(uint64(len(ops)))
+	for _, o := range ops {
+		if err := o.encode(&e); err != nil {
+			return nil, err
+		}
+	}
+	return e.buf, nil
+}
+
+func decodeCommit(b []byte) ([]op, error) {
+	d := decoder{buf: b}
+	d.uvarint()
+	ops := make([]op, d.length())
+	for i := range ops {
+		ops[i] = decodeOp(&d)
+	}
+	return ops, d.err
+}
+
+// Transaction is a transaction of a Database. A transaction makes its changes to copies of the tables it changes,
+// which share their rows with the tables of the database until they change them, so that other transactions don't see
+// its changes until it commits. Its changes are recorded, so that they can be made to the tables of the database and
+// written to the write-ahead log when it commits, or undone in its copies when it rolls back to a savepoint.
+type Transaction struct {
+	id         uint64
+	db         *Database
+	ops        []op
+	savepoints []savepoint
+	// tables are the copies of the tables the transaction changed, by lower cased name
+	tables map[string]*txTable
+}
+
+var _ sql.CommittableTransaction = (*Transaction)(nil)
+
+// txTable is the copy of a table changed by a transaction.
+type txTable struct {
+	table *Table
+	// version is the version of the table of the database when it was copied
+	version uint64
+}
+
+type savepoint struct {
+	name string
+	// pos is the number of ops of the transaction when the savepoint was created
+	pos int
+}
+
+// String implements the sql.Transaction interface.
+func (tx *Transaction) String() string {
+	return fmt.Sprintf("transaction %d of database %s", tx.id, tx.db.Name())
+}
+
+// Commit implements the sql.CommittableTransaction interface.
+func (tx *Transaction) Commit(ctx *sql.Context) error {
+	return tx.db.commit(ctx, tx)
+}
+
+func (tx *Transaction) savepoint(name string) int {
+	for i, sp := range tx.savepoints {
+		if strings.EqualFold(sp.name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// StartTransaction implements the sql.TransactionDatabase interface.
+func (d *Database) StartTransaction(ctx *sql.Context) (sql.Transaction, error) {
+	return d.newTransaction(), nil
+}
+
+func (d *Database) newTransaction() *Transaction {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.nextTxID++
+	return &Transaction{id: d.nextTxID, db: d}
+}
+
+// CommitTransaction implements the sql.TransactionDatabase interface.
+func (d *Database) CommitTransaction(ctx *sql.Context, tx sql.Transaction) error {
+	if tx, ok := tx.(*Transaction); ok && tx.db == d {
+		return d.commit(ctx, tx)
+	}
+	return nil
+}
+
+// Rollback implements the sql.TransactionDatabase interface.
+func (d *Database) Rollback(ctx *sql.Context, tx sql.Transaction) error {
+	if tx, ok := tx.(*Transaction); ok && tx.db == d {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		tx.ops, tx.tables, tx.savepoints = nil, nil, nil
+	}
+	return nil
+}
+
+// CreateSavepoint implements the sql.TransactionDatabase interface.
+func (d *Database) CreateSavepoint(ctx *sql.Context, tx sql.Transaction, name string) error {
+	t, ok := tx.(*Transaction)
+	if !ok || t.db != d {
+		return nil
+	}
+	if i := t.savepoint(name); i >= 0 {
+		t.savepoints = append(t.savepoints[:i], t.savepoints[i+1:]...)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	t.savepoints = append(t.savepoints, savepoint{name: name, pos: len(t.ops)})
+	return nil
+}
+
+// RollbackToSavepoint implements the sql.TransactionDatabase interface. The savepoints created after the one named
+// are removed.
+func (d *Database) RollbackToSavepoint(ctx *sql.Context, tx sql.Transaction, name string) error {
+	t, ok := tx.(*Transaction)
+	if !ok || t.db != d {
+		return nil
+	}
+	i := t.savepoint(name)
+	if i < 0 {
+		return sql.ErrSavepointDoesNotExist.New(name)
+	}
+	pos := t.savepoints[i].pos
+	t.savepoints = t.savepoints[:i+1]
+	return d.rollback(ctx, t, pos)
+}
+
+// ReleaseSavepoint implements the sql.TransactionDatabase interface. The savepoints created after the one named are
+// released as well.
+func (d *Database) ReleaseSavepoint(ctx *sql.Context, tx sql.Transaction, name string) error {
+	t, ok := tx.(*Transaction)
+	if !ok || t.db != d {
+		return nil
+	}
+	i := t.savepoint(name)
+	if i < 0 {
+		return sql.ErrSavepointDoesNotExist.New(name)
+	}
+	t.savepoints = t.savepoints[:i]
+	return nil
+}
+
+// transaction returns the transaction of the session, and whether it's a new transaction that must be committed once
+// the statement completes because the session's transaction isn't one of this database.
+func (d *Database) transaction(ctx *sql.Context) (*Transaction, bool) {
+	if tx := d.sessionTransaction(ctx); tx != nil {
+		return tx, false
+	}
+	return d.newTransaction(), true
+}
+
+func (d *Database) sessionTransaction(ctx *sql.Context) *Transaction {
+	if ctx == nil || ctx.Session == nil {
+		return nil
+	}
+	if tx, ok := ctx.GetTransaction().(*Transaction); ok && tx.db == d {
+		return tx
+	}
+	return nil
+}
+
+// txTable returns the copy of the table with the name given changed by a transaction, if any.
+func (d *Database) txTable(tx *Transaction, name string) (*Table, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if t, ok := tx.tables[strings.ToLower(name)]; ok {
+		return t.table, true
+	}
+	return nil, false
+}
+
+// tableForWrite returns the copy of the table given which a transaction makes its changes to, which is made the first
+// time it changes the table.
+func (d *Database) tableForWrite(ctx *sql.Context, tx *Transaction, t *Table) *Table {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	key := strings.ToLower(t.Name())
+	if copied, ok := tx.tables[key]; ok {
+		return copied.table
+	}
+
+	// A table dropped since it was resolved is copied as it is, and the transaction fails to commit
+	table, err := d.committedTable(ctx, t.Name())
+	if err != nil {
+		table = t.Table
+	}
+	if tx.tables == nil {
+		tx.tables = make(map[string]*txTable)
+	}
+	copied := &Table{Table: table.Share(), db: d, tx: tx}
+	tx.tables[key] = &txTable{table: copied, version: d.versions[key]}
+	return copied
+}
+
+// record adds an op to the changes of a transaction.
+func (d *Database) record(tx *Transaction, o op) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	tx.ops = append(tx.ops, o)
+}
+
+// position returns the number of ops of a transaction.
+func (d *Database) position(tx *Transaction) int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return len(tx.ops)
+}
+
+// discard forgets the ops of a table recorded by a transaction since the position given, which were already undone by
+// the table.
+func (d *Database) discard(tx *Transaction, table string, pos int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if pos >= len(tx.ops) {
+		return
+	}
+	kept := tx.ops[:pos]
+	for _, o := range tx.ops[pos:] {
+		if o.table != table {
+			kept = append(kept, o)
+		}
+	}
+	tx.ops = kept
+}
+
+// commit makes the changes of a transaction to the tables of the database and writes them to the write-ahead log. If
+// they conflict with the changes committed by other transactions since the transaction copied a table, or can't be
+// written, none of them is made.
+func (d *Database) commit(ctx *sql.Context, tx *Transaction) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	ops, tables := tx.ops, tx.tables
+	tx.ops, tx.tables, tx.savepoints = nil, nil, nil
+	if len(ops) == 0 {
+		return nil
+	}
+
+	if err := d.publish(ctx, tx.id, ops, tables); err != nil {
+		return err
+	}
+	payload, err := encodeCommit(tx.id, ops)
+	if err == nil {
+		err = d.wal.append(payload)
+	}
+	if err != nil {
+		if undoErr := d.undo(ctx, ops); undoErr != nil {
+			return undoErr
+		}
+		return err
+	}
+
+	for key := range tables {
+		d.versions[key]++
+	}
+	if d.wal.size > d.checkpointSize {
+		return d.checkpoint(ctx)
+	}
+	return nil
+}
+
+// publish makes the changes of a transaction, which were made to the copies of the tables given, to the tables of the
+// database. A copy whose table changed since it was made can only be published if its rows are still there: a row
+// updated by a transaction must not have been updated or deleted by another one.
+func (d *Database) publish(ctx *sql.Context, txID uint64, ops []op, tables map[string]*txTable) error {
+	changed := make(map[string]*memory.Table)
+	for key, t := range tables {
+		table, err := d.committedTable(ctx, key)
+		if err != nil {
+			return ErrTransactionConflict.New(txID, d.Name(), err)
+		}
+		if !sameSchema(table.Schema(), t.table.Schema()) {
+			return ErrTransactionConflict.New(txID, d.Name(), fmt.Sprintf("the schema of table %s changed", table.Name()))
+		}
+		if t.version != d.versions[key] {
+			changed[key] = table
+		}
+	}
+
+	for i, o := range ops {
+		err := d.checkUpdated(ctx, changed[strings.ToLower(o.table)], o)
+		if err == nil {
+			err = d.apply(ctx, o)
+		}
+		if err != nil {
+			if undoErr := d.undo(ctx, ops[:i]); undoErr != nil {
+				return undoErr
+			}
+			return ErrTransactionConflict.New(txID, d.Name(), err)
+		}
+	}
+	return nil
+}
+
+// checkUpdated returns an error if the op given updates a row that isn't in the table given, if any.
+func (d *Database) checkUpdated(ctx *sql.Context, table *memory.Table, o op) error {
+	if table == nil || o.kind != opUpdate {
+		return nil
+	}
+	partitions, err := table.Partitions(ctx)
+	if err != nil {
+		return err
+	}
+	for {
+		p, err := partitions.Next()
+		if err == io.EOF {
+			return fmt.Errorf("row %s of table %s was changed", sql.FormatRow(o.row), table.Name())
+		} else if err != nil {
+			return err
+		}
+		for _, row := range table.GetPartition(string(p.Key())) {
+			if equal, err := row.Equals(o.row, table.Schema()); err != nil || equal {
+				return err
+			}
+		}
+	}
+}
+
+// rollback undoes the changes of a transaction since the position given in its copies of the tables.
+func (d *Database) rollback(ctx *sql.Context, tx *Transaction, pos int) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if pos >= len(tx.ops) {
+		return nil
+	}
+
+	ops := tx.ops[pos:]
+	tx.ops = tx.ops[:pos]
+	for i := len(ops) - 1; i >= 0; i-- {
+		o := ops[i].inverse()
+		t, ok := tx.tables[strings.ToLower(o.table)]
+		if !ok {
+			return sql.ErrTableNotFound.New(o.table)
+		}
+		if err := applyTo(ctx, t.table.Table, o); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// undo applies the inverse of the ops given to the tables of the database, in reverse order.
+func (d *Database) undo(ctx *sql.Context, ops []op) error {
+	for i := len(ops) - 1; i >= 0; i-- {
+		if err := d.apply(ctx, ops[i].inverse()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// apply makes the change of an op to its table of the database, without recording it.
+func (d *Database) apply(ctx *sql.Context, o op) error {
+	table, err := d.committedTable(ctx, o.table)
+	if err != nil {
+		return err
+	}
+	return applyTo(ctx, table, o)
+}
+
+// applyTo makes the change of an op to the table given.
+func applyTo(ctx *sql.Context, table *memory.Table, o op) error {
+	editor := table.Replacer(ctx).(memoryEditor)
+	var err error
+	switch o.kind {
+	case opInsert:
+		err = editor.Insert(ctx, o.row)
+	case opDelete:
+		err = editor.Delete(ctx, o.row)
+	case opUpdate:
+		err = editor.Update(ctx, o.row, o.newRow)
+	}
+	if err != nil {
+		return err
+	}
+	return editor.Close(ctx)
+}
+
+// sameSchema returns whether two schemas have the same columns. Types are compared by their SQL definition, since some
+// hold functions which reflect.DeepEqual never considers equal.
+func sameSchema(a, b sql.Schema) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Name != b[i].Name || a[i].Nullable != b[i].Nullable || a[i].AutoIncrement != b[i].AutoIncrement ||
+			a[i].PrimaryKey != b[i].PrimaryKey || a[i].Type.String() != b[i].Type.String() ||
+			a[i].Default.String() != b[i].Default.String() {
+			return false
+		}
+	}
+	return true
+}
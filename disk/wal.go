@@ -0,0 +1,186 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disk
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+const (
+	snapshotFileName = "snapshot"
+	walFileName      = "wal"
+)
+
+var (
+	snapshotMagic = []byte("GMSSNAP1")
+	walMagic      = []byte("GMSWAL01")
+)
+
+// frameHeaderSize is the size of the length and checksum preceding the payload of a frame.
+const frameHeaderSize = 8
+
+// appendFrame appends to buf the payload given, preceded by its length and CRC-32 checksum.
+func appendFrame(buf, payload []byte) []byte {
+	var header [frameHeaderSize]byte
+	binary.LittleEndian.PutUint32(header[:4], uint32(len(payload)))
+	binary.LittleEndian.PutUint32(header[4:], crc32.ChecksumIEEE(payload))
+	buf = append(buf, header[:]...)
+	return append(buf, payload...)
+}
+
+// readFrame returns the payload of the frame at the start of b and the bytes following it. It returns false if the
+// frame is incomplete or its checksum doesn't match, which is the case of a frame torn by a crash.
+func readFrame(b []byte) (payload, rest []byte, ok bool) {
+	if len(b) < frameHeaderSize {
+		return nil, nil, false
+	}
+	size := binary.LittleEndian.Uint32(b[:4])
+	if uint64(size) > uint64(len(b)-frameHeaderSize) {
+		return nil, nil, false
+	}
+	payload = b[frameHeaderSize : frameHeaderSize+int(size)]
+	if crc32.ChecksumIEEE(payload) != binary.LittleEndian.Uint32(b[4:frameHeaderSize]) {
+		return nil, nil, false
+	}
+	return payload, b[frameHeaderSize+int(size):], true
+}
+
+// header returns the header of a file: its magic bytes followed by the generation of the checkpoint it belongs to.
+func header(magic []byte, generation uint64) []byte {
+	b := make([]byte, len(magic)+8)
+	copy(b, magic)
+	binary.LittleEndian.PutUint64(b[len(magic):], generation)
+	return b
+}
+
+// readHeader returns the generation in the header of the data given and the bytes following it, or false if the data
+// doesn't start with the magic bytes given.
+func readHeader(b []byte, magic []byte) (uint64, []byte, bool) {
+	if len(b) < len(magic)+8 || !bytes.Equal(b[:len(magic)], magic) {
+		return 0, nil, false
+	}
+	return binary.LittleEndian.Uint64(b[len(magic):]), b[len(magic)+8:], true
+}
+
+// writeFileAtomic replaces the named file in the directory given with the data given, so that after a crash the file
+// has either its previous or its new contents.
+func writeFileAtomic(dir, name string, data []byte) error {
+	tmp := filepath.Join(dir, name+".tmp")
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, filepath.Join(dir, name)); err != nil {
+		return err
+	}
+	return syncDir(dir)
+}
+
+// syncDir flushes the entries of a directory, making the files created or renamed in it durable.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
+
+// wal is the write-ahead log of a database. It holds a frame for each committed transaction since the checkpoint of
+// its generation.
+type wal struct {
+	f    *os.File
+	size int64
+}
+
+// createWAL replaces the write-ahead log in the directory given with an empty log of the generation given.
+func createWAL(dir string, generation uint64) (*wal, error) {
+	h := header(walMagic, generation)
+	if err := writeFileAtomic(dir, walFileName, h); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(filepath.Join(dir, walFileName), os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &wal{f: f, size: int64(len(h))}, nil
+}
+
+// append writes a record to the log and waits for it to be on stable storage. A record that fails to be written is
+// removed, so that it doesn't hide the records appended after it.
+func (w *wal) append(payload []byte) error {
+	frame := appendFrame(nil, payload)
+	if _, err := w.f.Write(frame); err != nil {
+		_ = w.f.Truncate(w.size)
+		return err
+	}
+	if err := w.f.Sync(); err != nil {
+		_ = w.f.Truncate(w.size)
+		return err
+	}
+	w.size += int64(len(frame))
+	return nil
+}
+
+func (w *wal) close() error {
+	return w.f.Close()
+}
+
+// readWAL returns the records of the write-ahead log in the directory given, if it belongs to the generation given.
+// Records following a torn or corrupt frame are ignored, as they can only be the result of a crash during a write.
+func readWAL(dir string, generation uint64) ([][]byte, error) {
+	b, err := ioutil.ReadFile(filepath.Join(dir, walFileName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	walGeneration, b, ok := readHeader(b, walMagic)
+	if !ok {
+		return nil, ErrCorruptData.New(walFileName, "invalid header")
+	}
+	// A log of another generation was left by a crash right after a checkpoint, whose snapshot contains its records
+	if walGeneration != generation {
+		return nil, nil
+	}
+
+	var records [][]byte
+	for len(b) > 0 {
+		payload, rest, ok := readFrame(b)
+		if !ok {
+			break
+		}
+		records = append(records, payload)
+		b = rest
+	}
+	return records, nil
+}
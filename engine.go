@@ -15,14 +15,19 @@
 package sqle
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/linanh/go-mysql-server/auth"
+	"github.com/linanh/go-mysql-server/metrics"
 	"github.com/linanh/go-mysql-server/sql"
 	"github.com/linanh/go-mysql-server/sql/analyzer"
 	"github.com/linanh/go-mysql-server/sql/expression/function"
+	"github.com/linanh/go-mysql-server/sql/mysql_db"
 	"github.com/linanh/go-mysql-server/sql/parse"
+	"github.com/linanh/go-mysql-server/sql/performance_schema"
 	"github.com/linanh/go-mysql-server/sql/plan"
 )
 
@@ -32,14 +37,25 @@ type Config struct {
 	VersionPostfix string
 	// Auth used for authentication and authorization.
 	Auth auth.Auth
+	// Binlog is the binary log the changes committed through the engine are logged to, if any.
+	Binlog sql.Binlog
+	// Metrics is the registry the metrics of the engine are registered in. If it's nil, they're the ones of
+	// metrics.Default, such as StatementCounter.
+	Metrics *metrics.Registry
 }
 
 // Engine is a SQL engine.
 type Engine struct {
-	Catalog  *sql.Catalog
-	Analyzer *analyzer.Analyzer
-	Auth     auth.Auth
-	LS       *sql.LockSubsystem
+	Catalog      *sql.Catalog
+	Analyzer     *analyzer.Analyzer
+	Auth         auth.Auth
+	LS           *sql.LockSubsystem
+	PreparedData *PreparedDataCache
+	slowLog      *slowQueryLog
+	// registry is the registry of the metrics of the engine, and metrics the metrics of its statements, which are nil
+	// if they're the ones of metrics.Default
+	registry *metrics.Registry
+	metrics  *statementMetrics
 }
 
 type ColumnWithRawDefault struct {
@@ -82,7 +98,37 @@ func New(c *sql.Catalog, a *analyzer.Analyzer, cfg *Config) *Engine {
 		au = cfg.Auth
 	}
 
-	return &Engine{c, a, au, ls}
+	// Accounts managed with CREATE USER and GRANT live in the privilege store of the auth method, and are exposed as
+	// the grant tables of the mysql database
+	if pa, ok := au.(auth.PrivilegedAuth); ok {
+		c.PrivilegeStore = pa.PrivilegeStore()
+		if !c.HasDB(mysql_db.MysqlDatabaseName) {
+			c.AddDatabase(mysql_db.NewDatabase(c.PrivilegeStore))
+		}
+	}
+
+	// The statements executed by the engine are shown by the performance_schema database, which every engine has
+	if !c.HasDB(performance_schema.PerformanceSchemaDatabaseName) {
+		c.AddDatabase(performance_schema.NewPerformanceSchemaDatabase(c))
+	}
+
+	// The changes committed through the engine are logged to its binary log, if any
+	if cfg != nil && cfg.Binlog != nil {
+		c.Binlog = cfg.Binlog
+	}
+
+	e := &Engine{c, a, au, ls, NewPreparedDataCache(), new(slowQueryLog), metrics.Default, nil}
+	if cfg != nil && cfg.Metrics != nil {
+		e.registry = cfg.Metrics
+		e.metrics = newStatementMetrics(cfg.Metrics)
+		ls.RegisterMetrics(cfg.Metrics)
+	}
+	return e
+}
+
+// Metrics returns the registry the metrics of the engine are registered in.
+func (e *Engine) Metrics() *metrics.Registry {
+	return e.registry
 }
 
 // NewDefault creates a new default Engine.
@@ -125,20 +171,75 @@ func (e *Engine) QueryWithBindings(
 	return e.QueryNodeWithBindings(ctx, query, nil, bindings)
 }
 
+// PrepareQuery parses and analyzes a query meant to be executed many times in the current session, and returns its
+// analyzed plan, without any bindings applied. Queries and data changes have the plan they have before their bindings
+// are applied cached, and later executions of the same query with QueryWithBindings only finish analyzing that plan.
+func (e *Engine) PrepareQuery(ctx *sql.Context, query string) (sql.Node, error) {
+	parsed, err := parse.Parse(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isPreparable(parsed) {
+		return e.Analyzer.Analyze(ctx, parsed, nil)
+	}
+
+	prepared, err := e.prepare(ctx, query, parsed)
+	if err != nil {
+		return nil, err
+	}
+	return e.Analyzer.AnalyzePrepared(ctx, prepared, nil)
+}
+
+func (e *Engine) prepare(ctx *sql.Context, query string, parsed sql.Node) (sql.Node, error) {
+	prepared, err := e.Analyzer.PrepareQuery(ctx, parsed, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	e.PreparedData.set(ctx, query, &preparedQuery{
+		parsed: parsed,
+		node:   prepared,
+		tables: referencedTables(ctx, parsed),
+	})
+	return prepared, nil
+}
+
 // QueryNodeWithBindings executes the query given with the bindings provided. If parsed is non-nil, it will be used
-// instead of parsing the query from text.
+// instead of parsing the query from text. Queries prepared with PrepareQuery in the current session are executed with
+// their cached plan.
 func (e *Engine) QueryNodeWithBindings(
 	ctx *sql.Context,
 	query string,
 	parsed sql.Node,
 	bindings map[string]sql.Expression,
 ) (sql.Schema, sql.RowIter, error) {
-	var (
-		analyzed sql.Node
-		iter     sql.RowIter
-		err      error
-	)
+	finish := observeStatement(ctx, e.Catalog.StatementEvents, e.slowLog, e.metrics, query)
+	if err := e.initLogBin(ctx); err != nil {
+		finish(0, 0, err)
+		return nil, nil, err
+	}
+	schema, iter, err := e.queryNodeWithBindings(ctx, query, parsed, bindings)
+	if err != nil {
+		finish(0, 0, err)
+		return nil, nil, err
+	}
+	return schema, &statementIter{childIter: iter, finish: finish}, nil
+}
+
+func (e *Engine) queryNodeWithBindings(
+	ctx *sql.Context,
+	query string,
+	parsed sql.Node,
+	bindings map[string]sql.Expression,
+) (sql.Schema, sql.RowIter, error) {
+	// The execution time limit of the statement includes its analysis
+	start := time.Now()
+	if prepared := e.PreparedData.get(ctx, query); prepared != nil {
+		return e.queryPrepared(ctx, query, prepared, bindings, start)
+	}
 
+	var err error
 	if parsed == nil {
 		parsed, err = parse.Parse(ctx, query)
 		if err != nil {
@@ -146,12 +247,15 @@ func (e *Engine) QueryNodeWithBindings(
 		}
 	}
 
-	err = e.authCheck(ctx, parsed)
+	// EXECUTE is checked and run in a transaction as the statement it executes
+	statement := executedStatement(ctx, parsed)
+
+	err = e.authCheck(ctx, statement)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	transactionDatabase, err := e.beginTransaction(ctx, parsed)
+	transactionDatabase, err := e.beginTransaction(ctx, statement)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -163,12 +267,126 @@ func (e *Engine) QueryNodeWithBindings(
 		}
 	}
 
-	analyzed, err = e.Analyzer.Analyze(ctx, parsed, nil)
+	limit, err := executionTimeLimit(ctx, executedQuery(ctx, query, parsed))
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx, cancel := withExecutionTimeLimit(ctx, start, limit)
+
+	analyzed, err := e.Analyzer.Analyze(ctx, parsed, nil)
+	if err != nil {
+		return nil, nil, analysisError(ctx, err, cancel)
+	}
+
+	schema, iter, err := e.execute(ctx, statement, executedQuery(ctx, query, parsed), analyzed, transactionDatabase, cancel)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Plans prepared before a schema change could refer to columns and indexes which changed
+	if plan.IsDDLNode(statement) {
+		e.PreparedData.Invalidate(ddlTargets(ctx, statement))
+	}
+
+	return schema, iter, nil
+}
+
+// executedStatement returns the statement prepared in the session which an EXECUTE statement runs, or the statement
+// given for any other statement.
+func executedStatement(ctx *sql.Context, parsed sql.Node) sql.Node {
+	if execute, ok := parsed.(*plan.ExecuteQuery); ok {
+		if stmt, ok := sql.SessionPreparedStatement(ctx, execute.Name); ok {
+			return stmt.Node
+		}
+	}
+	return parsed
+}
+
+// queryPrepared executes a query with its cached plan, which is prepared again if any of its tables changed.
+func (e *Engine) queryPrepared(
+	ctx *sql.Context,
+	query string,
+	prepared *preparedQuery,
+	bindings map[string]sql.Expression,
+	start time.Time,
+) (sql.Schema, sql.RowIter, error) {
+	err := e.authCheck(ctx, prepared.parsed)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	transactionDatabase, err := e.beginTransaction(ctx, prepared.parsed)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Tables are resolved again in the transaction just started
+	node, fresh, err := refreshTables(ctx, prepared.node)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !fresh {
+		e.PreparedData.delete(ctx, query)
+		node, err = e.prepare(ctx, query, prepared.parsed)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if len(bindings) > 0 {
+		node, err = plan.ApplyBindings(ctx, node, bindings)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	limit, err := executionTimeLimit(ctx, query)
 	if err != nil {
 		return nil, nil, err
 	}
+	ctx, cancel := withExecutionTimeLimit(ctx, start, limit)
+
+	analyzed, err := e.Analyzer.AnalyzePrepared(ctx, node, nil)
+	if err != nil {
+		return nil, nil, analysisError(ctx, err, cancel)
+	}
+
+	return e.execute(ctx, prepared.parsed, query, analyzed, transactionDatabase, cancel)
+}
+
+// execute returns the schema and rows of an analyzed plan, committing the transaction when the rows are closed if
+// autocommit is on. The rows are cancelled once the execution time limit of the context passes, if it has one, in which
+// case cancel is the function releasing the context from withExecutionTimeLimit, and nil otherwise. The row
+// changes the statement given commits are logged to the binary log of the engine, if any, and passed to its listeners
+// of row changes when the rows are closed.
+func (e *Engine) execute(
+	ctx *sql.Context,
+	statement sql.Node,
+	query string,
+	analyzed sql.Node,
+	transactionDatabase string,
+	cancel context.CancelFunc,
+) (sql.Schema, sql.RowIter, error) {
+	// The rows the statement changes are recorded as it changes them, and logged and passed to the listeners of row
+	// changes once they're committed
+	recording := e.binlogging(ctx) || e.Catalog.RowChangeListeners.Listening()
+	sql.SessionPendingChanges(ctx).SetRecording(recording)
+	if e.binlogging(ctx) {
+		binlog := e.Catalog.Binlog
+		sql.SessionPendingChanges(ctx).SetLog(func(changes []sql.RowChange) error {
+			return binlog.LogTransaction(ctx, changes)
+		})
+	} else {
+		sql.SessionPendingChanges(ctx).SetLog(nil)
+	}
 
-	iter, err = analyzed.RowIter(ctx, nil)
+	var iter sql.RowIter
+	var err error
+	if cancel != nil {
+		iter, err = limitExecutionTime(ctx, analyzed, cancel)
+	} else {
+		iter, err = analyzed.RowIter(ctx, nil)
+	}
 	if err != nil {
 		return nil, nil, err
 	}
@@ -182,6 +400,14 @@ func (e *Engine) QueryNodeWithBindings(
 		iter = transactionCommittingIter{iter, transactionDatabase}
 	}
 
+	if recording || e.Catalog.Binlog != nil {
+		committing := &rowChangesIter{childIter: iter, listeners: e.Catalog.RowChangeListeners, binlog: e.Catalog.Binlog}
+		if e.binlogging(ctx) && plan.IsDDLNode(statement) {
+			committing.query = query
+		}
+		iter = committing
+	}
+
 	return analyzed.Schema(), iter, nil
 }
 
@@ -272,12 +498,15 @@ func (t transactionCommittingIter) Close(ctx *sql.Context) error {
 	commitTransaction := (tx != nil) && !ctx.GetIgnoreAutoCommit()
 	if commitTransaction {
 		ctx.GetLogger().Tracef("committing transaction %s", tx)
-		if err := ctx.Session.CommitTransaction(ctx, t.transactionDatabase, tx); err != nil {
-			return err
-		}
+		return sql.SessionPendingChanges(ctx).CommitWith(func() error {
+			if err := ctx.Session.CommitTransaction(ctx, t.transactionDatabase, tx); err != nil {
+				return err
+			}
 
-		// Clearing out the current transaction will tell us to start a new one the next time this session queries
-		ctx.SetTransaction(nil)
+			// Clearing out the current transaction will tell us to start a new one the next time this session queries
+			ctx.SetTransaction(nil)
+			return nil
+		})
 	}
 
 	return nil
@@ -330,14 +559,27 @@ func getTransactionDatabase(ctx *sql.Context, parsed sql.Node) string {
 }
 
 func (e *Engine) authCheck(ctx *sql.Context, node sql.Node) error {
+	if err := killCheck(ctx, e.Catalog, node); err != nil {
+		return err
+	}
+
+	if pa, ok := e.Auth.(auth.PrivilegedAuth); ok {
+		return pa.AllowedPrivileges(ctx, privilegeChecks(ctx, e.Catalog, node))
+	}
+
 	var perm = auth.ReadPerm
 	if plan.IsDDLNode(node) {
 		perm = auth.ReadPerm | auth.WritePerm
 	}
-	switch node.(type) {
+	switch n := node.(type) {
 	case
 		*plan.DeleteFrom, *plan.InsertInto, *plan.Update, *plan.LockTables, *plan.UnlockTables:
 		perm = auth.ReadPerm | auth.WritePerm
+	case *plan.Set:
+		// Global system variables affect every session
+		if setsGlobalVariable(n) {
+			perm = auth.ReadPerm | auth.WritePerm
+		}
 	}
 
 	return e.Auth.Allowed(ctx, perm)
@@ -49,6 +49,22 @@ func TestSessionSelectLimit(t *testing.T) {
 	enginetest.TestSessionSelectLimit(t, enginetest.NewDefaultMemoryHarness())
 }
 
+func TestPerformanceSchema(t *testing.T) {
+	enginetest.TestPerformanceSchema(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestSlowQueryLog(t *testing.T) {
+	enginetest.TestSlowQueryLog(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestRowChangeListeners(t *testing.T) {
+	enginetest.TestRowChangeListeners(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestShowStatus(t *testing.T) {
+	enginetest.TestShowStatus(t, enginetest.NewDefaultMemoryHarness())
+}
+
 func TestVariables(t *testing.T) {
 	enginetest.TestVariables(t, enginetest.NewDefaultMemoryHarness())
 }
@@ -147,6 +163,53 @@ func TestRootSpanFinish(t *testing.T) {
 	require.True(t, fakeSpan.finished)
 }
 
+// minimalSession hides the optional interfaces of the session it wraps, leaving only the methods of sql.Session.
+type minimalSession struct {
+	sql.Session
+}
+
+func TestMinimalSession(t *testing.T) {
+	require := require.New(t)
+	harness := enginetest.NewDefaultMemoryHarness()
+	e := enginetest.NewEngine(t, harness)
+	ctx := sql.NewContext(
+		context.Background(),
+		sql.WithSession(minimalSession{sql.NewBaseSession()})).WithCurrentDB("mydb")
+
+	for _, query := range []string{
+		"INSERT INTO mytable VALUES (4, 'fourth row')",
+		"UPDATE mytable SET s = 'first' WHERE i = 1",
+		"START TRANSACTION",
+		"DELETE FROM mytable WHERE i = 4",
+		"SAVEPOINT s",
+		"ROLLBACK TO SAVEPOINT s",
+		"COMMIT",
+		"SHOW SESSION STATUS LIKE 'Questions'",
+		"SELECT * FROM performance_schema.session_status",
+	} {
+		_, iter, err := e.Query(ctx, query)
+		require.NoError(err, query)
+		_, err = sql.RowIterToRows(ctx, iter)
+		require.NoError(err, query)
+	}
+
+	enginetest.TestQueryWithContext(t, ctx, e, "SELECT i, s FROM mytable ORDER BY i",
+		[]sql.Row{{int64(1), "first"}, {int64(2), "second row"}, {int64(3), "third row"}}, nil, nil)
+
+	for _, query := range []string{"PREPARE s FROM 'SELECT 1'", "SET ROLE ALL"} {
+		_, iter, err := e.Query(ctx, query)
+		if err == nil {
+			_, err = sql.RowIterToRows(ctx, iter)
+		}
+		require.True(sql.ErrUnsupportedFeature.Is(err), query)
+	}
+	_, iter, err := e.Query(ctx, "EXECUTE s")
+	if err == nil {
+		_, err = sql.RowIterToRows(ctx, iter)
+	}
+	require.True(sql.ErrUnknownPreparedStatement.Is(err))
+}
+
 type lockableTable struct {
 	sql.Table
 	readLocks  int
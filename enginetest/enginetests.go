@@ -17,6 +17,9 @@ package enginetest
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 	"sync/atomic"
 	"testing"
@@ -56,6 +59,73 @@ func TestQueries(t *testing.T, harness Harness) {
 	}
 }
 
+// TestQueriesPrepared runs the query tests as prepared statements, executing each of them twice to use its cached plan.
+func TestQueriesPrepared(t *testing.T, harness Harness) {
+	engine := NewEngine(t, harness)
+	createIndexes(t, harness, engine)
+	createForeignKeys(t, harness, engine)
+
+	for _, tt := range QueryTests {
+		t.Run(tt.Query, func(t *testing.T) {
+			if sh, ok := harness.(SkippingHarness); ok && sh.SkipQueryTest(tt.Query) {
+				t.Skipf("Skipping query %s", tt.Query)
+			}
+
+			ctx := NewContextWithEngine(harness, engine)
+			_, err := engine.PrepareQuery(ctx, tt.Query)
+			require.NoError(t, err)
+			for i := 0; i < 2; i++ {
+				TestQueryWithContext(t, ctx, engine, tt.Query, tt.Expected, tt.ExpectedColumns, tt.Bindings)
+			}
+		})
+	}
+}
+
+// TestPreparedQueryCache tests that the cached plans of prepared statements are used with different bindings, and
+// prepared again once the tables they reference change.
+func TestPreparedQueryCache(t *testing.T, harness Harness) {
+	require := require.New(t)
+	e := NewEngine(t, harness)
+	ctx := NewContextWithEngine(harness, e)
+
+	RunQueryWithContext(t, e, ctx, "CREATE TABLE prepared (pk BIGINT PRIMARY KEY, v1 VARCHAR(20))")
+	RunQueryWithContext(t, e, ctx, "INSERT INTO prepared VALUES (1, 'one'), (2, 'two'), (3, 'three')")
+
+	const query = "SELECT * FROM prepared WHERE pk = ? OR v1 IN (SELECT v1 FROM prepared WHERE pk = ?) ORDER BY pk"
+	_, err := e.PrepareQuery(ctx, query)
+	require.NoError(err)
+	require.True(e.PreparedData.Cached(ctx, query))
+
+	bind := func(a, b int64) map[string]sql.Expression {
+		return map[string]sql.Expression{
+			"v1": expression.NewLiteral(a, sql.Int64),
+			"v2": expression.NewLiteral(b, sql.Int64),
+		}
+	}
+	TestQueryWithContext(t, ctx, e, query, []sql.Row{{int64(1), "one"}, {int64(3), "three"}}, nil, bind(1, 3))
+	TestQueryWithContext(t, ctx, e, query, []sql.Row{{int64(2), "two"}}, nil, bind(2, 2))
+
+	// Other sessions don't share the plan
+	other := sql.NewContext(context.Background(), sql.WithSession(sql.NewSession("address", sql.Client{Address: "client", User: "user"}, 2)))
+	other.SetCurrentDatabase(ctx.GetCurrentDatabase())
+	require.False(e.PreparedData.Cached(other, query))
+
+	// Changing the table drops the plan, and executing the statement again prepares it with the new schema
+	RunQueryWithContext(t, e, ctx, "ALTER TABLE prepared ADD COLUMN v2 INT")
+	require.False(e.PreparedData.Cached(ctx, query))
+	_, err = e.PrepareQuery(ctx, query)
+	require.NoError(err)
+	TestQueryWithContext(t, ctx, e, query, []sql.Row{{int64(2), "two", nil}}, nil, bind(2, 2))
+
+	// DDL on other tables leaves the plan alone
+	RunQueryWithContext(t, e, ctx, "CREATE TABLE unrelated (pk BIGINT PRIMARY KEY)")
+	require.True(e.PreparedData.Cached(ctx, query))
+
+	RunQueryWithContext(t, e, ctx, "DROP TABLE prepared")
+	require.False(e.PreparedData.Cached(ctx, query))
+	AssertErrWithCtx(t, e, ctx, query, sql.ErrTableNotFound)
+}
+
 // Runs the query tests given after setting up the engine. Useful for testing out a smaller subset of queries during
 // debugging.
 func RunQueryTests(t *testing.T, harness Harness, queries []QueryTest) {
@@ -896,7 +966,7 @@ func TestTruncate(t *testing.T, harness Harness) {
 			require.FailNow(t, "Incorrectly converted DELETE with WHERE clause to TRUNCATE")
 		}
 
-		TestQuery(t, harness, e, deleteStr, []sql.Row{{sql.NewOkResult(4)}}, nil, nil)
+		TestQuery(t, harness, e, deleteStr, []sql.Row{{sql.NewOkResult(7)}}, nil, nil)
 		TestQuery(t, harness, e, "SELECT * FROM t12a ORDER BY 1", []sql.Row(nil), nil, nil)
 		TestQuery(t, harness, e, "SELECT * FROM t12b ORDER BY 1", []sql.Row(nil), nil, nil)
 	})
@@ -2855,6 +2925,293 @@ func TestUse(t *testing.T, harness Harness) {
 	require.Equal("foo", ctx.GetCurrentDatabase())
 }
 
+// TestPerformanceSchema runs tests of the statement and thread tables of the performance_schema database
+func TestPerformanceSchema(t *testing.T, harness Harness) {
+	require := require.New(t)
+	e := NewEngine(t, harness)
+
+	ctx := NewContext(harness)
+	id := ctx.Session.ID()
+	e.Catalog.ProcessList.AddConnection(id, func() {})
+	e.Catalog.ProcessList.SetConnectionClient(id, sql.Client{User: "root", Address: "localhost"})
+	defer e.Catalog.ProcessList.RemoveConnection(id)
+
+	for _, q := range []string{
+		"SELECT * FROM mytable WHERE i = 1",
+		"SELECT * FROM mytable WHERE i = 2",
+		"select * from mytable   where i = 3",
+		"INSERT INTO mytable VALUES (10, 'ten'), (11, 'eleven')",
+	} {
+		_, iter, err := e.Query(ctx, q)
+		require.NoError(err)
+		_, err = sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+	}
+	_, _, err := e.Query(ctx, "SELECT * FROM nonexistent")
+	require.Error(err)
+
+	TestQueryWithContext(t, ctx, e,
+		"SELECT digest_text, count_star, sum_rows_sent, sum_rows_affected, sum_errors, query_sample_text IS NOT NULL "+
+			"FROM performance_schema.events_statements_summary_by_digest WHERE schema_name = 'mydb' ORDER BY digest_text",
+		[]sql.Row{
+			{"INSERT INTO `mytable` VALUES (...)", uint64(1), uint64(0), uint64(2), uint64(0), true},
+			{"SELECT * FROM `mytable` WHERE `i` = ?", uint64(3), uint64(3), uint64(0), uint64(0), true},
+			{"SELECT * FROM `nonexistent`", uint64(1), uint64(0), uint64(0), uint64(1), true},
+		}, nil, nil)
+
+	TestQueryWithContext(t, ctx, e,
+		"SELECT event_id, end_event_id, event_name, sql_text, current_schema, mysql_errno, rows_sent, timer_end >= timer_start "+
+			"FROM performance_schema.events_statements_history WHERE event_id <= 5 ORDER BY event_id",
+		[]sql.Row{
+			{uint64(1), uint64(1), "statement/sql/select", "SELECT * FROM mytable WHERE i = 1", "mydb", nil, uint64(1), true},
+			{uint64(2), uint64(2), "statement/sql/select", "SELECT * FROM mytable WHERE i = 2", "mydb", nil, uint64(1), true},
+			{uint64(3), uint64(3), "statement/sql/select", "select * from mytable   where i = 3", "mydb", nil, uint64(1), true},
+			{uint64(4), uint64(4), "statement/sql/insert", "INSERT INTO mytable VALUES (10, 'ten'), (11, 'eleven')", "mydb", nil, uint64(0), true},
+			{uint64(5), uint64(5), "statement/sql/select", "SELECT * FROM nonexistent", "mydb", int64(1146), uint64(0), true},
+		}, nil, nil)
+
+	// The current statement of the thread is the one reading the table
+	TestQueryWithContext(t, ctx, e,
+		"SELECT sql_text, end_event_id IS NULL FROM performance_schema.events_statements_current",
+		[]sql.Row{{"SELECT sql_text, end_event_id IS NULL FROM performance_schema.events_statements_current", true}}, nil, nil)
+
+	TestQueryWithContext(t, ctx, e,
+		"SELECT processlist_id, processlist_user, processlist_host, processlist_db, processlist_command FROM performance_schema.threads",
+		[]sql.Row{{uint64(id), "root", "localhost", "mydb", "Sleep"}}, nil, nil)
+
+	TestQueryWithContext(t, ctx, e, "TRUNCATE TABLE performance_schema.events_statements_summary_by_digest", []sql.Row{{sql.NewOkResult(7)}}, nil, nil)
+	// Only the TRUNCATE statement was summarized since
+	TestQueryWithContext(t, ctx, e,
+		"SELECT digest_text FROM performance_schema.events_statements_summary_by_digest",
+		[]sql.Row{{"TRUNCATE TABLE `performance_schema` . `events_statements_summary_by_digest`"}}, nil, nil)
+
+	// Passwords aren't recorded, whether the statement succeeds or not
+	_, iter, err := e.Query(ctx, "CREATE USER bob IDENTIFIED BY 'secret'")
+	if err == nil {
+		_, err = sql.RowIterToRows(ctx, iter)
+	}
+	TestQueryWithContext(t, ctx, e,
+		"SELECT sql_text FROM performance_schema.events_statements_history WHERE sql_text LIKE 'CREATE USER%'",
+		[]sql.Row{{"CREATE USER bob IDENTIFIED BY ?"}}, nil, nil)
+}
+
+func TestSlowQueryLog(t *testing.T, harness Harness) {
+	require := require.New(t)
+	e := NewEngine(t, harness)
+
+	dir, err := ioutil.TempDir("", "slow-query-log")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "slow.log")
+
+	for name, val := range map[string]interface{}{
+		"slow_query_log":      int8(1),
+		"slow_query_log_file": path,
+	} {
+		_, prev, _ := sql.SystemVariables.GetGlobal(name)
+		require.NoError(sql.SystemVariables.SetGlobal(name, val))
+		defer sql.SystemVariables.SetGlobal(name, prev)
+	}
+
+	ctx := NewContext(harness)
+	query := func(q string) {
+		_, iter, err := e.Query(ctx, q)
+		require.NoError(err)
+		_, err = sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+	}
+
+	// Every statement is slow
+	require.NoError(ctx.SetSessionVariable(ctx, "long_query_time", float64(0)))
+	query("SELECT * FROM mytable WHERE i = 1")
+	query("SELECT i FROM mytable")
+	// Passwords are redacted, whether the statement succeeds or not
+	if _, iter, err := e.Query(ctx, "CREATE USER bob IDENTIFIED BY 'secret'"); err == nil {
+		_, _ = sql.RowIterToRows(ctx, iter)
+	}
+
+	// No statement is slow, but the ones reading a table without an index are logged
+	require.NoError(ctx.SetSessionVariable(ctx, "long_query_time", float64(100)))
+	_, prev, _ := sql.SystemVariables.GetGlobal("log_queries_not_using_indexes")
+	require.NoError(sql.SystemVariables.SetGlobal("log_queries_not_using_indexes", int8(1)))
+	defer sql.SystemVariables.SetGlobal("log_queries_not_using_indexes", prev)
+	query("SELECT * FROM mytable WHERE i = 2")
+	query("SELECT s FROM mytable ORDER BY s")
+
+	contents, err := ioutil.ReadFile(path)
+	require.NoError(err)
+	log := string(contents)
+	require.Contains(log, "Time                 Id Command    Argument\n")
+
+	var entries []string
+	for _, entry := range strings.Split(log, "# Time: ")[1:] {
+		lines := strings.Split(entry, "\n")
+		require.Len(lines, 7)
+		require.Equal(fmt.Sprintf("# User@Host: %[1]s[%[1]s] @  [%s]  Id: %d", ctx.Client().User, ctx.Client().Address, ctx.Session.ID()), lines[1])
+		require.Regexp(`^# Query_time: \d+\.\d{6}  Lock_time: \d+\.\d{6} Rows_sent: \d+  Rows_examined: \d+$`, lines[2])
+		require.Equal("use mydb;", lines[3])
+		require.Regexp(`^SET timestamp=\d+;$`, lines[4])
+		entries = append(entries, lines[2][strings.Index(lines[2], "Rows_sent"):]+" "+lines[5])
+	}
+	require.Equal([]string{
+		"Rows_sent: 1  Rows_examined: 1 SELECT * FROM mytable WHERE i = 1;",
+		"Rows_sent: 3  Rows_examined: 3 SELECT i FROM mytable;",
+		"Rows_sent: 0  Rows_examined: 0 CREATE USER bob IDENTIFIED BY ?;",
+		"Rows_sent: 3  Rows_examined: 3 SELECT s FROM mytable ORDER BY s;",
+	}, entries)
+}
+
+func TestRowChangeListeners(t *testing.T, harness Harness) {
+	require := require.New(t)
+	e := NewEngine(t, harness)
+	ctx := NewContext(harness)
+	exec := func(queries ...string) {
+		for _, q := range queries {
+			_, iter, err := e.Query(ctx, q)
+			require.NoError(err, q)
+			_, err = sql.RowIterToRows(ctx, iter)
+			require.NoError(err, q)
+		}
+	}
+	exec("CREATE TABLE cdc (pk int primary key, v varchar(10))")
+
+	// Changes are only recorded while there's a listener
+	exec("INSERT INTO cdc VALUES (1, 'a')")
+	var transactions []string
+	remove := e.Catalog.RowChangeListeners.Add(sql.RowChangeListenerFunc(func(ctx *sql.Context, tx sql.CommittedTransaction) error {
+		var changes []string
+		for _, change := range tx.Changes {
+			changes = append(changes, fmt.Sprintf("%s.%s %v -> %v", change.Database, change.Table, change.Old, change.New))
+		}
+		transactions = append(transactions, fmt.Sprintf("%d: %s", tx.ID, strings.Join(changes, ", ")))
+		return nil
+	}))
+
+	exec(
+		"INSERT INTO cdc VALUES (2, 'b'), (3, 'c')",
+		"UPDATE cdc SET v = 'x' WHERE pk = 1",
+		"DELETE FROM cdc WHERE pk = 3",
+		"REPLACE INTO cdc VALUES (2, 'y')",
+		"INSERT INTO cdc VALUES (1, 'z') ON DUPLICATE KEY UPDATE v = 'z'",
+	)
+
+	// The changes of a statement which fails are discarded
+	_, iter, err := e.Query(ctx, "INSERT INTO cdc VALUES (4, 'd'), (1, 'e')")
+	if err == nil {
+		_, err = sql.RowIterToRows(ctx, iter)
+	}
+	require.True(sql.ErrPrimaryKeyViolation.Is(err), "%v", err)
+
+	// The errors of listeners are returned to the statement committing the transaction
+	removeFailing := e.Catalog.RowChangeListeners.Add(sql.RowChangeListenerFunc(func(ctx *sql.Context, tx sql.CommittedTransaction) error {
+		return fmt.Errorf("listener failed")
+	}))
+	_, iter, err = e.Query(ctx, "INSERT INTO cdc VALUES (5, 'f')")
+	require.NoError(err)
+	_, err = sql.RowIterToRows(ctx, iter)
+	require.EqualError(err, "listener failed")
+	removeFailing()
+
+	// Deleting every row of a table deletes them one by one rather than truncating it
+	var deleted []string
+	removeDeletes := e.Catalog.RowChangeListeners.Add(sql.RowChangeListenerFunc(func(ctx *sql.Context, tx sql.CommittedTransaction) error {
+		for _, change := range tx.Changes {
+			deleted = append(deleted, fmt.Sprint(change.Old, change.New))
+		}
+		return nil
+	}))
+	exec("DELETE FROM cdc")
+	require.ElementsMatch([]string{"[1 z] []", "[2 y] []", "[5 f] []"}, deleted)
+	removeDeletes()
+
+	// Removing every row of a table, or of some of its partitions, at once is a reset, committed on its own
+	var resets []string
+	removeResets := e.Catalog.RowChangeListeners.Add(sql.RowChangeListenerFunc(func(ctx *sql.Context, tx sql.CommittedTransaction) error {
+		for _, change := range tx.Changes {
+			if change.Reset {
+				resets = append(resets, fmt.Sprintf("%s.%s %v %d", change.Database, change.Table, change.Partitions, len(change.Schema)))
+			}
+		}
+		return nil
+	}))
+	exec(
+		"CREATE TABLE cdc_parts (pk int primary key) PARTITION BY RANGE (pk) (PARTITION p0 VALUES LESS THAN (10), PARTITION p1 VALUES LESS THAN (20), PARTITION p2 VALUES LESS THAN MAXVALUE)",
+		"INSERT INTO cdc_parts VALUES (1), (11), (21)",
+		"ALTER TABLE cdc_parts TRUNCATE PARTITION p0",
+		"ALTER TABLE cdc_parts DROP PARTITION p1",
+		"TRUNCATE TABLE cdc",
+		"DROP TABLE cdc_parts",
+	)
+	require.Equal([]string{"mydb.cdc_parts [p0] 1", "mydb.cdc_parts [p1] 1", "mydb.cdc [] 2", "mydb.cdc_parts [] 1"}, resets)
+	removeResets()
+
+	remove()
+	exec("INSERT INTO cdc VALUES (6, 'g')")
+
+	require.Len(transactions, 12)
+	require.Equal([]string{
+		"1: mydb.cdc [] -> [2 b], mydb.cdc [] -> [3 c]",
+		"2: mydb.cdc [1 a] -> [1 x]",
+		"3: mydb.cdc [3 c] -> []",
+		"4: mydb.cdc [2 b] -> [], mydb.cdc [] -> [2 y]",
+		"5: mydb.cdc [1 x] -> [1 z]",
+		"6: mydb.cdc [] -> [5 f]",
+	}, transactions[:6])
+}
+
+func TestShowStatus(t *testing.T, harness Harness) {
+	require := require.New(t)
+	e := NewEngine(t, harness)
+
+	ctx := NewContext(harness)
+	for _, q := range []string{
+		"SELECT * FROM mytable",
+		"SELECT * FROM mytable WHERE i = 1",
+		"INSERT INTO mytable VALUES (10, 'ten')",
+		"SET @a = 1",
+	} {
+		_, iter, err := e.Query(ctx, q)
+		require.NoError(err)
+		_, err = sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+	}
+
+	TestQueryWithContext(t, ctx, e, "SHOW STATUS LIKE 'Com\\_%'", []sql.Row{
+		{"Com_begin", "0"},
+		{"Com_call_procedure", "0"},
+		{"Com_change_db", "0"},
+		{"Com_commit", "0"},
+		{"Com_dealloc_sql", "0"},
+		{"Com_delete", "0"},
+		{"Com_execute_sql", "0"},
+		{"Com_insert", "1"},
+		{"Com_kill", "0"},
+		{"Com_load", "0"},
+		{"Com_prepare_sql", "0"},
+		{"Com_replace", "0"},
+		{"Com_rollback", "0"},
+		{"Com_select", "2"},
+		{"Com_set_option", "1"},
+		{"Com_truncate", "0"},
+		{"Com_update", "0"},
+	}, nil, nil)
+
+	// The statement showing the status is counted before it runs
+	TestQueryWithContext(t, ctx, e, "SHOW SESSION STATUS LIKE 'questions'", []sql.Row{{"Questions", "6"}}, nil, nil)
+	TestQueryWithContext(t, ctx, e, "SHOW LOCAL STATUS LIKE 'Select_scan'", []sql.Row{{"Select_scan", "1"}}, nil, nil)
+
+	TestQueryWithContext(t, ctx, e,
+		"SELECT variable_value FROM performance_schema.session_status WHERE variable_name = 'Queries'",
+		[]sql.Row{{"8"}}, nil, nil)
+
+	questions, ok := sql.StatusVariables.GetGlobal("Questions")
+	require.True(ok)
+	TestQueryWithContext(t, ctx, e,
+		"SELECT variable_value >= "+fmt.Sprint(questions)+" FROM performance_schema.global_status WHERE variable_name = 'Questions'",
+		[]sql.Row{{true}}, nil, nil)
+	TestQueryWithContext(t, ctx, e, "SHOW GLOBAL STATUS LIKE 'threads_running'", []sql.Row{{"Threads_running", "1"}}, nil, nil)
+}
+
 func TestSessionSelectLimit(t *testing.T, harness Harness) {
 	q := []QueryTest{
 		{
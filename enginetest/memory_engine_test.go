@@ -91,6 +91,14 @@ func TestQueriesSimple(t *testing.T) {
 	enginetest.TestQueries(t, enginetest.NewMemoryHarness("simple", 1, testNumPartitions, true, nil))
 }
 
+func TestQueriesPrepared(t *testing.T) {
+	enginetest.TestQueriesPrepared(t, enginetest.NewMemoryHarness("prepared", 1, testNumPartitions, true, nil))
+}
+
+func TestPreparedQueryCache(t *testing.T) {
+	enginetest.TestPreparedQueryCache(t, enginetest.NewDefaultMemoryHarness())
+}
+
 // Convenience test for debugging a single query. Unskip and set to the desired query.
 func TestSingleQuery(t *testing.T) {
 	t.Skip()
@@ -750,6 +750,79 @@ BEGIN
 END;`,
 		ExpectedErr: sql.ErrDeclareConditionNotFound,
 	},
+	{
+		Name: "Dynamic SQL with PREPARE and EXECUTE",
+		SetUpScript: []string{
+			"CREATE TABLE t1(pk BIGINT PRIMARY KEY, v1 VARCHAR(20))",
+			"INSERT INTO t1 VALUES (1, 'a'), (2, 'b'), (3, 'c')",
+			`CREATE PROCEDURE p1(tbl VARCHAR(20), x BIGINT)
+BEGIN
+	SET @sql = CONCAT('SELECT v1 FROM ', tbl, ' WHERE pk >= ? ORDER BY pk');
+	SET @x = x;
+	PREPARE stmt FROM @sql;
+	EXECUTE stmt USING @x;
+	DEALLOCATE PREPARE stmt;
+END;`,
+			`CREATE PROCEDURE p2(x BIGINT, y VARCHAR(20))
+BEGIN
+	SET @x = x, @y = y;
+	-- execute is only a statement at the start of one
+	PREPARE ins FROM 'INSERT INTO t1 VALUES (?, \'execute\')';
+	IF y = 'skip' THEN
+		EXECUTE ins USING @x;
+	ELSE
+		PREPARE ins FROM "INSERT INTO t1 VALUES (?, ?)";
+		EXECUTE ins USING @x, @y;
+	END IF;
+END;`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "CALL p1('t1', 2)",
+				Expected: []sql.Row{{"b"}, {"c"}},
+			},
+			{
+				Query:       "EXECUTE stmt USING @x",
+				ExpectedErr: sql.ErrUnknownPreparedStatement,
+			},
+			{
+				Query:    "CALL p2(4, 'd')",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "CALL p2(5, 'skip')",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT * FROM t1 WHERE pk > 3 ORDER BY pk",
+				Expected: []sql.Row{{int64(4), "d"}, {int64(5), "execute"}},
+			},
+			{
+				Query:    "SET @x = 6",
+				Expected: []sql.Row{{}},
+			},
+			{
+				// Statements prepared in procedures stay prepared in the session
+				Query:       "EXECUTE ins USING @x, @x",
+				ExpectedErr: sql.ErrWrongArgumentsToExecute,
+			},
+		},
+	},
+	{
+		Name: "KILL in procedures",
+		SetUpScript: []string{
+			`CREATE PROCEDURE p1(id INT)
+BEGIN
+	KILL QUERY id;
+END;`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "CALL p1(12345)",
+				ExpectedErr: sql.ErrUnknownThread,
+			},
+		},
+	},
 }
 
 var ProcedureCallTests = []ScriptTest{
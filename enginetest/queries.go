@@ -2578,11 +2578,11 @@ var QueryTests = []QueryTest{
 	},
 	{
 		Query:    `SHOW DATABASES`,
-		Expected: []sql.Row{{"mydb"}, {"foo"}, {"information_schema"}},
+		Expected: []sql.Row{{"mydb"}, {"foo"}, {"information_schema"}, {"performance_schema"}},
 	},
 	{
 		Query:    `SHOW SCHEMAS`,
-		Expected: []sql.Row{{"mydb"}, {"foo"}, {"information_schema"}},
+		Expected: []sql.Row{{"mydb"}, {"foo"}, {"information_schema"}, {"performance_schema"}},
 	},
 	{
 		Query:    `SHOW GRANTS`,
@@ -2594,6 +2594,7 @@ var QueryTests = []QueryTest{
 			{"information_schema", "utf8mb4", "utf8mb4_0900_ai_ci"},
 			{"mydb", "utf8mb4", "utf8mb4_0900_ai_ci"},
 			{"foo", "utf8mb4", "utf8mb4_0900_ai_ci"},
+			{"performance_schema", "utf8mb4", "utf8mb4_0900_ai_ci"},
 		},
 	},
 	{
@@ -4264,10 +4265,10 @@ var QueryTests = []QueryTest{
 			{int64(7)},
 			{int64(3)},
 			{int64(2)},
+			{int64(4)},
 			{int64(8)},
 			{int64(6)},
 			{int64(5)},
-			{int64(4)},
 		},
 	},
 	{
@@ -5900,9 +5901,9 @@ var InfoSchemaScripts = []ScriptTest{
 			{
 				Query: "SELECT * FROM information_schema.key_column_usage where table_name='ptable2' ORDER BY constraint_name",
 				Expected: []sql.Row{
-					{"def", "mydb", "PRIMARY", "def", "mydb", "ptable2", "pk", 1, nil, nil, nil, nil},
 					{"def", "mydb", "fkr", "def", "mydb", "ptable2", "test_score2", 1, 1, "mydb", "ptable", "test_score"},
 					{"def", "mydb", "fkr", "def", "mydb", "ptable2", "height2", 2, 2, "mydb", "ptable", "height"},
+					{"def", "mydb", "PRIMARY", "def", "mydb", "ptable2", "pk", 1, nil, nil, nil, nil},
 				},
 			},
 		},
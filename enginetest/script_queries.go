@@ -1170,6 +1170,578 @@ var ScriptTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "SHOW CREATE TABLE keeps the case of ENUM/SET values",
+		SetUpScript: []string{
+			"CREATE TABLE sizes (pk int PRIMARY KEY, size ENUM('Small', 'LARGE') NOT NULL, colors SET('Red', 'blue'), v varchar(10) DEFAULT 'ABC')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SHOW CREATE TABLE sizes",
+				Expected: []sql.Row{{"sizes", "CREATE TABLE `sizes` (\n" +
+					"  `pk` int NOT NULL,\n" +
+					"  `size` enum('Small','LARGE') NOT NULL,\n" +
+					"  `colors` set('Red','blue'),\n" +
+					"  `v` varchar(10) DEFAULT \"ABC\",\n" +
+					"  PRIMARY KEY (`pk`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4"}},
+			},
+		},
+	},
+	{
+		Name: "PREPARE, EXECUTE and DEALLOCATE PREPARE",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk BIGINT PRIMARY KEY, v VARCHAR(10))",
+			"INSERT INTO t VALUES (1, 'a'), (2, 'b'), (3, 'c')",
+			"SET @a = 1, @b = 'c'",
+			"SET @s = 'SELECT v FROM t WHERE pk > ? AND v <> ? ORDER BY pk'",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "PREPARE s1 FROM @s",
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+			{
+				Query:    "EXECUTE s1 USING @a, @b",
+				Expected: []sql.Row{{"b"}},
+			},
+			{
+				Query:       "EXECUTE s1 USING @a",
+				ExpectedErr: sql.ErrWrongArgumentsToExecute,
+			},
+			{
+				Query:    "PREPARE s2 FROM 'UPDATE t SET v = ? WHERE pk = ?'",
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+			{
+				Query:    "EXECUTE s2 USING @b, @a",
+				Expected: []sql.Row{{sql.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
+			},
+			{
+				Query:    "PREPARE s3 FROM 'SELECT pk FROM t WHERE v IN (SELECT v FROM t WHERE pk = ?) ORDER BY pk'",
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+			{
+				Query:    "EXECUTE s3 USING @a",
+				Expected: []sql.Row{{int64(1)}, {int64(3)}},
+			},
+			{
+				Query:       "PREPARE s4 FROM 'EXECUTE s1'",
+				ExpectedErr: sql.ErrUnsupportedPreparedStatement,
+			},
+			{
+				Query:    "DEALLOCATE PREPARE s1",
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+			{
+				Query:       "EXECUTE s1 USING @a, @b",
+				ExpectedErr: sql.ErrUnknownPreparedStatement,
+			},
+			{
+				Query:       "DROP PREPARE s1",
+				ExpectedErr: sql.ErrUnknownPreparedStatement,
+			},
+		},
+	},
+	{
+		Name: "max_execution_time and the MAX_EXECUTION_TIME hint",
+		SetUpScript: []string{
+			"CREATE TABLE t (i INT)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "SELECT /*+ MAX_EXECUTION_TIME(10) */ SLEEP(10)",
+				ExpectedErr: sql.ErrQueryTimeout,
+			},
+			{
+				Query:    "SELECT /*+ MAX_EXECUTION_TIME(10000) */ SLEEP(0.01)",
+				Expected: []sql.Row{{int(0)}},
+			},
+			{
+				// Only optimizer hint comments set the limit
+				Query:    "SELECT /* MAX_EXECUTION_TIME(10) */ SLEEP(0.05), '/*+ MAX_EXECUTION_TIME(10) */'",
+				Expected: []sql.Row{{int(0), "/*+ MAX_EXECUTION_TIME(10) */"}},
+			},
+			{
+				Query:    "SET max_execution_time = 10",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:       "SELECT SLEEP(10)",
+				ExpectedErr: sql.ErrQueryTimeout,
+			},
+			{
+				Query:    "SELECT /*+ MAX_EXECUTION_TIME(0) */ SLEEP(0.05)",
+				Expected: []sql.Row{{int(0)}},
+			},
+			{
+				// Only SELECT statements are limited
+				Query:    "INSERT INTO t SELECT SLEEP(0.05)",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "PREPARE s FROM 'SELECT SLEEP(10)'",
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+			{
+				Query:       "EXECUTE s",
+				ExpectedErr: sql.ErrQueryTimeout,
+			},
+		},
+	},
+	{
+		Name: "strings compare, group, and are unique in the collations of their columns",
+		SetUpScript: []string{
+			"CREATE TABLE words (pk int primary key, ai varchar(20), cs varchar(20) COLLATE utf8mb4_0900_as_cs, UNIQUE KEY (ai))",
+			"INSERT INTO words VALUES (1, 'resume', 'resume'), (2, 'Straße', 'Resume'), (3, 'zebra', 'résumé'), (4, 'ñu', 'resume')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT pk FROM words WHERE ai = 'RÉSUMÉ'",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "SELECT pk FROM words WHERE ai = 'strasse  '",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT pk FROM words WHERE cs = 'resume' ORDER BY pk",
+				Expected: []sql.Row{{1}, {4}},
+			},
+			{
+				Query:    "SELECT pk FROM words WHERE ai LIKE 'r_sum%' OR ai LIKE 'N%'",
+				Expected: []sql.Row{{1}, {4}},
+			},
+			{
+				Query:    "SELECT pk FROM words WHERE cs LIKE 'r_sum_' ORDER BY pk",
+				Expected: []sql.Row{{1}, {3}, {4}},
+			},
+			{
+				Query:    "SELECT ai FROM words ORDER BY ai",
+				Expected: []sql.Row{{"ñu"}, {"resume"}, {"Straße"}, {"zebra"}},
+			},
+			{
+				Query:    "SELECT cs FROM words ORDER BY cs, pk",
+				Expected: []sql.Row{{"resume"}, {"resume"}, {"Resume"}, {"résumé"}},
+			},
+			{
+				Query:    "SELECT count(DISTINCT ai), count(DISTINCT cs) FROM words",
+				Expected: []sql.Row{{4, 3}},
+			},
+			{
+				Query:    "UPDATE words SET cs = UPPER(cs)",
+				Expected: []sql.Row{{newUpdateResult(4, 4)}},
+			},
+			{
+				Query:    "SELECT DISTINCT cs FROM words ORDER BY cs",
+				Expected: []sql.Row{{"RESUME"}, {"RÉSUMÉ"}},
+			},
+			{
+				Query:    "SELECT min(pk), count(*) FROM words GROUP BY cs ORDER BY 2",
+				Expected: []sql.Row{{3, 1}, {1, 3}},
+			},
+			{
+				Query:       "INSERT INTO words VALUES (5, 'RÉSUMÉ', 'x')",
+				ExpectedErr: sql.ErrUniqueKeyViolation,
+			},
+			{
+				Query:    "INSERT INTO words VALUES (5, 'résumés', 'x')",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+		},
+	},
+	{
+		Name: "IN subqueries and hash joins match strings equal in their collation",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, s varchar(20), cs varchar(20) COLLATE utf8mb4_0900_as_cs)",
+			"CREATE TABLE u (pk int primary key, s varchar(20), cs varchar(20) COLLATE utf8mb4_0900_as_cs)",
+			"INSERT INTO t VALUES (1, 'a', 'a'), (2, 'b', 'b'), (3, 'é', 'é')",
+			"INSERT INTO u VALUES (1, 'A', 'A'), (2, 'E', 'é')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT pk FROM t WHERE s IN (SELECT s FROM u) ORDER BY pk",
+				Expected: []sql.Row{{1}, {3}},
+			},
+			{
+				Query:    "SELECT pk FROM t WHERE s NOT IN (SELECT s FROM u) ORDER BY pk",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "SELECT pk FROM t WHERE cs IN (SELECT cs FROM u) ORDER BY pk",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "SELECT /*+ JOIN_ORDER(t, sq) */ t.pk, sq.pk FROM t JOIN (SELECT * FROM u) sq ON t.s = sq.s ORDER BY 1",
+				Expected: []sql.Row{{1, 1}, {3, 2}},
+			},
+			{
+				Query:    "SELECT /*+ JOIN_ORDER(t, sq) */ t.pk, sq.pk FROM t JOIN (SELECT * FROM u) sq ON t.cs = sq.cs AND t.s = sq.s ORDER BY 1",
+				Expected: []sql.Row{{3, 2}},
+			},
+		},
+	},
+	{
+		Name: "strings are converted between character sets",
+		SetUpScript: []string{
+			"CREATE TABLE legacy (pk int primary key, l varchar(4) CHARACTER SET latin1, c varchar(10) CHARACTER SET cp1251)",
+			"INSERT INTO legacy VALUES (1, 'café', 'Привет')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT l, c, HEX(l), HEX(c) FROM legacy",
+				Expected: []sql.Row{{"café", "Привет", "636166E9", "CFF0E8E2E5F2"}},
+			},
+			{
+				Query:       "INSERT INTO legacy VALUES (2, '日本', NULL)",
+				ExpectedErr: sql.ErrIncorrectStringValue,
+			},
+			{
+				Query:       "INSERT INTO legacy VALUES (2, 'cafés', NULL)",
+				ExpectedErr: sql.ErrLengthBeyondLimit,
+			},
+			{
+				Query:    "SELECT CONVERT('añ日' USING latin1), HEX(CONVERT('é' USING binary)), HEX(CONVERT(CONVERT('é' USING latin1) USING binary))",
+				Expected: []sql.Row{{"añ?", "C3A9", "E9"}},
+			},
+			{
+				Query:    "SELECT CONVERT(_binary X'CFF0E8E2E5F2' USING cp1251), CONVERT(l USING utf8mb4) FROM legacy",
+				Expected: []sql.Row{{"Привет", "café"}},
+			},
+			{
+				Query:    "SELECT _latin1 X'E9', _latin1'é', _sjis 0x93FA, HEX(_latin1'é')",
+				Expected: []sql.Row{{"é", "Ã©", "日", "C3A9"}},
+			},
+			{
+				Query:    "SELECT pk FROM legacy WHERE l = _latin1 X'636166E9'",
+				Expected: []sql.Row{{1}},
+			},
+		},
+	},
+	{
+		Name: "spatial columns, functions and indexes",
+		SetUpScript: []string{
+			"CREATE TABLE places (pk int primary key, name varchar(20), loc point NOT NULL SRID 4326, SPATIAL INDEX idx_loc (loc))",
+			"INSERT INTO places VALUES (1, 'office', ST_GeomFromText('POINT(40.7282 -73.9949)', 4326)), (2, 'park', ST_GeomFromText('POINT(40.7484 -73.9857)', 4326)), (3, 'airport', ST_GeomFromText('POINT(40.6413 -73.7781)', 4326))",
+			"CREATE TABLE shapes (pk int primary key, g geometry)",
+			"INSERT INTO shapes VALUES (1, ST_GeomFromText('POLYGON((0 0,10 0,10 10,0 10,0 0))')), (2, ST_GeomFromText('LINESTRING(0 0,5 5)')), (3, NULL)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT name, ST_AsText(loc), ST_Latitude(loc), ST_Longitude(loc), ST_SRID(loc) FROM places WHERE pk = 1",
+				Expected: []sql.Row{{"office", "POINT(40.7282 -73.9949)", 40.7282, -73.9949, uint32(4326)}},
+			},
+			{
+				Query:    "SELECT name FROM places WHERE ST_Within(loc, ST_GeomFromText('POLYGON((40.7 -74,40.8 -74,40.8 -73.9,40.7 -73.9,40.7 -74))', 4326)) ORDER BY pk",
+				Expected: []sql.Row{{"office"}, {"park"}},
+			},
+			{
+				Query:    "SELECT name FROM places WHERE MBRContains(ST_GeomFromText('POLYGON((40.6 -73.8,40.7 -73.8,40.7 -73.7,40.6 -73.7,40.6 -73.8))', 4326), loc)",
+				Expected: []sql.Row{{"airport"}},
+			},
+			{
+				Query:    "SELECT ROUND(ST_Distance_Sphere(a.loc, b.loc)) FROM places a, places b WHERE a.pk = 1 AND b.pk = 2",
+				Expected: []sql.Row{{float64(2376)}},
+			},
+			{
+				Query:       "INSERT INTO places VALUES (4, 'origin', POINT(0, 0))",
+				ExpectedErr: sql.ErrWrongSRIDForColumn,
+			},
+			{
+				Query:       "INSERT INTO places VALUES (4, 'nowhere', ST_GeomFromText('LINESTRING(0 0,1 1)', 4326))",
+				ExpectedErr: sql.ErrCannotGetGeometryObject,
+			},
+			{
+				Query: "SHOW CREATE TABLE places",
+				Expected: []sql.Row{{"places", "CREATE TABLE `places` (\n" +
+					"  `pk` int NOT NULL,\n" +
+					"  `name` varchar(20),\n" +
+					"  `loc` point NOT NULL /*!80003 SRID 4326 */,\n" +
+					"  PRIMARY KEY (`pk`),\n" +
+					"  SPATIAL KEY `idx_loc` (`loc`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4"}},
+			},
+			{
+				Query: "SELECT pk, ST_GeometryType(g), ST_AsText(ST_Envelope(g)), ST_Contains(g, POINT(2, 2)), ST_Contains(g, POINT(10, 5)), ST_Intersects(g, POINT(10, 5)) FROM shapes ORDER BY pk",
+				Expected: []sql.Row{
+					{1, "POLYGON", "POLYGON((0 0,10 0,10 10,0 10,0 0))", true, false, true},
+					{2, "LINESTRING", "POLYGON((0 0,5 0,5 5,0 5,0 0))", true, false, false},
+					{3, nil, nil, nil, nil, nil},
+				},
+			},
+			{
+				Query:       "SELECT ST_GeomFromText('POINT(1 2')",
+				ExpectedErr: sql.ErrInvalidGISData,
+			},
+			{
+				Query:       "CREATE SPATIAL INDEX idx_g ON shapes (g)",
+				ExpectedErr: sql.ErrSpatialIndexNullable,
+			},
+		},
+	},
+	{
+		Name: "FULLTEXT indexes and MATCH ... AGAINST",
+		SetUpScript: []string{
+			"CREATE TABLE articles (id int primary key, title varchar(200), body text, FULLTEXT KEY ft (title, body))",
+			"INSERT INTO articles VALUES (1, 'MySQL Tutorial', 'DBMS stands for DataBase ...'), (2, 'How To Use MySQL Well', 'After you went through a ...'), (3, 'Optimizing MySQL', 'In this tutorial, we show ...'), (4, '1001 MySQL Tricks', '1. Never run mysqld as root. 2. ...'), (5, 'MySQL vs. YourSQL', 'In the following database comparison ...'), (6, 'MySQL Security', 'When configured properly, MySQL ...')",
+			"CREATE TABLE stopwords (value varchar(30))",
+			"INSERT INTO stopwords VALUES ('mysql'), ('security')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT id FROM articles WHERE MATCH (title, body) AGAINST ('database') ORDER BY id",
+				Expected: []sql.Row{{1}, {5}},
+			},
+			{
+				Query:    "SELECT id, MATCH (body, title) AGAINST ('tutorial security') > 0 AS relevant FROM articles ORDER BY id",
+				Expected: []sql.Row{{1, true}, {2, false}, {3, true}, {4, false}, {5, false}, {6, true}},
+			},
+			{
+				Query:    "SELECT id FROM articles WHERE MATCH (title, body) AGAINST ('+mysql -yoursql -tricks' IN BOOLEAN MODE) ORDER BY id",
+				Expected: []sql.Row{{1}, {2}, {3}, {6}},
+			},
+			{
+				Query:    "SELECT id FROM articles WHERE MATCH (title, body) AGAINST ('data* \"run mysqld\"' IN BOOLEAN MODE) ORDER BY id",
+				Expected: []sql.Row{{1}, {4}, {5}},
+			},
+			{
+				Query:    "SELECT id FROM articles WHERE MATCH (title, body) AGAINST ('how')",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SET innodb_ft_enable_stopword = 0",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "SELECT id FROM articles WHERE MATCH (title, body) AGAINST ('how')",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "SET innodb_ft_enable_stopword = 1, innodb_ft_user_stopword_table = 'mydb/stopwords'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "SELECT id FROM articles WHERE MATCH (title, body) AGAINST ('how security')",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "SET innodb_ft_user_stopword_table = 'mydb/articles'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:       "SELECT id FROM articles WHERE MATCH (title, body) AGAINST ('security')",
+				ExpectedErr: sql.ErrInvalidStopwordTable,
+			},
+			{
+				Query:    "SET innodb_ft_user_stopword_table = ''",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:       "SELECT id FROM articles WHERE MATCH (title) AGAINST ('security')",
+				ExpectedErr: sql.ErrNoFullTextIndex,
+			},
+			{
+				Query:       "SELECT id FROM articles WHERE MATCH (title, body) AGAINST (title)",
+				ExpectedErr: sql.ErrFullTextAgainstArgument,
+			},
+			{
+				Query:       "CREATE FULLTEXT INDEX ft_id ON articles (id)",
+				ExpectedErr: sql.ErrFullTextColumnType,
+			},
+			{
+				Query:    "ALTER TABLE articles ADD FULLTEXT INDEX ft_title (title)",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT id FROM articles WHERE MATCH (title) AGAINST ('security')",
+				Expected: []sql.Row{{6}},
+			},
+			{
+				Query:    "INSERT INTO articles VALUES (7, 'Database Design', 'Normalizing tables ...')",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "DELETE FROM articles WHERE id = 1",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "UPDATE articles SET body = 'In the following comparison ...' WHERE id = 5",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "SELECT id FROM articles WHERE MATCH (title, body) AGAINST ('database') ORDER BY id",
+				Expected: []sql.Row{{7}},
+			},
+			{
+				Query:    "SELECT id FROM articles WHERE MATCH (title, body) AGAINST ('+comparison following' IN BOOLEAN MODE) ORDER BY id",
+				Expected: []sql.Row{{5}},
+			},
+			{
+				Query: "SHOW CREATE TABLE articles",
+				Expected: []sql.Row{{"articles", "CREATE TABLE `articles` (\n" +
+					"  `id` int NOT NULL,\n" +
+					"  `title` varchar(200),\n" +
+					"  `body` text,\n" +
+					"  PRIMARY KEY (`id`),\n" +
+					"  FULLTEXT KEY `ft` (`title`,`body`),\n" +
+					"  FULLTEXT KEY `ft_title` (`title`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4"}},
+			},
+		},
+	},
+	{
+		Name: "Table partitioning",
+		SetUpScript: []string{
+			"CREATE TABLE sales (id int primary key, region varchar(10)) PARTITION BY RANGE (id) (PARTITION p0 VALUES LESS THAN (10), PARTITION p1 VALUES LESS THAN (20), PARTITION p2 VALUES LESS THAN MAXVALUE)",
+			"INSERT INTO sales VALUES (1, 'north'), (5, 'south'), (15, 'east'), (25, 'west')",
+			"CREATE TABLE regions (id int, region varchar(10)) PARTITION BY LIST COLUMNS (region) (PARTITION pn VALUES IN ('north', 'east'), PARTITION ps VALUES IN ('south', 'west'))",
+			"INSERT INTO regions VALUES (1, 'north'), (2, 'south'), (3, 'east')",
+			"CREATE TABLE hashed (id int primary key) PARTITION BY HASH (id) PARTITIONS 3",
+			"INSERT INTO hashed VALUES (1), (2), (3), (4)",
+			"CREATE TABLE plain (id int primary key)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT * FROM sales PARTITION (p1)",
+				Expected: []sql.Row{{15, "east"}},
+			},
+			{
+				Query:    "SELECT s.id FROM sales PARTITION (p0, P2) AS s ORDER BY s.id",
+				Expected: []sql.Row{{1}, {5}, {25}},
+			},
+			{
+				Query:    "SELECT id FROM sales PARTITION (p0) WHERE id > 3",
+				Expected: []sql.Row{{5}},
+			},
+			{
+				Query:    "SELECT id FROM sales WHERE id BETWEEN 4 AND 16 ORDER BY id",
+				Expected: []sql.Row{{5}, {15}},
+			},
+			{
+				Query:    "SELECT id FROM regions PARTITION (ps) ORDER BY id",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "SELECT id FROM hashed PARTITION (p1) ORDER BY id",
+				Expected: []sql.Row{{1}, {4}},
+			},
+			{
+				Query:       "SELECT * FROM sales PARTITION (p9)",
+				ExpectedErr: sql.ErrUnknownPartition,
+			},
+			{
+				Query:       "SELECT * FROM plain PARTITION (p0)",
+				ExpectedErr: sql.ErrPartitionClauseOnNonPartitioned,
+			},
+			{
+				Query:       "INSERT INTO regions VALUES (4, 'central')",
+				ExpectedErr: sql.ErrNoPartitionForValue,
+			},
+			{
+				Query:       "CREATE TABLE bad (id int primary key, v int) PARTITION BY HASH (v) PARTITIONS 2",
+				ExpectedErr: sql.ErrUniqueKeyNeedsAllPartitionFields,
+			},
+			{
+				Query: "SHOW CREATE TABLE sales",
+				Expected: []sql.Row{{"sales", "CREATE TABLE `sales` (\n" +
+					"  `id` int NOT NULL,\n" +
+					"  `region` varchar(10),\n" +
+					"  PRIMARY KEY (`id`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4\n" +
+					"/*!50100 PARTITION BY RANGE (`id`)\n" +
+					"(PARTITION p0 VALUES LESS THAN (10) ENGINE = InnoDB,\n" +
+					" PARTITION p1 VALUES LESS THAN (20) ENGINE = InnoDB,\n" +
+					" PARTITION p2 VALUES LESS THAN MAXVALUE ENGINE = InnoDB) */"}},
+			},
+			{
+				// The output of SHOW CREATE TABLE creates the same table, with its partitioning in an executable comment
+				Query: "CREATE TABLE sales_copy (\n" +
+					"  `id` int NOT NULL,\n" +
+					"  `region` varchar(10),\n" +
+					"  PRIMARY KEY (`id`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4\n" +
+					"/*!50100 PARTITION BY RANGE (`id`)\n" +
+					"(PARTITION p0 VALUES LESS THAN (10) ENGINE = InnoDB,\n" +
+					" PARTITION p1 VALUES LESS THAN (20) ENGINE = InnoDB,\n" +
+					" PARTITION p2 VALUES LESS THAN MAXVALUE ENGINE = InnoDB) */",
+				Expected: []sql.Row{},
+			},
+			{
+				Query: "SHOW CREATE TABLE sales_copy",
+				Expected: []sql.Row{{"sales_copy", "CREATE TABLE `sales_copy` (\n" +
+					"  `id` int NOT NULL,\n" +
+					"  `region` varchar(10),\n" +
+					"  PRIMARY KEY (`id`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4\n" +
+					"/*!50100 PARTITION BY RANGE (`id`)\n" +
+					"(PARTITION p0 VALUES LESS THAN (10) ENGINE = InnoDB,\n" +
+					" PARTITION p1 VALUES LESS THAN (20) ENGINE = InnoDB,\n" +
+					" PARTITION p2 VALUES LESS THAN MAXVALUE ENGINE = InnoDB) */"}},
+			},
+			{
+				Query:    "SELECT * FROM sales_copy PARTITION (p1)",
+				Expected: []sql.Row{},
+			},
+			{
+				Query: "SELECT table_name, partition_name, partition_ordinal_position, partition_method, partition_expression, partition_description, table_rows FROM information_schema.partitions WHERE table_schema = 'mydb' AND table_name IN ('sales', 'regions') ORDER BY 1, 3",
+				Expected: []sql.Row{
+					{"regions", "pn", uint64(1), "LIST COLUMNS", "`region`", "'north','east'", uint64(2)},
+					{"regions", "ps", uint64(2), "LIST COLUMNS", "`region`", "'south','west'", uint64(1)},
+					{"sales", "p0", uint64(1), "RANGE", "`id`", "10", uint64(2)},
+					{"sales", "p1", uint64(2), "RANGE", "`id`", "20", uint64(1)},
+					{"sales", "p2", uint64(3), "RANGE", "`id`", "MAXVALUE", uint64(1)},
+				},
+			},
+			{
+				Query:    "ALTER TABLE sales REORGANIZE PARTITION p2 INTO (PARTITION p2 VALUES LESS THAN (30), PARTITION p3 VALUES LESS THAN MAXVALUE)",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "INSERT INTO sales VALUES (35, 'north')",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT id FROM sales PARTITION (p3)",
+				Expected: []sql.Row{{35}},
+			},
+			{
+				Query:    "ALTER TABLE sales DROP PARTITION p0",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "ALTER TABLE sales TRUNCATE PARTITION p3",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT id FROM sales ORDER BY id",
+				Expected: []sql.Row{{15}, {25}},
+			},
+			{
+				Query:       "ALTER TABLE sales COALESCE PARTITION 1",
+				ExpectedErr: sql.ErrCoalesceOnlyOnHashPartition,
+			},
+			{
+				Query:    "ALTER TABLE hashed COALESCE PARTITION 1",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT partition_name, table_rows FROM information_schema.partitions WHERE table_name = 'hashed' ORDER BY 1",
+				Expected: []sql.Row{{"p0", uint64(2)}, {"p1", uint64(2)}},
+			},
+			{
+				Query:    "ALTER TABLE regions REMOVE PARTITIONING",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT count(*) FROM information_schema.partitions WHERE table_name = 'regions'",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:       "ALTER TABLE regions DROP PARTITION pn",
+				ExpectedErr: sql.ErrPartitionManagementOnNonPartitioned,
+			},
+		},
+	},
 }
 
 var CreateCheckConstraintsScripts = []ScriptTest{
@@ -0,0 +1,125 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/linanh/go-mysql-server/sql"
+	"github.com/linanh/go-mysql-server/sql/parse"
+	"github.com/linanh/go-mysql-server/sql/plan"
+)
+
+// executionTimeLimit returns the limit on the execution time of a query, or zero if there's none. As in MySQL, only
+// SELECT statements have a limit, set by their MAX_EXECUTION_TIME hint or else by the max_execution_time system
+// variable, in milliseconds.
+func executionTimeLimit(ctx *sql.Context, query string) (time.Duration, error) {
+	if parse.StatementType(query) != "select" {
+		return 0, nil
+	}
+
+	limit, ok, err := parse.MaxExecutionTimeHint(query)
+	if err != nil || ok {
+		return limit, err
+	}
+
+	val, err := ctx.GetSessionVariable(ctx, "max_execution_time")
+	if err != nil {
+		return 0, err
+	}
+	ms, err := sql.Int64.Convert(val)
+	if err != nil || ms.(int64) <= 0 {
+		return 0, err
+	}
+	return time.Duration(ms.(int64)) * time.Millisecond, nil
+}
+
+// withExecutionTimeLimit returns the context to analyze and run a statement started at the time given with, which is
+// cancelled once the limit given has passed since then, along with the function releasing it. With no limit, the
+// context given is returned, and the function is nil.
+func withExecutionTimeLimit(ctx *sql.Context, start time.Time, limit time.Duration) (*sql.Context, context.CancelFunc) {
+	if limit <= 0 {
+		return ctx, nil
+	}
+	newCtx, cancel := context.WithDeadline(ctx, start.Add(limit))
+	return ctx.WithContext(newCtx), cancel
+}
+
+// executedQuery returns the text of the statement prepared in the session which an EXECUTE statement runs, or the
+// query given for any other statement.
+func executedQuery(ctx *sql.Context, query string, parsed sql.Node) string {
+	if execute, ok := parsed.(*plan.ExecuteQuery); ok {
+		if stmt, ok := sql.SessionPreparedStatement(ctx, execute.Name); ok {
+			return stmt.Query
+		}
+	}
+	return query
+}
+
+// limitExecutionTime returns the rows of an analyzed plan, run with a context from withExecutionTimeLimit, which fail
+// with an error of kind sql.ErrQueryTimeout once its limit has passed. The context is released with the function given
+// once the rows are closed.
+func limitExecutionTime(ctx *sql.Context, analyzed sql.Node, cancel context.CancelFunc) (sql.RowIter, error) {
+	iter, err := analyzed.RowIter(ctx, nil)
+	if err != nil {
+		cancel()
+		return nil, timeLimitError(ctx, err)
+	}
+	return &timeLimitIter{childIter: iter, ctx: ctx, cancel: cancel}, nil
+}
+
+// timeLimitIter is the sql.RowIter of a query with an execution time limit. Not every iterator stops when its context
+// is cancelled, so the limit is also checked before every row.
+type timeLimitIter struct {
+	childIter sql.RowIter
+	ctx       *sql.Context
+	cancel    context.CancelFunc
+}
+
+func (t *timeLimitIter) Next() (sql.Row, error) {
+	if t.ctx.Err() == context.DeadlineExceeded {
+		return nil, sql.ErrQueryTimeout.New()
+	}
+	row, err := t.childIter.Next()
+	if err != nil && err != io.EOF {
+		return nil, timeLimitError(t.ctx, err)
+	}
+	return row, err
+}
+
+func (t *timeLimitIter) Close(ctx *sql.Context) error {
+	defer t.cancel()
+	return t.childIter.Close(ctx)
+}
+
+// analysisError returns the error of the analysis of a statement, which is of kind sql.ErrQueryTimeout if the execution
+// time limit of the context given has passed, releasing the context with the function given if it's not nil.
+func analysisError(ctx *sql.Context, err error, cancel context.CancelFunc) error {
+	if cancel == nil {
+		return err
+	}
+	defer cancel()
+	return timeLimitError(ctx, err)
+}
+
+// timeLimitError returns an error of kind sql.ErrQueryTimeout for the error given if the context's limit has passed.
+func timeLimitError(ctx *sql.Context, err error) error {
+	if ctx.Err() == context.DeadlineExceeded {
+		return sql.ErrQueryTimeout.New()
+	}
+	return err
+}
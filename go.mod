@@ -34,4 +34,7 @@ require (
 
 replace github.com/oliveagle/jsonpath => github.com/dolthub/jsonpath v0.0.0-20210609232853-d49537a30474
 
+// The server needs hooks which the pinned vitess doesn't have, see third_party/vitess/README.md
+replace github.com/dolthub/vitess => ./third_party/vitess
+
 go 1.15
@@ -0,0 +1,208 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ber implements the subset of ASN.1 Basic Encoding Rules needed to speak LDAP: single byte tags and definite
+// lengths.
+package ber
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Universal tags.
+const (
+	TagBoolean     byte = 0x01
+	TagInteger     byte = 0x02
+	TagOctetString byte = 0x04
+	TagNull        byte = 0x05
+	TagEnumerated  byte = 0x0a
+	TagSequence    byte = 0x30
+	TagSet         byte = 0x31
+)
+
+// Class and form bits of tags.
+const (
+	ClassApplication byte = 0x40
+	ClassContext     byte = 0x80
+	Constructed      byte = 0x20
+)
+
+// maxLength is the largest element accepted, to keep malformed input from allocating too much.
+const maxLength = 16 << 20
+
+// ErrMalformed is returned when decoding something which isn't valid BER.
+var ErrMalformed = errors.New("malformed BER element")
+
+// Element is a decoded BER element.
+type Element struct {
+	Tag   byte
+	Value []byte
+}
+
+// Children decodes the elements of a constructed element.
+func (e Element) Children() ([]Element, error) {
+	var children []Element
+	data := e.Value
+	for len(data) > 0 {
+		child, n, err := decode(data)
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, child)
+		data = data[n:]
+	}
+	return children, nil
+}
+
+// Int decodes the value of an integer or enumerated element.
+func (e Element) Int() (int64, error) {
+	if len(e.Value) == 0 || len(e.Value) > 8 {
+		return 0, ErrMalformed
+	}
+	// Sign extend from the first byte
+	n := int64(int8(e.Value[0]))
+	for _, b := range e.Value[1:] {
+		n = n<<8 | int64(b)
+	}
+	return n, nil
+}
+
+// String returns the value of an octet string element.
+func (e Element) String() string {
+	return string(e.Value)
+}
+
+// Bool decodes the value of a boolean element.
+func (e Element) Bool() bool {
+	return len(e.Value) > 0 && e.Value[0] != 0
+}
+
+// Read reads a single element from a stream.
+func Read(r *bufio.Reader) (Element, error) {
+	tag, err := r.ReadByte()
+	if err != nil {
+		return Element{}, err
+	}
+	if tag&0x1f == 0x1f {
+		return Element{}, fmt.Errorf("%w: multi-byte tags are not supported", ErrMalformed)
+	}
+
+	first, err := r.ReadByte()
+	if err != nil {
+		return Element{}, err
+	}
+	length := int(first)
+	if first&0x80 != 0 {
+		n := int(first & 0x7f)
+		if n == 0 || n > 4 {
+			return Element{}, fmt.Errorf("%w: unsupported length", ErrMalformed)
+		}
+		length = 0
+		for i := 0; i < n; i++ {
+			b, err := r.ReadByte()
+			if err != nil {
+				return Element{}, err
+			}
+			length = length<<8 | int(b)
+		}
+	}
+	if length > maxLength {
+		return Element{}, fmt.Errorf("%w: element of %d bytes is too long", ErrMalformed, length)
+	}
+
+	value := make([]byte, length)
+	if _, err := io.ReadFull(r, value); err != nil {
+		return Element{}, err
+	}
+	return Element{Tag: tag, Value: value}, nil
+}
+
+// decode decodes the element at the start of data, returning it and its encoded length.
+func decode(data []byte) (Element, int, error) {
+	if len(data) < 2 || data[0]&0x1f == 0x1f {
+		return Element{}, 0, ErrMalformed
+	}
+	pos := 2
+	length := int(data[1])
+	if data[1]&0x80 != 0 {
+		n := int(data[1] & 0x7f)
+		if n == 0 || n > 4 || len(data) < 2+n {
+			return Element{}, 0, ErrMalformed
+		}
+		length = 0
+		for _, b := range data[2 : 2+n] {
+			length = length<<8 | int(b)
+		}
+		pos += n
+	}
+	if length < 0 || len(data)-pos < length {
+		return Element{}, 0, ErrMalformed
+	}
+	return Element{Tag: data[0], Value: data[pos : pos+length]}, pos + length, nil
+}
+
+// Encode returns the encoding of an element with the tag and contents given.
+func Encode(tag byte, value []byte) []byte {
+	var header []byte
+	switch l := len(value); {
+	case l < 0x80:
+		header = []byte{tag, byte(l)}
+	case l <= 0xff:
+		header = []byte{tag, 0x81, byte(l)}
+	case l <= 0xffff:
+		header = []byte{tag, 0x82, byte(l >> 8), byte(l)}
+	default:
+		header = []byte{tag, 0x84, byte(l >> 24), byte(l >> 16), byte(l >> 8), byte(l)}
+	}
+	return append(header, value...)
+}
+
+// EncodeConstructed returns the encoding of a constructed element with the tag and encoded children given.
+func EncodeConstructed(tag byte, children ...[]byte) []byte {
+	var value []byte
+	for _, c := range children {
+		value = append(value, c...)
+	}
+	return Encode(tag, value)
+}
+
+// EncodeInt returns the encoding of an integer or enumerated element.
+func EncodeInt(tag byte, n int64) []byte {
+	var value []byte
+	for {
+		value = append([]byte{byte(n)}, value...)
+		// Stop once the remaining bits are just the sign extension of the ones encoded
+		if (n >= -0x80 && n < 0x80) || len(value) == 8 {
+			break
+		}
+		n >>= 8
+	}
+	return Encode(tag, value)
+}
+
+// EncodeString returns the encoding of an octet string element.
+func EncodeString(tag byte, s string) []byte {
+	return Encode(tag, []byte(s))
+}
+
+// EncodeBool returns the encoding of a boolean element.
+func EncodeBool(tag byte, b bool) []byte {
+	if b {
+		return Encode(tag, []byte{0xff})
+	}
+	return Encode(tag, []byte{0})
+}
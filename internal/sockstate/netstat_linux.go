@@ -287,6 +287,7 @@ func GetConnInode(c *net.TCPConn) (n uint64, err error) {
 	if err != nil {
 		return
 	}
+	defer f.Close()
 
 	socketStr := fmt.Sprintf("/proc/%d/fd/%d", os.Getpid(), f.Fd())
 	socketLnk, err := os.Readlink(socketStr)
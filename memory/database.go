@@ -167,7 +167,7 @@ func (d *Database) RenameTable(ctx *sql.Context, oldName, newName string) error
 		return sql.ErrTableAlreadyExists.New(newName)
 	}
 
-	tbl.(*Table).name = newName
+	tbl.(*Table).Rename(newName)
 	d.tables[newName] = tbl
 	delete(d.tables, oldName)
 
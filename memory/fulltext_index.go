@@ -0,0 +1,253 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// FullTextIndex is an index of the words of text columns, which searches the rows of a partition with an inverted index
+// of them. The inverted indexes are built the first time the partitions are searched, and kept up to date as rows are
+// inserted, updated and deleted.
+type FullTextIndex struct {
+	MergeableIndex
+	// inverted holds the inverted indexes of the partitions for each configuration of the parser they were searched
+	// with, since the words indexed depend on it
+	inverted *fullTextInvertedIndexes
+}
+
+var _ sql.Index = (*FullTextIndex)(nil)
+var _ sql.FullTextIndex = (*FullTextIndex)(nil)
+
+// fullTextInvertedIndexes are the inverted indexes of the partitions of a FULLTEXT index, by parser configuration.
+type fullTextInvertedIndexes struct {
+	mu      sync.Mutex
+	parsers map[string]*parserInvertedIndexes
+	// last are the inverted indexes of the last parser searched with, which is usually searched with again
+	lastParser *sql.FullTextParser
+	last       *parserInvertedIndexes
+}
+
+// parserInvertedIndexes are the inverted indexes of the partitions of a FULLTEXT index whose documents were split
+// into words by a parser.
+type parserInvertedIndexes struct {
+	parser     *sql.FullTextParser
+	partitions *partitionCache
+}
+
+func newFullTextInvertedIndexes() *fullTextInvertedIndexes {
+	return &fullTextInvertedIndexes{parsers: make(map[string]*parserInvertedIndexes)}
+}
+
+// IndexType implements sql.Index.
+func (i *FullTextIndex) IndexType() string {
+	return "FULLTEXT"
+}
+
+// Statistics implements sql.FullTextIndex. The statistics are the ones of the inverted indexes of all the partitions.
+func (i *FullTextIndex) Statistics(ctx *sql.Context, parser *sql.FullTextParser) (sql.FullTextStatistics, error) {
+	stats := make(fullTextStatistics, 0, len(i.Tbl.keys))
+	for _, key := range i.Tbl.keys {
+		index, err := i.invertedIndex(ctx, parser, string(key))
+		if err != nil {
+			return nil, err
+		}
+		stats = append(stats, index)
+	}
+	return stats, nil
+}
+
+// Search implements sql.FullTextIndex.
+func (i *FullTextIndex) Search(ctx *sql.Context, parser *sql.FullTextParser, query *sql.FullTextQuery) (sql.IndexLookup, error) {
+	return &FullTextIndexLookup{idx: i, parser: parser, query: query}, nil
+}
+
+// invertedIndex returns the inverted index of the rows of the partition with the key given, whose documents are split
+// into words by the parser given.
+func (i *FullTextIndex) invertedIndex(ctx *sql.Context, parser *sql.FullTextParser, key string) (*invertedIndex, error) {
+	var partitions *partitionCache
+	if i.inverted != nil {
+		i.inverted.mu.Lock()
+		if i.inverted.lastParser != parser {
+			name := parserKey(parser)
+			indexes, ok := i.inverted.parsers[name]
+			if !ok {
+				indexes = &parserInvertedIndexes{parser: parser, partitions: newPartitionCache()}
+				i.inverted.parsers[name] = indexes
+			}
+			i.inverted.lastParser, i.inverted.last = parser, indexes
+		}
+		partitions = i.inverted.last.partitions
+		i.inverted.mu.Unlock()
+	}
+
+	index, err := partitions.get(i.Tbl, key, func() (interface{}, error) {
+		index := newInvertedIndex()
+		for _, row := range i.Tbl.partitions[key] {
+			d, err := i.document(ctx, parser, row)
+			if err != nil {
+				return nil, err
+			}
+			index.Add(d)
+		}
+		return index, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return index.(*invertedIndex), nil
+}
+
+// rowWritten updates the inverted indexes of the partition with the key given after the row at the position given was
+// inserted, updated or deleted. old is nil for inserted rows and new for deleted ones, and version is the version of
+// the rows of the partition before the change.
+func (i *FullTextIndex) rowWritten(ctx *sql.Context, key string, version uint64, pos int, old, new sql.Row) {
+	if i.inverted == nil {
+		return
+	}
+
+	i.inverted.mu.Lock()
+	indexes := make([]*parserInvertedIndexes, 0, len(i.inverted.parsers))
+	for _, idx := range i.inverted.parsers {
+		indexes = append(indexes, idx)
+	}
+	i.inverted.mu.Unlock()
+
+	for _, idx := range indexes {
+		parser := idx.parser
+		idx.partitions.update(i.Tbl, key, version, func(data interface{}) error {
+			index := data.(*invertedIndex)
+			if new == nil {
+				index.Remove(pos)
+				return nil
+			}
+			d, err := i.document(ctx, parser, new)
+			if err != nil {
+				return err
+			}
+			if old == nil {
+				index.Add(d)
+			} else {
+				index.Replace(pos, d)
+			}
+			return nil
+		})
+	}
+}
+
+// document returns the document of the text of the indexed columns of a row.
+func (i *FullTextIndex) document(ctx *sql.Context, parser *sql.FullTextParser, row sql.Row) (*sql.FullTextDocument, error) {
+	texts := make([]string, 0, len(i.Exprs))
+	for _, expr := range i.Exprs {
+		v, err := expr.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if v == nil {
+			continue
+		}
+		text, err := sql.LongText.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		texts = append(texts, text.(string))
+	}
+	return sql.NewFullTextDocument(parser, texts...), nil
+}
+
+// parserKey returns a key identifying the configuration of a parser, which tells how it splits text into words.
+func parserKey(parser *sql.FullTextParser) string {
+	stopwords := make([]string, 0, len(parser.Stopwords))
+	for w, ok := range parser.Stopwords {
+		if ok {
+			stopwords = append(stopwords, w)
+		}
+	}
+	sort.Strings(stopwords)
+	return fmt.Sprintf("%d %d %s", parser.MinTokenSize, parser.MaxTokenSize, strings.Join(stopwords, "\x00"))
+}
+
+// fullTextStatistics are the statistics of the inverted indexes of several partitions.
+type fullTextStatistics []*invertedIndex
+
+var _ sql.FullTextStatistics = fullTextStatistics(nil)
+
+// DocumentCount implements sql.FullTextStatistics.
+func (s fullTextStatistics) DocumentCount() int {
+	count := 0
+	for _, index := range s {
+		count += index.DocumentCount()
+	}
+	return count
+}
+
+// DocumentFrequency implements sql.FullTextStatistics.
+func (s fullTextStatistics) DocumentFrequency(t *sql.FullTextTerm) int {
+	frequency := 0
+	for _, index := range s {
+		frequency += index.DocumentFrequency(t)
+	}
+	return frequency
+}
+
+// FullTextIndexLookup is a lookup of the rows which match a FULLTEXT search. It can't be merged with other lookups.
+type FullTextIndexLookup struct {
+	idx    *FullTextIndex
+	parser *sql.FullTextParser
+	query  *sql.FullTextQuery
+}
+
+var _ sql.IndexLookup = (*FullTextIndexLookup)(nil)
+var _ sql.MergeableIndexLookup = (*FullTextIndexLookup)(nil)
+var _ sql.DriverIndexLookup = (*FullTextIndexLookup)(nil)
+
+func (l *FullTextIndexLookup) IsMergeable(_ sql.IndexLookup) bool {
+	return false
+}
+
+func (l *FullTextIndexLookup) Intersection(_ ...sql.IndexLookup) (sql.IndexLookup, error) {
+	panic("not mergeable!")
+}
+
+func (l *FullTextIndexLookup) Union(_ ...sql.IndexLookup) (sql.IndexLookup, error) {
+	panic("not mergeable!")
+}
+
+func (l *FullTextIndexLookup) Indexes() []string {
+	return l.idx.Expressions()
+}
+
+func (l *FullTextIndexLookup) String() string {
+	return fmt.Sprintf("%s MATCH %s", l.idx.ID(), l.query.Mode)
+}
+
+// Values implements sql.IndexLookup.
+func (l *FullTextIndexLookup) Values(p sql.Partition) (sql.IndexValueIter, error) {
+	key := string(p.Key())
+	if _, ok := l.idx.Tbl.partitions[key]; !ok {
+		return nil, sql.ErrPartitionNotFound.New(p.Key())
+	}
+
+	index, err := l.idx.invertedIndex(sql.NewEmptyContext(), l.parser, key)
+	if err != nil {
+		return nil, err
+	}
+	return &positionIndexValIter{positions: index.Search(l.query)}, nil
+}
@@ -0,0 +1,184 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// invertedIndex maps the words of the documents of a FULLTEXT index to the positions of the documents containing them.
+type invertedIndex struct {
+	documents []*sql.FullTextDocument
+	postings  map[string][]int
+}
+
+var _ sql.FullTextStatistics = (*invertedIndex)(nil)
+
+func newInvertedIndex() *invertedIndex {
+	return &invertedIndex{postings: make(map[string][]int)}
+}
+
+// Add adds the next document to the index, at the position following the last one.
+func (i *invertedIndex) Add(d *sql.FullTextDocument) {
+	pos := len(i.documents)
+	i.documents = append(i.documents, d)
+	for w := range d.Tokens() {
+		i.postings[w] = append(i.postings[w], pos)
+	}
+}
+
+// Remove removes the document at the position given from the index. The documents following it move back a position,
+// like the rows following a deleted one.
+func (i *invertedIndex) Remove(pos int) {
+	for w := range i.documents[pos].Tokens() {
+		if p := removePosition(i.postings[w], pos); len(p) > 0 {
+			i.postings[w] = p
+		} else {
+			delete(i.postings, w)
+		}
+	}
+	i.documents = append(i.documents[:pos], i.documents[pos+1:]...)
+	for _, p := range i.postings {
+		for j := sort.SearchInts(p, pos); j < len(p); j++ {
+			p[j]--
+		}
+	}
+}
+
+// Replace replaces the document at the position given with another one.
+func (i *invertedIndex) Replace(pos int, d *sql.FullTextDocument) {
+	for w := range i.documents[pos].Tokens() {
+		if p := removePosition(i.postings[w], pos); len(p) > 0 {
+			i.postings[w] = p
+		} else {
+			delete(i.postings, w)
+		}
+	}
+	i.documents[pos] = d
+	for w := range d.Tokens() {
+		p := i.postings[w]
+		j := sort.SearchInts(p, pos)
+		p = append(p, 0)
+		copy(p[j+1:], p[j:])
+		p[j] = pos
+		i.postings[w] = p
+	}
+}
+
+// removePosition removes a position from a list of positions in ascending order.
+func removePosition(positions []int, pos int) []int {
+	j := sort.SearchInts(positions, pos)
+	if j == len(positions) || positions[j] != pos {
+		return positions
+	}
+	return append(positions[:j], positions[j+1:]...)
+}
+
+// DocumentCount implements sql.FullTextStatistics.
+func (i *invertedIndex) DocumentCount() int {
+	return len(i.documents)
+}
+
+// DocumentFrequency implements sql.FullTextStatistics.
+func (i *invertedIndex) DocumentFrequency(t *sql.FullTextTerm) int {
+	return len(i.containing(t))
+}
+
+// containing returns the positions of the documents containing a word, prefix or phrase, in ascending order.
+func (i *invertedIndex) containing(t *sql.FullTextTerm) []int {
+	switch {
+	case t.Group != nil:
+		return nil
+	case t.Phrase != nil:
+		// Every document containing the phrase contains its indexed words, so they're only looked for in the
+		// documents containing the least frequent one.
+		candidates := i.allPositions()
+		for _, w := range t.Phrase {
+			if p, ok := i.postings[w]; ok && len(p) < len(candidates) {
+				candidates = p
+			}
+		}
+		var positions []int
+		for _, pos := range candidates {
+			if i.documents[pos].Count(t) > 0 {
+				positions = append(positions, pos)
+			}
+		}
+		return positions
+	case t.Prefix:
+		var lists [][]int
+		for w, p := range i.postings {
+			if strings.HasPrefix(w, t.Word) {
+				lists = append(lists, p)
+			}
+		}
+		return unionPositions(lists...)
+	default:
+		return i.postings[t.Word]
+	}
+}
+
+func (i *invertedIndex) allPositions() []int {
+	positions := make([]int, len(i.documents))
+	for pos := range positions {
+		positions[pos] = pos
+	}
+	return positions
+}
+
+// Search returns the positions of the documents which match a query, in ascending order. Only the documents containing
+// one of the terms of the query which aren't excluded are considered.
+func (i *invertedIndex) Search(q *sql.FullTextQuery) []int {
+	var positions []int
+	for _, pos := range unionPositions(i.candidates(q.Terms)...) {
+		if q.Matches(i.documents[pos]) {
+			positions = append(positions, pos)
+		}
+	}
+	return positions
+}
+
+func (i *invertedIndex) candidates(terms []*sql.FullTextTerm) [][]int {
+	var lists [][]int
+	for _, t := range terms {
+		switch {
+		case t.Operator == sql.FullTextExcluded:
+		case t.Group != nil:
+			lists = append(lists, i.candidates(t.Group)...)
+		default:
+			lists = append(lists, i.containing(t))
+		}
+	}
+	return lists
+}
+
+// unionPositions returns the positions in any of the lists given, in ascending order.
+func unionPositions(lists ...[]int) []int {
+	seen := make(map[int]bool)
+	var positions []int
+	for _, l := range lists {
+		for _, pos := range l {
+			if !seen[pos] {
+				seen[pos] = true
+				positions = append(positions, pos)
+			}
+		}
+	}
+	sort.Ints(positions)
+	return positions
+}
@@ -0,0 +1,91 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+func TestInvertedIndex(t *testing.T) {
+	require := require.New(t)
+
+	parser := &sql.FullTextParser{MinTokenSize: 3, MaxTokenSize: 84}
+	index := newInvertedIndex()
+	for _, text := range []string{
+		"MySQL Tutorial: DBMS stands for DataBase",
+		"How To Use MySQL Well",
+		"Optimizing MySQL databases",
+		"MySQL vs. YourSQL: a database comparison",
+		"Oracle Security",
+	} {
+		index.Add(sql.NewFullTextDocument(parser, text))
+	}
+	require.Equal(5, index.DocumentCount())
+
+	testCases := []struct {
+		query     string
+		mode      sql.FullTextMode
+		frequency int
+		expected  []int
+	}{
+		{"database", sql.FullTextNaturalLanguageMode, 2, []int{0, 3}},
+		{"database security", sql.FullTextNaturalLanguageMode, 2, []int{0, 3, 4}},
+		{"data*", sql.FullTextBooleanMode, 3, []int{0, 2, 3}},
+		{`"database comparison"`, sql.FullTextBooleanMode, 1, []int{3}},
+		{"+mysql -yoursql -(tutorial well)", sql.FullTextBooleanMode, 4, []int{2}},
+		{"-mysql", sql.FullTextBooleanMode, 4, nil},
+		{"missing", sql.FullTextNaturalLanguageMode, 0, nil},
+	}
+
+	for _, tt := range testCases {
+		q := sql.ParseFullTextQuery(parser, tt.query, tt.mode)
+		require.Equal(tt.frequency, index.DocumentFrequency(q.Terms[0]), tt.query)
+		require.Equal(tt.expected, index.Search(q), tt.query)
+	}
+}
+
+func TestInvertedIndexChanges(t *testing.T) {
+	require := require.New(t)
+
+	parser := &sql.FullTextParser{MinTokenSize: 3, MaxTokenSize: 84}
+	texts := []string{
+		"MySQL Tutorial: DBMS stands for DataBase",
+		"How To Use MySQL Well",
+		"Optimizing MySQL databases",
+		"MySQL vs. YourSQL: a database comparison",
+	}
+	index := newInvertedIndex()
+	for _, text := range texts {
+		index.Add(sql.NewFullTextDocument(parser, text))
+	}
+
+	index.Remove(1)
+	texts = append(texts[:1], texts[2:]...)
+	index.Replace(0, sql.NewFullTextDocument(parser, "Oracle Security"))
+	texts[0] = "Oracle Security"
+	index.Add(sql.NewFullTextDocument(parser, "Securing MySQL"))
+	texts = append(texts, "Securing MySQL")
+
+	// The index is the one of the documents it ends up with
+	expected := newInvertedIndex()
+	for _, text := range texts {
+		expected.Add(sql.NewFullTextDocument(parser, text))
+	}
+	require.Equal(expected, index)
+}
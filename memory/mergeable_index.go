@@ -37,6 +37,7 @@ var _ sql.Index = (*MergeableIndex)(nil)
 var _ sql.AscendIndex = (*MergeableIndex)(nil)
 var _ sql.DescendIndex = (*MergeableIndex)(nil)
 var _ sql.NegateIndex = (*MergeableIndex)(nil)
+var _ sql.IndexStatistics = (*MergeableIndex)(nil)
 
 func (i *MergeableIndex) Database() string                    { return i.DB }
 func (i *MergeableIndex) Driver() string                      { return i.DriverName }
@@ -60,6 +61,39 @@ func (i *MergeableIndex) Comment() string {
 	return i.CommentStr
 }
 
+// Cardinality implements the sql.IndexStatistics interface. It's cached until the rows of the table change.
+func (i *MergeableIndex) Cardinality(ctx *sql.Context) (uint64, error) {
+	if i.Tbl == nil {
+		return 0, nil
+	}
+
+	cardinality, err := i.Tbl.statistics.get(i.Tbl, "cardinality "+strings.Join(i.Expressions(), ", "), func() (interface{}, error) {
+		keys := make(map[uint64]struct{})
+		for _, partition := range i.Tbl.partitions {
+			for _, row := range partition {
+				key := make(sql.Row, len(i.Exprs))
+				for j, expr := range i.Exprs {
+					v, err := expr.Eval(ctx, row)
+					if err != nil {
+						return nil, err
+					}
+					key[j] = v
+				}
+				hash, err := sql.HashOf(key)
+				if err != nil {
+					return nil, err
+				}
+				keys[hash] = struct{}{}
+			}
+		}
+		return uint64(len(keys)), nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return cardinality.(uint64), nil
+}
+
 func (i *MergeableIndex) IndexType() string {
 	if len(i.DriverName) > 0 {
 		return i.DriverName
@@ -0,0 +1,42 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import "github.com/linanh/go-mysql-server/sql"
+
+// NotifyListener returns a RowChangeListener calling the Notify given with the row changes of every committed
+// transaction, in the order they were made. Tables are given by name, like Table.SetNotify does. Resets aren't
+// notified, since a Notify is only given rows. The changes of a transaction stop being notified at the first error,
+// which is returned.
+func NotifyListener(notify Notify) sql.RowChangeListener {
+	return sql.RowChangeListenerFunc(func(ctx *sql.Context, tx sql.CommittedTransaction) error {
+		for _, change := range tx.Changes {
+			var err error
+			switch {
+			case change.Reset:
+			case change.Old == nil:
+				err = notify.Insert(change.Table, change.New)
+			case change.New == nil:
+				err = notify.Delete(change.Table, change.Old)
+			default:
+				err = notify.Update(change.Table, change.Old, change.New)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,67 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/linanh/go-mysql-server/memory"
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+type recordingNotify struct {
+	calls []string
+	fail  bool
+}
+
+func (n *recordingNotify) Insert(tableName string, row sql.Row) error {
+	n.calls = append(n.calls, fmt.Sprintf("insert %s %v", tableName, row))
+	return nil
+}
+
+func (n *recordingNotify) Delete(tableName string, row sql.Row) error {
+	n.calls = append(n.calls, fmt.Sprintf("delete %s %v", tableName, row))
+	return nil
+}
+
+func (n *recordingNotify) Update(tableName string, oldRow sql.Row, newRow sql.Row) error {
+	n.calls = append(n.calls, fmt.Sprintf("update %s %v %v", tableName, oldRow, newRow))
+	if n.fail {
+		return fmt.Errorf("update failed")
+	}
+	return nil
+}
+
+func TestNotifyListener(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	notify := &recordingNotify{}
+	listener := memory.NotifyListener(notify)
+	tx := sql.CommittedTransaction{ID: 1, Changes: []sql.RowChange{
+		{Database: "db", Table: "t", New: sql.NewRow(int64(1), "a")},
+		{Database: "db", Table: "t", Old: sql.NewRow(int64(1), "a"), New: sql.NewRow(int64(1), "b")},
+		{Database: "db", Table: "u", Old: sql.NewRow(int64(2))},
+	}}
+	require.NoError(listener.TransactionCommitted(ctx, tx))
+	require.Equal([]string{"insert t [1 a]", "update t [1 a] [1 b]", "delete u [2]"}, notify.calls)
+
+	notify = &recordingNotify{fail: true}
+	require.Error(memory.NotifyListener(notify).TransactionCommitted(ctx, tx))
+	require.Equal([]string{"insert t [1 a]", "update t [1 a] [1 b]"}, notify.calls)
+}
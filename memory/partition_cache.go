@@ -0,0 +1,80 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import "sync"
+
+// partitionCache caches data built from the rows of the partitions of a table, such as the structures searched by an
+// index, until the rows change. It's safe for concurrent use, since the partitions of a table are read concurrently.
+type partitionCache struct {
+	mu      sync.Mutex
+	entries map[string]partitionCacheEntry
+}
+
+type partitionCacheEntry struct {
+	version uint64
+	data    interface{}
+}
+
+func newPartitionCache() *partitionCache {
+	return &partitionCache{entries: make(map[string]partitionCacheEntry)}
+}
+
+// get returns the data built from the rows of the partition of the table given with the key given, which is built with
+// the function given if it wasn't cached for the current version of the rows. A nil cache caches nothing.
+func (c *partitionCache) get(t *Table, key string, build func() (interface{}, error)) (interface{}, error) {
+	if c == nil {
+		return build()
+	}
+
+	version := t.version(key)
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && entry.version == version {
+		return entry.data, nil
+	}
+
+	data, err := build()
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	c.entries[key] = partitionCacheEntry{version: version, data: data}
+	c.mu.Unlock()
+	return data, nil
+}
+
+// update applies a change of the rows of the partition of the table given with the key given to the data cached for
+// them, so that it's cached for their new version. The data is only changed if it was built for the version of the rows
+// the change was made to, which is given; otherwise, or if the change can't be applied, it's built again the next time
+// it's read.
+func (c *partitionCache) update(t *Table, key string, version uint64, apply func(data interface{}) error) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return
+	}
+	if entry.version != version || apply(entry.data) != nil {
+		delete(c.entries, key)
+		return
+	}
+	c.entries[key] = partitionCacheEntry{version: t.version(key), data: entry.data}
+}
@@ -0,0 +1,157 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"strings"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+var _ sql.PartitionedTable = (*Table)(nil)
+var _ sql.PartitionAlterableTable = (*Table)(nil)
+
+// GetPartitioning implements the sql.PartitionedTable interface.
+func (t *Table) GetPartitioning(_ *sql.Context) (*sql.Partitioning, error) {
+	return t.partitioning, nil
+}
+
+// WithPartitions implements the sql.PartitionedTable interface.
+func (t *Table) WithPartitions(names []string) sql.Table {
+	selected := make(map[string]bool)
+	for _, name := range names {
+		name = strings.ToLower(name)
+		if t.selected == nil || t.selected[name] {
+			selected[name] = true
+		}
+	}
+
+	nt := *t
+	nt.selected = selected
+	return &nt
+}
+
+// SetPartitioning implements the sql.PartitionAlterableTable interface. The partitions of a table which isn't
+// partitioned by SQL are the ones it was created with, and are named after their position.
+func (t *Table) SetPartitioning(ctx *sql.Context, partitioning *sql.Partitioning) error {
+	var keys [][]byte
+	if partitioning != nil {
+		for _, name := range partitioning.Names() {
+			keys = append(keys, []byte(name))
+		}
+	} else {
+		keys = [][]byte{[]byte("0")}
+	}
+
+	partitions := make(map[string][]sql.Row, len(keys))
+	for _, key := range keys {
+		partitions[string(key)] = []sql.Row{}
+	}
+	for _, key := range t.keys {
+		for _, row := range t.partitions[string(key)] {
+			key := keys[0]
+			if partitioning != nil {
+				i, err := partitioning.PartitionOf(ctx, t.schema, row)
+				if err != nil {
+					return err
+				}
+				key = keys[i]
+			}
+			partitions[string(key)] = append(partitions[string(key)], row)
+		}
+	}
+
+	t.partitioning = partitioning
+	t.partitions = partitions
+	t.keys = keys
+	t.insert = 0
+	t.changed()
+	return nil
+}
+
+// DropPartitions implements the sql.PartitionAlterableTable interface.
+func (t *Table) DropPartitions(ctx *sql.Context, names []string) error {
+	if t.partitioning == nil {
+		return sql.ErrPartitionManagementOnNonPartitioned.New()
+	}
+
+	dropped := make(map[string]bool)
+	for _, name := range names {
+		if t.partitioning.PartitionIndex(name) < 0 {
+			return sql.ErrUnknownPartition.New(name, t.name)
+		}
+		dropped[strings.ToLower(name)] = true
+	}
+
+	partitioning := t.partitioning.Copy()
+	partitioning.Definitions = partitioning.Definitions[:0]
+	var keys [][]byte
+	for _, def := range t.partitioning.Definitions {
+		if dropped[strings.ToLower(def.Name)] {
+			delete(t.partitions, def.Name)
+			continue
+		}
+		partitioning.Definitions = append(partitioning.Definitions, def)
+		keys = append(keys, []byte(def.Name))
+	}
+
+	t.partitioning = partitioning
+	t.keys = keys
+	t.insert = 0
+	t.changed()
+	return nil
+}
+
+// TruncatePartitions implements the sql.PartitionAlterableTable interface.
+func (t *Table) TruncatePartitions(ctx *sql.Context, names []string) error {
+	if t.partitioning == nil {
+		return sql.ErrPartitionManagementOnNonPartitioned.New()
+	}
+
+	for _, name := range names {
+		i := t.partitioning.PartitionIndex(name)
+		if i < 0 {
+			return sql.ErrUnknownPartition.New(name, t.name)
+		}
+		t.partitions[t.partitioning.Definitions[i].Name] = []sql.Row{}
+		t.changed(t.partitioning.Definitions[i].Name)
+	}
+	return nil
+}
+
+// partitionFor returns the key of the partition a new row is inserted into: the one its values belong to if the table
+// is partitioned by SQL, or the next one in turn otherwise.
+func (t *Table) partitionFor(ctx *sql.Context, row sql.Row) (string, error) {
+	if t.partitioning != nil {
+		i, err := t.partitioning.PartitionOf(ctx, t.schema, row)
+		if err != nil {
+			return "", err
+		}
+		return string(t.keys[i]), nil
+	}
+
+	key := string(t.keys[t.insert])
+	t.insert++
+	if t.insert == len(t.keys) {
+		t.insert = 0
+	}
+	return key, nil
+}
+
+// isSelected returns whether the partition with the key given is one of the partitions selected with
+// WithPartitions.
+func (t *Table) isSelected(key []byte) bool {
+	return t.selected == nil || t.selected[strings.ToLower(string(key))]
+}
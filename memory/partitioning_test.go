@@ -0,0 +1,136 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/linanh/go-mysql-server/memory"
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+func newRangePartitionedTable(t *testing.T) *memory.Table {
+	ctx := sql.NewEmptyContext()
+	table := memory.NewPartitionedTable("t", sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "t", Nullable: true},
+	}, 1)
+	for _, v := range []int64{1, 15, 25, 35} {
+		require.NoError(t, table.Insert(ctx, sql.NewRow(v)))
+	}
+
+	err := table.SetPartitioning(ctx, &sql.Partitioning{
+		Method:     sql.PartitionMethod_Range,
+		UseColumns: true,
+		Columns:    []string{"a"},
+		Types:      []sql.Type{sql.Int64},
+		Definitions: []sql.PartitionDefinition{
+			{Name: "p0", LessThan: []interface{}{int64(10)}},
+			{Name: "p1", LessThan: []interface{}{int64(20)}},
+			{Name: "p2", LessThan: []interface{}{nil}},
+		},
+	})
+	require.NoError(t, err)
+	return table
+}
+
+func partitionValues(t *testing.T, table sql.Table) []int64 {
+	var values []int64
+	for _, row := range getAllRows(t, table) {
+		values = append(values, row[0].(int64))
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	return values
+}
+
+func TestTableSetPartitioning(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+	table := newRangePartitionedTable(t)
+
+	count, err := table.PartitionCount(ctx)
+	require.NoError(err)
+	require.Equal(int64(3), count)
+	require.Equal([]int64{1, 15, 25, 35}, partitionValues(t, table))
+	require.Equal([]int64{15}, partitionValues(t, table.WithPartitions([]string{"P1"})))
+	require.Equal([]int64{1, 25, 35}, partitionValues(t, table.WithPartitions([]string{"p0", "p2"})))
+	require.Equal([]int64{25, 35}, partitionValues(t, table.WithPartitions([]string{"p0", "p2"}).(sql.PartitionedTable).WithPartitions([]string{"p1", "p2"})))
+
+	require.NoError(table.Insert(ctx, sql.NewRow(int64(5))))
+	require.Equal([]int64{1, 5}, partitionValues(t, table.WithPartitions([]string{"p0"})))
+
+	require.NoError(table.SetPartitioning(ctx, nil))
+	p, err := table.GetPartitioning(ctx)
+	require.NoError(err)
+	require.Nil(p)
+	count, err = table.PartitionCount(ctx)
+	require.NoError(err)
+	require.Equal(int64(1), count)
+	require.Equal([]int64{1, 5, 15, 25, 35}, partitionValues(t, table))
+}
+
+func TestTableSetPartitioningNoPartition(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+	table := newRangePartitionedTable(t)
+
+	err := table.SetPartitioning(ctx, &sql.Partitioning{
+		Method:     sql.PartitionMethod_List,
+		UseColumns: true,
+		Columns:    []string{"a"},
+		Types:      []sql.Type{sql.Int64},
+		Definitions: []sql.PartitionDefinition{
+			{Name: "p0", In: [][]interface{}{{int64(1)}, {int64(15)}}},
+		},
+	})
+	require.True(sql.ErrNoPartitionForValue.Is(err))
+
+	// The table is left unchanged
+	p, err := table.GetPartitioning(ctx)
+	require.NoError(err)
+	require.Equal(sql.PartitionMethod_Range, p.Method)
+	require.Equal([]int64{1, 15, 25, 35}, partitionValues(t, table))
+
+	err = table.Insert(ctx, sql.NewRow(nil))
+	require.NoError(err)
+	require.Len(getAllRows(t, table.WithPartitions([]string{"p0"})), 2)
+}
+
+func TestTableDropAndTruncatePartitions(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+	table := newRangePartitionedTable(t)
+
+	require.NoError(table.TruncatePartitions(ctx, []string{"p2"}))
+	require.Equal([]int64{1, 15}, partitionValues(t, table))
+
+	require.NoError(table.DropPartitions(ctx, []string{"P0"}))
+	p, err := table.GetPartitioning(ctx)
+	require.NoError(err)
+	require.Equal([]string{"p1", "p2"}, p.Names())
+	require.Equal([]int64{15}, partitionValues(t, table))
+
+	err = table.DropPartitions(ctx, []string{"p9"})
+	require.True(sql.ErrUnknownPartition.Is(err))
+
+	require.NoError(table.Insert(ctx, sql.NewRow(int64(3))))
+	require.Equal([]int64{3, 15}, partitionValues(t, table.WithPartitions([]string{"p1"})))
+
+	unpartitioned := memory.NewTable("u", sql.Schema{{Name: "a", Type: sql.Int64, Source: "u"}})
+	err = unpartitioned.TruncatePartitions(ctx, []string{"p0"})
+	require.True(sql.ErrPartitionManagementOnNonPartitioned.Is(err))
+}
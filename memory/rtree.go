@@ -0,0 +1,157 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"math"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// rtreeMaxEntries is the number of entries a node of an rtree holds before it's split.
+const rtreeMaxEntries = 8
+
+// rtree is an R-tree of row positions by the minimum bounding rectangles of their geometries, using quadratic splits.
+type rtree struct {
+	root *rtreeNode
+}
+
+type rtreeNode struct {
+	leaf    bool
+	entries []rtreeEntry
+}
+
+type rtreeEntry struct {
+	mbr   sql.MBR
+	child *rtreeNode
+	pos   int
+}
+
+func newRtree() *rtree {
+	return &rtree{root: &rtreeNode{leaf: true}}
+}
+
+// Insert adds the row position given with the rectangle given to the tree.
+func (t *rtree) Insert(mbr sql.MBR, pos int) {
+	split := t.root.insert(rtreeEntry{mbr: mbr, pos: pos})
+	if split != nil {
+		old := t.root
+		t.root = &rtreeNode{entries: []rtreeEntry{
+			{mbr: old.mbr(), child: old},
+			{mbr: split.mbr(), child: split},
+		}}
+	}
+}
+
+// Search calls f with the position of every row whose rectangle intersects the one given.
+func (t *rtree) Search(mbr sql.MBR, f func(pos int)) {
+	t.root.search(mbr, f)
+}
+
+func (n *rtreeNode) search(mbr sql.MBR, f func(pos int)) {
+	for _, e := range n.entries {
+		if !e.mbr.Intersects(mbr) {
+			continue
+		}
+		if n.leaf {
+			f(e.pos)
+		} else {
+			e.child.search(mbr, f)
+		}
+	}
+}
+
+func (n *rtreeNode) mbr() sql.MBR {
+	m := n.entries[0].mbr
+	for _, e := range n.entries[1:] {
+		m = m.Union(e.mbr)
+	}
+	return m
+}
+
+// insert adds an entry to the subtree of the node, and returns the new sibling of the node if it had to be split.
+func (n *rtreeNode) insert(e rtreeEntry) *rtreeNode {
+	if n.leaf {
+		n.entries = append(n.entries, e)
+	} else {
+		i := n.chooseSubtree(e.mbr)
+		child := n.entries[i].child
+		split := child.insert(e)
+		n.entries[i].mbr = child.mbr()
+		if split != nil {
+			n.entries = append(n.entries, rtreeEntry{mbr: split.mbr(), child: split})
+		}
+	}
+
+	if len(n.entries) > rtreeMaxEntries {
+		return n.split()
+	}
+	return nil
+}
+
+// chooseSubtree returns the entry whose rectangle needs the least enlargement to include the one given.
+func (n *rtreeNode) chooseSubtree(mbr sql.MBR) int {
+	best := 0
+	bestEnlargement, bestArea := math.Inf(1), math.Inf(1)
+	for i, e := range n.entries {
+		area := e.mbr.Area()
+		enlargement := e.mbr.Union(mbr).Area() - area
+		if enlargement < bestEnlargement || (enlargement == bestEnlargement && area < bestArea) {
+			best, bestEnlargement, bestArea = i, enlargement, area
+		}
+	}
+	return best
+}
+
+// split moves about half of the entries of the node to a new node, which it returns.
+func (n *rtreeNode) split() *rtreeNode {
+	entries := n.entries
+
+	// Seed the groups with the pair of entries that would waste the most area together.
+	seedA, seedB := 0, 1
+	worst := math.Inf(-1)
+	for i := range entries {
+		for j := i + 1; j < len(entries); j++ {
+			waste := entries[i].mbr.Union(entries[j].mbr).Area() - entries[i].mbr.Area() - entries[j].mbr.Area()
+			if waste > worst {
+				seedA, seedB, worst = i, j, waste
+			}
+		}
+	}
+
+	a := []rtreeEntry{entries[seedA]}
+	b := []rtreeEntry{entries[seedB]}
+	mbrA, mbrB := entries[seedA].mbr, entries[seedB].mbr
+	minEntries := len(entries) / 2
+	for i, e := range entries {
+		if i == seedA || i == seedB {
+			continue
+		}
+		remaining := len(entries) - i
+		switch {
+		case len(a)+remaining <= minEntries:
+			a, mbrA = append(a, e), mbrA.Union(e.mbr)
+		case len(b)+remaining <= minEntries:
+			b, mbrB = append(b, e), mbrB.Union(e.mbr)
+		case mbrA.Union(e.mbr).Area()-mbrA.Area() <= mbrB.Union(e.mbr).Area()-mbrB.Area():
+			a, mbrA = append(a, e), mbrA.Union(e.mbr)
+		default:
+			b, mbrB = append(b, e), mbrB.Union(e.mbr)
+		}
+	}
+
+	n.entries = a
+	return &rtreeNode{leaf: n.leaf, entries: b}
+}
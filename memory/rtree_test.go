@@ -0,0 +1,61 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+func TestRtree(t *testing.T) {
+	require := require.New(t)
+
+	// A grid of 20x20 points, enough for the tree to split several levels deep.
+	tree := newRtree()
+	var mbrs []sql.MBR
+	for x := 0; x < 20; x++ {
+		for y := 0; y < 20; y++ {
+			mbr := sql.MBR{MinX: float64(x), MinY: float64(y), MaxX: float64(x), MaxY: float64(y)}
+			tree.Insert(mbr, len(mbrs))
+			mbrs = append(mbrs, mbr)
+		}
+	}
+	require.False(tree.root.leaf)
+
+	for _, search := range []sql.MBR{
+		{MinX: 2, MinY: 3, MaxX: 5, MaxY: 4},
+		{MinX: -1, MinY: -1, MaxX: 0, MaxY: 0},
+		{MinX: 19.5, MinY: 0, MaxX: 30, MaxY: 30},
+		{MinX: -10, MinY: -10, MaxX: 30, MaxY: 30},
+	} {
+		var expected []int
+		for pos, mbr := range mbrs {
+			if mbr.Intersects(search) {
+				expected = append(expected, pos)
+			}
+		}
+
+		var actual []int
+		tree.Search(search, func(pos int) {
+			actual = append(actual, pos)
+		})
+		sort.Ints(actual)
+		require.Equal(expected, actual, "%v", search)
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// ErrSnapshotUnsupportedTable is returned when a snapshot is taken or restored for a database holding tables which
+// aren't a Table.
+var ErrSnapshotUnsupportedTable = errors.NewKind("table %s of database %s doesn't support snapshots")
+
+// Snapshot is the state of a Database at a point in time: its tables, with their schema, rows, indexes, constraints
+// and AUTO_INCREMENT values, along with its triggers and stored procedures.
+//
+// Snapshots are copy-on-write: the rows of a partition are shared by the snapshot and the tables it was taken from or
+// restored to, until one of those tables changes them. Taking and restoring a snapshot takes time proportional to the
+// number of tables and partitions, rather than to the number of rows.
+type Snapshot struct {
+	tables           map[string]*Table
+	triggers         []sql.TriggerDefinition
+	storedProcedures []sql.StoredProcedureDetails
+}
+
+// Snapshot returns a snapshot of the current state of the database. Every table of the database must be a Table.
+func (d *Database) Snapshot() (*Snapshot, error) {
+	if err := d.checkSnapshotTables(); err != nil {
+		return nil, err
+	}
+
+	s := &Snapshot{
+		tables:           make(map[string]*Table, len(d.tables)),
+		triggers:         append([]sql.TriggerDefinition(nil), d.triggers...),
+		storedProcedures: append([]sql.StoredProcedureDetails(nil), d.storedProcedures...),
+	}
+	for name, table := range d.tables {
+		s.tables[name] = table.(*Table).Share()
+	}
+	return s, nil
+}
+
+// Restore replaces the tables, triggers and stored procedures of the database with the ones of the snapshot given.
+// The snapshot is left unchanged, and can be restored again. Every table of the database must be a Table.
+func (d *Database) Restore(s *Snapshot) error {
+	if err := d.checkSnapshotTables(); err != nil {
+		return err
+	}
+
+	tables := make(map[string]sql.Table, len(s.tables))
+	for name, table := range s.tables {
+		tables[name] = table.Share()
+	}
+	d.tables = tables
+	d.triggers = append([]sql.TriggerDefinition(nil), s.triggers...)
+	d.storedProcedures = append([]sql.StoredProcedureDetails(nil), s.storedProcedures...)
+	return nil
+}
+
+func (d *Database) checkSnapshotTables() error {
+	for name, table := range d.tables {
+		if _, ok := table.(*Table); !ok {
+			return ErrSnapshotUnsupportedTable.New(name, d.name)
+		}
+	}
+	return nil
+}
+
+// Share returns a copy of the table which shares its rows with it, like the tables of a snapshot. Both tables copy the
+// rows of a partition before they change them.
+func (t *Table) Share() *Table {
+	nt := *t
+	nt.schema = copyschema(t.schema)
+	nt.foreignKeys = append([]sql.ForeignKeyConstraint(nil), t.foreignKeys...)
+	nt.checks = append([]sql.CheckDefinition(nil), t.checks...)
+	nt.keys = append([][]byte(nil), t.keys...)
+
+	if t.shared == nil {
+		t.shared = make(map[string]bool)
+	}
+	nt.partitions = make(map[string][]sql.Row, len(t.partitions))
+	nt.shared = make(map[string]bool, len(t.partitions))
+	nt.versions = make(map[string]uint64, len(t.versions))
+	for key, version := range t.versions {
+		nt.versions[key] = version
+	}
+	for key, rows := range t.partitions {
+		nt.partitions[key] = rows
+		nt.shared[key] = true
+		t.shared[key] = true
+	}
+
+	if t.indexes != nil {
+		nt.indexes = make(map[string]sql.Index, len(t.indexes))
+		for name, index := range t.indexes {
+			nt.indexes[name] = indexOn(index, &nt)
+		}
+	}
+	return &nt
+}
+
+// indexOn returns a copy of the index given which reads the rows of the table given.
+func indexOn(index sql.Index, t *Table) sql.Index {
+	switch index := index.(type) {
+	case *MergeableIndex:
+		idx := *index
+		idx.Tbl = t
+		return &idx
+	case *UnmergeableIndex:
+		idx := *index
+		idx.Tbl = t
+		return &idx
+	case *SpatialIndex:
+		idx := *index
+		idx.Tbl = t
+		idx.trees = newPartitionCache()
+		return &idx
+	case *FullTextIndex:
+		idx := *index
+		idx.Tbl = t
+		idx.inverted = newFullTextInvertedIndexes()
+		return &idx
+	default:
+		return index
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/linanh/go-mysql-server/memory"
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+func TestSnapshotRestore(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	db := memory.NewDatabase("db")
+	schema := sql.Schema{
+		{Name: "id", Type: sql.Int64, Source: "t", PrimaryKey: true, AutoIncrement: true},
+		{Name: "name", Type: sql.Text, Source: "t"},
+	}
+	require.NoError(db.CreateTable(ctx, "t", schema))
+	table := db.Tables()["t"].(*memory.Table)
+	require.NoError(table.CreateIndex(ctx, "name_idx", sql.IndexUsing_Default, sql.IndexConstraint_None,
+		[]sql.IndexColumn{{Name: "name"}}, ""))
+	for _, row := range []sql.Row{{int64(1), "a"}, {int64(2), "b"}, {int64(3), "c"}} {
+		require.NoError(table.Insert(ctx, row))
+	}
+	require.NoError(db.CreateTrigger(ctx, sql.TriggerDefinition{Name: "trg", CreateStatement: "create trigger trg ..."}))
+
+	snapshot, err := db.Snapshot()
+	require.NoError(err)
+
+	// Changes made after the snapshot are undone by restoring it
+	require.NoError(table.Updater(ctx).Update(ctx, sql.Row{int64(1), "a"}, sql.Row{int64(1), "z"}))
+	require.NoError(table.Deleter(ctx).Delete(ctx, sql.Row{int64(2), "b"}))
+	require.NoError(table.Insert(ctx, sql.Row{int64(4), "d"}))
+	require.NoError(db.DropTrigger(ctx, "trg"))
+	require.NoError(db.CreateTable(ctx, "u", nil))
+	require.Equal([]sql.Row{{int64(1), "z"}, {int64(3), "c"}, {int64(4), "d"}}, tableRows(t, ctx, table))
+
+	for i := 0; i < 2; i++ {
+		require.NoError(db.Restore(snapshot))
+		require.Len(db.Tables(), 1)
+		restored := db.Tables()["t"].(*memory.Table)
+		require.Equal([]sql.Row{{int64(1), "a"}, {int64(2), "b"}, {int64(3), "c"}}, tableRows(t, ctx, restored))
+
+		triggers, err := db.GetTriggers(ctx)
+		require.NoError(err)
+		require.Len(triggers, 1)
+
+		indexes, err := restored.GetIndexes(ctx)
+		require.NoError(err)
+		require.Len(indexes, 1)
+		require.Equal("name_idx", indexes[0].ID())
+		require.Same(restored, indexes[0].(*memory.UnmergeableIndex).Tbl)
+
+		autoIncrement, err := restored.PeekNextAutoIncrementValue(ctx)
+		require.NoError(err)
+		require.Equal(int64(4), autoIncrement)
+
+		// Changes to the restored table don't affect the snapshot
+		require.NoError(restored.Deleter(ctx).Delete(ctx, sql.Row{int64(1), "a"}))
+		require.NoError(restored.Insert(ctx, sql.Row{int64(5), "e"}))
+		require.NoError(restored.AddColumn(ctx, &sql.Column{Name: "extra", Type: sql.Int64, Source: "t", Nullable: true}, nil))
+		require.Equal([]sql.Row{{int64(2), "b", nil}, {int64(3), "c", nil}, {int64(5), "e", nil}}, tableRows(t, ctx, restored))
+	}
+
+	// The table the snapshot was taken from isn't changed by restoring it
+	require.Equal([]sql.Row{{int64(1), "z"}, {int64(3), "c"}, {int64(4), "d"}}, tableRows(t, ctx, table))
+}
+
+func TestSnapshotUnsupportedTable(t *testing.T) {
+	db := memory.NewDatabase("db")
+	db.AddTable("t", memory.NewFilteredTable("t", nil))
+	_, err := db.Snapshot()
+	require.True(t, memory.ErrSnapshotUnsupportedTable.Is(err))
+}
+
+func tableRows(t *testing.T, ctx *sql.Context, table sql.Table) []sql.Row {
+	var rows []sql.Row
+	partitions, err := table.Partitions(ctx)
+	require.NoError(t, err)
+	for {
+		p, err := partitions.Next()
+		if err != nil {
+			break
+		}
+		iter, err := table.PartitionRows(ctx, p)
+		require.NoError(t, err)
+		partitionRows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(t, err)
+		rows = append(rows, partitionRows...)
+	}
+	return rows
+}
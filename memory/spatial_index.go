@@ -0,0 +1,166 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// SpatialIndex is an index over a single spatial column, which finds the rows whose geometries' minimum bounding
+// rectangles intersect a rectangle with an R-tree built over the rows of a partition.
+type SpatialIndex struct {
+	MergeableIndex
+	// trees caches the R-tree of each partition until its rows change
+	trees *partitionCache
+}
+
+var _ sql.Index = (*SpatialIndex)(nil)
+var _ sql.SpatialIndex = (*SpatialIndex)(nil)
+
+// IndexType implements sql.Index.
+func (i *SpatialIndex) IndexType() string {
+	return "SPATIAL"
+}
+
+// Get implements sql.Index. The rows returned are the ones whose geometries' rectangles intersect the one of the
+// geometry given, which the engine filters further.
+func (i *SpatialIndex) Get(key ...interface{}) (sql.IndexLookup, error) {
+	if len(key) != 1 {
+		return nil, fmt.Errorf("spatial index expects a single key, got %d", len(key))
+	}
+	g, err := sql.Geometry.Convert(key[0])
+	if err != nil {
+		return nil, err
+	}
+	mbr, ok := sql.GeometryMBR(g.(sql.GeometryValue))
+	if !ok {
+		return &SpatialIndexLookup{idx: i, empty: true}, nil
+	}
+	return i.Intersecting(mbr)
+}
+
+// Intersecting implements sql.SpatialIndex.
+func (i *SpatialIndex) Intersecting(mbr sql.MBR) (sql.IndexLookup, error) {
+	return &SpatialIndexLookup{idx: i, mbr: mbr}, nil
+}
+
+// SpatialIndexLookup is a lookup of the rows whose geometries' rectangles intersect a rectangle. It can't be merged
+// with other lookups.
+type SpatialIndexLookup struct {
+	idx   *SpatialIndex
+	mbr   sql.MBR
+	empty bool
+}
+
+var _ sql.IndexLookup = (*SpatialIndexLookup)(nil)
+var _ sql.MergeableIndexLookup = (*SpatialIndexLookup)(nil)
+var _ sql.DriverIndexLookup = (*SpatialIndexLookup)(nil)
+
+func (l *SpatialIndexLookup) IsMergeable(_ sql.IndexLookup) bool {
+	return false
+}
+
+func (l *SpatialIndexLookup) Intersection(_ ...sql.IndexLookup) (sql.IndexLookup, error) {
+	panic("not mergeable!")
+}
+
+func (l *SpatialIndexLookup) Union(_ ...sql.IndexLookup) (sql.IndexLookup, error) {
+	panic("not mergeable!")
+}
+
+func (l *SpatialIndexLookup) Indexes() []string {
+	return l.idx.Expressions()
+}
+
+func (l *SpatialIndexLookup) String() string {
+	if l.empty {
+		return fmt.Sprintf("%s EMPTY", l.idx.Expressions()[0])
+	}
+	return fmt.Sprintf("%s INTERSECTS (%v %v, %v %v)", l.idx.Expressions()[0], l.mbr.MinX, l.mbr.MinY, l.mbr.MaxX, l.mbr.MaxY)
+}
+
+// Values implements sql.IndexLookup. The R-tree of the partition is built the first time its rows are looked up,
+// and again after they change.
+func (l *SpatialIndexLookup) Values(p sql.Partition) (sql.IndexValueIter, error) {
+	key := string(p.Key())
+	rows, ok := l.idx.Tbl.partitions[key]
+	if !ok {
+		return nil, sql.ErrPartitionNotFound.New(p.Key())
+	}
+	if l.empty {
+		return &positionIndexValIter{}, nil
+	}
+
+	tree, err := l.idx.trees.get(l.idx.Tbl, key, func() (interface{}, error) {
+		return l.idx.buildTree(rows)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var positions []int
+	tree.(*rtree).Search(l.mbr, func(pos int) {
+		positions = append(positions, pos)
+	})
+	sort.Ints(positions)
+	return &positionIndexValIter{positions: positions}, nil
+}
+
+// buildTree returns an R-tree of the positions of the rows given by the rectangles of their geometries.
+func (i *SpatialIndex) buildTree(rows []sql.Row) (*rtree, error) {
+	ctx := sql.NewEmptyContext()
+	tree := newRtree()
+	for pos, row := range rows {
+		v, err := i.Exprs[0].Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if v == nil {
+			continue
+		}
+		g, err := sql.Geometry.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		if mbr, ok := sql.GeometryMBR(g.(sql.GeometryValue)); ok {
+			tree.Insert(mbr, pos)
+		}
+	}
+	return tree, nil
+}
+
+// positionIndexValIter iterates over the encoded positions of the rows of a partition found by an index, in table
+// order.
+type positionIndexValIter struct {
+	positions []int
+	i         int
+}
+
+func (i *positionIndexValIter) Next() ([]byte, error) {
+	if i.i >= len(i.positions) {
+		return nil, io.EOF
+	}
+	pos := i.positions[i.i]
+	i.i++
+	return EncodeIndexValue(&IndexValue{Pos: pos})
+}
+
+func (i *positionIndexValIter) Close(_ *sql.Context) error {
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+func TestSpatialIndexTreeCache(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	table := NewTable("places", sql.Schema{
+		{Name: "id", Type: sql.Int64, Source: "places", PrimaryKey: true},
+		{Name: "g", Type: sql.Geometry, Source: "places"},
+	})
+	for i, p := range []sql.Point{{X: 0, Y: 0}, {X: 5, Y: 5}, {X: 10, Y: 10}} {
+		require.NoError(table.Insert(ctx, sql.NewRow(int64(i), p)))
+	}
+	require.NoError(table.CreateIndex(ctx, "g_idx", sql.IndexUsing_Default, sql.IndexConstraint_Spatial, []sql.IndexColumn{{Name: "g"}}, ""))
+	indexes, err := table.GetIndexes(ctx)
+	require.NoError(err)
+	idx := indexes[0].(*SpatialIndex)
+
+	lookup, err := idx.Intersecting(sql.MBR{MinX: 4, MinY: 4, MaxX: 11, MaxY: 11})
+	require.NoError(err)
+	partition := &Partition{key: []byte("0")}
+	positions := func() []int {
+		iter, err := lookup.(sql.DriverIndexLookup).Values(partition)
+		require.NoError(err)
+		var positions []int
+		for {
+			v, err := iter.Next()
+			if err == io.EOF {
+				return positions
+			}
+			require.NoError(err)
+			value, err := DecodeIndexValue(v)
+			require.NoError(err)
+			positions = append(positions, value.Pos)
+		}
+	}
+
+	// The tree is built once for the rows of the partition
+	require.Equal([]int{1, 2}, positions())
+	tree := idx.trees.entries["0"].data
+	require.Equal([]int{1, 2}, positions())
+	require.Same(tree, idx.trees.entries["0"].data)
+
+	// and again once they change
+	require.NoError(table.Insert(ctx, sql.NewRow(int64(3), sql.Point{X: 6, Y: 6})))
+	require.Equal([]int{1, 2, 3}, positions())
+	require.NotSame(tree, idx.trees.entries["0"].data)
+
+	deleter := table.Deleter(ctx)
+	require.NoError(deleter.Delete(ctx, sql.NewRow(int64(1), sql.Point{X: 5, Y: 5})))
+	require.NoError(deleter.Close(ctx))
+	require.Equal([]int{1, 2}, positions())
+
+	updater := table.Updater(ctx)
+	require.NoError(updater.Update(ctx, sql.NewRow(int64(2), sql.Point{X: 10, Y: 10}), sql.NewRow(int64(2), sql.Point{X: 20, Y: 20})))
+	require.NoError(updater.Close(ctx))
+	require.Equal([]int{2}, positions())
+}
@@ -0,0 +1,77 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import "sync"
+
+// statisticsCache caches statistics computed from the rows of every partition of a table, such as the histograms of
+// its columns and the cardinalities of its indexes, until the rows of any partition change. The analyzer reads them for
+// every join order it considers, so they're only computed again once the table is written to. It's safe for concurrent
+// use.
+type statisticsCache struct {
+	mu      sync.Mutex
+	entries map[string]statisticsCacheEntry
+}
+
+type statisticsCacheEntry struct {
+	// versions are the versions of the rows of the partitions of the table the statistics were computed from, in the
+	// order of its keys
+	versions []uint64
+	data     interface{}
+}
+
+func newStatisticsCache() *statisticsCache {
+	return &statisticsCache{entries: make(map[string]statisticsCacheEntry)}
+}
+
+// get returns the statistics of the table given with the key given, which are computed with the function given if
+// they weren't cached for the current versions of its rows. A nil cache caches nothing.
+func (c *statisticsCache) get(t *Table, key string, compute func() (interface{}, error)) (interface{}, error) {
+	if c == nil {
+		return compute()
+	}
+
+	versions := make([]uint64, len(t.keys))
+	for i, k := range t.keys {
+		versions[i] = t.version(string(k))
+	}
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && sameVersions(entry.versions, versions) {
+		return entry.data, nil
+	}
+
+	data, err := compute()
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	c.entries[key] = statisticsCacheEntry{versions: versions, data: data}
+	c.mu.Unlock()
+	return data, nil
+}
+
+func sameVersions(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
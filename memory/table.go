@@ -22,6 +22,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync/atomic"
 
 	"github.com/dolthub/vitess/go/sqltypes"
 	errors "gopkg.in/src-d/go-errors.v1"
@@ -48,6 +49,20 @@ type Table struct {
 	// Data storage
 	partitions map[string][]sql.Row
 	keys       [][]byte
+	// shared are the keys of the partitions whose rows are shared with a snapshot, and copied before they're changed
+	shared map[string]bool
+	// versions are the versions of the rows of each partition, which change whenever the rows do, so that the data
+	// built from them can be cached
+	versions map[string]uint64
+	// statistics caches the statistics computed from the rows of every partition, until they change. It's shared with
+	// the copies of the table.
+	statistics *statisticsCache
+
+	// partitioning is the partitioning declared with PARTITION BY, whose partitions are named in keys
+	partitioning *sql.Partitioning
+
+	// selected are the lower-cased names of the partitions rows are read from, or nil if they're all read
+	selected map[string]bool
 
 	// Insert bookkeeping
 	insert int
@@ -63,7 +78,8 @@ type Table struct {
 	disableNotify bool
 }
 
-//Insert, Update, Delete产生的事件通知
+// Notify is notified of the rows inserted, updated and deleted in tables. Register it on the engine with
+// NotifyListener, so it's only notified of committed changes.
 type Notify interface {
 	Insert(tableName string, row sql.Row) error
 	Delete(tableName string, row sql.Row) error
@@ -86,6 +102,7 @@ var _ sql.CheckAlterableTable = (*Table)(nil)
 var _ sql.CheckTable = (*Table)(nil)
 var _ sql.AutoIncrementTable = (*Table)(nil)
 var _ sql.StatisticsTable = (*Table)(nil)
+var _ sql.HistogramTable = (*Table)(nil)
 var _ sql.ProjectedTable = (*Table)(nil)
 var _ sql.PrimaryKeyAlterableTable = (*Table)(nil)
 
@@ -124,6 +141,9 @@ func NewPartitionedTable(name string, schema sql.Schema, numPartitions int) *Tab
 		schema:     schema,
 		partitions: partitions,
 		keys:       keys,
+		shared:     map[string]bool{},
+		versions:   map[string]uint64{},
+		statistics: newStatisticsCache(),
 		autoIncVal: autoIncVal,
 		autoColIdx: autoIncIdx,
 	}
@@ -134,6 +154,11 @@ func (t *Table) Name() string {
 	return t.name
 }
 
+// Rename changes the name of the table.
+func (t *Table) Rename(name string) {
+	t.name = name
+}
+
 // Schema implements the sql.Table interface.
 func (t *Table) Schema() sql.Schema {
 	return t.schema
@@ -152,7 +177,7 @@ func (t *Table) GetPartition(key string) []sql.Row {
 func (t *Table) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
 	var keys [][]byte
 	for _, k := range t.keys {
-		if rows, ok := t.partitions[string(k)]; ok && len(rows) > 0 {
+		if rows, ok := t.partitions[string(k)]; ok && len(rows) > 0 && t.isSelected(k) {
 			keys = append(keys, k)
 		}
 	}
@@ -161,6 +186,9 @@ func (t *Table) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
 
 // PartitionCount implements the sql.PartitionCounter interface.
 func (t *Table) PartitionCount(ctx *sql.Context) (int64, error) {
+	if t.selected != nil {
+		return int64(len(t.selected)), nil
+	}
 	return int64(len(t.partitions)), nil
 }
 
@@ -195,8 +223,10 @@ func (t *Table) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.Ro
 
 func (t *Table) NumRows(ctx *sql.Context) (uint64, error) {
 	var count uint64 = 0
-	for _, rows := range t.partitions {
-		count += uint64(len(rows))
+	for key, rows := range t.partitions {
+		if t.isSelected([]byte(key)) {
+			count += uint64(len(rows))
+		}
 	}
 
 	return count, nil
@@ -220,6 +250,8 @@ func (t *Table) DataLength(ctx *sql.Context) (uint64, error) {
 			numBytesPerRow += 2
 		case sql.JsonType:
 			numBytesPerRow += 20
+		case sql.SpatialType:
+			numBytesPerRow += 25
 		case sql.NullType:
 			numBytesPerRow += 1
 		case sql.TimeType:
@@ -239,6 +271,31 @@ func (t *Table) DataLength(ctx *sql.Context) (uint64, error) {
 	return numBytesPerRow * numRows, nil
 }
 
+// histogramBuckets is the maximum number of buckets in the histograms returned by Table.Histogram.
+const histogramBuckets = 32
+
+// Histogram implements the sql.HistogramTable interface. Histograms are cached until the rows of the table change.
+func (t *Table) Histogram(ctx *sql.Context, colName string) (*sql.Histogram, error) {
+	idx := t.schema.IndexOf(colName, t.name)
+	if idx < 0 {
+		return nil, nil
+	}
+
+	histogram, err := t.statistics.get(t, fmt.Sprintf("histogram %d %s", idx, t.schema[idx].Type), func() (interface{}, error) {
+		var values []interface{}
+		for _, key := range t.keys {
+			for _, row := range t.partitions[string(key)] {
+				values = append(values, row[idx])
+			}
+		}
+		return sql.NewHistogram(t.schema[idx].Type, values, histogramBuckets)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return histogram.(*sql.Histogram), nil
+}
+
 func NewPartition(key []byte) *Partition {
 	return &Partition{key: key}
 }
@@ -392,6 +449,8 @@ type tableEditor struct {
 	initialAutoIncVal interface{}
 	initialPartitions map[string][]sql.Row
 	initialInsert     int
+	// repartition is whether updated rows must be moved to the partitions their new values belong to
+	repartition bool
 }
 
 var _ sql.RowReplacer = (*tableEditor)(nil)
@@ -399,9 +458,14 @@ var _ sql.RowUpdater = (*tableEditor)(nil)
 var _ sql.RowInserter = (*tableEditor)(nil)
 var _ sql.RowDeleter = (*tableEditor)(nil)
 
-func (t *tableEditor) Close(*sql.Context) error {
+func (t *tableEditor) Close(ctx *sql.Context) error {
 	// TODO: it would be nice to apply all pending updates here at once, rather than directly in the Insert / Update
 	//  / Delete methods.
+	if t.repartition {
+		// Updated rows are moved once the statement is done, so that they aren't read again from their new partition
+		t.repartition = false
+		return t.table.SetPartitioning(ctx, t.table.partitioning)
+	}
 	return nil
 }
 
@@ -422,6 +486,7 @@ func (t *tableEditor) DiscardChanges(ctx *sql.Context, errorEncountered error) e
 	t.table.insert = t.initialInsert
 	t.table.autoIncVal = t.initialAutoIncVal
 	t.table.partitions = t.initialPartitions
+	t.table.changed()
 	return nil
 }
 
@@ -430,23 +495,23 @@ func (t *tableEditor) StatementComplete(ctx *sql.Context) error {
 }
 
 func (t *Table) Inserter(*sql.Context) sql.RowInserter {
-	return &tableEditor{t, nil, nil, 0}
+	return &tableEditor{table: t}
 }
 
 func (t *Table) Updater(*sql.Context) sql.RowUpdater {
-	return &tableEditor{t, nil, nil, 0}
+	return &tableEditor{table: t}
 }
 
 func (t *Table) Replacer(*sql.Context) sql.RowReplacer {
-	return &tableEditor{t, nil, nil, 0}
+	return &tableEditor{table: t}
 }
 
 func (t *Table) Deleter(*sql.Context) sql.RowDeleter {
-	return &tableEditor{t, nil, nil, 0}
+	return &tableEditor{table: t}
 }
 
 func (t *Table) AutoIncrementSetter(*sql.Context) sql.AutoIncrementSetter {
-	return &tableEditor{t, nil, nil, 0}
+	return &tableEditor{table: t}
 }
 
 func (t *Table) Truncate(ctx *sql.Context) (int, error) {
@@ -455,9 +520,64 @@ func (t *Table) Truncate(ctx *sql.Context) (int, error) {
 		count += len(t.partitions[key])
 		t.partitions[key] = nil
 	}
+	t.changed()
 	return count, nil
 }
 
+// rowsForWrite returns the rows of the partition with the key given, which are copied first if they're shared with a
+// snapshot, so that they can be changed in place. The rows are given a new version.
+func (t *Table) rowsForWrite(key string) []sql.Row {
+	rows := t.partitions[key]
+	if t.shared[key] {
+		rows = append(make([]sql.Row, 0, len(rows)+1), rows...)
+		t.partitions[key] = rows
+		delete(t.shared, key)
+	}
+	t.changed(key)
+	return rows
+}
+
+// lastVersion is the last version given to the rows of a partition. Versions are unique across tables, so that the
+// tables sharing rows with a snapshot never give the same version to different rows.
+var lastVersion uint64
+
+// changed gives a new version to the rows of the partitions with the keys given, or to the rows of every partition if
+// none is given.
+func (t *Table) changed(keys ...string) {
+	if t.versions == nil {
+		t.versions = make(map[string]uint64)
+	}
+	if len(keys) == 0 {
+		for key := range t.versions {
+			if _, ok := t.partitions[key]; !ok {
+				delete(t.versions, key)
+			}
+		}
+		for key := range t.partitions {
+			keys = append(keys, key)
+		}
+	}
+	for _, key := range keys {
+		t.versions[key] = atomic.AddUint64(&lastVersion, 1)
+	}
+}
+
+// version returns the version of the rows of the partition with the key given.
+func (t *Table) version(key string) uint64 {
+	return t.versions[key]
+}
+
+// rowWritten updates the indexes which keep data built from the rows of the partition with the key given after the row
+// at the position given was inserted, updated or deleted. old is nil for inserted rows and new for deleted ones, and
+// version is the version of the rows of the partition before the change.
+func (t *Table) rowWritten(ctx *sql.Context, key string, version uint64, pos int, old, new sql.Row) {
+	for _, index := range t.indexes {
+		if index, ok := index.(*FullTextIndex); ok {
+			index.rowWritten(ctx, key, version, pos, old, new)
+		}
+	}
+}
+
 // Convenience method to avoid having to create an inserter in test setup
 func (t *Table) Insert(ctx *sql.Context, row sql.Row) error {
 	inserter := t.Inserter(ctx)
@@ -467,16 +587,25 @@ func (t *Table) Insert(ctx *sql.Context, row sql.Row) error {
 	return inserter.Close(ctx)
 }
 
-// SetNotify for insert, update, delete
+// SetNotify sets the Notify called by the editors of the table as they change rows.
+//
+// Deprecated: changes are notified as soon as they're made, even if their statement fails or their transaction is
+// rolled back. Add NotifyListener(notify) to the RowChangeListeners of the catalog instead.
 func (t *Table) SetNotify(notify Notify) {
 	t.notify = notify
 	t.disableNotify = false
 }
 
+// DisableNotify stops the Notify of the table from being called, until EnableNotify is called.
+//
+// Deprecated: see SetNotify.
 func (t *Table) DisableNotify() {
 	t.disableNotify = true
 }
 
+// EnableNotify lets the Notify of the table be called again after DisableNotify.
+//
+// Deprecated: see SetNotify.
 func (t *Table) EnableNotify() {
 	t.disableNotify = false
 }
@@ -490,27 +619,31 @@ func (t *tableEditor) Insert(ctx *sql.Context, row sql.Row) error {
 	if err := t.checkUniquenessConstraints(row); err != nil {
 		return err
 	}
+	if err := t.checkUniqueIndexes(row, nil); err != nil {
+		return err
+	}
 
-	key := string(t.table.keys[t.table.insert])
-	t.table.insert++
-	if t.table.insert == len(t.table.keys) {
-		t.table.insert = 0
+	key, err := t.table.partitionFor(ctx, row)
+	if err != nil {
+		return err
 	}
 
-	t.table.partitions[key] = append(t.table.partitions[key], row)
+	version := t.table.version(key)
+	rows := append(t.table.rowsForWrite(key), row)
+	t.table.partitions[key] = rows
+	t.table.rowWritten(ctx, key, version, len(rows)-1, nil, row)
 
 	idx := t.table.autoColIdx
 	if idx >= 0 {
-		// autoIncVal = max(autoIncVal, insertVal)
+		// autoIncVal = max(autoIncVal, insertVal + 1)
 		autoCol := t.table.schema[idx]
 		cmp, err := autoCol.Type.Compare(row[idx], t.table.autoIncVal)
 		if err != nil {
 			return err
 		}
-		if cmp > 0 {
-			t.table.autoIncVal = row[idx]
+		if cmp >= 0 {
+			t.table.autoIncVal = increment(row[idx])
 		}
-		t.table.autoIncVal = increment(t.table.autoIncVal)
 	}
 
 	if t.table.notify != nil && !t.table.disableNotify {
@@ -591,8 +724,11 @@ func (t *tableEditor) Delete(ctx *sql.Context, row sql.Row) error {
 			// have the row to be replaced, so we need to consider primary key information.
 			pkColIdxes := t.pkColumnIndexes()
 			if len(pkColIdxes) > 0 {
-				if columnsMatch(pkColIdxes, partitionRow, row) {
+				if columnsMatch(t.table.schema, pkColIdxes, partitionRow, row) {
+					version := t.table.version(partitionIndex)
+					partition = t.table.rowsForWrite(partitionIndex)
 					t.table.partitions[partitionIndex] = append(partition[:partitionRowIndex], partition[partitionRowIndex+1:]...)
+					t.table.rowWritten(ctx, partitionIndex, version, partitionRowIndex, partitionRow, nil)
 					break
 				}
 			}
@@ -605,7 +741,10 @@ func (t *tableEditor) Delete(ctx *sql.Context, row sql.Row) error {
 			}
 
 			if matches {
+				version := t.table.version(partitionIndex)
+				partition = t.table.rowsForWrite(partitionIndex)
 				t.table.partitions[partitionIndex] = append(partition[:partitionRowIndex], partition[partitionRowIndex+1:]...)
+				t.table.rowWritten(ctx, partitionIndex, version, partitionRowIndex, partitionRow, nil)
 				break
 			}
 		}
@@ -637,6 +776,15 @@ func (t *tableEditor) Update(ctx *sql.Context, oldRow sql.Row, newRow sql.Row) e
 			return err
 		}
 	}
+	if err := t.checkUniqueIndexes(newRow, oldRow); err != nil {
+		return err
+	}
+	if t.table.partitioning != nil {
+		if _, err := t.table.partitionFor(ctx, newRow); err != nil {
+			return err
+		}
+		t.repartition = true
+	}
 
 	matches := false
 	for partitionIndex, partition := range t.table.partitions {
@@ -647,7 +795,9 @@ func (t *tableEditor) Update(ctx *sql.Context, oldRow sql.Row, newRow sql.Row) e
 				return err
 			}
 			if matches {
-				t.table.partitions[partitionIndex][partitionRowIndex] = newRow
+				version := t.table.version(partitionIndex)
+				t.table.rowsForWrite(partitionIndex)[partitionRowIndex] = newRow
+				t.table.rowWritten(ctx, partitionIndex, version, partitionRowIndex, partitionRow, newRow)
 				break
 			}
 		}
@@ -675,7 +825,7 @@ func (t *tableEditor) checkUniquenessConstraints(row sql.Row) error {
 	if len(pkColIdxes) > 0 {
 		for _, partition := range t.table.partitions {
 			for _, partitionRow := range partition {
-				if columnsMatch(pkColIdxes, partitionRow, row) {
+				if columnsMatch(t.table.schema, pkColIdxes, partitionRow, row) {
 					vals := make([]interface{}, len(pkColIdxes))
 					for _, i := range pkColIdxes {
 						vals[i] = row[pkColIdxes[i]]
@@ -689,6 +839,66 @@ func (t *tableEditor) checkUniquenessConstraints(row sql.Row) error {
 	return nil
 }
 
+// checkUniqueIndexes returns an error if the row given has the same values for the columns of a unique index as
+// another row of the table. The indexes whose columns have the same values in the row as in oldRow, the row it
+// replaces if any, aren't checked.
+func (t *tableEditor) checkUniqueIndexes(row, oldRow sql.Row) error {
+	for _, index := range t.table.indexes {
+		colIdxes, ok := t.uniqueIndexColumns(index)
+		if !ok {
+			continue
+		}
+		if oldRow != nil && columnsMatch(t.table.schema, colIdxes, oldRow, row) {
+			continue
+		}
+		// NULL values are never equal to each other
+		hasNull := false
+		for _, i := range colIdxes {
+			hasNull = hasNull || row[i] == nil
+		}
+		if hasNull {
+			continue
+		}
+
+		for _, partition := range t.table.partitions {
+			for _, partitionRow := range partition {
+				if columnsMatch(t.table.schema, colIdxes, partitionRow, row) {
+					vals := make([]string, len(colIdxes))
+					for j, i := range colIdxes {
+						vals[j] = fmt.Sprint(row[i])
+					}
+					return sql.NewUniqueKeyErr(fmt.Sprintf("[%s]", strings.Join(vals, ",")), false, partitionRow)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// uniqueIndexColumns returns the indexes of the columns of a unique index of the table, or false if the index isn't
+// unique or isn't on columns.
+func (t *tableEditor) uniqueIndexColumns(index sql.Index) ([]int, bool) {
+	exprIdx, ok := index.(ExpressionsIndex)
+	if !ok || !index.IsUnique() {
+		return nil, false
+	}
+
+	var colIdxes []int
+	for _, expr := range exprIdx.ColumnExpressions() {
+		gf, ok := expr.(*expression.GetField)
+		if !ok {
+			return nil, false
+		}
+		idx, _ := t.table.getField(gf.Name())
+		if idx < 0 {
+			return nil, false
+		}
+		colIdxes = append(colIdxes, idx)
+	}
+	return colIdxes, len(colIdxes) > 0
+}
+
 func (t *tableEditor) pkColumnIndexes() []int {
 	var pkColIdxes []int
 	for _, column := range t.table.schema {
@@ -702,13 +912,15 @@ func (t *tableEditor) pkColumnIndexes() []int {
 
 func (t *tableEditor) pkColsDiffer(row, row2 sql.Row) bool {
 	pkColIdxes := t.pkColumnIndexes()
-	return !columnsMatch(pkColIdxes, row, row2)
+	return !columnsMatch(t.table.schema, pkColIdxes, row, row2)
 }
 
-// Returns whether the values for the columns given match in the two rows provided
-func columnsMatch(colIndexes []int, row sql.Row, row2 sql.Row) bool {
+// Returns whether the values for the columns given match in the two rows provided, with strings compared in the
+// collations of their columns
+func columnsMatch(schema sql.Schema, colIndexes []int, row sql.Row, row2 sql.Row) bool {
 	for _, i := range colIndexes {
-		if row[i] != row2[i] {
+		typ := schema[i].Type
+		if sql.CollationKey(typ, row[i]) != sql.CollationKey(typ, row2[i]) {
 			return false
 		}
 	}
@@ -800,6 +1012,7 @@ func (t *Table) insertValueInRows(ctx *sql.Context, idx int, colDefault *sql.Col
 		}
 		t.partitions[k] = newP
 	}
+	t.changed()
 	return nil
 }
 
@@ -815,6 +1028,7 @@ func (t *Table) DropColumn(ctx *sql.Context, columnName string) error {
 		}
 		t.partitions[k] = newP
 	}
+	t.changed()
 	return nil
 }
 
@@ -882,6 +1096,7 @@ func (t *Table) ModifyColumn(ctx *sql.Context, columnName string, column *sql.Co
 		}
 		t.partitions[k] = newP
 	}
+	t.changed()
 
 	_ = t.dropColumnFromSchema(ctx, columnName)
 	t.addColumnToSchema(ctx, column, order)
@@ -917,6 +1132,19 @@ func (t *Table) DebugString() string {
 		kind += fmt.Sprintf("Indexed on %s", t.lookup)
 	}
 
+	if t.selected != nil {
+		var partitions []string
+		for _, key := range t.keys {
+			if t.isSelected(key) {
+				partitions = append(partitions, string(key))
+			}
+		}
+		if kind != "" {
+			kind += " "
+		}
+		kind += fmt.Sprintf("Partitions [%s] ", strings.Join(partitions, ", "))
+	}
+
 	if kind != "" {
 		kind = ": " + kind
 	}
@@ -1183,6 +1411,48 @@ func (t *Table) createIndex(name string, columns []sql.IndexColumn, constraint s
 		exprs[i] = expression.NewGetFieldWithTable(idx, field.Type, t.name, field.Name, field.Nullable)
 	}
 
+	if constraint == sql.IndexConstraint_Spatial {
+		if len(columns) != 1 {
+			return nil, sql.ErrSpatialIndexKeyParts.New()
+		}
+		_, field := t.getField(columns[0].Name)
+		if !sql.IsSpatial(field.Type) {
+			return nil, sql.ErrSpatialIndexType.New(field.Name)
+		}
+		if field.Nullable {
+			return nil, sql.ErrSpatialIndexNullable.New()
+		}
+		return &SpatialIndex{
+			MergeableIndex: MergeableIndex{
+				Tbl:        t,
+				TableName:  t.name,
+				Exprs:      exprs,
+				Name:       name,
+				CommentStr: comment,
+			},
+			trees: newPartitionCache(),
+		}, nil
+	}
+
+	if constraint == sql.IndexConstraint_Fulltext {
+		for _, column := range columns {
+			_, field := t.getField(column.Name)
+			if !sql.IsTextOnly(field.Type) {
+				return nil, sql.ErrFullTextColumnType.New(field.Name)
+			}
+		}
+		return &FullTextIndex{
+			MergeableIndex: MergeableIndex{
+				Tbl:        t,
+				TableName:  t.name,
+				Exprs:      exprs,
+				Name:       name,
+				CommentStr: comment,
+			},
+			inverted: newFullTextInvertedIndexes(),
+		}, nil
+	}
+
 	return &UnmergeableIndex{
 		MergeableIndex{
 			DB:         "",
@@ -1331,6 +1601,7 @@ func (t *Table) CreatePrimaryKey(ctx *sql.Context, columns []sql.IndexColumn) er
 	t.schema = potentialSchema
 	t.partitions = newTable.partitions
 	t.keys = newTable.keys
+	t.changed()
 
 	return nil
 }
@@ -1357,6 +1628,11 @@ func copyschema(sch sql.Schema) sql.Schema {
 
 func copyTable(t *Table, newSch sql.Schema) (*Table, error) {
 	newTable := NewPartitionedTable(t.name, newSch, len(t.partitions))
+	if t.partitioning != nil {
+		if err := newTable.SetPartitioning(sql.NewEmptyContext(), t.partitioning); err != nil {
+			return nil, err
+		}
+	}
 	for _, partition := range t.partitions {
 		for _, partitionRow := range partition {
 			err := newTable.Insert(sql.NewEmptyContext(), partitionRow)
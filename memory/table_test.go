@@ -409,3 +409,47 @@ func TestTableIndexKeyValueIter(t *testing.T) {
 		})
 	}
 }
+
+func TestTableStatisticsCache(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	table := memory.NewPartitionedTable("stats", sql.Schema{
+		{Name: "id", Type: sql.Int64, Source: "stats", PrimaryKey: true},
+		{Name: "v", Type: sql.Int64, Source: "stats"},
+	}, 2)
+	for i := int64(0); i < 6; i++ {
+		require.NoError(table.Insert(ctx, sql.NewRow(i, i%3)))
+	}
+	require.NoError(table.CreateIndex(ctx, "v_idx", sql.IndexUsing_Default, sql.IndexConstraint_None, []sql.IndexColumn{{Name: "v"}}, ""))
+	indexes, err := table.GetIndexes(ctx)
+	require.NoError(err)
+	var stats sql.IndexStatistics
+	for _, index := range indexes {
+		if index.ID() == "v_idx" {
+			stats = index.(sql.IndexStatistics)
+		}
+	}
+	require.NotNil(stats)
+
+	histogram, err := table.Histogram(ctx, "v")
+	require.NoError(err)
+	require.Equal(uint64(6), histogram.RowCount())
+	cached, err := table.Histogram(ctx, "v")
+	require.NoError(err)
+	require.True(histogram == cached)
+	cardinality, err := stats.Cardinality(ctx)
+	require.NoError(err)
+	require.Equal(uint64(3), cardinality)
+
+	require.NoError(table.Insert(ctx, sql.NewRow(int64(6), int64(3))))
+
+	histogram, err = table.Histogram(ctx, "v")
+	require.NoError(err)
+	require.False(histogram == cached)
+	require.Equal(uint64(7), histogram.RowCount())
+	require.Equal(uint64(4), histogram.DistinctCount())
+	cardinality, err = stats.Cardinality(ctx)
+	require.NoError(err)
+	require.Equal(uint64(4), cardinality)
+}
@@ -0,0 +1,274 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metrics is a registry of the metrics of the server and engine, which can be exported in the Prometheus text
+// format. The metrics implement the go-kit metrics interfaces, and are labeled the go-kit way: With takes label names
+// and values in turn.
+package metrics
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/go-kit/kit/metrics"
+)
+
+// Default is the registry the metrics of the server and engine are registered in.
+var Default = NewRegistry()
+
+// DefaultBuckets are the upper bounds of the buckets of histograms of durations in seconds.
+var DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// OverflowLabel is the value of every label of the series which the values of new series are added to once a metric
+// has reached its limit of series.
+const OverflowLabel = "other"
+
+type metricType string
+
+const (
+	counterType   metricType = "counter"
+	gaugeType     metricType = "gauge"
+	histogramType metricType = "histogram"
+)
+
+// Registry holds metrics by name.
+type Registry struct {
+	mu       sync.RWMutex
+	families map[string]*family
+}
+
+// NewRegistry returns an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{families: make(map[string]*family)}
+}
+
+// NewCounter registers a counter, which replaces any metric registered with the same name.
+func (r *Registry) NewCounter(name, help string) *Counter {
+	return &Counter{f: r.register(name, help, counterType, nil, nil)}
+}
+
+// NewGauge registers a gauge, which replaces any metric registered with the same name.
+func (r *Registry) NewGauge(name, help string) *Gauge {
+	return &Gauge{f: r.register(name, help, gaugeType, nil, nil)}
+}
+
+// NewGaugeFunc registers a gauge whose value is the result of the function given at the time of export, which
+// replaces any metric registered with the same name.
+func (r *Registry) NewGaugeFunc(name, help string, fn func() float64) {
+	r.register(name, help, gaugeType, nil, fn)
+}
+
+// NewHistogram registers a histogram with the bucket upper bounds given, which replaces any metric registered with
+// the same name.
+func (r *Registry) NewHistogram(name, help string, buckets []float64) *Histogram {
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+	return &Histogram{f: r.register(name, help, histogramType, buckets, nil)}
+}
+
+// Unregister removes the metric with the name given, if any.
+func (r *Registry) Unregister(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.families, name)
+}
+
+func (r *Registry) register(name, help string, typ metricType, buckets []float64, fn func() float64) *family {
+	f := &family{
+		name:    name,
+		help:    help,
+		typ:     typ,
+		buckets: buckets,
+		fn:      fn,
+		series:  make(map[string]*series),
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.families[name] = f
+	return f
+}
+
+// family is a metric with all of its series.
+type family struct {
+	name    string
+	help    string
+	typ     metricType
+	buckets []float64
+	fn      func() float64
+
+	mu        sync.Mutex
+	series    map[string]*series
+	maxSeries int
+}
+
+// series is the value of a metric for a set of label values.
+type series struct {
+	labels []labelPair
+	value  float64
+	// counts are the number of observations in each bucket of a histogram, not cumulative
+	counts []uint64
+	count  uint64
+}
+
+type labelPair struct {
+	name, value string
+}
+
+// update calls the function given with the series of the label values given, which is created if it doesn't exist.
+func (f *family) update(lvs []string, fn func(s *series)) {
+	labels := toLabels(lvs)
+	key := labelsKey(labels)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	s, ok := f.series[key]
+	if !ok {
+		if f.maxSeries > 0 && len(f.series) >= f.maxSeries {
+			for i := range labels {
+				labels[i].value = OverflowLabel
+			}
+			key = labelsKey(labels)
+			s, ok = f.series[key]
+		}
+		if !ok {
+			s = &series{labels: labels}
+			if f.typ == histogramType {
+				s.counts = make([]uint64, len(f.buckets))
+			}
+			f.series[key] = s
+		}
+	}
+	fn(s)
+}
+
+func (f *family) limit(n int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.maxSeries = n
+}
+
+// toLabels returns the label pairs of go-kit label values sorted by name. A label given more than once takes its last
+// value.
+func toLabels(lvs []string) []labelPair {
+	byName := make(map[string]string, len(lvs)/2)
+	for i := 0; i+1 < len(lvs); i += 2 {
+		byName[lvs[i]] = lvs[i+1]
+	}
+	labels := make([]labelPair, 0, len(byName))
+	for name, value := range byName {
+		labels = append(labels, labelPair{name, value})
+	}
+	sort.Slice(labels, func(i, j int) bool { return labels[i].name < labels[j].name })
+	return labels
+}
+
+func labelsKey(labels []labelPair) string {
+	var sb strings.Builder
+	for _, l := range labels {
+		sb.WriteString(l.name)
+		sb.WriteByte(0)
+		sb.WriteString(l.value)
+		sb.WriteByte(0)
+	}
+	return sb.String()
+}
+
+// withLabels appends go-kit label values, padding an odd number of them with "unknown" as go-kit does.
+func withLabels(lvs []string, labelValues []string) []string {
+	if len(labelValues)%2 != 0 {
+		labelValues = append(labelValues, "unknown")
+	}
+	return append(append([]string(nil), lvs...), labelValues...)
+}
+
+// Counter is a monotonically increasing value.
+type Counter struct {
+	f   *family
+	lvs []string
+}
+
+var _ metrics.Counter = (*Counter)(nil)
+
+// With implements the metrics.Counter interface.
+func (c *Counter) With(labelValues ...string) metrics.Counter {
+	return &Counter{f: c.f, lvs: withLabels(c.lvs, labelValues)}
+}
+
+// Add implements the metrics.Counter interface.
+func (c *Counter) Add(delta float64) {
+	c.f.update(c.lvs, func(s *series) { s.value += delta })
+}
+
+// LimitSeries limits the number of series of the counter. Once reached, new label values are counted in a series
+// whose labels are all OverflowLabel.
+func (c *Counter) LimitSeries(n int) *Counter {
+	c.f.limit(n)
+	return c
+}
+
+// Gauge is a value which can go up and down.
+type Gauge struct {
+	f   *family
+	lvs []string
+}
+
+var _ metrics.Gauge = (*Gauge)(nil)
+
+// With implements the metrics.Gauge interface.
+func (g *Gauge) With(labelValues ...string) metrics.Gauge {
+	return &Gauge{f: g.f, lvs: withLabels(g.lvs, labelValues)}
+}
+
+// Set implements the metrics.Gauge interface.
+func (g *Gauge) Set(value float64) {
+	g.f.update(g.lvs, func(s *series) { s.value = value })
+}
+
+// Add implements the metrics.Gauge interface.
+func (g *Gauge) Add(delta float64) {
+	g.f.update(g.lvs, func(s *series) { s.value += delta })
+}
+
+// Histogram counts observations in buckets.
+type Histogram struct {
+	f   *family
+	lvs []string
+}
+
+var _ metrics.Histogram = (*Histogram)(nil)
+
+// With implements the metrics.Histogram interface.
+func (h *Histogram) With(labelValues ...string) metrics.Histogram {
+	return &Histogram{f: h.f, lvs: withLabels(h.lvs, labelValues)}
+}
+
+// Observe implements the metrics.Histogram interface.
+func (h *Histogram) Observe(value float64) {
+	i := sort.SearchFloat64s(h.f.buckets, value)
+	h.f.update(h.lvs, func(s *series) {
+		if i < len(s.counts) {
+			s.counts[i]++
+		}
+		s.count++
+		s.value += value
+	})
+}
+
+// LimitSeries limits the number of series of the histogram. Once reached, observations with new label values are
+// made in a series whose labels are all OverflowLabel.
+func (h *Histogram) LimitSeries(n int) *Histogram {
+	h.f.limit(n)
+	return h
+}
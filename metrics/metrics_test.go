@@ -0,0 +1,137 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryWrite(t *testing.T) {
+	require := require.New(t)
+
+	r := NewRegistry()
+	c := r.NewCounter("queries_total", "Number of queries.")
+	c.With("type", "select").Add(2)
+	c.With("type", "insert").Add(1)
+	c.With("type", "select").Add(1)
+
+	g := r.NewGauge("connections", "Open connections.")
+	g.Add(3)
+	g.Add(-1)
+
+	h := r.NewHistogram("latency_seconds", "Latency.", []float64{1, 0.1})
+	h.With("digest", `a"b\c`).Observe(0.05)
+	h.With("digest", `a"b\c`).Observe(0.5)
+	h.With("digest", `a"b\c`).Observe(3)
+
+	r.NewGaugeFunc("processes", "Running processes.\nOne per query.", func() float64 { return 7 })
+
+	var buf bytes.Buffer
+	require.NoError(r.Write(&buf))
+	require.Equal(`# HELP connections Open connections.
+# TYPE connections gauge
+connections 2
+# HELP latency_seconds Latency.
+# TYPE latency_seconds histogram
+latency_seconds_bucket{digest="a\"b\\c",le="0.1"} 1
+latency_seconds_bucket{digest="a\"b\\c",le="1"} 2
+latency_seconds_bucket{digest="a\"b\\c",le="+Inf"} 3
+latency_seconds_sum{digest="a\"b\\c"} 3.55
+latency_seconds_count{digest="a\"b\\c"} 3
+# HELP processes Running processes.\nOne per query.
+# TYPE processes gauge
+processes 7
+# HELP queries_total Number of queries.
+# TYPE queries_total counter
+queries_total{type="insert"} 1
+queries_total{type="select"} 3
+`, buf.String())
+}
+
+func TestRegistryLabels(t *testing.T) {
+	require := require.New(t)
+
+	r := NewRegistry()
+	c := r.NewCounter("c", "C.")
+	// Labels are sorted by name, and an odd number of label values is padded
+	c.With("b", "1", "a", "2").Add(1)
+	c.With("a", "2").With("b", "1").Add(1)
+	c.With("a").Add(1)
+
+	var buf bytes.Buffer
+	require.NoError(r.Write(&buf))
+	require.Equal(`# HELP c C.
+# TYPE c counter
+c{a="2",b="1"} 2
+c{a="unknown"} 1
+`, buf.String())
+}
+
+func TestRegistryLimitSeries(t *testing.T) {
+	require := require.New(t)
+
+	r := NewRegistry()
+	c := r.NewCounter("c", "C.").LimitSeries(2)
+	c.With("a", "1").Add(1)
+	c.With("a", "2").Add(1)
+	c.With("a", "3").Add(1)
+	c.With("a", "4").Add(1)
+	c.With("a", "1").Add(1)
+
+	var buf bytes.Buffer
+	require.NoError(r.Write(&buf))
+	require.Equal(`# HELP c C.
+# TYPE c counter
+c{a="1"} 2
+c{a="2"} 1
+c{a="other"} 2
+`, buf.String())
+}
+
+func TestRegistryReplace(t *testing.T) {
+	require := require.New(t)
+
+	r := NewRegistry()
+	r.NewCounter("c", "C.").Add(1)
+	r.NewGauge("c", "G.").Set(5)
+	r.NewGauge("d", "D.").Set(1)
+	r.Unregister("d")
+
+	var buf bytes.Buffer
+	require.NoError(r.Write(&buf))
+	require.Equal(`# HELP c G.
+# TYPE c gauge
+c 5
+`, buf.String())
+}
+
+func TestRegistryHandler(t *testing.T) {
+	require := require.New(t)
+
+	r := NewRegistry()
+	r.NewCounter("c", "C.").Add(1)
+
+	rec := httptest.NewRecorder()
+	r.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	require.Equal(ContentType, rec.Header().Get("Content-Type"))
+	body, err := ioutil.ReadAll(rec.Body)
+	require.NoError(err)
+	require.Equal("# HELP c C.\n# TYPE c counter\nc 1\n", string(body))
+}
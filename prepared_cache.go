@@ -0,0 +1,237 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"sync"
+
+	"github.com/linanh/go-mysql-server/sql"
+	"github.com/linanh/go-mysql-server/sql/plan"
+)
+
+// PreparedDataCache holds the plans of the statements prepared in each session, analyzed as far as they can be before
+// the values bound to them are known. Plans are dropped when DDL statements change the tables they reference.
+type PreparedDataCache struct {
+	mu   sync.Mutex
+	data map[uint32]map[preparedKey]*preparedQuery
+}
+
+// preparedKey identifies a prepared statement in a session. Table names in the statement are resolved against the
+// current database, so the same text is a different statement in another database.
+type preparedKey struct {
+	database string
+	query    string
+}
+
+// preparedQuery is a cached prepared statement.
+type preparedQuery struct {
+	// parsed is the statement as parsed, used for privilege checks and to start transactions
+	parsed sql.Node
+	// node is the statement analyzed by Analyzer.PrepareQuery
+	node sql.Node
+	// tables holds the lower cased database and table names referenced by the statement
+	tables []sql.PrivilegeTarget
+}
+
+// NewPreparedDataCache creates an empty PreparedDataCache.
+func NewPreparedDataCache() *PreparedDataCache {
+	return &PreparedDataCache{data: make(map[uint32]map[preparedKey]*preparedQuery)}
+}
+
+func (p *PreparedDataCache) get(ctx *sql.Context, query string) *preparedQuery {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.data[ctx.Session.ID()][preparedKey{ctx.GetCurrentDatabase(), query}]
+}
+
+// Cached returns whether the query given has been prepared in the session of the context given.
+func (p *PreparedDataCache) Cached(ctx *sql.Context, query string) bool {
+	return p.get(ctx, query) != nil
+}
+
+func (p *PreparedDataCache) set(ctx *sql.Context, query string, pq *preparedQuery) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	session, ok := p.data[ctx.Session.ID()]
+	if !ok {
+		session = make(map[preparedKey]*preparedQuery)
+		p.data[ctx.Session.ID()] = session
+	}
+	session[preparedKey{ctx.GetCurrentDatabase(), query}] = pq
+}
+
+func (p *PreparedDataCache) delete(ctx *sql.Context, query string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.data[ctx.Session.ID()], preparedKey{ctx.GetCurrentDatabase(), query})
+}
+
+// Retain drops the statements of a session whose text isn't one of the queries given, such as those closed by the
+// client.
+func (p *PreparedDataCache) Retain(sessionID uint32, queries map[string]bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for key := range p.data[sessionID] {
+		if !queries[key.query] {
+			delete(p.data[sessionID], key)
+		}
+	}
+}
+
+// UncacheSession drops all the statements of a session.
+func (p *PreparedDataCache) UncacheSession(sessionID uint32) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.data, sessionID)
+}
+
+// Invalidate drops the statements of every session which reference the targets given. A target without a table
+// stands for every table in its database.
+func (p *PreparedDataCache) Invalidate(targets []sql.PrivilegeTarget) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, session := range p.data {
+		for key, pq := range session {
+			if pq.references(targets) {
+				delete(session, key)
+			}
+		}
+	}
+}
+
+func (pq *preparedQuery) references(targets []sql.PrivilegeTarget) bool {
+	for _, t := range pq.tables {
+		for _, target := range targets {
+			if t.Database == target.Database && (target.Table == "" || t.Table == target.Table) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isPreparable returns whether the plan of a parsed statement can be cached. Only queries and data changes are, as
+// the plans of other statements are cheap to build or depend on more than the tables they reference.
+func isPreparable(parsed sql.Node) bool {
+	switch parsed.(type) {
+	case *plan.Project, *plan.GroupBy, *plan.Having, *plan.Sort, *plan.Limit, *plan.Offset, *plan.Distinct,
+		*plan.Filter, *plan.Union, *plan.With, *plan.InsertInto, *plan.Update, *plan.DeleteFrom:
+	default:
+		return false
+	}
+
+	// Tables read as of a point in time are resolved with the value of that point when the statement is analyzed
+	preparable := true
+	plan.Inspect(parsed, func(n sql.Node) bool {
+		if t, ok := n.(*plan.UnresolvedTable); ok && t.AsOf != nil {
+			preparable = false
+		}
+		return preparable
+	})
+	return preparable
+}
+
+// referencedTables returns the tables and views a parsed statement references.
+func referencedTables(ctx *sql.Context, parsed sql.Node) []sql.PrivilegeTarget {
+	var tables []sql.PrivilegeTarget
+	for _, c := range privilegeChecks(ctx, nil, parsed) {
+		if c.Target.Table != "" {
+			tables = append(tables, c.Target)
+		}
+	}
+	return tables
+}
+
+// ddlTargets returns the tables or databases changed by a DDL statement.
+func ddlTargets(ctx *sql.Context, parsed sql.Node) []sql.PrivilegeTarget {
+	var targets []sql.PrivilegeTarget
+	for _, c := range privilegeChecks(ctx, nil, parsed) {
+		if c.Target.Database != "" {
+			targets = append(targets, c.Target)
+		}
+	}
+	return targets
+}
+
+// refreshTables replaces the tables of a prepared plan with the current versions from their databases, which may
+// differ between transactions. It returns false if any table was dropped or had its schema changed, in which case the
+// plan must be prepared again.
+func refreshTables(ctx *sql.Context, n sql.Node) (sql.Node, bool, error) {
+	fresh := true
+	var refresh func(n sql.Node) (sql.Node, error)
+	refresh = func(n sql.Node) (sql.Node, error) {
+		n, err := plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+			switch n := n.(type) {
+			case *plan.ResolvedTable:
+				// Tables without a database, such as dual, never change
+				if n.Database == nil {
+					return n, nil
+				}
+				t, ok, err := n.Database.GetTableInsensitive(ctx, n.Name())
+				if err != nil {
+					return nil, err
+				}
+				if !ok || !t.Schema().Equals(n.Schema()) {
+					fresh = false
+					return n, nil
+				}
+				return plan.NewResolvedTable(t, n.Database, n.AsOf), nil
+			case *plan.SubqueryAlias:
+				child, err := refresh(n.Child)
+				if err != nil {
+					return nil, err
+				}
+				return n.WithChildren(child)
+			case *plan.Union:
+				left, err := refresh(n.Left())
+				if err != nil {
+					return nil, err
+				}
+				right, err := refresh(n.Right())
+				if err != nil {
+					return nil, err
+				}
+				return n.WithChildren(left, right)
+			case *plan.InsertInto:
+				source, err := refresh(n.Source)
+				if err != nil {
+					return nil, err
+				}
+				return n.WithSource(source), nil
+			default:
+				return n, nil
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+		return plan.TransformExpressionsUp(ctx, n, func(e sql.Expression) (sql.Expression, error) {
+			if sq, ok := e.(*plan.Subquery); ok {
+				query, err := refresh(sq.Query)
+				if err != nil {
+					return nil, err
+				}
+				return sq.WithQuery(query), nil
+			}
+			return e, nil
+		})
+	}
+
+	n, err := refresh(n)
+	if err != nil {
+		return nil, false, err
+	}
+	return n, fresh, nil
+}
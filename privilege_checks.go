@@ -0,0 +1,554 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/linanh/go-mysql-server/sql"
+	"github.com/linanh/go-mysql-server/sql/expression"
+	"github.com/linanh/go-mysql-server/sql/information_schema"
+	"github.com/linanh/go-mysql-server/sql/plan"
+)
+
+// privilegeChecks returns the privileges the current user needs to execute the parsed node given. Tables read anywhere
+// in the statement need SELECT, while the objects a statement modifies need the privilege for that modification. When
+// a catalog is given, the checks on the tables a statement reads, inserts into or updates list the columns it uses,
+// so the privileges can be held on those columns instead.
+func privilegeChecks(ctx *sql.Context, catalog *sql.Catalog, node sql.Node) []sql.PrivilegeCheck {
+	pc := &privilegeCollector{ctx: ctx, catalog: catalog, ctes: make(map[string]bool)}
+	pc.collect(node)
+	return pc.checks
+}
+
+type privilegeCollector struct {
+	ctx     *sql.Context
+	catalog *sql.Catalog
+	checks  []sql.PrivilegeCheck
+	// ctes holds the names of common table expressions, which look like tables but aren't
+	ctes map[string]bool
+}
+
+func (pc *privilegeCollector) add(privs sql.PrivilegeType, target sql.PrivilegeTarget) {
+	pc.checks = append(pc.checks, sql.PrivilegeCheck{Target: target, Privileges: privs})
+}
+
+// addColumns adds a check for privileges which can also be held on the columns given of the table given, if its
+// schema is known.
+func (pc *privilegeCollector) addColumns(privs sql.PrivilegeType, t *scopeTable, columns []string) {
+	check := sql.PrivilegeCheck{Target: t.target, Privileges: privs}
+	if t.schema != nil {
+		check.ByColumn = true
+		check.Columns = columns
+	}
+	pc.checks = append(pc.checks, check)
+}
+
+// database returns the lower cased name of the database given, or of the current database if it's empty.
+func (pc *privilegeCollector) database(name string) string {
+	if name == "" {
+		name = pc.ctx.GetCurrentDatabase()
+	}
+	return strings.ToLower(name)
+}
+
+func (pc *privilegeCollector) databaseTarget(db string) sql.PrivilegeTarget {
+	return sql.PrivilegeTarget{Database: pc.database(db)}
+}
+
+// databaseOfTarget returns the target for the database given, which is the current database if it's nil.
+func (pc *privilegeCollector) databaseOfTarget(db sql.Database) sql.PrivilegeTarget {
+	var name string
+	if db != nil {
+		name = db.Name()
+	}
+	return pc.databaseTarget(name)
+}
+
+func (pc *privilegeCollector) tableTarget(db sql.Database, table string) sql.PrivilegeTarget {
+	var name string
+	if db != nil {
+		name = db.Name()
+	}
+	return sql.PrivilegeTarget{Database: pc.database(name), Table: strings.ToLower(table)}
+}
+
+// tables adds a check for the privileges given on every table in the node given, and for SELECT on every table in
+// its subqueries.
+func (pc *privilegeCollector) tables(node sql.Node, privs sql.PrivilegeType) {
+	if node == nil {
+		return
+	}
+	s := pc.scope(node, nil)
+	for _, t := range s.tables {
+		if !t.skip {
+			pc.add(privs, t.target)
+		}
+	}
+}
+
+// read adds a check for SELECT on the tables the query given reads, with the columns of them it uses.
+func (pc *privilegeCollector) read(node sql.Node, outer *columnScope) {
+	if node == nil {
+		return
+	}
+	s := pc.scope(node, outer)
+	for _, t := range s.tables {
+		if !t.skip {
+			pc.addColumns(sql.PrivilegeType_Select, t, t.readColumns())
+		}
+	}
+}
+
+// columnScope holds the tables of a query level, which the column references in it and in its subqueries resolve to.
+type columnScope struct {
+	outer  *columnScope
+	tables []*scopeTable
+}
+
+// scopeTable is a table of a query level, along with the columns of it the query reads and assigns.
+type scopeTable struct {
+	// name is the alias of the table, or its name if it has none
+	name   string
+	target sql.PrivilegeTarget
+	// schema is the schema of the table, which is nil when it isn't known
+	schema sql.Schema
+	// skip is set for the tables which aren't checked, like common table expressions
+	skip     bool
+	all      bool
+	read     []string
+	assigned []string
+}
+
+// readColumns returns the columns of the table the query reads.
+func (t *scopeTable) readColumns() []string {
+	if !t.all {
+		return t.read
+	}
+	columns := make([]string, len(t.schema))
+	for i, col := range t.schema {
+		columns[i] = strings.ToLower(col.Name)
+	}
+	return columns
+}
+
+func (t *scopeTable) has(column string) bool {
+	for _, col := range t.schema {
+		if strings.EqualFold(col.Name, column) {
+			return true
+		}
+	}
+	return false
+}
+
+func isStar(e sql.Expression) bool {
+	_, ok := e.(*expression.Star)
+	return ok
+}
+
+func addColumn(columns []string, column string) []string {
+	column = strings.ToLower(column)
+	for _, c := range columns {
+		if c == column {
+			return columns
+		}
+	}
+	return append(columns, column)
+}
+
+// resolve returns the tables the column given, qualified with the table name given if it's not empty, refers to. An
+// unqualified column refers to the tables of the innermost query level with such a column.
+func (s *columnScope) resolve(table, column string) []*scopeTable {
+	for ; s != nil; s = s.outer {
+		var tables []*scopeTable
+		for _, t := range s.tables {
+			if table != "" && strings.EqualFold(t.name, table) || table == "" && t.has(column) {
+				tables = append(tables, t)
+			}
+		}
+		if len(tables) > 0 {
+			return tables
+		}
+	}
+	return nil
+}
+
+// scope resolves the tables of the query level given and the columns its expressions use, which are recorded on the
+// tables of the scope returned. Derived tables, the sides of unions, common table expressions and subqueries are query
+// levels of their own, whose tables get a SELECT check right away.
+func (pc *privilegeCollector) scope(node sql.Node, outer *columnScope) *columnScope {
+	s := &columnScope{outer: outer}
+	var expressioners []sql.Expressioner
+	var visit func(n sql.Node, alias string)
+	visit = func(n sql.Node, alias string) {
+		switch n := n.(type) {
+		case *plan.With:
+			for _, cte := range n.CTEs {
+				pc.ctes[strings.ToLower(cte.Subquery.Name())] = true
+			}
+			for _, cte := range n.CTEs {
+				pc.read(cte.Subquery.Child, outer)
+			}
+		case *plan.Union:
+			pc.read(n.Left(), outer)
+			pc.read(n.Right(), outer)
+			return
+		case *plan.SubqueryAlias:
+			pc.read(n.Child, outer)
+			return
+		case *plan.TableAlias:
+			visit(n.Child, n.Name())
+			return
+		case *plan.UnresolvedTable:
+			s.tables = append(s.tables, pc.table(n, alias))
+			return
+		}
+		if e, ok := n.(sql.Expressioner); ok {
+			expressioners = append(expressioners, e)
+		}
+		for _, child := range n.Children() {
+			visit(child, "")
+		}
+	}
+	visit(node, "")
+
+	var inspect func(e sql.Expression) bool
+	inspect = func(e sql.Expression) bool {
+		switch e := e.(type) {
+		case *plan.Subquery:
+			pc.read(e.Query, s)
+			return false
+		case *expression.SetField:
+			if col, ok := e.Left.(*expression.UnresolvedColumn); ok {
+				for _, t := range s.resolve(col.Table(), col.Name()) {
+					t.assigned = addColumn(t.assigned, col.Name())
+				}
+				sql.Inspect(e.Right, inspect)
+				return false
+			}
+		case *expression.UnresolvedColumn:
+			for _, t := range s.resolve(e.Table(), e.Name()) {
+				t.read = addColumn(t.read, e.Name())
+			}
+		case *expression.UnresolvedFunction:
+			// COUNT(*) counts rows without reading any column
+			if args := e.Children(); strings.EqualFold(e.Name(), "count") && len(args) == 1 && e.Window == nil && isStar(args[0]) {
+				return false
+			}
+		case *expression.Star:
+			for _, t := range s.tables {
+				if e.Table == "" || strings.EqualFold(t.name, e.Table) {
+					t.all = t.schema != nil
+				}
+			}
+		}
+		return true
+	}
+	for _, n := range expressioners {
+		for _, e := range n.Expressions() {
+			sql.Inspect(e, inspect)
+		}
+	}
+	return s
+}
+
+// table returns the scope table for the table given, which is known by the alias given if it's not empty.
+func (pc *privilegeCollector) table(t *plan.UnresolvedTable, alias string) *scopeTable {
+	db := pc.database(t.Database)
+	name := strings.ToLower(t.Name())
+	st := &scopeTable{name: name, target: sql.PrivilegeTarget{Database: db, Table: name}}
+	if alias != "" {
+		st.name = alias
+	}
+	if db == information_schema.InformationSchemaDatabaseName || (t.Database == "" && (name == "dual" || pc.ctes[name])) {
+		st.skip = true
+		return st
+	}
+	if pc.catalog != nil {
+		if table, _, err := pc.catalog.Table(pc.ctx, db, name); err == nil {
+			st.schema = table.Schema()
+		}
+	}
+	return st
+}
+
+// insert adds the checks on the destination of the statement given, which inserts the columns it lists, or every
+// column if it lists none, and updates the ones its ON DUPLICATE KEY UPDATE clause assigns.
+func (pc *privilegeCollector) insert(n *plan.InsertInto) {
+	s := pc.scope(n.Destination, nil)
+	for _, t := range s.tables {
+		if t.skip {
+			continue
+		}
+		columns := make([]string, len(n.ColumnNames))
+		for i, name := range n.ColumnNames {
+			columns[i] = strings.ToLower(name)
+		}
+		if len(columns) == 0 {
+			t.all = t.schema != nil
+			columns = t.readColumns()
+		}
+		pc.addColumns(sql.PrivilegeType_Insert, t, columns)
+		if n.IsReplace {
+			pc.add(sql.PrivilegeType_Delete, t.target)
+		}
+		if len(n.OnDupExprs) > 0 {
+			var assigned []string
+			for _, e := range n.OnDupExprs {
+				if set, ok := e.(*expression.SetField); ok {
+					if col, ok := set.Left.(*expression.UnresolvedColumn); ok {
+						assigned = addColumn(assigned, col.Name())
+					}
+				}
+			}
+			pc.addColumns(sql.PrivilegeType_Update, t, assigned)
+		}
+	}
+}
+
+// change adds the checks for an UPDATE or DELETE statement, which need the privileges given on the tables they change
+// and SELECT on the columns they read. The UPDATE privilege can be held on the columns assigned instead.
+func (pc *privilegeCollector) change(node sql.Node, privs sql.PrivilegeType) {
+	s := pc.scope(node, nil)
+	for _, t := range s.tables {
+		if t.skip {
+			continue
+		}
+		if privs == sql.PrivilegeType_Update {
+			pc.addColumns(privs, t, t.assigned)
+		} else {
+			pc.add(privs, t.target)
+		}
+		if len(t.read) > 0 || t.all {
+			pc.addColumns(sql.PrivilegeType_Select, t, t.readColumns())
+		}
+	}
+}
+
+func (pc *privilegeCollector) collect(node sql.Node) {
+	alter := sql.PrivilegeType_Alter
+
+	switch n := node.(type) {
+	case *plan.InsertInto:
+		pc.insert(n)
+		// LOAD DATA reads a file of the server instead of tables, unless the client sends it
+		if ld, ok := n.Source.(*plan.LoadData); ok {
+			if !ld.Local {
+				pc.add(sql.PrivilegeType_File, sql.PrivilegeTarget{})
+			}
+		} else {
+			pc.read(n.Source, nil)
+		}
+	case *plan.Update:
+		pc.change(n.Child, sql.PrivilegeType_Update)
+	case *plan.DeleteFrom:
+		pc.change(n.Child, sql.PrivilegeType_Delete)
+	case *plan.Truncate:
+		pc.tables(n.Child, sql.PrivilegeType_Drop)
+	case *plan.CreateTable:
+		pc.add(sql.PrivilegeType_Create, pc.tableTarget(n.Database(), n.Name()))
+		pc.tables(n.Like(), sql.PrivilegeType_Select)
+		pc.read(n.Select(), nil)
+	case *plan.DropTable:
+		for _, name := range n.TableNames() {
+			pc.add(sql.PrivilegeType_Drop, pc.tableTarget(n.Database(), name))
+		}
+	case *plan.RenameTable:
+		for _, name := range n.OldNames() {
+			pc.add(sql.PrivilegeType_Alter|sql.PrivilegeType_Drop, pc.tableTarget(n.Database(), name))
+		}
+		for _, name := range n.NewNames() {
+			pc.add(sql.PrivilegeType_Create|sql.PrivilegeType_Insert, pc.tableTarget(n.Database(), name))
+		}
+	case *plan.AddColumn:
+		pc.add(alter, pc.tableTarget(n.Database(), n.TableName()))
+	case *plan.ModifyColumn:
+		pc.add(alter, pc.tableTarget(n.Database(), n.TableName()))
+	case *plan.DropColumn:
+		pc.add(alter, pc.tableTarget(n.Database(), n.TableName()))
+	case *plan.RenameColumn:
+		pc.add(alter, pc.tableTarget(n.Database(), n.TableName()))
+	case *plan.CreateForeignKey:
+		pc.add(alter, pc.tableTarget(n.Database(), n.Table))
+		pc.add(sql.PrivilegeType_References, pc.tableTarget(n.Database(), n.ReferencedTable))
+	case *plan.AlterPK:
+		pc.tables(n.Table, alter)
+	case *plan.AlterAutoIncrement, *plan.AlterDefaultSet, *plan.AlterDefaultDrop, *plan.CreateCheck, *plan.DropCheck,
+		*plan.DropConstraint, *plan.DropForeignKey, *plan.AlterPartition:
+		pc.tables(n, alter)
+	case *plan.CreateIndex:
+		pc.tables(n.Table, sql.PrivilegeType_Index)
+	case *plan.DropIndex:
+		pc.tables(n.Table, sql.PrivilegeType_Index)
+	case *plan.AlterIndex:
+		pc.tables(n.Table, sql.PrivilegeType_Index)
+	case *plan.CreateDB:
+		pc.add(sql.PrivilegeType_Create, pc.databaseTarget(n.DatabaseName()))
+	case *plan.DropDB:
+		pc.add(sql.PrivilegeType_Drop, pc.databaseTarget(n.DatabaseName()))
+	case *plan.CreateView:
+		privs := sql.PrivilegeType_CreateView
+		if n.IsReplace {
+			privs |= sql.PrivilegeType_Drop
+		}
+		pc.add(privs, pc.tableTarget(n.Database(), n.Name))
+		pc.read(n.Child, nil)
+	case *plan.DropView:
+		for _, child := range n.Children() {
+			if dv, ok := child.(*plan.SingleDropView); ok {
+				pc.add(sql.PrivilegeType_Drop, pc.tableTarget(dv.Database(), dv.ViewName()))
+			}
+		}
+	case *plan.CreateTrigger:
+		pc.tables(n.Table, sql.PrivilegeType_Trigger)
+	case *plan.DropTrigger:
+		pc.add(sql.PrivilegeType_Trigger, pc.databaseOfTarget(n.Database()))
+	case *plan.CreateProcedure:
+		pc.add(sql.PrivilegeType_CreateRoutine, pc.databaseOfTarget(n.Database()))
+	case *plan.DropProcedure:
+		pc.add(sql.PrivilegeType_AlterRoutine, pc.databaseOfTarget(n.Database()))
+	case *plan.Call:
+		pc.add(sql.PrivilegeType_Execute, pc.databaseTarget(""))
+	case *plan.LockTables:
+		for _, l := range n.Locks {
+			pc.tables(l.Table, sql.PrivilegeType_Select|sql.PrivilegeType_LockTables)
+		}
+	case *plan.CreateUser, *plan.AlterUser, *plan.GrantRole, *plan.RevokeRole:
+		pc.add(sql.PrivilegeType_CreateUser, sql.PrivilegeTarget{})
+	case *plan.DropUser:
+		if n.IsRole {
+			pc.add(sql.PrivilegeType_DropRole, sql.PrivilegeTarget{})
+		} else {
+			pc.add(sql.PrivilegeType_CreateUser, sql.PrivilegeTarget{})
+		}
+	case *plan.CreateRole:
+		pc.add(sql.PrivilegeType_CreateRole, sql.PrivilegeTarget{})
+	case *plan.Grant:
+		pc.add(sql.PrivilegeType_GrantOption|privilegeSpecsType(n.Privileges), n.Target)
+	case *plan.Revoke:
+		if n.All {
+			pc.add(sql.PrivilegeType_CreateUser, sql.PrivilegeTarget{})
+		} else {
+			pc.add(sql.PrivilegeType_GrantOption|privilegeSpecsType(n.Privileges), n.Target)
+		}
+	case *plan.ShowGrants:
+		// Looking at the grants of other users requires reading the grant tables
+		if n.For != nil {
+			pc.add(sql.PrivilegeType_Select, sql.PrivilegeTarget{Database: "mysql"})
+		}
+	case *plan.ShowBinaryLogs, *plan.ShowMasterStatus, *plan.ShowReplicaStatus:
+		pc.add(sql.PrivilegeType_ReplicationClient, sql.PrivilegeTarget{})
+	case *plan.ShowBinlogEvents:
+		pc.add(sql.PrivilegeType_ReplicationSlave, sql.PrivilegeTarget{})
+	case *plan.ChangeReplicationSource, *plan.StartReplica, *plan.StopReplica, *plan.PurgeBinaryLogs:
+		pc.add(sql.PrivilegeType_Super, sql.PrivilegeTarget{})
+	case *plan.Set:
+		if setsGlobalVariable(n) {
+			pc.add(sql.PrivilegeType_Super, sql.PrivilegeTarget{})
+		}
+		pc.read(n, nil)
+	default:
+		pc.read(node, nil)
+	}
+}
+
+// killCheck returns an error if the node given is a KILL statement naming a connection of another user or host, and
+// the current user doesn't hold the PROCESS privilege. Without a privilege store, which is the case when the engine's
+// auth doesn't manage privileges, no user holds it, so users only ever kill their own connections.
+func killCheck(ctx *sql.Context, catalog *sql.Catalog, node sql.Node) error {
+	k, ok := node.(*plan.Kill)
+	if !ok {
+		return nil
+	}
+	var connID uint32
+	// The id of connections named by a column is only known once it's resolved, so those need the privilege
+	if k.ConnID.Resolved() {
+		var err error
+		connID, err = k.ConnectionID(ctx, nil)
+		if err != nil {
+			return err
+		}
+		owner, ok := catalog.ProcessList.ConnectionClient(connID)
+		if !ok {
+			return sql.ErrUnknownThread.New(connID)
+		}
+		client := ctx.Client()
+		if owner.User == client.User && owner.Host() == client.Host() {
+			return nil
+		}
+	}
+
+	allowed, err := hasGlobalPrivileges(ctx, catalog, sql.PrivilegeType_Process)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return sql.ErrKillDenied.New(connID)
+	}
+	return nil
+}
+
+// hasGlobalPrivileges returns whether the current user holds the privileges given on every database. Without a
+// privilege store, which is the case when the engine's auth doesn't manage privileges, no user holds them.
+func hasGlobalPrivileges(ctx *sql.Context, catalog *sql.Catalog, privs sql.PrivilegeType) (bool, error) {
+	if catalog.PrivilegeStore == nil {
+		return false, nil
+	}
+	store := catalog.PrivilegeStore
+	account, ok, err := sql.FindAccount(ctx, store, ctx.Client().User, ctx.Client().Address)
+	if err != nil || !ok {
+		return false, err
+	}
+	held, err := sql.EffectivePrivileges(ctx, store, account.UserIdentity, sql.SessionActiveRoles(ctx), sql.PrivilegeTarget{})
+	if err != nil {
+		return false, err
+	}
+	return held.Has(privs), nil
+}
+
+// setsGlobalVariable returns whether the SET statement given assigns a system variable in the global scope, which
+// affects every session.
+func setsGlobalVariable(n *plan.Set) bool {
+	for _, e := range n.Exprs {
+		sf, ok := e.(*expression.SetField)
+		if !ok {
+			continue
+		}
+		switch left := sf.Left.(type) {
+		case *expression.SystemVar:
+			if left.Scope == sql.SystemVariableScope_Global {
+				return true
+			}
+		case *expression.UnresolvedColumn:
+			// SET @@GLOBAL.var is resolved by the analyzer
+			_, scope, err := sqlparser.VarScope(left.String())
+			if err == nil && (scope == sqlparser.SetScope_Global || scope == sqlparser.SetScope_Persist ||
+				scope == sqlparser.SetScope_PersistOnly) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func privilegeSpecsType(specs []plan.PrivilegeSpec) sql.PrivilegeType {
+	var privs sql.PrivilegeType
+	for _, s := range specs {
+		privs |= s.Privileges
+	}
+	return privs
+}
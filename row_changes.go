@@ -0,0 +1,66 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// rowChangesIter commits the changes made by a statement outside of a transaction once its rows are closed, and passes
+// the row changes of the transactions committed by the statement to the listeners of row changes of the engine, one
+// transaction at a time. Transactions are logged to the binary log as they're committed, and the statement itself is
+// logged after them if it changed the schema.
+type rowChangesIter struct {
+	childIter sql.RowIter
+	listeners *sql.RowChangeListeners
+	binlog    sql.Binlog
+	// query is the text of the statement if it's logged as a statement, or empty.
+	query string
+}
+
+var _ sql.RowIter = (*rowChangesIter)(nil)
+
+// Next implements the interface sql.RowIter.
+func (i *rowChangesIter) Next() (sql.Row, error) {
+	return i.childIter.Next()
+}
+
+// Close implements the interface sql.RowIter.
+func (i *rowChangesIter) Close(ctx *sql.Context) error {
+	// Changes made outside of a transaction, like the ones to databases which don't support them, are committed with
+	// the statement making them
+	changes := sql.SessionPendingChanges(ctx)
+	if err := i.childIter.Close(ctx); err != nil {
+		if ctx.GetTransaction() == nil {
+			changes.Rollback()
+		}
+		return err
+	}
+	if ctx.GetTransaction() == nil {
+		if err := changes.Commit(); err != nil {
+			return err
+		}
+	}
+	for _, committed := range changes.TakeCommitted() {
+		if err := i.listeners.TransactionCommitted(ctx, committed); err != nil {
+			return err
+		}
+	}
+
+	if i.query != "" {
+		return i.binlog.LogQuery(ctx, ctx.GetCurrentDatabase(), i.query)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	"github.com/dolthub/vitess/go/mysql"
+
+	"github.com/linanh/go-mysql-server/auth"
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// errMasterFatalReadingBinlog is the error code replicas are sent when their events can't be sent.
+const errMasterFatalReadingBinlog = 1236
+
+// binlogDumper is a binary log which can stream its events, such as a binlog.Log.
+type binlogDumper interface {
+	Dump(ctx context.Context, file string, pos uint64, nonBlock bool, send func(event []byte) error) error
+}
+
+// ComRegisterReplica implements the mysql.BinlogDumpHandler interface. Replicas are only checked for the REPLICATION
+// SLAVE privilege, as nothing is recorded about them.
+func (h *Handler) ComRegisterReplica(c *mysql.Conn, serverID uint32, host string, port uint16) error {
+	ctx, err := h.sm.NewContext(c)
+	if err != nil {
+		return err
+	}
+	return h.checkReplicationPrivileges(ctx)
+}
+
+// ComBinlogDump implements the mysql.BinlogDumpHandler interface. It sends the events of the engine's binary log from
+// the position given.
+func (h *Handler) ComBinlogDump(
+	ctx context.Context,
+	c *mysql.Conn,
+	file string,
+	pos uint32,
+	nonBlock bool,
+	send func(event []byte) error,
+) error {
+	sqlCtx, err := h.sm.NewContext(c)
+	if err != nil {
+		return err
+	}
+	if err := h.checkReplicationPrivileges(sqlCtx); err != nil {
+		return err
+	}
+	dumper, ok := h.e.Catalog.Binlog.(binlogDumper)
+	if !ok {
+		return mysql.NewSQLError(errMasterFatalReadingBinlog, mysql.SSUnknownSQLState, sql.ErrNoBinaryLogging.New().Error())
+	}
+
+	err = dumper.Dump(ctx, file, uint64(pos), nonBlock, send)
+	if err != nil && err != context.Canceled {
+		return mysql.NewSQLError(errMasterFatalReadingBinlog, mysql.SSUnknownSQLState, err.Error())
+	}
+	return err
+}
+
+// checkReplicationPrivileges checks that the user of the connection holds the REPLICATION SLAVE privilege, or can read
+// if the engine's auth doesn't manage privileges.
+func (h *Handler) checkReplicationPrivileges(ctx *sql.Context) error {
+	a := h.e.Auth
+	var err error
+	if pa, ok := a.(auth.PrivilegedAuth); ok {
+		err = pa.AllowedPrivileges(ctx, []sql.PrivilegeCheck{{Privileges: sql.PrivilegeType_ReplicationSlave}})
+	} else {
+		err = a.Allowed(ctx, auth.ReadPerm)
+	}
+	if err != nil {
+		sqlErr, _ := sql.CastSQLError(err)
+		return sqlErr
+	}
+	return nil
+}
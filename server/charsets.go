@@ -0,0 +1,130 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+
+	"github.com/linanh/go-mysql-server/sql"
+	"github.com/linanh/go-mysql-server/sql/encodings"
+)
+
+// convertedCharset returns the character set of a system variable of the session, such as character_set_client, and
+// its encoding, if strings have to be converted to or from it. The strings of the UTF-8 and binary character sets,
+// and of the ones which aren't supported, are kept as they are.
+func convertedCharset(ctx *sql.Context, name string) (sql.CharacterSet, encodings.Encoding, bool) {
+	cs, ok := sql.CharacterSetVariable(ctx, name)
+	if !ok || cs.IsUnicode() || cs == sql.CharacterSet_utf8mb3 {
+		return "", nil, false
+	}
+	e, err := cs.Encoding()
+	if err != nil {
+		return "", nil, false
+	}
+	return cs, e, true
+}
+
+// decodeClientString returns a string sent by the client, such as a query or the value of a bind variable, decoded
+// from character_set_client.
+func decodeClientString(ctx *sql.Context, s string) string {
+	if _, e, ok := convertedCharset(ctx, "character_set_client"); ok {
+		s, _ = e.Decode([]byte(s))
+	}
+	return s
+}
+
+// textBindings returns the bind variables of a prepared statement, with the values of the parameters the client sent
+// as strings typed as TEXT. The protocol gives the values of every kind of string as VARBINARY ones, which would
+// otherwise be taken as binary strings rather than strings of character_set_client.
+func textBindings(prepare *mysql.PrepareData) map[string]*query.BindVariable {
+	var bindings map[string]*query.BindVariable
+	for i, typ := range prepare.ParamsType {
+		key := fmt.Sprintf("v%d", i+1)
+		v, ok := prepare.BindVars[key]
+		if !ok || v == nil || v.Type != sqltypes.VarBinary || !sqltypes.IsText(query.Type(typ)) {
+			continue
+		}
+		if bindings == nil {
+			bindings = make(map[string]*query.BindVariable, len(prepare.BindVars))
+			for k, v := range prepare.BindVars {
+				bindings[k] = v
+			}
+		}
+		bindings[key] = &query.BindVariable{Type: sqltypes.Text, Value: v.Value}
+	}
+	if bindings == nil {
+		return prepare.BindVars
+	}
+	return bindings
+}
+
+// resultCharset is the character set the strings of result sets are sent in, which is character_set_results. The
+// strings aren't converted if its encoding is nil.
+type resultCharset struct {
+	id       uint32
+	encoding encodings.Encoding
+}
+
+// newResultCharset returns the resultCharset of the session of a context.
+func newResultCharset(ctx *sql.Context) resultCharset {
+	cs, e, ok := convertedCharset(ctx, "character_set_results")
+	if !ok {
+		return resultCharset{}
+	}
+	return resultCharset{id: uint32(cs.DefaultCollation().ID()), encoding: e}
+}
+
+// fieldCharset returns the character set ID of a column of a result set.
+func (rc resultCharset) fieldCharset(typ sql.Type) uint32 {
+	switch {
+	case sql.IsBlob(typ):
+		return mysql.CharacterSetBinary
+	case rc.encoding != nil:
+		return rc.id
+	default:
+		return mysql.CharacterSetUtf8
+	}
+}
+
+// encode returns a value of a result set with its string encoded in the character set.
+func (rc resultCharset) encode(v sqltypes.Value) sqltypes.Value {
+	if rc.encoding == nil || !sqltypes.IsText(v.Type()) {
+		return v
+	}
+	encoded, _ := rc.encoding.Encode(v.ToString())
+	return sqltypes.MakeTrusted(v.Type(), encoded)
+}
+
+// setHandshakeCharset sets the character sets of the session of a connection to the one of the collation the client
+// sent in its handshake, as SET NAMES does.
+func setHandshakeCharset(ctx *sql.Context, sess sql.Session, conn *mysql.Conn) error {
+	if conn.CharacterSet == 0 {
+		return nil
+	}
+	collation, ok := sql.CollationFromID(int64(conn.CharacterSet))
+	if !ok {
+		return nil
+	}
+	for _, name := range []string{"character_set_client", "character_set_connection", "character_set_results"} {
+		if err := sess.SetSessionVariable(ctx, name, collation.CharacterSet().String()); err != nil {
+			return err
+		}
+	}
+	return sess.SetSessionVariable(ctx, "collation_connection", collation.Name)
+}
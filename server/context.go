@@ -54,6 +54,8 @@ type SessionManager struct {
 	idxRegs   map[uint32]*sql.IndexRegistry
 	viewRegs  map[uint32]*sql.ViewRegistry
 	pid       uint64
+	// processList is told the user of each connection a session is created for, if it's set
+	processList *sql.ProcessList
 }
 
 // NewSessionManager creates a SessionManager with the given SessionBuilder.
@@ -103,6 +105,15 @@ func (s *SessionManager) NewSession(ctx context.Context, conn *mysql.Conn) error
 			WithField(sqle.ConnectTimeLogKey, time.Now()),
 	)
 
+	if s.processList != nil {
+		s.processList.SetConnectionClient(conn.ConnectionID, s.sessions[conn.ConnectionID].Client())
+	}
+
+	if err == nil {
+		sess := s.sessions[conn.ConnectionID]
+		err = setHandshakeCharset(sql.NewContext(ctx, sql.WithSession(sess)), sess, conn)
+	}
+
 	return err
 }
 
@@ -19,7 +19,6 @@ import (
 	"net"
 	"regexp"
 	"strconv"
-	"strings"
 	"sync"
 	"time"
 
@@ -27,7 +26,7 @@ import (
 	"github.com/dolthub/vitess/go/netutil"
 	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/dolthub/vitess/go/vt/proto/query"
-	"github.com/go-kit/kit/metrics/discard"
+	kitmetrics "github.com/go-kit/kit/metrics"
 	"github.com/opentracing/opentracing-go"
 	"github.com/sirupsen/logrus"
 	"gopkg.in/src-d/go-errors.v1"
@@ -35,14 +34,13 @@ import (
 	sqle "github.com/linanh/go-mysql-server"
 	"github.com/linanh/go-mysql-server/auth"
 	"github.com/linanh/go-mysql-server/internal/sockstate"
+	"github.com/linanh/go-mysql-server/metrics"
 	"github.com/linanh/go-mysql-server/sql"
 	"github.com/linanh/go-mysql-server/sql/expression"
 	"github.com/linanh/go-mysql-server/sql/parse"
 	"github.com/linanh/go-mysql-server/sql/plan"
 )
 
-var regKillCmd = regexp.MustCompile(`^kill (?:(query|connection) )?(\d+)$`)
-
 var errConnectionNotFound = errors.NewKind("connection not found: %c")
 
 // ErrRowTimeout will be returned if the wait for the row is longer than the connection timeout
@@ -63,10 +61,15 @@ type Handler struct {
 	e           *sqle.Engine
 	sm          *SessionManager
 	readTimeout time.Duration
+	// audit, if not nil, is sent the queries run, besides the engine's Auth
+	audit auth.AuditMethod
+	// metrics are the metrics of the handler, or nil if they're the ones of metrics.Default, such as ConnectionsGauge
+	metrics *handlerMetrics
 }
 
 // NewHandler creates a new Handler given a SQLe engine.
 func NewHandler(e *sqle.Engine, sm *SessionManager, rt time.Duration) *Handler {
+	sm.processList = e.Catalog.ProcessList
 	return &Handler{
 		e:           e,
 		sm:          sm,
@@ -77,6 +80,10 @@ func NewHandler(e *sqle.Engine, sm *SessionManager, rt time.Duration) *Handler {
 // NewConnection reports that a new connection has been established.
 func (h *Handler) NewConnection(c *mysql.Conn) {
 	logrus.WithField(sqle.ConnectionIdLogField, c.ConnectionID).Infof("NewConnection")
+	h.e.Catalog.ProcessList.AddConnection(c.ConnectionID, c.Close)
+	h.connectionsGauge().Add(1)
+	sql.StatusVariables.Increment("Connections", 1)
+	sql.StatusVariables.Increment("Threads_connected", 1)
 }
 
 func (h *Handler) ComInitDB(c *mysql.Conn, schemaName string) error {
@@ -88,34 +95,124 @@ func (h *Handler) ComPrepare(c *mysql.Conn, query string) ([]*query.Field, error
 	if err != nil {
 		return nil, err
 	}
-	schema, err := h.e.AnalyzeQuery(ctx, query)
+	if decoded := decodeClientString(ctx, query); decoded != query {
+		query = decoded
+		ctx = ctx.WithQuery(query)
+	}
+
+	// Statements closed by the client are only noticed here, as vitess doesn't tell handlers about them
+	open := make(map[string]bool, len(c.PrepareData))
+	for _, p := range c.PrepareData {
+		open[p.PrepareStmt] = true
+	}
+	h.e.PreparedData.Retain(ctx.Session.ID(), open)
+
+	analyzed, err := h.e.PrepareQuery(ctx, query)
 	if err != nil {
 		return nil, err
 	}
+	schema := analyzed.Schema()
 	if sql.IsOkResultSchema(schema) {
 		return nil, nil
 	}
-	return schemaToFields(schema), nil
+	return schemaToFields(schema, newResultCharset(ctx)), nil
 }
 
 func (h *Handler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareData, callback func(*sqltypes.Result) error) error {
-	return h.errorWrappedDoQuery(c, prepare.PrepareStmt, prepare.BindVars, callback)
+	return h.errorWrappedDoQuery(c, prepare.PrepareStmt, textBindings(prepare), nil, callback)
 }
 
+// ComResetConnection implements the mysql.Handler interface. The session of the connection is replaced with a new
+// one, keeping only its current database.
 func (h *Handler) ComResetConnection(c *mysql.Conn) {
-	// TODO: handle reset logic
+	ctx, err := h.sm.NewContext(c)
+	if err != nil {
+		logrus.WithField(sqle.ConnectionIdLogField, c.ConnectionID).Errorf("unable to reset session: %s", err)
+		return
+	}
+	db := ctx.GetCurrentDatabase()
+	h.releaseSession(ctx, c)
+
+	if err := h.sm.NewSession(ctx, c); err != nil {
+		logrus.WithField(sqle.ConnectionIdLogField, c.ConnectionID).Errorf("unable to reset session: %s", err)
+		return
+	}
+	h.sm.session(c).SetCurrentDatabase(db)
+}
+
+// ComChangeUser implements the mysql.ChangeUserHandler interface. It replaces the session of the connection with one
+// for the user it was authenticated as, using the database given.
+func (h *Handler) ComChangeUser(c *mysql.Conn, db string) error {
+	ctx, err := h.sm.NewContext(c)
+	if err != nil {
+		return err
+	}
+	h.releaseSession(ctx, c)
+
+	c.PrepareData = make(map[uint32]*mysql.PrepareData)
+	if err := h.sm.NewSession(ctx, c); err != nil {
+		return err
+	}
+	return h.ComInitDB(c, db)
+}
+
+// releaseSession releases what the session of a connection holds outside of itself: its open transaction, named
+// locks, table locks, temporary tables and cached plans.
+func (h *Handler) releaseSession(ctx *sql.Context, c *mysql.Conn) {
+	logger := logrus.WithField(sqle.ConnectionIdLogField, c.ConnectionID)
+
+	if tx := ctx.GetTransaction(); tx != nil {
+		db, err := h.e.Catalog.Database(ctx.GetCurrentDatabase())
+		if tdb, ok := db.(sql.TransactionDatabase); err == nil && ok {
+			if err := tdb.Rollback(ctx, tx); err != nil {
+				logger.Errorf("unable to roll back transaction of session: %s", err)
+			}
+		}
+		ctx.SetTransaction(nil)
+	}
+
+	if _, err := h.e.LS.ReleaseAll(ctx); err != nil {
+		logger.Errorf("unable to release locks of session: %s", err)
+	}
+	if err := h.e.Catalog.UnlockTables(ctx, c.ConnectionID); err != nil {
+		logger.Errorf("unable to unlock tables of session: %s", err)
+	}
+
+	for _, db := range h.e.Catalog.AllDatabases() {
+		tdb, ok := db.(sql.TemporaryTableDatabase)
+		dropper, canDrop := db.(sql.TableDropper)
+		if !ok || !canDrop {
+			continue
+		}
+		tables, err := tdb.GetAllTemporaryTables(ctx)
+		if err != nil {
+			logger.Errorf("unable to list temporary tables of session: %s", err)
+			continue
+		}
+		for _, t := range tables {
+			if err := dropper.DropTable(ctx, t.Name()); err != nil {
+				logger.Errorf("unable to drop temporary table %s of session: %s", t.Name(), err)
+			}
+		}
+	}
+
+	h.e.PreparedData.UncacheSession(ctx.Session.ID())
 }
 
 // ConnectionClosed reports that a connection has been closed.
 func (h *Handler) ConnectionClosed(c *mysql.Conn) {
-	ctx, _ := h.sm.NewContextWithQuery(c, "")
-	h.sm.CloseConn(c)
-
 	// If connection was closed, kill its associated queries.
 	h.e.Catalog.ProcessList.Kill(c.ConnectionID)
-	if err := h.e.Catalog.UnlockTables(ctx, c.ConnectionID); err != nil {
-		logrus.Errorf("mysql/server unable to unlock tables on session close: %s", err)
+
+	ctx, _ := h.sm.NewContextWithQuery(c, "")
+	if ctx != nil {
+		h.releaseSession(ctx, c)
 	}
+	h.sm.CloseConn(c)
+	h.e.Catalog.ProcessList.RemoveConnection(c.ConnectionID)
+	h.e.Catalog.StatementEvents.RemoveThread(c.ConnectionID)
+	h.connectionsGauge().Add(-1)
+	sql.StatusVariables.Increment("Threads_connected", -1)
 
 	logrus.WithField(sqle.ConnectionIdLogField, c.ConnectionID).Infof("ConnectionClosed")
 }
@@ -126,10 +223,10 @@ func (h *Handler) ComQuery(
 	query string,
 	callback func(*sqltypes.Result) error,
 ) error {
-	return h.errorWrappedDoQuery(c, query, nil, callback)
+	return h.errorWrappedDoQuery(c, query, nil, nil, callback)
 }
 
-func bindingsToExprs(bindings map[string]*query.BindVariable) (map[string]sql.Expression, error) {
+func bindingsToExprs(ctx *sql.Context, bindings map[string]*query.BindVariable) (map[string]sql.Expression, error) {
 	res := make(map[string]sql.Expression, len(bindings))
 	for k, v := range bindings {
 		v, err := sqltypes.NewValue(v.Type, v.Value)
@@ -203,11 +300,12 @@ func bindingsToExprs(bindings map[string]*query.BindVariable) (map[string]sql.Ex
 			}
 			res[k] = expression.NewLiteral(v, t)
 		case v.Type() == sqltypes.Text || v.Type() == sqltypes.VarChar || v.Type() == sqltypes.Char:
-			t, err := sql.CreateStringWithDefaults(v.Type(), int64(len(v.ToBytes())))
+			s := decodeClientString(ctx, string(v.ToBytes()))
+			t, err := sql.CreateStringWithDefaults(v.Type(), int64(len(s)))
 			if err != nil {
 				return nil, err
 			}
-			v, err := t.Convert(v.ToBytes())
+			v, err := t.Convert(s)
 			if err != nil {
 				return nil, err
 			}
@@ -238,33 +336,48 @@ func bindingsToExprs(bindings map[string]*query.BindVariable) (map[string]sql.Ex
 
 var queryLoggingRegex = regexp.MustCompile(`[\r\n\t ]+`)
 
+// doQuery runs a query and passes its results to the callback given. resultSet, if any, is passed the result set of
+// every SELECT statement run by the procedure the query calls, before its results.
 func (h *Handler) doQuery(
 	c *mysql.Conn,
 	query string,
 	bindings map[string]*query.BindVariable,
+	resultSet func(*sqltypes.Result) error,
 	callback func(*sqltypes.Result) error,
-) error {
+) (err error) {
 	ctx, err := h.sm.NewContextWithQuery(c, query)
 	if err != nil {
 		return err
 	}
 	logrus.Tracef("mysql/server connection %d: received query %s", c.ConnectionID, query)
-
-	handled, err := h.handleKill(ctx, c, query)
-	if err != nil {
-		return err
-	}
-
-	if handled {
-		return callback(&sqltypes.Result{})
+	sql.IncrementStatusVariable(ctx, "Bytes_received", int64(len(query)))
+	if decoded := decodeClientString(ctx, query); decoded != query {
+		query = decoded
+		ctx = ctx.WithQuery(query)
 	}
 
 	ctx.SetLogger(ctx.GetLogger().
 		WithField("query", string(queryLoggingRegex.ReplaceAll([]byte(query), []byte(" ")))))
 	ctx.GetLogger().Debugf("Starting query")
 
+	if resultSet != nil && parse.StatementType(query) == "call" {
+		ctx = plan.WithResultSets(ctx, func(sch sql.Schema, rows []sql.Row) error {
+			res, err := resultFromRows(ctx, sch, rows)
+			if err != nil {
+				return err
+			}
+			if err := resultSet(res); err != nil {
+				return err
+			}
+			h.resultSent(ctx, res)
+			return nil
+		})
+	}
+
 	finish := observeQuery(ctx, query)
-	defer finish(err)
+	defer func() {
+		finish(err)
+	}()
 
 	// TODO: it would be nice to put this logic in the engine, not the handler, but we don't want the process to be
 	//  marked done until we're done spooling rows over the wire
@@ -277,10 +390,14 @@ func (h *Handler) doQuery(
 
 	start := time.Now()
 
-	parsed, _ := parse.Parse(ctx, query)
-	switch n := parsed.(type) {
-	case *plan.LoadData:
-		if n.Local {
+	// Prepared statements are executed with their cached plan, and are never LOAD DATA statements
+	var parsed sql.Node
+	if !h.e.PreparedData.Cached(ctx, query) {
+		parsed, _ = parse.Parse(ctx, query)
+	}
+	// LOAD DATA statements are parsed as inserting the rows of the file
+	if insert, ok := parsed.(*plan.InsertInto); ok {
+		if n, ok := insert.Source.(*plan.LoadData); ok && n.Local {
 			// tell the connection to undergo the load data process with this metadata
 			tmpdir, err := ctx.GetSessionVariable(ctx, "tmpdir")
 			if err != nil {
@@ -297,7 +414,7 @@ func (h *Handler) doQuery(
 
 	var sqlBindings map[string]sql.Expression
 	if len(bindings) > 0 {
-		sqlBindings, err = bindingsToExprs(bindings)
+		sqlBindings, err = bindingsToExprs(ctx, bindings)
 		if err != nil {
 			ctx.GetLogger().WithError(err).Errorf("Error processing bindings")
 			return err
@@ -307,8 +424,12 @@ func (h *Handler) doQuery(
 	// TODO: it would be nice to put this logic in the engine itself, not the handler, but we wouldn't get accurate
 	//  timing without some more work
 	defer func() {
-		if q, ok := h.e.Auth.(*auth.Audit); ok {
-			q.Query(ctx, time.Since(start), err)
+		d := time.Since(start)
+		if q, ok := h.e.Auth.(auth.AuditQuery); ok {
+			q.Query(ctx, d, err)
+		}
+		if h.audit != nil {
+			h.audit.Query(ctx, d, err)
 		}
 	}()
 
@@ -340,6 +461,8 @@ func (h *Handler) doQuery(
 	timer := time.NewTimer(waitTime)
 	defer timer.Stop()
 
+	rc := newResultCharset(ctx)
+
 	// Read rows off the row iterator and send them to the row channel.
 	go func() {
 		for {
@@ -362,7 +485,7 @@ func (h *Handler) doQuery(
 rowLoop:
 	for {
 		if r == nil {
-			r = &sqltypes.Result{Fields: schemaToFields(schema)}
+			r = &sqltypes.Result{Fields: schemaToFields(schema, rc)}
 		}
 
 		if r.RowsAffected == rowsBatch {
@@ -370,6 +493,7 @@ rowLoop:
 				close(quit)
 				return err
 			}
+			h.resultSent(ctx, r)
 
 			r = nil
 			proccesedAtLeastOneBatch = true
@@ -394,7 +518,7 @@ rowLoop:
 				break rowLoop
 			}
 
-			outputRow, err := rowToSQL(schema, row)
+			outputRow, err := rowToSQL(schema, row, rc)
 			if err != nil {
 				close(quit)
 				return err
@@ -450,7 +574,42 @@ rowLoop:
 		return nil
 	}
 
-	return callback(r)
+	if err = callback(r); err != nil {
+		return err
+	}
+	h.resultSent(ctx, r)
+	return nil
+}
+
+// resultFromRows returns the result holding the rows given, in the schema given.
+func resultFromRows(ctx *sql.Context, sch sql.Schema, rows []sql.Row) (*sqltypes.Result, error) {
+	rc := newResultCharset(ctx)
+	res := &sqltypes.Result{Fields: schemaToFields(sch, rc)}
+	for _, row := range rows {
+		outputRow, err := rowToSQL(sch, row, rc)
+		if err != nil {
+			return nil, err
+		}
+		res.Rows = append(res.Rows, outputRow)
+	}
+	return res, nil
+}
+
+// resultSent records the rows of a result sent to the client in the metrics and the Bytes_sent status variable, which
+// counts the size of their values.
+func (h *Handler) resultSent(ctx *sql.Context, r *sqltypes.Result) {
+	rowsSent := RowsSentCounter
+	if h.metrics != nil {
+		rowsSent = h.metrics.rowsSent
+	}
+	rowsSent.Add(float64(len(r.Rows)))
+	var size int
+	for _, row := range r.Rows {
+		for _, v := range row {
+			size += v.Len()
+		}
+	}
+	sql.IncrementStatusVariable(ctx, "Bytes_sent", int64(size))
 }
 
 // See https://dev.mysql.com/doc/internals/en/status-flags.html
@@ -516,9 +675,10 @@ func (h *Handler) errorWrappedDoQuery(
 	c *mysql.Conn,
 	query string,
 	bindings map[string]*query.BindVariable,
+	resultSet func(*sqltypes.Result) error,
 	callback func(*sqltypes.Result) error,
 ) error {
-	err := h.doQuery(c, query, bindings, callback)
+	err := h.doQuery(c, query, bindings, resultSet, callback)
 	err, ok := sql.CastSQLError(err)
 	if ok {
 		return nil
@@ -614,45 +774,7 @@ func (h *Handler) WarningCount(c *mysql.Conn) uint16 {
 	return 0
 }
 
-func (h *Handler) handleKill(ctx *sql.Context, conn *mysql.Conn, query string) (bool, error) {
-	q := strings.ToLower(query)
-	// TODO: move this to parser, normal execution path
-	s := regKillCmd.FindStringSubmatch(q)
-	if s == nil {
-		return false, nil
-	}
-
-	ctx.GetLogger().Info("killing query")
-
-	id, err := strconv.ParseUint(s[2], 10, 32)
-	if err != nil {
-		return false, err
-	}
-
-	// KILL CONNECTION and KILL should close the connection. KILL QUERY only
-	// cancels the query.
-	//
-	// https://dev.mysql.com/doc/refman/8.0/en/kill.html
-	//
-	// KILL [CONNECTION | QUERY] processlist_id
-	// - KILL QUERY terminates the statement the connection is currently executing,
-	// but leaves the connection itself intact.
-
-	// - KILL CONNECTION is the same as KILL with no modifier:
-	// It terminates the connection associated with the given processlist_id,
-	// after terminating any statement the connection is executing.
-	connID := uint32(id)
-	h.e.Catalog.Kill(connID)
-	if s[1] != "query" {
-		ctx.GetLogger().Info("kill connection")
-		h.sm.CloseConn(conn)
-		conn.Close()
-	}
-
-	return true, nil
-}
-
-func rowToSQL(s sql.Schema, row sql.Row) ([]sqltypes.Value, error) {
+func rowToSQL(s sql.Schema, row sql.Row, rc resultCharset) ([]sqltypes.Value, error) {
 	o := make([]sqltypes.Value, len(row))
 	var err error
 	for i, v := range row {
@@ -665,52 +787,121 @@ func rowToSQL(s sql.Schema, row sql.Row) ([]sqltypes.Value, error) {
 		if err != nil {
 			return nil, err
 		}
+		o[i] = rc.encode(o[i])
 	}
 
 	return o, nil
 }
 
-func schemaToFields(s sql.Schema) []*query.Field {
+func schemaToFields(s sql.Schema, rc resultCharset) []*query.Field {
 	fields := make([]*query.Field, len(s))
 	for i, c := range s {
-		var charset uint32 = mysql.CharacterSetUtf8
-		if sql.IsBlob(c.Type) {
-			charset = mysql.CharacterSetBinary
-		}
-
 		fields[i] = &query.Field{
 			Name:    c.Name,
 			Type:    c.Type.Type(),
-			Charset: charset,
+			Charset: rc.fieldCharset(c.Type),
 		}
 	}
 
 	return fields
 }
 
+// handlerMetrics are the metrics of the connections of a handler.
+type handlerMetrics struct {
+	connections kitmetrics.Gauge
+	rowsSent    kitmetrics.Counter
+}
+
+// newHandlerMetrics registers the metrics of the connections of a handler in the registry given.
+func newHandlerMetrics(r *metrics.Registry) *handlerMetrics {
+	return &handlerMetrics{
+		connections: r.NewGauge("gms_connections", "Number of open client connections."),
+		rowsSent:    r.NewCounter("gms_rows_sent_total", "Number of rows sent to clients."),
+	}
+}
+
+var defaultHandlerMetrics = newHandlerMetrics(metrics.Default)
+
 var (
+	// ConnectionsGauge describes the number of open connections, for the servers without a metrics registry of their
+	// own.
+	ConnectionsGauge = defaultHandlerMetrics.connections
+
+	// RowsSentCounter describes a metric that accumulates number of rows sent to clients monotonically, for the servers
+	// without a metrics registry of their own.
+	RowsSentCounter = defaultHandlerMetrics.rowsSent
+
 	// QueryCounter describes a metric that accumulates number of queries monotonically.
-	QueryCounter = discard.NewCounter()
+	//
+	// Deprecated: QueryCounter is sqle.StatementCounter, which the engine counts statements in by type. If it's replaced
+	// with another metric, the server counts queries in it by query text, as it used to.
+	QueryCounter = sqle.StatementCounter
 
 	// QueryErrorCounter describes a metric that accumulates number of failed queries monotonically.
-	QueryErrorCounter = discard.NewCounter()
+	//
+	// Deprecated: QueryErrorCounter is sqle.StatementErrorCounter, which the engine counts failed statements in by type.
+	// If it's replaced with another metric, the server counts failed queries in it by query text and error, as it used
+	// to.
+	QueryErrorCounter = sqle.StatementErrorCounter
 
 	// QueryHistogram describes a queries latency.
-	QueryHistogram = discard.NewHistogram()
+	//
+	// Deprecated: QueryHistogram is sqle.StatementHistogram, which the engine observes the latency of statements in by
+	// digest. If it's replaced with another metric, the server observes the latency of queries in it by query text, as
+	// it used to.
+	QueryHistogram = sqle.StatementHistogram
 )
 
+// The metrics QueryCounter, QueryErrorCounter and QueryHistogram are aliases of, which the server doesn't observe as the
+// engine already does.
+var (
+	queryCounterAlias      = QueryCounter
+	queryErrorCounterAlias = QueryErrorCounter
+	queryHistogramAlias    = QueryHistogram
+)
+
+// connectionsGauge returns the gauge of the open connections of the handler.
+func (h *Handler) connectionsGauge() kitmetrics.Gauge {
+	if h.metrics != nil {
+		return h.metrics.connections
+	}
+	return ConnectionsGauge
+}
+
+// observeQuery starts the tracing span of a query, and returns the function to call once it finishes. The metrics of
+// the statement are observed by the engine, and in the deprecated QueryCounter, QueryErrorCounter and QueryHistogram if
+// they were replaced.
 func observeQuery(ctx *sql.Context, query string) func(err error) {
 	span, _ := ctx.Span("query", opentracing.Tag{Key: "query", Value: query})
 
 	t := time.Now()
 	return func(err error) {
 		if err != nil {
-			QueryErrorCounter.With("query", query, "error", err.Error()).Add(1)
+			if !sameMetric(QueryErrorCounter, queryErrorCounterAlias) {
+				QueryErrorCounter.With("query", query, "error", err.Error()).Add(1)
+			}
 		} else {
-			QueryCounter.With("query", query).Add(1)
-			QueryHistogram.With("query", query, "duration", "seconds").Observe(time.Since(t).Seconds())
+			if !sameMetric(QueryCounter, queryCounterAlias) {
+				QueryCounter.With("query", query).Add(1)
+			}
+			if !sameMetric(QueryHistogram, queryHistogramAlias) {
+				QueryHistogram.With("query", query, "duration", "seconds").Observe(time.Since(t).Seconds())
+			}
 		}
 
 		span.Finish()
 	}
 }
+
+// sameMetric returns whether a metric is the metric of the metrics package given. Metrics of other packages never are,
+// and aren't compared as they may not be comparable.
+func sameMetric(metric, alias interface{}) bool {
+	switch m := metric.(type) {
+	case *metrics.Counter:
+		return m == alias
+	case *metrics.Histogram:
+		return m == alias
+	default:
+		return false
+	}
+}
@@ -16,18 +16,35 @@ package server
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	gosql "database/sql"
+	"encoding/pem"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
 	"net"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/dolthub/vitess/go/mysql"
 	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/dolthub/vitess/go/vt/proto/query"
+	gomysql "github.com/go-sql-driver/mysql"
 	"github.com/opentracing/opentracing-go"
 	"github.com/stretchr/testify/require"
 
 	sqle "github.com/linanh/go-mysql-server"
+	"github.com/linanh/go-mysql-server/auth"
+	"github.com/linanh/go-mysql-server/binlog"
+	"github.com/linanh/go-mysql-server/memory"
 	"github.com/linanh/go-mysql-server/sql"
 	"github.com/linanh/go-mysql-server/sql/expression"
 )
@@ -196,6 +213,67 @@ func TestHandlerComPrepare(t *testing.T) {
 	}
 }
 
+func TestHandlerComStmtExecute(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+	conn := newConn(1)
+	handler := NewHandler(
+		e,
+		NewSessionManager(
+			testSessionBuilder,
+			opentracing.NoopTracer{},
+			func(db string) bool { return db == "test" },
+			sql.NewMemoryManager(nil),
+			"foo",
+		),
+		0,
+	)
+	handler.NewConnection(conn)
+	require.NoError(handler.ComInitDB(conn, "test"))
+
+	const stmt = "SELECT c1 FROM test WHERE c1 = ? OR c1 = ?"
+	conn.PrepareData = map[uint32]*mysql.PrepareData{1: {StatementID: 1, PrepareStmt: stmt, ParamsCount: 2}}
+	fields, err := handler.ComPrepare(conn, stmt)
+	require.NoError(err)
+	require.Len(fields, 1)
+
+	ctx, err := handler.sm.NewContextWithQuery(conn, stmt)
+	require.NoError(err)
+	require.True(e.PreparedData.Cached(ctx, stmt))
+
+	for _, values := range [][2]int64{{1, 2}, {5, 1000}} {
+		prepare := &mysql.PrepareData{
+			StatementID: 1,
+			PrepareStmt: stmt,
+			ParamsCount: 2,
+			BindVars: map[string]*query.BindVariable{
+				"v1": sqltypes.Int64BindVariable(values[0]),
+				"v2": sqltypes.Int64BindVariable(values[1]),
+			},
+		}
+		var rows [][]sqltypes.Value
+		err := handler.ComStmtExecute(conn, prepare, func(res *sqltypes.Result) error {
+			rows = append(rows, res.Rows...)
+			return nil
+		})
+		require.NoError(err)
+		require.Equal([][]sqltypes.Value{
+			{sqltypes.NewInt32(int32(values[0]))},
+			{sqltypes.NewInt32(int32(values[1]))},
+		}, rows)
+	}
+
+	// Statements closed by the client are dropped when the next one is prepared
+	conn.PrepareData = map[uint32]*mysql.PrepareData{2: {StatementID: 2, PrepareStmt: "SELECT 1"}}
+	_, err = handler.ComPrepare(conn, "SELECT 1")
+	require.NoError(err)
+	require.False(e.PreparedData.Cached(ctx, stmt))
+	require.True(e.PreparedData.Cached(ctx, "SELECT 1"))
+
+	handler.ConnectionClosed(conn)
+	require.False(e.PreparedData.Cached(ctx, "SELECT 1"))
+}
+
 func TestHandlerKill(t *testing.T) {
 	require := require.New(t)
 	e := setupMemDB(require)
@@ -244,8 +322,62 @@ func TestHandlerKill(t *testing.T) {
 	})
 	require.NoError(err)
 
-	require.Len(handler.sm.sessions, 1)
+	// The killed connection is closed, and its session goes once the server sees it closed
+	require.True(conn1.IsClosed())
+	require.False(conn2.IsClosed())
 	assertNoConnProcesses(t, e, conn1.ConnectionID)
+	handler.ConnectionClosed(conn1)
+	require.Len(handler.sm.sessions, 1)
+
+	err = handler.ComQuery(conn2, "KILL 1", func(res *sqltypes.Result) error {
+		return nil
+	})
+	require.Error(err)
+	require.Equal(mysql.ERNoSuchThread, err.(*mysql.SQLError).Num)
+}
+
+func TestHandlerKillOtherUser(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+
+	users := map[uint32]string{1: "alice", 2: "bob"}
+	handler := NewHandler(
+		e,
+		NewSessionManager(
+			func(ctx context.Context, conn *mysql.Conn, addr string) (sql.Session, *sql.IndexRegistry, *sql.ViewRegistry, error) {
+				client := sql.Client{User: users[conn.ConnectionID], Capabilities: conn.Capabilities}
+				return sql.NewSession(addr, client, conn.ConnectionID), sql.NewIndexRegistry(), sql.NewViewRegistry(), nil
+			},
+			opentracing.NoopTracer{},
+			func(db string) bool { return db == "test" },
+			sql.NewMemoryManager(nil),
+			"foo",
+		),
+		0,
+	)
+
+	conn1 := newConn(1)
+	handler.NewConnection(conn1)
+	conn2 := newConn(2)
+	handler.NewConnection(conn2)
+	handler.ComInitDB(conn1, "test")
+	handler.ComInitDB(conn2, "test")
+
+	// The default auth doesn't manage privileges, so no user can kill the connections of others
+	for _, query := range []string{"KILL 1", "KILL QUERY 1"} {
+		err := handler.ComQuery(conn2, query, func(res *sqltypes.Result) error {
+			return nil
+		})
+		require.Error(err)
+		require.Equal(mysql.ERKillDenied, err.(*mysql.SQLError).Num)
+	}
+	require.False(conn1.IsClosed())
+
+	err := handler.ComQuery(conn2, "KILL 2", func(res *sqltypes.Result) error {
+		return nil
+	})
+	require.NoError(err)
+	require.True(conn2.IsClosed())
 }
 
 func assertNoConnProcesses(t *testing.T, e *sqle.Engine, conn uint32) {
@@ -273,7 +405,7 @@ func TestSchemaToFields(t *testing.T) {
 		{Name: "baz", Type: query.Type_INT64, Charset: mysql.CharacterSetUtf8},
 	}
 
-	fields := schemaToFields(schema)
+	fields := schemaToFields(schema, resultCharset{})
 	require.Equal(expected, fields)
 }
 
@@ -471,7 +603,7 @@ func TestBindingsToExprs(t *testing.T) {
 
 	for _, c := range cases {
 		t.Run(c.Name, func(t *testing.T) {
-			res, err := bindingsToExprs(c.Bindings)
+			res, err := bindingsToExprs(sql.NewEmptyContext(), c.Bindings)
 			if !c.Err {
 				require.NoError(t, err)
 				require.Equal(t, c.Result, res)
@@ -554,3 +686,583 @@ func TestHandlerFoundRowsCapabilities(t *testing.T) {
 		})
 	}
 }
+
+func TestHandlerComResetConnection(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+	conn := newConn(1)
+	handler := NewHandler(
+		e,
+		NewSessionManager(
+			testSessionBuilder,
+			opentracing.NoopTracer{},
+			func(db string) bool { return db == "test" },
+			sql.NewMemoryManager(nil),
+			"foo",
+		),
+		0,
+	)
+	handler.NewConnection(conn)
+	require.NoError(handler.ComInitDB(conn, "test"))
+
+	for _, q := range []string{
+		"SET @x = 1",
+		"SELECT GET_LOCK('l', 0)",
+		"PREPARE s FROM 'SELECT 1'",
+	} {
+		err := handler.ComQuery(conn, q, func(res *sqltypes.Result) error { return nil })
+		require.NoError(err, q)
+	}
+	before := handler.sm.session(conn)
+
+	handler.ComResetConnection(conn)
+
+	ctx, err := handler.sm.NewContext(conn)
+	require.NoError(err)
+	require.NotEqual(before, ctx.Session)
+	require.Equal("test", ctx.GetCurrentDatabase())
+	_, val, err := ctx.GetUserVariable(ctx, "x")
+	require.NoError(err)
+	require.Nil(val)
+	state, _ := e.LS.GetLockState("l")
+	require.Equal(sql.LockFree, state)
+	_, ok := sql.SessionPreparedStatement(ctx, "s")
+	require.False(ok)
+}
+
+func TestHandlerComChangeUser(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+
+	s, err := NewServer(Config{
+		Protocol: "tcp",
+		Address:  "localhost:0",
+		Auth:     auth.NewNativeSingle("user", "password", auth.AllPermissions),
+	}, e, testSessionBuilder)
+	require.NoError(err)
+	go s.Start()
+	defer s.Close()
+
+	host, port, err := net.SplitHostPort(s.Listener.Addr().String())
+	require.NoError(err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(err)
+	conn, err := mysql.Connect(context.Background(), &mysql.ConnParams{Host: host, Port: portNum, Uname: "user", Pass: "password"})
+	require.NoError(err)
+	defer conn.Close()
+	_, err = conn.ExecuteFetch("SET @x = 1", 0, false)
+	require.NoError(err)
+
+	// The session is replaced by one for the user, using the database asked for
+	changeUser(t, conn, "user", "password", "test")
+	res, err := conn.ExecuteFetch("SELECT @x, DATABASE(), CURRENT_USER()", 1, false)
+	require.NoError(err)
+	require.True(res.Rows[0][0].IsNull())
+	require.Equal("test", res.Rows[0][1].ToString())
+	require.Equal("user@127.0.0.1:34567", res.Rows[0][2].ToString())
+
+	// A wrong password closes the connection
+	require.Equal(byte(mysql.ErrPacket), changeUser(t, conn, "user", "wrong", "")[0])
+	_, err = conn.ExecuteFetch("SELECT 1", 1, false)
+	require.Error(err)
+}
+
+func TestHandlerStatusVariables(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+	conn := newConn(1)
+	handler := NewHandler(
+		e,
+		NewSessionManager(
+			testSessionBuilder,
+			opentracing.NoopTracer{},
+			func(db string) bool { return db == "test" },
+			sql.NewMemoryManager(nil),
+			"foo",
+		),
+		0,
+	)
+
+	connections, _ := sql.StatusVariables.GetGlobal("Connections")
+	connected, _ := sql.StatusVariables.GetGlobal("Threads_connected")
+	handler.NewConnection(conn)
+	val, _ := sql.StatusVariables.GetGlobal("Connections")
+	require.Equal(connections+1, val)
+	val, _ = sql.StatusVariables.GetGlobal("Threads_connected")
+	require.Equal(connected+1, val)
+
+	query := "SELECT c1 FROM test.test WHERE c1 < 2"
+	err := handler.ComQuery(conn, query, func(res *sqltypes.Result) error { return nil })
+	require.NoError(err)
+
+	ctx, err := handler.sm.NewContext(conn)
+	require.NoError(err)
+	status := sql.SessionStatusVariables(ctx)
+	require.Equal(int64(len(query)), status["Bytes_received"])
+	// The values of c1 are 0 and 1
+	require.Equal(int64(2), status["Bytes_sent"])
+	require.Equal(int64(1), status["Com_select"])
+
+	handler.ConnectionClosed(conn)
+	val, _ = sql.StatusVariables.GetGlobal("Threads_connected")
+	require.Equal(connected, val)
+}
+
+func TestHandlerMultiStatements(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+	e.AddDatabase(memory.NewDatabase("multi"))
+
+	s, err := NewServer(Config{
+		Protocol: "tcp",
+		Address:  "localhost:0",
+		Auth:     new(auth.None),
+	}, e, testSessionBuilder)
+	require.NoError(err)
+	go s.Start()
+	defer s.Close()
+
+	db, err := gosql.Open("mysql", fmt.Sprintf("root:@tcp(%s)/multi?multiStatements=true", s.Listener.Addr()))
+	require.NoError(err)
+	defer db.Close()
+	// The statements of a command run on the same connection
+	db.SetMaxOpenConns(1)
+
+	_, err = db.Exec("CREATE TABLE t (pk BIGINT PRIMARY KEY AUTO_INCREMENT, v INT); INSERT INTO t (v) VALUES (10), (20)")
+	require.NoError(err)
+
+	// The bodies of procedures aren't split
+	_, err = db.Exec("CREATE PROCEDURE p(x INT) BEGIN SELECT v FROM t ORDER BY v; IF x > 0 THEN SELECT x; END IF; END; SELECT 1")
+	require.NoError(err)
+
+	readResults := func(db *gosql.DB, query string) [][]string {
+		rows, err := db.Query(query)
+		require.NoError(err)
+		defer rows.Close()
+		var results [][]string
+		for {
+			var result []string
+			for rows.Next() {
+				var v string
+				require.NoError(rows.Scan(&v))
+				result = append(result, v)
+			}
+			results = append(results, result)
+			if !rows.NextResultSet() {
+				break
+			}
+		}
+		require.NoError(rows.Err())
+		return results
+	}
+
+	// The driver skips the results of statements which return no rows
+	require.Equal([][]string{{"3"}}, readResults(db, "INSERT INTO t (v) VALUES (30); SELECT LAST_INSERT_ID()"))
+	require.Equal([][]string{{"10", "20", "30"}, {"3"}}, readResults(db, "SELECT v FROM t ORDER BY v; SELECT COUNT(*) FROM t"))
+
+	// Every SELECT statement run by a procedure returns a result set
+	require.Equal([][]string{{"10", "20", "30"}, {"15"}}, readResults(db, "CALL p(15)"))
+	require.Equal([][]string{{"10", "20", "30"}, {"1"}}, readResults(db, "CALL p(0); SELECT 1"))
+
+	// The statements following a failed one don't run
+	_, err = db.Exec("INSERT INTO t (v) VALUES (40); SELECT * FROM nonexistent; INSERT INTO t (v) VALUES (50)")
+	require.Error(err)
+	require.Equal([][]string{{"10", "20", "30", "40"}}, readResults(db, "SELECT v FROM t ORDER BY v"))
+
+	// Clients which only enabled CLIENT_MULTI_RESULTS get every result set of procedures, but can't send several
+	// statements
+	single, err := gosql.Open("mysql", fmt.Sprintf("root:@tcp(%s)/multi", s.Listener.Addr()))
+	require.NoError(err)
+	defer single.Close()
+	single.SetMaxOpenConns(1)
+	require.Equal([][]string{{"10", "20", "30", "40"}, {"15"}}, readResults(single, "CALL p(15)"))
+	_, err = single.Exec("SELECT 1; SELECT 2")
+	require.Error(err)
+
+	// Clients which enabled CLIENT_DEPRECATE_EOF get OK packets at the end of result sets
+	host, port, err := net.SplitHostPort(s.Listener.Addr().String())
+	require.NoError(err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(err)
+	conn, err := mysql.Connect(context.Background(), &mysql.ConnParams{Host: host, Port: portNum, Uname: "root", DbName: "multi"})
+	require.NoError(err)
+	defer conn.Close()
+	require.NotZero(conn.Capabilities & mysql.CapabilityClientDeprecateEOF)
+	res, more, err := conn.ExecuteFetchMulti("SELECT v FROM t ORDER BY v; CALL p(0)", 10, true)
+	require.NoError(err)
+	require.True(more)
+	require.Len(res.Rows, 4)
+	res, more, _, err = conn.ReadQueryResult(10, true)
+	require.NoError(err)
+	require.True(more)
+	require.Len(res.Rows, 4)
+	require.Equal("v", res.Fields[0].Name)
+	res, more, _, err = conn.ReadQueryResult(10, true)
+	require.NoError(err)
+	require.False(more)
+	require.Empty(res.Rows)
+}
+
+func TestHandlerCharacterSets(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+	e.AddDatabase(memory.NewDatabase("charsets"))
+
+	s, err := NewServer(Config{
+		Protocol: "tcp",
+		Address:  "localhost:0",
+		Auth:     new(auth.None),
+	}, e, testSessionBuilder)
+	require.NoError(err)
+	go s.Start()
+	defer s.Close()
+
+	// The client sends and reads latin1 strings
+	db, err := gosql.Open("mysql", fmt.Sprintf("root:@tcp(%s)/charsets?collation=latin1_swedish_ci", s.Listener.Addr()))
+	require.NoError(err)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	var client string
+	require.NoError(db.QueryRow("SELECT @@character_set_client").Scan(&client))
+	require.Equal("latin1", client)
+
+	_, err = db.Exec("CREATE TABLE t (pk INT PRIMARY KEY, v VARCHAR(10) CHARACTER SET utf8mb4, l VARCHAR(10) CHARACTER SET latin1)")
+	require.NoError(err)
+	_, err = db.Exec("INSERT INTO t VALUES (1, 'caf\xe9', 'caf\xe9')")
+	require.NoError(err)
+	_, err = db.Exec("INSERT INTO t VALUES (?, ?, ?)", 2, "\xc5ngstr\xf6m", "na\xefve")
+	require.NoError(err)
+
+	var v, l string
+	require.NoError(db.QueryRow("SELECT v, l FROM t WHERE pk = 1").Scan(&v, &l))
+	require.Equal("caf\xe9", v)
+	require.Equal("caf\xe9", l)
+	require.NoError(db.QueryRow("SELECT v, l FROM t WHERE pk = ?", 2).Scan(&v, &l))
+	require.Equal("\xc5ngstr\xf6m", v)
+	require.Equal("na\xefve", l)
+
+	// The strings are stored as the characters they are
+	require.NoError(db.QueryRow("SELECT HEX(v), HEX(l) FROM t WHERE pk = 1").Scan(&v, &l))
+	require.Equal("636166C3A9", v)
+	require.Equal("636166E9", l)
+
+	// Characters which latin1 can't represent are sent as ?
+	_, err = db.Exec("SET NAMES utf8mb4")
+	require.NoError(err)
+	_, err = db.Exec("INSERT INTO t VALUES (3, '日本', 'x')")
+	require.NoError(err)
+	_, err = db.Exec("SET character_set_results = 'latin1'")
+	require.NoError(err)
+	require.NoError(db.QueryRow("SELECT v FROM t WHERE pk = 3").Scan(&v))
+	require.Equal("??", v)
+}
+
+func TestHandlerComBinlogDump(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+	e.Catalog.Binlog = binlog.NewLog("")
+
+	s, err := NewServer(Config{
+		Protocol: "tcp",
+		Address:  "localhost:0",
+		Auth:     new(auth.None),
+	}, e, testSessionBuilder)
+	require.NoError(err)
+	go s.Start()
+	defer s.Close()
+
+	host, port, err := net.SplitHostPort(s.Listener.Addr().String())
+	require.NoError(err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(err)
+	connect := func() *mysql.Conn {
+		conn, err := mysql.Connect(context.Background(), &mysql.ConnParams{Host: host, Port: portNum, Uname: "root", DbName: "test"})
+		require.NoError(err)
+		return conn
+	}
+	readEvents := func(conn *mysql.Conn, n int) []mysql.BinlogEvent {
+		var events []mysql.BinlogEvent
+		for len(events) < n {
+			ev, err := conn.ReadBinlogEvent()
+			require.NoError(err)
+			events = append(events, ev)
+		}
+		return events
+	}
+
+	client := connect()
+	defer client.Close()
+	_, err = client.ExecuteFetch("INSERT INTO test VALUES (5000)", 0, false)
+	require.NoError(err)
+
+	// With the non-block flag, the events of the log are followed by an EOF packet
+	replica := connect()
+	defer replica.Close()
+	require.NoError(replica.WriteComBinlogDump(1, "", 4, 1))
+	events := readEvents(replica, 6)
+	require.True(events[0].IsRotate())
+	require.True(events[1].IsFormatDescription())
+	require.True(events[2].IsQuery())
+	require.True(events[3].IsTableMap())
+	require.True(events[4].IsWriteRows())
+	require.True(events[5].IsXID())
+	_, err = replica.ReadBinlogEvent()
+	require.Error(err)
+	require.Contains(err.Error(), "EOF")
+
+	// The connection is still usable, and unknown files are reported with an error
+	require.NoError(replica.WriteComBinlogDump(1, "binlog.000009", 4, 1))
+	_, err = replica.ReadBinlogEvent()
+	require.Error(err)
+	require.Equal(1236, err.(*mysql.SQLError).Number())
+	_, err = replica.ExecuteFetch("SELECT 1", 1, false)
+	require.NoError(err)
+
+	// Without it, events are sent as they're logged
+	require.NoError(replica.WriteComBinlogDump(1, "", 4, 0))
+	readEvents(replica, 6)
+	_, err = client.ExecuteFetch("DELETE FROM test WHERE c1 = 5000", 0, false)
+	require.NoError(err)
+	events = readEvents(replica, 4)
+	require.True(events[0].IsQuery())
+	require.True(events[1].IsTableMap())
+	require.True(events[2].IsDeleteRows())
+	require.True(events[3].IsXID())
+}
+
+func TestHandlerReplicationPrivileges(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+	e.Catalog.Binlog = binlog.NewLog("")
+	a, err := auth.NewAccountsSingle("root", "")
+	require.NoError(err)
+	e.Auth = a
+
+	ctx := sql.NewEmptyContext()
+	store := a.PrivilegeStore()
+	for user, privileges := range map[string]sql.PrivilegeType{
+		"user":    sql.PrivilegeType_Select,
+		"replica": sql.PrivilegeType_ReplicationSlave,
+	} {
+		id := sql.NewUserIdentity(user, "%")
+		require.NoError(store.SetAccount(ctx, sql.Account{UserIdentity: id, Plugin: sql.DefaultAuthPlugin}))
+		require.NoError(store.SetGrant(ctx, sql.PrivilegeGrant{Grantee: id, Privileges: privileges}))
+	}
+
+	s, err := NewServer(Config{
+		Protocol: "tcp",
+		Address:  "localhost:0",
+		Auth:     a,
+	}, e, testSessionBuilder)
+	require.NoError(err)
+	go s.Start()
+	defer s.Close()
+
+	host, port, err := net.SplitHostPort(s.Listener.Addr().String())
+	require.NoError(err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(err)
+	registerSlave := []byte("\x15\x02\x00\x00\x00\x00\x00\x00\xea\x0c\x00\x00\x00\x00\x00\x00\x00\x00\x00")
+
+	// Register and dump are refused without the REPLICATION SLAVE privilege
+	for user, allowed := range map[string]bool{"user": false, "replica": true} {
+		conn, err := mysql.Connect(context.Background(), &mysql.ConnParams{Host: host, Port: portNum, Uname: user})
+		require.NoError(err)
+
+		writeRawPacket(t, conn, 0, registerSlave)
+		res := readRawPacket(t, conn)
+		require.NoError(conn.WriteComBinlogDump(2, "", 4, 1))
+		ev, err := conn.ReadBinlogEvent()
+		if allowed {
+			require.Equal(byte(mysql.OKPacket), res[0])
+			require.NoError(err)
+			require.True(ev.IsRotate())
+		} else {
+			require.Equal(byte(mysql.ErrPacket), res[0])
+			require.Contains(string(res), "REPLICATION SLAVE")
+			require.Error(err)
+			require.Contains(err.Error(), "REPLICATION SLAVE")
+		}
+		conn.Close()
+	}
+}
+
+func TestHandlerTLS(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+	e.Catalog.Binlog = binlog.NewLog("")
+	cert, certPEM := selfSignedCertificate(t)
+
+	s, err := NewServer(Config{
+		Protocol:               "tcp",
+		Address:                "localhost:0",
+		Auth:                   auth.NewNativeSingle("user", "password", auth.AllPermissions),
+		TLSConfig:              &tls.Config{Certificates: []tls.Certificate{cert}},
+		RequireSecureTransport: true,
+	}, e, testSessionBuilder)
+	require.NoError(err)
+	go s.Start()
+	defer s.Close()
+	addr := s.Listener.Addr().String()
+
+	// Connections without TLS are refused
+	db, err := gosql.Open("mysql", fmt.Sprintf("user:password@tcp(%s)/test", addr))
+	require.NoError(err)
+	err = db.Ping()
+	require.Error(err)
+	require.Contains(err.Error(), "insecure transport")
+	require.NoError(db.Close())
+
+	// Multi-statement commands are run over TLS too
+	db, err = gosql.Open("mysql", fmt.Sprintf("user:password@tcp(%s)/test?tls=skip-verify&multiStatements=true", addr))
+	require.NoError(err)
+	defer db.Close()
+	rows, err := db.Query("SELECT 1; SELECT 2")
+	require.NoError(err)
+	var results []int
+	for {
+		for rows.Next() {
+			var v int
+			require.NoError(rows.Scan(&v))
+			results = append(results, v)
+		}
+		if !rows.NextResultSet() {
+			break
+		}
+	}
+	require.NoError(rows.Err())
+	require.NoError(rows.Close())
+	require.Equal([]int{1, 2}, results)
+
+	// So are COM_CHANGE_USER and COM_BINLOG_DUMP
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(ioutil.WriteFile(caFile, certPEM, 0600))
+	host, port, err := net.SplitHostPort(addr)
+	require.NoError(err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(err)
+	params := &mysql.ConnParams{Host: host, Port: portNum, Uname: "user", Pass: "password", DbName: "test", SslCa: caFile, ServerName: "localhost"}
+	params.EnableSSL()
+	conn, err := mysql.Connect(context.Background(), params)
+	require.NoError(err)
+	defer conn.Close()
+	_, ok := conn.Conn.(*tls.Conn)
+	require.True(ok)
+
+	_, err = conn.ExecuteFetch("SET @x = 1", 0, false)
+	require.NoError(err)
+	require.Equal(byte(mysql.OKPacket), changeUser(t, conn, "user", "password", "test")[0])
+	res, err := conn.ExecuteFetch("SELECT @x", 1, false)
+	require.NoError(err)
+	require.True(res.Rows[0][0].IsNull())
+
+	_, err = conn.ExecuteFetch("INSERT INTO test VALUES (5000)", 0, false)
+	require.NoError(err)
+	require.NoError(conn.WriteComBinlogDump(1, "", 4, 1))
+	for i := 0; i < 6; i++ {
+		_, err := conn.ReadBinlogEvent()
+		require.NoError(err)
+	}
+	_, err = conn.ReadBinlogEvent()
+	require.Error(err)
+	require.Contains(err.Error(), "EOF")
+}
+
+// changeUser sends a COM_CHANGE_USER command for the user, password and database given on a client connection, and
+// returns the packet answering it. The command names mysql_clear_password as its authentication method, for the
+// server to switch the client to mysql_native_password with a salt of its own.
+func changeUser(t *testing.T, conn *mysql.Conn, user, password, db string) []byte {
+	t.Helper()
+	writeRawPacket(t, conn, 0, []byte("\x11"+user+"\x00\x00"+db+"\x00\x21\x00"+mysql.MysqlClearPassword+"\x00"))
+	switchRequest := readRawPacket(t, conn)
+	prefix := "\xfe" + mysql.MysqlNativePassword + "\x00"
+	require.Equal(t, prefix, string(switchRequest[:len(prefix)]))
+	salt := switchRequest[len(prefix) : len(switchRequest)-1]
+	writeRawPacket(t, conn, 2, mysql.ScramblePassword(salt, []byte(password)))
+	return readRawPacket(t, conn)
+}
+
+// writeRawPacket writes a packet to the connection, for the commands the client doesn't implement.
+func writeRawPacket(t *testing.T, conn *mysql.Conn, seq byte, payload []byte) {
+	t.Helper()
+	_, err := conn.Conn.Write(append([]byte{byte(len(payload)), byte(len(payload) >> 8), byte(len(payload) >> 16), seq}, payload...))
+	require.NoError(t, err)
+}
+
+// readRawPacket reads the payload of a packet from the connection.
+func readRawPacket(t *testing.T, conn *mysql.Conn) []byte {
+	t.Helper()
+	header := make([]byte, 4)
+	_, err := io.ReadFull(conn.Conn, header)
+	require.NoError(t, err)
+	payload := make([]byte, int(header[0])|int(header[1])<<8|int(header[2])<<16)
+	_, err = io.ReadFull(conn.Conn, payload)
+	require.NoError(t, err)
+	return payload
+}
+
+// selfSignedCertificate returns a certificate for localhost, and the certificate in PEM format for clients to trust.
+func selfSignedCertificate(t *testing.T) (tls.Certificate, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		DNSNames:              []string{"localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestHandlerLoadDataLocal(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+	e.AddDatabase(memory.NewDatabase("load"))
+	// Sessions take the global value of local_infile when they start
+	require.NoError(sql.SystemVariables.SetGlobal("local_infile", int8(1)))
+	defer sql.SystemVariables.SetGlobal("local_infile", int8(0))
+	// The file sent by the client is written in tmpdir, whose path doesn't end with a separator
+	require.NoError(sql.SystemVariables.AssignValues(map[string]interface{}{"tmpdir": t.TempDir()}))
+	defer sql.SystemVariables.AssignValues(map[string]interface{}{"tmpdir": sql.GetTmpdirSessionVar()})
+
+	s, err := NewServer(Config{
+		Protocol: "tcp",
+		Address:  "localhost:0",
+		Auth:     new(auth.None),
+	}, e, testSessionBuilder)
+	require.NoError(err)
+	go s.Start()
+	defer s.Close()
+
+	db, err := gosql.Open("mysql", fmt.Sprintf("root:@tcp(%s)/load?multiStatements=true", s.Listener.Addr()))
+	require.NoError(err)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	gomysql.RegisterReaderHandler("load", func() io.Reader { return strings.NewReader("10\n20\n") })
+	defer gomysql.DeregisterReaderHandler("load")
+	_, err = db.Exec("CREATE TABLE t (v INT PRIMARY KEY)")
+	require.NoError(err)
+
+	// The client sends the file, which the statement reads from where it's written
+	res, err := db.Exec("LOAD DATA LOCAL INFILE 'Reader::load' INTO TABLE t")
+	require.NoError(err)
+	n, err := res.RowsAffected()
+	require.NoError(err)
+	require.Equal(int64(2), n)
+
+	// So does a statement of a multi-statement command
+	_, err = db.Exec("DELETE FROM t; LOAD DATA LOCAL INFILE 'Reader::load' INTO TABLE t")
+	require.NoError(err)
+	var count int
+	require.NoError(db.QueryRow("SELECT COUNT(*) FROM t").Scan(&count))
+	require.Equal(2, count)
+}
@@ -0,0 +1,68 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net"
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+
+	sqle "github.com/linanh/go-mysql-server"
+	"github.com/linanh/go-mysql-server/metrics"
+)
+
+// registerEngineMetrics registers the metrics read from the engine at the time of export in the registry given. They
+// replace those of any engine registered before.
+func registerEngineMetrics(r *metrics.Registry, e *sqle.Engine) {
+	r.NewGaugeFunc("gms_processes", "Number of processes in the process list.", func() float64 {
+		return float64(e.Catalog.ProcessList.Size())
+	})
+	r.NewGaugeFunc("gms_memory_used_bytes", "Memory in use in bytes, as reported by the memory manager.", func() float64 {
+		return float64(e.Catalog.MemoryManager.UsedMemory())
+	})
+	r.NewGaugeFunc("gms_memory_caches", "Number of caches kept by the memory manager.", func() float64 {
+		return float64(e.Catalog.MemoryManager.NumCaches())
+	})
+}
+
+// metricsServer is the HTTP listener serving the metrics of a registry at /metrics.
+type metricsServer struct {
+	listener net.Listener
+	srv      *http.Server
+}
+
+func newMetricsServer(address string, r *metrics.Registry) (*metricsServer, error) {
+	l, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", r.Handler())
+	return &metricsServer{listener: l, srv: &http.Server{Handler: mux}}, nil
+}
+
+func (m *metricsServer) serve() {
+	if err := m.srv.Serve(m.listener); err != nil && err != http.ErrServerClosed {
+		logrus.WithError(err).Error("metrics listener failed")
+	}
+}
+
+// close stops the HTTP server. The listener is closed too, as the server only closes it once it serves.
+func (m *metricsServer) close() {
+	m.srv.Close()
+	m.listener.Close()
+}
@@ -0,0 +1,156 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/stretchr/testify/require"
+
+	sqle "github.com/linanh/go-mysql-server"
+	"github.com/linanh/go-mysql-server/auth"
+	"github.com/linanh/go-mysql-server/memory"
+	"github.com/linanh/go-mysql-server/metrics"
+	"github.com/linanh/go-mysql-server/sql"
+	"github.com/linanh/go-mysql-server/sql/analyzer"
+	"github.com/linanh/go-mysql-server/sql/parse"
+)
+
+func TestServerMetrics(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+
+	s, err := NewServer(Config{
+		Protocol:       "tcp",
+		Address:        "localhost:0",
+		Auth:           new(auth.None),
+		MetricsAddress: "localhost:0",
+	}, e, testSessionBuilder)
+	require.NoError(err)
+	go s.Start()
+	defer s.Close()
+
+	conn := newConn(1)
+	s.h.NewConnection(conn)
+	err = s.h.ComQuery(conn, "SELECT * FROM test.test WHERE c1 < 5", func(*sqltypes.Result) error { return nil })
+	require.NoError(err)
+	err = s.h.ComQuery(conn, "SELECT * FROM test.nonexistent", func(*sqltypes.Result) error { return nil })
+	require.Error(err)
+
+	res, err := http.Get("http://" + s.metrics.listener.Addr().String() + "/metrics")
+	require.NoError(err)
+	defer res.Body.Close()
+	require.Equal(http.StatusOK, res.StatusCode)
+	require.Equal(metrics.ContentType, res.Header.Get("Content-Type"))
+	body, err := ioutil.ReadAll(res.Body)
+	require.NoError(err)
+
+	digest, _ := parse.QueryDigest("SELECT * FROM test.test WHERE c1 < 5")
+	for _, expected := range []string{
+		"# TYPE gms_statements_total counter\n",
+		`gms_statements_total{type="select"} `,
+		`gms_statement_errors_total{type="select"} `,
+		`gms_statement_duration_seconds_count{digest="` + digest + `"} 1`,
+		"# TYPE gms_rows_sent_total counter\ngms_rows_sent_total ",
+		"# TYPE gms_connections gauge\ngms_connections ",
+		"gms_processes 0\n",
+		"gms_memory_used_bytes ",
+		"gms_memory_caches ",
+	} {
+		require.Contains(string(body), expected)
+	}
+}
+
+func TestServerMetricsRegistry(t *testing.T) {
+	require := require.New(t)
+	r := metrics.NewRegistry()
+	c := sql.NewCatalog()
+	e := sqle.New(c, analyzer.NewDefault(c), &sqle.Config{Metrics: r})
+	e.AddDatabase(memory.NewDatabase("test"))
+	require.Equal(r, e.Metrics())
+
+	s, err := NewServer(Config{
+		Protocol: "tcp",
+		Address:  "localhost:0",
+		Auth:     new(auth.None),
+	}, e, testSessionBuilder)
+	require.NoError(err)
+	defer s.Close()
+
+	conn := newConn(1)
+	s.h.NewConnection(conn)
+	query := "SELECT 1 AS registry_of_its_own"
+	err = s.h.ComQuery(conn, query, func(*sqltypes.Result) error { return nil })
+	require.NoError(err)
+
+	// The metrics of the engine and server are registered in the registry of the engine, and not in the default one
+	digest, _ := parse.QueryDigest(query)
+	var own, shared bytes.Buffer
+	require.NoError(r.Write(&own))
+	require.NoError(metrics.Default.Write(&shared))
+	for _, expected := range []string{
+		`gms_statements_total{type="select"} 1`,
+		`gms_statement_duration_seconds_count{digest="` + digest + `"} 1`,
+		"gms_rows_sent_total 1\n",
+		"gms_connections 1\n",
+		"gms_processes ",
+	} {
+		require.Contains(own.String(), expected)
+	}
+	require.NotContains(shared.String(), digest)
+}
+
+func TestDeprecatedQueryMetrics(t *testing.T) {
+	require := require.New(t)
+	require.Equal(sqle.StatementCounter, QueryCounter)
+	require.Equal(sqle.StatementErrorCounter, QueryErrorCounter)
+	require.Equal(sqle.StatementHistogram, QueryHistogram)
+
+	// The deprecated metrics are still observed by the server once they're replaced
+	r := metrics.NewRegistry()
+	QueryCounter = r.NewCounter("queries", "")
+	QueryErrorCounter = r.NewCounter("query_errors", "")
+	QueryHistogram = r.NewHistogram("query_seconds", "", metrics.DefaultBuckets)
+	defer func() {
+		QueryCounter, QueryErrorCounter, QueryHistogram = sqle.StatementCounter, sqle.StatementErrorCounter, sqle.StatementHistogram
+	}()
+
+	s, err := NewServer(Config{
+		Protocol: "tcp",
+		Address:  "localhost:0",
+		Auth:     new(auth.None),
+	}, setupMemDB(require), testSessionBuilder)
+	require.NoError(err)
+	defer s.Close()
+
+	conn := newConn(1)
+	s.h.NewConnection(conn)
+	require.NoError(s.h.ComQuery(conn, "SELECT 1", func(*sqltypes.Result) error { return nil }))
+	require.Error(s.h.ComQuery(conn, "SELECT * FROM test.nonexistent", func(*sqltypes.Result) error { return nil }))
+
+	var exported bytes.Buffer
+	require.NoError(r.Write(&exported))
+	for _, expected := range []string{
+		`queries{query="SELECT 1"} 1`,
+		`query_errors{error="table not found: nonexistent",query="SELECT * FROM test.nonexistent"} 1`,
+		`query_seconds_count{duration="seconds",query="SELECT 1"} 1`,
+	} {
+		require.Contains(exported.String(), expected)
+	}
+}
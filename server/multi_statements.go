@@ -0,0 +1,70 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	"github.com/linanh/go-mysql-server/sql/parse"
+)
+
+// ComMultiQuery implements the mysql.MultiQueryHandler interface, which vitess calls instead of ComQuery.
+//
+// vitess would split the queries of clients which enabled CLIENT_MULTI_STATEMENTS on every semicolon, which breaks
+// the bodies of stored procedures and triggers, so their statements are split by the parser here instead, and run one
+// after the other until one fails. The result sets of every SELECT statement run by the procedure a CALL statement
+// calls are returned to clients which enabled CLIENT_MULTI_RESULTS.
+func (h *Handler) ComMultiQuery(
+	c *mysql.Conn,
+	query string,
+	callback func(*sqltypes.Result, bool) error,
+) (string, error) {
+	statement, rest := query, ""
+	if c.Capabilities&mysql.CapabilityClientMultiStatements != 0 {
+		var err error
+		if statement, rest, err = parse.FirstStatement(query); err != nil {
+			return "", err
+		}
+		if statement == "" {
+			// The engine reports the query as empty
+			statement = query
+		}
+	}
+
+	var resultSet func(*sqltypes.Result) error
+	var sets int
+	if c.Capabilities&mysql.CapabilityClientMultiResults != 0 {
+		resultSet = func(res *sqltypes.Result) error {
+			sets++
+			return callback(res, true)
+		}
+	}
+
+	err := h.errorWrappedDoQuery(c, statement, nil, resultSet, func(res *sqltypes.Result) error {
+		// The rows of a CALL statement are those of the last SELECT statement run, which were already returned
+		if sets > 0 {
+			return nil
+		}
+		return callback(res, false)
+	})
+	if err == nil && sets > 0 {
+		err = callback(&sqltypes.Result{}, false)
+	}
+	if err != nil {
+		return "", err
+	}
+	return rest, nil
+}
@@ -24,12 +24,15 @@ import (
 
 	sqle "github.com/linanh/go-mysql-server"
 	"github.com/linanh/go-mysql-server/auth"
+	"github.com/linanh/go-mysql-server/metrics"
 )
 
 // Server is a MySQL server for SQLe engines.
 type Server struct {
 	Listener *mysql.Listener
 	h        *Handler
+	audit    *auth.AuditFile
+	metrics  *metricsServer
 }
 
 // Config for the mysql server.
@@ -55,7 +58,20 @@ type Config struct {
 	TLSConfig *tls.Config
 	// RequestSecureTransport will require incoming connections to be TLS. Requires non-|nil| TLSConfig.
 	RequireSecureTransport bool
-	Listener       net.Listener
+	// AllowClearTextWithoutTLS lets the users whose accounts authenticate with a plugin other than
+	// mysql_native_password, such as caching_sha2_password and sha256_password, authenticate on connections without
+	// TLS. Their connections are refused otherwise, as the password exchange of some plugins is in clear text.
+	AllowClearTextWithoutTLS bool
+	// AuditLog, if not |nil|, makes the server write the audit trail of connections and queries to a file. The engine's
+	// Auth is left alone: to write its authorization checks to an audit file too, wrap it with auth.NewAudit.
+	AuditLog *auth.AuditFileConfig
+	// MetricsAddress, if not empty, is the address of an HTTP listener serving the metrics of the server and engine in
+	// the Prometheus text format at /metrics.
+	MetricsAddress string
+	// Metrics is the registry the metrics of the server are registered in, and served from at MetricsAddress. Defaults
+	// to the registry of the engine.
+	Metrics  *metrics.Registry
+	Listener net.Listener
 }
 
 // NewDefaultServer creates a Server with the default session builder.
@@ -93,6 +109,17 @@ func NewServer(cfg Config, e *sqle.Engine, sb SessionBuilder) (*Server, error) {
 			e.Catalog.MemoryManager,
 			cfg.Address),
 		cfg.ConnReadTimeout)
+	var audit *auth.AuditFile
+	if cfg.AuditLog != nil {
+		var err error
+		audit, err = auth.NewAuditFile(*cfg.AuditLog)
+		if err != nil {
+			return nil, err
+		}
+		cfg.Auth = auth.NewAudit(cfg.Auth, audit)
+		handler.audit = audit
+	}
+
 	a := cfg.Auth.Mysql()
 
 	var l *Listener
@@ -127,12 +154,33 @@ func NewServer(cfg Config, e *sqle.Engine, sb SessionBuilder) (*Server, error) {
 	}
 	vtListnr.TLSConfig = cfg.TLSConfig
 	vtListnr.RequireSecureTransport = cfg.RequireSecureTransport
+	vtListnr.AllowClearTextWithoutTLS = cfg.AllowClearTextWithoutTLS
 
-	return &Server{Listener: vtListnr, h: handler}, nil
+	registry := cfg.Metrics
+	if registry == nil {
+		registry = e.Metrics()
+	}
+	if registry != metrics.Default {
+		handler.metrics = newHandlerMetrics(registry)
+	}
+	registerEngineMetrics(registry, e)
+	var ms *metricsServer
+	if cfg.MetricsAddress != "" {
+		ms, err = newMetricsServer(cfg.MetricsAddress, registry)
+		if err != nil {
+			vtListnr.Close()
+			return nil, err
+		}
+	}
+
+	return &Server{Listener: vtListnr, h: handler, audit: audit, metrics: ms}, nil
 }
 
 // Start starts accepting connections on the server.
 func (s *Server) Start() error {
+	if s.metrics != nil {
+		go s.metrics.serve()
+	}
 	s.Listener.Accept()
 	return nil
 }
@@ -140,5 +188,11 @@ func (s *Server) Start() error {
 // Close closes the server connection.
 func (s *Server) Close() error {
 	s.Listener.Close()
+	if s.metrics != nil {
+		s.metrics.close()
+	}
+	if s.audit != nil {
+		return s.audit.Close()
+	}
 	return nil
 }
@@ -0,0 +1,197 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/linanh/go-mysql-server/sql"
+	"github.com/linanh/go-mysql-server/sql/parse"
+)
+
+// defaultSlowQueryLogFile is the default value of slow_query_log_file, which names the file after the host.
+const defaultSlowQueryLogFile = "host_name-slow.log"
+
+// slowQueryLog appends the statements which are slow, or which don't use indexes, to the file named by the
+// slow_query_log_file system variable, in the format of the MySQL slow query log so tools such as pt-query-digest can
+// read it.
+type slowQueryLog struct {
+	mu   sync.Mutex
+	path string
+	file *os.File
+}
+
+// slowStatement is a statement which finished, as written to the slow query log.
+type slowStatement struct {
+	query     string
+	database  string
+	startedAt time.Time
+	duration  time.Duration
+	stats     *sql.QueryStats
+	rowsSent  uint64
+}
+
+// log writes the statement given if the slow query log is enabled and the statement ran for long_query_time or more,
+// or if log_queries_not_using_indexes is enabled and the statement read a table without an index. Statements which
+// examined fewer rows than min_examined_row_limit are never written. Slow statements are counted by the Slow_queries
+// status variable whether the slow query log is enabled or not.
+func (l *slowQueryLog) log(ctx *sql.Context, s slowStatement) {
+	longQueryTime, err := sessionFloat(ctx, "long_query_time")
+	if err != nil {
+		logrus.WithError(err).Error("unable to read long_query_time")
+		return
+	}
+	slow := s.duration.Seconds() >= longQueryTime
+	if slow {
+		sql.IncrementStatusVariable(ctx, "Slow_queries", 1)
+	}
+
+	if l == nil || !globalBool("slow_query_log") {
+		return
+	}
+	if !slow && !(s.stats.FullScan() && globalBool("log_queries_not_using_indexes")) {
+		return
+	}
+
+	minRows, err := sessionFloat(ctx, "min_examined_row_limit")
+	if err != nil {
+		logrus.WithError(err).Error("unable to read min_examined_row_limit")
+		return
+	}
+	if float64(s.stats.RowsExamined()) < minRows {
+		return
+	}
+
+	_, path, _ := sql.SystemVariables.GetGlobal("slow_query_log_file")
+	if err := l.write(slowQueryLogPath(path), s.entry(ctx)); err != nil {
+		logrus.WithError(err).Error("unable to write to the slow query log")
+	}
+}
+
+// entry returns the entry of the statement in the slow query log.
+func (s slowStatement) entry(ctx *sql.Context) string {
+	client := ctx.Client()
+	host := client.Address
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# Time: %s\n", s.startedAt.UTC().Format("2006-01-02T15:04:05.000000Z"))
+	fmt.Fprintf(&sb, "# User@Host: %s[%s] @  [%s]  Id: %d\n", client.User, client.User, host, ctx.Session.ID())
+	fmt.Fprintf(&sb, "# Query_time: %.6f  Lock_time: %.6f Rows_sent: %d  Rows_examined: %d\n",
+		s.duration.Seconds(), s.stats.LockTime().Seconds(), s.rowsSent, s.stats.RowsExamined())
+	if s.database != "" {
+		fmt.Fprintf(&sb, "use %s;\n", s.database)
+	}
+	fmt.Fprintf(&sb, "SET timestamp=%d;\n", s.startedAt.Unix())
+	// Like the audit file, the log never holds passwords
+	sb.WriteString(strings.TrimRight(strings.TrimSpace(parse.RedactPasswords(s.query)), ";"))
+	sb.WriteString(";\n")
+	return sb.String()
+}
+
+// write appends an entry to the file at the path given, which is opened the first time it's written to. A header is
+// written to new files, as MySQL does.
+func (l *slowQueryLog) write(path, entry string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.file == nil || l.path != path {
+		if err := l.open(path); err != nil {
+			return err
+		}
+	}
+
+	_, err := l.file.WriteString(entry)
+	return err
+}
+
+func (l *slowQueryLog) open(path string) error {
+	if l.file != nil {
+		if err := l.file.Close(); err != nil {
+			logrus.WithError(err).Error("unable to close the slow query log")
+		}
+		l.file = nil
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0640)
+	if err != nil {
+		return err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	if info.Size() == 0 {
+		_, version, _ := sql.SystemVariables.GetGlobal("version")
+		header := fmt.Sprintf("%s, Version: %v. started with:\nTcp port: 0  Unix socket: (null)\n"+
+			"Time                 Id Command    Argument\n", filepath.Base(os.Args[0]), version)
+		if _, err := f.WriteString(header); err != nil {
+			_ = f.Close()
+			return err
+		}
+	}
+
+	l.path = path
+	l.file = f
+	return nil
+}
+
+// slowQueryLogPath returns the path of the slow query log named by the slow_query_log_file value given.
+func slowQueryLogPath(value interface{}) string {
+	path, _ := value.(string)
+	if path == "" || path == defaultSlowQueryLogFile {
+		host, err := os.Hostname()
+		if err != nil {
+			host = "localhost"
+		}
+		path = host + "-slow.log"
+	}
+	return path
+}
+
+// globalBool returns whether the boolean global system variable given is enabled.
+func globalBool(name string) bool {
+	_, val, ok := sql.SystemVariables.GetGlobal(name)
+	if !ok {
+		return false
+	}
+	b, err := sql.Int8.Convert(val)
+	return err == nil && b.(int8) != 0
+}
+
+// sessionFloat returns the value of the numeric session system variable given.
+func sessionFloat(ctx *sql.Context, name string) (float64, error) {
+	val, err := ctx.GetSessionVariable(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+	f, err := sql.Float64.Convert(val)
+	if err != nil {
+		return 0, err
+	}
+	return f.(float64), nil
+}
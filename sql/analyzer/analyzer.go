@@ -15,6 +15,7 @@
 package analyzer
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"reflect"
@@ -352,6 +353,29 @@ func (a *Analyzer) Analyze(ctx *sql.Context, n sql.Node, scope *Scope) (sql.Node
 	return a.analyzeWithSelector(ctx, n, scope, analyzeAll)
 }
 
+// PrepareQuery applies the rules which don't depend on the values bound to the node given, stopping before the
+// optimizations that do, such as pushing filters down to indexes. The result is meant to be cached and analyzed with
+// AnalyzePrepared every time it's executed, once its bindings are applied.
+func (a *Analyzer) PrepareQuery(ctx *sql.Context, n sql.Node, scope *Scope) (sql.Node, error) {
+	ctx = ctx.WithContext(context.WithValue(ctx.Context, preparingKey{}, true))
+	return a.analyzeThroughBatch(ctx, n, scope, "default-rules")
+}
+
+// preparingKey is the context key which marks the analysis done by PrepareQuery.
+type preparingKey struct{}
+
+// isPreparing returns whether the context given belongs to the analysis done by PrepareQuery, whose result is only
+// partially analyzed.
+func isPreparing(ctx *sql.Context) bool {
+	preparing, _ := ctx.Value(preparingKey{}).(bool)
+	return preparing
+}
+
+// AnalyzePrepared applies the rest of the transformation rules to a node returned by PrepareQuery.
+func (a *Analyzer) AnalyzePrepared(ctx *sql.Context, n sql.Node, scope *Scope) (sql.Node, error) {
+	return a.analyzeStartingAtBatch(ctx, n, scope, "once-after")
+}
+
 func (a *Analyzer) analyzeThroughBatch(ctx *sql.Context, n sql.Node, scope *Scope, until string) (sql.Node, error) {
 	stop := false
 	return a.analyzeWithSelector(ctx, n, scope, func(desc string) bool {
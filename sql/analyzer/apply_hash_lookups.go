@@ -77,6 +77,7 @@ func applyHashLookups(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (
 		// expression, rewrite the GetField indexes to work against the non-prefixed rows that
 		// are actually returned from the child.
 		var primaryGetFields, secondaryGetFields []sql.Expression
+		var keyTypes []sql.Type
 		validCondition := true
 		sql.Inspect(cond, func(e sql.Expression) bool {
 			if e == nil {
@@ -88,6 +89,7 @@ func applyHashLookups(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (
 					if sgf := secondaryGetter(e.Right()); sgf != nil {
 						primaryGetFields = append(primaryGetFields, pgf)
 						secondaryGetFields = append(secondaryGetFields, sgf)
+						keyTypes = append(keyTypes, hashKeyType(e))
 					} else {
 						validCondition = false
 					}
@@ -95,6 +97,7 @@ func applyHashLookups(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (
 					if sgf := secondaryGetter(e.Left()); sgf != nil {
 						primaryGetFields = append(primaryGetFields, pgf)
 						secondaryGetFields = append(secondaryGetFields, sgf)
+						keyTypes = append(keyTypes, hashKeyType(e))
 					} else {
 						validCondition = false
 					}
@@ -112,12 +115,23 @@ func applyHashLookups(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (
 		if validCondition {
 			primaryTuple := expression.NewTuple(primaryGetFields...)
 			secondaryTuple := expression.NewTuple(secondaryGetFields...)
-			return plan.NewHashLookup(cr, secondaryTuple, primaryTuple), nil
+			return plan.NewHashLookup(cr, secondaryTuple, primaryTuple, keyTypes), nil
 		}
 		return n, nil
 	})
 }
 
+// hashKeyType returns the type the values compared by an equality are hashed in, so that strings equal in the
+// collation they're compared in have the same hash, or nil if they aren't both strings.
+func hashKeyType(e *expression.Equals) sql.Type {
+	_, leftIsString := e.Left().Type().(sql.StringType)
+	_, rightIsString := e.Right().Type().(sql.StringType)
+	if leftIsString && rightIsString {
+		return sql.CreateLongText(e.Collation())
+	}
+	return nil
+}
+
 func getFieldIndexRange(low, high, offset int) func(sql.Expression) sql.Expression {
 	if high != -1 {
 		return func(e sql.Expression) sql.Expression {
@@ -81,6 +81,90 @@ func assignCatalog(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql
 			nc := *node
 			nc.Catalog = a.Catalog
 			return &nc, nil
+		case *plan.CreateUser:
+			nc := *node
+			nc.Catalog = a.Catalog
+			return &nc, nil
+		case *plan.AlterUser:
+			nc := *node
+			nc.Catalog = a.Catalog
+			return &nc, nil
+		case *plan.DropUser:
+			nc := *node
+			nc.Catalog = a.Catalog
+			return &nc, nil
+		case *plan.CreateRole:
+			nc := *node
+			nc.Catalog = a.Catalog
+			return &nc, nil
+		case *plan.Grant:
+			nc := *node
+			nc.Catalog = a.Catalog
+			return &nc, nil
+		case *plan.Revoke:
+			nc := *node
+			nc.Catalog = a.Catalog
+			return &nc, nil
+		case *plan.GrantRole:
+			nc := *node
+			nc.Catalog = a.Catalog
+			return &nc, nil
+		case *plan.RevokeRole:
+			nc := *node
+			nc.Catalog = a.Catalog
+			return &nc, nil
+		case *plan.SetRole:
+			nc := *node
+			nc.Catalog = a.Catalog
+			return &nc, nil
+		case *plan.ShowGrants:
+			nc := *node
+			nc.Catalog = a.Catalog
+			return &nc, nil
+		case *plan.Kill:
+			nc := *node
+			nc.Catalog = a.Catalog
+			return &nc, nil
+		case *plan.ShowBinaryLogs:
+			nc := *node
+			nc.Catalog = a.Catalog
+			return &nc, nil
+		case *plan.ShowBinlogEvents:
+			nc := *node
+			nc.Catalog = a.Catalog
+			return &nc, nil
+		case *plan.ShowMasterStatus:
+			nc := *node
+			nc.Catalog = a.Catalog
+			return &nc, nil
+		case *plan.PurgeBinaryLogs:
+			nc := *node
+			nc.Catalog = a.Catalog
+			return &nc, nil
+		case *plan.ChangeReplicationSource:
+			nc := *node
+			nc.Catalog = a.Catalog
+			return &nc, nil
+		case *plan.StartReplica:
+			nc := *node
+			nc.Catalog = a.Catalog
+			return &nc, nil
+		case *plan.StopReplica:
+			nc := *node
+			nc.Catalog = a.Catalog
+			return &nc, nil
+		case *plan.ShowReplicaStatus:
+			nc := *node
+			nc.Catalog = a.Catalog
+			return &nc, nil
+		case *plan.PrepareQuery:
+			nc := *node
+			nc.Builder = statementBuilder{a}
+			return &nc, nil
+		case *plan.ExecuteQuery:
+			nc := *node
+			nc.Builder = statementBuilder{a}
+			return &nc, nil
 		default:
 			return n, nil
 		}
@@ -45,23 +45,13 @@ func validateCreateTableChecks(ctx *sql.Context, a *Analyzer, n *plan.CreateTabl
 		return nil, err
 	}
 
-	plan.InspectExpressions(n, func(e sql.Expression) bool {
+	for _, ch := range n.Checks() {
+		err = checkExpressionValid(ch.Expr)
 		if err != nil {
-			return false
+			return nil, err
 		}
 
-		switch e := e.(type) {
-		case *expression.Wrapper, nil:
-			// column defaults, no need to inspect these
-			return false
-		default:
-			// check expressions, must be validated
-			// TODO: would be better to wrap these in something else to be able to identify them better
-			err = checkExpressionValid(e)
-			if err != nil {
-				return false
-			}
-
+		sql.Inspect(ch.Expr, func(e sql.Expression) bool {
 			switch e := e.(type) {
 			case column:
 				col := newTableCol(e.Table(), e.Name())
@@ -70,10 +60,9 @@ func validateCreateTableChecks(ctx *sql.Context, a *Analyzer, n *plan.CreateTabl
 					return false
 				}
 			}
-
-			return true
-		}
-	})
+			return err == nil
+		})
+	}
 
 	if err != nil {
 		return nil, err
@@ -313,6 +313,30 @@ func getIndexes(
 		}
 
 		return result, nil
+	case *expression.MatchAgainst:
+		lookup, err := getFullTextIndexLookup(ctx, e)
+		if err != nil || lookup == nil {
+			return result, err
+		}
+
+		getField := extractGetField(e)
+		if getField == nil {
+			return result, nil
+		}
+
+		result[getField.Table()] = lookup
+	case sql.SpatialRelation:
+		lookup, err := getSpatialIndexLookup(ctx, ia, e, tableAliases)
+		if err != nil || lookup == nil {
+			return result, err
+		}
+
+		getField := extractGetField(e)
+		if getField == nil {
+			return result, nil
+		}
+
+		result[getField.Table()] = lookup
 	}
 
 	return result, nil
@@ -405,6 +429,81 @@ func getComparisonIndexLookup(
 	return nil, nil
 }
 
+// getFullTextIndexLookup returns the index lookup of the rows which may match a MATCH ... AGAINST search of the FULLTEXT
+// index the analyzer set on it. The search itself is still evaluated on the rows of the lookup.
+func getFullTextIndexLookup(ctx *sql.Context, e *expression.MatchAgainst) (*indexLookup, error) {
+	idx := e.Index()
+	if idx == nil {
+		return nil, nil
+	}
+
+	query, err := e.Query(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lookup, err := idx.Search(ctx, e.Parser(), query)
+	if err != nil || lookup == nil {
+		return nil, err
+	}
+
+	return &indexLookup{
+		exprs:   e.Columns,
+		lookup:  lookup,
+		indexes: []sql.Index{idx},
+	}, nil
+}
+
+// getSpatialIndexLookup returns the index lookup of the geometries which may satisfy the given spatial relation between
+// a column with a spatial index and a constant geometry, which are the ones whose minimum bounding rectangles intersect
+// the constant's. The relation itself is still evaluated on the rows of the lookup.
+func getSpatialIndexLookup(
+	ctx *sql.Context,
+	ia *indexAnalyzer,
+	e sql.SpatialRelation,
+	tableAliases TableAliases,
+) (*indexLookup, error) {
+	left, right, ok := e.IntersectingArguments()
+	if !ok {
+		return nil, nil
+	}
+	if !isEvaluable(right) {
+		left, right = right, left
+	}
+	if isEvaluable(left) || !isEvaluable(right) {
+		return nil, nil
+	}
+
+	idx, ok := ia.IndexByExpression(ctx, ctx.GetCurrentDatabase(), normalizeExpressions(ctx, tableAliases, left)...).(sql.SpatialIndex)
+	if !ok {
+		return nil, nil
+	}
+
+	value, err := right.Eval(sql.NewEmptyContext(), nil)
+	if err != nil || value == nil {
+		return nil, err
+	}
+	// Errors about the geometry are left to the relation, which reports them with the name of its function.
+	g, err := sql.Geometry.Convert(value)
+	if err != nil {
+		return nil, nil
+	}
+	mbr, ok := sql.GeometryMBR(g.(sql.GeometryValue))
+	if !ok {
+		return nil, nil
+	}
+
+	lookup, err := idx.Intersecting(mbr)
+	if err != nil || lookup == nil {
+		return nil, err
+	}
+
+	return &indexLookup{
+		exprs:   []sql.Expression{left},
+		lookup:  lookup,
+		indexes: []sql.Index{idx},
+	}, nil
+}
+
 // Returns an equivalent expression to the one given with the left and right terms reversed. The new left and right side
 // of the expression are returned as well.
 func swapTermsOfExpression(e expression.Comparer) (left sql.Expression, right sql.Expression, newExpr expression.Comparer) {
@@ -16,7 +16,6 @@ package analyzer
 
 import (
 	"fmt"
-	"math"
 	"strconv"
 	"strings"
 
@@ -24,6 +23,19 @@ import (
 	"github.com/linanh/go-mysql-server/sql/plan"
 )
 
+const (
+	// defaultTableRowCount is the number of rows assumed for tables which
+	// can't report statistics about themselves.
+	defaultTableRowCount = 1000
+	// maxExhaustiveJoinSearch is the largest number of commutable tables
+	// for which every possible access order is costed.
+	maxExhaustiveJoinSearch = 6
+	// maxDynamicJoinSearch is the largest number of commutable tables
+	// which are ordered with dynamic programming. Larger joins are ordered
+	// greedily.
+	maxDynamicJoinSearch = 12
+)
+
 func buildJoinTree(
 	jo *joinOrderNode,
 	joinConds []*joinCond,
@@ -42,25 +54,6 @@ func buildJoinTree(
 	return found
 }
 
-// Generates all permutations of the slice given.
-func permutations(a []int) (res [][]int) {
-	var helper func(n int)
-	helper = func(n int) {
-		if n > len(a) {
-			res = append(res, append([]int(nil), a...))
-		} else {
-			helper(n + 1)
-			for i := n + 1; i < len(a); i++ {
-				a[n], a[i] = a[i], a[n]
-				helper(n + 1)
-				a[i], a[n] = a[n], a[i]
-			}
-		}
-	}
-	helper(0)
-	return res
-}
-
 // assignConditions attempts to assign the conditions in |conditions|
 // to the search tree in |root|, such that every condition is on an
 // internal node, and all of the trees referenced in the condition
@@ -129,7 +122,8 @@ type joinOrderNode struct {
 	left     *joinOrderNode
 	right    *joinOrderNode
 	order    []int
-	cost     uint64
+	cost     float64
+	rows     float64
 }
 
 func (jo *joinOrderNode) String() string {
@@ -266,7 +260,7 @@ func (jo *joinOrderNode) tables() []NameableNode {
 	}
 }
 
-// estimateCost sets `jo.cost` and `jo.order` for this
+// estimateCost sets `jo.cost`, `jo.rows` and `jo.order` for this
 // `joinOrderNode`, taking into account the cost of its children and
 // attempting to find the lowest cost assignment by varying
 // `jo.order` for commutable nodes.
@@ -275,10 +269,12 @@ func (jo *joinOrderNode) estimateCost(ctx *sql.Context, joinIndexes joinIndexesB
 		// Subqueries are considered opaque in this analysis, so give them the opaque table cost.
 		switch node := jo.node.(type) {
 		case *plan.SubqueryAlias:
-			jo.cost = uint64(1000)
+			jo.rows = defaultTableRowCount
+			jo.cost = jo.rows
 			return nil
 		case *plan.ValueDerivedTable:
-			jo.cost = uint64(len(node.ExpressionTuples))
+			jo.rows = float64(len(node.ExpressionTuples))
+			jo.cost = jo.rows
 			return nil
 		}
 
@@ -289,10 +285,11 @@ func (jo *joinOrderNode) estimateCost(ctx *sql.Context, joinIndexes joinIndexesB
 			if err != nil {
 				return err
 			}
-			jo.cost = numRows
+			jo.rows = float64(numRows)
 		} else {
-			jo.cost = uint64(1000)
+			jo.rows = defaultTableRowCount
 		}
+		jo.cost = jo.rows
 	} else if jo.left != nil {
 		err := jo.left.estimateCost(ctx, joinIndexes)
 		if err != nil {
@@ -303,6 +300,7 @@ func (jo *joinOrderNode) estimateCost(ctx *sql.Context, joinIndexes joinIndexesB
 			return err
 		}
 		jo.cost = jo.left.cost * jo.right.cost
+		jo.rows = jo.left.rows * jo.right.rows
 	} else {
 		for i := range jo.commutes {
 			err := jo.commutes[i].estimateCost(ctx, joinIndexes)
@@ -310,52 +308,243 @@ func (jo *joinOrderNode) estimateCost(ctx *sql.Context, joinIndexes joinIndexesB
 				return err
 			}
 		}
-		indexes := make([]int, len(jo.commutes))
-		for i := range jo.commutes {
-			indexes[i] = i
+
+		var best *partialJoinOrder
+		var err error
+		switch {
+		case len(jo.commutes) <= maxExhaustiveJoinSearch:
+			best, err = jo.searchAllAccessOrders(ctx, joinIndexes)
+		case len(jo.commutes) <= maxDynamicJoinSearch:
+			best, err = jo.searchAccessOrdersDynamic(ctx, joinIndexes)
+		default:
+			best, err = jo.searchAccessOrdersGreedy(ctx, joinIndexes)
+		}
+		if err != nil {
+			return err
 		}
-		lowestCost := uint64(math.MaxUint64)
-		accessOrders := permutations(indexes)
-		lowestCostIdx := 0
-		for i, accessOrder := range accessOrders {
-			cost, err := jo.estimateAccessOrderCost(ctx, accessOrder, joinIndexes, lowestCost)
+
+		jo.order = best.order
+		jo.cost = best.cost
+		jo.rows = best.rows
+	}
+
+	return nil
+}
+
+// partialJoinOrder is a left-deep access order for some of the commutable
+// children of a joinOrderNode, along with its estimated cost and the
+// estimated number of rows it produces.
+type partialJoinOrder struct {
+	order  []int
+	schema sql.Schema
+	cost   float64
+	rows   float64
+}
+
+// extend returns the partial order that results from joining the
+// commutable child at |idx| to the right of this one.
+func (p *partialJoinOrder) extend(ctx *sql.Context, jo *joinOrderNode, idx int, joinIndexes joinIndexesByTable) (*partialJoinOrder, error) {
+	child := &jo.commutes[idx]
+	next := &partialJoinOrder{
+		order:  append(append(make([]int, 0, len(p.order)+1), p.order...), idx),
+		schema: append(append(make(sql.Schema, 0, len(p.schema)+len(child.schema())), p.schema...), child.schema()...),
+	}
+
+	// The first table in the order is always scanned in full.
+	if len(p.order) == 0 {
+		next.cost = child.cost
+		next.rows = child.rows
+		return next, nil
+	}
+
+	if child.node != nil && !isOpaqueJoinTable(child.node) {
+		indexes := joinIndexes[strings.ToLower(child.node.Name())]
+		if ji := indexes.getUsableIndex(next.schema); ji != nil {
+			// Every row on the left does one lookup into the index on
+			// this table, which returns some number of rows.
+			rowsPerLookup, err := estimateRowsPerLookup(ctx, child, ji)
 			if err != nil {
-				return err
+				return nil, err
+			}
+			next.cost = p.cost + p.rows*rowsPerLookup
+			next.rows = p.rows * rowsPerLookup
+			return next, nil
+		}
+	}
+
+	next.cost = p.cost + p.rows*child.cost
+	next.rows = p.rows * child.rows
+	return next, nil
+}
+
+// searchAllAccessOrders costs every permutation of the commutable
+// children of this node and returns the cheapest one.
+func (jo *joinOrderNode) searchAllAccessOrders(ctx *sql.Context, joinIndexes joinIndexesByTable) (*partialJoinOrder, error) {
+	indexes := make([]int, len(jo.commutes))
+	for i := range jo.commutes {
+		indexes[i] = i
+	}
+
+	var best *partialJoinOrder
+	for _, accessOrder := range permutations(indexes) {
+		p := &partialJoinOrder{}
+		for _, idx := range accessOrder {
+			var err error
+			p, err = p.extend(ctx, jo, idx, joinIndexes)
+			if err != nil {
+				return nil, err
 			}
-			if cost < lowestCost {
-				lowestCost = cost
-				lowestCostIdx = i
+			if best != nil && p.cost >= best.cost {
+				break
 			}
 		}
-		jo.order = accessOrders[lowestCostIdx]
-		jo.cost = lowestCost
+		if len(p.order) == len(accessOrder) && (best == nil || p.cost < best.cost) {
+			best = p
+		}
 	}
 
-	return nil
+	return best, nil
 }
 
-func (jo *joinOrderNode) estimateAccessOrderCost(ctx *sql.Context, accessOrder []int, joinIndexes joinIndexesByTable, lowestCost uint64) (uint64, error) {
-	cost := uint64(1)
-	var availableSchemaForKeys sql.Schema
-	for i, idx := range accessOrder {
-		if cost >= lowestCost {
-			return cost, nil
-		}
-		availableSchemaForKeys = append(availableSchemaForKeys, jo.commutes[idx].schema()...)
-		if jo.commutes[idx].node != nil {
-			indexes := joinIndexes[strings.ToLower(jo.commutes[idx].node.Name())]
-			_, isSubquery := jo.commutes[idx].node.(*plan.SubqueryAlias)
-			_, isValuesTable := jo.commutes[idx].node.(*plan.ValueDerivedTable)
-			if i == 0 || isSubquery || isValuesTable || indexes.getUsableIndex(availableSchemaForKeys) == nil {
-				cost *= jo.commutes[idx].cost
-			} else {
-				cost += 1
+// searchAccessOrdersDynamic finds the cheapest left-deep access order for
+// the commutable children of this node by dynamic programming over the
+// subsets of the children: the cheapest order for a set of tables is the
+// cheapest order for one of its subsets with a single table one fewer,
+// extended with the remaining table.
+func (jo *joinOrderNode) searchAccessOrdersDynamic(ctx *sql.Context, joinIndexes joinIndexesByTable) (*partialJoinOrder, error) {
+	n := len(jo.commutes)
+	best := make([]*partialJoinOrder, 1<<uint(n))
+	best[0] = &partialJoinOrder{}
+
+	// Every subset is numerically smaller than its supersets, so
+	// visiting the sets in order visits each set after all of its
+	// subsets.
+	for set := 0; set < len(best)-1; set++ {
+		p := best[set]
+		if p == nil {
+			continue
+		}
+		for idx := 0; idx < n; idx++ {
+			bit := 1 << uint(idx)
+			if set&bit != 0 {
+				continue
+			}
+			next, err := p.extend(ctx, jo, idx, joinIndexes)
+			if err != nil {
+				return nil, err
 			}
+			if cur := best[set|bit]; cur == nil || next.cost < cur.cost {
+				best[set|bit] = next
+			}
+		}
+	}
+
+	return best[len(best)-1], nil
+}
+
+// searchAccessOrdersGreedy builds an access order for the commutable
+// children of this node by repeatedly joining whichever remaining table
+// is cheapest to add next. It is used for joins with too many tables to
+// search more thoroughly.
+func (jo *joinOrderNode) searchAccessOrdersGreedy(ctx *sql.Context, joinIndexes joinIndexesByTable) (*partialJoinOrder, error) {
+	used := make([]bool, len(jo.commutes))
+	p := &partialJoinOrder{}
+	for len(p.order) < len(jo.commutes) {
+		var next *partialJoinOrder
+		for idx := range jo.commutes {
+			if used[idx] {
+				continue
+			}
+			candidate, err := p.extend(ctx, jo, idx, joinIndexes)
+			if err != nil {
+				return nil, err
+			}
+			if next == nil || candidate.cost < next.cost {
+				next = candidate
+			}
+		}
+		used[next.order[len(next.order)-1]] = true
+		p = next
+	}
+
+	return p, nil
+}
+
+// estimateRowsPerLookup estimates the number of rows in the table of |jo|
+// matched by a single lookup into the join index given. It prefers the
+// cardinality reported by the index itself, then a histogram of the
+// indexed column, and otherwise assumes that every lookup finds a single
+// row.
+func estimateRowsPerLookup(ctx *sql.Context, jo *joinOrderNode, ji *joinIndex) (float64, error) {
+	if ji.index == nil {
+		left, err := estimateRowsPerLookup(ctx, jo, ji.disjunction[0])
+		if err != nil {
+			return 0, err
+		}
+		right, err := estimateRowsPerLookup(ctx, jo, ji.disjunction[1])
+		if err != nil {
+			return 0, err
+		}
+		return left + right, nil
+	}
+
+	if ji.index.IsUnique() {
+		return 1, nil
+	}
+
+	var distinct uint64
+	if is, ok := ji.index.(sql.IndexStatistics); ok {
+		var err error
+		distinct, err = is.Cardinality(ctx)
+		if err != nil {
+			return 0, err
+		}
+	} else if rt := getResolvedTable(jo.node); rt != nil && len(ji.cols) == 1 {
+		if ht, ok := rt.Table.(sql.HistogramTable); ok {
+			histogram, err := ht.Histogram(ctx, ji.cols[0].Name())
+			if err != nil {
+				return 0, err
+			}
+			if histogram != nil {
+				distinct = histogram.DistinctCount()
+			}
+		}
+	}
+
+	if distinct == 0 || jo.rows <= float64(distinct) {
+		return 1, nil
+	}
+	return jo.rows / float64(distinct), nil
+}
+
+// isOpaqueJoinTable returns whether the node given is a derived table,
+// which can't have indexes applied to it.
+func isOpaqueJoinTable(node NameableNode) bool {
+	switch node.(type) {
+	case *plan.SubqueryAlias, *plan.ValueDerivedTable:
+		return true
+	default:
+		return false
+	}
+}
+
+// Generates all permutations of the slice given.
+func permutations(a []int) (res [][]int) {
+	var helper func(n int)
+	helper = func(n int) {
+		if n > len(a) {
+			res = append(res, append([]int(nil), a...))
 		} else {
-			cost *= jo.commutes[idx].cost
+			helper(n + 1)
+			for i := n + 1; i < len(a); i++ {
+				a[n], a[i] = a[i], a[n]
+				helper(n + 1)
+				a[i], a[n] = a[n], a[i]
+			}
 		}
 	}
-	return cost, nil
+	helper(0)
+	return res
 }
 
 func (jo *joinOrderNode) schema() sql.Schema {
@@ -19,8 +19,11 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/linanh/go-mysql-server/memory"
 	"github.com/linanh/go-mysql-server/sql"
+	"github.com/linanh/go-mysql-server/sql/expression"
 	"github.com/linanh/go-mysql-server/sql/plan"
 )
 
@@ -603,6 +606,101 @@ func TestBuildJoinTree(t *testing.T) {
 	}
 }
 
+func TestEstimateCostUsesStatistics(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	// A star schema: the fact table has many rows for each key of dim1, but only about one row for each key of dim2.
+	fact := memory.NewTable("fact", sql.Schema{
+		{Name: "d1", Type: sql.Int64, Source: "fact"},
+		{Name: "d2", Type: sql.Int64, Source: "fact"},
+	})
+	for i := int64(0); i < 1000; i++ {
+		require.NoError(fact.Insert(ctx, sql.NewRow(i%2, i%500)))
+	}
+	dim1 := memory.NewTable("dim1", sql.Schema{{Name: "id", Type: sql.Int64, Source: "dim1"}})
+	for i := int64(0); i < 10; i++ {
+		require.NoError(dim1.Insert(ctx, sql.NewRow(i)))
+	}
+	dim2 := memory.NewTable("dim2", sql.Schema{{Name: "id", Type: sql.Int64, Source: "dim2"}})
+	for i := int64(0); i < 500; i++ {
+		require.NoError(dim2.Insert(ctx, sql.NewRow(i)))
+	}
+
+	index := func(table *memory.Table, col *expression.GetField, unique bool) sql.Index {
+		return &memory.MergeableIndex{
+			Tbl:       table,
+			TableName: table.Name(),
+			Exprs:     []sql.Expression{col},
+			Unique:    unique,
+		}
+	}
+	joinIndexes := joinIndexesByTable{
+		"fact": {
+			{table: "fact", index: index(fact, gf(0, "fact", "d1"), false), cols: []*expression.GetField{gf(0, "fact", "d1")}, comparandCols: []*expression.GetField{gf(0, "dim1", "id")}},
+			{table: "fact", index: index(fact, gf(1, "fact", "d2"), false), cols: []*expression.GetField{gf(1, "fact", "d2")}, comparandCols: []*expression.GetField{gf(0, "dim2", "id")}},
+		},
+		"dim1": {
+			{table: "dim1", index: index(dim1, gf(0, "dim1", "id"), true), cols: []*expression.GetField{gf(0, "dim1", "id")}, comparandCols: []*expression.GetField{gf(0, "fact", "d1")}},
+		},
+		"dim2": {
+			{table: "dim2", index: index(dim2, gf(0, "dim2", "id"), true), cols: []*expression.GetField{gf(0, "dim2", "id")}, comparandCols: []*expression.GetField{gf(1, "fact", "d2")}},
+		},
+	}
+
+	newJoinOrder := func() *joinOrderNode {
+		return &joinOrderNode{commutes: []joinOrderNode{
+			{node: plan.NewResolvedTable(fact, nil, nil)},
+			{node: plan.NewResolvedTable(dim1, nil, nil)},
+			{node: plan.NewResolvedTable(dim2, nil, nil)},
+		}}
+	}
+
+	jo := newJoinOrder()
+	require.NoError(jo.estimateCost(ctx, joinIndexes))
+	require.Equal([]string{"dim2", "fact", "dim1"}, jo.tableNames())
+	require.Equal(float64(1000), jo.rows)
+
+	// Dynamic programming should find the same order as the exhaustive search
+	jo = newJoinOrder()
+	for i := range jo.commutes {
+		require.NoError(jo.commutes[i].estimateCost(ctx, joinIndexes))
+	}
+	best, err := jo.searchAccessOrdersDynamic(ctx, joinIndexes)
+	require.NoError(err)
+	require.Equal([]int{2, 0, 1}, best.order)
+
+	greedy, err := jo.searchAccessOrdersGreedy(ctx, joinIndexes)
+	require.NoError(err)
+	require.ElementsMatch([]int{0, 1, 2}, greedy.order)
+}
+
+func TestEstimateCostManyTables(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	var tables []interface{}
+	for i := 0; i < maxDynamicJoinSearch+2; i++ {
+		tables = append(tables, fmt.Sprintf("t%d", i))
+	}
+
+	// Tables without statistics all get the default cost, so any complete order is acceptable. This is mostly testing
+	// that very wide joins are planned without enumerating every permutation.
+	jo := tableOrder(tables...)
+	for i := range jo.commutes {
+		jo.commutes[i].node = plan.NewResolvedTable(memory.NewTable(fmt.Sprintf("t%d", i), nil), nil, nil)
+	}
+	require.NoError(jo.estimateCost(ctx, nil))
+	require.Len(jo.order, len(tables))
+
+	jo = tableOrder(tables[:maxDynamicJoinSearch]...)
+	for i := range jo.commutes {
+		jo.commutes[i].node = plan.NewResolvedTable(memory.NewTable(fmt.Sprintf("t%d", i), nil), nil, nil)
+	}
+	require.NoError(jo.estimateCost(ctx, nil))
+	require.Len(jo.order, maxDynamicJoinSearch)
+}
+
 // jc == join cond
 func jc(leftTable, rightTable string) *joinCond {
 	return &joinCond{
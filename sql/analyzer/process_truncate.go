@@ -69,6 +69,12 @@ func deleteToTruncate(ctx *sql.Context, a *Analyzer, deletePlan *plan.DeleteFrom
 	}
 	tblName := strings.ToLower(tbl.Name())
 
+	// The rows deleted by TRUNCATE aren't known, so they couldn't be logged, and the listeners of row changes would
+	// only be told the table was reset
+	if a.Catalog.Binlog != nil || a.Catalog.RowChangeListeners.Listening() {
+		return deletePlan, nil
+	}
+
 	// auto_increment behaves differently for TRUNCATE and DELETE
 	for _, col := range tbl.Schema() {
 		if col.AutoIncrement {
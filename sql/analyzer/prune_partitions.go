@@ -0,0 +1,338 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/linanh/go-mysql-server/sql"
+	"github.com/linanh/go-mysql-server/sql/expression"
+	"github.com/linanh/go-mysql-server/sql/plan"
+)
+
+// maxPrunedKeys is the maximum number of combinations of the values of the partitioning columns which are looked up to
+// prune partitions.
+const maxPrunedKeys = 256
+
+// selectPartitions returns a table restricted to the partitions named by a PARTITION clause.
+func selectPartitions(ctx *sql.Context, table sql.Table, names []string) (sql.Table, error) {
+	pt, ok := table.(sql.PartitionedTable)
+	if !ok {
+		return nil, sql.ErrPartitionClauseOnNonPartitioned.New()
+	}
+	p, err := pt.GetPartitioning(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return nil, sql.ErrPartitionClauseOnNonPartitioned.New()
+	}
+	for _, name := range names {
+		if p.PartitionIndex(name) < 0 {
+			return nil, sql.ErrUnknownPartition.New(name, table.Name())
+		}
+	}
+	return pt.WithPartitions(names), nil
+}
+
+// prunePartitions restricts the partitioned tables filters read from to the partitions the rows matching them can be
+// in. Partitions are found from the values the filters require for the partitioning columns or, for RANGE partitions,
+// from the range they require for the first one.
+func prunePartitions(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	if !n.Resolved() {
+		return n, nil
+	}
+
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		filter, ok := n.(*plan.Filter)
+		if !ok {
+			return n, nil
+		}
+
+		child, err := prunePartitionsOfNode(ctx, a, filter.Child, splitConjunction(filter.Expression))
+		if err != nil || child == filter.Child {
+			return n, err
+		}
+		return filter.WithChildren(child)
+	})
+}
+
+// prunePartitionsOfNode prunes the partitions of the tables whose rows all go through a filter. The tables of the
+// nullable side of outer joins and of subqueries don't.
+func prunePartitionsOfNode(ctx *sql.Context, a *Analyzer, n sql.Node, conditions []sql.Expression) (sql.Node, error) {
+	switch n := n.(type) {
+	case *plan.ResolvedTable:
+		return prunePartitionsOfTable(ctx, a, n, n.Name(), conditions)
+	case *plan.TableAlias:
+		rt, ok := n.Child.(*plan.ResolvedTable)
+		if !ok {
+			return n, nil
+		}
+		child, err := prunePartitionsOfTable(ctx, a, rt, n.Name(), conditions)
+		if err != nil || child == rt {
+			return n, err
+		}
+		return n.WithChildren(child)
+	case *plan.InnerJoin, *plan.CrossJoin:
+		children := n.Children()
+		newChildren := make([]sql.Node, len(children))
+		changed := false
+		for i, child := range children {
+			newChild, err := prunePartitionsOfNode(ctx, a, child, conditions)
+			if err != nil {
+				return nil, err
+			}
+			newChildren[i] = newChild
+			changed = changed || newChild != child
+		}
+		if !changed {
+			return n, nil
+		}
+		return n.WithChildren(newChildren...)
+	default:
+		return n, nil
+	}
+}
+
+func prunePartitionsOfTable(ctx *sql.Context, a *Analyzer, rt *plan.ResolvedTable, name string, conditions []sql.Expression) (sql.Node, error) {
+	pt, ok := rt.Table.(sql.PartitionedTable)
+	if !ok {
+		return rt, nil
+	}
+	p, err := pt.GetPartitioning(ctx)
+	if err != nil || p == nil {
+		return rt, err
+	}
+
+	selected, ok := partitionsOfKeys(ctx, p, name, conditions)
+	if !ok {
+		selected, ok = partitionsOfRange(p, name, conditions)
+	}
+	if !ok || len(selected) == len(p.Definitions) {
+		return rt, nil
+	}
+
+	names := make([]string, 0, len(selected))
+	for i, d := range p.Definitions {
+		if selected[i] {
+			names = append(names, d.Name)
+		}
+	}
+	a.Log("pruned partitions of table %s to %v", name, names)
+	return rt.WithTable(pt.WithPartitions(names))
+}
+
+// partitionsOfKeys returns the partitions of the values of the partitioning columns which the conditions require,
+// if they require a few values for each of them.
+func partitionsOfKeys(ctx *sql.Context, p *sql.Partitioning, table string, conditions []sql.Expression) (map[int]bool, bool) {
+	keys := []sql.Row{{}}
+	for i, col := range p.Columns {
+		values, ok := columnValues(p.Types[i], table, col, conditions)
+		if !ok || len(keys)*len(values) > maxPrunedKeys {
+			return nil, false
+		}
+
+		var newKeys []sql.Row
+		for _, key := range keys {
+			for _, v := range values {
+				newKeys = append(newKeys, append(key.Copy(), v))
+			}
+		}
+		keys = newKeys
+	}
+
+	selected := make(map[int]bool)
+	for _, key := range keys {
+		i, err := p.PartitionOfKey(ctx, key)
+		if sql.ErrNoPartitionForValue.Is(err) {
+			continue
+		}
+		if err != nil {
+			return nil, false
+		}
+		selected[i] = true
+	}
+	return selected, true
+}
+
+// columnValues returns the values of a column which a condition requires, converted to the type of the column.
+func columnValues(typ sql.Type, table, column string, conditions []sql.Expression) ([]interface{}, bool) {
+	for _, c := range conditions {
+		var exprs []sql.Expression
+		switch c := c.(type) {
+		case *expression.Equals:
+			if isPartitionColumn(c.Left(), table, column) {
+				exprs = []sql.Expression{c.Right()}
+			} else if isPartitionColumn(c.Right(), table, column) {
+				exprs = []sql.Expression{c.Left()}
+			}
+		case *expression.InTuple:
+			if tuple, ok := c.Right().(expression.Tuple); ok && isPartitionColumn(c.Left(), table, column) {
+				exprs = tuple
+			}
+		case *expression.IsNull:
+			if isPartitionColumn(c.Child, table, column) {
+				return []interface{}{nil}, true
+			}
+		}
+		if exprs == nil {
+			continue
+		}
+
+		values := make([]interface{}, 0, len(exprs))
+		for _, e := range exprs {
+			v, ok := literalValue(typ, e)
+			if !ok {
+				return nil, false
+			}
+			if v != nil {
+				values = append(values, v)
+			}
+		}
+		return values, true
+	}
+	return nil, false
+}
+
+// partitionsOfRange returns the RANGE partitions which can hold values of their first column in the range the
+// conditions require, if the partitions are bound by that column.
+func partitionsOfRange(p *sql.Partitioning, table string, conditions []sql.Expression) (map[int]bool, bool) {
+	if p.Method != sql.PartitionMethod_Range {
+		return nil, false
+	}
+	if _, ok := p.Expr.(*expression.GetField); !ok && !p.UseColumns {
+		return nil, false
+	}
+
+	typ := p.Types[0]
+	compare := func(a, b interface{}) int {
+		var cmp int
+		var err error
+		if p.UseColumns {
+			cmp, err = typ.Compare(a, b)
+		} else {
+			cmp, err = sql.Int64.Compare(a, b)
+		}
+		if err != nil {
+			return 0
+		}
+		return cmp
+	}
+
+	// The range is [lower, upper], as exclusive bounds are treated as inclusive ones
+	var lower, upper interface{}
+	found := false
+	bound := func(e sql.Expression, isLower bool) {
+		v, ok := literalValue(typ, e)
+		if !ok || v == nil {
+			return
+		}
+		if !p.UseColumns {
+			if v, ok = integerValue(e, v); !ok {
+				return
+			}
+		}
+		found = true
+		if isLower && (lower == nil || compare(v, lower) > 0) {
+			lower = v
+		} else if !isLower && (upper == nil || compare(v, upper) < 0) {
+			upper = v
+		}
+	}
+
+	column := p.Columns[0]
+	for _, c := range conditions {
+		switch c := c.(type) {
+		case *expression.GreaterThan, *expression.GreaterThanOrEqual, *expression.LessThan, *expression.LessThanOrEqual, *expression.Equals:
+			cmp := c.(expression.Comparer)
+			_, greater := c.(*expression.GreaterThan)
+			_, greaterOrEqual := c.(*expression.GreaterThanOrEqual)
+			_, equals := c.(*expression.Equals)
+			isLower := greater || greaterOrEqual
+			switch {
+			case isPartitionColumn(cmp.Left(), table, column):
+				bound(cmp.Right(), isLower || equals)
+				if equals {
+					bound(cmp.Right(), false)
+				}
+			case isPartitionColumn(cmp.Right(), table, column):
+				bound(cmp.Left(), !isLower || equals)
+				if equals {
+					bound(cmp.Left(), true)
+				}
+			}
+		case *expression.Between:
+			if isPartitionColumn(c.Val, table, column) {
+				bound(c.Lower, true)
+				bound(c.Upper, false)
+			}
+		}
+	}
+	if !found {
+		return nil, false
+	}
+
+	selected := make(map[int]bool)
+	for i, d := range p.Definitions {
+		// The values of the first column of the partition are at least the bound of the previous partition, and less
+		// than its own bound, or at most it if there are more columns.
+		if upper != nil && i > 0 {
+			prev := p.Definitions[i-1].LessThan[0]
+			if prev != nil && compare(prev, upper) > 0 {
+				continue
+			}
+		}
+		if lower != nil && d.LessThan[0] != nil {
+			cmp := compare(d.LessThan[0], lower)
+			if cmp < 0 || (cmp == 0 && len(p.Columns) == 1) {
+				continue
+			}
+		}
+		selected[i] = true
+	}
+	return selected, true
+}
+
+// isPartitionColumn returns whether an expression is the column of the table given.
+func isPartitionColumn(e sql.Expression, table, column string) bool {
+	gf, ok := e.(*expression.GetField)
+	return ok && strings.EqualFold(gf.Table(), table) && strings.EqualFold(gf.Name(), column)
+}
+
+// literalValue returns the value of a literal converted to the type given.
+func literalValue(typ sql.Type, e sql.Expression) (interface{}, bool) {
+	lit, ok := e.(*expression.Literal)
+	if !ok {
+		return nil, false
+	}
+	v, err := lit.Eval(nil, nil)
+	if err != nil {
+		return nil, false
+	}
+	if v == nil {
+		return nil, true
+	}
+	v, err = typ.Convert(v)
+	return v, err == nil
+}
+
+// integerValue returns a value of an integer column as an int64, if the literal it was converted from was an integer.
+func integerValue(e sql.Expression, v interface{}) (interface{}, bool) {
+	if !sql.IsInteger(e.Type()) {
+		return nil, false
+	}
+	v, err := sql.Int64.Convert(v)
+	return v, err == nil
+}
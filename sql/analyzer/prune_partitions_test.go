@@ -0,0 +1,122 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/linanh/go-mysql-server/memory"
+	"github.com/linanh/go-mysql-server/sql"
+	"github.com/linanh/go-mysql-server/sql/expression"
+	"github.com/linanh/go-mysql-server/sql/plan"
+)
+
+func TestPrunePartitions(t *testing.T) {
+	rule := getRuleFrom(OnceAfterDefault, "prune_partitions")
+
+	table := memory.NewPartitionedTable("t", sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "t"},
+		{Name: "b", Type: sql.Text, Source: "t"},
+	}, 1)
+	require.NoError(t, table.SetPartitioning(sql.NewEmptyContext(), &sql.Partitioning{
+		Method:     sql.PartitionMethod_Range,
+		UseColumns: true,
+		Columns:    []string{"a"},
+		Types:      []sql.Type{sql.Int64},
+		Definitions: []sql.PartitionDefinition{
+			{Name: "p0", LessThan: []interface{}{int64(10)}},
+			{Name: "p1", LessThan: []interface{}{int64(20)}},
+			{Name: "p2", LessThan: []interface{}{nil}},
+		},
+	}))
+	other := memory.NewTable("u", sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "u"},
+	})
+
+	a := expression.NewGetFieldWithTable(0, sql.Int64, "t", "a", false)
+	b := expression.NewGetFieldWithTable(1, sql.Text, "t", "b", false)
+	ua := expression.NewGetFieldWithTable(2, sql.Int64, "u", "a", false)
+	lit := func(v int64) sql.Expression {
+		return expression.NewLiteral(v, sql.Int64)
+	}
+	filter := func(e sql.Expression, child sql.Node) sql.Node {
+		return plan.NewFilter(e, child)
+	}
+	resolved := func(names ...string) sql.Node {
+		if names == nil {
+			return plan.NewResolvedTable(table, nil, nil)
+		}
+		return plan.NewResolvedTable(table.WithPartitions(names), nil, nil)
+	}
+
+	testCases := []analyzerFnTestCase{
+		{
+			name:     "equality",
+			node:     filter(expression.NewEquals(a, lit(15)), resolved()),
+			expected: filter(expression.NewEquals(a, lit(15)), resolved("p1")),
+		},
+		{
+			name:     "in",
+			node:     filter(expression.NewInTuple(a, expression.NewTuple(lit(1), lit(25))), resolved()),
+			expected: filter(expression.NewInTuple(a, expression.NewTuple(lit(1), lit(25))), resolved("p0", "p2")),
+		},
+		{
+			name:     "range",
+			node:     filter(expression.NewLessThan(a, lit(12)), resolved()),
+			expected: filter(expression.NewLessThan(a, lit(12)), resolved("p0", "p1")),
+		},
+		{
+			name: "between in conjunction",
+			node: filter(
+				expression.NewAnd(expression.NewBetween(a, lit(20), lit(30)), expression.NewEquals(b, expression.NewLiteral("x", sql.LongText))),
+				resolved(),
+			),
+			expected: filter(
+				expression.NewAnd(expression.NewBetween(a, lit(20), lit(30)), expression.NewEquals(b, expression.NewLiteral("x", sql.LongText))),
+				resolved("p2"),
+			),
+		},
+		{
+			name: "inner join",
+			node: filter(
+				expression.NewEquals(a, lit(5)),
+				plan.NewInnerJoin(resolved(), plan.NewResolvedTable(other, nil, nil), expression.NewEquals(a, ua)),
+			),
+			expected: filter(
+				expression.NewEquals(a, lit(5)),
+				plan.NewInnerJoin(resolved("p0"), plan.NewResolvedTable(other, nil, nil), expression.NewEquals(a, ua)),
+			),
+		},
+		{
+			name: "outer join",
+			node: filter(
+				expression.NewEquals(a, lit(5)),
+				plan.NewRightJoin(resolved(), plan.NewResolvedTable(other, nil, nil), expression.NewEquals(a, ua)),
+			),
+		},
+		{
+			name: "disjunction",
+			node: filter(expression.NewOr(expression.NewEquals(a, lit(5)), expression.NewEquals(a, lit(15))), resolved()),
+		},
+		{
+			name: "other column",
+			node: filter(expression.NewEquals(b, expression.NewLiteral("x", sql.LongText)), resolved()),
+		},
+	}
+
+	runTestCases(t, nil, testCases, NewDefault(nil), *rule)
+}
@@ -0,0 +1,201 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/linanh/go-mysql-server/sql"
+	"github.com/linanh/go-mysql-server/sql/expression"
+	"github.com/linanh/go-mysql-server/sql/plan"
+)
+
+// resolveFullTextMatches sets the FULLTEXT indexes MATCH ... AGAINST expressions search, which are the ones defined on
+// exactly the columns they name, and the parser which splits text into words as the session configures it.
+func resolveFullTextMatches(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	var found bool
+	plan.InspectExpressions(n, func(e sql.Expression) bool {
+		if _, ok := e.(*expression.MatchAgainst); ok {
+			found = true
+		}
+		return !found
+	})
+	if !found {
+		return n, nil
+	}
+
+	tableAliases, err := getTableAliases(n, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	var parser *sql.FullTextParser
+	n, err = plan.TransformExpressionsUp(ctx, n, func(e sql.Expression) (sql.Expression, error) {
+		m, ok := e.(*expression.MatchAgainst)
+		if !ok || m.Index() != nil {
+			return e, nil
+		}
+		if !isEvaluable(m.Against) {
+			return nil, sql.ErrFullTextAgainstArgument.New()
+		}
+
+		index, err := findFullTextIndex(ctx, tableAliases, m.Columns)
+		if err != nil {
+			return nil, err
+		}
+		if parser == nil {
+			parser, err = newFullTextParser(ctx, a)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return m.WithIndex(index, parser), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Searches which are conditions of a filter hold for the rows which match them, whatever their relevance.
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		filter, ok := n.(*plan.Filter)
+		if !ok {
+			return n, nil
+		}
+
+		conditions := splitConjunction(filter.Expression)
+		var changed bool
+		for i, c := range conditions {
+			if m, ok := c.(*expression.MatchAgainst); ok {
+				conditions[i] = m.AsCondition()
+				changed = true
+			}
+		}
+		if !changed {
+			return n, nil
+		}
+		return plan.NewFilter(expression.JoinAnd(conditions...), filter.Child), nil
+	})
+}
+
+// findFullTextIndex returns the FULLTEXT index defined on exactly the columns given, in any order.
+func findFullTextIndex(ctx *sql.Context, tableAliases TableAliases, columns []sql.Expression) (sql.FullTextIndex, error) {
+	var tableName string
+	names := make(map[string]bool)
+	for _, c := range columns {
+		gf, ok := c.(*expression.GetField)
+		if !ok || (tableName != "" && tableName != strings.ToLower(gf.Table())) {
+			return nil, sql.ErrNoFullTextIndex.New()
+		}
+		tableName = strings.ToLower(gf.Table())
+		names[strings.ToLower(gf.Name())] = true
+	}
+
+	rt, ok := tableAliases[tableName].(*plan.ResolvedTable)
+	if !ok {
+		return nil, sql.ErrNoFullTextIndex.New()
+	}
+	table, ok := rt.Table.(sql.IndexedTable)
+	if !ok {
+		return nil, sql.ErrNoFullTextIndex.New()
+	}
+	indexes, err := table.GetIndexes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, idx := range indexes {
+		ft, ok := idx.(sql.FullTextIndex)
+		if !ok || len(ft.Expressions()) != len(names) {
+			continue
+		}
+		matches := true
+		for _, expr := range ft.Expressions() {
+			column := strings.ToLower(expr[strings.LastIndex(expr, ".")+1:])
+			matches = matches && names[column]
+		}
+		if matches {
+			return ft, nil
+		}
+	}
+	return nil, sql.ErrNoFullTextIndex.New()
+}
+
+// newFullTextParser returns the parser of the session's FULLTEXT searches. Its stopwords are the ones of the table
+// named by innodb_ft_user_stopword_table or innodb_ft_server_stopword_table as db_name/table_name, if either is set and
+// stopwords are enabled.
+func newFullTextParser(ctx *sql.Context, a *Analyzer) (*sql.FullTextParser, error) {
+	parser, err := sql.NewFullTextParser(ctx)
+	if err != nil || parser.Stopwords == nil {
+		return parser, err
+	}
+
+	name, err := ctx.GetSessionVariable(ctx, "innodb_ft_user_stopword_table")
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		_, name, _ = sql.SystemVariables.GetGlobal("innodb_ft_server_stopword_table")
+	}
+	if s, ok := name.(string); !ok || s == "" {
+		return parser, nil
+	}
+
+	words, err := readStopwordTable(ctx, a, name.(string))
+	if err != nil {
+		return nil, err
+	}
+	parser.SetStopwords(words)
+	return parser, nil
+}
+
+// readStopwordTable returns the words of a stopword table, which must have a single text column named value.
+func readStopwordTable(ctx *sql.Context, a *Analyzer, name string) ([]string, error) {
+	parts := strings.SplitN(name, "/", 2)
+	if len(parts) != 2 {
+		return nil, sql.ErrInvalidStopwordTable.New(name)
+	}
+	db, err := a.Catalog.Database(parts[0])
+	if err != nil {
+		return nil, sql.ErrInvalidStopwordTable.New(name)
+	}
+	table, ok, err := db.GetTableInsensitive(ctx, parts[1])
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, sql.ErrInvalidStopwordTable.New(name)
+	}
+	schema := table.Schema()
+	if len(schema) != 1 || !strings.EqualFold(schema[0].Name, "value") || !sql.IsTextOnly(schema[0].Type) {
+		return nil, sql.ErrInvalidStopwordTable.New(name)
+	}
+
+	partitions, err := table.Partitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := sql.RowIterToRows(ctx, sql.NewTableRowIter(ctx, table, partitions))
+	if err != nil {
+		return nil, err
+	}
+
+	words := make([]string, 0, len(rows))
+	for _, row := range rows {
+		if s, ok := row[0].(string); ok {
+			words = append(words, s)
+		}
+	}
+	return words, nil
+}
@@ -106,7 +106,14 @@ func resolveSubqueryExpressions(ctx *sql.Context, a *Analyzer, n sql.Node, scope
 		defer cancelFunc()
 		subScope := scope.newScope(n)
 
-		analyzed, err := a.Analyze(subqueryCtx, s.Query, subScope)
+		// Prepared queries finish the analysis of their subqueries once their bindings are applied
+		var analyzed sql.Node
+		var err error
+		if isPreparing(ctx) {
+			analyzed, err = a.analyzeThroughBatch(subqueryCtx, s.Query, subScope, "default-rules")
+		} else {
+			analyzed, err = a.Analyze(subqueryCtx, s.Query, subScope)
+		}
 		if err != nil {
 			// We ignore certain errors, deferring them to later analysis passes. Specifically, if the subquery isn't
 			// resolved or a column can't be found in the scope node, wait until a later pass.
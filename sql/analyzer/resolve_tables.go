@@ -74,6 +74,12 @@ func resolveTables(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql
 				return handleTableLookupFailure(err, name, db, a, t)
 			}
 
+			if len(t.Partitions) > 0 {
+				if rt, err = selectPartitions(ctx, rt, t.Partitions); err != nil {
+					return nil, err
+				}
+			}
+
 			a.Log("table resolved: %q as of %s", rt.Name(), asOf)
 			return plan.NewResolvedTable(rt, database, asOf), nil
 		}
@@ -83,6 +89,12 @@ func resolveTables(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql
 			return handleTableLookupFailure(err, name, db, a, t)
 		}
 
+		if len(t.Partitions) > 0 {
+			if rt, err = selectPartitions(ctx, rt, t.Partitions); err != nil {
+				return nil, err
+			}
+		}
+
 		a.Log("table resolved: %s", t.Name())
 		return plan.NewResolvedTable(rt, database, nil), nil
 	})
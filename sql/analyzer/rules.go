@@ -80,7 +80,9 @@ var OnceAfterDefault = []Rule{
 	{"resolve_generators", resolveGenerators},
 	{"remove_unnecessary_converts", removeUnnecessaryConverts},
 	{"assign_catalog", assignCatalog},
+	{"resolve_fulltext_matches", resolveFullTextMatches},
 	{"prune_columns", pruneColumns},
+	{"prune_partitions", prunePartitions},
 	{"optimize_joins", constructJoinPlan},
 	{"pushdown_filters", pushdownFilters},
 	{"subquery_indexes", applyIndexesFromOuterScope},
@@ -0,0 +1,38 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/linanh/go-mysql-server/sql"
+	"github.com/linanh/go-mysql-server/sql/parse"
+	"github.com/linanh/go-mysql-server/sql/plan"
+)
+
+// statementBuilder builds the statements of PREPARE and EXECUTE with the parser and the analyzer.
+type statementBuilder struct {
+	a *Analyzer
+}
+
+var _ plan.StatementBuilder = statementBuilder{}
+
+// Parse implements the plan.StatementBuilder interface.
+func (b statementBuilder) Parse(ctx *sql.Context, query string) (sql.Node, error) {
+	return parse.Parse(ctx, query)
+}
+
+// Analyze implements the plan.StatementBuilder interface.
+func (b statementBuilder) Analyze(ctx *sql.Context, n sql.Node) (sql.Node, error) {
+	return b.a.Analyze(ctx, n, nil)
+}
@@ -0,0 +1,74 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"time"
+
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+// ErrNoBinaryLogging is returned by statements reading the binary log when the engine has none.
+var ErrNoBinaryLogging = errors.NewKind("You are not using binary logging")
+
+// ErrUnknownBinlogFile is returned when SHOW BINLOG EVENTS names a file which isn't one of the binary log.
+var ErrUnknownBinlogFile = errors.NewKind("Error when executing command SHOW BINLOG EVENTS: Could not find target log")
+
+// ErrBinlogTargetNotFound is returned when PURGE BINARY LOGS TO names a file which isn't one of the binary log.
+var ErrBinlogTargetNotFound = errors.NewKind("Target log not found in binlog index")
+
+// Binlog is a binary log, which the engine logs the changes committed through it to when it's set on the Catalog.
+type Binlog interface {
+	// LogTransaction logs the row changes of a committed transaction.
+	LogTransaction(ctx *Context, changes []RowChange) error
+	// LogQuery logs a statement which isn't logged as row changes, such as one changing the schema, executed with the
+	// database given as the current one.
+	LogQuery(ctx *Context, database, query string) error
+	// Files returns the files of the binary log, oldest first. The last one is the file currently written to.
+	Files() []BinlogFile
+	// Events returns the events of the file with the name given, starting with the one at the position given.
+	Events(file string, pos uint64) ([]BinlogEvent, error)
+	// PurgeTo removes the files of the binary log preceding the one with the name given, as PURGE BINARY LOGS TO does.
+	// Files being read by replicas are kept, along with the ones following them.
+	PurgeTo(file string) error
+	// PurgeBefore removes the files of the binary log last written to before the time given, as PURGE BINARY LOGS
+	// BEFORE does. The file currently written to is always kept, and so are files being read by replicas and the ones
+	// following them.
+	PurgeBefore(t time.Time) error
+}
+
+// BinlogFile is a file of a binary log.
+type BinlogFile struct {
+	// Name is the name of the file.
+	Name string
+	// Size is the size of the file in bytes, which is the position the next event is written at.
+	Size uint64
+}
+
+// BinlogEvent describes an event of a binary log, as shown by SHOW BINLOG EVENTS.
+type BinlogEvent struct {
+	// LogName is the name of the file holding the event.
+	LogName string
+	// Pos is the position of the event in the file.
+	Pos uint64
+	// EventType is the name of the type of the event, such as Write_rows.
+	EventType string
+	// ServerID is the id of the server the event was logged by.
+	ServerID uint32
+	// EndLogPos is the position of the event following this one in the file.
+	EndLogPos uint64
+	// Info describes the content of the event.
+	Info string
+}
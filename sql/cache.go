@@ -36,6 +36,33 @@ func HashOf(v Row) (uint64, error) {
 	return hash.Sum64(), nil
 }
 
+// HashOfCollated returns a hash of the given row of the schema given to be used as key in a cache, which is the same
+// for the rows whose strings are equal in the collations of their columns.
+func HashOfCollated(sch Schema, v Row) (uint64, error) {
+	if len(sch) != len(v) {
+		return HashOf(v)
+	}
+	keys := make(Row, len(v))
+	for i, x := range v {
+		keys[i] = CollationKey(sch[i].Type, x)
+	}
+	return HashOf(keys)
+}
+
+// CollationKey returns the key of a value of the given type, which is the same for the values which are equal: the
+// weight string of the strings in the collation of their type, and the value itself otherwise.
+func CollationKey(typ Type, v interface{}) interface{} {
+	st, ok := typ.(StringType)
+	if !ok {
+		return v
+	}
+	s, ok := v.(string)
+	if !ok {
+		return v
+	}
+	return string(st.Collation().WeightString(s))
+}
+
 // ErrKeyNotFound is returned when the key could not be found in the cache.
 var ErrKeyNotFound = errors.NewKind("memory: key %d not found in cache")
 
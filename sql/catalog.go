@@ -43,6 +43,24 @@ type Catalog struct {
 	*ProcessList
 	*MemoryManager
 
+	// PrivilegeStore holds the accounts and privileges managed with CREATE USER, GRANT and related statements. It's
+	// nil unless the engine was configured with an auth.Auth backed by a privilege store.
+	PrivilegeStore PrivilegeStore
+
+	// StatementEvents records the statements executed by the engine, for the performance schema.
+	StatementEvents *StatementEvents
+
+	// RowChangeListeners are notified of the row changes committed through the engine.
+	RowChangeListeners *RowChangeListeners
+
+	// Binlog is the binary log the changes committed through the engine are logged to. It's nil unless the engine was
+	// configured with one.
+	Binlog Binlog
+
+	// Replica is the replica controlled by the replication statements, such as START REPLICA. It's nil unless the
+	// engine can act as one.
+	Replica Replica
+
 	mu       sync.RWMutex
 	provider MutableDatabaseProvider
 	locks    sessionLocks
@@ -62,11 +80,13 @@ func NewCatalog() *Catalog {
 // NewCatalogWithDbProvider returns a new empty Catalog.
 func NewCatalogWithDbProvider(provider MutableDatabaseProvider) *Catalog {
 	return &Catalog{
-		FunctionRegistry: NewFunctionRegistry(),
-		MemoryManager:    NewMemoryManager(ProcessMemory),
-		ProcessList:      NewProcessList(),
-		provider:         provider,
-		locks:            make(sessionLocks),
+		FunctionRegistry:   NewFunctionRegistry(),
+		MemoryManager:      NewMemoryManager(ProcessMemory),
+		ProcessList:        NewProcessList(),
+		StatementEvents:    NewStatementEvents(),
+		RowChangeListeners: NewRowChangeListeners(),
+		provider:           provider,
+		locks:              make(sessionLocks),
 	}
 }
 
@@ -0,0 +1,265 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/linanh/go-mysql-server/sql/encodings"
+)
+
+// ErrCharacterSetConversionNotSupported is returned when strings can't be converted to or from a character set.
+var ErrCharacterSetConversionNotSupported = errors.NewKind("conversion of strings to and from character set %v is not supported")
+
+// ErrIncorrectStringValue is returned when a string holds a character which the character set of the column it's
+// stored in can't represent.
+var ErrIncorrectStringValue = errors.NewKind("Incorrect string value: '%v'")
+
+// Encoding returns the encoding of the strings of the CharacterSet, which converts them to and from the UTF-8 strings
+// they're held in.
+func (cs CharacterSet) Encoding() (encodings.Encoding, error) {
+	if e, ok := encodings.Lookup(string(cs)); ok {
+		return e, nil
+	}
+	return nil, ErrCharacterSetConversionNotSupported.New(cs)
+}
+
+// IsUnicode returns whether the CharacterSet represents every Unicode character, so strings converted to it are kept
+// as they are.
+func (cs CharacterSet) IsUnicode() bool {
+	switch cs {
+	case CharacterSet_utf8mb4, CharacterSet_utf16, CharacterSet_utf16le, CharacterSet_utf32, CharacterSet_binary:
+		return true
+	default:
+		return false
+	}
+}
+
+// checkString returns ErrIncorrectStringValue if the CharacterSet can't represent every character of a string. The
+// strings of the character sets which aren't supported aren't checked.
+func (cs CharacterSet) checkString(s string) error {
+	if cs.IsUnicode() {
+		return nil
+	}
+	e, err := cs.Encoding()
+	if err != nil {
+		return nil
+	}
+	if _, ok := e.Encode(s); ok {
+		return nil
+	}
+
+	for _, r := range s {
+		if _, ok := e.Encode(string(r)); !ok {
+			var b strings.Builder
+			for _, c := range []byte(string(r)) {
+				fmt.Fprintf(&b, "\\x%02X", c)
+			}
+			return ErrIncorrectStringValue.New(b.String())
+		}
+	}
+	return nil
+}
+
+// ConvertString returns a string of one character set converted to another, which holds the characters of the
+// string which the character set converted to can represent, and Replacement for the other ones. The strings of the
+// binary character set are bytes, which are decoded when converting them to other character sets, and the strings
+// converted to it are encoded in the character set they're converted from.
+func ConvertString(s string, from, to CharacterSet) (string, error) {
+	if from == to {
+		return s, nil
+	}
+
+	if to == CharacterSet_binary {
+		e, err := from.Encoding()
+		if err != nil {
+			return "", err
+		}
+		encoded, _ := e.Encode(s)
+		return string(encoded), nil
+	}
+
+	e, err := to.Encoding()
+	if err != nil {
+		return "", err
+	}
+	if from == CharacterSet_binary {
+		decoded, _ := e.Decode([]byte(s))
+		return decoded, nil
+	}
+	if to.IsUnicode() {
+		return s, nil
+	}
+	encoded, _ := e.Encode(s)
+	decoded, _ := e.Decode(encoded)
+	return decoded, nil
+}
+
+// CollationFromID returns the collation with the ID given, as used by the MySQL protocol.
+func CollationFromID(id int64) (Collation, bool) {
+	name, ok := collationNamesByID[id]
+	if !ok {
+		for n, row := range CollationToMySQLVals {
+			if row.ID == id {
+				name, ok = n, true
+				break
+			}
+		}
+	}
+	if !ok {
+		return Collation{}, false
+	}
+	c, ok := Collations[name]
+	return c, ok
+}
+
+// collationIDs are the IDs of the collations of collationNamesByID, by the names of the collations.
+var collationIDs = map[string]int64{}
+
+func init() {
+	for id, name := range collationNamesByID {
+		if c, ok := Collations[name]; ok {
+			collationIDs[c.Name] = id
+		}
+	}
+}
+
+// collationNamesByID are the names of the collations of the IDs the MySQL protocol names them with, for the
+// collations which aren't in CollationToMySQLVals.
+var collationNamesByID = map[int64]string{
+	1:   "big5_chinese_ci",
+	2:   "latin2_czech_cs",
+	3:   "dec8_swedish_ci",
+	4:   "cp850_general_ci",
+	5:   "latin1_german1_ci",
+	6:   "hp8_english_ci",
+	7:   "koi8r_general_ci",
+	8:   "latin1_swedish_ci",
+	9:   "latin2_general_ci",
+	10:  "swe7_swedish_ci",
+	11:  "ascii_general_ci",
+	12:  "ujis_japanese_ci",
+	13:  "sjis_japanese_ci",
+	14:  "cp1251_bulgarian_ci",
+	15:  "latin1_danish_ci",
+	16:  "hebrew_general_ci",
+	18:  "tis620_thai_ci",
+	19:  "euckr_korean_ci",
+	20:  "latin7_estonian_cs",
+	21:  "latin2_hungarian_ci",
+	22:  "koi8u_general_ci",
+	23:  "cp1251_ukrainian_ci",
+	24:  "gb2312_chinese_ci",
+	25:  "greek_general_ci",
+	26:  "cp1250_general_ci",
+	27:  "latin2_croatian_ci",
+	28:  "gbk_chinese_ci",
+	29:  "cp1257_lithuanian_ci",
+	30:  "latin5_turkish_ci",
+	31:  "latin1_german2_ci",
+	32:  "armscii8_general_ci",
+	34:  "cp1250_czech_cs",
+	35:  "ucs2_general_ci",
+	36:  "cp866_general_ci",
+	37:  "keybcs2_general_ci",
+	38:  "macce_general_ci",
+	39:  "macroman_general_ci",
+	40:  "cp852_general_ci",
+	41:  "latin7_general_ci",
+	42:  "latin7_general_cs",
+	43:  "macce_bin",
+	44:  "cp1250_croatian_ci",
+	45:  "utf8mb4_general_ci",
+	46:  "utf8mb4_bin",
+	47:  "latin1_bin",
+	48:  "latin1_general_ci",
+	49:  "latin1_general_cs",
+	50:  "cp1251_bin",
+	51:  "cp1251_general_ci",
+	52:  "cp1251_general_cs",
+	53:  "macroman_bin",
+	54:  "utf16_general_ci",
+	55:  "utf16_bin",
+	56:  "utf16le_general_ci",
+	57:  "cp1256_general_ci",
+	58:  "cp1257_bin",
+	59:  "cp1257_general_ci",
+	60:  "utf32_general_ci",
+	61:  "utf32_bin",
+	62:  "utf16le_bin",
+	64:  "armscii8_bin",
+	65:  "ascii_bin",
+	66:  "cp1250_bin",
+	67:  "cp1256_bin",
+	68:  "cp866_bin",
+	69:  "dec8_bin",
+	70:  "greek_bin",
+	71:  "hebrew_bin",
+	72:  "hp8_bin",
+	73:  "keybcs2_bin",
+	74:  "koi8r_bin",
+	75:  "koi8u_bin",
+	76:  "utf8_tolower_ci",
+	77:  "latin2_bin",
+	78:  "latin5_bin",
+	79:  "latin7_bin",
+	80:  "cp850_bin",
+	81:  "cp852_bin",
+	82:  "swe7_bin",
+	83:  "utf8_bin",
+	84:  "big5_bin",
+	85:  "euckr_bin",
+	86:  "gb2312_bin",
+	87:  "gbk_bin",
+	88:  "sjis_bin",
+	89:  "tis620_bin",
+	90:  "ucs2_bin",
+	91:  "ujis_bin",
+	92:  "geostd8_general_ci",
+	93:  "geostd8_bin",
+	94:  "latin1_spanish_ci",
+	95:  "cp932_japanese_ci",
+	96:  "cp932_bin",
+	97:  "eucjpms_japanese_ci",
+	98:  "eucjpms_bin",
+	99:  "cp1250_polish_ci",
+	192: "utf8_unicode_ci",
+	224: "utf8mb4_unicode_ci",
+	248: "gb18030_chinese_ci",
+	278: "utf8mb4_0900_as_cs",
+	305: "utf8mb4_0900_as_ci",
+	309: "utf8mb4_0900_bin",
+}
+
+// CharacterSetVariable returns the character set of a system variable of the session, such as character_set_client.
+// It returns false if the variable is NULL or doesn't name a character set.
+func CharacterSetVariable(ctx *Context, name string) (CharacterSet, bool) {
+	val, err := ctx.GetSessionVariable(ctx, name)
+	if err != nil {
+		return "", false
+	}
+	s, ok := val.(string)
+	if !ok || s == "" {
+		return "", false
+	}
+	cs, err := ParseCharacterSet(strings.ToLower(s))
+	if err != nil {
+		return "", false
+	}
+	return cs, true
+}
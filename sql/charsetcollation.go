@@ -26,54 +26,55 @@ import (
 // CharacterSet represents the character set of a string.
 type CharacterSet string
 
-type insensitiveMatcher struct {
+// foldMatcher matches the strings folded with a function, against a LIKE pattern folded the same way.
+type foldMatcher struct {
 	regex.DisposableMatcher
+	fold func(r rune) rune
 }
 
-func (im *insensitiveMatcher) Match(matchStr string) bool {
-	lower := strings.ToLower(matchStr)
-	return im.DisposableMatcher.Match(lower)
+func (fm *foldMatcher) Match(matchStr string) bool {
+	return fm.DisposableMatcher.Match(strings.Map(fm.fold, matchStr))
 }
 
-func insensitiveLikeMatcher(likeStr string) (regex.DisposableMatcher, error) {
-	lower := strings.ToLower(likeStr)
-	dm, err := regex.NewDisposableMatcher("go", lower)
+// foldLikeMatcher returns the LIKE matcher function of a collation whose equal characters are the same once folded.
+func foldLikeMatcher(fold func(r rune) rune) func(likeStr string) (regex.DisposableMatcher, error) {
+	return func(likeStr string) (regex.DisposableMatcher, error) {
+		dm, err := regex.NewDisposableMatcher("go", strings.Map(fold, likeStr))
+		if err != nil {
+			return nil, err
+		}
 
-	if err != nil {
-		return nil, err
+		return &foldMatcher{dm, fold}, nil
 	}
-
-	return &insensitiveMatcher{dm}, nil
 }
 
 func sensitiveLikeMatcher(likeStr string) (regex.DisposableMatcher, error) {
 	return regex.NewDisposableMatcher("go", likeStr)
 }
 
-func insensitiveCompare(a, b string) int {
-	lowerA := strings.ToLower(a)
-	lowerB := strings.ToLower(b)
-	return strings.Compare(lowerA, lowerB)
-}
-
 // Collation represents the collation of a string.
 type Collation struct {
 	Name        string
 	CharSet     CharacterSet
 	Compare     func(as, bs string) int
 	LikeMatcher func(likeStr string) (regex.DisposableMatcher, error)
+	weights     weightStringFunc
 }
 
 var Collations = map[string]Collation{}
 
 func newCollation(name string, cs CharacterSet) Collation {
-	c := Collation{Name: name, CharSet: cs, Compare: insensitiveCompare, LikeMatcher: insensitiveLikeMatcher}
+	weights := collationWeights(name)
+	c := Collation{Name: name, CharSet: cs, Compare: compareWeights(weights), LikeMatcher: sensitiveLikeMatcher, weights: weights}
+	if fold := collationLikeFold(name); fold != nil {
+		c.LikeMatcher = foldLikeMatcher(fold)
+	}
 	Collations[name] = c
 	return c
 }
 
 func newCSCollation(name string, cs CharacterSet) Collation {
-	c := Collation{Name: name, CharSet: cs, Compare: strings.Compare, LikeMatcher: sensitiveLikeMatcher}
+	c := Collation{Name: name, CharSet: cs, Compare: strings.Compare, LikeMatcher: sensitiveLikeMatcher, weights: binaryWeights(false)}
 	Collations[name] = c
 	return c
 }
@@ -825,6 +826,9 @@ func (c Collation) String() string {
 func (c Collation) ID() int64 {
 	s, ok := CollationToMySQLVals[c.Name]
 	if !ok {
+		if id, ok := collationIDs[c.Name]; ok {
+			return id
+		}
 		s := CollationToMySQLVals[Collation_Default.Name]
 		return s.ID
 	}
@@ -871,3 +875,12 @@ func (c Collation) PadSpace() string {
 func (c Collation) Equals(other Collation) bool {
 	return c.Name == other.Name
 }
+
+// WeightString returns the weight string of a string in the Collation, as WEIGHT_STRING does. The weight strings of two
+// strings compare byte by byte as the strings compare in the Collation.
+func (c Collation) WeightString(s string) []byte {
+	if c.weights == nil {
+		return []byte(s)
+	}
+	return c.weights(s)
+}
@@ -0,0 +1,395 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"unicode"
+)
+
+// weightStringFunc returns the weight string of a string in a collation. Weight strings compare byte by byte in the
+// order of their strings in the collation, and are equal for the strings which the collation considers equal.
+type weightStringFunc func(s string) []byte
+
+// collationWeights returns the weight string function of the collation with the name given.
+//
+// The UCA 9.0.0 collations (utf8mb4_0900_*), and the UCA 4.0.0 ones of the Unicode character sets (*_unicode_ci and
+// the language specific ones, whose tailorings are not implemented), compare the letters of every script, ignoring
+// accents at the primary level and case up to the secondary one. The other _ci collations compare characters one by
+// one once accents and case are removed, as the general_ci collations of MySQL do, and the _cs ones compare them by
+// their upper case letter first and their case after. Only the collations of the UCA 9.0.0 and the binary collation
+// don't ignore trailing spaces, which MySQL calls NO PAD.
+func collationWeights(name string) weightStringFunc {
+	padSpace := !strings.Contains(name, "_0900_") && name != "binary"
+	switch {
+	case strings.HasSuffix(name, "_bin") || name == "binary":
+		return binaryWeights(padSpace)
+	case strings.HasSuffix(name, "_0900_as_cs"):
+		return ucaWeights(3, padSpace)
+	case strings.HasSuffix(name, "_0900_as_ci"):
+		return ucaWeights(2, padSpace)
+	case strings.Contains(name, "_0900_"):
+		return ucaWeights(1, padSpace)
+	}
+
+	if fold, ok := latin1Folds[name]; ok {
+		return legacyWeights(fold, padSpace)
+	}
+	if strings.HasSuffix(name, "_cs") {
+		return legacyWeights(caseSensitiveFold, padSpace)
+	}
+	if isUnicodeCharacterSet(name) && !strings.Contains(name, "_general_") && name != "utf8mb3_tolower_ci" {
+		return ucaWeights(1, padSpace)
+	}
+	return legacyWeights(generalFold, padSpace)
+}
+
+// collationLikeFold returns the function which folds the characters of the strings matched by LIKE in the collation
+// with the name given, so the characters which are equal in the collation are the same once folded, or nil if LIKE
+// compares the characters as they are.
+func collationLikeFold(name string) func(r rune) rune {
+	switch {
+	case strings.HasSuffix(name, "_bin") || strings.HasSuffix(name, "_cs") || name == "binary":
+		return nil
+	case strings.HasSuffix(name, "_as_ci") || name == "latin1_general_ci":
+		return unicode.ToLower
+	default:
+		return accentInsensitiveFold
+	}
+}
+
+func isUnicodeCharacterSet(name string) bool {
+	for _, prefix := range []string{"utf8_", "utf8mb3_", "utf8mb4_", "ucs2_", "utf16_", "utf16le_", "utf32_"} {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// trimPadding removes the trailing spaces of a string, which the PAD SPACE collations ignore.
+func trimPadding(s string, padSpace bool) string {
+	if padSpace {
+		return strings.TrimRight(s, " ")
+	}
+	return s
+}
+
+// binaryWeights returns the weight strings of the binary collations, which are the bytes of the strings.
+func binaryWeights(padSpace bool) weightStringFunc {
+	return func(s string) []byte {
+		return []byte(trimPadding(s, padSpace))
+	}
+}
+
+// legacyWeights returns the weight strings of the collations which weigh the characters of a string one by one, with
+// the weights of each character returned by the fold function given.
+func legacyWeights(fold func(r rune) []uint32, padSpace bool) weightStringFunc {
+	return func(s string) []byte {
+		s = trimPadding(s, padSpace)
+		buf := make([]byte, 0, len(s)*4)
+		for _, r := range s {
+			for _, w := range fold(r) {
+				buf = appendWeight(buf, w)
+			}
+		}
+		return buf
+	}
+}
+
+// generalFold weighs a character by its upper case letter without accents, and weighs the characters out of the Basic
+// Multilingual Plane the same, as utf8mb4_general_ci does.
+func generalFold(r rune) []uint32 {
+	if r > 0xFFFF {
+		return []uint32{0xFFFD << 8}
+	}
+	if r == 'ß' {
+		return []uint32{'S' << 8}
+	}
+	if base, _ := decompose(r); len(base) == 1 {
+		r = base[0]
+	}
+	return []uint32{uint32(unicode.ToUpper(r)) << 8}
+}
+
+// caseSensitiveFold weighs a character by its upper case letter and then its case, so upper case letters sort before
+// their lower case ones.
+func caseSensitiveFold(r rune) []uint32 {
+	upper := unicode.ToUpper(r)
+	if upper != r {
+		return []uint32{uint32(upper)<<8 | 1}
+	}
+	return []uint32{uint32(upper) << 8}
+}
+
+// latin1Folds are the fold functions of the latin1 collations whose rules differ from the general ones.
+var latin1Folds = map[string]func(r rune) []uint32{
+	"latin1_general_ci": func(r rune) []uint32 {
+		// Accents are significant
+		return []uint32{uint32(unicode.ToUpper(r)) << 8}
+	},
+	"latin1_swedish_ci": func(r rune) []uint32 {
+		// Å, Ä and Ö are letters of their own following Z, Æ and Ø are the Danish and Norwegian forms of Ä and Ö,
+		// and Ü is a form of Y
+		switch unicode.ToUpper(r) {
+		case 'Å':
+			return []uint32{'Z'<<8 | 1}
+		case 'Ä', 'Æ':
+			return []uint32{'Z'<<8 | 2}
+		case 'Ö', 'Ø':
+			return []uint32{'Z'<<8 | 3}
+		case 'Ü':
+			return []uint32{'Y' << 8}
+		}
+		return generalFold(r)
+	},
+	"latin1_german2_ci": func(r rune) []uint32 {
+		// Umlauts expand to their letter followed by E, as in phone books
+		switch unicode.ToUpper(r) {
+		case 'Ä', 'Æ':
+			return []uint32{'A' << 8, 'E' << 8}
+		case 'Ö':
+			return []uint32{'O' << 8, 'E' << 8}
+		case 'Ü':
+			return []uint32{'U' << 8, 'E' << 8}
+		}
+		if r == 'ß' {
+			return []uint32{'S' << 8, 'S' << 8}
+		}
+		return generalFold(r)
+	},
+}
+
+// accentInsensitiveFold returns the lower case letter without accents of a character.
+func accentInsensitiveFold(r rune) rune {
+	if base, _ := decompose(r); len(base) == 1 {
+		r = base[0]
+	}
+	return unicode.ToLower(r)
+}
+
+// The groups of primary weights of the UCA collations, in the order they sort in.
+const (
+	ucaGroupVariable = iota + 1
+	ucaGroupDigit
+	ucaGroupLatin
+	ucaGroupLetter
+	ucaGroupHan
+)
+
+// The tertiary weights of the UCA collations.
+const (
+	ucaLower = iota + 1
+	ucaUpper
+	// ucaExpansionLower and ucaExpansionUpper weigh the letters which expand to several ones, such as æ, so they
+	// differ from the letters they expand to
+	ucaExpansionLower
+	ucaExpansionUpper
+)
+
+// collationElement is the weights of a character, or of a part of it, in the UCA collations. The characters whose
+// primary weight is zero, such as combining accents, are ignored at the primary level.
+type collationElement struct {
+	primary   uint32
+	secondary uint32
+	tertiary  uint32
+}
+
+// ucaWeights returns the weight strings of the UCA collations which compare the given number of levels: the letters,
+// their accents and their case.
+func ucaWeights(levels int, padSpace bool) weightStringFunc {
+	return func(s string) []byte {
+		s = trimPadding(s, padSpace)
+		elements := make([]collationElement, 0, len(s))
+		for _, r := range s {
+			elements = appendCollationElements(elements, r)
+		}
+
+		buf := make([]byte, 0, len(elements)*4*levels)
+		for _, e := range elements {
+			if e.primary != 0 {
+				buf = appendWeight(buf, e.primary)
+			}
+		}
+		if levels >= 2 {
+			buf = appendWeight(buf, 0)
+			for _, e := range elements {
+				buf = appendWeight(buf, e.secondary)
+			}
+		}
+		if levels >= 3 {
+			buf = appendWeight(buf, 0)
+			for _, e := range elements {
+				buf = appendWeight(buf, e.tertiary)
+			}
+		}
+		return buf
+	}
+}
+
+// appendCollationElements appends the collation elements of a character to the ones given.
+func appendCollationElements(elements []collationElement, r rune) []collationElement {
+	switch {
+	case unicode.Is(unicode.Mn, r) || unicode.Is(unicode.Me, r):
+		return append(elements, collationElement{secondary: uint32(r), tertiary: ucaLower})
+	case unicode.IsControl(r) || unicode.Is(unicode.Cf, r):
+		// Control and format characters are ignored at every level
+		return elements
+	}
+
+	base, marks := decompose(r)
+	for _, b := range base {
+		lower := unicode.ToLower(b)
+		if lower == 'ς' {
+			lower = 'σ'
+		}
+		tertiary := uint32(ucaLower)
+		if lower != b && b != 'ς' {
+			tertiary = ucaUpper
+		}
+		if len(base) > 1 {
+			tertiary += ucaExpansionLower - ucaLower
+		}
+		elements = append(elements, collationElement{primary: ucaPrimary(lower), secondary: 1, tertiary: tertiary})
+	}
+	for _, m := range marks {
+		elements = append(elements, collationElement{secondary: uint32(m), tertiary: ucaLower})
+	}
+	return elements
+}
+
+// ucaPrimary returns the primary weight of a lower case character without accents. Spaces, punctuation and symbols
+// sort first, followed by digits, which are weighed by their value whatever their script, Latin letters, the letters
+// of the other scripts in the order of their code points, and Han characters last.
+func ucaPrimary(r rune) uint32 {
+	switch {
+	case r >= 'a' && r <= 'z':
+		return ucaGroupLatin<<24 | uint32(r-'a'+1)<<4
+	case latinLetters[r] != 0:
+		return ucaGroupLatin<<24 | latinLetters[r]
+	case unicode.IsDigit(r):
+		return ucaGroupDigit<<24 | digitValue(r)
+	case unicode.IsNumber(r):
+		return ucaGroupDigit<<24 | 0x10 + uint32(r)
+	case unicode.Is(unicode.Han, r):
+		return ucaGroupHan<<24 | uint32(r)
+	case unicode.IsLetter(r):
+		return ucaGroupLetter<<24 | uint32(r)
+	default:
+		return ucaGroupVariable<<24 | uint32(r)
+	}
+}
+
+// latinLetters are the primary weights of the Latin letters which are letters of their own, sorting after the letter
+// of the alphabet they follow.
+var latinLetters = map[rune]uint32{
+	'ð': ('d'-'a'+1)<<4 | 8,
+	'ı': ('i'-'a'+1)<<4 | 8,
+	'ĸ': ('q'-'a'+1)<<4 | 8,
+	'ŋ': ('n'-'a'+1)<<4 | 8,
+	'ſ': ('s' - 'a' + 1) << 4,
+	'þ': ('z'-'a'+1)<<4 | 8,
+}
+
+// digitValue returns the value of a decimal digit of any script, whose digits follow each other from zero to nine.
+func digitValue(r rune) uint32 {
+	zero := r
+	for zero > 0 && r-zero < 9 && unicode.IsDigit(zero-1) {
+		zero--
+	}
+	return uint32(r-zero) % 10
+}
+
+func appendWeight(buf []byte, w uint32) []byte {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], w)
+	return append(buf, b[:]...)
+}
+
+// decomposition is the letters and accents of a character.
+type decomposition struct {
+	base  []rune
+	marks []rune
+}
+
+// decompositions are the characters which are letters with accents, or which expand to several letters.
+var decompositions = make(map[rune]decomposition)
+
+// decompose returns the letters and accents of a character, which are the character alone if it has no accents.
+func decompose(r rune) ([]rune, []rune) {
+	if d, ok := decompositions[r]; ok {
+		return d.base, d.marks
+	}
+	return []rune{r}, nil
+}
+
+// accentedLetters are the letters with accents, as pairs of the accented letter and its letter, for each accent.
+var accentedLetters = map[rune]string{
+	0x0300: "ÀAÈEÌIÒOÙUàaèeìiòoùuǸNǹnẀWẁwỲYỳy",                                                     // grave
+	0x0301: "ÁAÉEÍIÓOÚUÝYáaéeíióoúuýyĆCćcĹLĺlŃNńnŔRŕrŚSśsŹZźzǴGǵgẂWẃwΆΑάαΈΕέεΉΗήηΊΙίιΌΟόοΎΥύυΏΩώω", // acute and tonos
+	0x0302: "ÂAÊEÎIÔOÛUâaêeîiôoûuĈCĉcĜGĝgĤHĥhĴJĵjŜSŝsŴWŵwŶYŷyẐZẑz",                                 // circumflex
+	0x0303: "ÃAÑNÕOãañnõoĨIĩiŨUũuẼEẽeỸYỹy",                                                         // tilde
+	0x0304: "ĀAāaĒEēeĪIīiŌOōoŪUūuȲYȳy",                                                             // macron
+	0x0306: "ĂAăaĔEĕeĞGğgĬIĭiŎOŏoŬUŭu",                                                             // breve
+	0x0307: "ĊCċcĖEėeĠGġgİIŻZżzḂBḃbḊDḋdḞFḟfṀMṁmṖPṗpṠSṡsṪTṫt",                                       // dot above
+	0x0308: "ÄAËEÏIÖOÜUäaëeïiöoüuÿyŸYẄWẅwẌXẍxΪΙΫΥϊιϋυЁЕёеЇІїі",                                     // diaeresis
+	0x030A: "ÅAåaŮUůu",                                                                             // ring above
+	0x030B: "ŐOőoŰUűu",                                                                             // double acute
+	0x030C: "ČCčcĎDďdĚEěeŇNňnŘRřrŠSšsŤTťtŽZžzǍAǎaǏIǐiǑOǒoǓUǔuǦGǧgǨKǩk",                             // caron
+	0x0326: "ȘSșsȚTțt",                                                                             // comma below
+	0x0327: "ÇCçcĢGģgĶKķkĻLļlŅNņnŖRŗrŞSşsŢTţtȨEȩe",                                                 // cedilla
+	0x0328: "ĄAąaĘEęeĮIįiŲUųuǪOǫo",                                                                 // ogonek
+	0x0335: "ĐDđdĦHħhŁLłlŦTŧtƵZƶzƗIɨi",                                                             // stroke
+	0x0338: "ØOøo",                                                                                 // long solidus
+}
+
+// expansions are the characters which sort as several letters.
+var expansions = map[rune]string{
+	'ß': "ss",
+	'ẞ': "SS",
+	'Æ': "AE",
+	'æ': "ae",
+	'Œ': "OE",
+	'œ': "oe",
+	'Ĳ': "IJ",
+	'ĳ': "ij",
+	'ﬀ': "ff",
+	'ﬁ': "fi",
+	'ﬂ': "fl",
+	'ﬃ': "ffi",
+	'ﬄ': "ffl",
+	'ﬆ': "st",
+}
+
+func init() {
+	for mark, pairs := range accentedLetters {
+		letters := []rune(pairs)
+		for i := 0; i+1 < len(letters); i += 2 {
+			decompositions[letters[i]] = decomposition{base: []rune{letters[i+1]}, marks: []rune{mark}}
+		}
+	}
+	for r, letters := range expansions {
+		decompositions[r] = decomposition{base: []rune(letters)}
+	}
+}
+
+// compareWeights returns the comparison function of a weight string function.
+func compareWeights(weights weightStringFunc) func(as, bs string) int {
+	return func(as, bs string) int {
+		return bytes.Compare(weights(as), weights(bs))
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollationCompare(t *testing.T) {
+	tests := []struct {
+		collation Collation
+		a         string
+		b         string
+		expected  int
+	}{
+		// Accents and case are ignored, trailing spaces are not
+		{Collation_utf8mb4_0900_ai_ci, "résumé", "RESUME", 0},
+		{Collation_utf8mb4_0900_ai_ci, "Straße", "strasse", 0},
+		{Collation_utf8mb4_0900_ai_ci, "Ærø", "aero", 0},
+		{Collation_utf8mb4_0900_ai_ci, "a", "a ", -1},
+		{Collation_utf8mb4_0900_ai_ci, "a b", "ab", -1},
+		{Collation_utf8mb4_0900_ai_ci, "9", "a", -1},
+		{Collation_utf8mb4_0900_ai_ci, "z", "α", -1},
+		{Collation_utf8mb4_0900_ai_ci, "Σ", "ς", 0},
+		{Collation_utf8mb4_0900_ai_ci, "ёж", "еж", 0},
+		{Collation_utf8mb4_0900_ai_ci, "þ", "z", 1},
+		{Collation_utf8mb4_0900_ai_ci, "é", "f", -1},
+		{Collation_utf8mb4_0900_ai_ci, "é", "é", 0},
+		// Accents are significant
+		{Collation_utf8mb4_0900_as_ci, "résumé", "RESUME", 1},
+		{Collation_utf8mb4_0900_as_ci, "résumé", "RÉSUMÉ", 0},
+		{Collation_utf8mb4_0900_as_ci, "é", "é", 0},
+		// Case is significant
+		{Collation_utf8mb4_0900_as_cs, "a", "A", -1},
+		{Collation_utf8mb4_0900_as_cs, "A", "b", -1},
+		{Collation_utf8mb4_0900_as_cs, "ae", "æ", -1},
+		{Collation_utf8mb4_0900_bin, "A", "a", -1},
+		{Collation_utf8mb4_0900_bin, "a", "a ", -1},
+		// PAD SPACE collations
+		{Collation_utf8mb4_general_ci, "Résumé  ", "resume", 0},
+		{Collation_utf8mb4_general_ci, "ß", "s", 0},
+		{Collation_utf8mb4_unicode_ci, "ß", "ss", 0},
+		{Collation_utf8mb4_unicode_ci, "a ", "A", 0},
+		{Collation_utf8mb4_bin, "a ", "a", 0},
+		{Collation_utf8mb4_bin, "a", "A", 1},
+		{Collation_latin1_swedish_ci, "Å", "Z", 1},
+		{Collation_latin1_swedish_ci, "Å", "ä", -1},
+		{Collation_latin1_swedish_ci, "ü", "Y", 0},
+		{Collation_latin1_german1_ci, "Ä", "a", 0},
+		{Collation_latin1_german2_ci, "Müller", "mueller", 0},
+		{Collation_latin1_general_ci, "é", "É", 0},
+		{Collation_latin1_general_ci, "é", "e", 1},
+		{Collation_latin1_general_cs, "A", "a", -1},
+		{Collation_latin1_general_cs, "a", "B", -1},
+		{Collation_binary, "a", "a ", -1},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s %q %q", test.collation.Name, test.a, test.b), func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(test.expected, test.collation.Compare(test.a, test.b))
+			require.Equal(-test.expected, test.collation.Compare(test.b, test.a))
+			require.Equal(test.expected, bytes.Compare(test.collation.WeightString(test.a), test.collation.WeightString(test.b)))
+		})
+	}
+}
+
+func TestCollationLikeMatcher(t *testing.T) {
+	tests := []struct {
+		collation Collation
+		pattern   string
+		s         string
+		expected  bool
+	}{
+		{Collation_utf8mb4_0900_ai_ci, "^r.sum.$", "RÉSUMÉ", true},
+		{Collation_utf8mb4_0900_ai_ci, "^é", "E", true},
+		{Collation_utf8mb4_0900_as_ci, "^é", "E", false},
+		{Collation_utf8mb4_0900_as_ci, "^é", "É", true},
+		{Collation_utf8mb4_0900_as_cs, "^é", "É", false},
+		{Collation_utf8mb4_bin, "^a", "A", false},
+		{Collation_latin1_general_ci, "^A", "a", true},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s %q %q", test.collation.Name, test.pattern, test.s), func(t *testing.T) {
+			require := require.New(t)
+			m, err := test.collation.LikeMatcher(test.pattern)
+			require.NoError(err)
+			defer m.Dispose()
+			require.Equal(test.expected, m.Match(test.s))
+		})
+	}
+}
@@ -253,6 +253,14 @@ type StatisticsTable interface {
 	DataLength(ctx *Context) (uint64, error)
 }
 
+// HistogramTable is a StatisticsTable that can also describe the distribution of values in its columns.
+type HistogramTable interface {
+	StatisticsTable
+	// Histogram returns the histogram of the values in the column with the name given, or nil if the table doesn't
+	// keep one for that column.
+	Histogram(ctx *Context, colName string) (*Histogram, error)
+}
+
 // IndexUsing is the desired storage type.
 type IndexUsing byte
 
@@ -557,6 +565,15 @@ type Transaction interface {
 	fmt.Stringer
 }
 
+// CommittableTransaction is a Transaction which commits itself, so that sessions that don't track the state of
+// transactions, such as BaseSession, can commit it when a statement completes with autocommit enabled.
+type CommittableTransaction interface {
+	Transaction
+
+	// Commit commits the transaction
+	Commit(ctx *Context) error
+}
+
 // TransactionDatabase is a Database that can BEGIN, ROLLBACK and COMMIT transactions, as well as create SAVEPOINTS and
 // restore to them.
 type TransactionDatabase interface {
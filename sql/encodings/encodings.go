@@ -0,0 +1,437 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package encodings converts strings between UTF-8, which strings are held in, and the encodings of the MySQL
+// character sets.
+package encodings
+
+import (
+	"encoding/binary"
+	"sync"
+	"unicode/utf8"
+)
+
+// Replacement is the character which the characters which can't be converted are replaced with, as in MySQL.
+const Replacement = '?'
+
+// Encoding converts strings between UTF-8 and the encoding of a character set.
+type Encoding interface {
+	// Decode returns the UTF-8 string of bytes in the encoding. The invalid bytes are replaced with Replacement, and
+	// false is returned if there were any.
+	Decode(b []byte) (string, bool)
+	// Encode returns the bytes in the encoding of a UTF-8 string. The characters which the encoding can't represent
+	// are replaced with Replacement, and false is returned if there were any.
+	Encode(s string) ([]byte, bool)
+}
+
+// Lookup returns the Encoding of the MySQL character set with the name given, or false if it isn't supported.
+func Lookup(charset string) (Encoding, bool) {
+	switch charset {
+	case "binary":
+		return binaryEncoding{}, true
+	case "utf8mb4":
+		return utf8Encoding{maxRune: utf8.MaxRune}, true
+	case "utf8", "utf8mb3":
+		return utf8Encoding{maxRune: 0xFFFF}, true
+	case "ascii":
+		return asciiEncoding{}, true
+	case "ucs2":
+		return utf16Encoding{order: binary.BigEndian, bmpOnly: true}, true
+	case "utf16":
+		return utf16Encoding{order: binary.BigEndian}, true
+	case "utf16le":
+		return utf16Encoding{order: binary.LittleEndian}, true
+	case "utf32":
+		return utf32Encoding{}, true
+	case "sjis", "cp932":
+		return &doubleByteEncoding{table: &cp932Table, single: halfWidthKatakana}, true
+	case "ujis", "eucjpms":
+		return &doubleByteEncoding{table: &eucjpTable}, true
+	case "gbk":
+		return &doubleByteEncoding{table: &gbkTable}, true
+	case "gb2312":
+		return &doubleByteEncoding{table: &gbkTable, leadLo: 0xA1, leadHi: 0xF7, trailLo: 0xA1}, true
+	case "big5":
+		return &doubleByteEncoding{table: &big5Table}, true
+	case "euckr":
+		return &doubleByteEncoding{table: &euckrTable}, true
+	}
+
+	encodingsMu.Lock()
+	defer encodingsMu.Unlock()
+	if e, ok := singleByteEncodings[charset]; ok {
+		return e, true
+	}
+	table, ok := singleByteTables[charset]
+	if !ok {
+		return nil, false
+	}
+	e := newSingleByteEncoding(table)
+	singleByteEncodings[charset] = e
+	return e, true
+}
+
+var (
+	encodingsMu         sync.Mutex
+	singleByteEncodings = make(map[string]*singleByteEncoding)
+)
+
+// binaryEncoding is the encoding of binary strings, whose bytes are kept as they are.
+type binaryEncoding struct{}
+
+func (binaryEncoding) Decode(b []byte) (string, bool) {
+	return string(b), true
+}
+
+func (binaryEncoding) Encode(s string) ([]byte, bool) {
+	return []byte(s), true
+}
+
+// utf8Encoding is the encoding of the UTF-8 character sets, which hold the characters up to maxRune.
+type utf8Encoding struct {
+	maxRune rune
+}
+
+func (e utf8Encoding) Decode(b []byte) (string, bool) {
+	if utf8.Valid(b) && (e.maxRune == utf8.MaxRune || !hasRuneAbove(string(b), e.maxRune)) {
+		return string(b), true
+	}
+
+	buf := make([]byte, 0, len(b))
+	for len(b) > 0 {
+		r, size := utf8.DecodeRune(b)
+		if r == utf8.RuneError && size <= 1 || r > e.maxRune {
+			r = Replacement
+		}
+		buf = append(buf, string(r)...)
+		b = b[size:]
+	}
+	return string(buf), false
+}
+
+func (e utf8Encoding) Encode(s string) ([]byte, bool) {
+	if e.maxRune == utf8.MaxRune || !hasRuneAbove(s, e.maxRune) {
+		return []byte(s), true
+	}
+
+	buf := make([]byte, 0, len(s))
+	for _, r := range s {
+		if r > e.maxRune {
+			r = Replacement
+		}
+		buf = append(buf, string(r)...)
+	}
+	return buf, false
+}
+
+func hasRuneAbove(s string, max rune) bool {
+	for _, r := range s {
+		if r > max {
+			return true
+		}
+	}
+	return false
+}
+
+// asciiEncoding is the encoding of US-ASCII.
+type asciiEncoding struct{}
+
+func (asciiEncoding) Decode(b []byte) (string, bool) {
+	ok := true
+	buf := make([]byte, len(b))
+	for i, c := range b {
+		if c >= utf8.RuneSelf {
+			c = Replacement
+			ok = false
+		}
+		buf[i] = c
+	}
+	return string(buf), ok
+}
+
+func (asciiEncoding) Encode(s string) ([]byte, bool) {
+	ok := true
+	buf := make([]byte, 0, len(s))
+	for _, r := range s {
+		if r >= utf8.RuneSelf {
+			r = Replacement
+			ok = false
+		}
+		buf = append(buf, byte(r))
+	}
+	return buf, ok
+}
+
+// utf16Encoding is the encoding of the UTF-16 character sets, and of UCS-2, which only holds the characters of the
+// Basic Multilingual Plane.
+type utf16Encoding struct {
+	order   binary.ByteOrder
+	bmpOnly bool
+}
+
+func (e utf16Encoding) Decode(b []byte) (string, bool) {
+	ok := true
+	buf := make([]byte, 0, len(b))
+	for len(b) > 0 {
+		if len(b) < 2 {
+			buf = append(buf, Replacement)
+			ok = false
+			break
+		}
+		r := rune(e.order.Uint16(b))
+		b = b[2:]
+		if r >= 0xD800 && r < 0xE000 {
+			var low rune
+			if !e.bmpOnly && r < 0xDC00 && len(b) >= 2 {
+				low = rune(e.order.Uint16(b))
+			}
+			if low >= 0xDC00 && low < 0xE000 {
+				r = 0x10000 + (r-0xD800)<<10 + (low - 0xDC00)
+				b = b[2:]
+			} else {
+				r = Replacement
+				ok = false
+			}
+		}
+		buf = append(buf, string(r)...)
+	}
+	return string(buf), ok
+}
+
+func (e utf16Encoding) Encode(s string) ([]byte, bool) {
+	ok := true
+	buf := make([]byte, 0, len(s)*2)
+	var code [2]byte
+	for _, r := range s {
+		if r > 0xFFFF && e.bmpOnly {
+			r = Replacement
+			ok = false
+		}
+		if r > 0xFFFF {
+			r -= 0x10000
+			e.order.PutUint16(code[:], uint16(0xD800+r>>10))
+			buf = append(buf, code[:]...)
+			r = 0xDC00 + r&0x3FF
+		}
+		e.order.PutUint16(code[:], uint16(r))
+		buf = append(buf, code[:]...)
+	}
+	return buf, ok
+}
+
+// utf32Encoding is the encoding of UTF-32, in big endian order.
+type utf32Encoding struct{}
+
+func (utf32Encoding) Decode(b []byte) (string, bool) {
+	ok := true
+	buf := make([]byte, 0, len(b))
+	for len(b) > 0 {
+		if len(b) < 4 {
+			buf = append(buf, Replacement)
+			ok = false
+			break
+		}
+		r := rune(binary.BigEndian.Uint32(b))
+		b = b[4:]
+		if !utf8.ValidRune(r) {
+			r = Replacement
+			ok = false
+		}
+		buf = append(buf, string(r)...)
+	}
+	return string(buf), ok
+}
+
+func (utf32Encoding) Encode(s string) ([]byte, bool) {
+	buf := make([]byte, 0, len(s)*4)
+	var code [4]byte
+	for _, r := range s {
+		binary.BigEndian.PutUint32(code[:], uint32(r))
+		buf = append(buf, code[:]...)
+	}
+	return buf, true
+}
+
+// singleByteEncoding is the encoding of the character sets whose characters are all one byte long, which are the
+// ASCII characters and the characters of a table for the bytes 0x80 to 0xFF.
+type singleByteEncoding struct {
+	decode [128]rune
+	encode map[rune]byte
+}
+
+func newSingleByteEncoding(table string) *singleByteEncoding {
+	e := &singleByteEncoding{encode: make(map[rune]byte)}
+	i := 0
+	for _, r := range table {
+		e.decode[i] = r
+		if r != utf8.RuneError {
+			e.encode[r] = byte(0x80 + i)
+		}
+		i++
+	}
+	return e
+}
+
+func (e *singleByteEncoding) Decode(b []byte) (string, bool) {
+	ok := true
+	buf := make([]byte, 0, len(b))
+	for _, c := range b {
+		if c < utf8.RuneSelf {
+			buf = append(buf, c)
+			continue
+		}
+		r := e.decode[c-0x80]
+		if r == utf8.RuneError {
+			r = Replacement
+			ok = false
+		}
+		buf = append(buf, string(r)...)
+	}
+	return string(buf), ok
+}
+
+func (e *singleByteEncoding) Encode(s string) ([]byte, bool) {
+	ok := true
+	buf := make([]byte, 0, len(s))
+	for _, r := range s {
+		if r < utf8.RuneSelf {
+			buf = append(buf, byte(r))
+			continue
+		}
+		c, found := e.encode[r]
+		if !found {
+			c = Replacement
+			ok = false
+		}
+		buf = append(buf, c)
+	}
+	return buf, ok
+}
+
+// doubleByteTable holds the characters of the codes of two bytes of a character set, from the lead bytes leadLo to
+// leadHi, each followed by the trail bytes trailLo to trailHi.
+type doubleByteTable struct {
+	leadLo, leadHi   byte
+	trailLo, trailHi byte
+	chars            string
+
+	once   sync.Once
+	decode []rune
+	encode map[rune]uint16
+}
+
+func (t *doubleByteTable) init() {
+	t.once.Do(func() {
+		t.decode = []rune(t.chars)
+		t.encode = make(map[rune]uint16, len(t.decode))
+		width := int(t.trailHi-t.trailLo) + 1
+		for i, r := range t.decode {
+			if r == utf8.RuneError {
+				continue
+			}
+			code := uint16(int(t.leadLo)+i/width)<<8 | uint16(int(t.trailLo)+i%width)
+			if _, ok := t.encode[r]; !ok {
+				t.encode[r] = code
+			}
+		}
+	})
+}
+
+// lookup returns the character of a code, or false if it isn't mapped.
+func (t *doubleByteTable) lookup(lead, trail byte) (rune, bool) {
+	if lead < t.leadLo || lead > t.leadHi || trail < t.trailLo || trail > t.trailHi {
+		return 0, false
+	}
+	r := t.decode[int(lead-t.leadLo)*(int(t.trailHi-t.trailLo)+1)+int(trail-t.trailLo)]
+	return r, r != utf8.RuneError
+}
+
+// halfWidthKatakana are the single byte characters 0xA1 to 0xDF of Shift JIS.
+var halfWidthKatakana = singleByteRange{lo: 0xA1, hi: 0xDF, first: 0xFF61}
+
+// singleByteRange is a range of single byte characters above ASCII, which are consecutive characters from first.
+type singleByteRange struct {
+	lo, hi byte
+	first  rune
+}
+
+// doubleByteEncoding is the encoding of the character sets whose characters are ASCII characters, the characters of
+// a range of single bytes, and characters of two bytes of a table, optionally restricted to a part of its lead and
+// trail bytes.
+type doubleByteEncoding struct {
+	table   *doubleByteTable
+	single  singleByteRange
+	leadLo  byte
+	leadHi  byte
+	trailLo byte
+}
+
+// lookup returns the character of a code of the encoding, or false if it isn't mapped.
+func (e *doubleByteEncoding) lookup(lead, trail byte) (rune, bool) {
+	if lead < e.leadLo || e.leadHi != 0 && lead > e.leadHi || trail < e.trailLo {
+		return 0, false
+	}
+	return e.table.lookup(lead, trail)
+}
+
+func (e *doubleByteEncoding) Decode(b []byte) (string, bool) {
+	e.table.init()
+	ok := true
+	buf := make([]byte, 0, len(b)*3/2)
+	for i := 0; i < len(b); i++ {
+		c := b[i]
+		switch {
+		case c < utf8.RuneSelf:
+			buf = append(buf, c)
+		case e.single.first != 0 && c >= e.single.lo && c <= e.single.hi:
+			buf = append(buf, string(e.single.first+rune(c-e.single.lo))...)
+		default:
+			if i+1 < len(b) {
+				if r, found := e.lookup(c, b[i+1]); found {
+					buf = append(buf, string(r)...)
+					i++
+					continue
+				}
+			}
+			buf = append(buf, Replacement)
+			ok = false
+		}
+	}
+	return string(buf), ok
+}
+
+func (e *doubleByteEncoding) Encode(s string) ([]byte, bool) {
+	e.table.init()
+	ok := true
+	buf := make([]byte, 0, len(s))
+	for _, r := range s {
+		if r < utf8.RuneSelf {
+			buf = append(buf, byte(r))
+			continue
+		}
+		if e.single.first != 0 && r >= e.single.first && r <= e.single.first+rune(e.single.hi-e.single.lo) {
+			buf = append(buf, e.single.lo+byte(r-e.single.first))
+			continue
+		}
+		if code, found := e.table.encode[r]; found {
+			if _, valid := e.lookup(byte(code>>8), byte(code)); valid {
+				buf = append(buf, byte(code>>8), byte(code))
+				continue
+			}
+		}
+		buf = append(buf, Replacement)
+		ok = false
+	}
+	return buf, ok
+}
@@ -0,0 +1,102 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encodings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodings(t *testing.T) {
+	tests := []struct {
+		charset string
+		s       string
+		encoded []byte
+	}{
+		{"utf8mb4", "añ😀", []byte("añ😀")},
+		{"binary", "\xff\x00", []byte{0xff, 0x00}},
+		{"ascii", "abc", []byte("abc")},
+		{"latin1", "café €", []byte{'c', 'a', 'f', 0xe9, ' ', 0x80}},
+		{"latin1", "\u0081", []byte{0x81}},
+		{"latin2", "Łódź", []byte{0xa3, 0xf3, 'd', 0xbc}},
+		{"cp1251", "Привет", []byte{0xcf, 0xf0, 0xe8, 0xe2, 0xe5, 0xf2}},
+		{"koi8r", "мир", []byte{0xcd, 0xc9, 0xd2}},
+		{"greek", "αβγ", []byte{0xe1, 0xe2, 0xe3}},
+		{"sjis", "日本ｱ", []byte{0x93, 0xfa, 0x96, 0x7b, 0xb1}},
+		{"ujis", "日本", []byte{0xc6, 0xfc, 0xcb, 0xdc}},
+		{"gbk", "中文", []byte{0xd6, 0xd0, 0xce, 0xc4}},
+		{"gb2312", "中文", []byte{0xd6, 0xd0, 0xce, 0xc4}},
+		{"big5", "中文", []byte{0xa4, 0xa4, 0xa4, 0xe5}},
+		{"euckr", "한국", []byte{0xc7, 0xd1, 0xb1, 0xb9}},
+		{"ucs2", "aé", []byte{0, 'a', 0, 0xe9}},
+		{"utf16", "😀", []byte{0xd8, 0x3d, 0xde, 0x00}},
+		{"utf16le", "a", []byte{'a', 0}},
+		{"utf32", "a😀", []byte{0, 0, 0, 'a', 0, 1, 0xf6, 0}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.charset+" "+test.s, func(t *testing.T) {
+			require := require.New(t)
+			e, ok := Lookup(test.charset)
+			require.True(ok)
+
+			encoded, ok := e.Encode(test.s)
+			require.True(ok)
+			require.Equal(test.encoded, encoded)
+
+			decoded, ok := e.Decode(test.encoded)
+			require.True(ok)
+			require.Equal(test.s, decoded)
+		})
+	}
+}
+
+func TestEncodingsReplacement(t *testing.T) {
+	tests := []struct {
+		charset string
+		s       string
+		encoded []byte
+	}{
+		{"latin1", "a€b日", []byte{'a', 0x80, 'b', '?'}},
+		{"ascii", "é", []byte{'?'}},
+		{"utf8mb3", "a😀", []byte{'a', '?'}},
+		{"ucs2", "😀", []byte{0, '?'}},
+		{"sjis", "한", []byte{'?'}},
+		{"gb2312", "丂", []byte{'?'}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.charset+" "+test.s, func(t *testing.T) {
+			e, ok := Lookup(test.charset)
+			require.True(t, ok)
+			encoded, _ := e.Encode(test.s)
+			require.Equal(t, test.encoded, encoded)
+		})
+	}
+
+	e, _ := Lookup("utf8mb4")
+	s, ok := e.Decode([]byte{'a', 0xff})
+	require.False(t, ok)
+	require.Equal(t, "a?", s)
+
+	e, _ = Lookup("sjis")
+	s, ok = e.Decode([]byte{'a', 0x81})
+	require.False(t, ok)
+	require.Equal(t, "a?", s)
+
+	_, ok = Lookup("armscii8")
+	require.False(t, ok)
+}
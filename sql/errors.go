@@ -310,6 +310,34 @@ var (
 
 	// ErrCantDropIndex is return when a table can't drop an index due to a foreign key relationship.
 	ErrCantDropIndex = errors.NewKind("error: can't drop index '%s': needed in a foreign key constraint")
+
+	// ErrUnknownPreparedStatement is returned when EXECUTE or DEALLOCATE PREPARE name a statement which isn't prepared.
+	ErrUnknownPreparedStatement = errors.NewKind("Unknown prepared statement handler (%s) given to %s")
+
+	// ErrWrongArgumentsToExecute is returned when EXECUTE is given a different number of values than the statement
+	// has parameters.
+	ErrWrongArgumentsToExecute = errors.NewKind("Incorrect arguments to EXECUTE")
+
+	// ErrUnsupportedPreparedStatement is returned when PREPARE is given a statement which can't be prepared.
+	ErrUnsupportedPreparedStatement = errors.NewKind("This command is not supported in the prepared statement protocol yet")
+
+	// ErrUnknownThread is returned when KILL names a connection which doesn't exist.
+	ErrUnknownThread = errors.NewKind("Unknown thread id: %d")
+
+	// ErrKillDenied is returned when KILL names a connection of another user, and the current user can't kill it.
+	ErrKillDenied = errors.NewKind("You are not owner of thread %d")
+
+	// ErrQueryTimeout is returned when a SELECT statement runs for longer than its execution time limit.
+	ErrQueryTimeout = errors.NewKind("Query execution was interrupted, maximum statement execution time exceeded")
+
+	// ErrSpatialIndexKeyParts is returned when a SPATIAL index is created on more than one column.
+	ErrSpatialIndexKeyParts = errors.NewKind("Too many key parts specified; max 1 parts allowed")
+
+	// ErrSpatialIndexType is returned when a SPATIAL index is created on a column which isn't of a spatial type.
+	ErrSpatialIndexType = errors.NewKind("Incorrect arguments to SPATIAL INDEX: column '%s' is not of a spatial type")
+
+	// ErrSpatialIndexNullable is returned when a SPATIAL index is created on a nullable column.
+	ErrSpatialIndexNullable = errors.NewKind("All parts of a SPATIAL index must be NOT NULL")
 )
 
 func CastSQLError(err error) (*mysql.SQLError, bool) {
@@ -358,6 +386,86 @@ func CastSQLError(err error) (*mysql.SQLError, bool) {
 		code = mysql.ERCantDropFieldOrKey
 	case ErrCantDropIndex.Is(err):
 		code = 1553 // TODO: Needs to be added to vitess
+	case ErrPrivilegeCheckFailed.Is(err):
+		code = mysql.ERSpecifiedAccessDenied
+	case ErrAccountNotFound.Is(err), ErrAccountExists.Is(err):
+		code = 1396 // TODO: Needs to be added to vitess
+	case ErrNoGrantFound.Is(err):
+		code = 1141 // TODO: Needs to be added to vitess
+	case ErrRoleNotGranted.Is(err):
+		code = 3530 // TODO: Needs to be added to vitess
+	case ErrUnknownPreparedStatement.Is(err):
+		code = 1243 // TODO: Needs to be added to vitess
+	case ErrWrongArgumentsToExecute.Is(err):
+		code = mysql.ERWrongArguments
+	case ErrUnsupportedPreparedStatement.Is(err):
+		code = 1295 // TODO: Needs to be added to vitess
+	case ErrUnknownThread.Is(err):
+		code = mysql.ERNoSuchThread
+	case ErrKillDenied.Is(err):
+		code = mysql.ERKillDenied
+	case ErrQueryTimeout.Is(err):
+		code = 3024 // TODO: Needs to be added to vitess
+	case ErrSpatialIndexKeyParts.Is(err):
+		code = mysql.ERTooManyKeyParts
+	case ErrSpatialIndexNullable.Is(err):
+		code = 1252 // TODO: Needs to be added to vitess
+	case ErrNoFullTextIndex.Is(err):
+		code = 1191 // TODO: Needs to be added to vitess
+	case ErrFullTextColumnType.Is(err):
+		code = mysql.ERBadFTColumn
+	case ErrFullTextAgainstArgument.Is(err):
+		code = mysql.ERWrongArguments
+	case ErrNoPartitionForValue.Is(err):
+		code = 1526 // TODO: Needs to be added to vitess
+	case ErrUnknownPartition.Is(err):
+		code = 1735 // TODO: Needs to be added to vitess
+	case ErrPartitionClauseOnNonPartitioned.Is(err):
+		code = 1747 // TODO: Needs to be added to vitess
+	case ErrPartitionManagementOnNonPartitioned.Is(err):
+		code = 1505 // TODO: Needs to be added to vitess
+	case ErrDuplicatePartitionName.Is(err):
+		code = 1517 // TODO: Needs to be added to vitess
+	case ErrPartitionsMustBeDefined.Is(err):
+		code = 1492 // TODO: Needs to be added to vitess
+	case ErrPartitionValuesMismatch.Is(err):
+		code = 1480 // TODO: Needs to be added to vitess
+	case ErrPartitionColumnCount.Is(err):
+		code = 1653 // TODO: Needs to be added to vitess
+	case ErrRangeNotIncreasing.Is(err):
+		code = 1493 // TODO: Needs to be added to vitess
+	case ErrPartitionMaxValue.Is(err):
+		code = 1481 // TODO: Needs to be added to vitess
+	case ErrMultipleDefConstInListPart.Is(err):
+		code = 1495 // TODO: Needs to be added to vitess
+	case ErrPartitionFunctionType.Is(err):
+		code = 1491 // TODO: Needs to be added to vitess
+	case ErrPartitionFunctionNotAllowed.Is(err):
+		code = 1564 // TODO: Needs to be added to vitess
+	case ErrPartitionColumnNotFound.Is(err):
+		code = 1488 // TODO: Needs to be added to vitess
+	case ErrUniqueKeyNeedsAllPartitionFields.Is(err):
+		code = 1503 // TODO: Needs to be added to vitess
+	case ErrNoBinaryLogging.Is(err):
+		code = 1381 // TODO: Needs to be added to vitess
+	case ErrUnknownBinlogFile.Is(err):
+		code = 1220 // TODO: Needs to be added to vitess
+	case ErrBinlogTargetNotFound.Is(err):
+		code = 1373 // TODO: Needs to be added to vitess
+	case ErrReplicaNotConfigured.Is(err):
+		code = 1200 // TODO: Needs to be added to vitess
+	case ErrReplicaRunning.Is(err):
+		code = 1198 // TODO: Needs to be added to vitess
+	case ErrDropLastPartition.Is(err):
+		code = 1508 // TODO: Needs to be added to vitess
+	case ErrOnlyOnRangeListPartition.Is(err):
+		code = 1512 // TODO: Needs to be added to vitess
+	case ErrCoalesceOnlyOnHashPartition.Is(err):
+		code = 1509 // TODO: Needs to be added to vitess
+	case ErrReorganizeRange.Is(err):
+		code = 1520 // TODO: Needs to be added to vitess
+	case ErrReorganizeNotConsecutive.Is(err):
+		code = 1519 // TODO: Needs to be added to vitess
 	default:
 		code = mysql.ERUnknownError
 	}
@@ -170,9 +170,42 @@ func (c *comparison) castLeftAndRight(left, right interface{}) (interface{}, int
 		return nil, nil, nil, err
 	}
 
+	if collation := c.Collation(); !collation.Equals(sql.Collation_Default) {
+		return left, right, sql.CreateLongText(collation), nil
+	}
 	return left, right, sql.LongText, nil
 }
 
+// Collation returns the collation which the strings compared are compared in. Binary strings compare byte by byte,
+// columns and variables compare in their own collation rather than the one of the literals they're compared to, and
+// other strings compare in the collation of the left one.
+func (c *comparison) Collation() sql.Collation {
+	leftType, leftIsString := c.Left().Type().(sql.StringType)
+	rightType, rightIsString := c.Right().Type().(sql.StringType)
+	switch {
+	case leftIsString && leftType.Collation().Equals(sql.Collation_binary):
+		return sql.Collation_binary
+	case rightIsString && rightType.Collation().Equals(sql.Collation_binary):
+		return sql.Collation_binary
+	case rightIsString && (!leftIsString || isNamedValue(c.Right()) && !isNamedValue(c.Left())):
+		return rightType.Collation()
+	case leftIsString:
+		return leftType.Collation()
+	default:
+		return sql.Collation_Default
+	}
+}
+
+// isNamedValue returns whether an expression is the value of a column or a variable.
+func isNamedValue(e sql.Expression) bool {
+	switch e.(type) {
+	case *GetField, *UserVar, *SystemVar, *ProcedureParam:
+		return true
+	default:
+		return false
+	}
+}
+
 func convertLeftAndRight(left, right interface{}, convertTo string) (interface{}, interface{}, error) {
 	l, err := convertValue(left, convertTo)
 	if err != nil {
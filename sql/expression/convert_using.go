@@ -0,0 +1,84 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// ConvertUsing represents a CONVERT(x USING charset) operation, which converts the string x to another character set.
+// The characters the character set can't represent are replaced with ?. Binary strings are bytes, which are decoded
+// from the character set, and strings converted to binary are encoded in their own character set.
+//
+// cc: https://dev.mysql.com/doc/refman/8.0/en/cast-functions.html#function_convert
+type ConvertUsing struct {
+	UnaryExpression
+	charset sql.CharacterSet
+}
+
+var _ sql.Expression = (*ConvertUsing)(nil)
+
+// NewConvertUsing creates a new ConvertUsing expression.
+func NewConvertUsing(expr sql.Expression, charset sql.CharacterSet) *ConvertUsing {
+	return &ConvertUsing{
+		UnaryExpression: UnaryExpression{Child: expr},
+		charset:         charset,
+	}
+}
+
+// String implements the fmt.Stringer interface.
+func (c *ConvertUsing) String() string {
+	return fmt.Sprintf("CONVERT(%s USING %s)", c.Child, c.charset)
+}
+
+// Type implements the Expression interface.
+func (c *ConvertUsing) Type() sql.Type {
+	if c.charset == sql.CharacterSet_binary {
+		return sql.LongBlob
+	}
+	return sql.CreateLongText(c.charset.DefaultCollation())
+}
+
+// Eval implements the Expression interface.
+func (c *ConvertUsing) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	val, err := c.Child.Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, err
+	}
+
+	from := sql.CharacterSet_utf8mb4
+	if st, ok := c.Child.Type().(sql.StringType); ok {
+		from = st.CharacterSet()
+	}
+	if b, ok := val.([]byte); ok {
+		val, from = string(b), sql.CharacterSet_binary
+	}
+
+	s, err := sql.LongText.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	return sql.ConvertString(s.(string), from, c.charset)
+}
+
+// WithChildren implements the Expression interface.
+func (c *ConvertUsing) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 1)
+	}
+	return NewConvertUsing(children[0], c.charset), nil
+}
@@ -29,7 +29,7 @@ func (de *DistinctExpression) seenValue(ctx *sql.Context, value interface{}) (bo
 		de.dispose = dispose
 	}
 
-	hash, err := hashstructure.Hash(value, nil)
+	hash, err := hashstructure.Hash(sql.CollationKey(de.Child.Type(), value), nil)
 	if err != nil {
 		return false, err
 	}
@@ -173,7 +173,7 @@ func (c *CountDistinct) Update(ctx *sql.Context, buffer, row sql.Row) error {
 			return err
 		}
 
-		value = v
+		value = sql.CollationKey(c.Child.Type(), v)
 	}
 
 	hash, err := hashstructure.Hash(value, nil)
@@ -58,9 +58,10 @@ func TestMin_Eval_Text(t *testing.T) {
 	m.Update(ctx, b, sql.NewRow("A"))
 	m.Update(ctx, b, sql.NewRow("b"))
 
+	// "a" and "A" are equal in the default collation, so the first one is kept
 	v, err := m.Eval(ctx, b)
 	assert.NoError(err)
-	assert.Equal("A", v)
+	assert.Equal("a", v)
 }
 
 func TestMin_Eval_Timestamp(t *testing.T) {
@@ -71,6 +71,8 @@ var Defaults = []sql.Function{
 	sql.Function1{Name: "floor", Fn: NewFloor},
 	sql.Function0{Name: "found_rows", Fn: NewFoundRows},
 	sql.Function1{Name: "from_base64", Fn: NewFromBase64},
+	sql.FunctionN{Name: "geomcollection", Fn: NewGeometryConstructorFunc("geomcollection", "GEOMETRYCOLLECTION")},
+	sql.FunctionN{Name: "geometrycollection", Fn: NewGeometryConstructorFunc("geometrycollection", "GEOMETRYCOLLECTION")},
 	sql.FunctionN{Name: "greatest", Fn: NewGreatest},
 	sql.Function0{Name: "group_concat", Fn: aggregation.NewEmptyGroupConcat},
 	sql.Function1{Name: "hex", Fn: NewHex},
@@ -118,6 +120,7 @@ var Defaults = []sql.Function{
 	sql.FunctionN{Name: "least", Fn: NewLeast},
 	sql.Function2{Name: "left", Fn: NewLeft},
 	sql.Function1{Name: "length", Fn: NewLength},
+	sql.FunctionN{Name: "linestring", Fn: NewGeometryConstructorFunc("linestring", "LINESTRING")},
 	sql.Function1{Name: "ln", Fn: NewLogBaseFunc(float64(math.E))},
 	sql.Function1{Name: "load_file", Fn: NewLoadFile},
 	sql.FunctionN{Name: "log", Fn: NewLog},
@@ -127,6 +130,15 @@ var Defaults = []sql.Function{
 	sql.FunctionN{Name: "lpad", Fn: NewPadFunc(lPadType)},
 	sql.Function1{Name: "ltrim", Fn: NewTrimFunc(lTrimType)},
 	sql.Function1{Name: "max", Fn: func(ctx *sql.Context, e sql.Expression) sql.Expression { return aggregation.NewMax(ctx, e) }},
+	sql.Function2{Name: "mbrcontains", Fn: NewSpatialPredicateFunc("mbrcontains", relationContains, true)},
+	sql.Function2{Name: "mbrcoveredby", Fn: NewSpatialPredicateFunc("mbrcoveredby", relationCoveredBy, true)},
+	sql.Function2{Name: "mbrcovers", Fn: NewSpatialPredicateFunc("mbrcovers", relationCovers, true)},
+	sql.Function2{Name: "mbrdisjoint", Fn: NewSpatialPredicateFunc("mbrdisjoint", relationDisjoint, true)},
+	sql.Function2{Name: "mbrequals", Fn: NewSpatialPredicateFunc("mbrequals", relationEquals, true)},
+	sql.Function2{Name: "mbrintersects", Fn: NewSpatialPredicateFunc("mbrintersects", relationIntersects, true)},
+	sql.Function2{Name: "mbroverlaps", Fn: NewSpatialPredicateFunc("mbroverlaps", relationOverlaps, true)},
+	sql.Function2{Name: "mbrtouches", Fn: NewSpatialPredicateFunc("mbrtouches", relationTouches, true)},
+	sql.Function2{Name: "mbrwithin", Fn: NewSpatialPredicateFunc("mbrwithin", relationWithin, true)},
 	sql.Function1{Name: "md5", Fn: NewMD5},
 	sql.Function1{Name: "microsecond", Fn: NewMicrosecond},
 	sql.FunctionN{Name: "mid", Fn: NewSubstring},
@@ -134,8 +146,13 @@ var Defaults = []sql.Function{
 	sql.Function1{Name: "minute", Fn: NewMinute},
 	sql.Function1{Name: "month", Fn: NewMonth},
 	sql.Function1{Name: "monthname", Fn: NewMonthName},
+	sql.FunctionN{Name: "multilinestring", Fn: NewGeometryConstructorFunc("multilinestring", "MULTILINESTRING")},
+	sql.FunctionN{Name: "multipoint", Fn: NewGeometryConstructorFunc("multipoint", "MULTIPOINT")},
+	sql.FunctionN{Name: "multipolygon", Fn: NewGeometryConstructorFunc("multipolygon", "MULTIPOLYGON")},
 	sql.FunctionN{Name: "now", Fn: NewNow},
 	sql.Function2{Name: "nullif", Fn: NewNullIf},
+	sql.FunctionN{Name: "point", Fn: NewGeometryConstructorFunc("point", "POINT")},
+	sql.FunctionN{Name: "polygon", Fn: NewGeometryConstructorFunc("polygon", "POLYGON")},
 	sql.Function2{Name: "pow", Fn: NewPower},
 	sql.Function2{Name: "power", Fn: NewPower},
 	sql.Function1{Name: "radians", Fn: NewRadians},
@@ -161,6 +178,57 @@ var Defaults = []sql.Function{
 	sql.Function1{Name: "soundex", Fn: NewSoundex},
 	sql.Function2{Name: "split", Fn: NewSplit},
 	sql.Function1{Name: "sqrt", Fn: NewSqrt},
+	sql.FunctionN{Name: "st_asbinary", Fn: NewAsTextFunc("st_asbinary", true)},
+	sql.FunctionN{Name: "st_astext", Fn: NewAsTextFunc("st_astext", false)},
+	sql.FunctionN{Name: "st_aswkb", Fn: NewAsTextFunc("st_aswkb", true)},
+	sql.FunctionN{Name: "st_aswkt", Fn: NewAsTextFunc("st_aswkt", false)},
+	sql.Function2{Name: "st_contains", Fn: NewSpatialPredicateFunc("st_contains", relationContains, false)},
+	sql.Function1{Name: "st_dimension", Fn: NewDimension},
+	sql.Function2{Name: "st_disjoint", Fn: NewSpatialPredicateFunc("st_disjoint", relationDisjoint, false)},
+	sql.Function2{Name: "st_distance", Fn: NewDistance},
+	sql.FunctionN{Name: "st_distance_sphere", Fn: NewDistanceSphere},
+	sql.Function1{Name: "st_envelope", Fn: NewEnvelope},
+	sql.Function2{Name: "st_equals", Fn: NewSpatialPredicateFunc("st_equals", relationEquals, false)},
+	sql.FunctionN{Name: "st_geomcollfromtext", Fn: NewGeomFromTextFunc("st_geomcollfromtext", "GEOMETRYCOLLECTION", false)},
+	sql.FunctionN{Name: "st_geomcollfromtxt", Fn: NewGeomFromTextFunc("st_geomcollfromtxt", "GEOMETRYCOLLECTION", false)},
+	sql.FunctionN{Name: "st_geomcollfromwkb", Fn: NewGeomFromTextFunc("st_geomcollfromwkb", "GEOMETRYCOLLECTION", true)},
+	sql.FunctionN{Name: "st_geometrycollectionfromtext", Fn: NewGeomFromTextFunc("st_geometrycollectionfromtext", "GEOMETRYCOLLECTION", false)},
+	sql.FunctionN{Name: "st_geometrycollectionfromwkb", Fn: NewGeomFromTextFunc("st_geometrycollectionfromwkb", "GEOMETRYCOLLECTION", true)},
+	sql.FunctionN{Name: "st_geometryfromtext", Fn: NewGeomFromTextFunc("st_geometryfromtext", "", false)},
+	sql.FunctionN{Name: "st_geometryfromwkb", Fn: NewGeomFromTextFunc("st_geometryfromwkb", "", true)},
+	sql.Function1{Name: "st_geometrytype", Fn: NewGeometryType},
+	sql.FunctionN{Name: "st_geomfromtext", Fn: NewGeomFromTextFunc("st_geomfromtext", "", false)},
+	sql.FunctionN{Name: "st_geomfromwkb", Fn: NewGeomFromTextFunc("st_geomfromwkb", "", true)},
+	sql.Function2{Name: "st_intersects", Fn: NewSpatialPredicateFunc("st_intersects", relationIntersects, false)},
+	sql.Function1{Name: "st_isempty", Fn: NewIsEmpty},
+	sql.FunctionN{Name: "st_latitude", Fn: NewPointCoordinateFunc("st_latitude", coordinateLatitude)},
+	sql.FunctionN{Name: "st_linefromtext", Fn: NewGeomFromTextFunc("st_linefromtext", "LINESTRING", false)},
+	sql.FunctionN{Name: "st_linefromwkb", Fn: NewGeomFromTextFunc("st_linefromwkb", "LINESTRING", true)},
+	sql.FunctionN{Name: "st_linestringfromtext", Fn: NewGeomFromTextFunc("st_linestringfromtext", "LINESTRING", false)},
+	sql.FunctionN{Name: "st_linestringfromwkb", Fn: NewGeomFromTextFunc("st_linestringfromwkb", "LINESTRING", true)},
+	sql.FunctionN{Name: "st_longitude", Fn: NewPointCoordinateFunc("st_longitude", coordinateLongitude)},
+	sql.FunctionN{Name: "st_mlinefromtext", Fn: NewGeomFromTextFunc("st_mlinefromtext", "MULTILINESTRING", false)},
+	sql.FunctionN{Name: "st_mlinefromwkb", Fn: NewGeomFromTextFunc("st_mlinefromwkb", "MULTILINESTRING", true)},
+	sql.FunctionN{Name: "st_mpointfromtext", Fn: NewGeomFromTextFunc("st_mpointfromtext", "MULTIPOINT", false)},
+	sql.FunctionN{Name: "st_mpointfromwkb", Fn: NewGeomFromTextFunc("st_mpointfromwkb", "MULTIPOINT", true)},
+	sql.FunctionN{Name: "st_mpolyfromtext", Fn: NewGeomFromTextFunc("st_mpolyfromtext", "MULTIPOLYGON", false)},
+	sql.FunctionN{Name: "st_mpolyfromwkb", Fn: NewGeomFromTextFunc("st_mpolyfromwkb", "MULTIPOLYGON", true)},
+	sql.FunctionN{Name: "st_multilinestringfromtext", Fn: NewGeomFromTextFunc("st_multilinestringfromtext", "MULTILINESTRING", false)},
+	sql.FunctionN{Name: "st_multilinestringfromwkb", Fn: NewGeomFromTextFunc("st_multilinestringfromwkb", "MULTILINESTRING", true)},
+	sql.FunctionN{Name: "st_multipointfromtext", Fn: NewGeomFromTextFunc("st_multipointfromtext", "MULTIPOINT", false)},
+	sql.FunctionN{Name: "st_multipointfromwkb", Fn: NewGeomFromTextFunc("st_multipointfromwkb", "MULTIPOINT", true)},
+	sql.FunctionN{Name: "st_multipolygonfromtext", Fn: NewGeomFromTextFunc("st_multipolygonfromtext", "MULTIPOLYGON", false)},
+	sql.FunctionN{Name: "st_multipolygonfromwkb", Fn: NewGeomFromTextFunc("st_multipolygonfromwkb", "MULTIPOLYGON", true)},
+	sql.FunctionN{Name: "st_pointfromtext", Fn: NewGeomFromTextFunc("st_pointfromtext", "POINT", false)},
+	sql.FunctionN{Name: "st_pointfromwkb", Fn: NewGeomFromTextFunc("st_pointfromwkb", "POINT", true)},
+	sql.FunctionN{Name: "st_polyfromtext", Fn: NewGeomFromTextFunc("st_polyfromtext", "POLYGON", false)},
+	sql.FunctionN{Name: "st_polyfromwkb", Fn: NewGeomFromTextFunc("st_polyfromwkb", "POLYGON", true)},
+	sql.FunctionN{Name: "st_polygonfromtext", Fn: NewGeomFromTextFunc("st_polygonfromtext", "POLYGON", false)},
+	sql.FunctionN{Name: "st_polygonfromwkb", Fn: NewGeomFromTextFunc("st_polygonfromwkb", "POLYGON", true)},
+	sql.FunctionN{Name: "st_srid", Fn: NewSRID},
+	sql.Function2{Name: "st_within", Fn: NewSpatialPredicateFunc("st_within", relationWithin, false)},
+	sql.FunctionN{Name: "st_x", Fn: NewPointCoordinateFunc("st_x", coordinateX)},
+	sql.FunctionN{Name: "st_y", Fn: NewPointCoordinateFunc("st_y", coordinateY)},
 	sql.FunctionN{Name: "substr", Fn: NewSubstring},
 	sql.FunctionN{Name: "substring", Fn: NewSubstring},
 	sql.Function3{Name: "substring_index", Fn: NewSubstringIndex},
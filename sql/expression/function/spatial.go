@@ -0,0 +1,1107 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/linanh/go-mysql-server/sql"
+	"github.com/linanh/go-mysql-server/sql/expression"
+)
+
+var (
+	// ErrInvalidSpatialOption is returned when the options argument of a spatial function isn't a valid one.
+	ErrInvalidSpatialOption = errors.NewKind("Invalid option '%s' in function %s.")
+
+	// ErrNonPositiveRadius is returned when ST_Distance_Sphere is given a radius which isn't positive.
+	ErrNonPositiveRadius = errors.NewKind("Invalid radius provided to function %s: Radius must be greater than zero.")
+
+	// ErrSRSNotGeographic is returned by the functions which only handle geometries of geographic spatial reference
+	// systems when they're given another geometry.
+	ErrSRSNotGeographic = errors.NewKind("Function %s is only defined for geographic spatial reference systems, but its argument is in SRID %d, which is not geographic.")
+)
+
+// defaultSphereRadius is the radius of the Earth in meters ST_Distance_Sphere uses unless it's given one.
+const defaultSphereRadius = 6370986
+
+// spatialFunc is the name and the arguments of a spatial function.
+type spatialFunc struct {
+	name string
+	args []sql.Expression
+}
+
+// FunctionName implements sql.FunctionExpression
+func (f *spatialFunc) FunctionName() string {
+	return f.name
+}
+
+// Children implements the sql.Expression interface.
+func (f *spatialFunc) Children() []sql.Expression {
+	return f.args
+}
+
+// Resolved implements the sql.Expression interface.
+func (f *spatialFunc) Resolved() bool {
+	for _, arg := range f.args {
+		if !arg.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// IsNullable implements the sql.Expression interface.
+func (f *spatialFunc) IsNullable() bool {
+	return true
+}
+
+func (f *spatialFunc) String() string {
+	args := make([]string, len(f.args))
+	for i, arg := range f.args {
+		args[i] = arg.String()
+	}
+	return fmt.Sprintf("%s(%s)", f.name, strings.Join(args, ", "))
+}
+
+// evalArg evaluates the argument of the index given, which is NULL if there's no such argument.
+func (f *spatialFunc) evalArg(ctx *sql.Context, row sql.Row, i int) (interface{}, error) {
+	if i >= len(f.args) {
+		return nil, nil
+	}
+	return f.args[i].Eval(ctx, row)
+}
+
+// evalGeometry evaluates an argument which must be a geometry, or the bytes one is stored as.
+func (f *spatialFunc) evalGeometry(ctx *sql.Context, row sql.Row, i int) (sql.GeometryValue, error) {
+	v, err := f.evalArg(ctx, row, i)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	if g, ok := v.(sql.GeometryValue); ok {
+		return g, nil
+	}
+	var b []byte
+	switch v := v.(type) {
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	}
+	g, ok := sql.DeserializeGeometry(b)
+	if !ok {
+		return nil, sql.ErrInvalidGISData.New(f.name)
+	}
+	return g, nil
+}
+
+// evalSRID evaluates an argument which is an SRID, and returns its spatial reference system. It returns false if the
+// argument is NULL.
+func (f *spatialFunc) evalSRID(ctx *sql.Context, row sql.Row, i int) (sql.SpatialReferenceSystem, bool, error) {
+	v, err := f.evalArg(ctx, row, i)
+	if err != nil || v == nil {
+		return sql.SpatialReferenceSystem{}, false, err
+	}
+	v, err = sql.Int64.Convert(v)
+	if err != nil {
+		return sql.SpatialReferenceSystem{}, false, err
+	}
+	srid := v.(int64)
+	if srid < 0 || srid > math.MaxUint32 {
+		return sql.SpatialReferenceSystem{}, false, sql.ErrUnknownSRID.New(srid)
+	}
+	srs, err := sql.LookupSpatialReferenceSystem(uint32(srid))
+	return srs, true, err
+}
+
+// evalSwapsAxes evaluates the options argument of the index given, such as 'axis-order=long-lat', and returns whether
+// the axes of the well-known text or binary of the geometries of a spatial reference system are swapped. They are
+// for the geographic systems whose latitude comes first, unless the options say otherwise.
+func (f *spatialFunc) evalSwapsAxes(ctx *sql.Context, row sql.Row, i int, srs sql.SpatialReferenceSystem) (bool, error) {
+	v, err := f.evalArg(ctx, row, i)
+	if err != nil {
+		return false, err
+	}
+	swap := srs.LatLong
+	if v == nil {
+		return swap, nil
+	}
+	v, err = sql.LongText.Convert(v)
+	if err != nil {
+		return false, err
+	}
+
+	for _, option := range strings.Split(v.(string), ",") {
+		option = strings.TrimSpace(option)
+		if option == "" {
+			continue
+		}
+		kv := strings.SplitN(option, "=", 2)
+		if len(kv) != 2 || strings.ToLower(strings.TrimSpace(kv[0])) != "axis-order" {
+			return false, ErrInvalidSpatialOption.New(option, f.name)
+		}
+		switch strings.ToLower(strings.TrimSpace(kv[1])) {
+		case "srid-defined":
+			swap = srs.LatLong
+		case "lat-long":
+			swap = srs.Geographic
+		case "long-lat":
+			swap = false
+		default:
+			return false, ErrInvalidSpatialOption.New(option, f.name)
+		}
+	}
+	return swap, nil
+}
+
+// checkSameSRID returns ErrDifferentSRIDs if two geometries aren't of the same spatial reference system.
+func (f *spatialFunc) checkSameSRID(a, b sql.GeometryValue) error {
+	if a.GetSRID() != b.GetSRID() {
+		return sql.ErrDifferentSRIDs.New(f.name, a.GetSRID(), b.GetSRID())
+	}
+	return nil
+}
+
+// validateSRS returns an error if a geometry has coordinates out of the range of its spatial reference system.
+func (f *spatialFunc) validateSRS(g sql.GeometryValue) error {
+	srs, err := sql.LookupSpatialReferenceSystem(g.GetSRID())
+	if err != nil {
+		return err
+	}
+	if srs.Geographic {
+		return sql.ValidateGeographicCoordinates(g, f.name)
+	}
+	return nil
+}
+
+// withChildren returns the name of a spatial function with the arguments given.
+func (f *spatialFunc) withChildren(children []sql.Expression) (spatialFunc, error) {
+	if len(children) != len(f.args) {
+		return spatialFunc{}, sql.ErrInvalidChildrenNumber.New(f, len(children), len(f.args))
+	}
+	return spatialFunc{name: f.name, args: children}, nil
+}
+
+func checkArgumentNumber(name string, args []sql.Expression, min, max int) error {
+	if len(args) >= min && len(args) <= max {
+		return nil
+	}
+	expected := fmt.Sprintf("%d to %d", min, max)
+	switch {
+	case min == max:
+		expected = fmt.Sprint(min)
+	case max == min+1:
+		expected = fmt.Sprintf("%d or %d", min, max)
+	case max == math.MaxInt32:
+		expected = fmt.Sprintf("at least %d", min)
+	}
+	return sql.ErrInvalidArgumentNumber.New(name, expected, len(args))
+}
+
+// GeomFromText is one of the functions which return the geometry of well-known text or binary, such as
+// ST_GeomFromText and ST_PointFromWKB. They take an optional SRID, and options for the axis order.
+// https://dev.mysql.com/doc/refman/8.0/en/gis-wkt-functions.html
+type GeomFromText struct {
+	spatialFunc
+	// geomType is the type of the geometries returned, or an empty string if it may be any.
+	geomType string
+	binary   bool
+}
+
+var _ sql.FunctionExpression = (*GeomFromText)(nil)
+
+// NewGeomFromTextFunc returns a GeomFromText creator function of the name given, which returns geometries of a type or
+// of any type if geomType is empty. The function takes well-known binary if binary is true.
+func NewGeomFromTextFunc(name string, geomType string, binary bool) func(ctx *sql.Context, args ...sql.Expression) (sql.Expression, error) {
+	return func(ctx *sql.Context, args ...sql.Expression) (sql.Expression, error) {
+		if err := checkArgumentNumber(name, args, 1, 3); err != nil {
+			return nil, err
+		}
+		return &GeomFromText{spatialFunc: spatialFunc{name: name, args: args}, geomType: geomType, binary: binary}, nil
+	}
+}
+
+// Type implements the sql.Expression interface.
+func (f *GeomFromText) Type() sql.Type {
+	if f.geomType == "" {
+		return sql.Geometry
+	}
+	return sql.MustCreateSpatialType(f.geomType, 0, false)
+}
+
+// WithChildren implements the sql.Expression interface.
+func (f *GeomFromText) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	sf, err := f.withChildren(children)
+	if err != nil {
+		return nil, err
+	}
+	return &GeomFromText{spatialFunc: sf, geomType: f.geomType, binary: f.binary}, nil
+}
+
+// Eval implements the sql.Expression interface.
+func (f *GeomFromText) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	v, err := f.evalArg(ctx, row, 0)
+	if err != nil || v == nil {
+		return nil, err
+	}
+
+	srs, err := sql.LookupSpatialReferenceSystem(0)
+	if err != nil {
+		return nil, err
+	}
+	if len(f.args) > 1 {
+		var ok bool
+		srs, ok, err = f.evalSRID(ctx, row, 1)
+		if err != nil || !ok {
+			return nil, err
+		}
+	}
+	swap, err := f.evalSwapsAxes(ctx, row, 2, srs)
+	if err != nil {
+		return nil, err
+	}
+
+	var g sql.GeometryValue
+	var ok bool
+	if f.binary {
+		var b interface{}
+		b, err = sql.LongBlob.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		g, ok = sql.GeometryFromWKB([]byte(b.(string)), srs.ID)
+	} else {
+		var s interface{}
+		s, err = sql.LongText.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		g, ok = sql.GeometryFromWKT(s.(string), srs.ID)
+	}
+	if !ok || (f.geomType != "" && g.GeometryType() != f.geomType) {
+		return nil, sql.ErrInvalidGISData.New(f.name)
+	}
+
+	if swap {
+		g = sql.SwapAxes(g)
+	}
+	if err := f.validateSRS(g); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// AsText is ST_AsText or ST_AsBinary, which return the well-known text or binary of a geometry. They take options for
+// the axis order.
+// https://dev.mysql.com/doc/refman/8.0/en/gis-format-conversion-functions.html
+type AsText struct {
+	spatialFunc
+	binary bool
+}
+
+var _ sql.FunctionExpression = (*AsText)(nil)
+
+// NewAsTextFunc returns an AsText creator function of the name given, which returns well-known binary if binary is
+// true.
+func NewAsTextFunc(name string, binary bool) func(ctx *sql.Context, args ...sql.Expression) (sql.Expression, error) {
+	return func(ctx *sql.Context, args ...sql.Expression) (sql.Expression, error) {
+		if err := checkArgumentNumber(name, args, 1, 2); err != nil {
+			return nil, err
+		}
+		return &AsText{spatialFunc: spatialFunc{name: name, args: args}, binary: binary}, nil
+	}
+}
+
+// Type implements the sql.Expression interface.
+func (f *AsText) Type() sql.Type {
+	if f.binary {
+		return sql.LongBlob
+	}
+	return sql.LongText
+}
+
+// WithChildren implements the sql.Expression interface.
+func (f *AsText) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	sf, err := f.withChildren(children)
+	if err != nil {
+		return nil, err
+	}
+	return &AsText{spatialFunc: sf, binary: f.binary}, nil
+}
+
+// Eval implements the sql.Expression interface.
+func (f *AsText) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	g, err := f.evalGeometry(ctx, row, 0)
+	if err != nil || g == nil {
+		return nil, err
+	}
+	srs, err := sql.LookupSpatialReferenceSystem(g.GetSRID())
+	if err != nil {
+		return nil, err
+	}
+	swap, err := f.evalSwapsAxes(ctx, row, 1, srs)
+	if err != nil {
+		return nil, err
+	}
+	if swap {
+		g = sql.SwapAxes(g)
+	}
+
+	if f.binary {
+		return string(sql.GeometryToWKB(g)), nil
+	}
+	return sql.GeometryToWKT(g), nil
+}
+
+// GeometryConstructor is one of the functions which make a geometry of their arguments, such as Point and LineString.
+// https://dev.mysql.com/doc/refman/8.0/en/gis-mysql-specific-functions.html
+type GeometryConstructor struct {
+	spatialFunc
+	geomType string
+}
+
+var _ sql.FunctionExpression = (*GeometryConstructor)(nil)
+
+// NewGeometryConstructorFunc returns a GeometryConstructor creator function of the name given, which makes geometries
+// of a type.
+func NewGeometryConstructorFunc(name string, geomType string) func(ctx *sql.Context, args ...sql.Expression) (sql.Expression, error) {
+	return func(ctx *sql.Context, args ...sql.Expression) (sql.Expression, error) {
+		var err error
+		switch geomType {
+		case "POINT":
+			err = checkArgumentNumber(name, args, 2, 2)
+		case "GEOMETRYCOLLECTION":
+		default:
+			err = checkArgumentNumber(name, args, 1, math.MaxInt32)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &GeometryConstructor{spatialFunc: spatialFunc{name: name, args: args}, geomType: geomType}, nil
+	}
+}
+
+// Type implements the sql.Expression interface.
+func (f *GeometryConstructor) Type() sql.Type {
+	return sql.MustCreateSpatialType(f.geomType, 0, false)
+}
+
+// WithChildren implements the sql.Expression interface.
+func (f *GeometryConstructor) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	sf, err := f.withChildren(children)
+	if err != nil {
+		return nil, err
+	}
+	return &GeometryConstructor{spatialFunc: sf, geomType: f.geomType}, nil
+}
+
+// Eval implements the sql.Expression interface.
+func (f *GeometryConstructor) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	if f.geomType == "POINT" {
+		var coordinates [2]float64
+		for i := range coordinates {
+			v, err := f.evalArg(ctx, row, i)
+			if err != nil || v == nil {
+				return nil, err
+			}
+			v, err = sql.Float64.Convert(v)
+			if err != nil {
+				return nil, err
+			}
+			coordinates[i] = v.(float64)
+		}
+		return sql.Point{X: coordinates[0], Y: coordinates[1]}, nil
+	}
+
+	geometries := make([]sql.GeometryValue, len(f.args))
+	for i := range f.args {
+		g, err := f.evalGeometry(ctx, row, i)
+		if err != nil || g == nil {
+			return nil, err
+		}
+		if i > 0 {
+			if err := f.checkSameSRID(geometries[0], g); err != nil {
+				return nil, err
+			}
+		}
+		geometries[i] = g
+	}
+
+	var g sql.GeometryValue
+	var ok bool
+	switch f.geomType {
+	case "LINESTRING":
+		var points []sql.Point
+		points, ok = pointsOf(geometries)
+		g = sql.LineString{Points: points}
+		ok = ok && len(points) >= 2
+	case "MULTIPOINT":
+		var points []sql.Point
+		points, ok = pointsOf(geometries)
+		g = sql.MultiPoint{Points: points}
+	case "POLYGON":
+		var rings []sql.LineString
+		rings, ok = ringsOf(geometries)
+		g = sql.Polygon{Rings: rings}
+	case "MULTILINESTRING":
+		var lines []sql.LineString
+		lines, ok = lineStringsOf(geometries)
+		g = sql.MultiLineString{LineStrings: lines}
+	case "MULTIPOLYGON":
+		polygons := make([]sql.Polygon, len(geometries))
+		ok = true
+		for i, c := range geometries {
+			polygons[i], ok = c.(sql.Polygon)
+			if !ok {
+				break
+			}
+		}
+		g = sql.MultiPolygon{Polygons: polygons}
+	case "GEOMETRYCOLLECTION":
+		g, ok = sql.GeometryCollection{Geometries: geometries}, true
+	}
+	if !ok {
+		return nil, sql.ErrInvalidGISData.New(f.name)
+	}
+
+	if len(geometries) > 0 {
+		g = g.SetSRID(geometries[0].GetSRID())
+	}
+	return g, nil
+}
+
+func pointsOf(geometries []sql.GeometryValue) ([]sql.Point, bool) {
+	points := make([]sql.Point, len(geometries))
+	for i, g := range geometries {
+		p, ok := g.(sql.Point)
+		if !ok {
+			return nil, false
+		}
+		points[i] = sql.Point{X: p.X, Y: p.Y}
+	}
+	return points, true
+}
+
+func lineStringsOf(geometries []sql.GeometryValue) ([]sql.LineString, bool) {
+	lines := make([]sql.LineString, len(geometries))
+	for i, g := range geometries {
+		l, ok := g.(sql.LineString)
+		if !ok {
+			return nil, false
+		}
+		lines[i] = sql.LineString{Points: l.Points}
+	}
+	return lines, true
+}
+
+func ringsOf(geometries []sql.GeometryValue) ([]sql.LineString, bool) {
+	rings, ok := lineStringsOf(geometries)
+	if !ok {
+		return nil, false
+	}
+	for _, r := range rings {
+		if len(r.Points) < 4 || r.Points[0] != r.Points[len(r.Points)-1] {
+			return nil, false
+		}
+	}
+	return rings, true
+}
+
+type coordinate byte
+
+const (
+	coordinateX coordinate = iota
+	coordinateY
+	coordinateLatitude
+	coordinateLongitude
+)
+
+// PointCoordinate is one of the functions which return a coordinate of a point, such as ST_X and ST_Latitude. Given a
+// second argument, they return the point with the coordinate set to it. ST_X and ST_Y return the first and second
+// coordinates in the axis order of the spatial reference system of the point.
+// https://dev.mysql.com/doc/refman/8.0/en/gis-point-property-functions.html
+type PointCoordinate struct {
+	spatialFunc
+	coordinate coordinate
+}
+
+var _ sql.FunctionExpression = (*PointCoordinate)(nil)
+
+// NewPointCoordinateFunc returns a PointCoordinate creator function of the name given, which returns a coordinate.
+func NewPointCoordinateFunc(name string, c coordinate) func(ctx *sql.Context, args ...sql.Expression) (sql.Expression, error) {
+	return func(ctx *sql.Context, args ...sql.Expression) (sql.Expression, error) {
+		if err := checkArgumentNumber(name, args, 1, 2); err != nil {
+			return nil, err
+		}
+		return &PointCoordinate{spatialFunc: spatialFunc{name: name, args: args}, coordinate: c}, nil
+	}
+}
+
+// Type implements the sql.Expression interface.
+func (f *PointCoordinate) Type() sql.Type {
+	if len(f.args) > 1 {
+		return sql.MustCreateSpatialType("POINT", 0, false)
+	}
+	return sql.Float64
+}
+
+// WithChildren implements the sql.Expression interface.
+func (f *PointCoordinate) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	sf, err := f.withChildren(children)
+	if err != nil {
+		return nil, err
+	}
+	return &PointCoordinate{spatialFunc: sf, coordinate: f.coordinate}, nil
+}
+
+// Eval implements the sql.Expression interface.
+func (f *PointCoordinate) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	g, err := f.evalGeometry(ctx, row, 0)
+	if err != nil || g == nil {
+		return nil, err
+	}
+	p, ok := g.(sql.Point)
+	if !ok {
+		return nil, sql.ErrUnsupportedGeometryArgument.New(f.name)
+	}
+	srs, err := sql.LookupSpatialReferenceSystem(p.SRID)
+	if err != nil {
+		return nil, err
+	}
+
+	var y bool
+	switch f.coordinate {
+	case coordinateX:
+		y = srs.LatLong
+	case coordinateY:
+		y = !srs.LatLong
+	case coordinateLatitude, coordinateLongitude:
+		if !srs.Geographic {
+			return nil, ErrSRSNotGeographic.New(f.name, p.SRID)
+		}
+		y = f.coordinate == coordinateLatitude
+	}
+
+	if len(f.args) == 1 {
+		if y {
+			return p.Y, nil
+		}
+		return p.X, nil
+	}
+
+	v, err := f.evalArg(ctx, row, 1)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	v, err = sql.Float64.Convert(v)
+	if err != nil {
+		return nil, err
+	}
+	if y {
+		p.Y = v.(float64)
+	} else {
+		p.X = v.(float64)
+	}
+	if err := f.validateSRS(p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// SRID is the ST_SRID function, which returns the SRID of a geometry, or the geometry with the SRID given as its second
+// argument, whose coordinates are kept as they are.
+// https://dev.mysql.com/doc/refman/8.0/en/gis-general-property-functions.html#function_st-srid
+type SRID struct {
+	spatialFunc
+}
+
+var _ sql.FunctionExpression = (*SRID)(nil)
+
+// NewSRID creates a new SRID expression.
+func NewSRID(ctx *sql.Context, args ...sql.Expression) (sql.Expression, error) {
+	if err := checkArgumentNumber("st_srid", args, 1, 2); err != nil {
+		return nil, err
+	}
+	return &SRID{spatialFunc{name: "st_srid", args: args}}, nil
+}
+
+// Type implements the sql.Expression interface.
+func (f *SRID) Type() sql.Type {
+	if len(f.args) > 1 {
+		return sql.Geometry
+	}
+	return sql.Uint32
+}
+
+// WithChildren implements the sql.Expression interface.
+func (f *SRID) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	sf, err := f.withChildren(children)
+	if err != nil {
+		return nil, err
+	}
+	return &SRID{sf}, nil
+}
+
+// Eval implements the sql.Expression interface.
+func (f *SRID) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	g, err := f.evalGeometry(ctx, row, 0)
+	if err != nil || g == nil {
+		return nil, err
+	}
+	if len(f.args) == 1 {
+		return g.GetSRID(), nil
+	}
+
+	srs, ok, err := f.evalSRID(ctx, row, 1)
+	if err != nil || !ok {
+		return nil, err
+	}
+	g = g.SetSRID(srs.ID)
+	if err := f.validateSRS(g); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// GeometryType is the ST_GeometryType function, which returns the name of the type of a geometry.
+// https://dev.mysql.com/doc/refman/8.0/en/gis-general-property-functions.html#function_st-geometrytype
+type GeometryType struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*GeometryType)(nil)
+
+// NewGeometryType creates a new GeometryType expression.
+func NewGeometryType(ctx *sql.Context, arg sql.Expression) sql.Expression {
+	return &GeometryType{NewUnaryFunc(arg, "ST_GEOMETRYTYPE", sql.LongText)}
+}
+
+// Eval implements the sql.Expression interface.
+func (f *GeometryType) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	g, err := evalUnaryGeometry(ctx, row, f.UnaryFunc)
+	if err != nil || g == nil {
+		return nil, err
+	}
+	return g.GeometryType(), nil
+}
+
+// WithChildren implements the sql.Expression interface.
+func (f *GeometryType) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 1)
+	}
+	return NewGeometryType(ctx, children[0]), nil
+}
+
+// Dimension is the ST_Dimension function, which returns the dimension of a geometry.
+// https://dev.mysql.com/doc/refman/8.0/en/gis-general-property-functions.html#function_st-dimension
+type Dimension struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*Dimension)(nil)
+
+// NewDimension creates a new Dimension expression.
+func NewDimension(ctx *sql.Context, arg sql.Expression) sql.Expression {
+	return &Dimension{NewUnaryFunc(arg, "ST_DIMENSION", sql.Int32)}
+}
+
+// Eval implements the sql.Expression interface.
+func (f *Dimension) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	g, err := evalUnaryGeometry(ctx, row, f.UnaryFunc)
+	if err != nil || g == nil {
+		return nil, err
+	}
+	return int32(g.Dimension()), nil
+}
+
+// WithChildren implements the sql.Expression interface.
+func (f *Dimension) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 1)
+	}
+	return NewDimension(ctx, children[0]), nil
+}
+
+// IsEmpty is the ST_IsEmpty function, which returns whether a geometry has no points.
+// https://dev.mysql.com/doc/refman/8.0/en/gis-general-property-functions.html#function_st-isempty
+type IsEmpty struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*IsEmpty)(nil)
+
+// NewIsEmpty creates a new IsEmpty expression.
+func NewIsEmpty(ctx *sql.Context, arg sql.Expression) sql.Expression {
+	return &IsEmpty{NewUnaryFunc(arg, "ST_ISEMPTY", sql.Boolean)}
+}
+
+// Eval implements the sql.Expression interface.
+func (f *IsEmpty) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	g, err := evalUnaryGeometry(ctx, row, f.UnaryFunc)
+	if err != nil || g == nil {
+		return nil, err
+	}
+	return g.IsEmpty(), nil
+}
+
+// WithChildren implements the sql.Expression interface.
+func (f *IsEmpty) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 1)
+	}
+	return NewIsEmpty(ctx, children[0]), nil
+}
+
+// Envelope is the ST_Envelope function, which returns the minimum bounding rectangle of a geometry as a geometry.
+// https://dev.mysql.com/doc/refman/8.0/en/gis-general-property-functions.html#function_st-envelope
+type Envelope struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*Envelope)(nil)
+
+// NewEnvelope creates a new Envelope expression.
+func NewEnvelope(ctx *sql.Context, arg sql.Expression) sql.Expression {
+	return &Envelope{NewUnaryFunc(arg, "ST_ENVELOPE", sql.Geometry)}
+}
+
+// Eval implements the sql.Expression interface.
+func (f *Envelope) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	g, err := evalUnaryGeometry(ctx, row, f.UnaryFunc)
+	if err != nil || g == nil {
+		return nil, err
+	}
+	mbr, ok := sql.GeometryMBR(g)
+	if !ok {
+		return nil, nil
+	}
+	return mbr.Geometry(g.GetSRID()), nil
+}
+
+// WithChildren implements the sql.Expression interface.
+func (f *Envelope) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 1)
+	}
+	return NewEnvelope(ctx, children[0]), nil
+}
+
+func evalUnaryGeometry(ctx *sql.Context, row sql.Row, f *UnaryFunc) (sql.GeometryValue, error) {
+	sf := spatialFunc{name: strings.ToLower(f.Name), args: []sql.Expression{f.Child}}
+	return sf.evalGeometry(ctx, row, 0)
+}
+
+// Distance is the ST_Distance function, which returns the shortest distance between two geometries on the plane.
+// https://dev.mysql.com/doc/refman/8.0/en/spatial-relation-functions-object-shapes.html#function_st-distance
+type Distance struct {
+	spatialFunc
+}
+
+var _ sql.FunctionExpression = (*Distance)(nil)
+
+// NewDistance creates a new Distance expression.
+func NewDistance(ctx *sql.Context, g1, g2 sql.Expression) sql.Expression {
+	return &Distance{spatialFunc{name: "st_distance", args: []sql.Expression{g1, g2}}}
+}
+
+// Type implements the sql.Expression interface.
+func (f *Distance) Type() sql.Type {
+	return sql.Float64
+}
+
+// WithChildren implements the sql.Expression interface.
+func (f *Distance) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 2)
+	}
+	return NewDistance(ctx, children[0], children[1]), nil
+}
+
+// Eval implements the sql.Expression interface.
+func (f *Distance) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	a, b, err := f.evalGeometries(ctx, row)
+	if err != nil || a == nil || b == nil {
+		return nil, err
+	}
+	srs, err := sql.LookupSpatialReferenceSystem(a.GetSRID())
+	if err != nil {
+		return nil, err
+	}
+	if srs.Geographic {
+		return nil, sql.ErrNotImplementedForGeographicSRS.New(f.name)
+	}
+	if a.IsEmpty() || b.IsEmpty() {
+		return nil, nil
+	}
+	return cartesianDistance(a, b), nil
+}
+
+// evalGeometries evaluates the first two arguments, which are geometries of the same spatial reference system.
+func (f *spatialFunc) evalGeometries(ctx *sql.Context, row sql.Row) (sql.GeometryValue, sql.GeometryValue, error) {
+	a, err := f.evalGeometry(ctx, row, 0)
+	if err != nil || a == nil {
+		return nil, nil, err
+	}
+	b, err := f.evalGeometry(ctx, row, 1)
+	if err != nil || b == nil {
+		return nil, nil, err
+	}
+	if err := f.checkSameSRID(a, b); err != nil {
+		return nil, nil, err
+	}
+	return a, b, nil
+}
+
+// DistanceSphere is the ST_Distance_Sphere function, which returns the shortest distance on a sphere between two
+// points or multipoints, whose X coordinates are longitudes and Y coordinates latitudes in degrees.
+// https://dev.mysql.com/doc/refman/8.0/en/spatial-convenience-functions.html#function_st-distance-sphere
+type DistanceSphere struct {
+	spatialFunc
+}
+
+var _ sql.FunctionExpression = (*DistanceSphere)(nil)
+
+// NewDistanceSphere creates a new DistanceSphere expression.
+func NewDistanceSphere(ctx *sql.Context, args ...sql.Expression) (sql.Expression, error) {
+	if err := checkArgumentNumber("st_distance_sphere", args, 2, 3); err != nil {
+		return nil, err
+	}
+	return &DistanceSphere{spatialFunc{name: "st_distance_sphere", args: args}}, nil
+}
+
+// Type implements the sql.Expression interface.
+func (f *DistanceSphere) Type() sql.Type {
+	return sql.Float64
+}
+
+// WithChildren implements the sql.Expression interface.
+func (f *DistanceSphere) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	sf, err := f.withChildren(children)
+	if err != nil {
+		return nil, err
+	}
+	return &DistanceSphere{sf}, nil
+}
+
+// Eval implements the sql.Expression interface.
+func (f *DistanceSphere) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	a, b, err := f.evalGeometries(ctx, row)
+	if err != nil || a == nil || b == nil {
+		return nil, err
+	}
+
+	radius := float64(defaultSphereRadius)
+	if len(f.args) > 2 {
+		v, err := f.evalArg(ctx, row, 2)
+		if err != nil || v == nil {
+			return nil, err
+		}
+		v, err = sql.Float64.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		radius = v.(float64)
+		if radius <= 0 {
+			return nil, ErrNonPositiveRadius.New(f.name)
+		}
+	}
+
+	srs, err := sql.LookupSpatialReferenceSystem(a.GetSRID())
+	if err != nil {
+		return nil, err
+	}
+	if srs.ID != 0 && !srs.Geographic {
+		return nil, sql.ErrUnsupportedGeometryArgument.New(f.name)
+	}
+	pa, ok := f.sphereArgument(a)
+	if !ok {
+		return nil, sql.ErrUnsupportedGeometryArgument.New(f.name)
+	}
+	pb, ok := f.sphereArgument(b)
+	if !ok {
+		return nil, sql.ErrUnsupportedGeometryArgument.New(f.name)
+	}
+	for _, g := range []sql.GeometryValue{a, b} {
+		if err := sql.ValidateGeographicCoordinates(g, f.name); err != nil {
+			return nil, err
+		}
+	}
+	if len(pa) == 0 || len(pb) == 0 {
+		return nil, nil
+	}
+
+	distance := math.Inf(1)
+	for _, p := range pa {
+		for _, q := range pb {
+			distance = math.Min(distance, haversine(p, q, radius))
+		}
+	}
+	return distance, nil
+}
+
+// sphereArgument returns the points of an argument of ST_Distance_Sphere, which must be a point or multipoint.
+func (f *DistanceSphere) sphereArgument(g sql.GeometryValue) ([]sql.Point, bool) {
+	switch g := g.(type) {
+	case sql.Point:
+		return []sql.Point{g}, true
+	case sql.MultiPoint:
+		return g.Points, true
+	default:
+		return nil, false
+	}
+}
+
+// haversine returns the distance between two points on a sphere of the radius given, whose X coordinates are
+// longitudes and Y coordinates latitudes in degrees.
+func haversine(p, q sql.Point, radius float64) float64 {
+	toRadians := func(d float64) float64 { return d * math.Pi / 180 }
+	lat1, lat2 := toRadians(p.Y), toRadians(q.Y)
+	dLat, dLong := lat2-lat1, toRadians(q.X-p.X)
+	h := math.Pow(math.Sin(dLat/2), 2) + math.Cos(lat1)*math.Cos(lat2)*math.Pow(math.Sin(dLong/2), 2)
+	return 2 * radius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+type spatialRelation byte
+
+const (
+	relationContains spatialRelation = iota
+	relationWithin
+	relationIntersects
+	relationDisjoint
+	relationEquals
+	relationTouches
+	relationOverlaps
+	relationCovers
+	relationCoveredBy
+)
+
+// SpatialPredicate is one of the functions which return whether two geometries are in a spatial relation, such as
+// ST_Contains, or whether their minimum bounding rectangles are, such as MBRContains.
+// https://dev.mysql.com/doc/refman/8.0/en/spatial-relation-functions.html
+type SpatialPredicate struct {
+	expression.BinaryExpression
+	name     string
+	relation spatialRelation
+	// mbr is whether the relation is between the minimum bounding rectangles of the geometries.
+	mbr bool
+}
+
+var _ sql.FunctionExpression = (*SpatialPredicate)(nil)
+var _ sql.SpatialRelation = (*SpatialPredicate)(nil)
+
+// NewSpatialPredicateFunc returns a SpatialPredicate creator function of the name given, for a relation between two
+// geometries, or their minimum bounding rectangles if mbr is true.
+func NewSpatialPredicateFunc(name string, relation spatialRelation, mbr bool) func(ctx *sql.Context, g1, g2 sql.Expression) sql.Expression {
+	return func(ctx *sql.Context, g1, g2 sql.Expression) sql.Expression {
+		return &SpatialPredicate{
+			BinaryExpression: expression.BinaryExpression{Left: g1, Right: g2},
+			name:             name,
+			relation:         relation,
+			mbr:              mbr,
+		}
+	}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (f *SpatialPredicate) FunctionName() string {
+	return f.name
+}
+
+// Type implements the sql.Expression interface.
+func (f *SpatialPredicate) Type() sql.Type {
+	return sql.Boolean
+}
+
+// IsNullable implements the sql.Expression interface.
+func (f *SpatialPredicate) IsNullable() bool {
+	return true
+}
+
+func (f *SpatialPredicate) String() string {
+	return fmt.Sprintf("%s(%s, %s)", f.name, f.Left, f.Right)
+}
+
+// WithChildren implements the sql.Expression interface.
+func (f *SpatialPredicate) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 2)
+	}
+	return NewSpatialPredicateFunc(f.name, f.relation, f.mbr)(ctx, children[0], children[1]), nil
+}
+
+// IntersectingArguments implements the sql.SpatialRelation interface. Every relation but the disjoint one only holds
+// for geometries whose minimum bounding rectangles intersect.
+func (f *SpatialPredicate) IntersectingArguments() (sql.Expression, sql.Expression, bool) {
+	return f.Left, f.Right, f.relation != relationDisjoint
+}
+
+// Eval implements the sql.Expression interface. It returns NULL if either geometry is empty.
+func (f *SpatialPredicate) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	sf := spatialFunc{name: f.name, args: []sql.Expression{f.Left, f.Right}}
+	a, b, err := sf.evalGeometries(ctx, row)
+	if err != nil || a == nil || b == nil {
+		return nil, err
+	}
+	ma, ok := sql.GeometryMBR(a)
+	if !ok {
+		return nil, nil
+	}
+	mb, ok := sql.GeometryMBR(b)
+	if !ok {
+		return nil, nil
+	}
+
+	if f.mbr {
+		switch f.relation {
+		case relationContains:
+			return ma.Contains(mb), nil
+		case relationWithin:
+			return mb.Contains(ma), nil
+		case relationIntersects:
+			return ma.Intersects(mb), nil
+		case relationDisjoint:
+			return !ma.Intersects(mb), nil
+		case relationEquals:
+			return ma.Equals(mb), nil
+		case relationTouches:
+			return ma.Touches(mb), nil
+		case relationOverlaps:
+			return ma.Overlaps(mb), nil
+		case relationCovers:
+			return ma.Covers(mb), nil
+		case relationCoveredBy:
+			return mb.Covers(ma), nil
+		}
+	}
+
+	switch f.relation {
+	case relationContains:
+		return ma.Covers(mb) && geometryContains(a, b), nil
+	case relationWithin:
+		return mb.Covers(ma) && geometryContains(b, a), nil
+	case relationIntersects:
+		return ma.Intersects(mb) && geometriesIntersect(a, b), nil
+	case relationDisjoint:
+		return !ma.Intersects(mb) || !geometriesIntersect(a, b), nil
+	case relationEquals:
+		return ma.Equals(mb) && geometriesEqual(a, b), nil
+	default:
+		return nil, sql.ErrUnsupportedGeometryArgument.New(f.name)
+	}
+}
@@ -0,0 +1,435 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"math"
+	"sort"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// The relations between geometries are computed on the plane, with the longitudes and latitudes of geographic
+// geometries taken as their X and Y coordinates, which is close enough for the small areas they're mostly used on.
+
+// components returns the points, lines and polygons a geometry is made of.
+func components(g sql.GeometryValue) []sql.GeometryValue {
+	switch g := g.(type) {
+	case sql.MultiPoint:
+		c := make([]sql.GeometryValue, len(g.Points))
+		for i, p := range g.Points {
+			c[i] = p
+		}
+		return c
+	case sql.MultiLineString:
+		c := make([]sql.GeometryValue, len(g.LineStrings))
+		for i, l := range g.LineStrings {
+			c[i] = l
+		}
+		return c
+	case sql.MultiPolygon:
+		c := make([]sql.GeometryValue, len(g.Polygons))
+		for i, p := range g.Polygons {
+			c[i] = p
+		}
+		return c
+	case sql.GeometryCollection:
+		var c []sql.GeometryValue
+		for _, child := range g.Geometries {
+			c = append(c, components(child)...)
+		}
+		return c
+	default:
+		return []sql.GeometryValue{g}
+	}
+}
+
+// geometriesIntersect returns whether two geometries have a point in common.
+func geometriesIntersect(a, b sql.GeometryValue) bool {
+	for _, ca := range components(a) {
+		for _, cb := range components(b) {
+			if componentsIntersect(ca, cb) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// geometryContains returns whether every point of b is a point of a, and their interiors have a point in common.
+func geometryContains(a, b sql.GeometryValue) bool {
+	cb := components(b)
+	if len(cb) == 0 {
+		return false
+	}
+	interior := false
+	for _, c := range cb {
+		covered := false
+		for _, ca := range components(a) {
+			if ok, i := componentCovers(ca, c); ok {
+				covered = true
+				interior = interior || i
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return interior
+}
+
+// geometryCovers returns whether every point of b is a point of a.
+func geometryCovers(a, b sql.GeometryValue) bool {
+	for _, c := range components(b) {
+		covered := false
+		for _, ca := range components(a) {
+			if ok, _ := componentCovers(ca, c); ok {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+// geometriesEqual returns whether two geometries have the same points.
+func geometriesEqual(a, b sql.GeometryValue) bool {
+	if a.IsEmpty() || b.IsEmpty() {
+		return a.IsEmpty() && b.IsEmpty()
+	}
+	return geometryCovers(a, b) && geometryCovers(b, a)
+}
+
+func componentsIntersect(a, b sql.GeometryValue) bool {
+	switch a := a.(type) {
+	case sql.Point:
+		switch b := b.(type) {
+		case sql.Point:
+			return a.X == b.X && a.Y == b.Y
+		case sql.LineString:
+			return pointOnLine(a, b.Points)
+		case sql.Polygon:
+			return pointInPolygon(a, b) >= 0
+		}
+	case sql.LineString:
+		switch b := b.(type) {
+		case sql.Point:
+			return componentsIntersect(b, a)
+		case sql.LineString:
+			return linesIntersect(a.Points, b.Points)
+		case sql.Polygon:
+			if pointInPolygon(a.Points[0], b) >= 0 {
+				return true
+			}
+			for _, r := range b.Rings {
+				if linesIntersect(a.Points, r.Points) {
+					return true
+				}
+			}
+			return false
+		}
+	case sql.Polygon:
+		switch b := b.(type) {
+		case sql.Point, sql.LineString:
+			return componentsIntersect(b, a)
+		case sql.Polygon:
+			if pointInPolygon(a.Rings[0].Points[0], b) >= 0 || pointInPolygon(b.Rings[0].Points[0], a) >= 0 {
+				return true
+			}
+			for _, ra := range a.Rings {
+				for _, rb := range b.Rings {
+					if linesIntersect(ra.Points, rb.Points) {
+						return true
+					}
+				}
+			}
+			return false
+		}
+	}
+	return false
+}
+
+// componentCovers returns whether every point of b is a point of a, and whether the interiors of a and b have a point
+// in common if so.
+func componentCovers(a, b sql.GeometryValue) (covered bool, interior bool) {
+	switch a := a.(type) {
+	case sql.Point:
+		if b, ok := b.(sql.Point); ok && a.X == b.X && a.Y == b.Y {
+			return true, true
+		}
+		return false, false
+	case sql.LineString:
+		switch b := b.(type) {
+		case sql.Point:
+			if !pointOnLine(b, a.Points) {
+				return false, false
+			}
+			return true, !onLineBoundary(b, a.Points)
+		case sql.LineString:
+			for i := 1; i < len(b.Points); i++ {
+				for _, m := range segmentPieces(b.Points[i-1], b.Points[i], a.Points) {
+					if !pointOnLine(m, a.Points) {
+						return false, false
+					}
+				}
+			}
+			return true, true
+		}
+		return false, false
+	case sql.Polygon:
+		switch b := b.(type) {
+		case sql.Point:
+			in := pointInPolygon(b, a)
+			return in >= 0, in > 0
+		case sql.LineString:
+			return lineInPolygon(b.Points, a)
+		case sql.Polygon:
+			if ok, _ := lineInPolygon(b.Rings[0].Points, a); !ok {
+				return false, false
+			}
+			// A hole of a which is inside b leaves a part of b out of a.
+			for _, hole := range a.Rings[1:] {
+				for _, p := range hole.Points {
+					if pointInPolygon(p, b) > 0 {
+						return false, false
+					}
+				}
+			}
+			return true, true
+		}
+	}
+	return false, false
+}
+
+// lineInPolygon returns whether every point of a line is a point of a polygon, and whether a point of the line is in
+// the interior of the polygon if so.
+func lineInPolygon(line []sql.Point, polygon sql.Polygon) (covered bool, interior bool) {
+	rings := make([][]sql.Point, len(polygon.Rings))
+	for i, r := range polygon.Rings {
+		rings[i] = r.Points
+	}
+	for i := 1; i < len(line); i++ {
+		for _, m := range segmentPieces(line[i-1], line[i], rings...) {
+			switch pointInPolygon(m, polygon) {
+			case -1:
+				return false, false
+			case 1:
+				interior = true
+			}
+		}
+	}
+	return true, interior
+}
+
+// segmentPieces splits the segment from a to b at the points it meets the lines given, and returns the midpoints of
+// the pieces, each of which is inside or outside of anything the lines bound, or on the lines, as a whole.
+func segmentPieces(a, b sql.Point, lines ...[]sql.Point) []sql.Point {
+	ts := []float64{0, 1}
+	dx, dy := b.X-a.X, b.Y-a.Y
+	length := dx*dx + dy*dy
+	if length == 0 {
+		return []sql.Point{a}
+	}
+	param := func(p sql.Point) float64 {
+		return ((p.X-a.X)*dx + (p.Y-a.Y)*dy) / length
+	}
+	for _, line := range lines {
+		for i := range line {
+			if pointOnSegment(line[i], a, b) {
+				ts = append(ts, param(line[i]))
+			}
+			if i > 0 {
+				if p, ok := segmentIntersection(a, b, line[i-1], line[i]); ok {
+					ts = append(ts, param(p))
+				}
+			}
+		}
+	}
+	sort.Float64s(ts)
+
+	var midpoints []sql.Point
+	for i := 1; i < len(ts); i++ {
+		if ts[i] == ts[i-1] {
+			continue
+		}
+		t := (ts[i-1] + ts[i]) / 2
+		midpoints = append(midpoints, sql.Point{X: a.X + t*dx, Y: a.Y + t*dy})
+	}
+	return midpoints
+}
+
+// onLineBoundary returns whether a point is on the boundary of a line, which is its end points unless it's closed.
+func onLineBoundary(p sql.Point, line []sql.Point) bool {
+	first, last := line[0], line[len(line)-1]
+	if first.X == last.X && first.Y == last.Y {
+		return false
+	}
+	return p.X == first.X && p.Y == first.Y || p.X == last.X && p.Y == last.Y
+}
+
+func pointOnLine(p sql.Point, line []sql.Point) bool {
+	for i := 1; i < len(line); i++ {
+		if pointOnSegment(p, line[i-1], line[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func linesIntersect(a, b []sql.Point) bool {
+	for i := 1; i < len(a); i++ {
+		for j := 1; j < len(b); j++ {
+			if segmentsIntersect(a[i-1], a[i], b[j-1], b[j]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// pointInPolygon returns 1 if a point is in the interior of a polygon, 0 if it's on its boundary and -1 if it's
+// outside of it.
+func pointInPolygon(p sql.Point, polygon sql.Polygon) int {
+	for i, r := range polygon.Rings {
+		switch pointInRing(p, r.Points) {
+		case 0:
+			return 0
+		case 1:
+			if i > 0 {
+				return -1
+			}
+		case -1:
+			if i == 0 {
+				return -1
+			}
+		}
+	}
+	return 1
+}
+
+// pointInRing returns 1 if a point is inside a ring, 0 if it's on it and -1 if it's outside of it.
+func pointInRing(p sql.Point, ring []sql.Point) int {
+	inside := false
+	for i := 1; i < len(ring); i++ {
+		a, b := ring[i-1], ring[i]
+		if pointOnSegment(p, a, b) {
+			return 0
+		}
+		if (a.Y > p.Y) != (b.Y > p.Y) && p.X < (b.X-a.X)*(p.Y-a.Y)/(b.Y-a.Y)+a.X {
+			inside = !inside
+		}
+	}
+	if inside {
+		return 1
+	}
+	return -1
+}
+
+func cross(o, a, b sql.Point) float64 {
+	return (a.X-o.X)*(b.Y-o.Y) - (a.Y-o.Y)*(b.X-o.X)
+}
+
+// pointOnSegment returns whether a point is on a segment, with the tolerance of the rounding of the points computed
+// along segments.
+func pointOnSegment(p, a, b sql.Point) bool {
+	tolerance := 1e-12 * math.Hypot(b.X-a.X, b.Y-a.Y) * math.Hypot(p.X-a.X, p.Y-a.Y)
+	return math.Abs(cross(a, b, p)) <= tolerance &&
+		math.Min(a.X, b.X) <= p.X && p.X <= math.Max(a.X, b.X) &&
+		math.Min(a.Y, b.Y) <= p.Y && p.Y <= math.Max(a.Y, b.Y)
+}
+
+func segmentsIntersect(a, b, c, d sql.Point) bool {
+	d1, d2 := cross(c, d, a), cross(c, d, b)
+	d3, d4 := cross(a, b, c), cross(a, b, d)
+	if ((d1 > 0 && d2 < 0) || (d1 < 0 && d2 > 0)) && ((d3 > 0 && d4 < 0) || (d3 < 0 && d4 > 0)) {
+		return true
+	}
+	return pointOnSegment(a, c, d) || pointOnSegment(b, c, d) || pointOnSegment(c, a, b) || pointOnSegment(d, a, b)
+}
+
+// segmentIntersection returns the point where two segments cross, if they cross at a single point.
+func segmentIntersection(a, b, c, d sql.Point) (sql.Point, bool) {
+	denominator := (b.X-a.X)*(d.Y-c.Y) - (b.Y-a.Y)*(d.X-c.X)
+	if denominator == 0 {
+		return sql.Point{}, false
+	}
+	t := ((c.X-a.X)*(d.Y-c.Y) - (c.Y-a.Y)*(d.X-c.X)) / denominator
+	u := ((c.X-a.X)*(b.Y-a.Y) - (c.Y-a.Y)*(b.X-a.X)) / denominator
+	if t < 0 || t > 1 || u < 0 || u > 1 {
+		return sql.Point{}, false
+	}
+	return sql.Point{X: a.X + t*(b.X-a.X), Y: a.Y + t*(b.Y-a.Y)}, true
+}
+
+// cartesianDistance returns the shortest distance between two geometries on the plane.
+func cartesianDistance(a, b sql.GeometryValue) float64 {
+	if geometriesIntersect(a, b) {
+		return 0
+	}
+	distance := math.Inf(1)
+	for _, ca := range components(a) {
+		for _, cb := range components(b) {
+			for _, sa := range segments(ca) {
+				for _, sb := range segments(cb) {
+					distance = math.Min(distance, segmentDistance(sa[0], sa[1], sb[0], sb[1]))
+				}
+			}
+		}
+	}
+	return distance
+}
+
+// segments returns the segments of a point, line or polygon, of which the ones of points are degenerate.
+func segments(g sql.GeometryValue) [][2]sql.Point {
+	var s [][2]sql.Point
+	addLine := func(points []sql.Point) {
+		for i := 1; i < len(points); i++ {
+			s = append(s, [2]sql.Point{points[i-1], points[i]})
+		}
+	}
+	switch g := g.(type) {
+	case sql.Point:
+		s = append(s, [2]sql.Point{g, g})
+	case sql.LineString:
+		addLine(g.Points)
+	case sql.Polygon:
+		for _, r := range g.Rings {
+			addLine(r.Points)
+		}
+	}
+	return s
+}
+
+func segmentDistance(a, b, c, d sql.Point) float64 {
+	if segmentsIntersect(a, b, c, d) {
+		return 0
+	}
+	return math.Min(
+		math.Min(pointSegmentDistance(a, c, d), pointSegmentDistance(b, c, d)),
+		math.Min(pointSegmentDistance(c, a, b), pointSegmentDistance(d, a, b)),
+	)
+}
+
+func pointSegmentDistance(p, a, b sql.Point) float64 {
+	dx, dy := b.X-a.X, b.Y-a.Y
+	t := 0.0
+	if length := dx*dx + dy*dy; length > 0 {
+		t = math.Max(0, math.Min(1, ((p.X-a.X)*dx+(p.Y-a.Y)*dy)/length))
+	}
+	return math.Hypot(p.X-(a.X+t*dx), p.Y-(a.Y+t*dy))
+}
@@ -0,0 +1,133 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/linanh/go-mysql-server/sql"
+	"github.com/linanh/go-mysql-server/sql/expression"
+)
+
+func geomFromText(t *testing.T, wkt string, srid uint32) sql.Expression {
+	g, ok := sql.GeometryFromWKT(wkt, srid)
+	require.True(t, ok, wkt)
+	return expression.NewLiteral(g, sql.Geometry)
+}
+
+func TestGeomFromText(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []sql.Expression
+		expected interface{}
+		err      *errors.Kind
+	}{
+		{"point", []sql.Expression{expression.NewLiteral("POINT(1 2)", sql.LongText)}, sql.Point{X: 1, Y: 2}, nil},
+		{"null", []sql.Expression{expression.NewLiteral(nil, sql.Null)}, nil, nil},
+		{"geographic", []sql.Expression{expression.NewLiteral("POINT(10 20)", sql.LongText), expression.NewLiteral(int64(4326), sql.Int64)}, sql.Point{SRID: 4326, X: 20, Y: 10}, nil},
+		{"long-lat axis order", []sql.Expression{expression.NewLiteral("POINT(10 20)", sql.LongText), expression.NewLiteral(int64(4326), sql.Int64), expression.NewLiteral("axis-order=long-lat", sql.LongText)}, sql.Point{SRID: 4326, X: 10, Y: 20}, nil},
+		{"invalid", []sql.Expression{expression.NewLiteral("POINT(1)", sql.LongText)}, nil, sql.ErrInvalidGISData},
+		{"unknown srid", []sql.Expression{expression.NewLiteral("POINT(1 2)", sql.LongText), expression.NewLiteral(int64(1234), sql.Int64)}, nil, sql.ErrUnknownSRID},
+		{"latitude out of range", []sql.Expression{expression.NewLiteral("POINT(91 0)", sql.LongText), expression.NewLiteral(int64(4326), sql.Int64)}, nil, sql.ErrLatitudeOutOfRange},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			f, err := NewGeomFromTextFunc("st_geomfromtext", "", false)(sql.NewEmptyContext(), tt.args...)
+			require.NoError(err)
+			v, err := f.Eval(sql.NewEmptyContext(), nil)
+			if tt.err != nil {
+				require.Error(err)
+				require.True(tt.err.Is(err), "unexpected error %v", err)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tt.expected, v)
+		})
+	}
+}
+
+func TestAsText(t *testing.T) {
+	require := require.New(t)
+
+	f, err := NewAsTextFunc("st_astext", false)(sql.NewEmptyContext(), geomFromText(t, "LINESTRING(0 0, 1.5 2)", 0))
+	require.NoError(err)
+	v, err := f.Eval(sql.NewEmptyContext(), nil)
+	require.NoError(err)
+	require.Equal("LINESTRING(0 0,1.5 2)", v)
+
+	f, err = NewAsTextFunc("st_astext", false)(sql.NewEmptyContext(), expression.NewLiteral(sql.Point{SRID: 4326, X: 20, Y: 10}, sql.Geometry))
+	require.NoError(err)
+	v, err = f.Eval(sql.NewEmptyContext(), nil)
+	require.NoError(err)
+	require.Equal("POINT(10 20)", v)
+}
+
+func TestDistanceSphere(t *testing.T) {
+	require := require.New(t)
+
+	f, err := NewDistanceSphere(sql.NewEmptyContext(), geomFromText(t, "POINT(0 0)", 0), geomFromText(t, "POINT(180 0)", 0))
+	require.NoError(err)
+	v, err := f.Eval(sql.NewEmptyContext(), nil)
+	require.NoError(err)
+	require.InDelta(20015042.813723423, v, 1e-6)
+
+	f, err = NewDistanceSphere(sql.NewEmptyContext(), geomFromText(t, "POINT(0 0)", 0), geomFromText(t, "POINT(0 1)", 0), expression.NewLiteral(int64(-1), sql.Int64))
+	require.NoError(err)
+	_, err = f.Eval(sql.NewEmptyContext(), nil)
+	require.True(ErrNonPositiveRadius.Is(err))
+}
+
+func TestSpatialPredicate(t *testing.T) {
+	square := "POLYGON((0 0,10 0,10 10,0 10,0 0))"
+	testCases := []struct {
+		name     string
+		relation spatialRelation
+		mbr      bool
+		g1, g2   string
+		expected interface{}
+	}{
+		{"st_contains", relationContains, false, square, "POINT(5 5)", true},
+		{"st_contains", relationContains, false, square, "POINT(10 5)", false},
+		{"st_contains", relationContains, false, square, "LINESTRING(1 1,9 9)", true},
+		{"st_contains", relationContains, false, "POLYGON((0 0,10 0,0 10,0 0))", "POINT(9 9)", false},
+		{"st_within", relationWithin, false, "POINT(5 5)", square, true},
+		{"st_intersects", relationIntersects, false, "LINESTRING(-1 5,11 5)", square, true},
+		{"st_disjoint", relationDisjoint, false, "POINT(11 11)", square, true},
+		{"st_equals", relationEquals, false, "LINESTRING(0 0,1 1)", "LINESTRING(1 1,0 0)", true},
+		{"mbrcontains", relationContains, true, "POLYGON((0 0,10 0,0 10,0 0))", "POINT(9 9)", true},
+		{"mbrtouches", relationTouches, true, square, "POINT(10 5)", true},
+		{"mbrcoveredby", relationCoveredBy, true, "POINT(10 5)", square, true},
+		{"st_contains", relationContains, false, "GEOMETRYCOLLECTION EMPTY", "POINT(1 1)", nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name+" "+tt.g1+" "+tt.g2, func(t *testing.T) {
+			require := require.New(t)
+			f := NewSpatialPredicateFunc(tt.name, tt.relation, tt.mbr)(sql.NewEmptyContext(), geomFromText(t, tt.g1, 0), geomFromText(t, tt.g2, 0))
+			v, err := f.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(err)
+			require.Equal(tt.expected, v)
+		})
+	}
+
+	f := NewSpatialPredicateFunc("st_contains", relationContains, false)(sql.NewEmptyContext(), geomFromText(t, square, 0), geomFromText(t, "POINT(1 1)", 4326))
+	_, err := f.Eval(sql.NewEmptyContext(), nil)
+	require.True(t, sql.ErrDifferentSRIDs.Is(err))
+}
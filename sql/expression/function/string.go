@@ -103,6 +103,12 @@ func (h *Hex) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 
 	switch val := arg.(type) {
 	case string:
+		// The bytes of a string are the ones of its character set
+		if st, ok := h.Child.Type().(sql.StringType); ok && !st.CharacterSet().IsUnicode() {
+			if encoded, err := sql.ConvertString(val, st.CharacterSet(), sql.CharacterSet_binary); err == nil {
+				val = encoded
+			}
+		}
 		return hexForString(val), nil
 
 	case uint8, uint16, uint32, uint, int, int8, int16, int32, int64:
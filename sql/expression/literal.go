@@ -66,6 +66,8 @@ func (p *Literal) String() string {
 		return fmt.Sprintf("%q", v)
 	case []byte:
 		return "BLOB"
+	case sql.GeometryValue:
+		return sql.GeometryToWKT(v)
 	case nil:
 		return "NULL"
 	default:
@@ -0,0 +1,201 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// MatchAgainst represents MATCH (columns) AGAINST (search), which returns the relevance of the text of the columns of a
+// row to the search string. The columns must be the ones of a FULLTEXT index, which the analyzer sets on the expression
+// and whose statistics the relevance is computed with. Used as a condition, it holds for the rows which match the
+// search, as the analyzer makes it evaluate to whether they do rather than to their relevance.
+//
+// cc: https://dev.mysql.com/doc/refman/8.0/en/fulltext-search.html
+type MatchAgainst struct {
+	Columns []sql.Expression
+	Against sql.Expression
+	Mode    sql.FullTextMode
+
+	index     sql.FullTextIndex
+	parser    *sql.FullTextParser
+	condition bool
+
+	mu    sync.Mutex
+	query *sql.FullTextQuery
+	text  string
+}
+
+var _ sql.Expression = (*MatchAgainst)(nil)
+
+// NewMatchAgainst creates a new MatchAgainst expression.
+func NewMatchAgainst(columns []sql.Expression, against sql.Expression, mode sql.FullTextMode) *MatchAgainst {
+	return &MatchAgainst{
+		Columns: columns,
+		Against: against,
+		Mode:    mode,
+	}
+}
+
+// WithIndex returns a copy of the expression which searches the FULLTEXT index given, splitting text into words with
+// the parser given.
+func (m *MatchAgainst) WithIndex(index sql.FullTextIndex, parser *sql.FullTextParser) *MatchAgainst {
+	nm := NewMatchAgainst(m.Columns, m.Against, m.Mode)
+	nm.index, nm.parser, nm.condition = index, parser, m.condition
+	return nm
+}
+
+// AsCondition returns a copy of the expression which evaluates to whether rows match the search, for use as a filter.
+// In natural language mode, rows match if they're relevant at all.
+func (m *MatchAgainst) AsCondition() *MatchAgainst {
+	nm := NewMatchAgainst(m.Columns, m.Against, m.Mode)
+	nm.index, nm.parser, nm.condition = m.index, m.parser, true
+	return nm
+}
+
+// Index returns the FULLTEXT index the expression searches, or nil if it hasn't been set.
+func (m *MatchAgainst) Index() sql.FullTextIndex {
+	return m.index
+}
+
+// Parser returns the parser the expression splits text into words with.
+func (m *MatchAgainst) Parser() *sql.FullTextParser {
+	return m.parser
+}
+
+// Query returns the parsed search string.
+func (m *MatchAgainst) Query(ctx *sql.Context) (*sql.FullTextQuery, error) {
+	if m.parser == nil {
+		return nil, sql.ErrNoFullTextIndex.New()
+	}
+
+	val, err := m.Against.Eval(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if val == nil {
+		val = ""
+	}
+	text, err := sql.LongText.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.query == nil || m.text != text.(string) {
+		m.query = sql.ParseFullTextQuery(m.parser, text.(string), m.Mode)
+		m.text = text.(string)
+	}
+	return m.query, nil
+}
+
+// Resolved implements the Expression interface.
+func (m *MatchAgainst) Resolved() bool {
+	for _, c := range m.Columns {
+		if !c.Resolved() {
+			return false
+		}
+	}
+	return m.Against.Resolved()
+}
+
+// IsNullable implements the Expression interface.
+func (m *MatchAgainst) IsNullable() bool {
+	return false
+}
+
+// Type implements the Expression interface.
+func (m *MatchAgainst) Type() sql.Type {
+	if m.condition {
+		return sql.Boolean
+	}
+	return sql.Float64
+}
+
+// Children implements the Expression interface.
+func (m *MatchAgainst) Children() []sql.Expression {
+	children := make([]sql.Expression, len(m.Columns), len(m.Columns)+1)
+	copy(children, m.Columns)
+	return append(children, m.Against)
+}
+
+// String implements the fmt.Stringer interface.
+func (m *MatchAgainst) String() string {
+	columns := make([]string, len(m.Columns))
+	for i, c := range m.Columns {
+		columns[i] = c.String()
+	}
+	return fmt.Sprintf("MATCH (%s) AGAINST (%s %s)", strings.Join(columns, ", "), m.Against, m.Mode)
+}
+
+// Eval implements the Expression interface.
+func (m *MatchAgainst) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	if m.index == nil {
+		return nil, sql.ErrNoFullTextIndex.New()
+	}
+
+	query, err := m.Query(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	texts := make([]string, 0, len(m.Columns))
+	for _, c := range m.Columns {
+		val, err := c.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if val == nil {
+			continue
+		}
+		text, err := sql.LongText.Convert(val)
+		if err != nil {
+			return nil, err
+		}
+		texts = append(texts, text.(string))
+	}
+
+	document := sql.NewFullTextDocument(m.parser, texts...)
+	if m.condition && m.Mode == sql.FullTextBooleanMode {
+		return query.Matches(document), nil
+	}
+
+	// The statistics are read on every evaluation rather than kept with the expression, since the rows of the index
+	// may change between the executions of a plan, as in the loops of stored procedures
+	stats, err := m.index.Statistics(ctx, m.parser)
+	if err != nil {
+		return nil, err
+	}
+	relevance := query.Relevance(document, stats)
+	if m.condition {
+		return relevance > 0, nil
+	}
+	return relevance, nil
+}
+
+// WithChildren implements the Expression interface.
+func (m *MatchAgainst) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != len(m.Columns)+1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(m, len(children), len(m.Columns)+1)
+	}
+	nm := NewMatchAgainst(children[:len(m.Columns)], children[len(m.Columns)], m.Mode)
+	nm.index, nm.parser, nm.condition = m.index, m.parser, m.condition
+	return nm, nil
+}
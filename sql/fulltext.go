@@ -0,0 +1,408 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+var (
+	// ErrNoFullTextIndex is returned when MATCH names columns which no FULLTEXT index is defined on.
+	ErrNoFullTextIndex = errors.NewKind("Can't find FULLTEXT index matching the column list")
+
+	// ErrFullTextColumnType is returned when a FULLTEXT index is created on a column which doesn't hold text.
+	ErrFullTextColumnType = errors.NewKind("Column '%s' cannot be part of FULLTEXT index")
+
+	// ErrFullTextAgainstArgument is returned when the search string of MATCH ... AGAINST isn't a constant.
+	ErrFullTextAgainstArgument = errors.NewKind("Incorrect arguments to AGAINST")
+
+	// ErrInvalidStopwordTable is returned when the stopword table of FULLTEXT searches doesn't exist or doesn't have
+	// a single VARCHAR column named value.
+	ErrInvalidStopwordTable = errors.NewKind("Invalid InnoDB FTS Stopword table: %s")
+)
+
+// FullTextMode is the mode of a MATCH ... AGAINST search.
+type FullTextMode byte
+
+const (
+	// FullTextNaturalLanguageMode searches for the words of the search string, and is the default mode.
+	FullTextNaturalLanguageMode FullTextMode = iota
+	// FullTextBooleanMode searches for the words of the search string according to its operators.
+	FullTextBooleanMode
+)
+
+func (m FullTextMode) String() string {
+	if m == FullTextBooleanMode {
+		return "IN BOOLEAN MODE"
+	}
+	return "IN NATURAL LANGUAGE MODE"
+}
+
+// defaultFullTextStopwords are the words InnoDB doesn't index unless stopwords are disabled or a stopword table is
+// configured.
+var defaultFullTextStopwords = []string{
+	"a", "about", "an", "are", "as", "at", "be", "by", "com", "de", "en", "for", "from", "how", "i", "in", "is", "it",
+	"la", "of", "on", "or", "that", "the", "this", "to", "was", "what", "when", "where", "who", "will", "with", "und",
+	"www",
+}
+
+// FullTextParser splits text into the words FULLTEXT indexes and searches are made of. Words shorter or longer than
+// the token sizes, and stopwords, aren't indexed.
+type FullTextParser struct {
+	MinTokenSize int
+	MaxTokenSize int
+	Stopwords    map[string]bool
+}
+
+// NewFullTextParser returns the parser configured by the innodb_ft_min_token_size, innodb_ft_max_token_size and
+// innodb_ft_enable_stopword variables. Stopword tables are read by the analyzer, which sets them with SetStopwords.
+func NewFullTextParser(ctx *Context) (*FullTextParser, error) {
+	p := &FullTextParser{
+		MinTokenSize: int(globalIntVariable("innodb_ft_min_token_size", 3)),
+		MaxTokenSize: int(globalIntVariable("innodb_ft_max_token_size", 84)),
+	}
+
+	enabled, err := ctx.GetSessionVariable(ctx, "innodb_ft_enable_stopword")
+	if err != nil {
+		return nil, err
+	}
+	if enabled, _ := ConvertToBool(enabled); enabled {
+		p.SetStopwords(defaultFullTextStopwords)
+	}
+	return p, nil
+}
+
+// globalIntVariable returns the global value of an integer system variable, or the default given if it's not one.
+func globalIntVariable(name string, def int64) int64 {
+	_, val, ok := SystemVariables.GetGlobal(name)
+	if !ok {
+		return def
+	}
+	v, err := Int64.Convert(val)
+	if err != nil {
+		return def
+	}
+	return v.(int64)
+}
+
+// SetStopwords replaces the stopwords of the parser.
+func (p *FullTextParser) SetStopwords(words []string) {
+	p.Stopwords = make(map[string]bool, len(words))
+	for _, w := range words {
+		p.Stopwords[strings.ToLower(w)] = true
+	}
+}
+
+// Words returns all the words of a text in lower case, in order.
+func (p *FullTextParser) Words(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !isFullTextWordRune(r)
+	})
+}
+
+// IsToken returns whether a word is indexed.
+func (p *FullTextParser) IsToken(word string) bool {
+	n := utf8.RuneCountInString(word)
+	return n >= p.MinTokenSize && n <= p.MaxTokenSize && !p.Stopwords[word]
+}
+
+func isFullTextWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// FullTextDocument is the text of the columns of a FULLTEXT index in a row.
+type FullTextDocument struct {
+	words  []string
+	tokens map[string]int
+}
+
+// NewFullTextDocument returns the document of the texts given.
+func NewFullTextDocument(p *FullTextParser, texts ...string) *FullTextDocument {
+	d := &FullTextDocument{tokens: make(map[string]int)}
+	for _, text := range texts {
+		for _, w := range p.Words(text) {
+			d.words = append(d.words, w)
+			if p.IsToken(w) {
+				d.tokens[w]++
+			}
+		}
+	}
+	return d
+}
+
+// Tokens returns the number of times each of the indexed words of the document occurs in it.
+func (d *FullTextDocument) Tokens() map[string]int {
+	return d.tokens
+}
+
+// Count returns the number of times a word, prefix or phrase occurs in the document.
+func (d *FullTextDocument) Count(t *FullTextTerm) int {
+	switch {
+	case t.Group != nil:
+		return 0
+	case t.Phrase != nil:
+		count := 0
+		for i := 0; i+len(t.Phrase) <= len(d.words); i++ {
+			if equalWords(d.words[i:i+len(t.Phrase)], t.Phrase) {
+				count++
+			}
+		}
+		return count
+	case t.Prefix:
+		count := 0
+		for w, n := range d.tokens {
+			if strings.HasPrefix(w, t.Word) {
+				count += n
+			}
+		}
+		return count
+	default:
+		return d.tokens[t.Word]
+	}
+}
+
+func equalWords(a, b []string) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// FullTextStatistics are the statistics of the documents of a FULLTEXT index relevance is computed with.
+type FullTextStatistics interface {
+	// DocumentCount returns the number of documents in the index.
+	DocumentCount() int
+	// DocumentFrequency returns the number of documents containing a word, prefix or phrase.
+	DocumentFrequency(t *FullTextTerm) int
+}
+
+// FullTextOperator is the operator of a term of a boolean mode search, such as + for words which must be present.
+type FullTextOperator byte
+
+const (
+	FullTextOptional FullTextOperator = 0
+	FullTextRequired FullTextOperator = '+'
+	FullTextExcluded FullTextOperator = '-'
+	FullTextIncrease FullTextOperator = '>'
+	FullTextDecrease FullTextOperator = '<'
+	FullTextNegate   FullTextOperator = '~'
+)
+
+// Weights of the relevance of terms with the > and < operators.
+const (
+	fullTextIncreaseWeight = 1.5
+	fullTextDecreaseWeight = 0.5
+)
+
+// FullTextTerm is a term of a search, which is a word, a prefix, a phrase or, in boolean mode, a group of terms.
+type FullTextTerm struct {
+	Operator FullTextOperator
+	Word     string
+	Prefix   bool
+	Phrase   []string
+	Group    []*FullTextTerm
+}
+
+// FullTextQuery is the parsed search string of MATCH ... AGAINST.
+type FullTextQuery struct {
+	Mode  FullTextMode
+	Terms []*FullTextTerm
+}
+
+// ParseFullTextQuery parses a search string. In natural language mode its terms are its indexed words. In boolean mode
+// its words may be preceded by the operators + - > < ~, followed by * to search for a prefix, quoted to search for a
+// phrase and grouped with parentheses.
+func ParseFullTextQuery(p *FullTextParser, query string, mode FullTextMode) *FullTextQuery {
+	q := &FullTextQuery{Mode: mode}
+	if mode == FullTextBooleanMode {
+		i := 0
+		q.Terms = parseBooleanTerms(p, []rune(query), &i, 0)
+		return q
+	}
+
+	seen := make(map[string]bool)
+	for _, w := range p.Words(query) {
+		if p.IsToken(w) && !seen[w] {
+			seen[w] = true
+			q.Terms = append(q.Terms, &FullTextTerm{Word: w})
+		}
+	}
+	return q
+}
+
+func parseBooleanTerms(p *FullTextParser, s []rune, i *int, depth int) []*FullTextTerm {
+	var terms []*FullTextTerm
+	op := FullTextOptional
+	for *i < len(s) {
+		c := s[*i]
+		switch {
+		case c == '+' || c == '-' || c == '>' || c == '<' || c == '~':
+			op = FullTextOperator(c)
+			*i++
+		case c == '(':
+			*i++
+			if group := parseBooleanTerms(p, s, i, depth+1); len(group) > 0 {
+				terms = append(terms, &FullTextTerm{Operator: op, Group: group})
+			}
+			op = FullTextOptional
+		case c == ')':
+			*i++
+			if depth > 0 {
+				return terms
+			}
+			op = FullTextOptional
+		case c == '"':
+			*i++
+			start := *i
+			for *i < len(s) && s[*i] != '"' {
+				*i++
+			}
+			words := p.Words(string(s[start:*i]))
+			if *i < len(s) {
+				*i++
+			}
+			if len(words) > 0 {
+				terms = append(terms, &FullTextTerm{Operator: op, Phrase: words})
+			}
+			op = FullTextOptional
+		case isFullTextWordRune(c):
+			start := *i
+			for *i < len(s) && isFullTextWordRune(s[*i]) {
+				*i++
+			}
+			word := strings.ToLower(string(s[start:*i]))
+			prefix := *i < len(s) && s[*i] == '*'
+			if prefix {
+				*i++
+			}
+			if prefix || p.IsToken(word) {
+				terms = append(terms, &FullTextTerm{Operator: op, Word: word, Prefix: prefix})
+			}
+			op = FullTextOptional
+		default:
+			*i++
+			op = FullTextOptional
+		}
+	}
+	return terms
+}
+
+// Matches returns whether a document matches the query. In natural language mode, it must contain one of its words.
+// In boolean mode, it must contain all the required terms, none of the excluded ones and, if there are no required
+// ones, one of the others.
+func (q *FullTextQuery) Matches(d *FullTextDocument) bool {
+	if q.Mode == FullTextBooleanMode {
+		return booleanMatches(q.Terms, d)
+	}
+	for _, t := range q.Terms {
+		if d.Count(t) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func booleanMatches(terms []*FullTextTerm, d *FullTextDocument) bool {
+	matched := false
+	for _, t := range terms {
+		var present bool
+		if t.Group != nil {
+			present = booleanMatches(t.Group, d)
+		} else {
+			present = d.Count(t) > 0
+		}
+
+		switch t.Operator {
+		case FullTextRequired:
+			if !present {
+				return false
+			}
+			matched = true
+		case FullTextExcluded:
+			if present {
+				return false
+			}
+		default:
+			matched = matched || present
+		}
+	}
+	return matched
+}
+
+// Relevance returns the relevance of a document to the query, which is the sum over its terms of the number of times
+// they occur in the document times the square of their inverse document frequency, log10(documents / documents
+// containing the term). In boolean mode, it's 0 for documents which don't match the query, and the terms' operators
+// weigh their relevance.
+func (q *FullTextQuery) Relevance(d *FullTextDocument, stats FullTextStatistics) float64 {
+	if q.Mode == FullTextBooleanMode {
+		if !booleanMatches(q.Terms, d) {
+			return 0
+		}
+		return booleanRelevance(q.Terms, d, stats)
+	}
+
+	var relevance float64
+	for _, t := range q.Terms {
+		relevance += termRelevance(t, d, stats)
+	}
+	return relevance
+}
+
+func booleanRelevance(terms []*FullTextTerm, d *FullTextDocument, stats FullTextStatistics) float64 {
+	var relevance float64
+	for _, t := range terms {
+		var r float64
+		if t.Group != nil {
+			if booleanMatches(t.Group, d) {
+				r = booleanRelevance(t.Group, d, stats)
+			}
+		} else {
+			r = termRelevance(t, d, stats)
+		}
+
+		switch t.Operator {
+		case FullTextExcluded:
+			r = 0
+		case FullTextIncrease:
+			r *= fullTextIncreaseWeight
+		case FullTextDecrease:
+			r *= fullTextDecreaseWeight
+		case FullTextNegate:
+			r = -r
+		}
+		relevance += r
+	}
+	return relevance
+}
+
+func termRelevance(t *FullTextTerm, d *FullTextDocument, stats FullTextStatistics) float64 {
+	tf := d.Count(t)
+	if tf == 0 {
+		return 0
+	}
+	df, n := stats.DocumentFrequency(t), stats.DocumentCount()
+	if df == 0 || n <= df {
+		return 0
+	}
+	idf := math.Log10(float64(n) / float64(df))
+	return float64(tf) * idf * idf
+}
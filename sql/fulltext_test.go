@@ -0,0 +1,144 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestFullTextParser() *FullTextParser {
+	p := &FullTextParser{MinTokenSize: 3, MaxTokenSize: 84}
+	p.SetStopwords(defaultFullTextStopwords)
+	return p
+}
+
+func TestParseFullTextQuery(t *testing.T) {
+	p := newTestFullTextParser()
+	testCases := []struct {
+		query    string
+		mode     FullTextMode
+		expected []*FullTextTerm
+	}{
+		{
+			"The MySQL database, the MySQL server",
+			FullTextNaturalLanguageMode,
+			[]*FullTextTerm{{Word: "mysql"}, {Word: "database"}, {Word: "server"}},
+		},
+		{
+			"+mysql -oracle >fast <slow ~bad data* an",
+			FullTextBooleanMode,
+			[]*FullTextTerm{
+				{Operator: FullTextRequired, Word: "mysql"},
+				{Operator: FullTextExcluded, Word: "oracle"},
+				{Operator: FullTextIncrease, Word: "fast"},
+				{Operator: FullTextDecrease, Word: "slow"},
+				{Operator: FullTextNegate, Word: "bad"},
+				{Word: "data", Prefix: true},
+			},
+		},
+		{
+			`+"Database Comparison" -(oracle db2)`,
+			FullTextBooleanMode,
+			[]*FullTextTerm{
+				{Operator: FullTextRequired, Phrase: []string{"database", "comparison"}},
+				{Operator: FullTextExcluded, Group: []*FullTextTerm{{Word: "oracle"}, {Word: "db2"}}},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			q := ParseFullTextQuery(p, tt.query, tt.mode)
+			require.Equal(t, tt.mode, q.Mode)
+			require.Equal(t, tt.expected, q.Terms)
+		})
+	}
+}
+
+type testFullTextStatistics []*FullTextDocument
+
+func (s testFullTextStatistics) DocumentCount() int {
+	return len(s)
+}
+
+func (s testFullTextStatistics) DocumentFrequency(t *FullTextTerm) int {
+	n := 0
+	for _, d := range s {
+		if d.Count(t) > 0 {
+			n++
+		}
+	}
+	return n
+}
+
+func TestFullTextQueryMatches(t *testing.T) {
+	p := newTestFullTextParser()
+	docs := testFullTextStatistics{
+		NewFullTextDocument(p, "MySQL Tutorial", "DBMS stands for DataBase"),
+		NewFullTextDocument(p, "MySQL vs. YourSQL", "In the following database comparison"),
+		NewFullTextDocument(p, "Oracle Security", "When configured properly"),
+	}
+
+	testCases := []struct {
+		query    string
+		mode     FullTextMode
+		expected []bool
+	}{
+		{"database", FullTextNaturalLanguageMode, []bool{true, true, false}},
+		{"the", FullTextNaturalLanguageMode, []bool{false, false, false}},
+		{"+mysql -yoursql", FullTextBooleanMode, []bool{true, false, false}},
+		{"tutorial security", FullTextBooleanMode, []bool{true, false, true}},
+		{"data*", FullTextBooleanMode, []bool{true, true, false}},
+		{`"database comparison"`, FullTextBooleanMode, []bool{false, true, false}},
+		{`+(tutorial oracle) -properly`, FullTextBooleanMode, []bool{true, false, false}},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			q := ParseFullTextQuery(p, tt.query, tt.mode)
+			for i, d := range docs {
+				require.Equal(t, tt.expected[i], q.Matches(d), "document %d", i)
+				relevance := q.Relevance(d, docs)
+				if !tt.expected[i] {
+					require.Zero(t, relevance, "document %d", i)
+				}
+			}
+		})
+	}
+}
+
+func TestFullTextRelevance(t *testing.T) {
+	p := newTestFullTextParser()
+	docs := testFullTextStatistics{
+		NewFullTextDocument(p, "database database"),
+		NewFullTextDocument(p, "database server"),
+		NewFullTextDocument(p, "server"),
+		NewFullTextDocument(p, "client"),
+	}
+
+	q := ParseFullTextQuery(p, "database", FullTextNaturalLanguageMode)
+	require.Greater(t, q.Relevance(docs[0], docs), q.Relevance(docs[1], docs))
+	require.Greater(t, q.Relevance(docs[1], docs), 0.0)
+	require.Zero(t, q.Relevance(docs[2], docs))
+
+	q = ParseFullTextQuery(p, ">database <server", FullTextBooleanMode)
+	require.Greater(t, q.Relevance(docs[1], docs), q.Relevance(docs[2], docs))
+	require.Greater(t, q.Relevance(docs[2], docs), 0.0)
+
+	p.MinTokenSize = 7
+	require.Equal(t, map[string]int{"database": 2}, NewFullTextDocument(p, "database database").Tokens())
+}
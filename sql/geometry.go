@@ -0,0 +1,980 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"encoding/binary"
+	"math"
+	"strconv"
+	"strings"
+
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+var (
+	// ErrInvalidGISData is returned when a function is given well-known text or binary which isn't a valid geometry.
+	ErrInvalidGISData = errors.NewKind("Invalid GIS data provided to function %s.")
+
+	// ErrUnknownSRID is returned when a geometry is given an SRID which names no spatial reference system.
+	ErrUnknownSRID = errors.NewKind("There's no spatial reference system with SRID %d.")
+
+	// ErrDifferentSRIDs is returned when a function is given two geometries of different spatial reference systems.
+	ErrDifferentSRIDs = errors.NewKind("Binary geometry function %s given two geometries of different srids: %d and %d, which should have been identical.")
+
+	// ErrLatitudeOutOfRange is returned when a geographic geometry has a latitude which isn't a valid one.
+	ErrLatitudeOutOfRange = errors.NewKind("Latitude %f is out of range in function %s. It must be within [-90.000000, 90.000000].")
+
+	// ErrLongitudeOutOfRange is returned when a geographic geometry has a longitude which isn't a valid one.
+	ErrLongitudeOutOfRange = errors.NewKind("Longitude %f is out of range in function %s. It must be within (-180.000000, 180.000000].")
+
+	// ErrUnsupportedGeometryArgument is returned when a function is given geometries of types it doesn't handle.
+	ErrUnsupportedGeometryArgument = errors.NewKind("Calling geometry function %s with unsupported types of arguments.")
+
+	// ErrNotImplementedForGeographicSRS is returned by the functions which only handle geometries on a plane when
+	// they're given geometries of a geographic spatial reference system.
+	ErrNotImplementedForGeographicSRS = errors.NewKind("%s has not been implemented for geographic spatial reference systems.")
+)
+
+// GeometryValue is a value of a spatial type. The coordinates of the points of geometries of geographic spatial
+// reference systems are held with X as the longitude and Y as the latitude, whatever the axis order of the system.
+type GeometryValue interface {
+	// GetSRID returns the SRID of the spatial reference system of the geometry.
+	GetSRID() uint32
+	// SetSRID returns the geometry with the SRID given.
+	SetSRID(srid uint32) GeometryValue
+	// GeometryType returns the name of the type of the geometry, such as POINT.
+	GeometryType() string
+	// Dimension returns the dimension of the geometry: 0 for points, 1 for lines and 2 for polygons.
+	Dimension() int
+	// IsEmpty returns whether the geometry has no points.
+	IsEmpty() bool
+	// mapPoints returns the geometry with each of its points replaced by the result of a function.
+	mapPoints(f func(Point) Point) GeometryValue
+}
+
+// Point is a POINT.
+type Point struct {
+	SRID uint32
+	X    float64
+	Y    float64
+}
+
+// LineString is a LINESTRING, which holds two points at least.
+type LineString struct {
+	SRID   uint32
+	Points []Point
+}
+
+// Polygon is a POLYGON, whose first ring is its exterior and whose other rings are its holes. Every ring is a closed
+// LineString of four points at least.
+type Polygon struct {
+	SRID  uint32
+	Rings []LineString
+}
+
+// MultiPoint is a MULTIPOINT.
+type MultiPoint struct {
+	SRID   uint32
+	Points []Point
+}
+
+// MultiLineString is a MULTILINESTRING.
+type MultiLineString struct {
+	SRID        uint32
+	LineStrings []LineString
+}
+
+// MultiPolygon is a MULTIPOLYGON.
+type MultiPolygon struct {
+	SRID     uint32
+	Polygons []Polygon
+}
+
+// GeometryCollection is a GEOMETRYCOLLECTION.
+type GeometryCollection struct {
+	SRID       uint32
+	Geometries []GeometryValue
+}
+
+var _ GeometryValue = Point{}
+var _ GeometryValue = LineString{}
+var _ GeometryValue = Polygon{}
+var _ GeometryValue = MultiPoint{}
+var _ GeometryValue = MultiLineString{}
+var _ GeometryValue = MultiPolygon{}
+var _ GeometryValue = GeometryCollection{}
+
+func (p Point) GetSRID() uint32                             { return p.SRID }
+func (p Point) SetSRID(srid uint32) GeometryValue           { p.SRID = srid; return p }
+func (p Point) GeometryType() string                        { return "POINT" }
+func (p Point) Dimension() int                              { return 0 }
+func (p Point) IsEmpty() bool                               { return false }
+func (p Point) mapPoints(f func(Point) Point) GeometryValue { return f(p) }
+
+func (l LineString) GetSRID() uint32                   { return l.SRID }
+func (l LineString) SetSRID(srid uint32) GeometryValue { l.SRID = srid; return l }
+func (l LineString) GeometryType() string              { return "LINESTRING" }
+func (l LineString) Dimension() int                    { return 1 }
+func (l LineString) IsEmpty() bool                     { return len(l.Points) == 0 }
+func (l LineString) mapPoints(f func(Point) Point) GeometryValue {
+	return LineString{SRID: l.SRID, Points: mapPoints(l.Points, f)}
+}
+
+func (p Polygon) GetSRID() uint32                   { return p.SRID }
+func (p Polygon) SetSRID(srid uint32) GeometryValue { p.SRID = srid; return p }
+func (p Polygon) GeometryType() string              { return "POLYGON" }
+func (p Polygon) Dimension() int                    { return 2 }
+func (p Polygon) IsEmpty() bool                     { return len(p.Rings) == 0 }
+func (p Polygon) mapPoints(f func(Point) Point) GeometryValue {
+	rings := make([]LineString, len(p.Rings))
+	for i, r := range p.Rings {
+		rings[i] = r.mapPoints(f).(LineString)
+	}
+	return Polygon{SRID: p.SRID, Rings: rings}
+}
+
+func (m MultiPoint) GetSRID() uint32                   { return m.SRID }
+func (m MultiPoint) SetSRID(srid uint32) GeometryValue { m.SRID = srid; return m }
+func (m MultiPoint) GeometryType() string              { return "MULTIPOINT" }
+func (m MultiPoint) Dimension() int                    { return 0 }
+func (m MultiPoint) IsEmpty() bool                     { return len(m.Points) == 0 }
+func (m MultiPoint) mapPoints(f func(Point) Point) GeometryValue {
+	return MultiPoint{SRID: m.SRID, Points: mapPoints(m.Points, f)}
+}
+
+func (m MultiLineString) GetSRID() uint32                   { return m.SRID }
+func (m MultiLineString) SetSRID(srid uint32) GeometryValue { m.SRID = srid; return m }
+func (m MultiLineString) GeometryType() string              { return "MULTILINESTRING" }
+func (m MultiLineString) Dimension() int                    { return 1 }
+func (m MultiLineString) IsEmpty() bool                     { return len(m.LineStrings) == 0 }
+func (m MultiLineString) mapPoints(f func(Point) Point) GeometryValue {
+	lines := make([]LineString, len(m.LineStrings))
+	for i, l := range m.LineStrings {
+		lines[i] = l.mapPoints(f).(LineString)
+	}
+	return MultiLineString{SRID: m.SRID, LineStrings: lines}
+}
+
+func (m MultiPolygon) GetSRID() uint32                   { return m.SRID }
+func (m MultiPolygon) SetSRID(srid uint32) GeometryValue { m.SRID = srid; return m }
+func (m MultiPolygon) GeometryType() string              { return "MULTIPOLYGON" }
+func (m MultiPolygon) Dimension() int                    { return 2 }
+func (m MultiPolygon) IsEmpty() bool                     { return len(m.Polygons) == 0 }
+func (m MultiPolygon) mapPoints(f func(Point) Point) GeometryValue {
+	polygons := make([]Polygon, len(m.Polygons))
+	for i, p := range m.Polygons {
+		polygons[i] = p.mapPoints(f).(Polygon)
+	}
+	return MultiPolygon{SRID: m.SRID, Polygons: polygons}
+}
+
+func (c GeometryCollection) GetSRID() uint32                   { return c.SRID }
+func (c GeometryCollection) SetSRID(srid uint32) GeometryValue { c.SRID = srid; return c }
+func (c GeometryCollection) GeometryType() string              { return "GEOMETRYCOLLECTION" }
+func (c GeometryCollection) IsEmpty() bool {
+	for _, g := range c.Geometries {
+		if !g.IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
+func (c GeometryCollection) Dimension() int {
+	dimension := 0
+	for _, g := range c.Geometries {
+		if d := g.Dimension(); d > dimension {
+			dimension = d
+		}
+	}
+	return dimension
+}
+func (c GeometryCollection) mapPoints(f func(Point) Point) GeometryValue {
+	geometries := make([]GeometryValue, len(c.Geometries))
+	for i, g := range c.Geometries {
+		geometries[i] = g.mapPoints(f)
+	}
+	return GeometryCollection{SRID: c.SRID, Geometries: geometries}
+}
+
+func mapPoints(points []Point, f func(Point) Point) []Point {
+	mapped := make([]Point, len(points))
+	for i, p := range points {
+		mapped[i] = f(p)
+	}
+	return mapped
+}
+
+// SwapAxes returns a geometry with the X and Y coordinates of its points swapped.
+func SwapAxes(g GeometryValue) GeometryValue {
+	return g.mapPoints(func(p Point) Point {
+		return Point{SRID: p.SRID, X: p.Y, Y: p.X}
+	})
+}
+
+// GeometryPoints calls a function with each point of a geometry, in order.
+func GeometryPoints(g GeometryValue, f func(Point)) {
+	g.mapPoints(func(p Point) Point {
+		f(p)
+		return p
+	})
+}
+
+// SpatialReferenceSystem is a spatial reference system which geometries may be defined in.
+type SpatialReferenceSystem struct {
+	// ID is the SRID of the system.
+	ID uint32
+	// Name is the name of the system.
+	Name string
+	// Geographic is whether the coordinates of the system are longitudes and latitudes in degrees on an ellipsoid,
+	// rather than positions on a plane.
+	Geographic bool
+	// LatLong is whether the latitude is the first axis of the system, as it's given in the well-known text and
+	// binary of geometries.
+	LatLong bool
+}
+
+// spatialReferenceSystems are the spatial reference systems by their SRIDs. SRID 0 is the infinite Cartesian plane
+// which has no units.
+var spatialReferenceSystems = map[uint32]SpatialReferenceSystem{
+	0:    {ID: 0},
+	3857: {ID: 3857, Name: "WGS 84 / Pseudo-Mercator"},
+	4269: {ID: 4269, Name: "NAD83", Geographic: true, LatLong: true},
+	4326: {ID: 4326, Name: "WGS 84", Geographic: true, LatLong: true},
+}
+
+// LookupSpatialReferenceSystem returns the spatial reference system of an SRID, or ErrUnknownSRID if there's none.
+func LookupSpatialReferenceSystem(srid uint32) (SpatialReferenceSystem, error) {
+	srs, ok := spatialReferenceSystems[srid]
+	if !ok {
+		return SpatialReferenceSystem{}, ErrUnknownSRID.New(srid)
+	}
+	return srs, nil
+}
+
+// ValidateGeographicCoordinates returns an error if a geometry of a geographic spatial reference system has a
+// longitude or latitude out of range. The function name given is the one the error names.
+func ValidateGeographicCoordinates(g GeometryValue, function string) error {
+	var err error
+	GeometryPoints(g, func(p Point) {
+		if err != nil {
+			return
+		}
+		if p.X <= -180 || p.X > 180 {
+			err = ErrLongitudeOutOfRange.New(p.X, function)
+		} else if p.Y < -90 || p.Y > 90 {
+			err = ErrLatitudeOutOfRange.New(p.Y, function)
+		}
+	})
+	return err
+}
+
+const (
+	wkbPoint uint32 = iota + 1
+	wkbLineString
+	wkbPolygon
+	wkbMultiPoint
+	wkbMultiLineString
+	wkbMultiPolygon
+	wkbGeometryCollection
+)
+
+// maxGeometryDepth is the deepest nesting of geometry collections which is decoded.
+const maxGeometryDepth = 32
+
+// GeometryToWKB returns the well-known binary of a geometry, in little-endian byte order.
+func GeometryToWKB(g GeometryValue) []byte {
+	return appendWKB(nil, g)
+}
+
+func appendWKB(b []byte, g GeometryValue) []byte {
+	switch g := g.(type) {
+	case Point:
+		b = appendWKBHeader(b, wkbPoint)
+		return appendWKBPoint(b, g)
+	case LineString:
+		b = appendWKBHeader(b, wkbLineString)
+		return appendWKBPoints(b, g.Points)
+	case Polygon:
+		b = appendWKBHeader(b, wkbPolygon)
+		return appendWKBRings(b, g.Rings)
+	case MultiPoint:
+		b = appendWKBHeader(b, wkbMultiPoint)
+		b = appendUint32(b, uint32(len(g.Points)))
+		for _, p := range g.Points {
+			b = appendWKB(b, p)
+		}
+		return b
+	case MultiLineString:
+		b = appendWKBHeader(b, wkbMultiLineString)
+		b = appendUint32(b, uint32(len(g.LineStrings)))
+		for _, l := range g.LineStrings {
+			b = appendWKB(b, l)
+		}
+		return b
+	case MultiPolygon:
+		b = appendWKBHeader(b, wkbMultiPolygon)
+		b = appendUint32(b, uint32(len(g.Polygons)))
+		for _, p := range g.Polygons {
+			b = appendWKB(b, p)
+		}
+		return b
+	case GeometryCollection:
+		b = appendWKBHeader(b, wkbGeometryCollection)
+		b = appendUint32(b, uint32(len(g.Geometries)))
+		for _, c := range g.Geometries {
+			b = appendWKB(b, c)
+		}
+		return b
+	default:
+		panic("unknown geometry")
+	}
+}
+
+func appendWKBHeader(b []byte, typ uint32) []byte {
+	b = append(b, 1)
+	return appendUint32(b, typ)
+}
+
+func appendUint32(b []byte, v uint32) []byte {
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], v)
+	return append(b, buf[:]...)
+}
+
+func appendWKBPoint(b []byte, p Point) []byte {
+	var buf [16]byte
+	binary.LittleEndian.PutUint64(buf[:8], math.Float64bits(p.X))
+	binary.LittleEndian.PutUint64(buf[8:], math.Float64bits(p.Y))
+	return append(b, buf[:]...)
+}
+
+func appendWKBPoints(b []byte, points []Point) []byte {
+	b = appendUint32(b, uint32(len(points)))
+	for _, p := range points {
+		b = appendWKBPoint(b, p)
+	}
+	return b
+}
+
+func appendWKBRings(b []byte, rings []LineString) []byte {
+	b = appendUint32(b, uint32(len(rings)))
+	for _, r := range rings {
+		b = appendWKBPoints(b, r.Points)
+	}
+	return b
+}
+
+// GeometryFromWKB returns the geometry of well-known binary, with the SRID given. It returns false if the binary isn't
+// the one of a valid geometry.
+func GeometryFromWKB(b []byte, srid uint32) (GeometryValue, bool) {
+	r := &wkbReader{b: b}
+	g, ok := r.geometry(0, 0)
+	if !ok || r.pos != len(b) {
+		return nil, false
+	}
+	return g.SetSRID(srid), true
+}
+
+type wkbReader struct {
+	b     []byte
+	pos   int
+	order binary.ByteOrder
+}
+
+func (r *wkbReader) uint32() (uint32, bool) {
+	if len(r.b)-r.pos < 4 {
+		return 0, false
+	}
+	v := r.order.Uint32(r.b[r.pos:])
+	r.pos += 4
+	return v, true
+}
+
+// count reads the number of elements which follow, each of which takes size bytes at least.
+func (r *wkbReader) count(size int) (int, bool) {
+	n, ok := r.uint32()
+	if !ok || uint64(n)*uint64(size) > uint64(len(r.b)-r.pos) {
+		return 0, false
+	}
+	return int(n), true
+}
+
+func (r *wkbReader) point() (Point, bool) {
+	if len(r.b)-r.pos < 16 {
+		return Point{}, false
+	}
+	x := math.Float64frombits(r.order.Uint64(r.b[r.pos:]))
+	y := math.Float64frombits(r.order.Uint64(r.b[r.pos+8:]))
+	r.pos += 16
+	if !isFinite(x) || !isFinite(y) {
+		return Point{}, false
+	}
+	return Point{X: x, Y: y}, true
+}
+
+func (r *wkbReader) points() ([]Point, bool) {
+	n, ok := r.count(16)
+	if !ok {
+		return nil, false
+	}
+	points := make([]Point, n)
+	for i := range points {
+		if points[i], ok = r.point(); !ok {
+			return nil, false
+		}
+	}
+	return points, true
+}
+
+func (r *wkbReader) lineString() (LineString, bool) {
+	points, ok := r.points()
+	if !ok || len(points) < 2 {
+		return LineString{}, false
+	}
+	return LineString{Points: points}, true
+}
+
+func (r *wkbReader) polygon() (Polygon, bool) {
+	n, ok := r.count(4)
+	if !ok || n == 0 {
+		return Polygon{}, false
+	}
+	rings := make([]LineString, n)
+	for i := range rings {
+		points, ok := r.points()
+		if !ok || !isRing(points) {
+			return Polygon{}, false
+		}
+		rings[i] = LineString{Points: points}
+	}
+	return Polygon{Rings: rings}, true
+}
+
+// geometry reads a geometry, including its header, whose nesting in geometry collections is depth. If typ isn't zero,
+// it's the only type of geometry accepted.
+func (r *wkbReader) geometry(typ uint32, depth int) (GeometryValue, bool) {
+	if r.pos >= len(r.b) {
+		return nil, false
+	}
+	switch r.b[r.pos] {
+	case 0:
+		r.order = binary.BigEndian
+	case 1:
+		r.order = binary.LittleEndian
+	default:
+		return nil, false
+	}
+	r.pos++
+	t, ok := r.uint32()
+	if !ok || (typ != 0 && t != typ) {
+		return nil, false
+	}
+
+	switch t {
+	case wkbPoint:
+		return r.point()
+	case wkbLineString:
+		return r.lineString()
+	case wkbPolygon:
+		return r.polygon()
+	case wkbMultiPoint:
+		n, ok := r.count(21)
+		if !ok {
+			return nil, false
+		}
+		points := make([]Point, n)
+		for i := range points {
+			g, ok := r.geometry(wkbPoint, depth)
+			if !ok {
+				return nil, false
+			}
+			points[i] = g.(Point)
+		}
+		return MultiPoint{Points: points}, true
+	case wkbMultiLineString:
+		n, ok := r.count(9)
+		if !ok {
+			return nil, false
+		}
+		lines := make([]LineString, n)
+		for i := range lines {
+			g, ok := r.geometry(wkbLineString, depth)
+			if !ok {
+				return nil, false
+			}
+			lines[i] = g.(LineString)
+		}
+		return MultiLineString{LineStrings: lines}, true
+	case wkbMultiPolygon:
+		n, ok := r.count(9)
+		if !ok {
+			return nil, false
+		}
+		polygons := make([]Polygon, n)
+		for i := range polygons {
+			g, ok := r.geometry(wkbPolygon, depth)
+			if !ok {
+				return nil, false
+			}
+			polygons[i] = g.(Polygon)
+		}
+		return MultiPolygon{Polygons: polygons}, true
+	case wkbGeometryCollection:
+		if depth >= maxGeometryDepth {
+			return nil, false
+		}
+		n, ok := r.count(5)
+		if !ok {
+			return nil, false
+		}
+		geometries := make([]GeometryValue, n)
+		for i := range geometries {
+			if geometries[i], ok = r.geometry(0, depth+1); !ok {
+				return nil, false
+			}
+		}
+		return GeometryCollection{Geometries: geometries}, true
+	default:
+		return nil, false
+	}
+}
+
+func isFinite(f float64) bool {
+	return !math.IsNaN(f) && !math.IsInf(f, 0)
+}
+
+// isRing returns whether points are the ones of a ring of a polygon, which is closed and has four points at least.
+func isRing(points []Point) bool {
+	if len(points) < 4 {
+		return false
+	}
+	first, last := points[0], points[len(points)-1]
+	return first.X == last.X && first.Y == last.Y
+}
+
+// SerializeGeometry returns a geometry in the format it's stored in, which is its SRID as a little-endian 32-bit
+// integer followed by its well-known binary.
+func SerializeGeometry(g GeometryValue) []byte {
+	b := appendUint32(make([]byte, 0, 25), g.GetSRID())
+	return appendWKB(b, g)
+}
+
+// DeserializeGeometry returns the geometry of the format returned by SerializeGeometry. It returns false if the bytes
+// aren't the ones of a valid geometry.
+func DeserializeGeometry(b []byte) (GeometryValue, bool) {
+	if len(b) < 4 {
+		return nil, false
+	}
+	return GeometryFromWKB(b[4:], binary.LittleEndian.Uint32(b))
+}
+
+// GeometryToWKT returns the well-known text of a geometry.
+func GeometryToWKT(g GeometryValue) string {
+	var sb strings.Builder
+	writeWKT(&sb, g)
+	return sb.String()
+}
+
+func writeWKT(sb *strings.Builder, g GeometryValue) {
+	sb.WriteString(g.GeometryType())
+	switch g := g.(type) {
+	case Point:
+		sb.WriteByte('(')
+		writeWKTPoint(sb, g)
+		sb.WriteByte(')')
+	case LineString:
+		writeWKTPoints(sb, g.Points)
+	case Polygon:
+		writeWKTRings(sb, g.Rings)
+	case MultiPoint:
+		sb.WriteByte('(')
+		for i, p := range g.Points {
+			if i > 0 {
+				sb.WriteByte(',')
+			}
+			sb.WriteByte('(')
+			writeWKTPoint(sb, p)
+			sb.WriteByte(')')
+		}
+		sb.WriteByte(')')
+	case MultiLineString:
+		writeWKTRings(sb, g.LineStrings)
+	case MultiPolygon:
+		sb.WriteByte('(')
+		for i, p := range g.Polygons {
+			if i > 0 {
+				sb.WriteByte(',')
+			}
+			writeWKTRings(sb, p.Rings)
+		}
+		sb.WriteByte(')')
+	case GeometryCollection:
+		if len(g.Geometries) == 0 {
+			sb.WriteString(" EMPTY")
+			return
+		}
+		sb.WriteByte('(')
+		for i, c := range g.Geometries {
+			if i > 0 {
+				sb.WriteByte(',')
+			}
+			writeWKT(sb, c)
+		}
+		sb.WriteByte(')')
+	}
+}
+
+func writeWKTPoint(sb *strings.Builder, p Point) {
+	sb.WriteString(formatCoordinate(p.X))
+	sb.WriteByte(' ')
+	sb.WriteString(formatCoordinate(p.Y))
+}
+
+func writeWKTPoints(sb *strings.Builder, points []Point) {
+	sb.WriteByte('(')
+	for i, p := range points {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		writeWKTPoint(sb, p)
+	}
+	sb.WriteByte(')')
+}
+
+func writeWKTRings(sb *strings.Builder, rings []LineString) {
+	sb.WriteByte('(')
+	for i, r := range rings {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		writeWKTPoints(sb, r.Points)
+	}
+	sb.WriteByte(')')
+}
+
+func formatCoordinate(f float64) string {
+	return strings.Replace(strconv.FormatFloat(f, 'g', -1, 64), "e+", "e", 1)
+}
+
+// GeometryFromWKT returns the geometry of well-known text, with the SRID given. It returns false if the text isn't
+// the one of a valid geometry.
+func GeometryFromWKT(wkt string, srid uint32) (GeometryValue, bool) {
+	p := &wktParser{s: wkt}
+	g, ok := p.geometry(0)
+	if !ok {
+		return nil, false
+	}
+	p.skipSpaces()
+	if p.pos != len(p.s) {
+		return nil, false
+	}
+	return g.SetSRID(srid), true
+}
+
+type wktParser struct {
+	s   string
+	pos int
+}
+
+func (p *wktParser) skipSpaces() {
+	for p.pos < len(p.s) {
+		switch p.s[p.pos] {
+		case ' ', '\t', '\n', '\r':
+			p.pos++
+		default:
+			return
+		}
+	}
+}
+
+func (p *wktParser) consume(c byte) bool {
+	p.skipSpaces()
+	if p.pos < len(p.s) && p.s[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *wktParser) peek(c byte) bool {
+	p.skipSpaces()
+	return p.pos < len(p.s) && p.s[p.pos] == c
+}
+
+func (p *wktParser) word() string {
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.s) {
+		c := p.s[p.pos]
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') {
+			break
+		}
+		p.pos++
+	}
+	return strings.ToUpper(p.s[start:p.pos])
+}
+
+func (p *wktParser) number() (float64, bool) {
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.s) {
+		c := p.s[p.pos]
+		if (c < '0' || c > '9') && c != '.' && c != '-' && c != '+' && c != 'e' && c != 'E' {
+			break
+		}
+		p.pos++
+	}
+	f, err := strconv.ParseFloat(p.s[start:p.pos], 64)
+	if err != nil || !isFinite(f) {
+		return 0, false
+	}
+	return f, true
+}
+
+func (p *wktParser) point() (Point, bool) {
+	x, ok := p.number()
+	if !ok {
+		return Point{}, false
+	}
+	y, ok := p.number()
+	if !ok {
+		return Point{}, false
+	}
+	return Point{X: x, Y: y}, true
+}
+
+// list parses a parenthesized list of elements separated by commas, calling element for each of them.
+func (p *wktParser) list(element func() bool) bool {
+	if !p.consume('(') {
+		return false
+	}
+	for {
+		if !element() {
+			return false
+		}
+		if p.consume(')') {
+			return true
+		}
+		if !p.consume(',') {
+			return false
+		}
+	}
+}
+
+func (p *wktParser) points() ([]Point, bool) {
+	var points []Point
+	ok := p.list(func() bool {
+		pt, ok := p.point()
+		points = append(points, pt)
+		return ok
+	})
+	return points, ok
+}
+
+func (p *wktParser) lineString() (LineString, bool) {
+	points, ok := p.points()
+	if !ok || len(points) < 2 {
+		return LineString{}, false
+	}
+	return LineString{Points: points}, true
+}
+
+func (p *wktParser) polygon() (Polygon, bool) {
+	var rings []LineString
+	ok := p.list(func() bool {
+		points, ok := p.points()
+		rings = append(rings, LineString{Points: points})
+		return ok && isRing(points)
+	})
+	return Polygon{Rings: rings}, ok
+}
+
+// geometry parses a geometry, whose nesting in geometry collections is depth.
+func (p *wktParser) geometry(depth int) (GeometryValue, bool) {
+	switch p.word() {
+	case "POINT":
+		if !p.consume('(') {
+			return nil, false
+		}
+		pt, ok := p.point()
+		if !ok || !p.consume(')') {
+			return nil, false
+		}
+		return pt, true
+	case "LINESTRING":
+		return p.lineString()
+	case "POLYGON":
+		return p.polygon()
+	case "MULTIPOINT":
+		var points []Point
+		ok := p.list(func() bool {
+			parenthesized := p.consume('(')
+			pt, ok := p.point()
+			points = append(points, pt)
+			return ok && (!parenthesized || p.consume(')'))
+		})
+		return MultiPoint{Points: points}, ok
+	case "MULTILINESTRING":
+		var lines []LineString
+		ok := p.list(func() bool {
+			l, ok := p.lineString()
+			lines = append(lines, l)
+			return ok
+		})
+		return MultiLineString{LineStrings: lines}, ok
+	case "MULTIPOLYGON":
+		var polygons []Polygon
+		ok := p.list(func() bool {
+			polygon, ok := p.polygon()
+			polygons = append(polygons, polygon)
+			return ok
+		})
+		return MultiPolygon{Polygons: polygons}, ok
+	case "GEOMETRYCOLLECTION", "GEOMCOLLECTION":
+		if depth >= maxGeometryDepth {
+			return nil, false
+		}
+		if p.peek('(') {
+			p.pos++
+			if p.consume(')') {
+				return GeometryCollection{}, true
+			}
+			p.pos--
+		} else {
+			return GeometryCollection{}, p.word() == "EMPTY"
+		}
+		var geometries []GeometryValue
+		ok := p.list(func() bool {
+			g, ok := p.geometry(depth + 1)
+			geometries = append(geometries, g)
+			return ok
+		})
+		return GeometryCollection{Geometries: geometries}, ok
+	default:
+		return nil, false
+	}
+}
+
+// MBR is the minimum bounding rectangle of a geometry, which is the smallest rectangle with sides parallel to the axes
+// that the geometry fits in.
+type MBR struct {
+	MinX float64
+	MinY float64
+	MaxX float64
+	MaxY float64
+}
+
+// GeometryMBR returns the minimum bounding rectangle of a geometry. It returns false if the geometry is empty.
+func GeometryMBR(g GeometryValue) (MBR, bool) {
+	m := MBR{MinX: math.Inf(1), MinY: math.Inf(1), MaxX: math.Inf(-1), MaxY: math.Inf(-1)}
+	empty := true
+	GeometryPoints(g, func(p Point) {
+		empty = false
+		m.MinX = math.Min(m.MinX, p.X)
+		m.MinY = math.Min(m.MinY, p.Y)
+		m.MaxX = math.Max(m.MaxX, p.X)
+		m.MaxY = math.Max(m.MaxY, p.Y)
+	})
+	return m, !empty
+}
+
+// Union returns the smallest rectangle which both rectangles fit in.
+func (m MBR) Union(o MBR) MBR {
+	return MBR{
+		MinX: math.Min(m.MinX, o.MinX),
+		MinY: math.Min(m.MinY, o.MinY),
+		MaxX: math.Max(m.MaxX, o.MaxX),
+		MaxY: math.Max(m.MaxY, o.MaxY),
+	}
+}
+
+// Area returns the area of the rectangle.
+func (m MBR) Area() float64 {
+	return (m.MaxX - m.MinX) * (m.MaxY - m.MinY)
+}
+
+// Equals returns whether both rectangles are the same.
+func (m MBR) Equals(o MBR) bool {
+	return m == o
+}
+
+// Intersects returns whether the rectangles have a point in common.
+func (m MBR) Intersects(o MBR) bool {
+	return m.MinX <= o.MaxX && o.MinX <= m.MaxX && m.MinY <= o.MaxY && o.MinY <= m.MaxY
+}
+
+// Covers returns whether every point of the other rectangle is a point of the rectangle.
+func (m MBR) Covers(o MBR) bool {
+	return m.MinX <= o.MinX && o.MaxX <= m.MaxX && m.MinY <= o.MinY && o.MaxY <= m.MaxY
+}
+
+// Contains returns whether the rectangle covers the other one, and the other one doesn't lie on its boundary.
+func (m MBR) Contains(o MBR) bool {
+	if !m.Covers(o) {
+		return false
+	}
+	onVerticalSide := o.MinX == o.MaxX && (o.MinX == m.MinX || o.MaxX == m.MaxX)
+	onHorizontalSide := o.MinY == o.MaxY && (o.MinY == m.MinY || o.MaxY == m.MaxY)
+	return !onVerticalSide && !onHorizontalSide
+}
+
+// interiorsIntersect returns whether the interiors of the rectangles have a point in common. The interior of a
+// rectangle which is degenerate on an axis is taken as the segment or point it is on that axis.
+func (m MBR) interiorsIntersect(o MBR) bool {
+	return intervalInteriorsIntersect(m.MinX, m.MaxX, o.MinX, o.MaxX) &&
+		intervalInteriorsIntersect(m.MinY, m.MaxY, o.MinY, o.MaxY)
+}
+
+func intervalInteriorsIntersect(min1, max1, min2, max2 float64) bool {
+	switch {
+	case min1 == max1 && min2 == max2:
+		return min1 == min2
+	case min1 == max1:
+		return min2 < min1 && min1 < max2
+	case min2 == max2:
+		return min1 < min2 && min2 < max1
+	default:
+		return min1 < max2 && min2 < max1
+	}
+}
+
+// Touches returns whether the rectangles intersect only at their boundaries.
+func (m MBR) Touches(o MBR) bool {
+	return m.Intersects(o) && !m.interiorsIntersect(o)
+}
+
+// Overlaps returns whether the interiors of the rectangles intersect, while neither covers the other.
+func (m MBR) Overlaps(o MBR) bool {
+	return m.interiorsIntersect(o) && !m.Covers(o) && !o.Covers(m)
+}
+
+// Geometry returns the rectangle as a geometry of the SRID given: a Point or a LineString if it's degenerate, and a
+// Polygon otherwise.
+func (m MBR) Geometry(srid uint32) GeometryValue {
+	switch {
+	case m.MinX == m.MaxX && m.MinY == m.MaxY:
+		return Point{SRID: srid, X: m.MinX, Y: m.MinY}
+	case m.MinX == m.MaxX || m.MinY == m.MaxY:
+		return LineString{SRID: srid, Points: []Point{{X: m.MinX, Y: m.MinY}, {X: m.MaxX, Y: m.MaxY}}}
+	default:
+		return Polygon{SRID: srid, Rings: []LineString{{Points: []Point{
+			{X: m.MinX, Y: m.MinY},
+			{X: m.MaxX, Y: m.MinY},
+			{X: m.MaxX, Y: m.MaxY},
+			{X: m.MinX, Y: m.MaxY},
+			{X: m.MinX, Y: m.MinY},
+		}}}}
+	}
+}
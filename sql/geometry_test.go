@@ -0,0 +1,126 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeometryWKT(t *testing.T) {
+	tests := []struct {
+		wkt      string
+		expected string
+	}{
+		{"POINT(1 2)", "POINT(1 2)"},
+		{"point ( -1.5   2e3 )", "POINT(-1.5 2000)"},
+		{"LINESTRING(0 0, 1 1, 2 0)", "LINESTRING(0 0,1 1,2 0)"},
+		{"POLYGON((0 0,4 0,4 4,0 4,0 0),(1 1,2 1,2 2,1 1))", "POLYGON((0 0,4 0,4 4,0 4,0 0),(1 1,2 1,2 2,1 1))"},
+		{"MULTIPOINT(1 1, 2 2)", "MULTIPOINT((1 1),(2 2))"},
+		{"MULTIPOINT((1 1),(2 2))", "MULTIPOINT((1 1),(2 2))"},
+		{"MULTILINESTRING((0 0,1 1),(2 2,3 3))", "MULTILINESTRING((0 0,1 1),(2 2,3 3))"},
+		{"MULTIPOLYGON(((0 0,1 0,1 1,0 0)))", "MULTIPOLYGON(((0 0,1 0,1 1,0 0)))"},
+		{"GEOMETRYCOLLECTION(POINT(1 1),LINESTRING(0 0,1 1))", "GEOMETRYCOLLECTION(POINT(1 1),LINESTRING(0 0,1 1))"},
+		{"GEOMETRYCOLLECTION EMPTY", "GEOMETRYCOLLECTION EMPTY"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.wkt, func(t *testing.T) {
+			g, ok := GeometryFromWKT(tt.wkt, 0)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, GeometryToWKT(g))
+
+			wkb, ok := GeometryFromWKB(GeometryToWKB(g), 0)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, GeometryToWKT(wkb))
+
+			stored, ok := DeserializeGeometry(SerializeGeometry(g))
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, GeometryToWKT(stored))
+		})
+	}
+
+	for _, wkt := range []string{
+		"",
+		"POINT(1)",
+		"POINT(1 2",
+		"LINESTRING(0 0)",
+		"POLYGON((0 0,1 0,1 1,0 1))",
+		"POINT(1 2) POINT(3 4)",
+		"CIRCLE(0 0)",
+	} {
+		t.Run("invalid "+wkt, func(t *testing.T) {
+			_, ok := GeometryFromWKT(wkt, 0)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestGeometryWKB(t *testing.T) {
+	require := require.New(t)
+
+	wkb, err := hex.DecodeString("0101000000000000000000F03F0000000000000040")
+	require.NoError(err)
+	g, ok := GeometryFromWKB(wkb, 0)
+	require.True(ok)
+	require.Equal(Point{X: 1, Y: 2}, g)
+	require.Equal(wkb, GeometryToWKB(g))
+
+	_, ok = GeometryFromWKB(wkb[:len(wkb)-1], 0)
+	require.False(ok)
+}
+
+func TestGeometrySRID(t *testing.T) {
+	require := require.New(t)
+
+	g, ok := GeometryFromWKT("POINT(10 20)", 4326)
+	require.True(ok)
+	require.Equal(uint32(4326), g.GetSRID())
+	require.Equal(Point{SRID: 4326, X: 20, Y: 10}, SwapAxes(g))
+
+	_, err := LookupSpatialReferenceSystem(1234)
+	require.True(ErrUnknownSRID.Is(err))
+
+	require.NoError(ValidateGeographicCoordinates(Point{SRID: 4326, X: 180, Y: 90}, "st_test"))
+	err = ValidateGeographicCoordinates(Point{SRID: 4326, X: 0, Y: 91}, "st_test")
+	require.True(ErrLatitudeOutOfRange.Is(err))
+	err = ValidateGeographicCoordinates(Point{SRID: 4326, X: -181, Y: 0}, "st_test")
+	require.True(ErrLongitudeOutOfRange.Is(err))
+}
+
+func TestGeometryMBR(t *testing.T) {
+	require := require.New(t)
+
+	g, ok := GeometryFromWKT("LINESTRING(0 3, 2 -1, 4 1)", 0)
+	require.True(ok)
+	mbr, ok := GeometryMBR(g)
+	require.True(ok)
+	require.Equal(MBR{MinX: 0, MinY: -1, MaxX: 4, MaxY: 3}, mbr)
+
+	_, ok = GeometryMBR(GeometryCollection{})
+	require.False(ok)
+
+	square := MBR{MinX: 0, MinY: 0, MaxX: 10, MaxY: 10}
+	require.True(square.Contains(MBR{MinX: 5, MinY: 5, MaxX: 5, MaxY: 5}))
+	require.False(square.Contains(MBR{MinX: 10, MinY: 5, MaxX: 10, MaxY: 5}))
+	require.True(square.Covers(MBR{MinX: 10, MinY: 5, MaxX: 10, MaxY: 5}))
+	require.True(square.Touches(MBR{MinX: 10, MinY: 0, MaxX: 20, MaxY: 10}))
+	require.True(square.Overlaps(MBR{MinX: 5, MinY: 5, MaxX: 15, MaxY: 15}))
+	require.False(square.Intersects(MBR{MinX: 11, MinY: 11, MaxX: 12, MaxY: 12}))
+	require.Equal(Point{X: 1, Y: 1}, MBR{MinX: 1, MinY: 1, MaxX: 1, MaxY: 1}.Geometry(0))
+}
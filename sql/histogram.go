@@ -0,0 +1,111 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"sort"
+)
+
+// HistogramBucket is a single bucket of a Histogram. It covers every value greater than the upper bound of the previous
+// bucket and less than or equal to its own upper bound.
+type HistogramBucket struct {
+	// UpperBound is the largest value in this bucket.
+	UpperBound interface{}
+	// Count is the number of rows with a value in this bucket.
+	Count uint64
+	// DistinctCount is the number of distinct values in this bucket.
+	DistinctCount uint64
+}
+
+// Histogram is an equi-height histogram of the values of a single column. NULL values are not kept in any bucket, but
+// are counted separately.
+type Histogram struct {
+	Buckets   []HistogramBucket
+	NullCount uint64
+}
+
+// NewHistogram builds a histogram with at most |numBuckets| buckets from the values given, which are compared with the
+// type given. Every bucket holds roughly the same number of rows, but the values equal to a bucket's upper bound are
+// never split across two buckets.
+func NewHistogram(typ Type, values []interface{}, numBuckets int) (*Histogram, error) {
+	h := &Histogram{}
+	nonNull := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		if v == nil {
+			h.NullCount++
+		} else {
+			nonNull = append(nonNull, v)
+		}
+	}
+
+	if len(nonNull) == 0 || numBuckets <= 0 {
+		return h, nil
+	}
+
+	var sortErr error
+	sort.SliceStable(nonNull, func(i, j int) bool {
+		cmp, err := typ.Compare(nonNull[i], nonNull[j])
+		if err != nil {
+			sortErr = err
+		}
+		return cmp < 0
+	})
+	if sortErr != nil {
+		return nil, sortErr
+	}
+
+	perBucket := (len(nonNull) + numBuckets - 1) / numBuckets
+	var bucket HistogramBucket
+	for i, v := range nonNull {
+		if i == 0 {
+			bucket.DistinctCount = 1
+		} else {
+			cmp, err := typ.Compare(nonNull[i-1], v)
+			if err != nil {
+				return nil, err
+			}
+			if cmp != 0 {
+				if bucket.Count >= uint64(perBucket) {
+					h.Buckets = append(h.Buckets, bucket)
+					bucket = HistogramBucket{}
+				}
+				bucket.DistinctCount++
+			}
+		}
+		bucket.UpperBound = v
+		bucket.Count++
+	}
+	h.Buckets = append(h.Buckets, bucket)
+
+	return h, nil
+}
+
+// RowCount returns the number of rows described by this histogram, including NULL values.
+func (h *Histogram) RowCount() uint64 {
+	count := h.NullCount
+	for _, b := range h.Buckets {
+		count += b.Count
+	}
+	return count
+}
+
+// DistinctCount returns the number of distinct non-NULL values described by this histogram.
+func (h *Histogram) DistinctCount() uint64 {
+	var count uint64
+	for _, b := range h.Buckets {
+		count += b.DistinctCount
+	}
+	return count
+}
@@ -0,0 +1,44 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHistogram(t *testing.T) {
+	require := require.New(t)
+
+	values := []interface{}{int64(5), int64(1), nil, int64(3), int64(3), int64(2), int64(3), int64(4), nil, int64(1)}
+	h, err := NewHistogram(Int64, values, 3)
+	require.NoError(err)
+
+	require.Equal(uint64(2), h.NullCount)
+	require.Equal(uint64(10), h.RowCount())
+	require.Equal(uint64(5), h.DistinctCount())
+	require.Equal([]HistogramBucket{
+		{UpperBound: int64(2), Count: 3, DistinctCount: 2},
+		{UpperBound: int64(3), Count: 3, DistinctCount: 1},
+		{UpperBound: int64(5), Count: 2, DistinctCount: 2},
+	}, h.Buckets)
+
+	h, err = NewHistogram(Int64, []interface{}{nil}, 3)
+	require.NoError(err)
+	require.Empty(h.Buckets)
+	require.Equal(uint64(1), h.RowCount())
+	require.Equal(uint64(0), h.DistinctCount())
+}
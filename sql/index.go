@@ -44,6 +44,14 @@ type Index interface {
 	IsGenerated() bool
 }
 
+// IndexStatistics is an index that can report the number of distinct keys it contains. The query planner uses this to
+// estimate how many rows a lookup into the index will return.
+type IndexStatistics interface {
+	Index
+	// Cardinality returns the estimated number of distinct keys in the index.
+	Cardinality(ctx *Context) (uint64, error)
+}
+
 // AscendIndex is an index that is sorted in ascending order.
 type AscendIndex interface {
 	// AscendGreaterOrEqual returns an IndexLookup for keys that are greater
@@ -77,6 +85,33 @@ type NegateIndex interface {
 	Not(keys ...interface{}) (IndexLookup, error)
 }
 
+// SpatialIndex is an index of the geometries of a spatial column, which looks them up by their minimum bounding
+// rectangles.
+type SpatialIndex interface {
+	Index
+	// Intersecting returns an IndexLookup for the geometries whose minimum bounding rectangles intersect the one given.
+	Intersecting(mbr MBR) (IndexLookup, error)
+}
+
+// FullTextIndex is an index of the words of text columns, which MATCH ... AGAINST searches.
+type FullTextIndex interface {
+	Index
+	// Statistics returns the statistics of the documents of the index, with their words as split by the parser given.
+	// It's called for every row whose relevance is computed, so that the relevance reflects the current rows.
+	Statistics(ctx *Context, parser *FullTextParser) (FullTextStatistics, error)
+	// Search returns an IndexLookup for the documents which may match the query given, with their words as split by
+	// the parser given.
+	Search(ctx *Context, parser *FullTextParser, query *FullTextQuery) (IndexLookup, error)
+}
+
+// SpatialRelation is a spatial function which relates two geometries, such as ST_Contains.
+type SpatialRelation interface {
+	Expression
+	// IntersectingArguments returns the two geometries the relation is between, and whether it only holds for
+	// geometries whose minimum bounding rectangles intersect, which a SpatialIndex can look up.
+	IntersectingArguments() (Expression, Expression, bool)
+}
+
 // IndexLookup is the implementation-specific definition of an index lookup, created by calls to Index.Get(). The
 // IndexLookup must contain all necessary information to retrieve exactly the rows in the table specified by key(s)
 // specified in Index.Get(). Implementors are responsible for all semantics of correctly returning rows that match an
@@ -520,6 +520,74 @@ func tablesRowIter(ctx *Context, cat *Catalog) (RowIter, error) {
 	return RowsToRowIter(rows...), nil
 }
 
+func partitionsRowIter(ctx *Context, cat *Catalog) (RowIter, error) {
+	var rows []Row
+	for _, db := range cat.AllDatabases() {
+		err := DBTableIter(ctx, db, func(t Table) (cont bool, err error) {
+			pt, ok := t.(PartitionedTable)
+			if !ok {
+				return true, nil
+			}
+			p, err := pt.GetPartitioning(ctx)
+			if err != nil {
+				return false, err
+			}
+			if p == nil {
+				return true, nil
+			}
+
+			for i, d := range p.Definitions {
+				var description interface{}
+				if desc, ok := p.Description(i); ok {
+					description = desc
+				}
+
+				var tableRows interface{}
+				if st, ok := pt.WithPartitions([]string{d.Name}).(StatisticsTable); ok {
+					if tableRows, err = st.NumRows(ctx); err != nil {
+						return false, err
+					}
+				}
+
+				rows = append(rows, Row{
+					"def",                // table_catalog
+					db.Name(),            // table_schema
+					t.Name(),             // table_name
+					d.Name,               // partition_name
+					nil,                  // subpartition_name
+					uint64(i + 1),        // partition_ordinal_position
+					nil,                  // subpartition_ordinal_position
+					p.MethodName(),       // partition_method
+					nil,                  // subpartition_method
+					p.ExpressionString(), // partition_expression
+					nil,                  // subpartition_expression
+					description,          // partition_description
+					tableRows,            // table_rows
+					nil,                  // avg_row_length
+					nil,                  // data_length
+					nil,                  // max_data_length
+					nil,                  // index_length
+					nil,                  // data_free
+					nil,                  // create_time
+					nil,                  // update_time
+					nil,                  // check_time
+					nil,                  // checksum
+					d.Comment,            // partition_comment
+					nil,                  // nodegroup
+					nil,                  // tablespace_name
+				})
+			}
+
+			return true, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return RowsToRowIter(rows...), nil
+}
+
 func columnsRowIter(ctx *Context, cat *Catalog) (RowIter, error) {
 	var rows []Row
 	for _, db := range cat.AllDatabases() {
@@ -1061,7 +1129,7 @@ func NewInformationSchemaDatabase(cat *Catalog) Database {
 				name:    PartitionsTableName,
 				schema:  partitionSchema,
 				catalog: cat,
-				rowIter: emptyRowIter,
+				rowIter: partitionsRowIter,
 			},
 			InnoDBTempTableName: &informationSchemaTable{
 				name:    InnoDBTempTableName,
@@ -20,7 +20,10 @@ import (
 	"time"
 	"unsafe"
 
+	kitmetrics "github.com/go-kit/kit/metrics"
 	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/linanh/go-mysql-server/metrics"
 )
 
 // ErrLockTimeout is the kind of error returned when acquiring a lock takes longer than the user specified timeout
@@ -32,6 +35,25 @@ var ErrLockDoesNotExist = errors.NewKind("Lock '%s' does not exist.")
 // ErrLockNotOwned is the kind of error returned when attempting an operation against a lock that the given context does not own.
 var ErrLockNotOwned = errors.NewKind("Operation '%s' failed as the lock '%s' has a different owner.")
 
+var (
+	// LockWaitCounter describes a metric that accumulates number of times acquiring a named lock had to wait
+	// monotonically, for the lock subsystems without metrics of their own.
+	LockWaitCounter = newLockWaitCounter(metrics.Default)
+
+	// LockWaitHistogram describes the time spent waiting for named locks, for the lock subsystems without metrics of
+	// their own.
+	LockWaitHistogram = newLockWaitHistogram(metrics.Default)
+)
+
+func newLockWaitCounter(r *metrics.Registry) kitmetrics.Counter {
+	return r.NewCounter("gms_lock_waits_total", "Number of times acquiring a named lock had to wait for another session.")
+}
+
+func newLockWaitHistogram(r *metrics.Registry) kitmetrics.Histogram {
+	return r.NewHistogram("gms_lock_wait_seconds", "Time spent waiting for named locks in seconds.",
+		metrics.DefaultBuckets)
+}
+
 type ownedLock struct {
 	Owner int64
 	Count int64
@@ -41,11 +63,21 @@ type ownedLock struct {
 type LockSubsystem struct {
 	lockLock *sync.RWMutex
 	locks    map[string]**ownedLock
+	// waits and waitTime observe the waits for locks, instead of LockWaitCounter and LockWaitHistogram if not nil
+	waits    kitmetrics.Counter
+	waitTime kitmetrics.Histogram
 }
 
 // NewLockSubsystem creates a LockSubsystem object
 func NewLockSubsystem() *LockSubsystem {
-	return &LockSubsystem{&sync.RWMutex{}, make(map[string]**ownedLock)}
+	return &LockSubsystem{lockLock: &sync.RWMutex{}, locks: make(map[string]**ownedLock)}
+}
+
+// RegisterMetrics registers the metrics of the waits for the locks in the registry given, which are observed instead of
+// LockWaitCounter and LockWaitHistogram.
+func (ls *LockSubsystem) RegisterMetrics(r *metrics.Registry) {
+	ls.waits = newLockWaitCounter(r)
+	ls.waitTime = newLockWaitHistogram(r)
 }
 
 func (ls *LockSubsystem) getNamedLock(name string) **ownedLock {
@@ -80,7 +112,21 @@ func (ls *LockSubsystem) Lock(ctx *Context, name string, timeout time.Duration)
 	}
 
 	userId := int64(ctx.Session.ID())
-	for i, start := 0, time.Now(); i == 0 || timeout < 0 || time.Since(start) < timeout; i++ {
+	start := time.Now()
+	waited := false
+	defer func() {
+		if waited {
+			waits, waitTime := LockWaitCounter, LockWaitHistogram
+			if ls.waits != nil {
+				waits, waitTime = ls.waits, ls.waitTime
+			}
+			waits.Add(1)
+			waitTime.Observe(time.Since(start).Seconds())
+			ctx.AddLockTime(time.Since(start))
+		}
+	}()
+
+	for i := 0; i == 0 || timeout < 0 || time.Since(start) < timeout; i++ {
 		dest := (*unsafe.Pointer)(unsafe.Pointer(nl))
 		curr := atomic.LoadPointer(dest)
 		currLock := *(*ownedLock)(curr)
@@ -97,6 +143,7 @@ func (ls *LockSubsystem) Lock(ctx *Context, name string, timeout time.Duration)
 			}
 		}
 
+		waited = true
 		time.Sleep(100 * time.Microsecond)
 	}
 
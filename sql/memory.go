@@ -145,6 +145,18 @@ func (m *MemoryManager) HasAvailable() bool {
 	return HasAvailableMemory(m.reporter)
 }
 
+// UsedMemory returns the memory in use in bytes, as reported by the memory manager's reporter.
+func (m *MemoryManager) UsedMemory() uint64 {
+	return m.reporter.UsedMemory()
+}
+
+// NumCaches returns the number of caches the memory manager is keeping track of.
+func (m *MemoryManager) NumCaches() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.caches)
+}
+
 // DisposeFunc is a function to completely erase a cache and remove it from the manager.
 type DisposeFunc func()
 
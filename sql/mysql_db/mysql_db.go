@@ -0,0 +1,429 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mysql_db implements the grant tables of the mysql system database, which are read-only views over the
+// accounts and privileges in a sql.PrivilegeStore.
+package mysql_db
+
+import (
+	"bytes"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+const (
+	// MysqlDatabaseName is the name of the mysql system database.
+	MysqlDatabaseName = "mysql"
+	// UserTableName is the name of the user table, with an entry for each account and its global privileges.
+	UserTableName = "user"
+	// DbTableName is the name of the db table, with an entry for each database level grant.
+	DbTableName = "db"
+	// TablesPrivTableName is the name of the tables_priv table, with an entry for each table level grant.
+	TablesPrivTableName = "tables_priv"
+	// ColumnsPrivTableName is the name of the columns_priv table, with an entry for each column level grant.
+	ColumnsPrivTableName = "columns_priv"
+	// RoleEdgesTableName is the name of the role_edges table, with an entry for each role granted to an account.
+	RoleEdgesTableName = "role_edges"
+)
+
+// privilegeName is the name of a privilege in a column name or SET value of a grant table.
+type privilegeName struct {
+	name string
+	priv sql.PrivilegeType
+}
+
+// privilegeColumns maps the privilege columns of the user and db tables to their privileges, in MySQL's order.
+var privilegeColumns = []privilegeName{
+	{"Select_priv", sql.PrivilegeType_Select},
+	{"Insert_priv", sql.PrivilegeType_Insert},
+	{"Update_priv", sql.PrivilegeType_Update},
+	{"Delete_priv", sql.PrivilegeType_Delete},
+	{"Create_priv", sql.PrivilegeType_Create},
+	{"Drop_priv", sql.PrivilegeType_Drop},
+	{"Reload_priv", sql.PrivilegeType_Reload},
+	{"Shutdown_priv", sql.PrivilegeType_Shutdown},
+	{"Process_priv", sql.PrivilegeType_Process},
+	{"File_priv", sql.PrivilegeType_File},
+	{"Grant_priv", sql.PrivilegeType_GrantOption},
+	{"References_priv", sql.PrivilegeType_References},
+	{"Index_priv", sql.PrivilegeType_Index},
+	{"Alter_priv", sql.PrivilegeType_Alter},
+	{"Show_db_priv", sql.PrivilegeType_ShowDatabases},
+	{"Super_priv", sql.PrivilegeType_Super},
+	{"Create_tmp_table_priv", sql.PrivilegeType_CreateTemporaryTables},
+	{"Lock_tables_priv", sql.PrivilegeType_LockTables},
+	{"Execute_priv", sql.PrivilegeType_Execute},
+	{"Repl_slave_priv", sql.PrivilegeType_ReplicationSlave},
+	{"Repl_client_priv", sql.PrivilegeType_ReplicationClient},
+	{"Create_view_priv", sql.PrivilegeType_CreateView},
+	{"Show_view_priv", sql.PrivilegeType_ShowView},
+	{"Create_routine_priv", sql.PrivilegeType_CreateRoutine},
+	{"Alter_routine_priv", sql.PrivilegeType_AlterRoutine},
+	{"Create_user_priv", sql.PrivilegeType_CreateUser},
+	{"Event_priv", sql.PrivilegeType_Event},
+	{"Trigger_priv", sql.PrivilegeType_Trigger},
+	{"Create_role_priv", sql.PrivilegeType_CreateRole},
+	{"Drop_role_priv", sql.PrivilegeType_DropRole},
+}
+
+// tablePrivilegeNames are the values of the Table_priv SET column of tables_priv.
+var tablePrivilegeNames = []privilegeName{
+	{"Select", sql.PrivilegeType_Select},
+	{"Insert", sql.PrivilegeType_Insert},
+	{"Update", sql.PrivilegeType_Update},
+	{"Delete", sql.PrivilegeType_Delete},
+	{"Create", sql.PrivilegeType_Create},
+	{"Drop", sql.PrivilegeType_Drop},
+	{"Grant", sql.PrivilegeType_GrantOption},
+	{"References", sql.PrivilegeType_References},
+	{"Index", sql.PrivilegeType_Index},
+	{"Alter", sql.PrivilegeType_Alter},
+	{"Create View", sql.PrivilegeType_CreateView},
+	{"Show view", sql.PrivilegeType_ShowView},
+	{"Trigger", sql.PrivilegeType_Trigger},
+}
+
+// columnPrivilegeNames are the values of the Column_priv SET columns of tables_priv and columns_priv.
+var columnPrivilegeNames = []privilegeName{
+	{"Select", sql.PrivilegeType_Select},
+	{"Insert", sql.PrivilegeType_Insert},
+	{"Update", sql.PrivilegeType_Update},
+	{"References", sql.PrivilegeType_References},
+}
+
+var (
+	yesNo         = sql.MustCreateEnumType([]string{"N", "Y"}, sql.Collation_Default)
+	tablePrivSet  = privilegeSet(tablePrivilegeNames)
+	columnPrivSet = privilegeSet(columnPrivilegeNames)
+)
+
+func privilegeSet(names []privilegeName) sql.SetType {
+	values := make([]string, len(names))
+	for i, n := range names {
+		values[i] = n.name
+	}
+	return sql.MustCreateSetType(values, sql.Collation_Default)
+}
+
+var userSchema = func() sql.Schema {
+	s := sql.Schema{
+		{Name: "Host", Type: sql.LongText, Source: UserTableName, PrimaryKey: true},
+		{Name: "User", Type: sql.LongText, Source: UserTableName, PrimaryKey: true},
+	}
+	for _, c := range privilegeColumns {
+		s = append(s, &sql.Column{Name: c.name, Type: yesNo, Source: UserTableName})
+	}
+	return append(s,
+		&sql.Column{Name: "plugin", Type: sql.LongText, Source: UserTableName},
+		&sql.Column{Name: "authentication_string", Type: sql.LongText, Source: UserTableName, Nullable: true},
+		&sql.Column{Name: "account_locked", Type: yesNo, Source: UserTableName},
+	)
+}()
+
+var dbSchema = func() sql.Schema {
+	s := sql.Schema{
+		{Name: "Host", Type: sql.LongText, Source: DbTableName, PrimaryKey: true},
+		{Name: "Db", Type: sql.LongText, Source: DbTableName, PrimaryKey: true},
+		{Name: "User", Type: sql.LongText, Source: DbTableName, PrimaryKey: true},
+	}
+	for _, c := range privilegeColumns {
+		if sql.PrivilegeType_Database.Has(c.priv) {
+			s = append(s, &sql.Column{Name: c.name, Type: yesNo, Source: DbTableName})
+		}
+	}
+	return s
+}()
+
+var tablesPrivSchema = sql.Schema{
+	{Name: "Host", Type: sql.LongText, Source: TablesPrivTableName, PrimaryKey: true},
+	{Name: "Db", Type: sql.LongText, Source: TablesPrivTableName, PrimaryKey: true},
+	{Name: "User", Type: sql.LongText, Source: TablesPrivTableName, PrimaryKey: true},
+	{Name: "Table_name", Type: sql.LongText, Source: TablesPrivTableName, PrimaryKey: true},
+	{Name: "Table_priv", Type: tablePrivSet, Source: TablesPrivTableName},
+	{Name: "Column_priv", Type: columnPrivSet, Source: TablesPrivTableName},
+}
+
+var columnsPrivSchema = sql.Schema{
+	{Name: "Host", Type: sql.LongText, Source: ColumnsPrivTableName, PrimaryKey: true},
+	{Name: "Db", Type: sql.LongText, Source: ColumnsPrivTableName, PrimaryKey: true},
+	{Name: "User", Type: sql.LongText, Source: ColumnsPrivTableName, PrimaryKey: true},
+	{Name: "Table_name", Type: sql.LongText, Source: ColumnsPrivTableName, PrimaryKey: true},
+	{Name: "Column_name", Type: sql.LongText, Source: ColumnsPrivTableName, PrimaryKey: true},
+	{Name: "Column_priv", Type: columnPrivSet, Source: ColumnsPrivTableName},
+}
+
+var roleEdgesSchema = sql.Schema{
+	{Name: "FROM_HOST", Type: sql.LongText, Source: RoleEdgesTableName, PrimaryKey: true},
+	{Name: "FROM_USER", Type: sql.LongText, Source: RoleEdgesTableName, PrimaryKey: true},
+	{Name: "TO_HOST", Type: sql.LongText, Source: RoleEdgesTableName, PrimaryKey: true},
+	{Name: "TO_USER", Type: sql.LongText, Source: RoleEdgesTableName, PrimaryKey: true},
+	{Name: "WITH_ADMIN_OPTION", Type: yesNo, Source: RoleEdgesTableName},
+}
+
+type database struct {
+	tables map[string]sql.Table
+}
+
+var _ sql.Database = (*database)(nil)
+
+// NewDatabase returns the mysql database for the privilege store given.
+func NewDatabase(store sql.PrivilegeStore) sql.Database {
+	tables := make(map[string]sql.Table)
+	for _, t := range []*grantTable{
+		{name: UserTableName, schema: userSchema, rowIter: userRows},
+		{name: DbTableName, schema: dbSchema, rowIter: dbRows},
+		{name: TablesPrivTableName, schema: tablesPrivSchema, rowIter: tablesPrivRows},
+		{name: ColumnsPrivTableName, schema: columnsPrivSchema, rowIter: columnsPrivRows},
+		{name: RoleEdgesTableName, schema: roleEdgesSchema, rowIter: roleEdgesRows},
+	} {
+		t.store = store
+		tables[t.name] = t
+	}
+	return &database{tables: tables}
+}
+
+// Name implements the sql.Database interface.
+func (db *database) Name() string {
+	return MysqlDatabaseName
+}
+
+// GetTableInsensitive implements the sql.Database interface.
+func (db *database) GetTableInsensitive(ctx *sql.Context, tblName string) (sql.Table, bool, error) {
+	tbl, ok := sql.GetTableInsensitive(tblName, db.tables)
+	return tbl, ok, nil
+}
+
+// GetTableNames implements the sql.Database interface.
+func (db *database) GetTableNames(ctx *sql.Context) ([]string, error) {
+	names := make([]string, 0, len(db.tables))
+	for name := range db.tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// grantTable is a table generated from the contents of a privilege store.
+type grantTable struct {
+	name    string
+	schema  sql.Schema
+	store   sql.PrivilegeStore
+	rowIter func(*sql.Context, sql.PrivilegeStore) ([]sql.Row, error)
+}
+
+var _ sql.Table = (*grantTable)(nil)
+
+// Name implements the sql.Table interface.
+func (t *grantTable) Name() string {
+	return t.name
+}
+
+// String implements the sql.Table interface.
+func (t *grantTable) String() string {
+	return t.name
+}
+
+// Schema implements the sql.Table interface.
+func (t *grantTable) Schema() sql.Schema {
+	return t.schema
+}
+
+// Partitions implements the sql.Table interface.
+func (t *grantTable) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
+	return &singlePartitionIter{key: t.partitionKey()}, nil
+}
+
+// PartitionRows implements the sql.Table interface.
+func (t *grantTable) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
+	if !bytes.Equal(partition.Key(), t.partitionKey()) {
+		return nil, sql.ErrPartitionNotFound.New(partition.Key())
+	}
+	rows, err := t.rowIter(ctx, t.store)
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+func (t *grantTable) partitionKey() []byte {
+	return []byte(MysqlDatabaseName + "." + t.name)
+}
+
+type singlePartitionIter struct {
+	key  []byte
+	done bool
+}
+
+func (p *singlePartitionIter) Key() []byte {
+	return p.key
+}
+
+func (p *singlePartitionIter) Next() (sql.Partition, error) {
+	if p.done {
+		return nil, io.EOF
+	}
+	p.done = true
+	return p, nil
+}
+
+func (p *singlePartitionIter) Close(*sql.Context) error {
+	return nil
+}
+
+func yn(b bool) string {
+	if b {
+		return "Y"
+	}
+	return "N"
+}
+
+func userRows(ctx *sql.Context, store sql.PrivilegeStore) ([]sql.Row, error) {
+	accounts, err := store.Accounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []sql.Row
+	for _, a := range accounts {
+		privs, err := sql.GrantOn(ctx, store, a.UserIdentity, sql.PrivilegeTarget{})
+		if err != nil {
+			return nil, err
+		}
+		row := sql.Row{a.Host, a.User}
+		for _, c := range privilegeColumns {
+			row = append(row, yn(privs.Has(c.priv)))
+		}
+		row = append(row, a.Plugin, a.AuthString, yn(a.Locked))
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func dbRows(ctx *sql.Context, store sql.PrivilegeStore) ([]sql.Row, error) {
+	grants, err := store.Grants(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []sql.Row
+	for _, g := range grants {
+		if g.Target.Database == "" || g.Target.Table != "" {
+			continue
+		}
+		row := sql.Row{g.Grantee.Host, g.Target.Database, g.Grantee.User}
+		for _, c := range privilegeColumns {
+			if sql.PrivilegeType_Database.Has(c.priv) {
+				row = append(row, yn(g.Privileges.Has(c.priv)))
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func tablesPrivRows(ctx *sql.Context, store sql.PrivilegeStore) ([]sql.Row, error) {
+	grants, err := store.Grants(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Column grants are summarized in the Column_priv column of the table's row, so group them by table
+	type key struct {
+		grantee sql.UserIdentity
+		db      string
+		table   string
+	}
+	var order []key
+	tablePrivs := make(map[key]sql.PrivilegeType)
+	columnPrivs := make(map[key]sql.PrivilegeType)
+	for _, g := range grants {
+		if g.Target.Table == "" {
+			continue
+		}
+		k := key{g.Grantee, g.Target.Database, g.Target.Table}
+		if _, ok := tablePrivs[k]; !ok {
+			if _, ok := columnPrivs[k]; !ok {
+				order = append(order, k)
+			}
+		}
+		if g.Target.Column == "" {
+			tablePrivs[k] |= g.Privileges
+		} else {
+			columnPrivs[k] |= g.Privileges
+		}
+	}
+
+	rows := make([]sql.Row, len(order))
+	for i, k := range order {
+		rows[i] = sql.Row{
+			k.grantee.Host,
+			k.db,
+			k.grantee.User,
+			k.table,
+			setString(tablePrivs[k], tablePrivilegeNames),
+			setString(columnPrivs[k], columnPrivilegeNames),
+		}
+	}
+	return rows, nil
+}
+
+func columnsPrivRows(ctx *sql.Context, store sql.PrivilegeStore) ([]sql.Row, error) {
+	grants, err := store.Grants(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []sql.Row
+	for _, g := range grants {
+		if g.Target.Column == "" {
+			continue
+		}
+		rows = append(rows, sql.Row{
+			g.Grantee.Host,
+			g.Target.Database,
+			g.Grantee.User,
+			g.Target.Table,
+			g.Target.Column,
+			setString(g.Privileges, columnPrivilegeNames),
+		})
+	}
+	return rows, nil
+}
+
+func roleEdgesRows(ctx *sql.Context, store sql.PrivilegeStore) ([]sql.Row, error) {
+	edges, err := store.RoleEdges(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]sql.Row, len(edges))
+	for i, e := range edges {
+		rows[i] = sql.Row{e.Role.Host, e.Role.User, e.Grantee.Host, e.Grantee.User, yn(e.AdminOption)}
+	}
+	return rows, nil
+}
+
+// setString returns the value of a privilege SET column holding the privileges given.
+func setString(privs sql.PrivilegeType, names []privilegeName) string {
+	var values []string
+	for _, n := range names {
+		if privs.Has(n.priv) {
+			values = append(values, n.name)
+		}
+	}
+	return strings.Join(values, ",")
+}
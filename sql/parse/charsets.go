@@ -0,0 +1,115 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// rewriteCharsets returns a query with its character set introducers, such as _latin1'abc', rewritten as
+// CONVERT(X'...' USING latin1), which the parser supports. The bytes of a string literal are the ones it was sent
+// with, in the character set of the client, and the ones of hex literals are kept as they are. Only _binary and
+// _utf8mb4 are parsed as introducers, and are left alone. The binary keyword of CONVERT(x USING binary) is quoted,
+// as the parser only takes identifiers and strings as character sets.
+func rewriteCharsets(ctx *sql.Context, query string) string {
+	if !strings.Contains(query, "_") && !strings.Contains(strings.ToLower(query), "binary") {
+		return query
+	}
+
+	var b strings.Builder
+	last := 0
+	tokenizer := sqlparser.NewStringTokenizer(query)
+	prevTyp, prevStart, prevEnd, prevVal := 0, 0, 0, ""
+	for {
+		typ, val := tokenizer.Scan()
+		if typ == 0 || typ == sqlparser.LEX_ERROR {
+			break
+		}
+		end := tokenizer.Position - 1
+
+		if prevTyp == sqlparser.USING && typ == sqlparser.BINARY {
+			start := end - len(val)
+			if start >= 0 && end <= len(query) && strings.EqualFold(query[start:end], "binary") {
+				b.WriteString(query[last:start])
+				b.WriteString("'binary'")
+				last = end
+			}
+		} else if prevTyp == sqlparser.ID && (typ == sqlparser.STRING || typ == sqlparser.HEX || typ == sqlparser.HEXNUM) {
+			if cs, ok := introducerCharacterSet(query, prevStart, prevEnd, prevVal); ok && end <= len(query) {
+				literal, ok := introducedBytes(ctx, typ, val)
+				if ok {
+					b.WriteString(query[last:prevStart])
+					b.WriteString("CONVERT(X'")
+					b.WriteString(hex.EncodeToString(literal))
+					b.WriteString("' USING ")
+					b.WriteString(string(cs))
+					b.WriteString(")")
+					last = end
+				}
+			}
+		}
+
+		prevTyp, prevEnd, prevVal = typ, end, string(val)
+		prevStart = end - len(val)
+	}
+
+	if last == 0 {
+		return query
+	}
+	b.WriteString(query[last:])
+	return b.String()
+}
+
+// introducerCharacterSet returns the character set of an identifier token which is a character set introducer.
+func introducerCharacterSet(query string, start, end int, val string) (sql.CharacterSet, bool) {
+	if !strings.HasPrefix(val, "_") || start < 0 || end > len(query) || query[start:end] != val {
+		return "", false
+	}
+	cs, err := sql.ParseCharacterSet(strings.ToLower(val[1:]))
+	if err != nil {
+		return "", false
+	}
+	return cs, true
+}
+
+// introducedBytes returns the bytes of a literal following a character set introducer.
+func introducedBytes(ctx *sql.Context, typ int, val []byte) ([]byte, bool) {
+	switch typ {
+	case sqlparser.STRING:
+		if client, ok := sql.CharacterSetVariable(ctx, "character_set_client"); ok && !client.IsUnicode() {
+			if e, err := client.Encoding(); err == nil {
+				encoded, _ := e.Encode(string(val))
+				return encoded, true
+			}
+		}
+		return val, true
+	case sqlparser.HEX:
+		decoded, err := hex.DecodeString(string(val))
+		return decoded, err == nil
+	case sqlparser.HEXNUM:
+		s := strings.TrimPrefix(strings.ToLower(string(val)), "0x")
+		if len(s)%2 == 1 {
+			s = "0" + s
+		}
+		decoded, err := hex.DecodeString(s)
+		return decoded, err == nil
+	}
+	return nil, false
+}
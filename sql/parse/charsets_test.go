@@ -0,0 +1,58 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+func TestRewriteCharsets(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected string
+	}{
+		{
+			"select _latin1'é', _cp1251 x'CF', _sjis 0x93FA",
+			"select CONVERT(X'c3a9' USING latin1), CONVERT(X'cf' USING cp1251), CONVERT(X'93fa' USING sjis)",
+		},
+		{
+			"select convert(a using binary) from t where b = _utf8mb4'x' or c = _binary'y'",
+			"select convert(a using 'binary') from t where b = _utf8mb4'x' or c = _binary'y'",
+		},
+		{
+			"select _latin1 'a''b', `_latin1` from t",
+			"select CONVERT(X'612762' USING latin1), `_latin1` from t",
+		},
+		{
+			"select _nonexistent'a', _id from t join u using (_id)",
+			"select _nonexistent'a', _id from t join u using (_id)",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			require.Equal(t, tt.expected, rewriteCharsets(sql.NewEmptyContext(), tt.query))
+		})
+	}
+
+	// The bytes of strings are the ones the client sent them with
+	ctx := sql.NewEmptyContext()
+	require.NoError(t, ctx.SetSessionVariable(ctx, "character_set_client", "latin1"))
+	require.Equal(t, "select CONVERT(X'e9' USING cp1251)", rewriteCharsets(ctx, "select _cp1251'é'"))
+}
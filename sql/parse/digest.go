@@ -0,0 +1,207 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// operatorTokens are the text of the operator tokens which the tokenizer doesn't return the text of.
+var operatorTokens = map[int]string{
+	sqlparser.AND:                     "AND",
+	sqlparser.OR:                      "OR",
+	sqlparser.LE:                      "<=",
+	sqlparser.GE:                      ">=",
+	sqlparser.NE:                      "!=",
+	sqlparser.NULL_SAFE_EQUAL:         "<=>",
+	sqlparser.SHIFT_LEFT:              "<<",
+	sqlparser.SHIFT_RIGHT:             ">>",
+	sqlparser.JSON_EXTRACT_OP:         "->",
+	sqlparser.JSON_UNQUOTE_EXTRACT_OP: "->>",
+}
+
+// NormalizeQuery returns the normalized text of a query, as in MySQL's statement digests: literals are replaced with
+// ?, the lists of values of IN and VALUES are collapsed to (...), comments are removed, keywords are upper cased and
+// identifiers are quoted. Queries which only differ in their values have the same normalized text.
+func NormalizeQuery(query string) string {
+	text, _ := normalizeQuery(query)
+	return text
+}
+
+// normalizeQuery returns the normalized text of a query and its statement type, as StatementType.
+func normalizeQuery(query string) (text string, statementType string) {
+	var tokens []string
+	var typer statementTyper
+	tokenizer := sqlparser.NewStringTokenizer(query)
+	for {
+		typ, val := tokenizer.Scan()
+		typer.next(typ, val)
+		switch typ {
+		case 0:
+			return strings.Join(tokens, " "), typer.typ
+		case sqlparser.LEX_ERROR:
+			// The rest of the query can't be tokenized, and is kept as it is
+			rest := strings.TrimSpace(query[tokenizer.OldPosition:])
+			if rest != "" {
+				tokens = append(tokens, rest)
+			}
+			return strings.Join(tokens, " "), typer.typ
+		case sqlparser.COMMENT:
+			continue
+		case sqlparser.STRING, sqlparser.INTEGRAL, sqlparser.FLOAT, sqlparser.DECIMAL, sqlparser.HEX, sqlparser.HEXNUM,
+			sqlparser.BIT_LITERAL, sqlparser.VALUE_ARG, sqlparser.LIST_ARG:
+			tokens = append(tokens, "?")
+		case sqlparser.ID:
+			if strings.HasPrefix(string(val), "@") {
+				tokens = append(tokens, string(val))
+			} else {
+				tokens = append(tokens, "`"+strings.Replace(string(val), "`", "``", -1)+"`")
+			}
+		case ')':
+			tokens = collapseValueList(append(tokens, ")"))
+		default:
+			switch {
+			case val != nil:
+				tokens = append(tokens, strings.ToUpper(string(val)))
+			case operatorTokens[typ] != "":
+				tokens = append(tokens, operatorTokens[typ])
+			case typ < 256:
+				tokens = append(tokens, string(rune(typ)))
+			default:
+				tokens = append(tokens, strings.ToUpper(sqlparser.KeywordString(typ)))
+			}
+		}
+	}
+}
+
+// collapseValueList collapses the list of values of IN or VALUES which the tokens given end with, if they do, to
+// (...). The rows of VALUES after the first one are removed.
+func collapseValueList(tokens []string) []string {
+	// Find the start of the list, which only holds values separated by commas
+	start := -1
+	for i := len(tokens) - 2; i >= 0; i-- {
+		if tokens[i] == "(" {
+			start = i
+			break
+		}
+		if tokens[i] != "?" && tokens[i] != "," {
+			return tokens
+		}
+	}
+	if start <= 0 {
+		return tokens
+	}
+
+	switch tokens[start-1] {
+	case "IN", "VALUES", "VALUE":
+		return append(tokens[:start], "(...)")
+	case ",":
+		// A row of VALUES after the first one
+		if start >= 2 && tokens[start-2] == "(...)" {
+			return tokens[:start-1]
+		}
+	}
+	return tokens
+}
+
+// QueryDigest returns the digest of a query, which is the hex encoded SHA-256 hash of its normalized text, and the
+// normalized text.
+func QueryDigest(query string) (digest string, text string) {
+	text = NormalizeQuery(query)
+	return digestOf(text), text
+}
+
+// StatementDigest returns the type of a statement, as StatementType, and its digest and normalized text, as
+// QueryDigest, tokenizing it only once.
+func StatementDigest(query string) (statementType string, digest string, text string) {
+	text, statementType = normalizeQuery(query)
+	return statementType, digestOf(text), text
+}
+
+func digestOf(text string) string {
+	sum := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(sum[:])
+}
+
+// StatementType returns the type of a statement, which is its first keyword in lower case, such as select or insert.
+// Statements starting with WITH are of the type of the statement following their common table expressions, those
+// starting with a parenthesis are select statements, and those which don't start with a keyword are of type other.
+func StatementType(query string) string {
+	var typer statementTyper
+	tokenizer := sqlparser.NewStringTokenizer(query)
+	for {
+		typ, val := tokenizer.Scan()
+		if typer.next(typ, val) {
+			return typer.typ
+		}
+	}
+}
+
+// statementTyper finds the type of a statement, as StatementType, from its tokens.
+type statementTyper struct {
+	// typ is the type of the statement, or empty if it isn't known yet
+	typ string
+	// with is whether the statement starts with WITH
+	with bool
+	// depth is the number of parentheses open, after WITH
+	depth int
+}
+
+// next takes the next token of the statement, and returns whether its type is known.
+func (s *statementTyper) next(typ int, val []byte) bool {
+	if s.typ != "" || typ == sqlparser.COMMENT {
+		return s.typ != ""
+	}
+	if !s.with {
+		if typ == sqlparser.WITH {
+			s.with = true
+			return false
+		}
+		s.typ = tokenStatementType(typ, val)
+		return true
+	}
+
+	switch typ {
+	case '(':
+		s.depth++
+	case ')':
+		s.depth--
+	case sqlparser.SELECT, sqlparser.UPDATE, sqlparser.DELETE:
+		if s.depth == 0 {
+			s.typ = strings.ToLower(string(val))
+		}
+	case 0, sqlparser.LEX_ERROR:
+		s.typ = "select"
+	}
+	return s.typ != ""
+}
+
+// tokenStatementType returns the type of a statement which doesn't start with WITH, given its first token.
+func tokenStatementType(typ int, val []byte) string {
+	switch typ {
+	case '(':
+		return "select"
+	case 0, sqlparser.LEX_ERROR, sqlparser.ID:
+		return "other"
+	}
+	if typ < 256 || val == nil {
+		return "other"
+	}
+	return strings.ToLower(string(val))
+}
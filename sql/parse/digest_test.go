@@ -0,0 +1,106 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeQuery(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected string
+	}{
+		{
+			"select a, b from t where x = 1 and y in (1, 2, 3) -- comment",
+			"SELECT `a` , `b` FROM `t` WHERE `x` = ? AND `y` IN (...)",
+		},
+		{
+			"SELECT a FROM t WHERE x = 'foo' /* comment */ AND y >= 2.5 OR z <=> ?",
+			"SELECT `a` FROM `t` WHERE `x` = ? AND `y` >= ? OR `z` <=> ?",
+		},
+		{
+			"insert into t values (1, 'a'), (2, 'b'), (3, \"c\")",
+			"INSERT INTO `t` VALUES (...)",
+		},
+		{
+			"insert into t (a, b) values (1, 'a')",
+			"INSERT INTO `t` ( `a` , `b` ) VALUES (...)",
+		},
+		{
+			"select count(*) from `my table` where f(1, 2) > 0",
+			"SELECT COUNT ( * ) FROM `my table` WHERE `f` ( ? , ? ) > ?",
+		},
+		{
+			"select @@autocommit, @a",
+			"SELECT @@autocommit , @a",
+		},
+		{
+			"with cte as (select 1) select * from cte",
+			"WITH `cte` AS ( SELECT ? ) SELECT * FROM `cte`",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			require.Equal(t, tt.expected, NormalizeQuery(tt.query))
+		})
+	}
+}
+
+func TestQueryDigest(t *testing.T) {
+	require := require.New(t)
+
+	digest, text := QueryDigest("select * from t where a = 1")
+	require.Equal("SELECT * FROM `t` WHERE `a` = ?", text)
+	require.Len(digest, 64)
+
+	other, _ := QueryDigest("SELECT *   FROM t WHERE a = 'x'")
+	require.Equal(digest, other)
+
+	other, _ = QueryDigest("select * from t where b = 1")
+	require.NotEqual(digest, other)
+}
+
+func TestStatementType(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected string
+	}{
+		{"select 1", "select"},
+		{"/* comment */ INSERT INTO t VALUES (1)", "insert"},
+		{"with cte as (select 1) select * from cte", "select"},
+		{"WITH cte (a) AS (SELECT 1), cte2 AS (SELECT (2)) DELETE FROM t WHERE a IN (SELECT a FROM cte)", "delete"},
+		{"with recursive cte as (select 1) update t, cte set t.a = cte.a", "update"},
+		{"with cte as (select 1) (select * from cte)", "select"},
+		{"(select 1)", "select"},
+		{"Show tables", "show"},
+		{"foo bar", "other"},
+		{"", "other"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			require.Equal(t, tt.expected, StatementType(tt.query))
+			typ, digest, text := StatementDigest(tt.query)
+			require.Equal(t, tt.expected, typ)
+			expectedDigest, expectedText := QueryDigest(tt.query)
+			require.Equal(t, expectedDigest, digest)
+			require.Equal(t, expectedText, text)
+		})
+	}
+}
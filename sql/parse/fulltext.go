@@ -0,0 +1,115 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// rewriteFullTextKeys returns a CREATE TABLE query with its FULLTEXT keys declared as plain keys, as the parser only
+// supports them in CREATE INDEX and ALTER TABLE, along with their lower-cased names. Keys without a name are named
+// after their first column, as MySQL does.
+func rewriteFullTextKeys(query string) (string, []string) {
+	if !strings.Contains(strings.ToLower(query), "fulltext") {
+		return query, nil
+	}
+
+	tokenizer := sqlparser.NewStringTokenizer(query)
+	if typ, _ := tokenizer.Scan(); typ != sqlparser.CREATE {
+		return query, nil
+	}
+
+	var b strings.Builder
+	var names []string
+	last, depth := 0, 0
+	for {
+		typ, val := tokenizer.Scan()
+		if typ == 0 || typ == sqlparser.LEX_ERROR {
+			break
+		}
+
+		switch typ {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case sqlparser.FULLTEXT:
+			if depth != 1 {
+				continue
+			}
+			keywordEnd := tokenizer.Position - 1
+			start := keywordEnd - len(val)
+
+			typ, val = tokenizer.Scan()
+			if typ == sqlparser.KEY || typ == sqlparser.INDEX {
+				keywordEnd = tokenizer.Position - 1
+				typ, val = tokenizer.Scan()
+			}
+
+			switch typ {
+			case sqlparser.ID:
+				b.WriteString(query[last:start])
+				b.WriteString("KEY")
+			case '(':
+				depth++
+				typ, val = tokenizer.Scan()
+				if typ != sqlparser.ID {
+					continue
+				}
+				b.WriteString(query[last:start])
+				b.WriteString("KEY `" + strings.ReplaceAll(string(val), "`", "``") + "`")
+			default:
+				continue
+			}
+			names = append(names, strings.ToLower(string(val)))
+			last = keywordEnd
+		}
+	}
+
+	if len(names) == 0 {
+		return query, nil
+	}
+	b.WriteString(query[last:])
+	return b.String(), names
+}
+
+// applyFullTextKeys makes the keys of a CREATE TABLE statement with the names returned by rewriteFullTextKeys FULLTEXT
+// keys.
+func applyFullTextKeys(stmt sqlparser.Statement, names []string) {
+	if len(names) == 0 {
+		return
+	}
+	ddl, ok := stmt.(*sqlparser.DDL)
+	if !ok || ddl.TableSpec == nil {
+		return
+	}
+
+	for _, def := range ddl.TableSpec.Indexes {
+		for _, name := range names {
+			if def.Info.Name.Lowered() == name && !def.Info.Primary && !def.Info.Unique && !def.Info.Spatial {
+				def.Info.Type = fullTextKeyType
+			}
+		}
+	}
+}
+
+// fullTextKeyType is the type of the keys of a CREATE TABLE statement applyFullTextKeys makes FULLTEXT keys.
+const fullTextKeyType = "fulltext key"
+
+func isFullTextKey(info *sqlparser.IndexInfo) bool {
+	return info.Type == fullTextKeyType
+}
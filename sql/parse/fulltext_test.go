@@ -0,0 +1,90 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/linanh/go-mysql-server/sql"
+	"github.com/linanh/go-mysql-server/sql/expression"
+)
+
+func TestRewriteFullTextKeys(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected string
+		names    []string
+	}{
+		{
+			"create table t (a int, b text, fulltext (b))",
+			"create table t (a int, b text, KEY `b` (b))",
+			[]string{"b"},
+		},
+		{
+			"CREATE TABLE t (a text, b text, FULLTEXT KEY Ft (a, b), FULLTEXT INDEX ft2 (b))",
+			"CREATE TABLE t (a text, b text, KEY Ft (a, b), KEY ft2 (b))",
+			[]string{"ft", "ft2"},
+		},
+		{
+			"create table t (a int, `fulltext` text)",
+			"create table t (a int, `fulltext` text)",
+			nil,
+		},
+		{
+			"select * from t where match (a) against ('fulltext')",
+			"select * from t where match (a) against ('fulltext')",
+			nil,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			query, names := rewriteFullTextKeys(tt.query)
+			require.Equal(t, tt.expected, query)
+			require.Equal(t, tt.names, names)
+		})
+	}
+}
+
+func TestParseMatchAgainst(t *testing.T) {
+	testCases := []struct {
+		query string
+		mode  sql.FullTextMode
+	}{
+		{"select * from t where match (a, b) against ('word')", sql.FullTextNaturalLanguageMode},
+		{"select * from t where match (a, b) against ('word' in natural language mode)", sql.FullTextNaturalLanguageMode},
+		{"select * from t where match (a, b) against ('+word' in boolean mode)", sql.FullTextBooleanMode},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			node, err := Parse(sql.NewEmptyContext(), tt.query)
+			require.NoError(t, err)
+
+			var match *expression.MatchAgainst
+			for _, e := range node.Children()[0].(sql.Expressioner).Expressions() {
+				match, _ = e.(*expression.MatchAgainst)
+			}
+			require.NotNil(t, match)
+			require.Len(t, match.Columns, 2)
+			require.Equal(t, tt.mode, match.Mode)
+		})
+	}
+
+	_, err := Parse(sql.NewEmptyContext(), "select * from t where match (a) against ('word' with query expansion)")
+	require.True(t, ErrUnsupportedFeature.Is(err))
+}
@@ -0,0 +1,67 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// maxExecutionTimeHintRegex matches the MAX_EXECUTION_TIME hint among optimizer hints.
+var maxExecutionTimeHintRegex = regexp.MustCompile(`(?i)\bmax_execution_time\s*\(\s*(\d+)\s*\)`)
+
+// MaxExecutionTimeHint returns the limit on the execution time of a SELECT statement set by its MAX_EXECUTION_TIME
+// optimizer hint, in milliseconds, and whether it has one. As in MySQL, the hint only applies after the first SELECT
+// keyword of the statement, which is the left-most one of a union.
+func MaxExecutionTimeHint(query string) (time.Duration, bool, error) {
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return 0, false, nil
+	}
+
+	var sel *sqlparser.Select
+	for sel == nil {
+		switch s := stmt.(type) {
+		case *sqlparser.Select:
+			sel = s
+		case *sqlparser.Union:
+			stmt = s.Left
+		case *sqlparser.ParenSelect:
+			stmt = s.Select
+		default:
+			return 0, false, nil
+		}
+	}
+
+	for _, c := range sel.Comments {
+		if !bytes.HasPrefix(c, []byte("/*+")) {
+			continue
+		}
+		if hint := maxExecutionTimeHintRegex.FindSubmatch(c); hint != nil {
+			ms, err := strconv.ParseUint(string(hint[1]), 10, 32)
+			if err != nil {
+				return 0, false, sql.ErrSyntaxError.New(err.Error())
+			}
+			return time.Duration(ms) * time.Millisecond, true, nil
+		}
+	}
+	return 0, false, nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+func TestMaxExecutionTimeHint(t *testing.T) {
+	for query, expected := range map[string]time.Duration{
+		"SELECT /*+ MAX_EXECUTION_TIME(10) */ 1":                                time.Duration(10) * time.Millisecond,
+		"/* leading */ select /*+ BKA(t) max_execution_time ( 20 ) */ * FROM t": time.Duration(20) * time.Millisecond,
+		"WITH a AS (SELECT 1) SELECT /*+ MAX_EXECUTION_TIME(30) */ * FROM a":    time.Duration(30) * time.Millisecond,
+		"SELECT /*+ MAX_EXECUTION_TIME(40) */ 1 UNION SELECT 2":                 time.Duration(40) * time.Millisecond,
+		"SELECT /*+ MAX_EXECUTION_TIME(0) */ 1":                                 0,
+	} {
+		limit, ok, err := MaxExecutionTimeHint(query)
+		require.NoError(t, err, query)
+		require.True(t, ok, query)
+		require.Equal(t, expected, limit, query)
+	}
+
+	// The hint only applies in optimizer hint comments after the first SELECT keyword
+	for _, query := range []string{
+		"SELECT 1",
+		"SELECT '/*+ MAX_EXECUTION_TIME(10) */'",
+		"SELECT /* MAX_EXECUTION_TIME(10) */ 1",
+		"SELECT 1 -- /*+ MAX_EXECUTION_TIME(10) */",
+		"SELECT 1 UNION SELECT /*+ MAX_EXECUTION_TIME(10) */ 2",
+		"SELECT * FROM (SELECT /*+ MAX_EXECUTION_TIME(10) */ 1) t",
+		"INSERT INTO t SELECT /*+ MAX_EXECUTION_TIME(10) */ 1",
+	} {
+		_, ok, err := MaxExecutionTimeHint(query)
+		require.NoError(t, err, query)
+		require.False(t, ok, query)
+	}
+
+	_, _, err := MaxExecutionTimeHint("SELECT /*+ MAX_EXECUTION_TIME(99999999999) */ 1")
+	require.True(t, sql.ErrSyntaxError.Is(err))
+}
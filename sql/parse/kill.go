@@ -0,0 +1,68 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"strconv"
+
+	"github.com/linanh/go-mysql-server/sql"
+	"github.com/linanh/go-mysql-server/sql/expression"
+	"github.com/linanh/go-mysql-server/sql/plan"
+)
+
+// parseKill parses KILL [CONNECTION | QUERY] statements. The connection id is a number, a user variable, or a name,
+// such as a parameter of a stored procedure.
+func parseKill(ctx *sql.Context, s string) (sql.Node, error) {
+	p, err := newTokenParser(s)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectKeywords("kill"); err != nil {
+		return nil, err
+	}
+
+	typ := plan.KillType_Connection
+	if p.acceptKeywords("query") {
+		typ = plan.KillType_Query
+	} else {
+		p.acceptKeywords("connection")
+	}
+
+	var connID sql.Expression
+	switch {
+	case p.isPunct(0, "@"):
+		connID, err = p.userVar()
+		if err != nil {
+			return nil, err
+		}
+	case p.peek().typ == tokenNumber:
+		n, err := strconv.ParseUint(p.next().val, 10, 64)
+		if err != nil {
+			return nil, sql.ErrSyntaxError.New(err.Error())
+		}
+		connID = expression.NewLiteral(n, sql.Uint64)
+	default:
+		name, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		connID = expression.NewUnresolvedColumn(name)
+	}
+
+	if err := p.expectEOF(); err != nil {
+		return nil, err
+	}
+	return plan.NewKill(typ, connID), nil
+}
@@ -0,0 +1,288 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"strings"
+	"unicode"
+
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+var errUnterminatedToken = errors.NewKind("unterminated %s starting at position %d")
+
+type tokenType byte
+
+const (
+	tokenEOF tokenType = iota
+	// tokenIdent is an unquoted identifier or keyword
+	tokenIdent
+	// tokenQuotedIdent is an identifier quoted with backticks
+	tokenQuotedIdent
+	// tokenString is a string quoted with single or double quotes
+	tokenString
+	// tokenNumber is an unsigned integer
+	tokenNumber
+	// tokenPunct is any other single character, such as a comma
+	tokenPunct
+)
+
+type token struct {
+	typ tokenType
+	val string
+	// pos is the position of the token's first rune in the statement
+	pos int
+}
+
+func (t token) String() string {
+	switch t.typ {
+	case tokenEOF:
+		return "EOF"
+	case tokenQuotedIdent:
+		return "`" + t.val + "`"
+	case tokenString:
+		return "'" + t.val + "'"
+	default:
+		return t.val
+	}
+}
+
+// tokenize splits a statement into tokens for the statements which the vitess parser doesn't support. Comments are
+// skipped, except for the contents of executable comments such as /*!50100 ... */, which are tokenized.
+func tokenize(s string) ([]token, error) {
+	var tokens []token
+	runes := []rune(s)
+	inExecutableComment := false
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '/' && i+2 < len(runes) && runes[i+1] == '*' && runes[i+2] == '!':
+			i += 3
+			for i < len(runes) && unicode.IsDigit(runes[i]) {
+				i++
+			}
+			inExecutableComment = true
+		case inExecutableComment && r == '*' && i+1 < len(runes) && runes[i+1] == '/':
+			i += 2
+			inExecutableComment = false
+		case r == '#' || (r == '-' && i+2 < len(runes) && runes[i+1] == '-' && unicode.IsSpace(runes[i+2])):
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+		case r == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			end := strings.Index(string(runes[i+2:]), "*/")
+			if end < 0 {
+				return nil, errUnterminatedToken.New("comment", i)
+			}
+			i += 2 + len([]rune(string(runes[i+2:])[:end])) + 2
+		case r == '\'' || r == '"' || r == '`':
+			val, next, err := readQuoted(runes, i)
+			if err != nil {
+				return nil, err
+			}
+			typ := tokenString
+			if r == '`' {
+				typ = tokenQuotedIdent
+			}
+			tokens = append(tokens, token{typ, val, i})
+			i = next
+		case unicode.IsDigit(r):
+			start := i
+			for i < len(runes) && unicode.IsDigit(runes[i]) {
+				i++
+			}
+			// Identifiers can start with digits too
+			if i < len(runes) && isIdentRune(runes[i]) {
+				for i < len(runes) && isIdentRune(runes[i]) {
+					i++
+				}
+				tokens = append(tokens, token{tokenIdent, string(runes[start:i]), start})
+			} else {
+				tokens = append(tokens, token{tokenNumber, string(runes[start:i]), start})
+			}
+		case isIdentRune(r):
+			start := i
+			for i < len(runes) && isIdentRune(runes[i]) {
+				i++
+			}
+			tokens = append(tokens, token{tokenIdent, string(runes[start:i]), start})
+		default:
+			tokens = append(tokens, token{tokenPunct, string(r), i})
+			i++
+		}
+	}
+	return tokens, nil
+}
+
+func isIdentRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '$'
+}
+
+// readQuoted reads the quoted token starting at runes[start], returning its unquoted value and the position after it.
+// The quote character is escaped by doubling it, and strings also accept backslash escapes.
+func readQuoted(runes []rune, start int) (string, int, error) {
+	quote := runes[start]
+	var sb strings.Builder
+	for i := start + 1; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == quote:
+			if i+1 < len(runes) && runes[i+1] == quote {
+				sb.WriteRune(quote)
+				i++
+				continue
+			}
+			return sb.String(), i + 1, nil
+		case r == '\\' && quote != '`' && i+1 < len(runes):
+			i++
+			switch runes[i] {
+			case 'n':
+				sb.WriteRune('\n')
+			case 't':
+				sb.WriteRune('\t')
+			case 'r':
+				sb.WriteRune('\r')
+			case '0':
+				sb.WriteRune(0)
+			default:
+				sb.WriteRune(runes[i])
+			}
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	return "", 0, errUnterminatedToken.New("quoted string", start)
+}
+
+// tokenParser is a small recursive descent helper over the tokens of a statement.
+type tokenParser struct {
+	src    []rune
+	tokens []token
+	pos    int
+}
+
+func newTokenParser(s string) (*tokenParser, error) {
+	tokens, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+	return &tokenParser{src: []rune(s), tokens: tokens}, nil
+}
+
+func (p *tokenParser) peek() token {
+	return p.peekN(0)
+}
+
+func (p *tokenParser) peekN(n int) token {
+	if p.pos+n >= len(p.tokens) {
+		return token{typ: tokenEOF}
+	}
+	return p.tokens[p.pos+n]
+}
+
+func (p *tokenParser) next() token {
+	t := p.peek()
+	if p.pos < len(p.tokens) {
+		p.pos++
+	}
+	return t
+}
+
+func (p *tokenParser) atEOF() bool {
+	return p.peek().typ == tokenEOF
+}
+
+// isKeyword returns whether the nth token from the current one is the unquoted keyword given.
+func (p *tokenParser) isKeyword(n int, keyword string) bool {
+	t := p.peekN(n)
+	return t.typ == tokenIdent && strings.EqualFold(t.val, keyword)
+}
+
+// acceptKeywords consumes the keywords given if the next tokens are exactly those keywords, and returns whether they
+// were consumed.
+func (p *tokenParser) acceptKeywords(keywords ...string) bool {
+	for i, kw := range keywords {
+		if !p.isKeyword(i, kw) {
+			return false
+		}
+	}
+	p.pos += len(keywords)
+	return true
+}
+
+func (p *tokenParser) expectKeywords(keywords ...string) error {
+	if !p.acceptKeywords(keywords...) {
+		return errUnexpectedSyntax.New(strings.ToLower(strings.Join(keywords, " ")), p.peek().String())
+	}
+	return nil
+}
+
+func (p *tokenParser) isPunct(n int, punct string) bool {
+	t := p.peekN(n)
+	return t.typ == tokenPunct && t.val == punct
+}
+
+func (p *tokenParser) acceptPunct(punct string) bool {
+	if p.isPunct(0, punct) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *tokenParser) expectPunct(punct string) error {
+	if !p.acceptPunct(punct) {
+		return errUnexpectedSyntax.New(punct, p.peek().String())
+	}
+	return nil
+}
+
+// ident reads a quoted or unquoted identifier.
+func (p *tokenParser) ident() (string, error) {
+	t := p.peek()
+	if t.typ != tokenIdent && t.typ != tokenQuotedIdent {
+		return "", errUnexpectedSyntax.New("identifier", t.String())
+	}
+	p.pos++
+	return t.val, nil
+}
+
+// identOrString reads an identifier or a quoted string, as used for user names, host names and plugin names.
+func (p *tokenParser) identOrString() (string, error) {
+	if p.peek().typ == tokenString {
+		return p.next().val, nil
+	}
+	return p.ident()
+}
+
+func (p *tokenParser) stringLiteral() (string, error) {
+	t := p.peek()
+	if t.typ != tokenString {
+		return "", errUnexpectedSyntax.New("string", t.String())
+	}
+	p.pos++
+	return t.val, nil
+}
+
+func (p *tokenParser) expectEOF() error {
+	// A single trailing semicolon is allowed
+	p.acceptPunct(";")
+	if !p.atEOF() {
+		return errUnexpectedSyntax.New("EOF", p.peek().String())
+	}
+	return nil
+}
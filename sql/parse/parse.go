@@ -62,9 +62,25 @@ var (
 
 var (
 	showVariablesRegex   = regexp.MustCompile(`^show\s+(.*)?variables\s*`)
+	showStatusRegex      = regexp.MustCompile(`^show\s+((global|session|local)\s+)?status(\s+|$)`)
 	showWarningsRegex    = regexp.MustCompile(`^show\s+warnings\s*`)
 	fullProcessListRegex = regexp.MustCompile(`^show\s+(full\s+)?processlist$`)
 	setRegex             = regexp.MustCompile(`^set\s+`)
+	setRoleRegex         = regexp.MustCompile(`^set\s+role\s+`)
+	createUserRegex      = regexp.MustCompile(`^create\s+(user|role)\s+`)
+	alterUserRegex       = regexp.MustCompile(`^alter\s+user\s+`)
+	dropUserRegex        = regexp.MustCompile(`^drop\s+(user|role)\s+`)
+	grantRegex           = regexp.MustCompile(`^grant\s+`)
+	revokeRegex          = regexp.MustCompile(`^revoke\s+`)
+	showGrantsRegex      = regexp.MustCompile(`^show\s+grants(\s+|$)`)
+	prepareRegex         = regexp.MustCompile(`^prepare\s+`)
+	executeRegex         = regexp.MustCompile(`^execute\s+`)
+	deallocateRegex      = regexp.MustCompile(`^(deallocate|drop)\s+prepare\s+`)
+	createProcedureRegex = regexp.MustCompile(`^create\s+(definer\s*=\s*\S+\s+)?procedure\s+`)
+	killRegex            = regexp.MustCompile(`^kill\s+`)
+	showBinlogsRegex     = regexp.MustCompile(`^show\s+((binary|master)\s+logs|binlog\s+events|master\s+status)(\s+|$)`)
+	purgeBinaryLogsRegex = regexp.MustCompile(`^purge\s+(binary|master)\s+logs(\s+|$)`)
+	replicationRegex     = regexp.MustCompile(`^(change\s+(replication\s+source|master)\s+to|(start|stop)\s+(replica|slave)|show\s+(replica|slave)\s+status)(\s+|$)`)
 )
 
 var describeSupportedFormats = []string{"tree"}
@@ -121,14 +137,54 @@ func Parse(ctx *sql.Context, query string) (sql.Node, error) {
 	switch true {
 	case showVariablesRegex.MatchString(lowerQuery):
 		return parseShowVariables(ctx, s)
+	case showStatusRegex.MatchString(lowerQuery):
+		return parseShowStatus(ctx, s)
 	case showWarningsRegex.MatchString(lowerQuery):
 		return parseShowWarnings(ctx, s)
 	case fullProcessListRegex.MatchString(lowerQuery):
 		return plan.NewShowProcessList(), nil
+	case showGrantsRegex.MatchString(lowerQuery):
+		return parseShowGrants(ctx, s)
+	case createUserRegex.MatchString(lowerQuery):
+		return parseCreateUser(ctx, s)
+	case alterUserRegex.MatchString(lowerQuery):
+		return parseAlterUser(ctx, s)
+	case dropUserRegex.MatchString(lowerQuery):
+		return parseDropUser(ctx, s)
+	case grantRegex.MatchString(lowerQuery):
+		return parseGrant(ctx, s)
+	case revokeRegex.MatchString(lowerQuery):
+		return parseRevoke(ctx, s)
+	case setRoleRegex.MatchString(lowerQuery):
+		return parseSetRole(ctx, s)
+	case prepareRegex.MatchString(lowerQuery):
+		return parsePrepare(ctx, s)
+	case executeRegex.MatchString(lowerQuery):
+		return parseExecute(ctx, s)
+	case deallocateRegex.MatchString(lowerQuery):
+		return parseDeallocate(ctx, s)
+	case killRegex.MatchString(lowerQuery):
+		return parseKill(ctx, s)
+	case showBinlogsRegex.MatchString(lowerQuery):
+		return parseShowBinlogs(ctx, s)
+	case purgeBinaryLogsRegex.MatchString(lowerQuery):
+		return parsePurgeBinaryLogs(ctx, s)
+	case replicationRegex.MatchString(lowerQuery):
+		return parseReplication(ctx, s)
 	case setRegex.MatchString(lowerQuery):
 		s = fixSetQuery(s)
+	case createProcedureRegex.MatchString(lowerQuery):
+		return parseCreateProcedure(ctx, s)
+	case alterPartitionRegex.MatchString(lowerQuery):
+		return parseAlterPartition(ctx, s)
 	}
 
+	s = rewriteCharsets(ctx, s)
+	s, srids, err := rewriteColumnSRIDs(s)
+	if err != nil {
+		return nil, err
+	}
+	s, partitionClause := splitPartitionClause(s)
 	stmt, err := sqlparser.Parse(s)
 	if err != nil {
 		if err.Error() == "empty statement" {
@@ -138,7 +194,15 @@ func Parse(ctx *sql.Context, query string) (sql.Node, error) {
 		return nil, sql.ErrSyntaxError.New(err.Error())
 	}
 
-	return convert(ctx, stmt, s)
+	node, err := convert(ctx, stmt, s)
+	if err != nil {
+		return nil, err
+	}
+	node, err = applyPartitionClause(ctx, node, partitionClause)
+	if err != nil {
+		return nil, err
+	}
+	return applyColumnSRIDs(node, srids)
 }
 
 // ParseColumnTypeString will return a SQL type for the given string that represents a column type.
@@ -180,10 +244,12 @@ func convert(ctx *sql.Context, stmt sqlparser.Statement, query string) (sql.Node
 	case *sqlparser.DDL:
 		// unlike other statements, DDL statements have loose parsing by default
 		// TODO: fix this
-		ddl, err := sqlparser.ParseStrictDDL(query)
+		strictQuery, fullTextKeys := rewriteFullTextKeys(query)
+		ddl, err := sqlparser.ParseStrictDDL(strictQuery)
 		if err != nil {
 			return nil, err
 		}
+		applyFullTextKeys(ddl, fullTextKeys)
 		return convertDDL(ctx, query, ddl.(*sqlparser.DDL))
 	case *sqlparser.MultiAlterDDL:
 		multiAlterDdl, err := sqlparser.ParseStrictDDL(query)
@@ -858,6 +924,9 @@ func convertCreateProcedure(ctx *sql.Context, query string, c *sqlparser.DDL) (s
 }
 
 func convertCall(ctx *sql.Context, c *sqlparser.Call) (sql.Node, error) {
+	if strings.EqualFold(c.FuncName, dynamicStatementProcedure) {
+		return convertDynamicStatement(ctx, c)
+	}
 	params := make([]sql.Expression, len(c.Params))
 	for i, param := range c.Params {
 		expr, err := ExprToExpression(ctx, param)
@@ -1081,6 +1150,14 @@ func convertAlterTable(ctx *sql.Context, ddl *sqlparser.DDL) (sql.Node, error) {
 	return nil, ErrUnsupportedFeature.New(sqlparser.String(ddl))
 }
 
+func partitionNames(partitions sqlparser.Partitions) []string {
+	names := make([]string, len(partitions))
+	for i, p := range partitions {
+		names[i] = p.String()
+	}
+	return names
+}
+
 func tableNameToUnresolvedTable(tableName sqlparser.TableName) *plan.UnresolvedTable {
 	return plan.NewUnresolvedTable(tableName.Name.String(), tableName.Qualifier.String())
 }
@@ -1298,12 +1375,13 @@ func convertCreateTable(ctx *sql.Context, c *sqlparser.DDL) (sql.Node, error) {
 			continue
 		}
 
-		//TODO: add vitess support for FULLTEXT
 		constraint := sql.IndexConstraint_None
 		if idxDef.Info.Unique {
 			constraint = sql.IndexConstraint_Unique
 		} else if idxDef.Info.Spatial {
 			constraint = sql.IndexConstraint_Spatial
+		} else if isFullTextKey(idxDef.Info) {
+			constraint = sql.IndexConstraint_Fulltext
 		}
 
 		columns := make([]sql.IndexColumn, len(idxDef.Columns))
@@ -1519,6 +1597,12 @@ func convertInsert(ctx *sql.Context, i *sqlparser.Insert) (sql.Node, error) {
 }
 
 func convertDelete(ctx *sql.Context, d *sqlparser.Delete) (sql.Node, error) {
+	if len(d.Partitions) > 0 && len(d.TableExprs) == 1 {
+		if t, ok := d.TableExprs[0].(*sqlparser.AliasedTableExpr); ok {
+			t.Partitions = d.Partitions
+		}
+	}
+
 	node, err := tableExprsToTable(ctx, d.TableExprs)
 	if err != nil {
 		return nil, err
@@ -1839,6 +1923,9 @@ func tableExprToTable(
 			} else {
 				node = tableNameToUnresolvedTable(e)
 			}
+			if len(t.Partitions) > 0 {
+				node = node.WithPartitions(partitionNames(t.Partitions))
+			}
 
 			if !t.As.IsEmpty() {
 				return plan.NewTableAlias(t.As.String(), node), nil
@@ -2221,6 +2308,28 @@ func MustStringToColumnDefaultValue(ctx *sql.Context, exprStr string, outType sq
 	return expr
 }
 
+func matchExprToExpression(ctx *sql.Context, m *sqlparser.MatchExpr) (sql.Expression, error) {
+	columns, err := selectExprsToExpressions(ctx, m.Columns)
+	if err != nil {
+		return nil, err
+	}
+	against, err := ExprToExpression(ctx, m.Expr)
+	if err != nil {
+		return nil, err
+	}
+
+	var mode sql.FullTextMode
+	switch m.Option {
+	case "", sqlparser.NaturalLanguageModeStr:
+		mode = sql.FullTextNaturalLanguageMode
+	case sqlparser.BooleanModeStr:
+		mode = sql.FullTextBooleanMode
+	default:
+		return nil, ErrUnsupportedFeature.New("MATCH ... AGAINST" + strings.ToUpper(m.Option))
+	}
+	return expression.NewMatchAgainst(columns, against, mode), nil
+}
+
 func ExprToExpression(ctx *sql.Context, e sqlparser.Expr) (sql.Expression, error) {
 	switch v := e.(type) {
 	default:
@@ -2305,6 +2414,8 @@ func ExprToExpression(ctx *sql.Context, e sqlparser.Expr) (sql.Expression, error
 
 		return expression.NewUnresolvedFunction(v.Name.Lowered(),
 			isAggregateFunc(v), overToWindow(ctx, v.Over), exprs...), nil
+	case *sqlparser.MatchExpr:
+		return matchExprToExpression(ctx, v)
 	case *sqlparser.GroupConcatExpr:
 		exprs, err := selectExprsToExpressions(ctx, v.Exprs)
 		if err != nil {
@@ -2362,6 +2473,25 @@ func ExprToExpression(ctx *sql.Context, e sqlparser.Expr) (sql.Expression, error
 		}
 
 		return expression.NewConvert(expr, v.Type.Type), nil
+	case *sqlparser.ConvertUsingExpr:
+		expr, err := ExprToExpression(ctx, v.Expr)
+		if err != nil {
+			return nil, err
+		}
+
+		charset, err := sql.ParseCharacterSet(strings.ToLower(v.Type))
+		if err != nil {
+			return nil, err
+		}
+		convertUsing := expression.NewConvertUsing(expr, charset)
+		if _, ok := expr.(*expression.Literal); ok {
+			val, err := convertUsing.Eval(ctx, nil)
+			if err != nil {
+				return nil, err
+			}
+			return expression.NewLiteral(val, convertUsing.Type()), nil
+		}
+		return convertUsing, nil
 	case *sqlparser.RangeCond:
 		val, err := ExprToExpression(ctx, v.Left)
 		if err != nil {
@@ -2710,6 +2840,20 @@ func unaryExprToExpression(ctx *sql.Context, e *sqlparser.UnaryExpr) (sql.Expres
 			return expression.NewLiteral(exprLiteral.Value(), sql.LongBlob), nil
 		}
 		return expr, nil
+	case sqlparser.Utf8mb4Str:
+		expr, err := ExprToExpression(ctx, e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		if exprLiteral, ok := expr.(*expression.Literal); ok {
+			switch v := exprLiteral.Value().(type) {
+			case string:
+				return expression.NewLiteral(v, sql.LongText), nil
+			case []byte:
+				return expression.NewLiteral(string(v), sql.LongText), nil
+			}
+		}
+		return expr, nil
 	default:
 		return nil, ErrUnsupportedFeature.New("unary operator: " + e.Operator)
 	}
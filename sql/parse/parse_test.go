@@ -2295,6 +2295,9 @@ CREATE TABLE t2
 	`SHOW SESSION VARIABLES`:                   plan.NewShowVariables(""),
 	`SHOW VARIABLES LIKE 'gtid_mode'`:          plan.NewShowVariables("gtid_mode"),
 	`SHOW SESSION VARIABLES LIKE 'autocommit'`: plan.NewShowVariables("autocommit"),
+	`SHOW STATUS`:                              plan.NewShowStatus("", false),
+	`SHOW SESSION STATUS LIKE 'Com_%'`:         plan.NewShowStatus("com_%", false),
+	`SHOW GLOBAL STATUS LIKE 'Questions'`:      plan.NewShowStatus("questions", true),
 	`UNLOCK TABLES`:                            plan.NewUnlockTables(),
 	`LOCK TABLES foo READ`: plan.NewLockTables([]*plan.TableLock{
 		{Table: plan.NewUnresolvedTable("foo", "")},
@@ -3145,6 +3148,118 @@ CREATE TABLE t2
 	`CREATE DATABASE IF NOT EXISTS test`: plan.NewCreateDatabase("test", true),
 	`DROP DATABASE test`:                 plan.NewDropDatabase("test", false),
 	`DROP DATABASE IF EXISTS test`:       plan.NewDropDatabase("test", true),
+	`CREATE USER bob`: plan.NewCreateUser(
+		[]plan.UserSpec{{Identity: sql.NewUserIdentity("bob", "%")}},
+		false,
+		nil,
+	),
+	"CREATE USER IF NOT EXISTS 'bob'@'LocalHost' IDENTIFIED BY 'pass', `alice`@`%` IDENTIFIED WITH mysql_native_password AS '*ABC' ACCOUNT LOCK": plan.NewCreateUser(
+		[]plan.UserSpec{
+			{Identity: sql.NewUserIdentity("bob", "localhost"), Password: stringPtr("pass")},
+			{Identity: sql.NewUserIdentity("alice", "%"), Plugin: "mysql_native_password", AuthString: stringPtr("*ABC")},
+		},
+		true,
+		boolPtr(true),
+	),
+	`ALTER USER IF EXISTS bob@localhost IDENTIFIED BY 'new' ACCOUNT UNLOCK`: plan.NewAlterUser(
+		[]plan.UserSpec{{Identity: sql.NewUserIdentity("bob", "localhost"), Password: stringPtr("new")}},
+		true,
+		boolPtr(false),
+	),
+	`DROP USER IF EXISTS bob, alice@localhost`: plan.NewDropUser(
+		[]sql.UserIdentity{sql.NewUserIdentity("bob", "%"), sql.NewUserIdentity("alice", "localhost")},
+		true,
+	),
+	`CREATE ROLE reader, 'writer'`: plan.NewCreateRole(
+		[]sql.UserIdentity{sql.NewUserIdentity("reader", "%"), sql.NewUserIdentity("writer", "%")},
+		false,
+	),
+	`DROP ROLE reader`: plan.NewDropRole([]sql.UserIdentity{sql.NewUserIdentity("reader", "%")}, false),
+	`GRANT ALL ON *.* TO root@localhost WITH GRANT OPTION`: plan.NewGrant(
+		[]plan.PrivilegeSpec{{Privileges: sql.PrivilegeType_All}},
+		sql.PrivilegeTarget{},
+		[]sql.UserIdentity{sql.NewUserIdentity("root", "localhost")},
+		true,
+	),
+	"GRANT SELECT, INSERT (a, `b`), CREATE VIEW ON TABLE mydb.`MyTable` TO bob, alice": plan.NewGrant(
+		[]plan.PrivilegeSpec{
+			{Privileges: sql.PrivilegeType_Select},
+			{Privileges: sql.PrivilegeType_Insert, Columns: []string{"a", "b"}},
+			{Privileges: sql.PrivilegeType_CreateView},
+		},
+		sql.PrivilegeTarget{Database: "mydb", Table: "mytable"},
+		[]sql.UserIdentity{sql.NewUserIdentity("bob", "%"), sql.NewUserIdentity("alice", "%")},
+		false,
+	),
+	`GRANT reader, writer TO bob WITH ADMIN OPTION`: plan.NewGrantRole(
+		[]sql.UserIdentity{sql.NewUserIdentity("reader", "%"), sql.NewUserIdentity("writer", "%")},
+		[]sql.UserIdentity{sql.NewUserIdentity("bob", "%")},
+		true,
+	),
+	`REVOKE DELETE ON mydb.* FROM bob`: plan.NewRevoke(
+		[]plan.PrivilegeSpec{{Privileges: sql.PrivilegeType_Delete}},
+		sql.PrivilegeTarget{Database: "mydb"},
+		[]sql.UserIdentity{sql.NewUserIdentity("bob", "%")},
+	),
+	`REVOKE ALL PRIVILEGES ON mydb.* FROM bob`: plan.NewRevoke(
+		[]plan.PrivilegeSpec{{Privileges: sql.PrivilegeType_All}},
+		sql.PrivilegeTarget{Database: "mydb"},
+		[]sql.UserIdentity{sql.NewUserIdentity("bob", "%")},
+	),
+	`REVOKE ALL PRIVILEGES, GRANT OPTION FROM bob`: plan.NewRevokeAll([]sql.UserIdentity{sql.NewUserIdentity("bob", "%")}),
+	`REVOKE reader FROM bob`: plan.NewRevokeRole(
+		[]sql.UserIdentity{sql.NewUserIdentity("reader", "%")},
+		[]sql.UserIdentity{sql.NewUserIdentity("bob", "%")},
+	),
+	`SET ROLE ALL EXCEPT reader`: plan.NewSetRole(plan.SetRoleType_AllExcept, []sql.UserIdentity{sql.NewUserIdentity("reader", "%")}),
+	`SET ROLE NONE`:              plan.NewSetRole(plan.SetRoleType_None, nil),
+	`SET ROLE reader, writer`: plan.NewSetRole(
+		plan.SetRoleType_List,
+		[]sql.UserIdentity{sql.NewUserIdentity("reader", "%"), sql.NewUserIdentity("writer", "%")},
+	),
+	`SHOW GRANTS`:                                plan.NewShowGrants(),
+	`SHOW GRANTS FOR CURRENT_USER()`:             plan.NewShowGrants(),
+	`SHOW GRANTS FOR bob@localhost USING reader`: plan.NewShowGrantsFor(sql.NewUserIdentity("bob", "localhost"), []sql.UserIdentity{sql.NewUserIdentity("reader", "%")}),
+	`PREPARE stmt FROM 'SELECT * FROM foo WHERE a = ?'`: plan.NewPrepareQuery(
+		"stmt",
+		expression.NewLiteral("SELECT * FROM foo WHERE a = ?", sql.LongText),
+	),
+	"PREPARE `stmt` FROM @sql":  plan.NewPrepareQuery("stmt", expression.NewUserVar("sql")),
+	`EXECUTE stmt`:              plan.NewExecuteQuery("stmt", nil),
+	`EXECUTE stmt USING @a, @b`: plan.NewExecuteQuery("stmt", []sql.Expression{expression.NewUserVar("a"), expression.NewUserVar("b")}),
+	`DEALLOCATE PREPARE stmt`:   plan.NewDeallocateQuery("stmt"),
+	`DROP PREPARE stmt`:         plan.NewDeallocateQuery("stmt"),
+	`KILL 12`:                   plan.NewKill(plan.KillType_Connection, expression.NewLiteral(uint64(12), sql.Uint64)),
+	`KILL CONNECTION @id`:       plan.NewKill(plan.KillType_Connection, expression.NewUserVar("id")),
+	`KILL QUERY id`:             plan.NewKill(plan.KillType_Query, expression.NewUnresolvedColumn("id")),
+	`SHOW BINARY LOGS`:          plan.NewShowBinaryLogs(),
+	`SHOW MASTER LOGS`:          plan.NewShowBinaryLogs(),
+	`SHOW MASTER STATUS`:        plan.NewShowMasterStatus(),
+	`SHOW BINLOG EVENTS`:        plan.NewShowBinlogEvents("", 0, 0, -1),
+	`SHOW BINLOG EVENTS IN 'binlog.000002' FROM 4 LIMIT 2, 10`: plan.NewShowBinlogEvents("binlog.000002", 4, 2, 10),
+	`SHOW BINLOG EVENTS LIMIT 5`:                               plan.NewShowBinlogEvents("", 0, 0, 5),
+	`PURGE BINARY LOGS TO 'binlog.000003'`:                     plan.NewPurgeBinaryLogsTo("binlog.000003"),
+	`purge master logs before '2021-08-01 10:00:00'`:           plan.NewPurgeBinaryLogsBefore(expression.NewLiteral("2021-08-01 10:00:00", sql.LongText)),
+	`START REPLICA`:     plan.NewStartReplica(),
+	`stop slave`:        plan.NewStopReplica(),
+	`SHOW SLAVE STATUS`: plan.NewShowReplicaStatus(),
+	`CHANGE REPLICATION SOURCE TO SOURCE_HOST = 'db1', SOURCE_PORT = 3306, source_log_pos = 4`: plan.NewChangeReplicationSource([]plan.ReplicationOption{
+		{Name: "SOURCE_HOST", Value: "db1"},
+		{Name: "SOURCE_PORT", Value: uint64(3306)},
+		{Name: "SOURCE_LOG_POS", Value: uint64(4)},
+	}),
+	`CHANGE MASTER TO MASTER_USER = 'repl', MASTER_PASSWORD = 'secret'`: plan.NewChangeReplicationSource([]plan.ReplicationOption{
+		{Name: "MASTER_USER", Value: "repl"},
+		{Name: "MASTER_PASSWORD", Value: "secret"},
+	}),
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func boolPtr(b bool) *bool {
+	return &b
 }
 
 func TestParse(t *testing.T) {
@@ -3223,6 +3338,12 @@ var fixturesErrors = map[string]*errors.Kind{
 	`SELECT a, count(i) over (partition by y) FROM foo`:       ErrUnsupportedFeature,
 	`SELECT i, row_number() over (order by a) group by 1`:     ErrUnsupportedFeature,
 	`SELECT i, row_number() over (order by a), max(b)`:        ErrUnsupportedFeature,
+	`GRANT SELECT ON mytable TO bob`:                          sql.ErrNoDatabaseSelected,
+	`GRANT FLY ON *.* TO bob`:                                 sql.ErrUnknownPrivilege,
+	`CREATE USER bob IDENTIFIED 'pass'`:                       errUnexpectedSyntax,
+	`REVOKE SELECT ON *.* TO bob`:                             errUnexpectedSyntax,
+	`CHANGE REPLICATION SOURCE TO SOURCE_DELAY = 5`:           sql.ErrUnknownReplicationOption,
+	`CHANGE REPLICATION SOURCE TO SOURCE_HOST 'db1'`:          errUnexpectedSyntax,
 }
 
 func TestParseErrors(t *testing.T) {
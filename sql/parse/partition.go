@@ -0,0 +1,476 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/linanh/go-mysql-server/sql"
+	"github.com/linanh/go-mysql-server/sql/expression"
+	"github.com/linanh/go-mysql-server/sql/plan"
+)
+
+var executableCommentStartRegex = regexp.MustCompile(`/\*!\d*\s*$`)
+
+var alterPartitionRegex = regexp.MustCompile(`^alter\s+table\s+\S+\s+(partition\s+by|remove\s+partitioning|(add|drop|truncate|coalesce|reorganize)\s+partition)(\s+|\(|$)`)
+
+// splitPartitionClause returns a CREATE TABLE statement without its PARTITION BY clause, which the vitess parser
+// doesn't support, and the clause. Other statements are returned unchanged.
+func splitPartitionClause(query string) (string, string) {
+	lower := strings.ToLower(query)
+	if !strings.HasPrefix(lower, "create") || !strings.Contains(lower, "partition") {
+		return query, ""
+	}
+
+	tokens, err := tokenize(query)
+	if err != nil {
+		// Let the vitess parser report the error
+		return query, ""
+	}
+	p := &tokenParser{src: []rune(query), tokens: tokens}
+	p.acceptKeywords("create")
+	p.acceptKeywords("temporary")
+	if !p.acceptKeywords("table") {
+		return query, ""
+	}
+
+	depth := 0
+	for !p.atEOF() {
+		switch {
+		case p.isPunct(0, "("):
+			depth++
+		case p.isPunct(0, ")"):
+			depth--
+		case depth == 0 && p.isKeyword(0, "partition") && p.isKeyword(1, "by"):
+			pos := p.peek().pos
+			create, clause := string(p.src[:pos]), string(p.src[pos:])
+			// SHOW CREATE TABLE writes the clause in an executable comment
+			if loc := executableCommentStartRegex.FindStringIndex(create); loc != nil {
+				create = create[:loc[0]]
+				clause = strings.TrimSuffix(strings.TrimRightFunc(clause, unicode.IsSpace), "*/")
+			}
+			return create, clause
+		}
+		p.next()
+	}
+	return query, ""
+}
+
+// applyPartitionClause partitions the table created by a CREATE TABLE node as its PARTITION BY clause declares.
+func applyPartitionClause(ctx *sql.Context, node sql.Node, clause string) (sql.Node, error) {
+	if clause == "" {
+		return node, nil
+	}
+	create, ok := node.(*plan.CreateTable)
+	if !ok {
+		return nil, ErrUnsupportedSyntax.New(clause)
+	}
+
+	p, err := newTokenParser(clause)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := p.partitionSpec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectEOF(); err != nil {
+		return nil, err
+	}
+	if create.Like() != nil || create.Select() != nil {
+		return nil, ErrUnsupportedFeature.New("PARTITION BY in CREATE TABLE ... LIKE or SELECT")
+	}
+	return create.WithPartitionSpec(spec), nil
+}
+
+// parseAlterPartition parses the ALTER TABLE statements changing the partitioning of a table, which the vitess parser
+// doesn't support.
+func parseAlterPartition(ctx *sql.Context, s string) (sql.Node, error) {
+	p, err := newTokenParser(s)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectKeywords("alter", "table"); err != nil {
+		return nil, err
+	}
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	var db string
+	if p.acceptPunct(".") {
+		db = name
+		if name, err = p.ident(); err != nil {
+			return nil, err
+		}
+	}
+	table := plan.NewUnresolvedTable(name, db)
+
+	var node sql.Node
+	switch {
+	case p.isKeyword(0, "partition"):
+		spec, err := p.partitionSpec(ctx)
+		if err != nil {
+			return nil, err
+		}
+		node = plan.NewAlterPartitionBy(table, spec)
+	case p.acceptKeywords("remove", "partitioning"):
+		node = plan.NewAlterRemovePartitioning(table)
+	case p.acceptKeywords("add", "partition"):
+		if p.acceptKeywords("partitions") {
+			n, err := p.count()
+			if err != nil {
+				return nil, err
+			}
+			node = plan.NewAlterAddPartitions(table, nil, n)
+		} else {
+			defs, err := p.partitionDefinitions(ctx)
+			if err != nil {
+				return nil, err
+			}
+			node = plan.NewAlterAddPartitions(table, defs, 0)
+		}
+	case p.acceptKeywords("drop", "partition"):
+		names, err := p.partitionNames()
+		if err != nil {
+			return nil, err
+		}
+		node = plan.NewAlterDropPartitions(table, names)
+	case p.acceptKeywords("truncate", "partition"):
+		if p.acceptKeywords("all") {
+			node = plan.NewAlterTruncatePartitions(table, nil, true)
+		} else {
+			names, err := p.partitionNames()
+			if err != nil {
+				return nil, err
+			}
+			node = plan.NewAlterTruncatePartitions(table, names, false)
+		}
+	case p.acceptKeywords("coalesce", "partition"):
+		n, err := p.count()
+		if err != nil {
+			return nil, err
+		}
+		node = plan.NewAlterCoalescePartitions(table, n)
+	case p.acceptKeywords("reorganize", "partition"):
+		names, err := p.partitionNames()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectKeywords("into"); err != nil {
+			return nil, err
+		}
+		defs, err := p.partitionDefinitions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		node = plan.NewAlterReorganizePartitions(table, names, defs)
+	default:
+		return nil, errUnexpectedSyntax.New("partition", p.peek().String())
+	}
+
+	if err := p.expectEOF(); err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// partitionSpec reads a PARTITION BY clause:
+//
+//	PARTITION BY { [LINEAR] HASH (expr) | [LINEAR] KEY [ALGORITHM = {1 | 2}] (column_list)
+//	  | RANGE {(expr) | COLUMNS (column_list)} | LIST {(expr) | COLUMNS (column_list)} }
+//	  [PARTITIONS num] [(partition_definition [, partition_definition] ...)]
+func (p *tokenParser) partitionSpec(ctx *sql.Context) (*plan.PartitionSpec, error) {
+	if err := p.expectKeywords("partition", "by"); err != nil {
+		return nil, err
+	}
+
+	spec := &plan.PartitionSpec{}
+	spec.Linear = p.acceptKeywords("linear")
+	switch {
+	case p.acceptKeywords("hash"):
+		spec.Method = sql.PartitionMethod_Hash
+	case p.acceptKeywords("key"):
+		spec.Method = sql.PartitionMethod_Key
+		if p.acceptKeywords("algorithm") {
+			p.acceptPunct("=")
+			if _, err := p.count(); err != nil {
+				return nil, err
+			}
+		}
+	case !spec.Linear && p.acceptKeywords("range"):
+		spec.Method = sql.PartitionMethod_Range
+	case !spec.Linear && p.acceptKeywords("list"):
+		spec.Method = sql.PartitionMethod_List
+	default:
+		return nil, errUnexpectedSyntax.New("partitioning method", p.peek().String())
+	}
+
+	spec.UseColumns = spec.Method != sql.PartitionMethod_Hash && spec.Method != sql.PartitionMethod_Key && p.acceptKeywords("columns")
+	if spec.UseColumns || spec.Method == sql.PartitionMethod_Key {
+		if err := p.expectPunct("("); err != nil {
+			return nil, err
+		}
+		for !p.acceptPunct(")") {
+			if len(spec.Columns) > 0 {
+				if err := p.expectPunct(","); err != nil {
+					return nil, err
+				}
+			}
+			name, err := p.ident()
+			if err != nil {
+				return nil, err
+			}
+			spec.Columns = append(spec.Columns, name)
+		}
+		if spec.UseColumns && len(spec.Columns) == 0 {
+			return nil, errUnexpectedSyntax.New("column", ")")
+		}
+	} else {
+		text, err := p.parenthesized()
+		if err != nil {
+			return nil, err
+		}
+		spec.Expr, err = parseExpression(ctx, text)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if p.acceptKeywords("partitions") {
+		n, err := p.count()
+		if err != nil {
+			return nil, err
+		}
+		spec.Count = n
+	}
+	if p.isKeyword(0, "subpartition") {
+		return nil, ErrUnsupportedFeature.New("SUBPARTITION")
+	}
+	if p.isPunct(0, "(") {
+		defs, err := p.partitionDefinitions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		spec.Definitions = defs
+	}
+	return spec, nil
+}
+
+// partitionDefinitions reads a parenthesized list of partition definitions:
+//
+//	PARTITION name [VALUES {LESS THAN {(value_list) | MAXVALUE} | IN (value_list)}]
+//	  [[STORAGE] ENGINE [=] engine_name] [COMMENT [=] 'string']
+func (p *tokenParser) partitionDefinitions(ctx *sql.Context) ([]*plan.PartitionDefinitionSpec, error) {
+	if err := p.expectPunct("("); err != nil {
+		return nil, err
+	}
+
+	var defs []*plan.PartitionDefinitionSpec
+	for {
+		if err := p.expectKeywords("partition"); err != nil {
+			return nil, err
+		}
+		name, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		def := &plan.PartitionDefinitionSpec{Name: name}
+
+		switch {
+		case p.acceptKeywords("values", "less", "than"):
+			if p.acceptKeywords("maxvalue") {
+				def.LessThan = []sql.Expression{nil}
+			} else if def.LessThan, err = p.partitionValues(ctx); err != nil {
+				return nil, err
+			}
+		case p.acceptKeywords("values", "in"):
+			if def.In, err = p.partitionValueLists(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		for {
+			if p.acceptKeywords("storage", "engine") || p.acceptKeywords("engine") {
+				p.acceptPunct("=")
+				if _, err := p.ident(); err != nil {
+					return nil, err
+				}
+			} else if p.acceptKeywords("comment") {
+				p.acceptPunct("=")
+				if def.Comment, err = p.stringLiteral(); err != nil {
+					return nil, err
+				}
+			} else {
+				break
+			}
+		}
+		if p.isPunct(0, "(") || p.isKeyword(0, "subpartition") {
+			return nil, ErrUnsupportedFeature.New("SUBPARTITION")
+		}
+
+		defs = append(defs, def)
+		if p.acceptPunct(")") {
+			return defs, nil
+		}
+		if err := p.expectPunct(","); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// partitionValues reads the parenthesized values of VALUES LESS THAN, where MAXVALUE is a nil expression.
+func (p *tokenParser) partitionValues(ctx *sql.Context) ([]sql.Expression, error) {
+	items, err := p.parenthesizedList()
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]sql.Expression, len(items))
+	for i, item := range items {
+		if strings.EqualFold(strings.TrimSpace(item), "maxvalue") {
+			continue
+		}
+		if values[i], err = parseExpression(ctx, item); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+// partitionValueLists reads the parenthesized values of VALUES IN. Each value is a tuple when partitions are bound by
+// several columns.
+func (p *tokenParser) partitionValueLists(ctx *sql.Context) ([][]sql.Expression, error) {
+	items, err := p.parenthesizedList()
+	if err != nil {
+		return nil, err
+	}
+
+	lists := make([][]sql.Expression, len(items))
+	for i, item := range items {
+		value, err := parseExpression(ctx, item)
+		if err != nil {
+			return nil, err
+		}
+		if tuple, ok := value.(expression.Tuple); ok {
+			lists[i] = tuple
+		} else {
+			lists[i] = []sql.Expression{value}
+		}
+	}
+	return lists, nil
+}
+
+// partitionNames reads a list of partition names.
+func (p *tokenParser) partitionNames() ([]string, error) {
+	var names []string
+	for {
+		name, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+		if !p.acceptPunct(",") {
+			return names, nil
+		}
+	}
+}
+
+// count reads a positive integer, such as the number of partitions.
+func (p *tokenParser) count() (int, error) {
+	t := p.peek()
+	if t.typ != tokenNumber {
+		return 0, errUnexpectedSyntax.New("number", t.String())
+	}
+	p.next()
+	n, err := strconv.Atoi(t.val)
+	if err != nil || n == 0 {
+		return 0, sql.ErrSyntaxError.New(fmt.Sprintf("invalid number %s", t.val))
+	}
+	return n, nil
+}
+
+// parenthesized reads a parenthesized group of tokens, returning the text between the parentheses.
+func (p *tokenParser) parenthesized() (string, error) {
+	open := p.peek()
+	if err := p.expectPunct("("); err != nil {
+		return "", err
+	}
+	for depth := 1; ; {
+		t := p.next()
+		switch {
+		case t.typ == tokenEOF:
+			return "", errUnexpectedSyntax.New(")", t.String())
+		case t.typ == tokenPunct && t.val == "(":
+			depth++
+		case t.typ == tokenPunct && t.val == ")":
+			depth--
+			if depth == 0 {
+				return string(p.src[open.pos+1 : t.pos]), nil
+			}
+		}
+	}
+}
+
+// parenthesizedList reads a parenthesized list, returning the text of each of its comma-separated items.
+func (p *tokenParser) parenthesizedList() ([]string, error) {
+	open := p.peek()
+	if err := p.expectPunct("("); err != nil {
+		return nil, err
+	}
+
+	var items []string
+	start := open.pos + 1
+	for depth := 1; ; {
+		t := p.next()
+		switch {
+		case t.typ == tokenEOF:
+			return nil, errUnexpectedSyntax.New(")", t.String())
+		case t.typ == tokenPunct && t.val == "(":
+			depth++
+		case t.typ == tokenPunct && t.val == "," && depth == 1:
+			items = append(items, string(p.src[start:t.pos]))
+			start = t.pos + 1
+		case t.typ == tokenPunct && t.val == ")":
+			depth--
+			if depth == 0 {
+				return append(items, string(p.src[start:t.pos])), nil
+			}
+		}
+	}
+}
+
+// parseExpression parses the text of an expression, such as one of a PARTITION BY clause.
+func parseExpression(ctx *sql.Context, text string) (sql.Expression, error) {
+	stmt, err := sqlparser.Parse("SELECT " + text)
+	if err != nil {
+		return nil, sql.ErrSyntaxError.New(err.Error())
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || len(sel.SelectExprs) != 1 {
+		return nil, sql.ErrSyntaxError.New(text)
+	}
+	aliased, ok := sel.SelectExprs[0].(*sqlparser.AliasedExpr)
+	if !ok || !aliased.As.IsEmpty() {
+		return nil, sql.ErrSyntaxError.New(text)
+	}
+	return ExprToExpression(ctx, aliased.Expr)
+}
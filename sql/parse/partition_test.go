@@ -0,0 +1,157 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/linanh/go-mysql-server/sql"
+	"github.com/linanh/go-mysql-server/sql/plan"
+)
+
+func TestSplitPartitionClause(t *testing.T) {
+	testCases := []struct {
+		query  string
+		create string
+		clause string
+	}{
+		{
+			"create table t (a int) partition by hash(a) partitions 4",
+			"create table t (a int) ",
+			"partition by hash(a) partitions 4",
+		},
+		{
+			"CREATE TEMPORARY TABLE t (`partition` int, b int) PARTITION BY RANGE (b) (PARTITION p0 VALUES LESS THAN (10))",
+			"CREATE TEMPORARY TABLE t (`partition` int, b int) ",
+			"PARTITION BY RANGE (b) (PARTITION p0 VALUES LESS THAN (10))",
+		},
+		{
+			"CREATE TABLE `t` (\n  `a` int\n) ENGINE=InnoDB\n/*!50100 PARTITION BY HASH (`a`)\nPARTITIONS 2 */",
+			"CREATE TABLE `t` (\n  `a` int\n) ENGINE=InnoDB\n",
+			"PARTITION BY HASH (`a`)\nPARTITIONS 2 ",
+		},
+		{
+			"create table t (a int comment 'partition by')",
+			"create table t (a int comment 'partition by')",
+			"",
+		},
+		{
+			"select * from t partition (p0)",
+			"select * from t partition (p0)",
+			"",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			create, clause := splitPartitionClause(tt.query)
+			require.Equal(t, tt.create, create)
+			require.Equal(t, tt.clause, clause)
+		})
+	}
+}
+
+func TestParsePartitionedCreateTable(t *testing.T) {
+	testCases := []struct {
+		query      string
+		method     sql.PartitionMethod
+		linear     bool
+		useColumns bool
+		columns    []string
+		count      int
+		names      []string
+	}{
+		{
+			"create table t (a int) partition by linear hash(a) partitions 4",
+			sql.PartitionMethod_Hash, true, false, nil, 4, nil,
+		},
+		{
+			"create table t (a int, b int) partition by key algorithm=2 (a, b)",
+			sql.PartitionMethod_Key, false, false, []string{"a", "b"}, 0, nil,
+		},
+		{
+			"create table t (a int) partition by range (a) (partition p0 values less than (10), partition p1 values less than maxvalue)",
+			sql.PartitionMethod_Range, false, false, nil, 0, []string{"p0", "p1"},
+		},
+		{
+			"create table t (a int, b varchar(10)) partition by list columns (a, b) (partition p0 values in ((1, 'x'), (2, 'y')) engine = innodb comment 'first')",
+			sql.PartitionMethod_List, false, true, []string{"a", "b"}, 0, []string{"p0"},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			node, err := Parse(sql.NewEmptyContext(), tt.query)
+			require.NoError(t, err)
+			create, ok := node.(*plan.CreateTable)
+			require.True(t, ok)
+
+			spec := create.PartitionSpec()
+			require.NotNil(t, spec)
+			require.Equal(t, tt.method, spec.Method)
+			require.Equal(t, tt.linear, spec.Linear)
+			require.Equal(t, tt.useColumns, spec.UseColumns)
+			require.Equal(t, tt.columns, spec.Columns)
+			require.Equal(t, tt.count, spec.Count)
+
+			var names []string
+			for _, d := range spec.Definitions {
+				names = append(names, d.Name)
+			}
+			require.Equal(t, tt.names, names)
+		})
+	}
+}
+
+func TestParseAlterPartition(t *testing.T) {
+	testCases := []struct {
+		query  string
+		action plan.PartitionAction
+		names  []string
+		count  int
+	}{
+		{"alter table t partition by hash(a) partitions 2", plan.PartitionAction_PartitionBy, nil, 0},
+		{"alter table t remove partitioning", plan.PartitionAction_Remove, nil, 0},
+		{"alter table t add partition (partition p3 values less than (40))", plan.PartitionAction_Add, nil, 0},
+		{"alter table t add partition partitions 3", plan.PartitionAction_Add, nil, 3},
+		{"alter table t drop partition p0, p1", plan.PartitionAction_Drop, []string{"p0", "p1"}, 0},
+		{"alter table t truncate partition p1", plan.PartitionAction_Truncate, []string{"p1"}, 0},
+		{"alter table t coalesce partition 2", plan.PartitionAction_Coalesce, nil, 2},
+		{
+			"alter table t reorganize partition p0 into (partition p00 values less than (5), partition p01 values less than (10))",
+			plan.PartitionAction_Reorganize, []string{"p0"}, 0,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			node, err := Parse(sql.NewEmptyContext(), tt.query)
+			require.NoError(t, err)
+			alter, ok := node.(*plan.AlterPartition)
+			require.True(t, ok)
+			require.Equal(t, tt.action, alter.Action)
+			require.Equal(t, tt.names, alter.Names)
+			require.Equal(t, tt.count, alter.Count)
+		})
+	}
+
+	_, err := Parse(sql.NewEmptyContext(), "alter table t truncate partition all")
+	require.NoError(t, err)
+
+	_, err = Parse(sql.NewEmptyContext(), "create table t (a int) partition by hash(a) subpartition by key(a)")
+	require.Error(t, err)
+}
@@ -0,0 +1,297 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/linanh/go-mysql-server/sql"
+	"github.com/linanh/go-mysql-server/sql/expression"
+	"github.com/linanh/go-mysql-server/sql/plan"
+)
+
+// dynamicStatementProcedure is the name of the procedure which the statements of dynamic SQL, and KILL statements, are
+// replaced with in the bodies of stored procedures, as the vitess parser doesn't support them. The statement text is its
+// only argument.
+const dynamicStatementProcedure = "__dynamic_statement"
+
+var dynamicStatementRegex = regexp.MustCompile(`(?i)^(prepare|execute|(deallocate|drop)\s+prepare|kill)\b`)
+
+// parsePrepare parses PREPARE statements.
+func parsePrepare(ctx *sql.Context, s string) (sql.Node, error) {
+	p, err := newTokenParser(s)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectKeywords("prepare"); err != nil {
+		return nil, err
+	}
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectKeywords("from"); err != nil {
+		return nil, err
+	}
+
+	var query sql.Expression
+	if p.isPunct(0, "@") {
+		query, err = p.userVar()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		text, err := p.stringLiteral()
+		if err != nil {
+			return nil, err
+		}
+		query = expression.NewLiteral(text, sql.LongText)
+	}
+
+	if err := p.expectEOF(); err != nil {
+		return nil, err
+	}
+	return plan.NewPrepareQuery(name, query), nil
+}
+
+// parseExecute parses EXECUTE statements.
+func parseExecute(ctx *sql.Context, s string) (sql.Node, error) {
+	p, err := newTokenParser(s)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectKeywords("execute"); err != nil {
+		return nil, err
+	}
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+
+	var using []sql.Expression
+	if p.acceptKeywords("using") {
+		for {
+			v, err := p.userVar()
+			if err != nil {
+				return nil, err
+			}
+			using = append(using, v)
+			if !p.acceptPunct(",") {
+				break
+			}
+		}
+	}
+
+	if err := p.expectEOF(); err != nil {
+		return nil, err
+	}
+	return plan.NewExecuteQuery(name, using), nil
+}
+
+// parseDeallocate parses DEALLOCATE PREPARE and DROP PREPARE statements.
+func parseDeallocate(ctx *sql.Context, s string) (sql.Node, error) {
+	p, err := newTokenParser(s)
+	if err != nil {
+		return nil, err
+	}
+	if !p.acceptKeywords("drop", "prepare") {
+		if err := p.expectKeywords("deallocate", "prepare"); err != nil {
+			return nil, err
+		}
+	}
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectEOF(); err != nil {
+		return nil, err
+	}
+	return plan.NewDeallocateQuery(name), nil
+}
+
+// parseDynamicStatement parses a statement of dynamic SQL, or a KILL statement, found in the body of a stored procedure.
+func parseDynamicStatement(ctx *sql.Context, s string) (sql.Node, error) {
+	lower := strings.ToLower(s)
+	switch {
+	case prepareRegex.MatchString(lower):
+		return parsePrepare(ctx, s)
+	case executeRegex.MatchString(lower):
+		return parseExecute(ctx, s)
+	case killRegex.MatchString(lower):
+		return parseKill(ctx, s)
+	default:
+		return parseDeallocate(ctx, s)
+	}
+}
+
+// userVar reads a user variable, such as @name.
+func (p *tokenParser) userVar() (sql.Expression, error) {
+	if err := p.expectPunct("@"); err != nil {
+		return nil, err
+	}
+	name, err := p.identOrString()
+	if err != nil {
+		return nil, err
+	}
+	return expression.NewUserVar(name), nil
+}
+
+// rewriteDynamicStatements replaces the statements of dynamic SQL in the body of a CREATE PROCEDURE statement with calls
+// to dynamicStatementProcedure, which convertCall turns back into the statements. It returns the statement rewritten
+// and the replacements made, keyed by the text they replaced.
+func rewriteDynamicStatements(s string) (string, map[string]string, error) {
+	runes := []rune(s)
+	var sb strings.Builder
+	replaced := make(map[string]string)
+
+	// statementStart is whether the next word starts a statement of the body
+	statementStart := false
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			sb.WriteRune(r)
+			i++
+		case r == '#' || (r == '-' && i+2 < len(runes) && runes[i+1] == '-' && unicode.IsSpace(runes[i+2])):
+			start := i
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+			sb.WriteString(string(runes[start:i]))
+		case r == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			end := strings.Index(string(runes[i+2:]), "*/")
+			if end < 0 {
+				return "", nil, errUnterminatedToken.New("comment", i)
+			}
+			next := i + 2 + len([]rune(string(runes[i+2:])[:end])) + 2
+			sb.WriteString(string(runes[i:next]))
+			i = next
+		case r == '\'' || r == '"' || r == '`':
+			_, next, err := readQuoted(runes, i)
+			if err != nil {
+				return "", nil, err
+			}
+			sb.WriteString(string(runes[i:next]))
+			statementStart = false
+			i = next
+		case isIdentRune(r):
+			if statementStart && dynamicStatementRegex.MatchString(string(runes[i:])) {
+				end, err := statementEnd(runes, i)
+				if err != nil {
+					return "", nil, err
+				}
+				original := string(runes[i:end])
+				call := "CALL " + dynamicStatementProcedure + "('" + escapeString(original) + "')"
+				replaced[call] = original
+				sb.WriteString(call)
+				statementStart = false
+				i = end
+				continue
+			}
+
+			start := i
+			for i < len(runes) && isIdentRune(runes[i]) {
+				i++
+			}
+			word := strings.ToLower(string(runes[start:i]))
+			sb.WriteString(string(runes[start:i]))
+			switch word {
+			case "begin", "then", "else", "do", "loop", "repeat":
+				statementStart = true
+			default:
+				statementStart = false
+			}
+		default:
+			sb.WriteRune(r)
+			// Labels end with a colon, which isn't part of an assignment
+			statementStart = r == ';' || (r == ':' && (i+1 == len(runes) || runes[i+1] != '='))
+			i++
+		}
+	}
+	return sb.String(), replaced, nil
+}
+
+// statementEnd returns the position of the semicolon ending the statement which starts at runes[start], or the end of
+// the runes if there's none.
+func statementEnd(runes []rune, start int) (int, error) {
+	for i := start; i < len(runes); {
+		switch runes[i] {
+		case ';':
+			return i, nil
+		case '\'', '"', '`':
+			_, next, err := readQuoted(runes, i)
+			if err != nil {
+				return 0, err
+			}
+			i = next
+		default:
+			i++
+		}
+	}
+	return len(runes), nil
+}
+
+func escapeString(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s)
+}
+
+// restoreDynamicStatements puts back the statements of dynamic SQL in the text of a stored procedure created from a
+// statement rewritten by rewriteDynamicStatements.
+func restoreDynamicStatements(n sql.Node, original string, replaced map[string]string) sql.Node {
+	cp, ok := n.(*plan.CreateProcedure)
+	if !ok {
+		return n
+	}
+	cp.CreateProcedureString = original
+	for call, statement := range replaced {
+		cp.BodyString = strings.Replace(cp.BodyString, call, statement, -1)
+	}
+	return cp
+}
+
+// parseCreateProcedure parses CREATE PROCEDURE statements, which may have statements of dynamic SQL in their body.
+func parseCreateProcedure(ctx *sql.Context, s string) (sql.Node, error) {
+	rewritten, replaced, err := rewriteDynamicStatements(s)
+	if err != nil {
+		// The vitess parser reports the error
+		rewritten = s
+	}
+
+	stmt, err := sqlparser.Parse(rewritten)
+	if err != nil {
+		return nil, sql.ErrSyntaxError.New(err.Error())
+	}
+	n, err := convert(ctx, stmt, rewritten)
+	if err != nil {
+		return nil, err
+	}
+	return restoreDynamicStatements(n, s, replaced), nil
+}
+
+// convertDynamicStatement converts a call to dynamicStatementProcedure into the statement of dynamic SQL it replaced.
+func convertDynamicStatement(ctx *sql.Context, c *sqlparser.Call) (sql.Node, error) {
+	if len(c.Params) != 1 {
+		return nil, sql.ErrSyntaxError.New("invalid dynamic statement")
+	}
+	val, ok := c.Params[0].(*sqlparser.SQLVal)
+	if !ok || val.Type != sqlparser.StrVal {
+		return nil, sql.ErrSyntaxError.New("invalid dynamic statement")
+	}
+	return parseDynamicStatement(ctx, string(val.Val))
+}
@@ -0,0 +1,89 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// passwordKeywords are the keywords whose string literal following them, possibly after =, is a password, such as in
+// CREATE USER ... IDENTIFIED BY 'password' or CHANGE REPLICATION SOURCE TO SOURCE_PASSWORD = 'password'. BY and AS are
+// only followed by passwords in the statements which identify users.
+var passwordKeywords = map[string]bool{
+	"password": true, "source_password": true, "master_password": true, "by": true, "as": true,
+}
+
+// RedactPasswords replaces the passwords in a query with ?, leaving the rest of the query as it is. The queries whose
+// passwords can't be found in their text, such as those in MySQL specific comments, are normalized as in NormalizeQuery
+// instead, which replaces every literal.
+func RedactPasswords(query string) string {
+	var sb strings.Builder
+	tokenizer := sqlparser.NewStringTokenizer(query)
+	// written is the position up to which the query was copied, and end the position after the last token
+	written, end := 0, 0
+	var typer statementTyper
+	// setPassword is whether the query is a SET PASSWORD [FOR user] = 'password' whose = wasn't reached yet
+	identified, setPassword, redactNext := false, false, false
+	for {
+		typ, val := tokenizer.Scan()
+		if typ == 0 {
+			break
+		}
+		start := end
+		for start < len(query) && strings.IndexByte(" \n\r\t", query[start]) >= 0 {
+			start++
+		}
+		prevEnd := end
+		end = tokenizer.Position - 1
+		typer.next(typ, val)
+
+		switch typ {
+		case sqlparser.STRING, sqlparser.LEX_ERROR:
+			if redactNext {
+				// The tokens of MySQL specific comments all end where the comment does
+				if end <= prevEnd {
+					return NormalizeQuery(query)
+				}
+				if typ == sqlparser.LEX_ERROR {
+					end = len(query)
+				}
+				sb.WriteString(query[written:start])
+				sb.WriteByte('?')
+				written = end
+			}
+			if typ == sqlparser.LEX_ERROR {
+				return sb.String() + query[written:]
+			}
+			redactNext = false
+		case sqlparser.COMMENT:
+		case '=':
+			if setPassword {
+				setPassword, redactNext = false, true
+			}
+		default:
+			word := strings.ToLower(string(val))
+			if word == "identified" {
+				identified = true
+			}
+			if word == "password" && typer.typ == "set" {
+				setPassword = true
+			}
+			redactNext = passwordKeywords[word] && (identified || (word != "by" && word != "as"))
+		}
+	}
+	return sb.String() + query[written:]
+}
@@ -0,0 +1,49 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactPasswords(t *testing.T) {
+	for query, expected := range map[string]string{
+		"CREATE USER 'bob'@'%' IDENTIFIED BY 'hunter2'": "CREATE USER 'bob'@'%' IDENTIFIED BY ?",
+		"ALTER USER bob IDENTIFIED WITH caching_sha2_password BY \"it's\" PASSWORD EXPIRE NEVER": "ALTER USER bob " +
+			"IDENTIFIED WITH caching_sha2_password BY ? PASSWORD EXPIRE NEVER",
+		"CREATE USER alice IDENTIFIED WITH mysql_native_password AS '*2470C0C06DEE42FD1618BB99005ADCA2EC9D1E19', bob " +
+			"IDENTIFIED BY 'x'": "CREATE USER alice IDENTIFIED WITH mysql_native_password AS ?, bob IDENTIFIED BY ?",
+		"SET PASSWORD FOR bob = 'hunter2'": "SET PASSWORD FOR bob = ?",
+		"CHANGE REPLICATION SOURCE TO SOURCE_HOST = 'db1', SOURCE_USER = 'repl', SOURCE_PASSWORD='s3cret'": "CHANGE " +
+			"REPLICATION SOURCE TO SOURCE_HOST = 'db1', SOURCE_USER = 'repl', SOURCE_PASSWORD=?",
+		"CHANGE MASTER TO MASTER_PASSWORD = 's3cret', MASTER_PORT = 3306": "CHANGE MASTER TO MASTER_PASSWORD = ?, " +
+			"MASTER_PORT = 3306",
+		"SELECT name AS 'n' FROM t ORDER BY 'n'": "SELECT name AS 'n' FROM t ORDER BY 'n'",
+		// Comments and the quotes of strings don't hide the passwords
+		"CREATE USER bob /* 'c' */ IDENTIFIED BY -- by\n'it''s' -- 'c'": "CREATE USER bob /* 'c' */ IDENTIFIED BY -- by\n? " +
+			"-- 'c'",
+		"SELECT 'IDENTIFIED BY', 'x'":             "SELECT 'IDENTIFIED BY', 'x'",
+		"CREATE USER bob IDENTIFIED BY 'unclosed": "CREATE USER bob IDENTIFIED BY ?",
+		// The tokens of MySQL specific comments can't be told apart in the text
+		"CREATE USER bob IDENTIFIED BY /*! 'x' */":  "CREATE USER bob IDENTIFIED BY ?",
+		"CREATE USER bob /*! IDENTIFIED BY 'x' */ ": "CREATE `USER` `bob` `IDENTIFIED` BY ?",
+	} {
+		t.Run(query, func(t *testing.T) {
+			require.Equal(t, expected, RedactPasswords(query))
+		})
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"strings"
+
+	"github.com/linanh/go-mysql-server/sql"
+	"github.com/linanh/go-mysql-server/sql/plan"
+)
+
+// parseReplication parses the statements controlling the replica: CHANGE REPLICATION SOURCE TO option = value, ...,
+// START REPLICA, STOP REPLICA and SHOW REPLICA STATUS, along with their older forms naming the source master and the
+// replica slave.
+func parseReplication(ctx *sql.Context, s string) (sql.Node, error) {
+	p, err := newTokenParser(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var node sql.Node
+	switch {
+	case p.acceptKeywords("start"):
+		if !p.acceptKeywords("replica") {
+			if err := p.expectKeywords("slave"); err != nil {
+				return nil, err
+			}
+		}
+		node = plan.NewStartReplica()
+	case p.acceptKeywords("stop"):
+		if !p.acceptKeywords("replica") {
+			if err := p.expectKeywords("slave"); err != nil {
+				return nil, err
+			}
+		}
+		node = plan.NewStopReplica()
+	case p.acceptKeywords("show"):
+		if !p.acceptKeywords("replica") {
+			if err := p.expectKeywords("slave"); err != nil {
+				return nil, err
+			}
+		}
+		if err := p.expectKeywords("status"); err != nil {
+			return nil, err
+		}
+		node = plan.NewShowReplicaStatus()
+	default:
+		if err := p.expectKeywords("change"); err != nil {
+			return nil, err
+		}
+		if !p.acceptKeywords("replication", "source") {
+			if err := p.expectKeywords("master"); err != nil {
+				return nil, err
+			}
+		}
+		if err := p.expectKeywords("to"); err != nil {
+			return nil, err
+		}
+		options, err := p.replicationOptions()
+		if err != nil {
+			return nil, err
+		}
+		node = plan.NewChangeReplicationSource(options)
+	}
+
+	if err := p.expectEOF(); err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// replicationOptions reads a comma-separated list of replication options set to a string or a number.
+func (p *tokenParser) replicationOptions() ([]plan.ReplicationOption, error) {
+	var options []plan.ReplicationOption
+	for {
+		name, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectPunct("="); err != nil {
+			return nil, err
+		}
+		var value interface{}
+		if p.peek().typ == tokenString {
+			value, err = p.stringLiteral()
+		} else {
+			value, err = p.number()
+		}
+		if err != nil {
+			return nil, err
+		}
+		// Unknown options and invalid values are rejected now rather than when the statement is executed
+		var source sql.ReplicationSource
+		if err := source.Set(name, value); err != nil {
+			return nil, err
+		}
+		options = append(options, plan.ReplicationOption{Name: strings.ToUpper(name), Value: value})
+
+		if !p.acceptPunct(",") {
+			return options, nil
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/linanh/go-mysql-server/sql"
+	"github.com/linanh/go-mysql-server/sql/plan"
+)
+
+// parseShowBinlogs parses the statements reading the binary log: SHOW {BINARY | MASTER} LOGS, SHOW MASTER STATUS and
+// SHOW BINLOG EVENTS [IN 'file'] [FROM pos] [LIMIT [offset,] count].
+func parseShowBinlogs(ctx *sql.Context, s string) (sql.Node, error) {
+	p, err := newTokenParser(s)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectKeywords("show"); err != nil {
+		return nil, err
+	}
+
+	var node sql.Node
+	switch {
+	case p.acceptKeywords("binary", "logs"), p.acceptKeywords("master", "logs"):
+		node = plan.NewShowBinaryLogs()
+	case p.acceptKeywords("master", "status"):
+		node = plan.NewShowMasterStatus()
+	default:
+		if err := p.expectKeywords("binlog", "events"); err != nil {
+			return nil, err
+		}
+		var file string
+		if p.acceptKeywords("in") {
+			if file, err = p.stringLiteral(); err != nil {
+				return nil, err
+			}
+		}
+		var pos uint64
+		if p.acceptKeywords("from") {
+			if pos, err = p.number(); err != nil {
+				return nil, err
+			}
+		}
+		offset, limit := uint64(0), int64(-1)
+		if p.acceptKeywords("limit") {
+			n, err := p.number()
+			if err != nil {
+				return nil, err
+			}
+			if p.acceptPunct(",") {
+				offset = n
+				if n, err = p.number(); err != nil {
+					return nil, err
+				}
+			}
+			limit = int64(n)
+		}
+		node = plan.NewShowBinlogEvents(file, pos, int64(offset), limit)
+	}
+
+	if err := p.expectEOF(); err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// parsePurgeBinaryLogs parses PURGE {BINARY | MASTER} LOGS {TO 'file' | BEFORE datetime}.
+func parsePurgeBinaryLogs(ctx *sql.Context, s string) (sql.Node, error) {
+	p, err := newTokenParser(s)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectKeywords("purge"); err != nil {
+		return nil, err
+	}
+	if !p.acceptKeywords("binary", "logs") {
+		if err := p.expectKeywords("master", "logs"); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.acceptKeywords("to") {
+		file, err := p.stringLiteral()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectEOF(); err != nil {
+			return nil, err
+		}
+		return plan.NewPurgeBinaryLogsTo(file), nil
+	}
+
+	if err := p.expectKeywords("before"); err != nil {
+		return nil, err
+	}
+	if p.atEOF() {
+		return nil, errUnexpectedSyntax.New("datetime", p.peek().String())
+	}
+	text := strings.TrimSpace(string(p.src[p.peek().pos:]))
+	before, err := parseExpression(ctx, strings.TrimSuffix(text, ";"))
+	if err != nil {
+		return nil, err
+	}
+	return plan.NewPurgeBinaryLogsBefore(before), nil
+}
+
+// number reads an unsigned integer, such as a position or a row count.
+func (p *tokenParser) number() (uint64, error) {
+	t := p.peek()
+	if t.typ != tokenNumber {
+		return 0, errUnexpectedSyntax.New("number", t.String())
+	}
+	p.next()
+	n, err := strconv.ParseInt(t.val, 10, 64)
+	if err != nil {
+		return 0, sql.ErrSyntaxError.New(err.Error())
+	}
+	return uint64(n), nil
+}
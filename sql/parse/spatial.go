@@ -0,0 +1,122 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/linanh/go-mysql-server/sql"
+	"github.com/linanh/go-mysql-server/sql/plan"
+)
+
+// sridCommentRegex matches the versioned comments SHOW CREATE TABLE declares the SRIDs of columns with.
+var sridCommentRegex = regexp.MustCompile(`(?i)/\*!\d{5}\s+(srid\s+\d+)\s*\*/`)
+
+// rewriteColumnSRIDs returns a query with the SRID attributes of its spatial columns removed, as the parser doesn't
+// support them, along with the SRIDs by the names of their columns.
+func rewriteColumnSRIDs(query string) (string, map[string]uint32, error) {
+	if !strings.Contains(strings.ToLower(query), "srid") {
+		return query, nil, nil
+	}
+	query = sridCommentRegex.ReplaceAllString(query, "$1")
+
+	var b strings.Builder
+	var srids map[string]uint32
+	last := 0
+	column, prevVal := "", ""
+	tokenizer := sqlparser.NewStringTokenizer(query)
+	for {
+		typ, val := tokenizer.Scan()
+		if typ == 0 || typ == sqlparser.LEX_ERROR {
+			break
+		}
+		end := tokenizer.Position - 1
+
+		if typ == sqlparser.ID && strings.EqualFold(string(val), "srid") {
+			typ = sqlparser.SRID
+		}
+
+		switch typ {
+		case sqlparser.GEOMETRY, sqlparser.POINT, sqlparser.LINESTRING, sqlparser.POLYGON,
+			sqlparser.GEOMETRYCOLLECTION, sqlparser.MULTIPOINT, sqlparser.MULTILINESTRING, sqlparser.MULTIPOLYGON:
+			column = prevVal
+		case ',':
+			column = ""
+		case sqlparser.SRID:
+			start := end - len(val)
+			nextTyp, nextVal := tokenizer.Scan()
+			prevVal = string(nextVal)
+			if column == "" || nextTyp != sqlparser.INTEGRAL {
+				continue
+			}
+			srid, err := strconv.ParseUint(string(nextVal), 10, 32)
+			if err != nil {
+				return "", nil, sql.ErrSyntaxError.New(err.Error())
+			}
+			if srids == nil {
+				srids = make(map[string]uint32)
+			}
+			srids[strings.ToLower(column)] = uint32(srid)
+			b.WriteString(query[last:start])
+			last = tokenizer.Position - 1
+			continue
+		}
+		prevVal = string(val)
+	}
+
+	if last == 0 {
+		return query, nil, nil
+	}
+	b.WriteString(query[last:])
+	return b.String(), srids, nil
+}
+
+// applyColumnSRIDs restricts the types of the spatial columns created or modified by a node to the SRIDs returned by
+// rewriteColumnSRIDs.
+func applyColumnSRIDs(node sql.Node, srids map[string]uint32) (sql.Node, error) {
+	if len(srids) == 0 {
+		return node, nil
+	}
+
+	var columns []*sql.Column
+	plan.Inspect(node, func(n sql.Node) bool {
+		switch n := n.(type) {
+		case *plan.CreateTable:
+			columns = append(columns, n.Schema()...)
+		case *plan.AddColumn:
+			columns = append(columns, n.Column())
+		case *plan.ModifyColumn:
+			columns = append(columns, n.NewColumn())
+		}
+		return true
+	})
+
+	for _, col := range columns {
+		srid, ok := srids[strings.ToLower(col.Name)]
+		if !ok {
+			continue
+		}
+		typ, err := sql.CreateSpatialType(col.Type.String(), srid, true)
+		if err != nil {
+			return nil, err
+		}
+		col.Type = typ
+	}
+	return node, nil
+}
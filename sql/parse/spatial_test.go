@@ -0,0 +1,59 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewriteColumnSRIDs(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected string
+		srids    map[string]uint32
+	}{
+		{
+			"create table t (pk int primary key, g point not null srid 4326, h geometry SRID 0)",
+			"create table t (pk int primary key, g point not null , h geometry )",
+			map[string]uint32{"g": 4326, "h": 0},
+		},
+		{
+			"create table t (`Loc` point /*!80003 SRID 3857 */ not null)",
+			"create table t (`Loc` point  not null)",
+			map[string]uint32{"loc": 3857},
+		},
+		{
+			"alter table t add column g polygon srid 0",
+			"alter table t add column g polygon ",
+			map[string]uint32{"g": 0},
+		},
+		{
+			"select srid from t where srid = 4326",
+			"select srid from t where srid = 4326",
+			nil,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			query, srids, err := rewriteColumnSRIDs(tt.query)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, query)
+			require.Equal(t, tt.srids, srids)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// loopKeywords are the words starting the loops of compound statements, which are matched by their text.
+var loopKeywords = map[string]bool{
+	"loop":   true,
+	"repeat": true,
+	"while":  true,
+}
+
+// SplitStatements splits a query holding several statements separated by semicolons, as sent by clients using
+// CLIENT_MULTI_STATEMENTS, into its statements. Unlike sqlparser.SplitStatementToPieces, the semicolons inside the
+// BEGIN ... END, IF, CASE and loop bodies of CREATE PROCEDURE, TRIGGER and EVENT statements don't split them. Empty
+// statements are dropped.
+func SplitStatements(query string) ([]string, error) {
+	var pieces []string
+	for query != "" {
+		piece, rest, err := FirstStatement(query)
+		if err != nil {
+			return nil, err
+		}
+		if piece != "" {
+			pieces = append(pieces, piece)
+		}
+		query = rest
+	}
+	return pieces, nil
+}
+
+// FirstStatement returns the first statement of a query holding several statements, as SplitStatements splits it,
+// and the rest of the query, which is empty if no statement follows. The statement is empty if the query has none.
+func FirstStatement(query string) (string, string, error) {
+	tokenizer := sqlparser.NewStringTokenizer(query)
+
+	var (
+		begin int
+		// compound is whether the statement is a CREATE statement, which may have a compound statement as its body
+		compound bool
+		// depth is the number of compound statements which the tokenizer is in
+		depth int
+		// first is whether the next token is the first one of the statement
+		first = true
+		// prev is the type of the previous token, and prevWord its lower cased text
+		prev     int
+		prevWord string
+	)
+	for {
+		typ, val := tokenizer.Scan()
+		switch typ {
+		case 0:
+			if piece := query[begin:]; strings.TrimSpace(piece) != "" {
+				return piece, "", nil
+			}
+			return "", "", nil
+		case sqlparser.LEX_ERROR:
+			return "", "", sql.ErrSyntaxError.New(fmt.Sprintf("syntax error at position %d near '%s'", tokenizer.Position, val))
+		case sqlparser.COMMENT:
+			continue
+		}
+
+		var word string
+		if typ != sqlparser.STRING {
+			word = strings.ToLower(string(val))
+		}
+
+		if first {
+			compound = typ == sqlparser.CREATE
+			first = false
+		}
+
+		if compound {
+			// The word after END is the kind of the compound statement it ends, as in END IF
+			afterEnd := prev == sqlparser.END
+			switch {
+			case typ == sqlparser.BEGIN, typ == sqlparser.CASE && !afterEnd:
+				depth++
+			case typ == sqlparser.IF && !afterEnd, loopKeywords[word] && !afterEnd:
+				// IF and REPEAT are also functions, which don't start statements
+				if startsStatement(prev, prevWord, depth) {
+					depth++
+				}
+			case typ == sqlparser.END && depth > 0:
+				depth--
+			}
+		}
+
+		if typ == ';' && depth == 0 {
+			// The tokenizer reads one character ahead of the token it returns
+			piece, rest := query[begin:tokenizer.Position-2], query[tokenizer.Position-1:]
+			if strings.TrimSpace(piece) != "" {
+				if strings.TrimSpace(rest) == "" {
+					rest = ""
+				}
+				return piece, rest, nil
+			}
+			begin = tokenizer.Position - 1
+			first = true
+		}
+
+		prev, prevWord = typ, word
+	}
+}
+
+// startsStatement returns whether a token following the one given starts a statement inside the body of a CREATE
+// statement, depth compound statements deep.
+func startsStatement(prev int, prevWord string, depth int) bool {
+	switch prev {
+	case ';', ':', sqlparser.BEGIN, sqlparser.THEN, sqlparser.ELSE, sqlparser.ROW:
+		return true
+	case ')':
+		// The body of a procedure follows its parameters
+		return depth == 0
+	default:
+		return prevWord == "do" || loopKeywords[prevWord]
+	}
+}
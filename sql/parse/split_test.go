@@ -0,0 +1,87 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitStatements(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected []string
+	}{
+		{"select 1", []string{"select 1"}},
+		{"select 1;", []string{"select 1"}},
+		{"insert into t values (1); select last_insert_id()", []string{"insert into t values (1)", " select last_insert_id()"}},
+		{"select ';'; select 2;;", []string{"select ';'", " select 2"}},
+		{"select 1; /* ; */ select 2", []string{"select 1", " /* ; */ select 2"}},
+		{"begin; select 1; commit", []string{"begin", " select 1", " commit"}},
+		{
+			"create procedure p() begin select 1; if a then select 2; end if; end; call p()",
+			[]string{"create procedure p() begin select 1; if a then select 2; end if; end", " call p()"},
+		},
+		{
+			"create procedure p(x int) if x > 0 then select if(x, 1, 2); else select 3; end if; select 4",
+			[]string{"create procedure p(x int) if x > 0 then select if(x, 1, 2); else select 3; end if", " select 4"},
+		},
+		{
+			"create trigger t before insert on a for each row begin set new.x = case when new.y then 1 else 2 end; end; select 1",
+			[]string{"create trigger t before insert on a for each row begin set new.x = case when new.y then 1 else 2 end; end", " select 1"},
+		},
+		{
+			"create procedure p() begin while x < 3 do set x = repeat('a', 2); end while; end; select 1",
+			[]string{"create procedure p() begin while x < 3 do set x = repeat('a', 2); end while; end", " select 1"},
+		},
+		{"create table t (a int); select 1", []string{"create table t (a int)", " select 1"}},
+		{"", nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			pieces, err := SplitStatements(tt.query)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, pieces)
+		})
+	}
+
+	_, err := SplitStatements("select 'unterminated; select 1")
+	require.Error(t, err)
+}
+
+func TestFirstStatement(t *testing.T) {
+	testCases := []struct {
+		query     string
+		statement string
+		rest      string
+	}{
+		{"select 1", "select 1", ""},
+		{"select 1; ", "select 1", ""},
+		{";; select 1; select 2", " select 1", " select 2"},
+		{"create procedure p() begin select 1; end; call p()", "create procedure p() begin select 1; end", " call p()"},
+		{" ; ", "", ""},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			statement, rest, err := FirstStatement(tt.query)
+			require.NoError(t, err)
+			require.Equal(t, tt.statement, statement)
+			require.Equal(t, tt.rest, rest)
+		})
+	}
+}
@@ -0,0 +1,542 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"strings"
+
+	"github.com/linanh/go-mysql-server/sql"
+	"github.com/linanh/go-mysql-server/sql/plan"
+)
+
+// parseCreateUser parses CREATE USER and CREATE ROLE statements.
+func parseCreateUser(ctx *sql.Context, s string) (sql.Node, error) {
+	p, err := newTokenParser(s)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectKeywords("create"); err != nil {
+		return nil, err
+	}
+
+	isRole := p.acceptKeywords("role")
+	if !isRole {
+		if err := p.expectKeywords("user"); err != nil {
+			return nil, err
+		}
+	}
+	ifNotExists := p.acceptKeywords("if", "not", "exists")
+
+	if isRole {
+		roles, err := p.userIdentities()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectEOF(); err != nil {
+			return nil, err
+		}
+		return plan.NewCreateRole(roles, ifNotExists), nil
+	}
+
+	users, err := p.userSpecs()
+	if err != nil {
+		return nil, err
+	}
+	locked, err := p.accountLockOption()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectEOF(); err != nil {
+		return nil, err
+	}
+	return plan.NewCreateUser(users, ifNotExists, locked), nil
+}
+
+// parseAlterUser parses ALTER USER statements.
+func parseAlterUser(ctx *sql.Context, s string) (sql.Node, error) {
+	p, err := newTokenParser(s)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectKeywords("alter", "user"); err != nil {
+		return nil, err
+	}
+	ifExists := p.acceptKeywords("if", "exists")
+
+	users, err := p.userSpecs()
+	if err != nil {
+		return nil, err
+	}
+	locked, err := p.accountLockOption()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectEOF(); err != nil {
+		return nil, err
+	}
+	return plan.NewAlterUser(users, ifExists, locked), nil
+}
+
+// parseDropUser parses DROP USER and DROP ROLE statements.
+func parseDropUser(ctx *sql.Context, s string) (sql.Node, error) {
+	p, err := newTokenParser(s)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectKeywords("drop"); err != nil {
+		return nil, err
+	}
+
+	isRole := p.acceptKeywords("role")
+	if !isRole {
+		if err := p.expectKeywords("user"); err != nil {
+			return nil, err
+		}
+	}
+	ifExists := p.acceptKeywords("if", "exists")
+
+	ids, err := p.userIdentities()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectEOF(); err != nil {
+		return nil, err
+	}
+
+	if isRole {
+		return plan.NewDropRole(ids, ifExists), nil
+	}
+	return plan.NewDropUser(ids, ifExists), nil
+}
+
+// parseGrant parses GRANT statements, which grant either privileges or roles.
+func parseGrant(ctx *sql.Context, s string) (sql.Node, error) {
+	p, err := newTokenParser(s)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectKeywords("grant"); err != nil {
+		return nil, err
+	}
+
+	if !p.hasPrivilegeLevel() {
+		roles, err := p.userIdentities()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectKeywords("to"); err != nil {
+			return nil, err
+		}
+		grantees, err := p.userIdentities()
+		if err != nil {
+			return nil, err
+		}
+		withAdmin := p.acceptKeywords("with", "admin", "option")
+		if err := p.expectEOF(); err != nil {
+			return nil, err
+		}
+		return plan.NewGrantRole(roles, grantees, withAdmin), nil
+	}
+
+	privileges, err := p.privilegeSpecs()
+	if err != nil {
+		return nil, err
+	}
+	target, err := p.privilegeLevel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectKeywords("to"); err != nil {
+		return nil, err
+	}
+	grantees, err := p.userIdentities()
+	if err != nil {
+		return nil, err
+	}
+	withGrant := p.acceptKeywords("with", "grant", "option")
+	if err := p.expectEOF(); err != nil {
+		return nil, err
+	}
+	return plan.NewGrant(privileges, target, grantees, withGrant), nil
+}
+
+// parseRevoke parses REVOKE statements, which revoke privileges, roles, or everything.
+func parseRevoke(ctx *sql.Context, s string) (sql.Node, error) {
+	p, err := newTokenParser(s)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectKeywords("revoke"); err != nil {
+		return nil, err
+	}
+
+	// REVOKE ALL [PRIVILEGES], GRANT OPTION FROM user [, user] ...
+	if p.acceptRevokeAll() {
+		if err := p.expectKeywords("from"); err != nil {
+			return nil, err
+		}
+		grantees, err := p.userIdentities()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectEOF(); err != nil {
+			return nil, err
+		}
+		return plan.NewRevokeAll(grantees), nil
+	}
+
+	if !p.hasPrivilegeLevel() {
+		roles, err := p.userIdentities()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectKeywords("from"); err != nil {
+			return nil, err
+		}
+		grantees, err := p.userIdentities()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectEOF(); err != nil {
+			return nil, err
+		}
+		return plan.NewRevokeRole(roles, grantees), nil
+	}
+
+	privileges, err := p.privilegeSpecs()
+	if err != nil {
+		return nil, err
+	}
+	target, err := p.privilegeLevel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectKeywords("from"); err != nil {
+		return nil, err
+	}
+	grantees, err := p.userIdentities()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectEOF(); err != nil {
+		return nil, err
+	}
+	return plan.NewRevoke(privileges, target, grantees), nil
+}
+
+// parseSetRole parses SET ROLE statements.
+func parseSetRole(ctx *sql.Context, s string) (sql.Node, error) {
+	p, err := newTokenParser(s)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectKeywords("set", "role"); err != nil {
+		return nil, err
+	}
+
+	typ := plan.SetRoleType_List
+	var roles []sql.UserIdentity
+	switch {
+	case p.acceptKeywords("default"):
+		typ = plan.SetRoleType_Default
+	case p.acceptKeywords("none"):
+		typ = plan.SetRoleType_None
+	case p.acceptKeywords("all", "except"):
+		typ = plan.SetRoleType_AllExcept
+		roles, err = p.userIdentities()
+	case p.acceptKeywords("all"):
+		typ = plan.SetRoleType_All
+	default:
+		roles, err = p.userIdentities()
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectEOF(); err != nil {
+		return nil, err
+	}
+	return plan.NewSetRole(typ, roles), nil
+}
+
+// parseShowGrants parses SHOW GRANTS statements.
+func parseShowGrants(ctx *sql.Context, s string) (sql.Node, error) {
+	p, err := newTokenParser(s)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectKeywords("show", "grants"); err != nil {
+		return nil, err
+	}
+
+	if !p.acceptKeywords("for") {
+		if err := p.expectEOF(); err != nil {
+			return nil, err
+		}
+		return plan.NewShowGrants(), nil
+	}
+
+	var user *sql.UserIdentity
+	if p.acceptKeywords("current_user") {
+		if p.acceptPunct("(") {
+			if err := p.expectPunct(")"); err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		id, err := p.userIdentity()
+		if err != nil {
+			return nil, err
+		}
+		user = &id
+	}
+
+	var using []sql.UserIdentity
+	if p.acceptKeywords("using") {
+		using, err = p.userIdentities()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := p.expectEOF(); err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		n := plan.NewShowGrants()
+		n.Using = using
+		return n, nil
+	}
+	return plan.NewShowGrantsFor(*user, using), nil
+}
+
+// userIdentity reads an account name of the form user[@host], where both parts may be quoted.
+func (p *tokenParser) userIdentity() (sql.UserIdentity, error) {
+	user, err := p.identOrString()
+	if err != nil {
+		return sql.UserIdentity{}, err
+	}
+	var host string
+	if p.acceptPunct("@") {
+		host, err = p.identOrString()
+		if err != nil {
+			return sql.UserIdentity{}, err
+		}
+	}
+	return sql.NewUserIdentity(user, host), nil
+}
+
+// userIdentities reads a comma separated list of account names.
+func (p *tokenParser) userIdentities() ([]sql.UserIdentity, error) {
+	var ids []sql.UserIdentity
+	for {
+		id, err := p.userIdentity()
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+		if !p.acceptPunct(",") {
+			return ids, nil
+		}
+	}
+}
+
+// userSpecs reads a comma separated list of account names, each followed by optional authentication options:
+//
+//	IDENTIFIED BY 'password'
+//	IDENTIFIED WITH plugin [BY 'password' | AS 'auth_string']
+func (p *tokenParser) userSpecs() ([]plan.UserSpec, error) {
+	var specs []plan.UserSpec
+	for {
+		id, err := p.userIdentity()
+		if err != nil {
+			return nil, err
+		}
+		spec := plan.UserSpec{Identity: id}
+
+		if p.acceptKeywords("identified") {
+			if p.acceptKeywords("with") {
+				spec.Plugin, err = p.identOrString()
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			switch {
+			case p.acceptKeywords("by"):
+				password, err := p.stringLiteral()
+				if err != nil {
+					return nil, err
+				}
+				spec.Password = &password
+			case spec.Plugin != "" && p.acceptKeywords("as"):
+				authString, err := p.stringLiteral()
+				if err != nil {
+					return nil, err
+				}
+				spec.AuthString = &authString
+			case spec.Plugin == "":
+				return nil, errUnexpectedSyntax.New("by or with", p.peek().String())
+			}
+		}
+
+		specs = append(specs, spec)
+		if !p.acceptPunct(",") {
+			return specs, nil
+		}
+	}
+}
+
+// accountLockOption reads an optional ACCOUNT LOCK or ACCOUNT UNLOCK clause, returning nil if there was none.
+func (p *tokenParser) accountLockOption() (*bool, error) {
+	if !p.acceptKeywords("account") {
+		return nil, nil
+	}
+	var locked bool
+	switch {
+	case p.acceptKeywords("lock"):
+		locked = true
+	case p.acceptKeywords("unlock"):
+		locked = false
+	default:
+		return nil, errUnexpectedSyntax.New("lock or unlock", p.peek().String())
+	}
+	return &locked, nil
+}
+
+// hasPrivilegeLevel returns whether the rest of the statement has an ON clause, which tells privilege grants apart from
+// role grants.
+func (p *tokenParser) hasPrivilegeLevel() bool {
+	for i := p.pos; i < len(p.tokens); i++ {
+		t := p.tokens[i]
+		if t.typ == tokenIdent && (strings.EqualFold(t.val, "to") || strings.EqualFold(t.val, "from")) {
+			return false
+		}
+		if t.typ == tokenIdent && strings.EqualFold(t.val, "on") {
+			return true
+		}
+	}
+	return false
+}
+
+// acceptRevokeAll consumes ALL [PRIVILEGES], GRANT OPTION if it's next, and returns whether it was.
+func (p *tokenParser) acceptRevokeAll() bool {
+	if !p.isKeyword(0, "all") {
+		return false
+	}
+	n := 1
+	if p.isKeyword(n, "privileges") {
+		n++
+	}
+	if p.isPunct(n, ",") && p.isKeyword(n+1, "grant") && p.isKeyword(n+2, "option") && p.isKeyword(n+3, "from") {
+		p.pos += n + 3
+		return true
+	}
+	return false
+}
+
+// privilegeSpecs reads the comma separated list of privileges in a GRANT or REVOKE statement, up to the ON keyword.
+func (p *tokenParser) privilegeSpecs() ([]plan.PrivilegeSpec, error) {
+	var specs []plan.PrivilegeSpec
+	for {
+		var words []string
+		for p.peek().typ == tokenIdent && !p.isKeyword(0, "on") {
+			words = append(words, p.next().val)
+		}
+		if len(words) == 0 {
+			return nil, errUnexpectedSyntax.New("privilege", p.peek().String())
+		}
+
+		priv, err := sql.ParsePrivilegeType(strings.Join(words, " "))
+		if err != nil {
+			return nil, err
+		}
+		spec := plan.PrivilegeSpec{Privileges: priv}
+
+		if p.acceptPunct("(") {
+			for {
+				col, err := p.ident()
+				if err != nil {
+					return nil, err
+				}
+				spec.Columns = append(spec.Columns, col)
+				if !p.acceptPunct(",") {
+					break
+				}
+			}
+			if err := p.expectPunct(")"); err != nil {
+				return nil, err
+			}
+		}
+
+		specs = append(specs, spec)
+		if !p.acceptPunct(",") {
+			break
+		}
+	}
+
+	if err := p.expectKeywords("on"); err != nil {
+		return nil, err
+	}
+	return specs, nil
+}
+
+// privilegeLevel reads the object a privilege is granted on, which is one of *.*, db.*, db.tbl, tbl or *. The last two
+// refer to the current database.
+func (p *tokenParser) privilegeLevel(ctx *sql.Context) (sql.PrivilegeTarget, error) {
+	p.acceptKeywords("table")
+
+	currentDatabase := func() (string, error) {
+		db := ctx.GetCurrentDatabase()
+		if db == "" {
+			return "", sql.ErrNoDatabaseSelected.New()
+		}
+		return db, nil
+	}
+
+	if p.acceptPunct("*") {
+		if p.acceptPunct(".") {
+			if err := p.expectPunct("*"); err != nil {
+				return sql.PrivilegeTarget{}, err
+			}
+			return sql.PrivilegeTarget{}, nil
+		}
+		db, err := currentDatabase()
+		if err != nil {
+			return sql.PrivilegeTarget{}, err
+		}
+		return sql.PrivilegeTarget{Database: strings.ToLower(db)}, nil
+	}
+
+	name, err := p.ident()
+	if err != nil {
+		return sql.PrivilegeTarget{}, err
+	}
+	if !p.acceptPunct(".") {
+		db, err := currentDatabase()
+		if err != nil {
+			return sql.PrivilegeTarget{}, err
+		}
+		return sql.PrivilegeTarget{Database: strings.ToLower(db), Table: strings.ToLower(name)}, nil
+	}
+
+	if p.acceptPunct("*") {
+		return sql.PrivilegeTarget{Database: strings.ToLower(name)}, nil
+	}
+	table, err := p.ident()
+	if err != nil {
+		return sql.PrivilegeTarget{}, err
+	}
+	return sql.PrivilegeTarget{Database: strings.ToLower(name), Table: strings.ToLower(table)}, nil
+}
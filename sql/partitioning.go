@@ -0,0 +1,563 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+var (
+	// ErrNoPartitionForValue is returned when a row doesn't belong to any partition of a RANGE or LIST partitioned
+	// table.
+	ErrNoPartitionForValue = errors.NewKind("Table has no partition for value %v")
+
+	// ErrUnknownPartition is returned when a statement names a partition the table doesn't have.
+	ErrUnknownPartition = errors.NewKind("Unknown partition '%s' in table '%s'")
+
+	// ErrPartitionClauseOnNonPartitioned is returned when a query selects the partitions of a table which isn't
+	// partitioned.
+	ErrPartitionClauseOnNonPartitioned = errors.NewKind("PARTITION () clause on non partitioned table")
+
+	// ErrPartitionManagementOnNonPartitioned is returned when partitions are altered on a table which isn't
+	// partitioned.
+	ErrPartitionManagementOnNonPartitioned = errors.NewKind("Partition management on a not partitioned table is not possible")
+
+	// ErrPartitioningNotSupported is returned when a table is partitioned by an integrator which doesn't support it.
+	ErrPartitioningNotSupported = errors.NewKind("table %s does not support partitioning")
+
+	// ErrDuplicatePartitionName is returned when two partitions of a table have the same name.
+	ErrDuplicatePartitionName = errors.NewKind("Duplicate partition name %s")
+
+	// ErrPartitionsMustBeDefined is returned when a table is partitioned by RANGE or LIST without partitions.
+	ErrPartitionsMustBeDefined = errors.NewKind("For %s partitions each partition must be defined")
+
+	// ErrPartitionValuesMismatch is returned when a partition is defined with the values of another partitioning
+	// method, such as VALUES IN for a RANGE partition.
+	ErrPartitionValuesMismatch = errors.NewKind("Only %s PARTITIONING can use VALUES %s in partition definition")
+
+	// ErrPartitionColumnCount is returned when the values of a partition don't have one value per partitioning
+	// column.
+	ErrPartitionColumnCount = errors.NewKind("Inconsistency in usage of column lists for partitioning")
+
+	// ErrRangeNotIncreasing is returned when the bounds of the partitions of a RANGE partitioned table don't
+	// increase.
+	ErrRangeNotIncreasing = errors.NewKind("VALUES LESS THAN value must be strictly increasing for each partition")
+
+	// ErrPartitionMaxValue is returned when a partition other than the last one is bound by MAXVALUE.
+	ErrPartitionMaxValue = errors.NewKind("MAXVALUE can only be used in last partition definition")
+
+	// ErrMultipleDefConstInListPart is returned when a value is in more than one partition of a LIST partitioned
+	// table.
+	ErrMultipleDefConstInListPart = errors.NewKind("Multiple definition of same constant in list partitioning")
+
+	// ErrPartitionValueType is returned when a partition of a table partitioned by an expression has values which
+	// aren't integers.
+	ErrPartitionValueType = errors.NewKind("VALUES value for partition '%s' must have type INT")
+
+	// ErrPartitionValueNotConstant is returned when the values of a partition aren't constants.
+	ErrPartitionValueNotConstant = errors.NewKind("VALUES value for partition '%s' must be a constant")
+
+	// ErrPartitionFunctionType is returned when the partitioning expression of a table isn't an integer.
+	ErrPartitionFunctionType = errors.NewKind("The PARTITION function returns the wrong type")
+
+	// ErrPartitionFunctionNotAllowed is returned when the partitioning expression of a table doesn't depend on its
+	// columns, or reads something other than them.
+	ErrPartitionFunctionNotAllowed = errors.NewKind("This partition function is not allowed")
+
+	// ErrPartitionColumnNotFound is returned when a partitioning column isn't a column of the table.
+	ErrPartitionColumnNotFound = errors.NewKind("Field in list of fields for partition function not found in table")
+
+	// ErrUniqueKeyNeedsAllPartitionFields is returned when a unique key of a partitioned table doesn't include all its
+	// partitioning columns.
+	ErrUniqueKeyNeedsAllPartitionFields = errors.NewKind("A %s must include all columns in the table's partitioning function")
+
+	// ErrDropLastPartition is returned when all the partitions of a table would be dropped.
+	ErrDropLastPartition = errors.NewKind("Cannot remove all partitions, use DROP TABLE instead")
+
+	// ErrOnlyOnRangeListPartition is returned when partitions of a HASH or KEY partitioned table are dropped.
+	ErrOnlyOnRangeListPartition = errors.NewKind("%s PARTITION can only be used on RANGE/LIST partitions")
+
+	// ErrCoalesceOnlyOnHashPartition is returned when partitions of a RANGE or LIST partitioned table are coalesced.
+	ErrCoalesceOnlyOnHashPartition = errors.NewKind("COALESCE PARTITION can only be used on HASH/KEY partitions")
+
+	// ErrReorganizeRange is returned when reorganized RANGE partitions don't cover the range of the partitions they
+	// replace.
+	ErrReorganizeRange = errors.NewKind("Reorganize of range partitions cannot change total ranges except for last partition where it can extend the range")
+
+	// ErrReorganizeNotConsecutive is returned when the reorganized partitions of a RANGE partitioned table aren't
+	// consecutive.
+	ErrReorganizeNotConsecutive = errors.NewKind("When reorganizing a set of partitions they must be in consecutive order")
+)
+
+// PartitionMethod is the way the rows of a partitioned table are assigned to its partitions.
+type PartitionMethod string
+
+const (
+	// PartitionMethod_Range assigns rows to the first partition whose bound is greater than their value.
+	PartitionMethod_Range PartitionMethod = "RANGE"
+	// PartitionMethod_List assigns rows to the partition listing their value.
+	PartitionMethod_List PartitionMethod = "LIST"
+	// PartitionMethod_Hash assigns rows to partitions by the modulus of their integer value.
+	PartitionMethod_Hash PartitionMethod = "HASH"
+	// PartitionMethod_Key assigns rows to partitions by a hash of the values of their columns.
+	PartitionMethod_Key PartitionMethod = "KEY"
+)
+
+// PartitionDefinition is a partition of a partitioned table.
+type PartitionDefinition struct {
+	Name    string
+	Comment string
+	// LessThan are the exclusive upper bounds of the rows of a RANGE partition, with one value per partitioning
+	// column. A nil value is MAXVALUE.
+	LessThan []interface{}
+	// In are the values of the rows of a LIST partition, each with one value per partitioning column.
+	In [][]interface{}
+}
+
+// Partitioning describes how the rows of a table are split into named partitions, as declared by PARTITION BY.
+type Partitioning struct {
+	Method PartitionMethod
+	// Linear is whether HASH or KEY partitions are assigned with the powers-of-two algorithm.
+	Linear bool
+	// UseColumns is whether RANGE or LIST partitions are bound by the values of columns rather than of an expression.
+	UseColumns bool
+	// Columns are the columns the partition of a row depends on, which are the ones of the partitioning expression,
+	// or the ones listed by RANGE COLUMNS, LIST COLUMNS and KEY.
+	Columns []string
+	// Types are the types of Columns.
+	Types []Type
+	// Expr is the partitioning expression of RANGE, LIST and HASH partitioning. It's evaluated over the values of
+	// Columns, and is nil when partitions are bound by columns.
+	Expr Expression
+	// Expression is the text of Expr, as shown by SHOW CREATE TABLE.
+	Expression string
+	// Definitions are the partitions of the table, in order.
+	Definitions []PartitionDefinition
+}
+
+// PartitionedTable is a table whose rows are split into the partitions declared with PARTITION BY. Queries can be
+// restricted to some of its partitions.
+type PartitionedTable interface {
+	Table
+	// GetPartitioning returns the partitioning of the table, or nil if it isn't partitioned.
+	GetPartitioning(ctx *Context) (*Partitioning, error)
+	// WithPartitions returns a version of the table which only returns the rows of the named partitions. If
+	// partitions were already selected, only the ones in both sets are.
+	WithPartitions(names []string) Table
+}
+
+// PartitionAlterableTable is a table whose partitioning can be declared and altered.
+type PartitionAlterableTable interface {
+	PartitionedTable
+	// SetPartitioning moves every row of the table to its partition in the partitioning given, or removes the
+	// partitioning of the table if it's nil. The table must be left unchanged if any row has no partition.
+	SetPartitioning(ctx *Context, partitioning *Partitioning) error
+	// DropPartitions removes the named partitions of the table along with their rows.
+	DropPartitions(ctx *Context, names []string) error
+	// TruncatePartitions removes the rows of the named partitions of the table.
+	TruncatePartitions(ctx *Context, names []string) error
+}
+
+// Copy returns a copy of the partitioning whose definitions can be changed.
+func (p *Partitioning) Copy() *Partitioning {
+	np := *p
+	np.Definitions = make([]PartitionDefinition, len(p.Definitions))
+	copy(np.Definitions, p.Definitions)
+	return &np
+}
+
+// Names returns the names of the partitions, in order.
+func (p *Partitioning) Names() []string {
+	names := make([]string, len(p.Definitions))
+	for i, d := range p.Definitions {
+		names[i] = d.Name
+	}
+	return names
+}
+
+// PartitionIndex returns the position of the partition with the name given, or -1 if there's none. Partition names
+// are case-insensitive.
+func (p *Partitioning) PartitionIndex(name string) int {
+	for i, d := range p.Definitions {
+		if strings.EqualFold(d.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Validate returns an error if the partitions don't have unique names or valid values for the partitioning method.
+func (p *Partitioning) Validate() error {
+	if len(p.Definitions) == 0 {
+		return ErrPartitionsMustBeDefined.New(p.Method)
+	}
+
+	names := make(map[string]bool)
+	for _, d := range p.Definitions {
+		if names[strings.ToLower(d.Name)] {
+			return ErrDuplicatePartitionName.New(d.Name)
+		}
+		names[strings.ToLower(d.Name)] = true
+
+		switch {
+		case p.Method == PartitionMethod_Range && d.In != nil:
+			return ErrPartitionValuesMismatch.New("LIST", "IN")
+		case p.Method == PartitionMethod_List && d.LessThan != nil:
+			return ErrPartitionValuesMismatch.New("RANGE", "LESS THAN")
+		case p.Method == PartitionMethod_Range && d.LessThan == nil, p.Method == PartitionMethod_List && d.In == nil:
+			return ErrPartitionsMustBeDefined.New(p.Method)
+		}
+	}
+
+	switch p.Method {
+	case PartitionMethod_Range:
+		for i, d := range p.Definitions {
+			if len(d.LessThan) != p.keyLen() {
+				return ErrPartitionColumnCount.New()
+			}
+			if i == 0 {
+				continue
+			}
+			prev := p.Definitions[i-1].LessThan
+			for _, v := range prev {
+				if v == nil && !p.UseColumns {
+					return ErrPartitionMaxValue.New()
+				}
+			}
+			less, err := p.lessThan(prev, d.LessThan)
+			if err != nil {
+				return err
+			}
+			if !less {
+				return ErrRangeNotIncreasing.New()
+			}
+		}
+	case PartitionMethod_List:
+		var seen [][]interface{}
+		for _, d := range p.Definitions {
+			for _, values := range d.In {
+				if len(values) != p.keyLen() {
+					return ErrPartitionColumnCount.New()
+				}
+				for _, other := range seen {
+					equal, err := p.equalValues(values, other)
+					if err != nil {
+						return err
+					}
+					if equal {
+						return ErrMultipleDefConstInListPart.New()
+					}
+				}
+				seen = append(seen, values)
+			}
+		}
+	}
+	return nil
+}
+
+// keyLen returns the number of values the partitions of the table are bound by.
+func (p *Partitioning) keyLen() int {
+	if p.UseColumns {
+		return len(p.Columns)
+	}
+	return 1
+}
+
+// Key returns the values of the partitioning columns of a row of a table with the schema given.
+func (p *Partitioning) Key(schema Schema, row Row) (Row, error) {
+	key := make(Row, len(p.Columns))
+	for i, name := range p.Columns {
+		idx := -1
+		for j, col := range schema {
+			if strings.EqualFold(col.Name, name) {
+				idx = j
+				break
+			}
+		}
+		if idx < 0 || idx >= len(row) {
+			return nil, ErrPartitionColumnNotFound.New()
+		}
+		key[i] = row[idx]
+	}
+	return key, nil
+}
+
+// PartitionOf returns the position of the partition of a row of a table with the schema given.
+func (p *Partitioning) PartitionOf(ctx *Context, schema Schema, row Row) (int, error) {
+	key, err := p.Key(schema, row)
+	if err != nil {
+		return 0, err
+	}
+	return p.PartitionOfKey(ctx, key)
+}
+
+// PartitionOfKey returns the position of the partition of the rows whose partitioning columns have the values given.
+func (p *Partitioning) PartitionOfKey(ctx *Context, key Row) (int, error) {
+	values, err := p.values(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+
+	switch p.Method {
+	case PartitionMethod_Range:
+		for i, d := range p.Definitions {
+			less, err := p.lessThan(values, d.LessThan)
+			if err != nil {
+				return 0, err
+			}
+			if less {
+				return i, nil
+			}
+		}
+	case PartitionMethod_List:
+		for i, d := range p.Definitions {
+			for _, in := range d.In {
+				equal, err := p.equalValues(values, in)
+				if err != nil {
+					return 0, err
+				}
+				if equal {
+					return i, nil
+				}
+			}
+		}
+	case PartitionMethod_Hash:
+		var n int64
+		if values[0] != nil {
+			n = values[0].(int64)
+		}
+		if n < 0 {
+			n = -n
+		}
+		return p.hashPartition(uint64(n)), nil
+	case PartitionMethod_Key:
+		h := fnv.New64a()
+		for _, v := range values {
+			if v == nil {
+				_, _ = h.Write([]byte{0})
+			} else {
+				_, _ = fmt.Fprintf(h, "\x01%v", v)
+			}
+		}
+		return p.hashPartition(h.Sum64()), nil
+	}
+
+	return 0, ErrNoPartitionForValue.New(p.formatValues(values, ","))
+}
+
+// values returns the values the partition of a key is found with: the value of the partitioning expression as an
+// integer, or the values of the partitioning columns.
+func (p *Partitioning) values(ctx *Context, key Row) ([]interface{}, error) {
+	if p.Expr == nil {
+		return key, nil
+	}
+	v, err := p.Expr.Eval(ctx, key)
+	if err != nil || v == nil {
+		return []interface{}{nil}, err
+	}
+	v, err = Int64.Convert(v)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{v}, nil
+}
+
+// hashPartition returns the partition of a HASH or KEY partitioned table of a hashed value. LINEAR partitioning uses
+// the next power of two of the number of partitions as modulus, so that adding partitions only splits existing ones.
+func (p *Partitioning) hashPartition(h uint64) int {
+	n := uint64(len(p.Definitions))
+	if !p.Linear {
+		return int(h % n)
+	}
+	v := uint64(1)
+	for v < n {
+		v <<= 1
+	}
+	i := h & (v - 1)
+	for i >= n {
+		v >>= 1
+		i = i & (v - 1)
+	}
+	return int(i)
+}
+
+// compare compares the ith partitioning value of two keys. NULL is less than any other value and MAXVALUE, which is
+// only found in bounds, greater.
+func (p *Partitioning) compare(i int, a, b interface{}) (int, error) {
+	switch {
+	case a == nil && b == nil:
+		return 0, nil
+	case a == nil:
+		return -1, nil
+	case b == nil:
+		return 1, nil
+	}
+	if !p.UseColumns {
+		return Int64.Compare(a, b)
+	}
+	return p.Types[i].Compare(a, b)
+}
+
+// lessThan returns whether the values of a key are less than the bound of a RANGE partition, comparing them in order.
+func (p *Partitioning) lessThan(values, bound []interface{}) (bool, error) {
+	for i := range values {
+		if i >= len(bound) {
+			return false, nil
+		}
+		if bound[i] == nil {
+			return true, nil
+		}
+		cmp, err := p.compare(i, values[i], bound[i])
+		if err != nil {
+			return false, err
+		}
+		if cmp != 0 {
+			return cmp < 0, nil
+		}
+	}
+	return false, nil
+}
+
+func (p *Partitioning) equalValues(a, b []interface{}) (bool, error) {
+	if len(a) != len(b) {
+		return false, nil
+	}
+	for i := range a {
+		if (a[i] == nil) != (b[i] == nil) {
+			return false, nil
+		}
+		if a[i] == nil {
+			continue
+		}
+		cmp, err := p.compare(i, a[i], b[i])
+		if err != nil || cmp != 0 {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// MethodName returns the partitioning method as shown by information_schema.partitions, such as RANGE COLUMNS or
+// LINEAR HASH.
+func (p *Partitioning) MethodName() string {
+	switch {
+	case p.Linear:
+		return "LINEAR " + string(p.Method)
+	case p.UseColumns:
+		return string(p.Method) + " COLUMNS"
+	default:
+		return string(p.Method)
+	}
+}
+
+// ExpressionString returns the partitioning expression, or the partitioning columns if partitions are bound by
+// columns.
+func (p *Partitioning) ExpressionString() string {
+	if p.Expr != nil {
+		return p.Expression
+	}
+	columns := make([]string, len(p.Columns))
+	for i, c := range p.Columns {
+		columns[i] = "`" + c + "`"
+	}
+	return strings.Join(columns, ",")
+}
+
+// Description returns the values of the partition at the position given as shown by information_schema.partitions,
+// or false for HASH and KEY partitions.
+func (p *Partitioning) Description(i int) (string, bool) {
+	d := p.Definitions[i]
+	switch p.Method {
+	case PartitionMethod_Range:
+		return p.formatValues(d.LessThan, ","), true
+	case PartitionMethod_List:
+		values := make([]string, len(d.In))
+		for j, in := range d.In {
+			values[j] = p.formatValues(in, ",")
+			if len(in) > 1 {
+				values[j] = "(" + values[j] + ")"
+			}
+		}
+		return strings.Join(values, ","), true
+	default:
+		return "", false
+	}
+}
+
+// String returns the PARTITION BY clause of the partitioning, as shown by SHOW CREATE TABLE.
+func (p *Partitioning) String() string {
+	var sb strings.Builder
+	if p.UseColumns {
+		fmt.Fprintf(&sb, "/*!50500 PARTITION BY %s  COLUMNS(%s)", p.Method, p.ExpressionString())
+	} else {
+		fmt.Fprintf(&sb, "/*!50100 PARTITION BY %s (%s)", strings.Replace(p.MethodName(), " COLUMNS", "", 1), p.ExpressionString())
+	}
+
+	switch p.Method {
+	case PartitionMethod_Hash, PartitionMethod_Key:
+		fmt.Fprintf(&sb, "\nPARTITIONS %d", len(p.Definitions))
+	default:
+		definitions := make([]string, len(p.Definitions))
+		for i, d := range p.Definitions {
+			description, _ := p.Description(i)
+			var values string
+			switch {
+			case p.Method == PartitionMethod_List:
+				values = fmt.Sprintf("VALUES IN (%s)", description)
+			case description == "MAXVALUE":
+				values = "VALUES LESS THAN MAXVALUE"
+			default:
+				values = fmt.Sprintf("VALUES LESS THAN (%s)", description)
+			}
+			comment := ""
+			if d.Comment != "" {
+				comment = fmt.Sprintf(" COMMENT = '%s'", strings.ReplaceAll(d.Comment, "'", "''"))
+			}
+			definitions[i] = fmt.Sprintf("PARTITION %s %s%s ENGINE = InnoDB", d.Name, values, comment)
+		}
+		fmt.Fprintf(&sb, "\n(%s)", strings.Join(definitions, ",\n "))
+	}
+
+	sb.WriteString(" */")
+	return sb.String()
+}
+
+func (p *Partitioning) formatValues(values []interface{}, sep string) string {
+	formatted := make([]string, len(values))
+	for i, v := range values {
+		switch {
+		case v == nil && p.Method == PartitionMethod_List:
+			formatted[i] = "NULL"
+		case v == nil:
+			formatted[i] = "MAXVALUE"
+		case !p.UseColumns || i >= len(p.Types):
+			formatted[i] = fmt.Sprintf("%v", v)
+		default:
+			val, err := p.Types[i].SQL(v)
+			if err != nil {
+				formatted[i] = fmt.Sprintf("%v", v)
+			} else if val.IsQuoted() {
+				formatted[i] = "'" + strings.ReplaceAll(val.ToString(), "'", "''") + "'"
+			} else {
+				formatted[i] = val.ToString()
+			}
+		}
+	}
+	return strings.Join(formatted, sep)
+}
@@ -0,0 +1,191 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+// firstKeyValue is a partitioning expression which is the value of the first partitioning column.
+type firstKeyValue struct{}
+
+func (firstKeyValue) Resolved() bool                                             { return true }
+func (firstKeyValue) String() string                                             { return "`a`" }
+func (firstKeyValue) Type() Type                                                 { return Int64 }
+func (firstKeyValue) IsNullable() bool                                           { return true }
+func (firstKeyValue) Eval(_ *Context, row Row) (interface{}, error)              { return row[0], nil }
+func (firstKeyValue) Children() []Expression                                     { return nil }
+func (e firstKeyValue) WithChildren(*Context, ...Expression) (Expression, error) { return e, nil }
+
+func rangePartitioning() *Partitioning {
+	return &Partitioning{
+		Method:     PartitionMethod_Range,
+		Columns:    []string{"a"},
+		Types:      []Type{Int64},
+		Expr:       firstKeyValue{},
+		Expression: "`a`",
+		Definitions: []PartitionDefinition{
+			{Name: "p0", LessThan: []interface{}{int64(10)}},
+			{Name: "p1", LessThan: []interface{}{int64(20)}},
+			{Name: "p2", LessThan: []interface{}{nil}},
+		},
+	}
+}
+
+func listColumnsPartitioning() *Partitioning {
+	return &Partitioning{
+		Method:     PartitionMethod_List,
+		UseColumns: true,
+		Columns:    []string{"a", "b"},
+		Types:      []Type{Int64, LongText},
+		Definitions: []PartitionDefinition{
+			{Name: "pa", In: [][]interface{}{{int64(1), "x"}, {int64(2), "y"}}},
+			{Name: "pb", In: [][]interface{}{{int64(3), "z"}, {nil, "x"}}, Comment: "nulls"},
+		},
+	}
+}
+
+func hashPartitioning(method PartitionMethod, linear bool, n int) *Partitioning {
+	p := &Partitioning{
+		Method:  method,
+		Linear:  linear,
+		Columns: []string{"a"},
+		Types:   []Type{Int64},
+	}
+	if method == PartitionMethod_Hash {
+		p.Expr = firstKeyValue{}
+		p.Expression = "`a`"
+	}
+	for i := 0; i < n; i++ {
+		p.Definitions = append(p.Definitions, PartitionDefinition{Name: "p" + string(rune('0'+i))})
+	}
+	return p
+}
+
+func TestPartitionOfKey(t *testing.T) {
+	testCases := []struct {
+		name         string
+		partitioning *Partitioning
+		key          Row
+		expected     int
+		err          *errors.Kind
+	}{
+		{"range first", rangePartitioning(), Row{int64(5)}, 0, nil},
+		{"range bound", rangePartitioning(), Row{int64(10)}, 1, nil},
+		{"range maxvalue", rangePartitioning(), Row{int64(1000)}, 2, nil},
+		{"range null", rangePartitioning(), Row{nil}, 0, nil},
+		{"list columns", listColumnsPartitioning(), Row{int64(2), "y"}, 0, nil},
+		{"list columns null", listColumnsPartitioning(), Row{nil, "x"}, 1, nil},
+		{"list columns no partition", listColumnsPartitioning(), Row{int64(2), "x"}, 0, ErrNoPartitionForValue},
+		{"hash", hashPartitioning(PartitionMethod_Hash, false, 3), Row{int64(7)}, 1, nil},
+		{"hash negative", hashPartitioning(PartitionMethod_Hash, false, 3), Row{int64(-7)}, 1, nil},
+		{"linear hash", hashPartitioning(PartitionMethod_Hash, true, 3), Row{int64(7)}, 1, nil},
+		{"linear hash in range", hashPartitioning(PartitionMethod_Hash, true, 3), Row{int64(6)}, 2, nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			i, err := tt.partitioning.PartitionOfKey(NewEmptyContext(), tt.key)
+			if tt.err != nil {
+				require.True(t, tt.err.Is(err), "unexpected error %v", err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, i)
+		})
+	}
+}
+
+func TestKeyPartitionIsStable(t *testing.T) {
+	p := hashPartitioning(PartitionMethod_Key, false, 4)
+	ctx := NewEmptyContext()
+	seen := make(map[int]bool)
+	for i := int64(0); i < 32; i++ {
+		first, err := p.PartitionOfKey(ctx, Row{i})
+		require.NoError(t, err)
+		second, err := p.PartitionOfKey(ctx, Row{i})
+		require.NoError(t, err)
+		require.Equal(t, first, second)
+		seen[first] = true
+	}
+	require.Len(t, seen, 4)
+}
+
+func TestPartitioningValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		modify func(p *Partitioning)
+		err    *errors.Kind
+	}{
+		{"valid", func(p *Partitioning) {}, nil},
+		{"no partitions", func(p *Partitioning) { p.Definitions = nil }, ErrPartitionsMustBeDefined},
+		{"duplicate name", func(p *Partitioning) { p.Definitions[1].Name = "P0" }, ErrDuplicatePartitionName},
+		{"not increasing", func(p *Partitioning) { p.Definitions[1].LessThan = []interface{}{int64(10)} }, ErrRangeNotIncreasing},
+		{"maxvalue not last", func(p *Partitioning) { p.Definitions[0].LessThan = []interface{}{nil} }, ErrPartitionMaxValue},
+		{"values in", func(p *Partitioning) { p.Definitions[2].In = [][]interface{}{{int64(1)}} }, ErrPartitionValuesMismatch},
+		{"column count", func(p *Partitioning) { p.Definitions[2].LessThan = []interface{}{nil, nil} }, ErrPartitionColumnCount},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			p := rangePartitioning()
+			tt.modify(p)
+			err := p.Validate()
+			if tt.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.True(t, tt.err.Is(err), "unexpected error %v", err)
+			}
+		})
+	}
+
+	p := listColumnsPartitioning()
+	p.Definitions[1].In = append(p.Definitions[1].In, []interface{}{int64(1), "x"})
+	require.True(t, ErrMultipleDefConstInListPart.Is(p.Validate()))
+}
+
+func TestPartitioningString(t *testing.T) {
+	require := require.New(t)
+
+	p := rangePartitioning()
+	require.Equal("RANGE", p.MethodName())
+	require.Equal("`a`", p.ExpressionString())
+	description, ok := p.Description(2)
+	require.True(ok)
+	require.Equal("MAXVALUE", description)
+	require.Equal("/*!50100 PARTITION BY RANGE (`a`)\n"+
+		"(PARTITION p0 VALUES LESS THAN (10) ENGINE = InnoDB,\n"+
+		" PARTITION p1 VALUES LESS THAN (20) ENGINE = InnoDB,\n"+
+		" PARTITION p2 VALUES LESS THAN MAXVALUE ENGINE = InnoDB) */", p.String())
+
+	p = listColumnsPartitioning()
+	require.Equal("LIST COLUMNS", p.MethodName())
+	require.Equal("`a`,`b`", p.ExpressionString())
+	description, ok = p.Description(1)
+	require.True(ok)
+	require.Equal("(3,'z'),(NULL,'x')", description)
+	require.Equal("/*!50500 PARTITION BY LIST  COLUMNS(`a`,`b`)\n"+
+		"(PARTITION pa VALUES IN ((1,'x'),(2,'y')) ENGINE = InnoDB,\n"+
+		" PARTITION pb VALUES IN ((3,'z'),(NULL,'x')) COMMENT = 'nulls' ENGINE = InnoDB) */", p.String())
+
+	p = hashPartitioning(PartitionMethod_Hash, true, 4)
+	require.Equal("LINEAR HASH", p.MethodName())
+	_, ok = p.Description(0)
+	require.False(ok)
+	require.Equal("/*!50100 PARTITION BY LINEAR HASH (`a`)\nPARTITIONS 4 */", p.String())
+}
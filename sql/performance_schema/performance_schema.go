@@ -0,0 +1,465 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package performance_schema
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	. "github.com/linanh/go-mysql-server/sql"
+)
+
+const (
+	// PerformanceSchemaDatabaseName is the name of the performance schema database.
+	PerformanceSchemaDatabaseName = "performance_schema"
+	// EventsStatementsCurrentTableName is the name of the events_statements_current table.
+	EventsStatementsCurrentTableName = "events_statements_current"
+	// EventsStatementsHistoryTableName is the name of the events_statements_history table.
+	EventsStatementsHistoryTableName = "events_statements_history"
+	// EventsStatementsSummaryByDigestTableName is the name of the events_statements_summary_by_digest table.
+	EventsStatementsSummaryByDigestTableName = "events_statements_summary_by_digest"
+	// ThreadsTableName is the name of the threads table.
+	ThreadsTableName = "threads"
+	// GlobalStatusTableName is the name of the global_status table.
+	GlobalStatusTableName = "global_status"
+	// SessionStatusTableName is the name of the session_status table.
+	SessionStatusTableName = "session_status"
+)
+
+type performanceSchemaDatabase struct {
+	name   string
+	tables map[string]Table
+}
+
+// performanceSchemaTable is a table whose rows are read from the catalog.
+type performanceSchemaTable struct {
+	name    string
+	schema  Schema
+	catalog *Catalog
+	rowIter func(*Context, *Catalog) (RowIter, error)
+}
+
+// truncatableTable is a table which TRUNCATE TABLE resets, as MySQL allows for the history and summary tables.
+type truncatableTable struct {
+	performanceSchemaTable
+	truncate func(*Catalog) int
+}
+
+type performanceSchemaPartition struct {
+	key []byte
+}
+
+type performanceSchemaPartitionIter struct {
+	performanceSchemaPartition
+	pos int
+}
+
+var (
+	_ Database          = (*performanceSchemaDatabase)(nil)
+	_ Table             = (*performanceSchemaTable)(nil)
+	_ TruncateableTable = (*truncatableTable)(nil)
+	_ Partition         = (*performanceSchemaPartition)(nil)
+	_ PartitionIter     = (*performanceSchemaPartitionIter)(nil)
+)
+
+func statementEventsSchema(source string) Schema {
+	return Schema{
+		{Name: "thread_id", Type: Uint64, Nullable: false, Source: source},
+		{Name: "event_id", Type: Uint64, Nullable: false, Source: source},
+		{Name: "end_event_id", Type: Uint64, Nullable: true, Source: source},
+		{Name: "event_name", Type: LongText, Nullable: false, Source: source},
+		{Name: "timer_start", Type: Uint64, Nullable: true, Source: source},
+		{Name: "timer_end", Type: Uint64, Nullable: true, Source: source},
+		{Name: "timer_wait", Type: Uint64, Nullable: true, Source: source},
+		{Name: "sql_text", Type: LongText, Nullable: true, Source: source},
+		{Name: "digest", Type: LongText, Nullable: true, Source: source},
+		{Name: "digest_text", Type: LongText, Nullable: true, Source: source},
+		{Name: "current_schema", Type: LongText, Nullable: true, Source: source},
+		{Name: "mysql_errno", Type: Int64, Nullable: true, Source: source},
+		{Name: "returned_sqlstate", Type: LongText, Nullable: true, Source: source},
+		{Name: "message_text", Type: LongText, Nullable: true, Source: source},
+		{Name: "errors", Type: Uint64, Nullable: false, Source: source},
+		{Name: "rows_affected", Type: Uint64, Nullable: false, Source: source},
+		{Name: "rows_sent", Type: Uint64, Nullable: false, Source: source},
+	}
+}
+
+var eventsStatementsCurrentSchema = statementEventsSchema(EventsStatementsCurrentTableName)
+
+var eventsStatementsHistorySchema = statementEventsSchema(EventsStatementsHistoryTableName)
+
+var eventsStatementsSummaryByDigestSchema = Schema{
+	{Name: "schema_name", Type: LongText, Nullable: true, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "digest", Type: LongText, Nullable: true, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "digest_text", Type: LongText, Nullable: true, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "count_star", Type: Uint64, Nullable: false, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "sum_timer_wait", Type: Uint64, Nullable: false, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "min_timer_wait", Type: Uint64, Nullable: false, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "avg_timer_wait", Type: Uint64, Nullable: false, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "max_timer_wait", Type: Uint64, Nullable: false, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "sum_errors", Type: Uint64, Nullable: false, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "sum_rows_affected", Type: Uint64, Nullable: false, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "sum_rows_sent", Type: Uint64, Nullable: false, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "first_seen", Type: Timestamp, Nullable: false, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "last_seen", Type: Timestamp, Nullable: false, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "query_sample_text", Type: LongText, Nullable: true, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "query_sample_seen", Type: Timestamp, Nullable: false, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "query_sample_timer_wait", Type: Uint64, Nullable: false, Source: EventsStatementsSummaryByDigestTableName},
+}
+
+var threadsSchema = Schema{
+	{Name: "thread_id", Type: Uint64, Nullable: false, Source: ThreadsTableName},
+	{Name: "name", Type: LongText, Nullable: false, Source: ThreadsTableName},
+	{Name: "type", Type: LongText, Nullable: false, Source: ThreadsTableName},
+	{Name: "processlist_id", Type: Uint64, Nullable: true, Source: ThreadsTableName},
+	{Name: "processlist_user", Type: LongText, Nullable: true, Source: ThreadsTableName},
+	{Name: "processlist_host", Type: LongText, Nullable: true, Source: ThreadsTableName},
+	{Name: "processlist_db", Type: LongText, Nullable: true, Source: ThreadsTableName},
+	{Name: "processlist_command", Type: LongText, Nullable: true, Source: ThreadsTableName},
+	{Name: "processlist_time", Type: Int64, Nullable: true, Source: ThreadsTableName},
+	{Name: "processlist_state", Type: LongText, Nullable: true, Source: ThreadsTableName},
+	{Name: "processlist_info", Type: LongText, Nullable: true, Source: ThreadsTableName},
+}
+
+func statusSchema(source string) Schema {
+	return Schema{
+		{Name: "variable_name", Type: LongText, Nullable: false, Source: source},
+		{Name: "variable_value", Type: LongText, Nullable: true, Source: source},
+	}
+}
+
+var globalStatusSchema = statusSchema(GlobalStatusTableName)
+
+var sessionStatusSchema = statusSchema(SessionStatusTableName)
+
+// timer returns the value of a timer of the performance schema at the time given, which is the time elapsed since
+// the statement events started being recorded in picoseconds.
+func timer(events *StatementEvents, t time.Time) uint64 {
+	return uint64(t.Sub(events.StartedAt()).Nanoseconds()) * 1000
+}
+
+// picoseconds returns a duration in picoseconds, the unit of the timers of the performance schema.
+func picoseconds(d time.Duration) uint64 {
+	return uint64(d.Nanoseconds()) * 1000
+}
+
+// nullIfEmpty returns nil for the empty string, which is NULL in the performance schema.
+func nullIfEmpty(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+func statementEventRow(events *StatementEvents, e StatementEvent) Row {
+	var endEventID interface{}
+	end := time.Now()
+	if !e.Running() {
+		endEventID = e.EventID
+		end = e.EndedAt
+	}
+
+	var errno, sqlState, message interface{}
+	if e.Errors > 0 {
+		errno = int64(e.ErrorNumber)
+		sqlState = e.SQLState
+		message = e.Message
+	}
+
+	return Row{
+		uint64(e.ThreadID),
+		e.EventID,
+		endEventID,
+		e.EventName,
+		timer(events, e.StartedAt),
+		timer(events, end),
+		picoseconds(end.Sub(e.StartedAt)),
+		e.SQLText,
+		nullIfEmpty(e.Digest),
+		nullIfEmpty(e.DigestText),
+		nullIfEmpty(e.Schema),
+		errno,
+		sqlState,
+		message,
+		e.Errors,
+		e.RowsAffected,
+		e.RowsSent,
+	}
+}
+
+func eventsStatementsCurrentRowIter(ctx *Context, c *Catalog) (RowIter, error) {
+	var rows []Row
+	for _, e := range c.StatementEvents.Current() {
+		rows = append(rows, statementEventRow(c.StatementEvents, e))
+	}
+	return RowsToRowIter(rows...), nil
+}
+
+func eventsStatementsHistoryRowIter(ctx *Context, c *Catalog) (RowIter, error) {
+	var rows []Row
+	for _, e := range c.StatementEvents.History() {
+		rows = append(rows, statementEventRow(c.StatementEvents, e))
+	}
+	return RowsToRowIter(rows...), nil
+}
+
+func eventsStatementsSummaryByDigestRowIter(ctx *Context, c *Catalog) (RowIter, error) {
+	var rows []Row
+	for _, s := range c.StatementEvents.Digests() {
+		rows = append(rows, Row{
+			nullIfEmpty(s.Schema),
+			nullIfEmpty(s.Digest),
+			nullIfEmpty(s.DigestText),
+			s.Count,
+			picoseconds(s.SumDuration),
+			picoseconds(s.MinDuration),
+			picoseconds(s.SumDuration / time.Duration(s.Count)),
+			picoseconds(s.MaxDuration),
+			s.SumErrors,
+			s.SumRowsAffected,
+			s.SumRowsSent,
+			s.FirstSeen,
+			s.LastSeen,
+			nullIfEmpty(s.SampleText),
+			s.SampleSeen,
+			picoseconds(s.SampleDuration),
+		})
+	}
+	return RowsToRowIter(rows...), nil
+}
+
+// threadsRowIter returns the foreground threads, which are the client connections and the connections running
+// queries, whose process is the first one running if there's more than one.
+func threadsRowIter(ctx *Context, c *Catalog) (RowIter, error) {
+	type thread struct {
+		user, host interface{}
+		process    *Process
+	}
+
+	var ids []uint32
+	threads := make(map[uint32]*thread)
+	for _, conn := range c.Connections() {
+		ids = append(ids, conn.ID)
+		threads[conn.ID] = &thread{user: nullIfEmpty(conn.User), host: nullIfEmpty(conn.Host)}
+	}
+	for _, proc := range c.Processes() {
+		t, ok := threads[proc.Connection]
+		if !ok {
+			ids = append(ids, proc.Connection)
+			t = &thread{user: nullIfEmpty(proc.User)}
+			threads[proc.Connection] = t
+		}
+		if t.process == nil || proc.Pid < t.process.Pid {
+			proc := proc
+			t.process = &proc
+		}
+	}
+
+	schemas := make(map[uint32]string)
+	for _, e := range c.StatementEvents.Current() {
+		schemas[e.ThreadID] = e.Schema
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	rows := make([]Row, len(ids))
+	for i, id := range ids {
+		t := threads[id]
+		command, state, info := "Sleep", interface{}(nil), interface{}(nil)
+		var seconds int64
+		if t.process != nil {
+			command, state, info = "Query", "executing", t.process.Query
+			seconds = int64(t.process.Seconds())
+		}
+		rows[i] = Row{
+			uint64(id),
+			"thread/sql/one_connection",
+			"FOREGROUND",
+			uint64(id),
+			t.user,
+			t.host,
+			nullIfEmpty(schemas[id]),
+			command,
+			seconds,
+			state,
+			info,
+		}
+	}
+	return RowsToRowIter(rows...), nil
+}
+
+// statusRows returns the rows of the status variables given, sorted by name.
+func statusRows(vals map[string]int64) []Row {
+	rows := make([]Row, 0, len(vals))
+	for name, val := range vals {
+		rows = append(rows, Row{name, fmt.Sprint(val)})
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i][0].(string) < rows[j][0].(string) })
+	return rows
+}
+
+func globalStatusRowIter(ctx *Context, c *Catalog) (RowIter, error) {
+	return RowsToRowIter(statusRows(StatusVariables.GetAllGlobal())...), nil
+}
+
+// sessionStatusRowIter returns the status variables of the current session, with the global values of those which
+// only exist in the global context.
+func sessionStatusRowIter(ctx *Context, c *Catalog) (RowIter, error) {
+	vals := StatusVariables.GetAllGlobal()
+	for name, val := range SessionStatusVariables(ctx) {
+		vals[name] = val
+	}
+	return RowsToRowIter(statusRows(vals)...), nil
+}
+
+// NewPerformanceSchemaDatabase creates a new PERFORMANCE_SCHEMA Database, whose statement tables are populated by
+// the engine as it executes statements, and whose status tables show the status variables.
+func NewPerformanceSchemaDatabase(cat *Catalog) Database {
+	return &performanceSchemaDatabase{
+		name: PerformanceSchemaDatabaseName,
+		tables: map[string]Table{
+			EventsStatementsCurrentTableName: &performanceSchemaTable{
+				name:    EventsStatementsCurrentTableName,
+				schema:  eventsStatementsCurrentSchema,
+				catalog: cat,
+				rowIter: eventsStatementsCurrentRowIter,
+			},
+			EventsStatementsHistoryTableName: &truncatableTable{
+				performanceSchemaTable: performanceSchemaTable{
+					name:    EventsStatementsHistoryTableName,
+					schema:  eventsStatementsHistorySchema,
+					catalog: cat,
+					rowIter: eventsStatementsHistoryRowIter,
+				},
+				truncate: func(c *Catalog) int { return c.StatementEvents.TruncateHistory() },
+			},
+			EventsStatementsSummaryByDigestTableName: &truncatableTable{
+				performanceSchemaTable: performanceSchemaTable{
+					name:    EventsStatementsSummaryByDigestTableName,
+					schema:  eventsStatementsSummaryByDigestSchema,
+					catalog: cat,
+					rowIter: eventsStatementsSummaryByDigestRowIter,
+				},
+				truncate: func(c *Catalog) int { return c.StatementEvents.TruncateDigests() },
+			},
+			ThreadsTableName: &performanceSchemaTable{
+				name:    ThreadsTableName,
+				schema:  threadsSchema,
+				catalog: cat,
+				rowIter: threadsRowIter,
+			},
+			GlobalStatusTableName: &performanceSchemaTable{
+				name:    GlobalStatusTableName,
+				schema:  globalStatusSchema,
+				catalog: cat,
+				rowIter: globalStatusRowIter,
+			},
+			SessionStatusTableName: &performanceSchemaTable{
+				name:    SessionStatusTableName,
+				schema:  sessionStatusSchema,
+				catalog: cat,
+				rowIter: sessionStatusRowIter,
+			},
+		},
+	}
+}
+
+// Name implements the sql.Database interface.
+func (db *performanceSchemaDatabase) Name() string { return db.name }
+
+// Tables implements the sql.Database interface.
+func (db *performanceSchemaDatabase) Tables() map[string]Table { return db.tables }
+
+func (db *performanceSchemaDatabase) GetTableInsensitive(ctx *Context, tblName string) (Table, bool, error) {
+	tbl, ok := GetTableInsensitive(tblName, db.tables)
+	return tbl, ok, nil
+}
+
+func (db *performanceSchemaDatabase) GetTableNames(ctx *Context) ([]string, error) {
+	tblNames := make([]string, 0, len(db.tables))
+	for k := range db.tables {
+		tblNames = append(tblNames, k)
+	}
+
+	return tblNames, nil
+}
+
+// Name implements the sql.Table interface.
+func (t *performanceSchemaTable) Name() string {
+	return t.name
+}
+
+// Schema implements the sql.Table interface.
+func (t *performanceSchemaTable) Schema() Schema {
+	return t.schema
+}
+
+// Partitions implements the sql.Table interface.
+func (t *performanceSchemaTable) Partitions(ctx *Context) (PartitionIter, error) {
+	return &performanceSchemaPartitionIter{performanceSchemaPartition: performanceSchemaPartition{partitionKey(t.Name())}}, nil
+}
+
+// PartitionRows implements the sql.PartitionRows interface.
+func (t *performanceSchemaTable) PartitionRows(ctx *Context, partition Partition) (RowIter, error) {
+	if !bytes.Equal(partition.Key(), partitionKey(t.Name())) {
+		return nil, ErrPartitionNotFound.New(partition.Key())
+	}
+	return t.rowIter(ctx, t.catalog)
+}
+
+func (t *performanceSchemaTable) String() string {
+	p := NewTreePrinter()
+	_ = p.WriteNode("Table(%s)", t.name)
+	var schema = make([]string, len(t.schema))
+	for i, col := range t.schema {
+		schema[i] = fmt.Sprintf(
+			"Column(%s, %s, nullable=%v)",
+			col.Name,
+			col.Type.String(),
+			col.Nullable,
+		)
+	}
+	_ = p.WriteChildren(schema...)
+	return p.String()
+}
+
+// Truncate implements the sql.TruncateableTable interface.
+func (t *truncatableTable) Truncate(ctx *Context) (int, error) {
+	return t.truncate(t.catalog), nil
+}
+
+// Key implements Partition  interface
+func (p *performanceSchemaPartition) Key() []byte { return p.key }
+
+// Next implements single PartitionIter interface
+func (pit *performanceSchemaPartitionIter) Next() (Partition, error) {
+	if pit.pos == 0 {
+		pit.pos++
+		return pit, nil
+	}
+	return nil, io.EOF
+}
+
+// Close implements single PartitionIter interface
+func (pit *performanceSchemaPartitionIter) Close(_ *Context) error {
+	pit.pos = 0
+	return nil
+}
+
+func partitionKey(tableName string) []byte {
+	return []byte(PerformanceSchemaDatabaseName + "." + tableName)
+}
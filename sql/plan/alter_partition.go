@@ -0,0 +1,762 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/linanh/go-mysql-server/sql"
+	"github.com/linanh/go-mysql-server/sql/expression"
+)
+
+// PartitionSpec is the PARTITION BY clause of a CREATE TABLE or ALTER TABLE statement.
+type PartitionSpec struct {
+	Method     sql.PartitionMethod
+	Linear     bool
+	UseColumns bool
+	// Columns are the columns of RANGE COLUMNS, LIST COLUMNS and KEY partitioning. KEY partitioning without columns
+	// uses the primary key.
+	Columns []string
+	// Expr is the partitioning expression of RANGE, LIST and HASH partitioning.
+	Expr sql.Expression
+	// Count is the number of HASH or KEY partitions given by PARTITIONS, or 0.
+	Count       int
+	Definitions []*PartitionDefinitionSpec
+}
+
+// PartitionDefinitionSpec is a partition definition of a PARTITION BY clause, or of ALTER TABLE ... ADD PARTITION and
+// REORGANIZE PARTITION.
+type PartitionDefinitionSpec struct {
+	Name    string
+	Comment string
+	// LessThan are the values of VALUES LESS THAN. A nil expression is MAXVALUE.
+	LessThan []sql.Expression
+	// In are the values of VALUES IN, each with one value per partitioning column.
+	In [][]sql.Expression
+}
+
+// Expressions returns the partitioning expression followed by the values of the partition definitions.
+func (s *PartitionSpec) Expressions() []sql.Expression {
+	var exprs []sql.Expression
+	if s.Expr != nil {
+		exprs = append(exprs, s.Expr)
+	}
+	return append(exprs, definitionExpressions(s.Definitions)...)
+}
+
+// WithExpressions returns a copy of the spec with the expressions given, in the order of Expressions, and the
+// expressions left over.
+func (s *PartitionSpec) WithExpressions(exprs []sql.Expression) (*PartitionSpec, []sql.Expression, error) {
+	ns := *s
+	if s.Expr != nil {
+		if len(exprs) == 0 {
+			return nil, nil, fmt.Errorf("expected a partitioning expression")
+		}
+		ns.Expr, exprs = exprs[0], exprs[1:]
+	}
+	var err error
+	ns.Definitions, exprs, err = withDefinitionExpressions(s.Definitions, exprs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &ns, exprs, nil
+}
+
+// Resolved returns whether the expressions of the spec are resolved.
+func (s *PartitionSpec) Resolved() bool {
+	return expression.ExpressionsResolved(s.Expressions()...)
+}
+
+func (s *PartitionSpec) String() string {
+	method := string(s.Method)
+	if s.Linear {
+		method = "LINEAR " + method
+	}
+	if s.Expr != nil {
+		return fmt.Sprintf("PARTITION BY %s (%s)", method, s.Expr)
+	}
+	if s.UseColumns {
+		method += " COLUMNS"
+	}
+	return fmt.Sprintf("PARTITION BY %s (%s)", method, strings.Join(s.Columns, ", "))
+}
+
+// Build returns the partitioning the spec declares for a table with the schema given. Every unique key, given by its
+// column names, must include all the partitioning columns.
+func (s *PartitionSpec) Build(ctx *sql.Context, schema sql.Schema, uniqueKeys [][]string) (*sql.Partitioning, error) {
+	p := &sql.Partitioning{
+		Method:     s.Method,
+		Linear:     s.Linear,
+		UseColumns: s.UseColumns,
+	}
+
+	if s.Expr != nil {
+		if err := s.buildExpression(p, schema); err != nil {
+			return nil, err
+		}
+	} else {
+		columns := s.Columns
+		if len(columns) == 0 && s.Method == sql.PartitionMethod_Key {
+			for _, col := range schema {
+				if col.PrimaryKey {
+					columns = append(columns, col.Name)
+				}
+			}
+		}
+		if len(columns) == 0 {
+			return nil, sql.ErrPartitionColumnNotFound.New()
+		}
+		for _, name := range columns {
+			idx := schemaIndexOf(schema, name)
+			if idx < 0 {
+				return nil, sql.ErrPartitionColumnNotFound.New()
+			}
+			p.Columns = append(p.Columns, schema[idx].Name)
+			p.Types = append(p.Types, schema[idx].Type)
+		}
+	}
+
+	if err := primaryKeyPartitioningCheck(p, schema); err != nil {
+		return nil, err
+	}
+	for _, key := range uniqueKeys {
+		if err := checkUniqueKeyPartitioning(p, key); err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case s.Method == sql.PartitionMethod_Hash || s.Method == sql.PartitionMethod_Key:
+		if len(s.Definitions) > 0 && s.Count > 0 && s.Count != len(s.Definitions) {
+			return nil, sql.ErrPartitionsMustBeDefined.New(s.Method)
+		}
+		for _, d := range s.Definitions {
+			if d.LessThan != nil {
+				return nil, sql.ErrPartitionValuesMismatch.New("RANGE", "LESS THAN")
+			}
+			if d.In != nil {
+				return nil, sql.ErrPartitionValuesMismatch.New("LIST", "IN")
+			}
+			p.Definitions = append(p.Definitions, sql.PartitionDefinition{Name: d.Name, Comment: d.Comment})
+		}
+		if len(s.Definitions) == 0 {
+			p.Definitions = hashPartitionDefinitions(0, s.Count)
+		}
+	default:
+		for _, d := range s.Definitions {
+			def, err := d.Build(ctx, p)
+			if err != nil {
+				return nil, err
+			}
+			p.Definitions = append(p.Definitions, def)
+		}
+	}
+
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// buildExpression sets the partitioning expression and the columns it's evaluated over. The expression is rebound to
+// rows with the values of those columns.
+func (s *PartitionSpec) buildExpression(p *sql.Partitioning, schema sql.Schema) error {
+	var err error
+	sql.Inspect(s.Expr, func(e sql.Expression) bool {
+		switch e := e.(type) {
+		case *Subquery, *expression.BindVar:
+			err = sql.ErrPartitionFunctionNotAllowed.New()
+		case sql.NonDeterministicExpression:
+			if e.IsNonDeterministic() {
+				err = sql.ErrPartitionFunctionNotAllowed.New()
+			}
+		}
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
+	if !sql.IsInteger(s.Expr.Type()) {
+		return sql.ErrPartitionFunctionType.New()
+	}
+
+	p.Expr, err = expression.TransformUp(sql.NewEmptyContext(), s.Expr, func(e sql.Expression) (sql.Expression, error) {
+		gf, ok := e.(*expression.GetField)
+		if !ok {
+			return e, nil
+		}
+		idx := schemaIndexOf(schema, gf.Name())
+		if idx < 0 {
+			return nil, sql.ErrPartitionColumnNotFound.New()
+		}
+		col := schema[idx]
+		key := -1
+		for i, name := range p.Columns {
+			if name == col.Name {
+				key = i
+			}
+		}
+		if key < 0 {
+			key = len(p.Columns)
+			p.Columns = append(p.Columns, col.Name)
+			p.Types = append(p.Types, col.Type)
+		}
+		return expression.NewGetField(key, col.Type, col.Name, col.Nullable), nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(p.Columns) == 0 {
+		return sql.ErrPartitionFunctionNotAllowed.New()
+	}
+
+	if gf, ok := p.Expr.(*expression.GetField); ok {
+		p.Expression = "`" + gf.Name() + "`"
+	} else {
+		p.Expression = p.Expr.String()
+	}
+	return nil
+}
+
+// Build returns the partition the definition declares in the partitioning given, whose method and columns must be
+// set.
+func (d *PartitionDefinitionSpec) Build(ctx *sql.Context, p *sql.Partitioning) (sql.PartitionDefinition, error) {
+	def := sql.PartitionDefinition{Name: d.Name, Comment: d.Comment}
+	if (p.Method == sql.PartitionMethod_Range && d.LessThan == nil) || (p.Method == sql.PartitionMethod_List && d.In == nil) {
+		return def, sql.ErrPartitionsMustBeDefined.New(p.Method)
+	}
+	if p.Method == sql.PartitionMethod_Range && d.In != nil {
+		return def, sql.ErrPartitionValuesMismatch.New("LIST", "IN")
+	}
+	if p.Method == sql.PartitionMethod_List && d.LessThan != nil {
+		return def, sql.ErrPartitionValuesMismatch.New("RANGE", "LESS THAN")
+	}
+
+	var err error
+	if d.LessThan != nil {
+		def.LessThan, err = d.values(ctx, p, d.LessThan)
+		if err != nil {
+			return def, err
+		}
+	}
+	for _, in := range d.In {
+		values, err := d.values(ctx, p, in)
+		if err != nil {
+			return def, err
+		}
+		def.In = append(def.In, values)
+	}
+	return def, nil
+}
+
+// values evaluates the values of a partition. The values of partitions bound by an expression must be integers, and
+// the ones of partitions bound by columns are converted to the types of the columns.
+func (d *PartitionDefinitionSpec) values(ctx *sql.Context, p *sql.Partitioning, exprs []sql.Expression) ([]interface{}, error) {
+	if (p.UseColumns && len(exprs) != len(p.Columns)) || (!p.UseColumns && len(exprs) != 1) {
+		return nil, sql.ErrPartitionColumnCount.New()
+	}
+
+	values := make([]interface{}, len(exprs))
+	for i, e := range exprs {
+		if e == nil {
+			continue
+		}
+		constant := true
+		sql.Inspect(e, func(e sql.Expression) bool {
+			switch e.(type) {
+			case *expression.GetField, *Subquery, *expression.BindVar:
+				constant = false
+			}
+			return constant
+		})
+		if !constant {
+			return nil, sql.ErrPartitionValueNotConstant.New(d.Name)
+		}
+
+		v, err := e.Eval(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		if v == nil {
+			if p.Method == sql.PartitionMethod_Range {
+				return nil, sql.ErrPartitionValueType.New(d.Name)
+			}
+			continue
+		}
+
+		if p.UseColumns {
+			values[i], err = p.Types[i].Convert(v)
+		} else if !sql.IsInteger(e.Type()) {
+			err = sql.ErrPartitionValueType.New(d.Name)
+		} else {
+			values[i], err = sql.Int64.Convert(v)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+func schemaIndexOf(schema sql.Schema, name string) int {
+	for i, col := range schema {
+		if strings.EqualFold(col.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// checkUniqueKeyPartitioning returns an error if a unique key doesn't include all the partitioning columns, so that
+// rows with the same key can't be in different partitions.
+func checkUniqueKeyPartitioning(p *sql.Partitioning, key []string) error {
+	for _, col := range p.Columns {
+		found := false
+		for _, name := range key {
+			found = found || strings.EqualFold(name, col)
+		}
+		if !found {
+			return sql.ErrUniqueKeyNeedsAllPartitionFields.New("UNIQUE INDEX")
+		}
+	}
+	return nil
+}
+
+// primaryKeyPartitioningCheck returns an error if the primary key of a schema doesn't include all the partitioning
+// columns.
+func primaryKeyPartitioningCheck(p *sql.Partitioning, schema sql.Schema) error {
+	var key []string
+	for _, col := range schema {
+		if col.PrimaryKey {
+			key = append(key, col.Name)
+		}
+	}
+	if len(key) == 0 {
+		return nil
+	}
+	if err := checkUniqueKeyPartitioning(p, key); err != nil {
+		return sql.ErrUniqueKeyNeedsAllPartitionFields.New("PRIMARY KEY")
+	}
+	return nil
+}
+
+// hashPartitionDefinitions returns count partitions named after their position, starting at the one given, as HASH
+// and KEY partitions without definitions are.
+func hashPartitionDefinitions(start, count int) []sql.PartitionDefinition {
+	if count == 0 && start == 0 {
+		count = 1
+	}
+	defs := make([]sql.PartitionDefinition, count)
+	for i := range defs {
+		defs[i] = sql.PartitionDefinition{Name: fmt.Sprintf("p%d", start+i)}
+	}
+	return defs
+}
+
+func definitionExpressions(defs []*PartitionDefinitionSpec) []sql.Expression {
+	var exprs []sql.Expression
+	for _, d := range defs {
+		for _, e := range d.LessThan {
+			if e != nil {
+				exprs = append(exprs, e)
+			}
+		}
+		for _, in := range d.In {
+			exprs = append(exprs, in...)
+		}
+	}
+	return exprs
+}
+
+func withDefinitionExpressions(defs []*PartitionDefinitionSpec, exprs []sql.Expression) ([]*PartitionDefinitionSpec, []sql.Expression, error) {
+	next := func() (sql.Expression, error) {
+		if len(exprs) == 0 {
+			return nil, fmt.Errorf("expected more partition values")
+		}
+		e := exprs[0]
+		exprs = exprs[1:]
+		return e, nil
+	}
+
+	var err error
+	newDefs := make([]*PartitionDefinitionSpec, len(defs))
+	for i, d := range defs {
+		nd := *d
+		if d.LessThan != nil {
+			nd.LessThan = make([]sql.Expression, len(d.LessThan))
+			for j, e := range d.LessThan {
+				if e != nil {
+					if nd.LessThan[j], err = next(); err != nil {
+						return nil, nil, err
+					}
+				}
+			}
+		}
+		if d.In != nil {
+			nd.In = make([][]sql.Expression, len(d.In))
+			for j, in := range d.In {
+				nd.In[j] = make([]sql.Expression, len(in))
+				for k := range in {
+					if nd.In[j][k], err = next(); err != nil {
+						return nil, nil, err
+					}
+				}
+			}
+		}
+		newDefs[i] = &nd
+	}
+	return newDefs, exprs, nil
+}
+
+// PartitionAction is the partitioning change of an AlterPartition node.
+type PartitionAction byte
+
+const (
+	PartitionAction_PartitionBy PartitionAction = iota
+	PartitionAction_Remove
+	PartitionAction_Add
+	PartitionAction_Drop
+	PartitionAction_Truncate
+	PartitionAction_Coalesce
+	PartitionAction_Reorganize
+)
+
+// AlterPartition is a node changing the partitioning of a table.
+type AlterPartition struct {
+	UnaryNode
+	Action PartitionAction
+	// Spec is the new partitioning of PARTITION BY.
+	Spec *PartitionSpec
+	// Definitions are the partitions added by ADD PARTITION, or the ones partitions are reorganized into.
+	Definitions []*PartitionDefinitionSpec
+	// Names are the partitions dropped, truncated or reorganized.
+	Names []string
+	// All is whether all partitions are truncated.
+	All bool
+	// Count is the number of HASH or KEY partitions added or coalesced.
+	Count int
+}
+
+var _ sql.Node = (*AlterPartition)(nil)
+var _ sql.Expressioner = (*AlterPartition)(nil)
+
+// NewAlterPartitionBy returns a node partitioning a table as the spec given declares.
+func NewAlterPartitionBy(table sql.Node, spec *PartitionSpec) *AlterPartition {
+	return &AlterPartition{UnaryNode: UnaryNode{table}, Action: PartitionAction_PartitionBy, Spec: spec}
+}
+
+// NewAlterRemovePartitioning returns a node removing the partitioning of a table.
+func NewAlterRemovePartitioning(table sql.Node) *AlterPartition {
+	return &AlterPartition{UnaryNode: UnaryNode{table}, Action: PartitionAction_Remove}
+}
+
+// NewAlterAddPartitions returns a node adding the partitions defined to a table, or count partitions if it's
+// partitioned by HASH or KEY.
+func NewAlterAddPartitions(table sql.Node, defs []*PartitionDefinitionSpec, count int) *AlterPartition {
+	return &AlterPartition{UnaryNode: UnaryNode{table}, Action: PartitionAction_Add, Definitions: defs, Count: count}
+}
+
+// NewAlterDropPartitions returns a node dropping the partitions of a table named.
+func NewAlterDropPartitions(table sql.Node, names []string) *AlterPartition {
+	return &AlterPartition{UnaryNode: UnaryNode{table}, Action: PartitionAction_Drop, Names: names}
+}
+
+// NewAlterTruncatePartitions returns a node removing the rows of the partitions of a table named, or of all of them.
+func NewAlterTruncatePartitions(table sql.Node, names []string, all bool) *AlterPartition {
+	return &AlterPartition{UnaryNode: UnaryNode{table}, Action: PartitionAction_Truncate, Names: names, All: all}
+}
+
+// NewAlterCoalescePartitions returns a node removing count partitions of a table partitioned by HASH or KEY.
+func NewAlterCoalescePartitions(table sql.Node, count int) *AlterPartition {
+	return &AlterPartition{UnaryNode: UnaryNode{table}, Action: PartitionAction_Coalesce, Count: count}
+}
+
+// NewAlterReorganizePartitions returns a node replacing the partitions of a table named with the ones defined.
+func NewAlterReorganizePartitions(table sql.Node, names []string, defs []*PartitionDefinitionSpec) *AlterPartition {
+	return &AlterPartition{UnaryNode: UnaryNode{table}, Action: PartitionAction_Reorganize, Names: names, Definitions: defs}
+}
+
+func getPartitionAlterable(node sql.Node) (sql.PartitionAlterableTable, error) {
+	switch node := node.(type) {
+	case sql.PartitionAlterableTable:
+		return node, nil
+	case *ResolvedTable:
+		return getPartitionAlterableTable(node.Table)
+	default:
+		return nil, sql.ErrPartitioningNotSupported.New(node.String())
+	}
+}
+
+func getPartitionAlterableTable(t sql.Table) (sql.PartitionAlterableTable, error) {
+	switch t := t.(type) {
+	case sql.PartitionAlterableTable:
+		return t, nil
+	case sql.TableWrapper:
+		return getPartitionAlterableTable(t.Underlying())
+	case *ResolvedTable:
+		return getPartitionAlterableTable(t.Table)
+	default:
+		return nil, sql.ErrPartitioningNotSupported.New(t.Name())
+	}
+}
+
+// Expressions implements the sql.Expressioner interface.
+func (p *AlterPartition) Expressions() []sql.Expression {
+	var exprs []sql.Expression
+	if p.Spec != nil {
+		exprs = p.Spec.Expressions()
+	}
+	return append(exprs, definitionExpressions(p.Definitions)...)
+}
+
+// WithExpressions implements the sql.Expressioner interface.
+func (p *AlterPartition) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != len(p.Expressions()) {
+		return nil, sql.ErrInvalidChildrenNumber.New(p, len(exprs), len(p.Expressions()))
+	}
+
+	np := *p
+	var err error
+	if p.Spec != nil {
+		np.Spec, exprs, err = p.Spec.WithExpressions(exprs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	np.Definitions, _, err = withDefinitionExpressions(p.Definitions, exprs)
+	if err != nil {
+		return nil, err
+	}
+	return &np, nil
+}
+
+// Resolved implements the Resolvable interface.
+func (p *AlterPartition) Resolved() bool {
+	return p.Child.Resolved() && expression.ExpressionsResolved(p.Expressions()...)
+}
+
+// WithChildren implements the Node interface.
+func (p *AlterPartition) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(p, len(children), 1)
+	}
+	np := *p
+	np.Child = children[0]
+	return &np, nil
+}
+
+// Schema implements the Node interface.
+func (p *AlterPartition) Schema() sql.Schema { return nil }
+
+// RowIter implements the Node interface.
+func (p *AlterPartition) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	err := p.Execute(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Execute changes the partitioning of the table.
+func (p *AlterPartition) Execute(ctx *sql.Context) error {
+	table, err := getPartitionAlterable(p.Child)
+	if err != nil {
+		return err
+	}
+
+	if p.Action == PartitionAction_PartitionBy {
+		keys, err := uniqueKeys(ctx, table)
+		if err != nil {
+			return err
+		}
+		partitioning, err := p.Spec.Build(ctx, table.Schema(), keys)
+		if err != nil {
+			return err
+		}
+		return table.SetPartitioning(ctx, partitioning)
+	}
+
+	current, err := table.GetPartitioning(ctx)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return sql.ErrPartitionManagementOnNonPartitioned.New()
+	}
+	hashed := current.Method == sql.PartitionMethod_Hash || current.Method == sql.PartitionMethod_Key
+
+	switch p.Action {
+	case PartitionAction_Remove:
+		return table.SetPartitioning(ctx, nil)
+	case PartitionAction_Add:
+		np := current.Copy()
+		if hashed && len(p.Definitions) == 0 {
+			np.Definitions = append(np.Definitions, hashPartitionDefinitions(len(np.Definitions), p.Count)...)
+		}
+		for _, d := range p.Definitions {
+			def := sql.PartitionDefinition{Name: d.Name, Comment: d.Comment}
+			if !hashed {
+				if def, err = d.Build(ctx, np); err != nil {
+					return err
+				}
+			}
+			np.Definitions = append(np.Definitions, def)
+		}
+		if err := np.Validate(); err != nil {
+			return err
+		}
+		return table.SetPartitioning(ctx, np)
+	case PartitionAction_Drop:
+		if hashed {
+			return sql.ErrOnlyOnRangeListPartition.New("DROP")
+		}
+		if err := p.checkNames(current, table.Name()); err != nil {
+			return err
+		}
+		if len(p.Names) >= len(current.Definitions) {
+			return sql.ErrDropLastPartition.New()
+		}
+		if err := table.DropPartitions(ctx, p.Names); err != nil {
+			return err
+		}
+		recordReset(ctx, deleteDatabaseHelper(p.Child), table, p.Names)
+		return nil
+	case PartitionAction_Truncate:
+		names := p.Names
+		if p.All {
+			names = current.Names()
+		}
+		if err := p.checkNames(current, table.Name()); err != nil {
+			return err
+		}
+		if err := table.TruncatePartitions(ctx, names); err != nil {
+			return err
+		}
+		recordReset(ctx, deleteDatabaseHelper(p.Child), table, names)
+		return nil
+	case PartitionAction_Coalesce:
+		if !hashed {
+			return sql.ErrCoalesceOnlyOnHashPartition.New()
+		}
+		if p.Count >= len(current.Definitions) {
+			return sql.ErrDropLastPartition.New()
+		}
+		np := current.Copy()
+		np.Definitions = np.Definitions[:len(np.Definitions)-p.Count]
+		return table.SetPartitioning(ctx, np)
+	case PartitionAction_Reorganize:
+		if hashed {
+			return sql.ErrOnlyOnRangeListPartition.New("REORGANIZE")
+		}
+		return p.reorganize(ctx, table, current)
+	default:
+		return fmt.Errorf("unknown partition action %d", p.Action)
+	}
+}
+
+// reorganize replaces consecutive partitions with new ones. RANGE partitions must cover the same range, except for
+// the last one which can extend it.
+func (p *AlterPartition) reorganize(ctx *sql.Context, table sql.PartitionAlterableTable, current *sql.Partitioning) error {
+	if err := p.checkNames(current, table.Name()); err != nil {
+		return err
+	}
+	first := current.PartitionIndex(p.Names[0])
+	for i, name := range p.Names {
+		if current.PartitionIndex(name) != first+i {
+			return sql.ErrReorganizeNotConsecutive.New()
+		}
+	}
+	last := first + len(p.Names) - 1
+
+	np := current.Copy()
+	defs := make([]sql.PartitionDefinition, len(p.Definitions))
+	for i, d := range p.Definitions {
+		def, err := d.Build(ctx, np)
+		if err != nil {
+			return err
+		}
+		defs[i] = def
+	}
+	np.Definitions = append(append(append([]sql.PartitionDefinition{}, current.Definitions[:first]...), defs...), current.Definitions[last+1:]...)
+	if err := np.Validate(); err != nil {
+		return err
+	}
+
+	if current.Method == sql.PartitionMethod_Range && last < len(current.Definitions)-1 {
+		oldBound, _ := current.Description(last)
+		newBound, _ := np.Description(first + len(defs) - 1)
+		if oldBound != newBound {
+			return sql.ErrReorganizeRange.New()
+		}
+	}
+	return table.SetPartitioning(ctx, np)
+}
+
+func (p *AlterPartition) checkNames(current *sql.Partitioning, table string) error {
+	for _, name := range p.Names {
+		if current.PartitionIndex(name) < 0 {
+			return sql.ErrUnknownPartition.New(name, table)
+		}
+	}
+	return nil
+}
+
+// uniqueKeys returns the columns of the unique indexes of a table.
+func uniqueKeys(ctx *sql.Context, table sql.Table) ([][]string, error) {
+	indexed, ok := table.(sql.IndexedTable)
+	if !ok {
+		return nil, nil
+	}
+	indexes, err := indexed.GetIndexes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys [][]string
+	for _, idx := range indexes {
+		if !idx.IsUnique() || idx.ID() == "PRIMARY" {
+			continue
+		}
+		key := make([]string, len(idx.Expressions()))
+		for i, expr := range idx.Expressions() {
+			key[i] = expr[strings.LastIndex(expr, ".")+1:]
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func (p AlterPartition) String() string {
+	pr := sql.NewTreePrinter()
+	switch p.Action {
+	case PartitionAction_PartitionBy:
+		_ = pr.WriteNode("AlterPartition(%s)", p.Spec)
+	case PartitionAction_Remove:
+		_ = pr.WriteNode("AlterPartition(REMOVE PARTITIONING)")
+	case PartitionAction_Add:
+		_ = pr.WriteNode("AlterPartition(ADD PARTITION)")
+	case PartitionAction_Drop:
+		_ = pr.WriteNode("AlterPartition(DROP PARTITION %s)", strings.Join(p.Names, ", "))
+	case PartitionAction_Truncate:
+		_ = pr.WriteNode("AlterPartition(TRUNCATE PARTITION %s)", strings.Join(p.Names, ", "))
+	case PartitionAction_Coalesce:
+		_ = pr.WriteNode("AlterPartition(COALESCE PARTITION %d)", p.Count)
+	case PartitionAction_Reorganize:
+		_ = pr.WriteNode("AlterPartition(REORGANIZE PARTITION %s)", strings.Join(p.Names, ", "))
+	}
+	_ = pr.WriteChildren(fmt.Sprintf("Table(%s)", p.Child.String()))
+	return pr.String()
+}
@@ -46,6 +46,16 @@ func (r *RenameTable) WithDatabase(db sql.Database) (sql.Node, error) {
 	return &nr, nil
 }
 
+// OldNames returns the names of the tables being renamed.
+func (r *RenameTable) OldNames() []string {
+	return r.oldNames
+}
+
+// NewNames returns the new names of the tables being renamed.
+func (r *RenameTable) NewNames() []string {
+	return r.newNames
+}
+
 func (r *RenameTable) String() string {
 	return fmt.Sprintf("Rename table %s to %s", r.oldNames, r.newNames)
 }
@@ -230,6 +240,10 @@ func (d *DropColumn) WithDatabase(db sql.Database) (sql.Node, error) {
 	return &nd, nil
 }
 
+func (d *DropColumn) TableName() string {
+	return d.tableName
+}
+
 func (d *DropColumn) String() string {
 	return fmt.Sprintf("drop column %s", d.column)
 }
@@ -299,6 +313,10 @@ func NewRenameColumn(db sql.Database, tableName string, columnName string, newCo
 	}
 }
 
+func (r *RenameColumn) TableName() string {
+	return r.tableName
+}
+
 func (r *RenameColumn) WithDatabase(db sql.Database) (sql.Node, error) {
 	nr := *r
 	nr.db = db
@@ -25,19 +25,53 @@ import (
 // returned and the |BindVar| expression is left in place. There is no check on
 // whether all entries in |bindings| are used at least once throughout the |n|.
 //
-// This applies binding substitutions across *SubqueryAlias nodes, but will
-// fail to apply bindings across other |sql.Opaque| nodes.
+// This applies binding substitutions across *SubqueryAlias and *Union nodes
+// and subquery expressions, but will fail to apply bindings across other
+// |sql.Opaque| nodes.
 func ApplyBindings(ctx *sql.Context, n sql.Node, bindings map[string]sql.Expression) (sql.Node, error) {
+	return transformBindVars(ctx, n, func(e *expression.BindVar) (sql.Expression, error) {
+		if val, found := bindings[e.Name]; found {
+			return val, nil
+		}
+		return e, nil
+	})
+}
+
+// BindVarNames returns the names of the `BindVar` expressions in the given
+// sql.Node, looking into the same nodes and expressions as ApplyBindings.
+func BindVarNames(ctx *sql.Context, n sql.Node) (map[string]bool, error) {
+	names := make(map[string]bool)
+	_, err := transformBindVars(ctx, n, func(e *expression.BindVar) (sql.Expression, error) {
+		names[e.Name] = true
+		return e, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+func transformBindVars(ctx *sql.Context, n sql.Node, f func(*expression.BindVar) (sql.Expression, error)) (sql.Node, error) {
 	withSubqueries, err := TransformUp(n, func(n sql.Node) (sql.Node, error) {
 		switch n := n.(type) {
 		case *SubqueryAlias:
-			child, err := ApplyBindings(ctx, n.Child, bindings)
+			child, err := transformBindVars(ctx, n.Child, f)
 			if err != nil {
 				return nil, err
 			}
 			return n.WithChildren(child)
+		case *Union:
+			left, err := transformBindVars(ctx, n.Left(), f)
+			if err != nil {
+				return nil, err
+			}
+			right, err := transformBindVars(ctx, n.Right(), f)
+			if err != nil {
+				return nil, err
+			}
+			return n.WithChildren(left, right)
 		case *InsertInto:
-			source, err := ApplyBindings(ctx, n.Source, bindings)
+			source, err := transformBindVars(ctx, n.Source, f)
 			if err != nil {
 				return nil, err
 			}
@@ -50,11 +84,15 @@ func ApplyBindings(ctx *sql.Context, n sql.Node, bindings map[string]sql.Express
 		return nil, err
 	}
 	return TransformExpressionsUp(ctx, withSubqueries, func(e sql.Expression) (sql.Expression, error) {
-		if bv, ok := e.(*expression.BindVar); ok {
-			val, found := bindings[bv.Name]
-			if found {
-				return val, nil
+		switch e := e.(type) {
+		case *expression.BindVar:
+			return f(e)
+		case *Subquery:
+			query, err := transformBindVars(ctx, e.Query, f)
+			if err != nil {
+				return nil, err
 			}
+			return e.WithQuery(query), nil
 		}
 		return e, nil
 	})
@@ -15,6 +15,7 @@
 package plan
 
 import (
+	"context"
 	"io"
 
 	"github.com/linanh/go-mysql-server/sql"
@@ -125,6 +126,14 @@ func (b *Block) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 					if isSelect || !selectSeen {
 						returnRows = rowCache.Get()
 					}
+					// The result sets of nested blocks are reported by the blocks themselves
+					if _, ok := subIter.(BlockRowIter); isSelect && !ok {
+						if f := resultSetsOf(ctx); f != nil {
+							if err := f(subIterSch, returnRows); err != nil {
+								return err
+							}
+						}
+					}
 					break
 				} else if err != nil {
 					return err
@@ -178,3 +187,23 @@ func (i *blockIter) RepresentingNode() sql.Node {
 func (i *blockIter) Schema() sql.Schema {
 	return i.sch
 }
+
+// ResultSetFunc is passed the schema and rows of every SELECT statement run by the blocks of a stored procedure, in
+// the order they were run, so CALL can return all of them to clients which accept multiple result sets. Without it, a
+// block only returns the rows of its last SELECT statement.
+type ResultSetFunc func(sch sql.Schema, rows []sql.Row) error
+
+// resultSetsKey is the context key of the ResultSetFunc which blocks report to.
+type resultSetsKey struct{}
+
+// WithResultSets returns a copy of the context given whose blocks pass the result set of each of their SELECT
+// statements to the function given as soon as it's complete. The error it returns stops the procedure.
+func WithResultSets(ctx *sql.Context, f ResultSetFunc) *sql.Context {
+	return ctx.WithContext(context.WithValue(ctx.Context, resultSetsKey{}, f))
+}
+
+// resultSetsOf returns the ResultSetFunc which the blocks run with the context given report to, if any.
+func resultSetsOf(ctx *sql.Context) ResultSetFunc {
+	f, _ := ctx.Value(resultSetsKey{}).(ResultSetFunc)
+	return f
+}
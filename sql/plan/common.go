@@ -103,7 +103,7 @@ func nodeRepresentsSelect(s sql.Node) bool {
 		case *AlterAutoIncrement, *AlterIndex, *CreateForeignKey, *CreateIndex, *CreateTable, *CreateTrigger,
 			*DeleteFrom, *DropForeignKey, *InsertInto, *ShowCreateTable, *ShowIndexes, *Truncate, *Update:
 			return false
-		case *ResolvedTable, *ProcedureResolvedTable:
+		case *ResolvedTable, *ProcedureResolvedTable, *IndexedTableAccess:
 			isSelect = true
 			return false
 		default:
@@ -35,6 +35,11 @@ func (c CreateDB) Resolved() bool {
 	return true
 }
 
+// DatabaseName returns the name of the database being created.
+func (c CreateDB) DatabaseName() string {
+	return c.dbName
+}
+
 func (c CreateDB) String() string {
 	ifNotExists := ""
 	if c.IfNotExists {
@@ -97,6 +102,11 @@ func (d DropDB) Resolved() bool {
 	return true
 }
 
+// DatabaseName returns the name of the database being dropped.
+func (d DropDB) DatabaseName() string {
+	return d.dbName
+}
+
 func (d DropDB) String() string {
 	ifExists := ""
 	if d.IfExists {
@@ -134,6 +134,7 @@ type CreateTable struct {
 	like        sql.Node
 	temporary   TempTableOption
 	selectNode  sql.Node
+	partitions  *PartitionSpec
 }
 
 var _ sql.Databaser = (*CreateTable)(nil)
@@ -206,12 +207,23 @@ func (c *CreateTable) Resolved() bool {
 	for _, col := range c.schema {
 		resolved = resolved && col.Default.Resolved()
 	}
+	if c.partitions != nil {
+		resolved = resolved && c.partitions.Resolved()
+	}
 	return resolved
 }
 
 // RowIter implements the Node interface.
 func (c *CreateTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	var err error
+	var partitioning *sql.Partitioning
+	if c.partitions != nil {
+		partitioning, err = c.partitions.Build(ctx, c.schema, c.uniqueKeys())
+		if err != nil {
+			return sql.RowsToRowIter(), err
+		}
+	}
+
 	if c.temporary == IsTempTable {
 		creatable, ok := c.db.(sql.TemporaryTableCreator)
 		if !ok {
@@ -271,9 +283,36 @@ func (c *CreateTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error
 		}
 	}
 
+	if partitioning != nil {
+		partitionAlterable, ok := tableNode.(sql.PartitionAlterableTable)
+		if !ok {
+			return sql.RowsToRowIter(), sql.ErrPartitioningNotSupported.New(c.name)
+		}
+		err = partitionAlterable.SetPartitioning(ctx, partitioning)
+		if err != nil {
+			return sql.RowsToRowIter(), err
+		}
+	}
+
 	return sql.RowsToRowIter(), nil
 }
 
+// uniqueKeys returns the columns of the unique indexes declared.
+func (c *CreateTable) uniqueKeys() [][]string {
+	var keys [][]string
+	for _, idxDef := range c.idxDefs {
+		if idxDef.Constraint != sql.IndexConstraint_Unique {
+			continue
+		}
+		key := make([]string, len(idxDef.Columns))
+		for i, col := range idxDef.Columns {
+			key[i] = col.Name
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
 func (c *CreateTable) createIndexes(ctx *sql.Context, tableNode sql.Table) error {
 	idxAlterable, ok := tableNode.(sql.IndexAlterableTable)
 	if !ok {
@@ -392,6 +431,9 @@ func (c *CreateTable) DebugString() string {
 	if len(c.chDefs) > 0 {
 		children = append(children, c.checkConstraintsDebugString())
 	}
+	if c.partitions != nil {
+		children = append(children, c.partitions.String())
+	}
 
 	p.WriteChildren(children...)
 	return p.String()
@@ -452,6 +494,9 @@ func (c *CreateTable) Expressions() []sql.Expression {
 		exprs[i] = ch.Expr
 		i++
 	}
+	if c.partitions != nil {
+		exprs = append(exprs, c.partitions.Expressions()...)
+	}
 	return exprs
 }
 
@@ -474,6 +519,23 @@ func (c *CreateTable) TableSpec() *TableSpec {
 	return ret
 }
 
+// Checks returns the check constraints of the table.
+func (c *CreateTable) Checks() []*sql.CheckConstraint {
+	return c.chDefs
+}
+
+// PartitionSpec returns the PARTITION BY clause of the table, or nil if it isn't partitioned.
+func (c *CreateTable) PartitionSpec() *PartitionSpec {
+	return c.partitions
+}
+
+// WithPartitionSpec returns a copy of the node partitioning the table as the spec given declares.
+func (c *CreateTable) WithPartitionSpec(spec *PartitionSpec) *CreateTable {
+	nc := *c
+	nc.partitions = spec
+	return &nc
+}
+
 func (c *CreateTable) Name() string {
 	return c.name
 }
@@ -487,8 +549,8 @@ func (c *CreateTable) Temporary() TempTableOption {
 }
 
 func (c *CreateTable) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
-	if len(exprs) != len(c.schema)+len(c.chDefs) {
-		return nil, sql.ErrInvalidChildrenNumber.New(c, len(exprs), len(c.schema)+len(c.chDefs))
+	if len(exprs) != len(c.Expressions()) {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(exprs), len(c.Expressions()))
 	}
 
 	nc := *c
@@ -507,6 +569,14 @@ func (c *CreateTable) WithExpressions(exprs ...sql.Expression) (sql.Node, error)
 		nc.chDefs[i-len(c.schema)].Expr = exprs[i]
 	}
 
+	if c.partitions != nil {
+		var err error
+		nc.partitions, _, err = c.partitions.WithExpressions(exprs[i:])
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &nc, nil
 }
 
@@ -588,6 +658,7 @@ func (d *DropTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error)
 		if err != nil {
 			return nil, err
 		}
+		recordReset(ctx, d.db.Name(), tbl, nil)
 	}
 
 	if len(d.triggerNames) > 0 {
@@ -111,7 +111,7 @@ func (p *DeleteFrom) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error)
 		return nil, err
 	}
 
-	deleter := deletable.Deleter(ctx)
+	deleter := recordDeletes(ctx, p.Database(), deletable, deletable.Deleter(ctx))
 
 	return newDeleteIter(iter, deleter, deletable.Schema(), ctx), nil
 }
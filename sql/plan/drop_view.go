@@ -74,6 +74,11 @@ func (dv *SingleDropView) WithChildren(children ...sql.Node) (sql.Node, error) {
 	return dv, nil
 }
 
+// ViewName returns the name of the view being dropped.
+func (dv *SingleDropView) ViewName() string {
+	return dv.viewName
+}
+
 // Database implements the Databaser interfacee. It returns the node's database.
 func (dv *SingleDropView) Database() sql.Database {
 	return dv.database
@@ -376,7 +376,12 @@ func (exchangePartition) Children() []sql.Node { return nil }
 func (exchangePartition) Resolved() bool { return true }
 
 func (p *exchangePartition) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
-	return p.table.PartitionRows(ctx, p.Partition)
+	ctx.SetFullScan()
+	iter, err := p.table.PartitionRows(ctx, p.Partition)
+	if err != nil {
+		return nil, err
+	}
+	return &exchangePartitionRowIter{ctx, iter}, nil
 }
 
 func (p *exchangePartition) Schema() sql.Schema {
@@ -391,3 +396,21 @@ func (p *exchangePartition) WithChildren(children ...sql.Node) (sql.Node, error)
 
 	return p, nil
 }
+
+// exchangePartitionRowIter counts the rows of a partition as examined by the query.
+type exchangePartitionRowIter struct {
+	ctx  *sql.Context
+	iter sql.RowIter
+}
+
+func (i *exchangePartitionRowIter) Next() (sql.Row, error) {
+	row, err := i.iter.Next()
+	if err == nil {
+		i.ctx.AddRowsExamined(1)
+	}
+	return row, err
+}
+
+func (i *exchangePartitionRowIter) Close(ctx *sql.Context) error {
+	return i.iter.Close(ctx)
+}
@@ -0,0 +1,384 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// PrivilegeSpec is a privilege named in a GRANT or REVOKE statement, optionally restricted to some columns.
+type PrivilegeSpec struct {
+	Privileges sql.PrivilegeType
+	Columns    []string
+}
+
+func (p PrivilegeSpec) String() string {
+	if len(p.Columns) == 0 {
+		return p.Privileges.String()
+	}
+	return fmt.Sprintf("%s (%s)", p.Privileges.String(), strings.Join(p.Columns, ", "))
+}
+
+func privilegeSpecsString(specs []PrivilegeSpec) string {
+	var strs []string
+	for _, s := range specs {
+		strs = append(strs, s.String())
+	}
+	return strings.Join(strs, ", ")
+}
+
+// grantsByTarget returns the privileges in the specs given, with the target given, keyed by target. Column privileges
+// get a target for each column. It returns an error if any privilege can't be granted on its target.
+func grantsByTarget(specs []PrivilegeSpec, target sql.PrivilegeTarget, grantOption bool) (map[sql.PrivilegeTarget]sql.PrivilegeType, error) {
+	res := make(map[sql.PrivilegeTarget]sql.PrivilegeType)
+	for _, spec := range specs {
+		if len(spec.Columns) == 0 {
+			if !target.AllowedPrivileges().Has(spec.Privileges) {
+				return nil, sql.ErrIllegalPrivilegeLevel.New()
+			}
+			res[target] |= spec.Privileges
+			continue
+		}
+
+		if target.Table == "" || !sql.PrivilegeType_Column.Has(spec.Privileges) {
+			return nil, sql.ErrIllegalPrivilegeLevel.New()
+		}
+		for _, col := range spec.Columns {
+			colTarget := target
+			colTarget.Column = strings.ToLower(col)
+			res[colTarget] |= spec.Privileges
+		}
+	}
+
+	if grantOption {
+		res[target] |= sql.PrivilegeType_GrantOption
+	}
+	return res, nil
+}
+
+// Grant grants privileges on a database object to users or roles.
+type Grant struct {
+	Catalog         *sql.Catalog
+	Privileges      []PrivilegeSpec
+	Target          sql.PrivilegeTarget
+	Grantees        []sql.UserIdentity
+	WithGrantOption bool
+}
+
+var _ sql.Node = (*Grant)(nil)
+
+// NewGrant returns a new Grant node.
+func NewGrant(privileges []PrivilegeSpec, target sql.PrivilegeTarget, grantees []sql.UserIdentity, withGrantOption bool) *Grant {
+	return &Grant{
+		Privileges:      privileges,
+		Target:          target,
+		Grantees:        grantees,
+		WithGrantOption: withGrantOption,
+	}
+}
+
+// Resolved implements the sql.Node interface.
+func (n *Grant) Resolved() bool { return true }
+
+// Schema implements the sql.Node interface.
+func (n *Grant) Schema() sql.Schema { return sql.OkResultSchema }
+
+// Children implements the sql.Node interface.
+func (n *Grant) Children() []sql.Node { return nil }
+
+// WithChildren implements the sql.Node interface.
+func (n *Grant) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(n, children...)
+}
+
+func (n *Grant) String() string {
+	withGrantOption := ""
+	if n.WithGrantOption {
+		withGrantOption = " with grant option"
+	}
+	return fmt.Sprintf("Grant(%s on %s to %s%s)", privilegeSpecsString(n.Privileges), n.Target, identitiesString(n.Grantees), withGrantOption)
+}
+
+// RowIter implements the sql.Node interface.
+func (n *Grant) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	store, err := privilegeStore(n.Catalog)
+	if err != nil {
+		return nil, err
+	}
+
+	grants, err := grantsByTarget(n.Privileges, n.Target, n.WithGrantOption)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkAccountsExist(ctx, store, "GRANT", n.Grantees); err != nil {
+		return nil, err
+	}
+
+	for _, grantee := range n.Grantees {
+		for target, privs := range grants {
+			current, err := sql.GrantOn(ctx, store, grantee, target)
+			if err != nil {
+				return nil, err
+			}
+			err = store.SetGrant(ctx, sql.PrivilegeGrant{Grantee: grantee, Target: target, Privileges: current | privs})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+}
+
+// Revoke revokes privileges on a database object from users or roles.
+type Revoke struct {
+	Catalog    *sql.Catalog
+	Privileges []PrivilegeSpec
+	Target     sql.PrivilegeTarget
+	Grantees   []sql.UserIdentity
+	// All is true for REVOKE ALL PRIVILEGES, GRANT OPTION statements, which revoke every privilege at every level.
+	All bool
+}
+
+var _ sql.Node = (*Revoke)(nil)
+
+// NewRevoke returns a new Revoke node.
+func NewRevoke(privileges []PrivilegeSpec, target sql.PrivilegeTarget, grantees []sql.UserIdentity) *Revoke {
+	return &Revoke{
+		Privileges: privileges,
+		Target:     target,
+		Grantees:   grantees,
+	}
+}
+
+// NewRevokeAll returns a new Revoke node which revokes every privilege from the grantees given.
+func NewRevokeAll(grantees []sql.UserIdentity) *Revoke {
+	return &Revoke{
+		Grantees: grantees,
+		All:      true,
+	}
+}
+
+// Resolved implements the sql.Node interface.
+func (n *Revoke) Resolved() bool { return true }
+
+// Schema implements the sql.Node interface.
+func (n *Revoke) Schema() sql.Schema { return sql.OkResultSchema }
+
+// Children implements the sql.Node interface.
+func (n *Revoke) Children() []sql.Node { return nil }
+
+// WithChildren implements the sql.Node interface.
+func (n *Revoke) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(n, children...)
+}
+
+func (n *Revoke) String() string {
+	if n.All {
+		return fmt.Sprintf("Revoke(all privileges, grant option from %s)", identitiesString(n.Grantees))
+	}
+	return fmt.Sprintf("Revoke(%s on %s from %s)", privilegeSpecsString(n.Privileges), n.Target, identitiesString(n.Grantees))
+}
+
+// RowIter implements the sql.Node interface.
+func (n *Revoke) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	store, err := privilegeStore(n.Catalog)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkAccountsExist(ctx, store, "REVOKE", n.Grantees); err != nil {
+		return nil, err
+	}
+
+	if n.All {
+		for _, grantee := range n.Grantees {
+			grants, err := sql.GrantsFor(ctx, store, grantee)
+			if err != nil {
+				return nil, err
+			}
+			for _, g := range grants {
+				if err := store.SetGrant(ctx, sql.PrivilegeGrant{Grantee: grantee, Target: g.Target}); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+	}
+
+	revoked, err := grantsByTarget(n.Privileges, n.Target, false)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, grantee := range n.Grantees {
+		for target, privs := range revoked {
+			current, err := sql.GrantOn(ctx, store, grantee, target)
+			if err != nil {
+				return nil, err
+			}
+			if current == 0 {
+				return nil, sql.ErrNoGrantFound.New(grantee.User, grantee.Host)
+			}
+			err = store.SetGrant(ctx, sql.PrivilegeGrant{Grantee: grantee, Target: target, Privileges: current &^ privs})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+}
+
+// GrantRole grants roles to users or other roles.
+type GrantRole struct {
+	Catalog         *sql.Catalog
+	Roles           []sql.UserIdentity
+	Grantees        []sql.UserIdentity
+	WithAdminOption bool
+}
+
+var _ sql.Node = (*GrantRole)(nil)
+
+// NewGrantRole returns a new GrantRole node.
+func NewGrantRole(roles []sql.UserIdentity, grantees []sql.UserIdentity, withAdminOption bool) *GrantRole {
+	return &GrantRole{
+		Roles:           roles,
+		Grantees:        grantees,
+		WithAdminOption: withAdminOption,
+	}
+}
+
+// Resolved implements the sql.Node interface.
+func (n *GrantRole) Resolved() bool { return true }
+
+// Schema implements the sql.Node interface.
+func (n *GrantRole) Schema() sql.Schema { return sql.OkResultSchema }
+
+// Children implements the sql.Node interface.
+func (n *GrantRole) Children() []sql.Node { return nil }
+
+// WithChildren implements the sql.Node interface.
+func (n *GrantRole) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(n, children...)
+}
+
+func (n *GrantRole) String() string {
+	withAdminOption := ""
+	if n.WithAdminOption {
+		withAdminOption = " with admin option"
+	}
+	return fmt.Sprintf("GrantRole(%s to %s%s)", identitiesString(n.Roles), identitiesString(n.Grantees), withAdminOption)
+}
+
+// RowIter implements the sql.Node interface.
+func (n *GrantRole) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	store, err := privilegeStore(n.Catalog)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkAccountsExist(ctx, store, "GRANT", n.Roles); err != nil {
+		return nil, err
+	}
+	if err := checkAccountsExist(ctx, store, "GRANT", n.Grantees); err != nil {
+		return nil, err
+	}
+
+	for _, grantee := range n.Grantees {
+		for _, role := range n.Roles {
+			err := store.SetRoleEdge(ctx, sql.RoleEdge{Role: role, Grantee: grantee, AdminOption: n.WithAdminOption})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+}
+
+// RevokeRole revokes roles from users or other roles.
+type RevokeRole struct {
+	Catalog  *sql.Catalog
+	Roles    []sql.UserIdentity
+	Grantees []sql.UserIdentity
+}
+
+var _ sql.Node = (*RevokeRole)(nil)
+
+// NewRevokeRole returns a new RevokeRole node.
+func NewRevokeRole(roles []sql.UserIdentity, grantees []sql.UserIdentity) *RevokeRole {
+	return &RevokeRole{
+		Roles:    roles,
+		Grantees: grantees,
+	}
+}
+
+// Resolved implements the sql.Node interface.
+func (n *RevokeRole) Resolved() bool { return true }
+
+// Schema implements the sql.Node interface.
+func (n *RevokeRole) Schema() sql.Schema { return sql.OkResultSchema }
+
+// Children implements the sql.Node interface.
+func (n *RevokeRole) Children() []sql.Node { return nil }
+
+// WithChildren implements the sql.Node interface.
+func (n *RevokeRole) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(n, children...)
+}
+
+func (n *RevokeRole) String() string {
+	return fmt.Sprintf("RevokeRole(%s from %s)", identitiesString(n.Roles), identitiesString(n.Grantees))
+}
+
+// RowIter implements the sql.Node interface.
+func (n *RevokeRole) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	store, err := privilegeStore(n.Catalog)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkAccountsExist(ctx, store, "REVOKE", n.Grantees); err != nil {
+		return nil, err
+	}
+
+	for _, grantee := range n.Grantees {
+		for _, role := range n.Roles {
+			if err := store.RemoveRoleEdge(ctx, role, grantee); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+}
+
+func checkAccountsExist(ctx *sql.Context, store sql.PrivilegeStore, operation string, ids []sql.UserIdentity) error {
+	for _, id := range ids {
+		_, exists, err := store.Account(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return sql.ErrAccountNotFound.New(operation, id.Quoted())
+		}
+	}
+	return nil
+}
@@ -28,12 +28,16 @@ import (
 // performing a lookup. When RowIter is called, if cached results are
 // available, it fulfills the RowIter call by performing a hash lookup
 // on the projected results. If cached results are not available, it
-// simply delegates to the child.
-func NewHashLookup(n *CachedResults, childProjection sql.Expression, lookupProjection sql.Expression) *HashLookup {
+// simply delegates to the child. The values projected are hashed by
+// their collation key in the types given, one for each value, so that
+// strings equal in the collation they're compared in are found; a nil
+// type hashes a value as is.
+func NewHashLookup(n *CachedResults, childProjection sql.Expression, lookupProjection sql.Expression, keyTypes []sql.Type) *HashLookup {
 	return &HashLookup{
 		UnaryNode:        UnaryNode{n},
 		childProjection:  childProjection,
 		lookupProjection: lookupProjection,
+		keyTypes:         keyTypes,
 		mutex:            new(sync.Mutex),
 	}
 }
@@ -42,6 +46,7 @@ type HashLookup struct {
 	UnaryNode
 	childProjection  sql.Expression
 	lookupProjection sql.Expression
+	keyTypes         []sql.Type
 	mutex            *sync.Mutex
 	lookup           map[interface{}][]sql.Row
 }
@@ -114,6 +119,11 @@ func (n *HashLookup) getHashKey(ctx *sql.Context, e sql.Expression, row sql.Row)
 		return nil, err
 	}
 	if s, ok := key.([]interface{}); ok {
+		keys := make([]interface{}, len(s))
+		for i, v := range s {
+			keys[i] = n.collationKey(i, v)
+		}
+		s = keys
 		switch len(s) {
 		case 0:
 			return [0]interface{}{}, nil
@@ -131,5 +141,13 @@ func (n *HashLookup) getHashKey(ctx *sql.Context, e sql.Expression, row sql.Row)
 			return sql.HashOf(s)
 		}
 	}
-	return key, nil
+	return n.collationKey(0, key), nil
+}
+
+// collationKey returns the key the i-th value projected is hashed by.
+func (n *HashLookup) collationKey(i int, v interface{}) interface{} {
+	if i < len(n.keyTypes) && n.keyTypes[i] != nil {
+		return sql.CollationKey(n.keyTypes[i], v)
+	}
+	return v
 }
@@ -159,6 +159,7 @@ func getInsertableTable(t sql.Table) (sql.InsertableTable, error) {
 
 func newInsertIter(
 	ctx *sql.Context,
+	database string,
 	table sql.Node,
 	values sql.Node,
 	isReplace bool,
@@ -180,11 +181,11 @@ func newInsertIter(
 	var updater sql.RowUpdater
 	// These type casts have already been asserted in the analyzer
 	if isReplace {
-		replacer = insertable.(sql.ReplaceableTable).Replacer(ctx)
+		replacer = recordReplaces(ctx, database, insertable, insertable.(sql.ReplaceableTable).Replacer(ctx))
 	} else {
-		inserter = insertable.Inserter(ctx)
+		inserter = recordInserts(ctx, database, insertable, insertable.Inserter(ctx))
 		if len(onDupUpdateExpr) > 0 {
-			updater = insertable.(sql.UpdatableTable).Updater(ctx)
+			updater = recordUpdates(ctx, database, insertable, insertable.(sql.UpdatableTable).Updater(ctx))
 		}
 	}
 
@@ -485,7 +486,11 @@ func toInt64(x interface{}) int64 {
 
 // RowIter implements the Node interface.
 func (ii *InsertInto) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
-	return newInsertIter(ctx, ii.Destination, ii.Source, ii.IsReplace, ii.OnDupExprs, ii.Checks, row, ii.Ignore)
+	var database string
+	if ii.db != nil {
+		database = ii.db.Name()
+	}
+	return newInsertIter(ctx, database, ii.Destination, ii.Source, ii.IsReplace, ii.OnDupExprs, ii.Checks, row, ii.Ignore)
 }
 
 // WithChildren implements the Node interface.
@@ -84,7 +84,8 @@ func (in *InSubquery) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 			return nil, nil
 		}
 
-		key, err := sql.HashOf(sql.NewRow(left))
+		// Values are hashed by their collation key in the type they're compared in
+		key, err := sql.HashOf(sql.NewRow(sql.CollationKey(typ, left)))
 		if err != nil {
 			return nil, err
 		}
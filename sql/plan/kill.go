@@ -0,0 +1,118 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// KillType is the kind of KILL statement.
+type KillType byte
+
+const (
+	// KillType_Connection terminates the statement a connection is running, and then the connection itself.
+	KillType_Connection KillType = iota
+	// KillType_Query terminates the statement a connection is running, leaving the connection intact.
+	KillType_Query
+)
+
+// Kill is the KILL statement. The engine checks that users only kill the connections of their own user and host, unless
+// they hold the PROCESS privilege, before running it.
+type Kill struct {
+	Catalog *sql.Catalog
+	Type    KillType
+	ConnID  sql.Expression
+}
+
+var _ sql.Node = (*Kill)(nil)
+var _ sql.Expressioner = (*Kill)(nil)
+
+// NewKill returns a new Kill node.
+func NewKill(typ KillType, connID sql.Expression) *Kill {
+	return &Kill{Type: typ, ConnID: connID}
+}
+
+// Resolved implements the sql.Node interface.
+func (k *Kill) Resolved() bool { return k.ConnID.Resolved() }
+
+// Schema implements the sql.Node interface.
+func (k *Kill) Schema() sql.Schema { return sql.OkResultSchema }
+
+// Children implements the sql.Node interface.
+func (k *Kill) Children() []sql.Node { return nil }
+
+// WithChildren implements the sql.Node interface.
+func (k *Kill) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(k, children...)
+}
+
+// Expressions implements the sql.Expressioner interface.
+func (k *Kill) Expressions() []sql.Expression { return []sql.Expression{k.ConnID} }
+
+// WithExpressions implements the sql.Expressioner interface.
+func (k *Kill) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(k, len(exprs), 1)
+	}
+	nk := *k
+	nk.ConnID = exprs[0]
+	return &nk, nil
+}
+
+func (k *Kill) String() string {
+	if k.Type == KillType_Query {
+		return fmt.Sprintf("Kill(query %s)", k.ConnID)
+	}
+	return fmt.Sprintf("Kill(connection %s)", k.ConnID)
+}
+
+// ConnectionID returns the id of the connection the statement kills.
+func (k *Kill) ConnectionID(ctx *sql.Context, row sql.Row) (uint32, error) {
+	val, err := k.ConnID.Eval(ctx, row)
+	if err != nil {
+		return 0, err
+	}
+	if val == nil {
+		return 0, sql.ErrUnknownThread.New(0)
+	}
+	id, err := sql.Uint32.Convert(val)
+	if err != nil {
+		return 0, err
+	}
+	return id.(uint32), nil
+}
+
+// RowIter implements the sql.Node interface.
+func (k *Kill) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	connID, err := k.ConnectionID(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := k.Catalog.ProcessList.ConnectionClient(connID); !ok {
+		return nil, sql.ErrUnknownThread.New(connID)
+	}
+
+	if k.Type == KillType_Query {
+		ctx.GetLogger().Info("killing query")
+		k.Catalog.ProcessList.Kill(connID)
+	} else {
+		ctx.GetLogger().Info("killing connection")
+		k.Catalog.ProcessList.KillConnection(connID)
+	}
+	return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+}
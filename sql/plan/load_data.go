@@ -162,7 +162,11 @@ func (l *LoadData) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 		}
 
 		tmpdir, err := ctx.GetSessionVariable(ctx, "tmpdir")
-		fileName = tmpdir.(string) + TmpfileName
+		if err != nil {
+			return nil, err
+		}
+		// The file is written where vitess writes it
+		fileName = filepath.Join(tmpdir.(string), TmpfileName)
 	} else {
 		dir, err := ctx.GetSessionVariable(ctx, "secure_file_priv")
 		if err != nil {
@@ -0,0 +1,293 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/linanh/go-mysql-server/sql"
+	"github.com/linanh/go-mysql-server/sql/expression"
+)
+
+// StatementBuilder parses and analyzes the statements run by PREPARE and EXECUTE, which are only known once they're
+// executed. It's set by the analyzer, as this package can't depend on the parser.
+type StatementBuilder interface {
+	// Parse parses a statement.
+	Parse(ctx *sql.Context, query string) (sql.Node, error)
+	// Analyze analyzes a parsed statement with its parameters bound.
+	Analyze(ctx *sql.Context, n sql.Node) (sql.Node, error)
+}
+
+// PrepareQuery is the PREPARE statement, which prepares the text of a statement under a name in the session, to run
+// it with EXECUTE. The text is a string literal or a user variable, with a ? for each parameter.
+type PrepareQuery struct {
+	Name    string
+	Query   sql.Expression
+	Builder StatementBuilder
+}
+
+var _ sql.Node = (*PrepareQuery)(nil)
+var _ sql.Expressioner = (*PrepareQuery)(nil)
+
+// NewPrepareQuery returns a new PrepareQuery node.
+func NewPrepareQuery(name string, query sql.Expression) *PrepareQuery {
+	return &PrepareQuery{Name: name, Query: query}
+}
+
+// Resolved implements the sql.Node interface.
+func (p *PrepareQuery) Resolved() bool { return p.Query.Resolved() }
+
+// Schema implements the sql.Node interface.
+func (p *PrepareQuery) Schema() sql.Schema { return sql.OkResultSchema }
+
+// Children implements the sql.Node interface.
+func (p *PrepareQuery) Children() []sql.Node { return nil }
+
+// WithChildren implements the sql.Node interface.
+func (p *PrepareQuery) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(p, children...)
+}
+
+// Expressions implements the sql.Expressioner interface.
+func (p *PrepareQuery) Expressions() []sql.Expression { return []sql.Expression{p.Query} }
+
+// WithExpressions implements the sql.Expressioner interface.
+func (p *PrepareQuery) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(p, len(exprs), 1)
+	}
+	np := *p
+	np.Query = exprs[0]
+	return &np, nil
+}
+
+func (p *PrepareQuery) String() string {
+	return fmt.Sprintf("Prepare(%s from %s)", p.Name, p.Query)
+}
+
+// RowIter implements the sql.Node interface.
+func (p *PrepareQuery) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	session, ok := ctx.Session.(sql.PreparedStatementSession)
+	if !ok {
+		return nil, sql.ErrUnsupportedFeature.New("PREPARE")
+	}
+	// A statement which fails to be prepared replaces the one prepared before it, as in MySQL
+	session.DeletePreparedStatement(p.Name)
+
+	val, err := p.Query.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if val == nil {
+		return nil, sql.ErrSyntaxError.New("PREPARE statement text is NULL")
+	}
+	query, err := sql.LongText.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := p.Builder.Parse(ctx, query.(string))
+	if err != nil {
+		return nil, err
+	}
+	switch parsed.(type) {
+	case *PrepareQuery, *ExecuteQuery, *DeallocateQuery:
+		return nil, sql.ErrUnsupportedPreparedStatement.New()
+	}
+
+	params, err := BindVarNames(ctx, parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	session.SetPreparedStatement(p.Name, &sql.PreparedStatement{
+		Query:  query.(string),
+		Node:   parsed,
+		Params: len(params),
+	})
+	return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+}
+
+// ExecuteQuery is the EXECUTE statement, which runs a statement prepared with PREPARE, binding the values of the user
+// variables given to its parameters in order.
+type ExecuteQuery struct {
+	Name    string
+	Using   []sql.Expression
+	Builder StatementBuilder
+	// rowIterSch is set during RowIter, as the statement run isn't known until then
+	rowIterSch sql.Schema
+}
+
+var _ sql.Node = (*ExecuteQuery)(nil)
+var _ sql.Expressioner = (*ExecuteQuery)(nil)
+
+// NewExecuteQuery returns a new ExecuteQuery node.
+func NewExecuteQuery(name string, using []sql.Expression) *ExecuteQuery {
+	return &ExecuteQuery{Name: name, Using: using}
+}
+
+// Resolved implements the sql.Node interface.
+func (e *ExecuteQuery) Resolved() bool { return expression.ExpressionsResolved(e.Using...) }
+
+// Schema implements the sql.Node interface.
+func (e *ExecuteQuery) Schema() sql.Schema { return e.rowIterSch }
+
+// Children implements the sql.Node interface.
+func (e *ExecuteQuery) Children() []sql.Node { return nil }
+
+// WithChildren implements the sql.Node interface.
+func (e *ExecuteQuery) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(e, children...)
+}
+
+// Expressions implements the sql.Expressioner interface.
+func (e *ExecuteQuery) Expressions() []sql.Expression { return e.Using }
+
+// WithExpressions implements the sql.Expressioner interface.
+func (e *ExecuteQuery) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != len(e.Using) {
+		return nil, sql.ErrInvalidChildrenNumber.New(e, len(exprs), len(e.Using))
+	}
+	ne := *e
+	ne.Using = exprs
+	return &ne, nil
+}
+
+func (e *ExecuteQuery) String() string {
+	if len(e.Using) == 0 {
+		return fmt.Sprintf("Execute(%s)", e.Name)
+	}
+	var using []string
+	for _, u := range e.Using {
+		using = append(using, u.String())
+	}
+	return fmt.Sprintf("Execute(%s using %s)", e.Name, strings.Join(using, ", "))
+}
+
+// RowIter implements the sql.Node interface.
+func (e *ExecuteQuery) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	stmt, ok := sql.SessionPreparedStatement(ctx, e.Name)
+	if !ok {
+		return nil, sql.ErrUnknownPreparedStatement.New(e.Name, "EXECUTE")
+	}
+	if len(e.Using) != stmt.Params {
+		return nil, sql.ErrWrongArgumentsToExecute.New()
+	}
+
+	bindings := make(map[string]sql.Expression, len(e.Using))
+	for i, u := range e.Using {
+		lit, err := bindingValue(ctx, u, row)
+		if err != nil {
+			return nil, err
+		}
+		// Parameters are named as the parser names the placeholders of the statement
+		bindings[fmt.Sprintf("v%d", i+1)] = lit
+	}
+
+	bound, err := ApplyBindings(ctx, stmt.Node, bindings)
+	if err != nil {
+		return nil, err
+	}
+	analyzed, err := e.Builder.Analyze(ctx, bound)
+	if err != nil {
+		return nil, err
+	}
+	iter, err := analyzed.RowIter(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	e.rowIterSch = analyzed.Schema()
+	return &executeIter{RowIter: iter, node: analyzed}, nil
+}
+
+// bindingValue returns the value of an expression given to EXECUTE as a literal. User variables have the type of the
+// value they hold.
+func bindingValue(ctx *sql.Context, e sql.Expression, row sql.Row) (sql.Expression, error) {
+	if uv, ok := e.(*expression.UserVar); ok {
+		typ, val, err := ctx.GetUserVariable(ctx, uv.Name)
+		if err != nil {
+			return nil, err
+		}
+		return expression.NewLiteral(val, typ), nil
+	}
+	val, err := e.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	return expression.NewLiteral(val, e.Type()), nil
+}
+
+// executeIter is the sql.RowIter of an ExecuteQuery, which represents the statement it runs in blocks.
+type executeIter struct {
+	sql.RowIter
+	node sql.Node
+}
+
+var _ BlockRowIter = (*executeIter)(nil)
+
+// RepresentingNode implements the sql.BlockRowIter interface.
+func (i *executeIter) RepresentingNode() sql.Node {
+	return i.node
+}
+
+// Schema implements the sql.BlockRowIter interface.
+func (i *executeIter) Schema() sql.Schema {
+	return i.node.Schema()
+}
+
+// DeallocateQuery is the DEALLOCATE PREPARE statement, which removes a statement prepared with PREPARE.
+type DeallocateQuery struct {
+	Name string
+}
+
+var _ sql.Node = (*DeallocateQuery)(nil)
+
+// NewDeallocateQuery returns a new DeallocateQuery node.
+func NewDeallocateQuery(name string) *DeallocateQuery {
+	return &DeallocateQuery{Name: name}
+}
+
+// Resolved implements the sql.Node interface.
+func (d *DeallocateQuery) Resolved() bool { return true }
+
+// Schema implements the sql.Node interface.
+func (d *DeallocateQuery) Schema() sql.Schema { return sql.OkResultSchema }
+
+// Children implements the sql.Node interface.
+func (d *DeallocateQuery) Children() []sql.Node { return nil }
+
+// WithChildren implements the sql.Node interface.
+func (d *DeallocateQuery) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(d, children...)
+}
+
+func (d *DeallocateQuery) String() string {
+	return fmt.Sprintf("Deallocate(%s)", d.Name)
+}
+
+// RowIter implements the sql.Node interface.
+func (d *DeallocateQuery) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	session, ok := ctx.Session.(sql.PreparedStatementSession)
+	if !ok {
+		return nil, sql.ErrUnknownPreparedStatement.New(d.Name, "DEALLOCATE PREPARE")
+	}
+	if _, ok := session.GetPreparedStatement(d.Name); !ok {
+		return nil, sql.ErrUnknownPreparedStatement.New(d.Name, "DEALLOCATE PREPARE")
+	}
+	session.DeletePreparedStatement(d.Name)
+	return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+}
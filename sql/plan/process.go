@@ -426,7 +426,7 @@ func IsDDLNode(node sql.Node) bool {
 		*CreateProcedure, *DropProcedure,
 		*CreateForeignKey, *DropForeignKey,
 		*CreateCheck, *DropCheck,
-		*CreateTrigger, *DropTrigger, *AlterPK:
+		*CreateTrigger, *DropTrigger, *AlterPK, *AlterPartition:
 		return true
 	default:
 		return false
@@ -440,7 +440,8 @@ func IsShowNode(node sql.Node) bool {
 		*ShowDatabases, *ShowCreateDatabase,
 		*ShowColumns, *ShowIndexes,
 		*ShowProcessList, *ShowTableStatus,
-		*ShowVariables, *ShowWarnings:
+		*ShowVariables, *ShowStatus, *ShowWarnings,
+		*ShowBinaryLogs, *ShowBinlogEvents, *ShowMasterStatus, *ShowReplicaStatus:
 		return true
 	default:
 		return false
@@ -0,0 +1,119 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// PurgeBinaryLogs is the PURGE BINARY LOGS statement, removing the files of the binary log preceding a file, or last
+// written to before a time.
+type PurgeBinaryLogs struct {
+	Catalog *sql.Catalog
+	// To is the name of the first file kept, if Before is nil.
+	To string
+	// Before is the time before which the files last written to are removed.
+	Before sql.Expression
+}
+
+var _ sql.Node = (*PurgeBinaryLogs)(nil)
+var _ sql.Expressioner = (*PurgeBinaryLogs)(nil)
+
+// NewPurgeBinaryLogsTo returns a new PurgeBinaryLogs node removing the files preceding the one with the name given.
+func NewPurgeBinaryLogsTo(to string) *PurgeBinaryLogs {
+	return &PurgeBinaryLogs{To: to}
+}
+
+// NewPurgeBinaryLogsBefore returns a new PurgeBinaryLogs node removing the files last written to before the time given.
+func NewPurgeBinaryLogsBefore(before sql.Expression) *PurgeBinaryLogs {
+	return &PurgeBinaryLogs{Before: before}
+}
+
+// Resolved implements the sql.Node interface.
+func (p *PurgeBinaryLogs) Resolved() bool {
+	return p.Before == nil || p.Before.Resolved()
+}
+
+// Schema implements the sql.Node interface.
+func (p *PurgeBinaryLogs) Schema() sql.Schema { return sql.OkResultSchema }
+
+// Children implements the sql.Node interface.
+func (p *PurgeBinaryLogs) Children() []sql.Node { return nil }
+
+// WithChildren implements the sql.Node interface.
+func (p *PurgeBinaryLogs) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(p, children...)
+}
+
+// Expressions implements the sql.Expressioner interface.
+func (p *PurgeBinaryLogs) Expressions() []sql.Expression {
+	if p.Before == nil {
+		return nil
+	}
+	return []sql.Expression{p.Before}
+}
+
+// WithExpressions implements the sql.Expressioner interface.
+func (p *PurgeBinaryLogs) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != len(p.Expressions()) {
+		return nil, sql.ErrInvalidChildrenNumber.New(p, len(exprs), len(p.Expressions()))
+	}
+	np := *p
+	if len(exprs) > 0 {
+		np.Before = exprs[0]
+	}
+	return &np, nil
+}
+
+func (p *PurgeBinaryLogs) String() string {
+	if p.Before != nil {
+		return fmt.Sprintf("PurgeBinaryLogs(before %s)", p.Before)
+	}
+	return fmt.Sprintf("PurgeBinaryLogs(to %q)", p.To)
+}
+
+// RowIter implements the sql.Node interface.
+func (p *PurgeBinaryLogs) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	binlog := p.Catalog.Binlog
+	if binlog == nil {
+		return nil, sql.ErrNoBinaryLogging.New()
+	}
+
+	if p.Before == nil {
+		if err := binlog.PurgeTo(p.To); err != nil {
+			return nil, err
+		}
+		return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+	}
+
+	val, err := p.Before.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	before, err := sql.Datetime.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	if before == nil {
+		return nil, sql.ErrInvalidArgument.New("PURGE BINARY LOGS BEFORE")
+	}
+	if err := binlog.PurgeBefore(before.(time.Time)); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+}
@@ -0,0 +1,250 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// ReplicationOption is an option set by CHANGE REPLICATION SOURCE TO, such as SOURCE_HOST.
+type ReplicationOption struct {
+	Name  string
+	Value interface{}
+}
+
+// ChangeReplicationSource is the CHANGE REPLICATION SOURCE TO statement, which sets options of the source of the
+// replica. Options which aren't set keep their value.
+type ChangeReplicationSource struct {
+	Catalog *sql.Catalog
+	Options []ReplicationOption
+}
+
+var _ sql.Node = (*ChangeReplicationSource)(nil)
+
+// NewChangeReplicationSource returns a new ChangeReplicationSource node.
+func NewChangeReplicationSource(options []ReplicationOption) *ChangeReplicationSource {
+	return &ChangeReplicationSource{Options: options}
+}
+
+// Resolved implements the sql.Node interface.
+func (c *ChangeReplicationSource) Resolved() bool { return true }
+
+// Schema implements the sql.Node interface.
+func (c *ChangeReplicationSource) Schema() sql.Schema { return sql.OkResultSchema }
+
+// Children implements the sql.Node interface.
+func (c *ChangeReplicationSource) Children() []sql.Node { return nil }
+
+// WithChildren implements the sql.Node interface.
+func (c *ChangeReplicationSource) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(c, children...)
+}
+
+func (c *ChangeReplicationSource) String() string {
+	options := make([]string, len(c.Options))
+	for i, o := range c.Options {
+		// The password isn't shown
+		if strings.HasSuffix(strings.ToUpper(o.Name), "_PASSWORD") {
+			options[i] = fmt.Sprintf("%s = ***", o.Name)
+		} else {
+			options[i] = fmt.Sprintf("%s = %v", o.Name, o.Value)
+		}
+	}
+	return fmt.Sprintf("ChangeReplicationSource(%s)", strings.Join(options, ", "))
+}
+
+// RowIter implements the sql.Node interface.
+func (c *ChangeReplicationSource) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	replica := c.Catalog.Replica
+	if replica == nil {
+		return nil, sql.ErrReplicationNotSupported.New()
+	}
+	source := replica.Source()
+	for _, o := range c.Options {
+		if err := source.Set(o.Name, o.Value); err != nil {
+			return nil, err
+		}
+	}
+	if err := replica.SetSource(ctx, source); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+}
+
+// StartReplica is the START REPLICA statement.
+type StartReplica struct {
+	Catalog *sql.Catalog
+}
+
+var _ sql.Node = (*StartReplica)(nil)
+
+// NewStartReplica returns a new StartReplica node.
+func NewStartReplica() *StartReplica {
+	return &StartReplica{}
+}
+
+// Resolved implements the sql.Node interface.
+func (s *StartReplica) Resolved() bool { return true }
+
+// Schema implements the sql.Node interface.
+func (s *StartReplica) Schema() sql.Schema { return sql.OkResultSchema }
+
+// Children implements the sql.Node interface.
+func (s *StartReplica) Children() []sql.Node { return nil }
+
+// WithChildren implements the sql.Node interface.
+func (s *StartReplica) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(s, children...)
+}
+
+func (s *StartReplica) String() string { return "StartReplica" }
+
+// RowIter implements the sql.Node interface.
+func (s *StartReplica) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	if s.Catalog.Replica == nil {
+		return nil, sql.ErrReplicationNotSupported.New()
+	}
+	if err := s.Catalog.Replica.Start(ctx); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+}
+
+// StopReplica is the STOP REPLICA statement.
+type StopReplica struct {
+	Catalog *sql.Catalog
+}
+
+var _ sql.Node = (*StopReplica)(nil)
+
+// NewStopReplica returns a new StopReplica node.
+func NewStopReplica() *StopReplica {
+	return &StopReplica{}
+}
+
+// Resolved implements the sql.Node interface.
+func (s *StopReplica) Resolved() bool { return true }
+
+// Schema implements the sql.Node interface.
+func (s *StopReplica) Schema() sql.Schema { return sql.OkResultSchema }
+
+// Children implements the sql.Node interface.
+func (s *StopReplica) Children() []sql.Node { return nil }
+
+// WithChildren implements the sql.Node interface.
+func (s *StopReplica) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(s, children...)
+}
+
+func (s *StopReplica) String() string { return "StopReplica" }
+
+// RowIter implements the sql.Node interface.
+func (s *StopReplica) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	if s.Catalog.Replica == nil {
+		return nil, sql.ErrReplicationNotSupported.New()
+	}
+	if err := s.Catalog.Replica.Stop(ctx); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+}
+
+var showReplicaStatusSchema = sql.Schema{
+	{Name: "Replica_IO_State", Type: sql.LongText},
+	{Name: "Source_Host", Type: sql.LongText},
+	{Name: "Source_User", Type: sql.LongText},
+	{Name: "Source_Port", Type: sql.Uint16},
+	{Name: "Connect_Retry", Type: sql.Uint32},
+	{Name: "Source_Log_File", Type: sql.LongText},
+	{Name: "Read_Source_Log_Pos", Type: sql.Uint64},
+	{Name: "Relay_Source_Log_File", Type: sql.LongText},
+	{Name: "Exec_Source_Log_Pos", Type: sql.Uint64},
+	{Name: "Replica_IO_Running", Type: sql.LongText},
+	{Name: "Replica_SQL_Running", Type: sql.LongText},
+	{Name: "Last_IO_Error", Type: sql.LongText},
+	{Name: "Last_SQL_Error", Type: sql.LongText},
+	{Name: "Source_Server_Id", Type: sql.Uint32},
+}
+
+// ShowReplicaStatus is the SHOW REPLICA STATUS statement. It has no rows unless the source of the replica was set.
+type ShowReplicaStatus struct {
+	Catalog *sql.Catalog
+}
+
+var _ sql.Node = (*ShowReplicaStatus)(nil)
+
+// NewShowReplicaStatus returns a new ShowReplicaStatus node.
+func NewShowReplicaStatus() *ShowReplicaStatus {
+	return &ShowReplicaStatus{}
+}
+
+// Resolved implements the sql.Node interface.
+func (s *ShowReplicaStatus) Resolved() bool { return true }
+
+// Schema implements the sql.Node interface.
+func (s *ShowReplicaStatus) Schema() sql.Schema { return showReplicaStatusSchema }
+
+// Children implements the sql.Node interface.
+func (s *ShowReplicaStatus) Children() []sql.Node { return nil }
+
+// WithChildren implements the sql.Node interface.
+func (s *ShowReplicaStatus) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(s, children...)
+}
+
+func (s *ShowReplicaStatus) String() string { return "ShowReplicaStatus" }
+
+// RowIter implements the sql.Node interface.
+func (s *ShowReplicaStatus) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	if s.Catalog.Replica == nil {
+		return sql.RowsToRowIter(), nil
+	}
+	status := s.Catalog.Replica.Status()
+	source := status.Source
+	if source.Host == "" {
+		return sql.RowsToRowIter(), nil
+	}
+
+	state := ""
+	if status.IORunning {
+		state = "Waiting for source to send event"
+	}
+	return sql.RowsToRowIter(sql.NewRow(
+		state,
+		source.Host,
+		source.User,
+		source.Port,
+		source.ConnectRetry,
+		status.ReadLogFile,
+		status.ReadLogPos,
+		status.ExecLogFile,
+		status.ExecLogPos,
+		yesNo(status.IORunning),
+		yesNo(status.SQLRunning),
+		status.LastIOError,
+		status.LastSQLError,
+		status.SourceServerID,
+	)), nil
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "Yes"
+	}
+	return "No"
+}
@@ -54,6 +54,11 @@ func (*ResolvedTable) Children() []sql.Node { return nil }
 func (t *ResolvedTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	span, ctx := ctx.Span("plan.ResolvedTable")
 
+	// Tables without a database are the dual table, which has nothing to scan
+	if t.Database != nil {
+		ctx.SetFullScan()
+	}
+
 	partitions, err := t.Table.Partitions(ctx)
 	if err != nil {
 		span.Finish()
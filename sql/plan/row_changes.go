@@ -0,0 +1,234 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// rowChangeRecorder adds the rows changed through a table editor to the pending changes of the session, discarding the
+// ones of a statement when its changes are.
+type rowChangeRecorder struct {
+	changes  *sql.PendingChanges
+	database string
+	table    string
+	schema   sql.Schema
+	// start is the number of pending changes when the statement began.
+	start int
+}
+
+// newRowChangeRecorder returns a recorder of the changes made to the table given, or nil if the pending changes of the
+// session aren't recording.
+func newRowChangeRecorder(ctx *sql.Context, database string, table sql.Table) *rowChangeRecorder {
+	if ctx.Session == nil || !sql.SessionPendingChanges(ctx).Recording() {
+		return nil
+	}
+	if database == "" {
+		database = ctx.GetCurrentDatabase()
+	}
+	changes := sql.SessionPendingChanges(ctx)
+	return &rowChangeRecorder{
+		changes:  changes,
+		database: database,
+		table:    table.Name(),
+		schema:   table.Schema(),
+		start:    changes.Len(),
+	}
+}
+
+func (r *rowChangeRecorder) statementBegin() {
+	r.start = r.changes.Len()
+}
+
+func (r *rowChangeRecorder) discardChanges() {
+	r.changes.Truncate(r.start)
+}
+
+func (r *rowChangeRecorder) record(old, new sql.Row) {
+	r.changes.Add(sql.RowChange{
+		Database: r.database,
+		Table:    r.table,
+		Schema:   r.schema,
+		Old:      old,
+		New:      new,
+	})
+}
+
+// recordReset records that every row of the table given, or of the partitions given if there are some, was removed, if
+// the pending changes of the session are recording.
+func recordReset(ctx *sql.Context, database string, table sql.Table, partitions []string) {
+	if recorder := newRowChangeRecorder(ctx, database, table); recorder != nil {
+		recorder.changes.CommitReset(sql.RowChange{
+			Database:   recorder.database,
+			Table:      recorder.table,
+			Schema:     recorder.schema,
+			Reset:      true,
+			Partitions: partitions,
+		})
+	}
+}
+
+// recordingInserter records the rows inserted by a sql.RowInserter.
+type recordingInserter struct {
+	sql.RowInserter
+	recorder *rowChangeRecorder
+}
+
+// recordInserts returns the inserter given, recording the rows it inserts into the table given if the pending changes
+// of the session are recording.
+func recordInserts(ctx *sql.Context, database string, table sql.Table, inserter sql.RowInserter) sql.RowInserter {
+	if recorder := newRowChangeRecorder(ctx, database, table); recorder != nil {
+		return &recordingInserter{inserter, recorder}
+	}
+	return inserter
+}
+
+// StatementBegin implements the interface sql.TableEditor.
+func (i *recordingInserter) StatementBegin(ctx *sql.Context) {
+	i.recorder.statementBegin()
+	i.RowInserter.StatementBegin(ctx)
+}
+
+// DiscardChanges implements the interface sql.TableEditor.
+func (i *recordingInserter) DiscardChanges(ctx *sql.Context, errorEncountered error) error {
+	i.recorder.discardChanges()
+	return i.RowInserter.DiscardChanges(ctx, errorEncountered)
+}
+
+// Insert implements the interface sql.RowInserter.
+func (i *recordingInserter) Insert(ctx *sql.Context, row sql.Row) error {
+	if err := i.RowInserter.Insert(ctx, row); err != nil {
+		return err
+	}
+	i.recorder.record(nil, row)
+	return nil
+}
+
+// recordingUpdater records the rows updated by a sql.RowUpdater.
+type recordingUpdater struct {
+	sql.RowUpdater
+	recorder *rowChangeRecorder
+}
+
+// recordUpdates returns the updater given, recording the rows it updates in the table given if the pending changes of
+// the session are recording.
+func recordUpdates(ctx *sql.Context, database string, table sql.Table, updater sql.RowUpdater) sql.RowUpdater {
+	if recorder := newRowChangeRecorder(ctx, database, table); recorder != nil {
+		return &recordingUpdater{updater, recorder}
+	}
+	return updater
+}
+
+// StatementBegin implements the interface sql.TableEditor.
+func (u *recordingUpdater) StatementBegin(ctx *sql.Context) {
+	u.recorder.statementBegin()
+	u.RowUpdater.StatementBegin(ctx)
+}
+
+// DiscardChanges implements the interface sql.TableEditor.
+func (u *recordingUpdater) DiscardChanges(ctx *sql.Context, errorEncountered error) error {
+	u.recorder.discardChanges()
+	return u.RowUpdater.DiscardChanges(ctx, errorEncountered)
+}
+
+// Update implements the interface sql.RowUpdater.
+func (u *recordingUpdater) Update(ctx *sql.Context, old sql.Row, new sql.Row) error {
+	if err := u.RowUpdater.Update(ctx, old, new); err != nil {
+		return err
+	}
+	u.recorder.record(old, new)
+	return nil
+}
+
+// recordingDeleter records the rows deleted by a sql.RowDeleter.
+type recordingDeleter struct {
+	sql.RowDeleter
+	recorder *rowChangeRecorder
+}
+
+// recordDeletes returns the deleter given, recording the rows it deletes from the table given if the pending changes
+// of the session are recording.
+func recordDeletes(ctx *sql.Context, database string, table sql.Table, deleter sql.RowDeleter) sql.RowDeleter {
+	if recorder := newRowChangeRecorder(ctx, database, table); recorder != nil {
+		return &recordingDeleter{deleter, recorder}
+	}
+	return deleter
+}
+
+// StatementBegin implements the interface sql.TableEditor.
+func (d *recordingDeleter) StatementBegin(ctx *sql.Context) {
+	d.recorder.statementBegin()
+	d.RowDeleter.StatementBegin(ctx)
+}
+
+// DiscardChanges implements the interface sql.TableEditor.
+func (d *recordingDeleter) DiscardChanges(ctx *sql.Context, errorEncountered error) error {
+	d.recorder.discardChanges()
+	return d.RowDeleter.DiscardChanges(ctx, errorEncountered)
+}
+
+// Delete implements the interface sql.RowDeleter.
+func (d *recordingDeleter) Delete(ctx *sql.Context, row sql.Row) error {
+	if err := d.RowDeleter.Delete(ctx, row); err != nil {
+		return err
+	}
+	d.recorder.record(row, nil)
+	return nil
+}
+
+// recordingReplacer records the rows deleted and inserted by a sql.RowReplacer.
+type recordingReplacer struct {
+	sql.RowReplacer
+	recorder *rowChangeRecorder
+}
+
+// recordReplaces returns the replacer given, recording the rows it deletes from and inserts into the table given if
+// the pending changes of the session are recording.
+func recordReplaces(ctx *sql.Context, database string, table sql.Table, replacer sql.RowReplacer) sql.RowReplacer {
+	if recorder := newRowChangeRecorder(ctx, database, table); recorder != nil {
+		return &recordingReplacer{replacer, recorder}
+	}
+	return replacer
+}
+
+// StatementBegin implements the interface sql.TableEditor.
+func (r *recordingReplacer) StatementBegin(ctx *sql.Context) {
+	r.recorder.statementBegin()
+	r.RowReplacer.StatementBegin(ctx)
+}
+
+// DiscardChanges implements the interface sql.TableEditor.
+func (r *recordingReplacer) DiscardChanges(ctx *sql.Context, errorEncountered error) error {
+	r.recorder.discardChanges()
+	return r.RowReplacer.DiscardChanges(ctx, errorEncountered)
+}
+
+// Insert implements the interface sql.RowReplacer.
+func (r *recordingReplacer) Insert(ctx *sql.Context, row sql.Row) error {
+	if err := r.RowReplacer.Insert(ctx, row); err != nil {
+		return err
+	}
+	r.recorder.record(nil, row)
+	return nil
+}
+
+// Delete implements the interface sql.RowReplacer.
+func (r *recordingReplacer) Delete(ctx *sql.Context, row sql.Row) error {
+	if err := r.RowReplacer.Delete(ctx, row); err != nil {
+		return err
+	}
+	r.recorder.record(row, nil)
+	return nil
+}
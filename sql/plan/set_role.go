@@ -0,0 +1,139 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// SetRoleType is the kind of SET ROLE statement.
+type SetRoleType byte
+
+const (
+	// SetRoleType_Default activates the user's default roles. Default roles aren't supported yet, so this is the same
+	// as SetRoleType_None.
+	SetRoleType_Default SetRoleType = iota
+	// SetRoleType_None deactivates every role.
+	SetRoleType_None
+	// SetRoleType_All activates every role granted to the user.
+	SetRoleType_All
+	// SetRoleType_AllExcept activates every role granted to the user except those listed.
+	SetRoleType_AllExcept
+	// SetRoleType_List activates the roles listed.
+	SetRoleType_List
+)
+
+// SetRole changes the roles which are active in the current session.
+type SetRole struct {
+	Catalog *sql.Catalog
+	Type    SetRoleType
+	Roles   []sql.UserIdentity
+}
+
+var _ sql.Node = (*SetRole)(nil)
+
+// NewSetRole returns a new SetRole node.
+func NewSetRole(typ SetRoleType, roles []sql.UserIdentity) *SetRole {
+	return &SetRole{
+		Type:  typ,
+		Roles: roles,
+	}
+}
+
+// Resolved implements the sql.Node interface.
+func (n *SetRole) Resolved() bool { return true }
+
+// Schema implements the sql.Node interface.
+func (n *SetRole) Schema() sql.Schema { return sql.OkResultSchema }
+
+// Children implements the sql.Node interface.
+func (n *SetRole) Children() []sql.Node { return nil }
+
+// WithChildren implements the sql.Node interface.
+func (n *SetRole) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(n, children...)
+}
+
+func (n *SetRole) String() string {
+	switch n.Type {
+	case SetRoleType_Default:
+		return "SetRole(default)"
+	case SetRoleType_None:
+		return "SetRole(none)"
+	case SetRoleType_All:
+		return "SetRole(all)"
+	case SetRoleType_AllExcept:
+		return fmt.Sprintf("SetRole(all except %s)", identitiesString(n.Roles))
+	default:
+		return fmt.Sprintf("SetRole(%s)", identitiesString(n.Roles))
+	}
+}
+
+// RowIter implements the sql.Node interface.
+func (n *SetRole) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	session, ok := ctx.Session.(sql.RoleSession)
+	if !ok {
+		return nil, sql.ErrUnsupportedFeature.New("SET ROLE")
+	}
+	store, err := privilegeStore(n.Catalog)
+	if err != nil {
+		return nil, err
+	}
+
+	var active []sql.UserIdentity
+	if n.Type != SetRoleType_Default && n.Type != SetRoleType_None {
+		account, ok, err := sql.FindAccount(ctx, store, ctx.Client().User, ctx.Client().Address)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, sql.ErrAccountNotFound.New("SET ROLE", sql.NewUserIdentity(ctx.Client().User, ctx.Client().Address).Quoted())
+		}
+
+		edges, err := sql.RolesGrantedTo(ctx, store, account.UserIdentity)
+		if err != nil {
+			return nil, err
+		}
+		granted := make(map[sql.UserIdentity]bool)
+		for _, e := range edges {
+			granted[e.Role] = true
+		}
+
+		switch n.Type {
+		case SetRoleType_All, SetRoleType_AllExcept:
+			excluded := make(map[sql.UserIdentity]bool)
+			for _, r := range n.Roles {
+				excluded[r] = true
+			}
+			for _, e := range edges {
+				if !excluded[e.Role] {
+					active = append(active, e.Role)
+				}
+			}
+		case SetRoleType_List:
+			for _, r := range n.Roles {
+				if !granted[r] {
+					return nil, sql.ErrRoleNotGranted.New(r.Quoted(), account.Quoted())
+				}
+			}
+			active = n.Roles
+		}
+	}
+
+	session.SetActiveRoles(active)
+	return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+}
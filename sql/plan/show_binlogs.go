@@ -0,0 +1,197 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+var showBinaryLogsSchema = sql.Schema{
+	{Name: "Log_name", Type: sql.LongText},
+	{Name: "File_size", Type: sql.Uint64},
+	{Name: "Encrypted", Type: sql.LongText},
+}
+
+var showBinlogEventsSchema = sql.Schema{
+	{Name: "Log_name", Type: sql.LongText},
+	{Name: "Pos", Type: sql.Uint64},
+	{Name: "Event_type", Type: sql.LongText},
+	{Name: "Server_id", Type: sql.Uint32},
+	{Name: "End_log_pos", Type: sql.Uint64},
+	{Name: "Info", Type: sql.LongText},
+}
+
+var showMasterStatusSchema = sql.Schema{
+	{Name: "File", Type: sql.LongText},
+	{Name: "Position", Type: sql.Uint64},
+	{Name: "Binlog_Do_DB", Type: sql.LongText},
+	{Name: "Binlog_Ignore_DB", Type: sql.LongText},
+	{Name: "Executed_Gtid_Set", Type: sql.LongText},
+}
+
+// ShowBinaryLogs is the SHOW BINARY LOGS statement, listing the files of the binary log.
+type ShowBinaryLogs struct {
+	Catalog *sql.Catalog
+}
+
+var _ sql.Node = (*ShowBinaryLogs)(nil)
+
+// NewShowBinaryLogs returns a new ShowBinaryLogs node.
+func NewShowBinaryLogs() *ShowBinaryLogs {
+	return &ShowBinaryLogs{}
+}
+
+// Resolved implements the sql.Node interface.
+func (s *ShowBinaryLogs) Resolved() bool { return true }
+
+// Schema implements the sql.Node interface.
+func (s *ShowBinaryLogs) Schema() sql.Schema { return showBinaryLogsSchema }
+
+// Children implements the sql.Node interface.
+func (s *ShowBinaryLogs) Children() []sql.Node { return nil }
+
+// WithChildren implements the sql.Node interface.
+func (s *ShowBinaryLogs) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(s, children...)
+}
+
+func (s *ShowBinaryLogs) String() string { return "ShowBinaryLogs" }
+
+// RowIter implements the sql.Node interface.
+func (s *ShowBinaryLogs) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	if s.Catalog.Binlog == nil {
+		return nil, sql.ErrNoBinaryLogging.New()
+	}
+	var rows []sql.Row
+	for _, f := range s.Catalog.Binlog.Files() {
+		rows = append(rows, sql.NewRow(f.Name, f.Size, "No"))
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// ShowBinlogEvents is the SHOW BINLOG EVENTS statement, listing the events of a file of the binary log, or of its
+// first file if none is given.
+type ShowBinlogEvents struct {
+	Catalog *sql.Catalog
+	// File is the name of the file whose events are listed, or empty for the first file.
+	File string
+	// Pos is the position in the file of the first event listed.
+	Pos uint64
+	// Offset is the number of events skipped, and Limit the maximum number of events listed, or -1 for all of them.
+	Offset, Limit int64
+}
+
+var _ sql.Node = (*ShowBinlogEvents)(nil)
+
+// NewShowBinlogEvents returns a new ShowBinlogEvents node.
+func NewShowBinlogEvents(file string, pos uint64, offset, limit int64) *ShowBinlogEvents {
+	return &ShowBinlogEvents{File: file, Pos: pos, Offset: offset, Limit: limit}
+}
+
+// Resolved implements the sql.Node interface.
+func (s *ShowBinlogEvents) Resolved() bool { return true }
+
+// Schema implements the sql.Node interface.
+func (s *ShowBinlogEvents) Schema() sql.Schema { return showBinlogEventsSchema }
+
+// Children implements the sql.Node interface.
+func (s *ShowBinlogEvents) Children() []sql.Node { return nil }
+
+// WithChildren implements the sql.Node interface.
+func (s *ShowBinlogEvents) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(s, children...)
+}
+
+func (s *ShowBinlogEvents) String() string {
+	return fmt.Sprintf("ShowBinlogEvents(%q, %d)", s.File, s.Pos)
+}
+
+// RowIter implements the sql.Node interface.
+func (s *ShowBinlogEvents) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	binlog := s.Catalog.Binlog
+	if binlog == nil {
+		return nil, sql.ErrNoBinaryLogging.New()
+	}
+	file := s.File
+	if file == "" {
+		files := binlog.Files()
+		if len(files) == 0 {
+			return sql.RowsToRowIter(), nil
+		}
+		file = files[0].Name
+	}
+	events, err := binlog.Events(file, s.Pos)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Offset >= int64(len(events)) {
+		events = nil
+	} else {
+		events = events[s.Offset:]
+	}
+	if s.Limit >= 0 && s.Limit < int64(len(events)) {
+		events = events[:s.Limit]
+	}
+	rows := make([]sql.Row, len(events))
+	for i, ev := range events {
+		rows[i] = sql.NewRow(ev.LogName, ev.Pos, ev.EventType, ev.ServerID, ev.EndLogPos, ev.Info)
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// ShowMasterStatus is the SHOW MASTER STATUS statement, showing the position at which the next events are appended
+// to the binary log. Without a binary log, it has no rows.
+type ShowMasterStatus struct {
+	Catalog *sql.Catalog
+}
+
+var _ sql.Node = (*ShowMasterStatus)(nil)
+
+// NewShowMasterStatus returns a new ShowMasterStatus node.
+func NewShowMasterStatus() *ShowMasterStatus {
+	return &ShowMasterStatus{}
+}
+
+// Resolved implements the sql.Node interface.
+func (s *ShowMasterStatus) Resolved() bool { return true }
+
+// Schema implements the sql.Node interface.
+func (s *ShowMasterStatus) Schema() sql.Schema { return showMasterStatusSchema }
+
+// Children implements the sql.Node interface.
+func (s *ShowMasterStatus) Children() []sql.Node { return nil }
+
+// WithChildren implements the sql.Node interface.
+func (s *ShowMasterStatus) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(s, children...)
+}
+
+func (s *ShowMasterStatus) String() string { return "ShowMasterStatus" }
+
+// RowIter implements the sql.Node interface.
+func (s *ShowMasterStatus) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	if s.Catalog.Binlog == nil {
+		return sql.RowsToRowIter(), nil
+	}
+	files := s.Catalog.Binlog.Files()
+	if len(files) == 0 {
+		return sql.RowsToRowIter(), nil
+	}
+	last := files[len(files)-1]
+	return sql.RowsToRowIter(sql.NewRow(last.Name, last.Size, "", "", "")), nil
+}
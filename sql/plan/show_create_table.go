@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"unicode"
 
 	"gopkg.in/src-d/go-errors.v1"
 
@@ -136,7 +137,14 @@ func (i *showCreateTablesIter) Next() (sql.Row, error) {
 
 		tableName = table.Name()
 		var err error
-		composedCreateTableStatement, err = i.produceCreateTableStatement(table.Table)
+		var fks []sql.ForeignKeyConstraint
+		if fkt := getForeignKeyTable(table.Table); fkt != nil {
+			fks, err = fkt.GetForeignKeys(i.ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+		composedCreateTableStatement, err = CreateTableStatement(i.ctx, table.Table, i.indexes, fks, i.checks)
 		if err != nil {
 			return nil, err
 		}
@@ -158,7 +166,9 @@ type NameAndSchema interface {
 	Schema() sql.Schema
 }
 
-func (i *showCreateTablesIter) produceCreateTableStatement(table sql.Table) (string, error) {
+// CreateTableStatement returns the CREATE TABLE statement of the table given, declaring the indexes, foreign keys and
+// check constraints given along with its columns, primary key and partitioning.
+func CreateTableStatement(ctx *sql.Context, table sql.Table, indexes []sql.Index, fks []sql.ForeignKeyConstraint, checks sql.CheckConstraints) (string, error) {
 	schema := table.Schema()
 	colStmts := make([]string, len(schema))
 	var primaryKeyCols []string
@@ -166,12 +176,16 @@ func (i *showCreateTablesIter) produceCreateTableStatement(table sql.Table) (str
 	// Statement creation parts for each column
 	// TODO: rather than lower-casing here, we should do it in the String() method of types
 	for i, col := range schema {
-		stmt := fmt.Sprintf("  `%s` %s", col.Name, strings.ToLower(col.Type.String()))
+		stmt := fmt.Sprintf("  `%s` %s", col.Name, lowerUnquoted(col.Type.String()))
 
 		if !col.Nullable {
 			stmt = fmt.Sprintf("%s NOT NULL", stmt)
 		}
 
+		if srid := sql.SRIDComment(col.Type); srid != "" {
+			stmt = fmt.Sprintf("%s %s", stmt, srid)
+		}
+
 		if col.AutoIncrement {
 			stmt = fmt.Sprintf("%s AUTO_INCREMENT", stmt)
 		}
@@ -199,7 +213,7 @@ func (i *showCreateTablesIter) produceCreateTableStatement(table sql.Table) (str
 		colStmts = append(colStmts, primaryKey)
 	}
 
-	for _, index := range i.indexes {
+	for _, index := range indexes {
 		// The primary key may or may not be declared as an index by the table. Don't print it twice if it's here.
 		if isPrimaryKeyIndex(index, table) {
 			continue
@@ -213,12 +227,16 @@ func (i *showCreateTablesIter) produceCreateTableStatement(table sql.Table) (str
 			}
 		}
 
-		unique := ""
+		keyType := ""
 		if index.IsUnique() {
-			unique = "UNIQUE "
+			keyType = "UNIQUE "
+		} else if _, ok := index.(sql.SpatialIndex); ok {
+			keyType = "SPATIAL "
+		} else if _, ok := index.(sql.FullTextIndex); ok {
+			keyType = "FULLTEXT "
 		}
 
-		key := fmt.Sprintf("  %sKEY `%s` (%s)", unique, index.ID(), strings.Join(indexCols, ","))
+		key := fmt.Sprintf("  %sKEY `%s` (%s)", keyType, index.ID(), strings.Join(indexCols, ","))
 		if index.Comment() != "" {
 			key = fmt.Sprintf("%s COMMENT '%s'", key, index.Comment())
 		}
@@ -226,29 +244,22 @@ func (i *showCreateTablesIter) produceCreateTableStatement(table sql.Table) (str
 		colStmts = append(colStmts, key)
 	}
 
-	fkt := getForeignKeyTable(table)
-	if fkt != nil {
-		fks, err := fkt.GetForeignKeys(i.ctx)
-		if err != nil {
-			return "", err
+	for _, fk := range fks {
+		keyCols := strings.Join(quoteIdentifiers(fk.Columns), ",")
+		refCols := strings.Join(quoteIdentifiers(fk.ReferencedColumns), ",")
+		onDelete := ""
+		if len(fk.OnDelete) > 0 && fk.OnDelete != sql.ForeignKeyReferenceOption_DefaultAction {
+			onDelete = " ON DELETE " + string(fk.OnDelete)
 		}
-		for _, fk := range fks {
-			keyCols := strings.Join(quoteIdentifiers(fk.Columns), ",")
-			refCols := strings.Join(quoteIdentifiers(fk.ReferencedColumns), ",")
-			onDelete := ""
-			if len(fk.OnDelete) > 0 && fk.OnDelete != sql.ForeignKeyReferenceOption_DefaultAction {
-				onDelete = " ON DELETE " + string(fk.OnDelete)
-			}
-			onUpdate := ""
-			if len(fk.OnUpdate) > 0 && fk.OnUpdate != sql.ForeignKeyReferenceOption_DefaultAction {
-				onUpdate = " ON UPDATE " + string(fk.OnUpdate)
-			}
-			colStmts = append(colStmts, fmt.Sprintf("  CONSTRAINT `%s` FOREIGN KEY (%s) REFERENCES `%s` (%s)%s%s", fk.Name, keyCols, fk.ReferencedTable, refCols, onDelete, onUpdate))
+		onUpdate := ""
+		if len(fk.OnUpdate) > 0 && fk.OnUpdate != sql.ForeignKeyReferenceOption_DefaultAction {
+			onUpdate = " ON UPDATE " + string(fk.OnUpdate)
 		}
+		colStmts = append(colStmts, fmt.Sprintf("  CONSTRAINT `%s` FOREIGN KEY (%s) REFERENCES `%s` (%s)%s%s", fk.Name, keyCols, fk.ReferencedTable, refCols, onDelete, onUpdate))
 	}
 
-	if i.checks != nil {
-		for _, check := range i.checks {
+	if checks != nil {
+		for _, check := range checks {
 			fmted := fmt.Sprintf("  CONSTRAINT `%s` CHECK %s", check.Name, check.Expr.String())
 
 			if !check.Enforced {
@@ -259,11 +270,36 @@ func (i *showCreateTablesIter) produceCreateTableStatement(table sql.Table) (str
 		}
 	}
 
-	return fmt.Sprintf(
+	stmt := fmt.Sprintf(
 		"CREATE TABLE `%s` (\n%s\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
 		table.Name(),
 		strings.Join(colStmts, ",\n"),
-	), nil
+	)
+
+	if pt := getPartitionedTable(table); pt != nil {
+		partitioning, err := pt.GetPartitioning(ctx)
+		if err != nil {
+			return "", err
+		}
+		if partitioning != nil {
+			stmt = fmt.Sprintf("%s\n%s", stmt, partitioning.String())
+		}
+	}
+
+	return stmt, nil
+}
+
+// getPartitionedTable returns the underlying PartitionedTable for the table given, or nil if it isn't a
+// PartitionedTable
+func getPartitionedTable(t sql.Table) sql.PartitionedTable {
+	switch t := t.(type) {
+	case sql.PartitionedTable:
+		return t
+	case sql.TableWrapper:
+		return getPartitionedTable(t.Underlying())
+	default:
+		return nil
+	}
 }
 
 // getForeignKeyTable returns the underlying ForeignKeyTable for the table given, or nil if it isn't a ForeignKeyTable
@@ -331,3 +367,24 @@ func produceCreateViewStatement(view *SubqueryAlias) string {
 func (i *showCreateTablesIter) Close(*sql.Context) error {
 	return nil
 }
+
+// lowerUnquoted returns the string given in lower case, except for its quoted strings, such as the values of enum and
+// set types, which are left unchanged.
+func lowerUnquoted(s string) string {
+	var sb strings.Builder
+	var quote rune
+	for _, r := range s {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"':
+			quote = r
+		default:
+			r = unicode.ToLower(r)
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
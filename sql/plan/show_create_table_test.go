@@ -201,3 +201,13 @@ func TestShowCreateView(t *testing.T) {
 
 	require.Equal(expected, row)
 }
+
+func TestCreateTableStatementKeepsQuotedValues(t *testing.T) {
+	table := memory.NewTable("t", sql.Schema{
+		&sql.Column{Name: "e", Type: sql.MustCreateEnumType([]string{"Small", "LARGE"}, sql.Collation_Default), Nullable: true},
+	})
+
+	stmt, err := CreateTableStatement(sql.NewEmptyContext(), table, nil, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "CREATE TABLE `t` (\n  `e` enum('Small','LARGE')\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4", stmt)
+}
@@ -1,4 +1,4 @@
-// Copyright 2021 Dolthub, Inc.
+// Copyright 2020-2021 Dolthub, Inc.
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -15,27 +15,40 @@
 package plan
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/linanh/go-mysql-server/sql"
 )
 
-// ShowGrants shows the columns details of a table.
-type ShowGrants struct{}
-
-var (
-	showGrantsSchema = sql.Schema{
-		{Name: "Grants for root@", Type: sql.LongText},
-	}
-)
+// ShowGrants shows the privileges granted to a user.
+type ShowGrants struct {
+	Catalog *sql.Catalog
+	// For is the user named in SHOW GRANTS FOR, or nil for the current user.
+	For *sql.UserIdentity
+	// Using holds the roles named in SHOW GRANTS FOR ... USING, whose privileges are included in the output.
+	Using []sql.UserIdentity
+}
 
 // NewShowGrants creates a new ShowGrants node.
 func NewShowGrants() *ShowGrants {
 	return &ShowGrants{}
 }
 
+// NewShowGrantsFor creates a new ShowGrants node for the user given, including the privileges of the roles given.
+func NewShowGrantsFor(user sql.UserIdentity, using []sql.UserIdentity) *ShowGrants {
+	return &ShowGrants{For: &user, Using: using}
+}
+
 // Schema implements the sql.Node interface.
 func (s *ShowGrants) Schema() sql.Schema {
+	name := "root@%"
+	if s.For != nil {
+		name = s.For.User + "@" + s.For.Host
+	}
 	return sql.Schema{{
-		Name: "Grants for root@%",
+		Name: "Grants for " + name,
 		Type: sql.LongText,
 	}}
 }
@@ -44,21 +57,169 @@ func (s *ShowGrants) Schema() sql.Schema {
 func (s *ShowGrants) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	span, _ := ctx.Span("plan.ShowGrants")
 
-	rows := []sql.Row{
-		sql.Row{"GRANT ALL PRIVILEGES ON *.* TO 'root'@'%' WITH GRANT OPTION"},
+	// Without a privilege store, everybody is root
+	if s.Catalog == nil || s.Catalog.PrivilegeStore == nil {
+		rows := []sql.Row{
+			sql.Row{"GRANT ALL PRIVILEGES ON *.* TO 'root'@'%' WITH GRANT OPTION"},
+		}
+		return sql.NewSpanIter(span, sql.RowsToRowIter(rows...)), nil
+	}
+
+	store := s.Catalog.PrivilegeStore
+	var user sql.UserIdentity
+	if s.For != nil {
+		user = *s.For
+		_, exists, err := store.Account(ctx, user)
+		if err != nil {
+			span.Finish()
+			return nil, err
+		}
+		if !exists {
+			span.Finish()
+			return nil, sql.ErrNoGrantFound.New(user.User, user.Host)
+		}
+	} else {
+		account, ok, err := sql.FindAccount(ctx, store, ctx.Client().User, ctx.Client().Address)
+		if err != nil {
+			span.Finish()
+			return nil, err
+		}
+		if !ok {
+			span.Finish()
+			return nil, sql.ErrNoGrantFound.New(ctx.Client().User, ctx.Client().Address)
+		}
+		user = account.UserIdentity
+	}
+
+	rows, err := grantStatements(ctx, store, user, s.Using)
+	if err != nil {
+		span.Finish()
+		return nil, err
 	}
 
 	return sql.NewSpanIter(span, sql.RowsToRowIter(rows...)), nil
 }
 
+// grantStatements returns the GRANT statements which would recreate the privileges and roles of the user given, along
+// with the privileges of the roles given.
+func grantStatements(ctx *sql.Context, store sql.PrivilegeStore, user sql.UserIdentity, using []sql.UserIdentity) ([]sql.Row, error) {
+	// Privileges on columns are displayed along with those of their table, so group everything by table
+	type tableGrant struct {
+		target  sql.PrivilegeTarget
+		privs   sql.PrivilegeType
+		columns map[sql.PrivilegeType][]string
+	}
+	byTarget := map[sql.PrivilegeTarget]*tableGrant{
+		{}: {columns: map[sql.PrivilegeType][]string{}},
+	}
+
+	for _, id := range append([]sql.UserIdentity{user}, using...) {
+		grants, err := sql.GrantsFor(ctx, store, id)
+		if err != nil {
+			return nil, err
+		}
+		for _, g := range grants {
+			target := g.Target
+			target.Column = ""
+			tg, ok := byTarget[target]
+			if !ok {
+				tg = &tableGrant{target: target, columns: map[sql.PrivilegeType][]string{}}
+				byTarget[target] = tg
+			}
+			if g.Target.Column == "" {
+				tg.privs |= g.Privileges
+				continue
+			}
+			for _, name := range g.Privileges.Names() {
+				p, _ := sql.ParsePrivilegeType(name)
+				tg.columns[p] = append(tg.columns[p], g.Target.Column)
+			}
+		}
+	}
+
+	targets := make([]sql.PrivilegeTarget, 0, len(byTarget))
+	for target := range byTarget {
+		targets = append(targets, target)
+	}
+	sort.Slice(targets, func(i, j int) bool {
+		if targets[i].Database != targets[j].Database {
+			return targets[i].Database < targets[j].Database
+		}
+		return targets[i].Table < targets[j].Table
+	})
+
+	var rows []sql.Row
+	for _, target := range targets {
+		tg := byTarget[target]
+		var privs []string
+		for _, name := range (tg.privs &^ sql.PrivilegeType_GrantOption).Names() {
+			p, _ := sql.ParsePrivilegeType(name)
+			if cols, ok := tg.columns[p]; ok {
+				sort.Strings(cols)
+				name = fmt.Sprintf("%s (`%s`)", name, strings.Join(cols, "`, `"))
+				delete(tg.columns, p)
+			}
+			privs = append(privs, name)
+		}
+		for _, name := range sql.PrivilegeType_Column.Names() {
+			p, _ := sql.ParsePrivilegeType(name)
+			if cols, ok := tg.columns[p]; ok {
+				sort.Strings(cols)
+				privs = append(privs, fmt.Sprintf("%s (`%s`)", name, strings.Join(cols, "`, `")))
+			}
+		}
+
+		if len(privs) == 0 {
+			if target.Database != "" {
+				continue
+			}
+			privs = []string{"USAGE"}
+		}
+		if tg.privs&^sql.PrivilegeType_GrantOption == sql.PrivilegeType_All && target.Database == "" {
+			privs = []string{"ALL PRIVILEGES"}
+		}
+
+		stmt := fmt.Sprintf("GRANT %s ON %s TO %s", strings.Join(privs, ", "), target, user)
+		if tg.privs.Has(sql.PrivilegeType_GrantOption) {
+			stmt += " WITH GRANT OPTION"
+		}
+		rows = append(rows, sql.Row{stmt})
+	}
+
+	edges, err := sql.RolesGrantedTo(ctx, store, user)
+	if err != nil {
+		return nil, err
+	}
+	var roles, adminRoles []string
+	for _, e := range edges {
+		if e.AdminOption {
+			adminRoles = append(adminRoles, e.Role.String())
+		} else {
+			roles = append(roles, e.Role.String())
+		}
+	}
+	if len(roles) > 0 {
+		rows = append(rows, sql.Row{fmt.Sprintf("GRANT %s TO %s", strings.Join(roles, ","), user)})
+	}
+	if len(adminRoles) > 0 {
+		rows = append(rows, sql.Row{fmt.Sprintf("GRANT %s TO %s WITH ADMIN OPTION", strings.Join(adminRoles, ","), user)})
+	}
+
+	return rows, nil
+}
+
 // WithChildren implements the Node interface.
 func (s *ShowGrants) WithChildren(children ...sql.Node) (sql.Node, error) {
-	return NewShowGrants(), nil
+	return NillaryWithChildren(s, children...)
 }
 
 func (s *ShowGrants) String() string {
 	p := sql.NewTreePrinter()
-	_ = p.WriteNode("ShowGrants")
+	if s.For != nil {
+		_ = p.WriteNode("ShowGrants(%s)", s.For.Quoted())
+	} else {
+		_ = p.WriteNode("ShowGrants")
+	}
 	return p.String()
 }
 
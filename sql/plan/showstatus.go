@@ -0,0 +1,122 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/linanh/go-mysql-server/sql"
+	"github.com/linanh/go-mysql-server/sql/expression"
+)
+
+// ShowStatus is a node that shows the global or session values of the status variables.
+type ShowStatus struct {
+	pattern string
+	global  bool
+}
+
+// NewShowStatus returns a new ShowStatus reference, which shows the global values of the status variables if global
+// is true, or their session values otherwise. like is a "like pattern". If like is an empty string it will return all
+// variables.
+func NewShowStatus(like string, global bool) *ShowStatus {
+	return &ShowStatus{
+		pattern: like,
+		global:  global,
+	}
+}
+
+// Resolved implements sql.Node interface. The function always returns true.
+func (s *ShowStatus) Resolved() bool {
+	return true
+}
+
+// WithChildren implements the Node interface.
+func (s *ShowStatus) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 0)
+	}
+
+	return s, nil
+}
+
+// String implements the fmt.Stringer interface.
+func (s *ShowStatus) String() string {
+	scope := "SESSION"
+	if s.global {
+		scope = "GLOBAL"
+	}
+	var like string
+	if s.pattern != "" {
+		like = fmt.Sprintf(" LIKE '%s'", s.pattern)
+	}
+	return fmt.Sprintf("SHOW %s STATUS%s", scope, like)
+}
+
+// Schema returns a new Schema reference for "SHOW STATUS" query.
+func (*ShowStatus) Schema() sql.Schema {
+	return sql.Schema{
+		&sql.Column{Name: "Variable_name", Type: sql.LongText, Nullable: false},
+		&sql.Column{Name: "Value", Type: sql.LongText, Nullable: true},
+	}
+}
+
+// Children implements sql.Node interface. The function always returns nil.
+func (*ShowStatus) Children() []sql.Node { return nil }
+
+// RowIter implements the sql.Node interface.
+// The function returns an iterator for filtered variables (based on like pattern). The session values are those of
+// the variables in the session context, and the global values of the variables which only exist in the global one.
+func (s *ShowStatus) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	var (
+		rows []sql.Row
+		like sql.Expression
+	)
+	if s.pattern != "" {
+		like = expression.NewLike(
+			expression.NewGetField(0, sql.LongText, "", false),
+			expression.NewGetField(1, sql.LongText, s.pattern, false),
+		)
+	}
+
+	vals := sql.StatusVariables.GetAllGlobal()
+	if !s.global {
+		for k, v := range sql.SessionStatusVariables(ctx) {
+			vals[k] = v
+		}
+	}
+
+	for k, v := range vals {
+		if like != nil {
+			// Names are matched case-insensitively, as they are in MySQL
+			b, err := like.Eval(ctx, sql.NewRow(strings.ToLower(k), strings.ToLower(s.pattern)))
+			if err != nil {
+				return nil, err
+			}
+			if !b.(bool) {
+				continue
+			}
+		}
+
+		rows = append(rows, sql.NewRow(k, fmt.Sprint(v)))
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i][0].(string) < rows[j][0].(string)
+	})
+
+	return sql.RowsToRowIter(rows...), nil
+}
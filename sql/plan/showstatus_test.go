@@ -0,0 +1,46 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+func TestShowStatus(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+	sql.IncrementStatusVariable(ctx, "Com_select", 2)
+
+	ss := NewShowStatus("com_sel%", false)
+	require.True(ss.Resolved())
+	rows, err := sql.NodeToRows(ctx, ss)
+	require.NoError(err)
+	require.Equal([]sql.Row{{"Com_select", "2"}}, rows)
+
+	// Global only variables have their global value in sessions
+	rows, err = sql.NodeToRows(ctx, NewShowStatus("threads_connected", false))
+	require.NoError(err)
+	require.Len(rows, 1)
+
+	global, _ := sql.StatusVariables.GetGlobal("Com_select")
+	rows, err = sql.NodeToRows(ctx, NewShowStatus("Com_select", true))
+	require.NoError(err)
+	require.Equal([]sql.Row{{"Com_select", fmt.Sprint(global)}}, rows)
+}
@@ -278,7 +278,7 @@ func (s *Subquery) evalMultiple(ctx *sql.Context, row sql.Row) ([]interface{}, e
 }
 
 // HashMultiple returns all rows returned by a subquery, backed by a sql.KeyValueCache. Keys are constructed using the
-// 64-bit hash of the values stored.
+// 64-bit hash of the collation keys of the values stored, in the type of the subquery.
 func (s *Subquery) HashMultiple(ctx *sql.Context, row sql.Row) (sql.KeyValueCache, error) {
 	s.cacheMu.Lock()
 	cached := s.resultsCached && s.hashCache != nil
@@ -297,7 +297,7 @@ func (s *Subquery) HashMultiple(ctx *sql.Context, row sql.Row) (sql.KeyValueCach
 		defer s.cacheMu.Unlock()
 		if !s.resultsCached || s.hashCache == nil {
 			hashCache, disposeFn := ctx.Memory.NewHistoryCache()
-			err = putAllRows(hashCache, result)
+			err = putAllRows(hashCache, s.Type(), result)
 			if err != nil {
 				return nil, err
 			}
@@ -307,12 +307,13 @@ func (s *Subquery) HashMultiple(ctx *sql.Context, row sql.Row) (sql.KeyValueCach
 	}
 
 	cache := sql.NewMapCache()
-	return cache, putAllRows(cache, result)
+	return cache, putAllRows(cache, s.Type(), result)
 }
 
-func putAllRows(cache sql.KeyValueCache, vals []interface{}) error {
+// putAllRows puts the values given in the cache, with the hash of their collation key in the type given as key.
+func putAllRows(cache sql.KeyValueCache, typ sql.Type, vals []interface{}) error {
 	for _, val := range vals {
-		rowKey, err := sql.HashOf(sql.NewRow(val))
+		rowKey, err := sql.HashOf(sql.NewRow(sql.CollationKey(typ, val)))
 		if err != nil {
 			return err
 		}
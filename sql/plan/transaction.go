@@ -61,7 +61,13 @@ func (s *StartTransaction) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter,
 	// A START TRANSACTION statement commits any pending work before beginning a new tx
 	// TODO: this work is wasted in the case that START TRANSACTION is the first statement after COMMIT
 	if currentTx != nil {
-		err := tdb.CommitTransaction(ctx, currentTx)
+		err := sql.SessionPendingChanges(ctx).CommitWith(func() error {
+			if err := tdb.CommitTransaction(ctx, currentTx); err != nil {
+				return err
+			}
+			ctx.SetTransaction(nil)
+			return nil
+		})
 		if err != nil {
 			return nil, err
 		}
@@ -180,14 +186,18 @@ func (c *Commit) RowIter(ctx *sql.Context, _ sql.Row) (sql.RowIter, error) {
 		return sql.RowsToRowIter(), nil
 	}
 
-	err := tdb.CommitTransaction(ctx, transaction)
+	err := sql.SessionPendingChanges(ctx).CommitWith(func() error {
+		if err := tdb.CommitTransaction(ctx, transaction); err != nil {
+			return err
+		}
+		ctx.SetIgnoreAutoCommit(false)
+		ctx.SetTransaction(nil)
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	ctx.SetIgnoreAutoCommit(false)
-	ctx.SetTransaction(nil)
-
 	return sql.RowsToRowIter(), nil
 }
 
@@ -249,6 +259,7 @@ func (r *Rollback) RowIter(ctx *sql.Context, _ sql.Row) (sql.RowIter, error) {
 	if err != nil {
 		return nil, err
 	}
+	sql.SessionPendingChanges(ctx).Rollback()
 
 	// Like Commit, Rollback ends the current transaction and a new one begins with the next statement
 	ctx.SetIgnoreAutoCommit(false)
@@ -322,6 +333,7 @@ func (c *CreateSavepoint) RowIter(ctx *sql.Context, _ sql.Row) (sql.RowIter, err
 	if err != nil {
 		return nil, err
 	}
+	sql.SessionPendingChanges(ctx).CreateSavepoint(c.name)
 
 	return sql.RowsToRowIter(), nil
 }
@@ -391,6 +403,7 @@ func (r *RollbackSavepoint) RowIter(ctx *sql.Context, _ sql.Row) (sql.RowIter, e
 	if err != nil {
 		return nil, err
 	}
+	sql.SessionPendingChanges(ctx).RollbackToSavepoint(r.name)
 
 	return sql.RowsToRowIter(), nil
 }
@@ -460,6 +473,7 @@ func (r *ReleaseSavepoint) RowIter(ctx *sql.Context, _ sql.Row) (sql.RowIter, er
 	if err != nil {
 		return nil, err
 	}
+	sql.SessionPendingChanges(ctx).ReleaseSavepoint(r.name)
 
 	return sql.RowsToRowIter(), nil
 }
@@ -88,6 +88,11 @@ func (p *Truncate) RowIter(ctx *sql.Context, _ sql.Row) (sql.RowIter, error) {
 	if err != nil {
 		return nil, err
 	}
+	database := p.db
+	if database == "" {
+		database = deleteDatabaseHelper(p.Child)
+	}
+	recordReset(ctx, database, truncatable, nil)
 	for _, col := range truncatable.Schema() {
 		if col.AutoIncrement {
 			aiTable, ok := truncatable.(sql.AutoIncrementTable)
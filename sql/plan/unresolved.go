@@ -30,16 +30,18 @@ type UnresolvedTable struct {
 	name     string
 	Database string
 	AsOf     sql.Expression
+	// Partitions are the partitions named by a PARTITION clause, which restricts the table to their rows.
+	Partitions []string
 }
 
 // NewUnresolvedTable creates a new Unresolved table.
 func NewUnresolvedTable(name, db string) *UnresolvedTable {
-	return &UnresolvedTable{name, db, nil}
+	return &UnresolvedTable{name, db, nil, nil}
 }
 
 // NewUnresolvedTableAsOf creates a new Unresolved table with an AS OF expression.
 func NewUnresolvedTableAsOf(name, db string, asOf sql.Expression) *UnresolvedTable {
-	return &UnresolvedTable{name, db, asOf}
+	return &UnresolvedTable{name, db, asOf, nil}
 }
 
 // Name implements the Nameable interface.
@@ -88,6 +90,13 @@ func (t *UnresolvedTable) WithDatabase(database string) (*UnresolvedTable, error
 	return &t2, nil
 }
 
+// WithPartitions returns a copy of this unresolved table restricted to the partitions named.
+func (t *UnresolvedTable) WithPartitions(partitions []string) *UnresolvedTable {
+	t2 := *t
+	t2.Partitions = partitions
+	return &t2
+}
+
 func (t UnresolvedTable) String() string {
 	return fmt.Sprintf("UnresolvedTable(%s)", t.name)
 }
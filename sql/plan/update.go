@@ -232,7 +232,7 @@ func (u *Update) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	if err != nil {
 		return nil, err
 	}
-	updater := updatable.Updater(ctx)
+	updater := recordUpdates(ctx, u.Database(), updatable, updatable.Updater(ctx))
 
 	iter, err := u.Child.RowIter(ctx, row)
 	if err != nil {
@@ -0,0 +1,454 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/vitess/go/mysql"
+
+	"github.com/linanh/go-mysql-server/sql"
+)
+
+// UserSpec is a user named in a CREATE USER or ALTER USER statement, along with its authentication options.
+type UserSpec struct {
+	Identity sql.UserIdentity
+	// Plugin is the authentication plugin named with IDENTIFIED WITH, or empty if none was given.
+	Plugin string
+	// Password is the password given with IDENTIFIED BY, or nil if none was given.
+	Password *string
+	// AuthString is the credential given with IDENTIFIED WITH ... AS, or nil if none was given.
+	AuthString *string
+}
+
+// account returns the account for this spec, starting from the existing account given.
+func (u UserSpec) account(existing sql.Account) (sql.Account, error) {
+	account := existing
+	account.UserIdentity = u.Identity
+	if u.Plugin != "" {
+		account.Plugin = strings.ToLower(u.Plugin)
+	}
+	if account.Plugin == "" {
+		account.Plugin = sql.DefaultAuthPlugin
+	}
+
+	switch {
+	case u.AuthString != nil:
+		account.AuthString = *u.AuthString
+	case u.Password != nil:
+		hash, err := sql.HashPassword(account.Plugin, *u.Password)
+		if err != nil {
+			return sql.Account{}, err
+		}
+		account.AuthString = hash
+	case u.Plugin != "" && u.Plugin != existing.Plugin:
+		// Changing the plugin without a new password invalidates the old credential.
+		account.AuthString = ""
+	}
+	return account, nil
+}
+
+func (u UserSpec) String() string {
+	var sb strings.Builder
+	sb.WriteString(u.Identity.Quoted())
+	if u.Plugin != "" {
+		sb.WriteString(" identified with ")
+		sb.WriteString(u.Plugin)
+	}
+	if u.Password != nil {
+		if u.Plugin == "" {
+			sb.WriteString(" identified")
+		}
+		sb.WriteString(" by <secret>")
+	} else if u.AuthString != nil {
+		sb.WriteString(" as <secret>")
+	}
+	return sb.String()
+}
+
+func userSpecsString(specs []UserSpec) string {
+	var strs []string
+	for _, s := range specs {
+		strs = append(strs, s.String())
+	}
+	return strings.Join(strs, ", ")
+}
+
+func identitiesString(ids []sql.UserIdentity) string {
+	var strs []string
+	for _, id := range ids {
+		strs = append(strs, id.Quoted())
+	}
+	return strings.Join(strs, ", ")
+}
+
+func privilegeStore(catalog *sql.Catalog) (sql.PrivilegeStore, error) {
+	if catalog == nil || catalog.PrivilegeStore == nil {
+		return nil, sql.ErrNoPrivilegeStore.New()
+	}
+	return catalog.PrivilegeStore, nil
+}
+
+// CreateUser creates one or more user accounts.
+type CreateUser struct {
+	Catalog     *sql.Catalog
+	Users       []UserSpec
+	IfNotExists bool
+	// Locked is the account lock option given, or nil if none was given.
+	Locked *bool
+}
+
+var _ sql.Node = (*CreateUser)(nil)
+
+// NewCreateUser returns a new CreateUser node.
+func NewCreateUser(users []UserSpec, ifNotExists bool, locked *bool) *CreateUser {
+	return &CreateUser{
+		Users:       users,
+		IfNotExists: ifNotExists,
+		Locked:      locked,
+	}
+}
+
+// Resolved implements the sql.Node interface.
+func (n *CreateUser) Resolved() bool { return true }
+
+// Schema implements the sql.Node interface.
+func (n *CreateUser) Schema() sql.Schema { return sql.OkResultSchema }
+
+// Children implements the sql.Node interface.
+func (n *CreateUser) Children() []sql.Node { return nil }
+
+// WithChildren implements the sql.Node interface.
+func (n *CreateUser) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(n, children...)
+}
+
+func (n *CreateUser) String() string {
+	ifNotExists := ""
+	if n.IfNotExists {
+		ifNotExists = "if not exists "
+	}
+	return fmt.Sprintf("CreateUser(%s%s)", ifNotExists, userSpecsString(n.Users))
+}
+
+// RowIter implements the sql.Node interface.
+func (n *CreateUser) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	store, err := privilegeStore(n.Catalog)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, u := range n.Users {
+		_, exists, err := store.Account(ctx, u.Identity)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			if n.IfNotExists {
+				ctx.Session.Warn(&sql.Warning{
+					Level:   "Note",
+					Code:    mysql.ERUnknownError,
+					Message: fmt.Sprintf("Authorization ID %s already exists.", u.Identity.Quoted()),
+				})
+				continue
+			}
+			return nil, sql.ErrAccountExists.New("CREATE USER", u.Identity.Quoted())
+		}
+	}
+
+	for _, u := range n.Users {
+		_, exists, err := store.Account(ctx, u.Identity)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			continue
+		}
+
+		account, err := u.account(sql.Account{})
+		if err != nil {
+			return nil, err
+		}
+		if n.Locked != nil {
+			account.Locked = *n.Locked
+		}
+		if err := store.SetAccount(ctx, account); err != nil {
+			return nil, err
+		}
+	}
+
+	return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+}
+
+// AlterUser changes the authentication options of one or more user accounts.
+type AlterUser struct {
+	Catalog  *sql.Catalog
+	Users    []UserSpec
+	IfExists bool
+	// Locked is the account lock option given, or nil if none was given.
+	Locked *bool
+}
+
+var _ sql.Node = (*AlterUser)(nil)
+
+// NewAlterUser returns a new AlterUser node.
+func NewAlterUser(users []UserSpec, ifExists bool, locked *bool) *AlterUser {
+	return &AlterUser{
+		Users:    users,
+		IfExists: ifExists,
+		Locked:   locked,
+	}
+}
+
+// Resolved implements the sql.Node interface.
+func (n *AlterUser) Resolved() bool { return true }
+
+// Schema implements the sql.Node interface.
+func (n *AlterUser) Schema() sql.Schema { return sql.OkResultSchema }
+
+// Children implements the sql.Node interface.
+func (n *AlterUser) Children() []sql.Node { return nil }
+
+// WithChildren implements the sql.Node interface.
+func (n *AlterUser) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(n, children...)
+}
+
+func (n *AlterUser) String() string {
+	ifExists := ""
+	if n.IfExists {
+		ifExists = "if exists "
+	}
+	return fmt.Sprintf("AlterUser(%s%s)", ifExists, userSpecsString(n.Users))
+}
+
+// RowIter implements the sql.Node interface.
+func (n *AlterUser) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	store, err := privilegeStore(n.Catalog)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]sql.Account, 0, len(n.Users))
+	for _, u := range n.Users {
+		existing, exists, err := store.Account(ctx, u.Identity)
+		if err != nil {
+			return nil, err
+		}
+		if !exists || existing.IsRole {
+			if n.IfExists {
+				ctx.Session.Warn(&sql.Warning{
+					Level:   "Note",
+					Code:    mysql.ERUnknownError,
+					Message: fmt.Sprintf("User %s does not exist.", u.Identity.Quoted()),
+				})
+				continue
+			}
+			return nil, sql.ErrAccountNotFound.New("ALTER USER", u.Identity.Quoted())
+		}
+
+		account, err := u.account(existing)
+		if err != nil {
+			return nil, err
+		}
+		if n.Locked != nil {
+			account.Locked = *n.Locked
+		}
+		accounts = append(accounts, account)
+	}
+
+	for _, account := range accounts {
+		if err := store.SetAccount(ctx, account); err != nil {
+			return nil, err
+		}
+	}
+
+	return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+}
+
+// DropUser removes user accounts or roles, along with their privileges.
+type DropUser struct {
+	Catalog  *sql.Catalog
+	Users    []sql.UserIdentity
+	IfExists bool
+	// IsRole is true for DROP ROLE statements.
+	IsRole bool
+}
+
+var _ sql.Node = (*DropUser)(nil)
+
+// NewDropUser returns a new DropUser node.
+func NewDropUser(users []sql.UserIdentity, ifExists bool) *DropUser {
+	return &DropUser{
+		Users:    users,
+		IfExists: ifExists,
+	}
+}
+
+// NewDropRole returns a new DropUser node that drops roles.
+func NewDropRole(roles []sql.UserIdentity, ifExists bool) *DropUser {
+	return &DropUser{
+		Users:    roles,
+		IfExists: ifExists,
+		IsRole:   true,
+	}
+}
+
+// Resolved implements the sql.Node interface.
+func (n *DropUser) Resolved() bool { return true }
+
+// Schema implements the sql.Node interface.
+func (n *DropUser) Schema() sql.Schema { return sql.OkResultSchema }
+
+// Children implements the sql.Node interface.
+func (n *DropUser) Children() []sql.Node { return nil }
+
+// WithChildren implements the sql.Node interface.
+func (n *DropUser) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(n, children...)
+}
+
+func (n *DropUser) operation() string {
+	if n.IsRole {
+		return "DROP ROLE"
+	}
+	return "DROP USER"
+}
+
+func (n *DropUser) String() string {
+	ifExists := ""
+	if n.IfExists {
+		ifExists = "if exists "
+	}
+	name := "DropUser"
+	if n.IsRole {
+		name = "DropRole"
+	}
+	return fmt.Sprintf("%s(%s%s)", name, ifExists, identitiesString(n.Users))
+}
+
+// RowIter implements the sql.Node interface.
+func (n *DropUser) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	store, err := privilegeStore(n.Catalog)
+	if err != nil {
+		return nil, err
+	}
+
+	var toDrop []sql.UserIdentity
+	for _, id := range n.Users {
+		_, exists, err := store.Account(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			if n.IfExists {
+				ctx.Session.Warn(&sql.Warning{
+					Level:   "Note",
+					Code:    mysql.ERUnknownError,
+					Message: fmt.Sprintf("Authorization ID %s does not exist.", id.Quoted()),
+				})
+				continue
+			}
+			return nil, sql.ErrAccountNotFound.New(n.operation(), id.Quoted())
+		}
+		toDrop = append(toDrop, id)
+	}
+
+	for _, id := range toDrop {
+		if err := store.DropAccount(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+
+	return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+}
+
+// CreateRole creates one or more roles.
+type CreateRole struct {
+	Catalog     *sql.Catalog
+	Roles       []sql.UserIdentity
+	IfNotExists bool
+}
+
+var _ sql.Node = (*CreateRole)(nil)
+
+// NewCreateRole returns a new CreateRole node.
+func NewCreateRole(roles []sql.UserIdentity, ifNotExists bool) *CreateRole {
+	return &CreateRole{
+		Roles:       roles,
+		IfNotExists: ifNotExists,
+	}
+}
+
+// Resolved implements the sql.Node interface.
+func (n *CreateRole) Resolved() bool { return true }
+
+// Schema implements the sql.Node interface.
+func (n *CreateRole) Schema() sql.Schema { return sql.OkResultSchema }
+
+// Children implements the sql.Node interface.
+func (n *CreateRole) Children() []sql.Node { return nil }
+
+// WithChildren implements the sql.Node interface.
+func (n *CreateRole) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(n, children...)
+}
+
+func (n *CreateRole) String() string {
+	ifNotExists := ""
+	if n.IfNotExists {
+		ifNotExists = "if not exists "
+	}
+	return fmt.Sprintf("CreateRole(%s%s)", ifNotExists, identitiesString(n.Roles))
+}
+
+// RowIter implements the sql.Node interface.
+func (n *CreateRole) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	store, err := privilegeStore(n.Catalog)
+	if err != nil {
+		return nil, err
+	}
+
+	var toCreate []sql.UserIdentity
+	for _, id := range n.Roles {
+		_, exists, err := store.Account(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			if n.IfNotExists {
+				ctx.Session.Warn(&sql.Warning{
+					Level:   "Note",
+					Code:    mysql.ERUnknownError,
+					Message: fmt.Sprintf("Authorization ID %s already exists.", id.Quoted()),
+				})
+				continue
+			}
+			return nil, sql.ErrAccountExists.New("CREATE ROLE", id.Quoted())
+		}
+		toCreate = append(toCreate, id)
+	}
+
+	for _, id := range toCreate {
+		// Roles are locked accounts without credentials, so nobody can log in as one.
+		role := sql.Account{UserIdentity: id, Plugin: sql.DefaultAuthPlugin, Locked: true, IsRole: true}
+		if err := store.SetAccount(ctx, role); err != nil {
+			return nil, err
+		}
+	}
+
+	return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+}
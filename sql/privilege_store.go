@@ -0,0 +1,159 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"sync"
+)
+
+// MemoryPrivilegeStore is a PrivilegeStore which keeps everything in memory.
+type MemoryPrivilegeStore struct {
+	mu       sync.RWMutex
+	accounts []Account
+	grants   []PrivilegeGrant
+	edges    []RoleEdge
+}
+
+var _ PrivilegeStore = (*MemoryPrivilegeStore)(nil)
+
+// NewMemoryPrivilegeStore returns a new empty MemoryPrivilegeStore.
+func NewMemoryPrivilegeStore() *MemoryPrivilegeStore {
+	return &MemoryPrivilegeStore{}
+}
+
+// Accounts implements the PrivilegeStore interface.
+func (s *MemoryPrivilegeStore) Accounts(ctx *Context) ([]Account, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]Account(nil), s.accounts...), nil
+}
+
+// Account implements the PrivilegeStore interface.
+func (s *MemoryPrivilegeStore) Account(ctx *Context, id UserIdentity) (Account, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, a := range s.accounts {
+		if a.UserIdentity == id {
+			return a, true, nil
+		}
+	}
+	return Account{}, false, nil
+}
+
+// SetAccount implements the PrivilegeStore interface.
+func (s *MemoryPrivilegeStore) SetAccount(ctx *Context, account Account) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, a := range s.accounts {
+		if a.UserIdentity == account.UserIdentity {
+			s.accounts[i] = account
+			return nil
+		}
+	}
+	s.accounts = append(s.accounts, account)
+	return nil
+}
+
+// DropAccount implements the PrivilegeStore interface.
+func (s *MemoryPrivilegeStore) DropAccount(ctx *Context, id UserIdentity) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	accounts := s.accounts[:0]
+	for _, a := range s.accounts {
+		if a.UserIdentity != id {
+			accounts = append(accounts, a)
+		}
+	}
+	s.accounts = accounts
+
+	grants := s.grants[:0]
+	for _, g := range s.grants {
+		if g.Grantee != id {
+			grants = append(grants, g)
+		}
+	}
+	s.grants = grants
+
+	edges := s.edges[:0]
+	for _, e := range s.edges {
+		if e.Grantee != id && e.Role != id {
+			edges = append(edges, e)
+		}
+	}
+	s.edges = edges
+	return nil
+}
+
+// Grants implements the PrivilegeStore interface.
+func (s *MemoryPrivilegeStore) Grants(ctx *Context) ([]PrivilegeGrant, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]PrivilegeGrant(nil), s.grants...), nil
+}
+
+// SetGrant implements the PrivilegeStore interface.
+func (s *MemoryPrivilegeStore) SetGrant(ctx *Context, grant PrivilegeGrant) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, g := range s.grants {
+		if g.Grantee == grant.Grantee && g.Target.equals(grant.Target) {
+			if grant.Privileges == 0 {
+				s.grants = append(s.grants[:i], s.grants[i+1:]...)
+			} else {
+				s.grants[i] = grant
+			}
+			return nil
+		}
+	}
+	if grant.Privileges != 0 {
+		s.grants = append(s.grants, grant)
+	}
+	return nil
+}
+
+// RoleEdges implements the PrivilegeStore interface.
+func (s *MemoryPrivilegeStore) RoleEdges(ctx *Context) ([]RoleEdge, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]RoleEdge(nil), s.edges...), nil
+}
+
+// SetRoleEdge implements the PrivilegeStore interface.
+func (s *MemoryPrivilegeStore) SetRoleEdge(ctx *Context, edge RoleEdge) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, e := range s.edges {
+		if e.Role == edge.Role && e.Grantee == edge.Grantee {
+			s.edges[i] = edge
+			return nil
+		}
+	}
+	s.edges = append(s.edges, edge)
+	return nil
+}
+
+// RemoveRoleEdge implements the PrivilegeStore interface.
+func (s *MemoryPrivilegeStore) RemoveRoleEdge(ctx *Context, role, grantee UserIdentity) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, e := range s.edges {
+		if e.Role == role && e.Grantee == grantee {
+			s.edges = append(s.edges[:i], s.edges[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
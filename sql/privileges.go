@@ -0,0 +1,536 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+var (
+	// ErrNoPrivilegeStore is returned by account management statements when the engine has no privilege store.
+	ErrNoPrivilegeStore = errors.NewKind("account management is not supported without a privilege store")
+	// ErrAccountExists is returned when creating a user or role that already exists.
+	ErrAccountExists = errors.NewKind("Operation %s failed for %s")
+	// ErrAccountNotFound is returned when a user or role doesn't exist.
+	ErrAccountNotFound = errors.NewKind("Operation %s failed for %s")
+	// ErrUnknownPrivilege is returned when a GRANT or REVOKE statement names a privilege that doesn't exist.
+	ErrUnknownPrivilege = errors.NewKind("unknown privilege: %s")
+	// ErrIllegalPrivilegeLevel is returned when a privilege is granted at a level it doesn't apply to, such as a global
+	// privilege on a single table.
+	ErrIllegalPrivilegeLevel = errors.NewKind("Illegal GRANT/REVOKE command; please consult the manual to see which privileges can be used")
+	// ErrNoGrantFound is returned when revoking a privilege that was never granted.
+	ErrNoGrantFound = errors.NewKind("There is no such grant defined for user '%s' on host '%s'")
+	// ErrPrivilegeCheckFailed is returned when a user lacks the privileges required by a statement.
+	ErrPrivilegeCheckFailed = errors.NewKind("command denied to user %s: %s")
+	// ErrRoleNotGranted is returned when activating a role that hasn't been granted to the current user.
+	ErrRoleNotGranted = errors.NewKind("%s is not granted to %s")
+	// ErrUnknownAuthPlugin is returned when an account is created with an authentication plugin that isn't registered.
+	ErrUnknownAuthPlugin = errors.NewKind("Plugin '%s' is not loaded")
+)
+
+// DefaultAuthPlugin is the authentication plugin for accounts created without one.
+const DefaultAuthPlugin = "mysql_native_password"
+
+// PasswordHasher computes the credential kept for an account from its password, for a single authentication plugin.
+type PasswordHasher func(password string) (string, error)
+
+var (
+	passwordHashersMu sync.RWMutex
+	passwordHashers   = map[string]PasswordHasher{
+		DefaultAuthPlugin: func(password string) (string, error) {
+			return NativePasswordHash(password), nil
+		},
+	}
+)
+
+// RegisterPasswordHasher makes the authentication plugin given available to CREATE USER and ALTER USER.
+func RegisterPasswordHasher(plugin string, hasher PasswordHasher) {
+	passwordHashersMu.Lock()
+	defer passwordHashersMu.Unlock()
+	passwordHashers[strings.ToLower(plugin)] = hasher
+}
+
+// HashPassword returns the credential to store for an account using the plugin given with the password given.
+func HashPassword(plugin, password string) (string, error) {
+	passwordHashersMu.RLock()
+	hasher, ok := passwordHashers[strings.ToLower(plugin)]
+	passwordHashersMu.RUnlock()
+	if !ok {
+		return "", ErrUnknownAuthPlugin.New(plugin)
+	}
+	return hasher(password)
+}
+
+// NativePasswordHash returns the mysql_native_password hash of a password, which is SHA1(SHA1(password)) in
+// uppercase hex with a leading asterisk. An empty password has an empty hash.
+func NativePasswordHash(password string) string {
+	if len(password) == 0 {
+		return ""
+	}
+
+	s1 := sha1.Sum([]byte(password))
+	s2 := sha1.Sum(s1[:])
+	return "*" + strings.ToUpper(hex.EncodeToString(s2[:]))
+}
+
+// PrivilegeType is a set of MySQL privileges.
+type PrivilegeType uint64
+
+const (
+	PrivilegeType_Select PrivilegeType = 1 << iota
+	PrivilegeType_Insert
+	PrivilegeType_Update
+	PrivilegeType_Delete
+	PrivilegeType_Create
+	PrivilegeType_Drop
+	PrivilegeType_Reload
+	PrivilegeType_Shutdown
+	PrivilegeType_Process
+	PrivilegeType_File
+	PrivilegeType_References
+	PrivilegeType_Index
+	PrivilegeType_Alter
+	PrivilegeType_ShowDatabases
+	PrivilegeType_Super
+	PrivilegeType_CreateTemporaryTables
+	PrivilegeType_LockTables
+	PrivilegeType_Execute
+	PrivilegeType_ReplicationSlave
+	PrivilegeType_ReplicationClient
+	PrivilegeType_CreateView
+	PrivilegeType_ShowView
+	PrivilegeType_CreateRoutine
+	PrivilegeType_AlterRoutine
+	PrivilegeType_CreateUser
+	PrivilegeType_Event
+	PrivilegeType_Trigger
+	PrivilegeType_CreateRole
+	PrivilegeType_DropRole
+	// PrivilegeType_GrantOption allows granting the other privileges held at the same level. It is never included in
+	// ALL PRIVILEGES.
+	PrivilegeType_GrantOption
+)
+
+// privilegeNames holds the name of each privilege, in the order MySQL displays them.
+var privilegeNames = []struct {
+	priv PrivilegeType
+	name string
+}{
+	{PrivilegeType_Select, "SELECT"},
+	{PrivilegeType_Insert, "INSERT"},
+	{PrivilegeType_Update, "UPDATE"},
+	{PrivilegeType_Delete, "DELETE"},
+	{PrivilegeType_Create, "CREATE"},
+	{PrivilegeType_Drop, "DROP"},
+	{PrivilegeType_Reload, "RELOAD"},
+	{PrivilegeType_Shutdown, "SHUTDOWN"},
+	{PrivilegeType_Process, "PROCESS"},
+	{PrivilegeType_File, "FILE"},
+	{PrivilegeType_References, "REFERENCES"},
+	{PrivilegeType_Index, "INDEX"},
+	{PrivilegeType_Alter, "ALTER"},
+	{PrivilegeType_ShowDatabases, "SHOW DATABASES"},
+	{PrivilegeType_Super, "SUPER"},
+	{PrivilegeType_CreateTemporaryTables, "CREATE TEMPORARY TABLES"},
+	{PrivilegeType_LockTables, "LOCK TABLES"},
+	{PrivilegeType_Execute, "EXECUTE"},
+	{PrivilegeType_ReplicationSlave, "REPLICATION SLAVE"},
+	{PrivilegeType_ReplicationClient, "REPLICATION CLIENT"},
+	{PrivilegeType_CreateView, "CREATE VIEW"},
+	{PrivilegeType_ShowView, "SHOW VIEW"},
+	{PrivilegeType_CreateRoutine, "CREATE ROUTINE"},
+	{PrivilegeType_AlterRoutine, "ALTER ROUTINE"},
+	{PrivilegeType_CreateUser, "CREATE USER"},
+	{PrivilegeType_Event, "EVENT"},
+	{PrivilegeType_Trigger, "TRIGGER"},
+	{PrivilegeType_CreateRole, "CREATE ROLE"},
+	{PrivilegeType_DropRole, "DROP ROLE"},
+	{PrivilegeType_GrantOption, "GRANT OPTION"},
+}
+
+const (
+	// PrivilegeType_All is every privilege except GRANT OPTION, as granted by GRANT ALL PRIVILEGES.
+	PrivilegeType_All = PrivilegeType_GrantOption - 1
+	// PrivilegeType_Database holds the privileges which can be granted on a database.
+	PrivilegeType_Database = PrivilegeType_Select | PrivilegeType_Insert | PrivilegeType_Update | PrivilegeType_Delete |
+		PrivilegeType_Create | PrivilegeType_Drop | PrivilegeType_References | PrivilegeType_Index | PrivilegeType_Alter |
+		PrivilegeType_CreateTemporaryTables | PrivilegeType_LockTables | PrivilegeType_Execute | PrivilegeType_CreateView |
+		PrivilegeType_ShowView | PrivilegeType_CreateRoutine | PrivilegeType_AlterRoutine | PrivilegeType_Event |
+		PrivilegeType_Trigger | PrivilegeType_GrantOption
+	// PrivilegeType_Table holds the privileges which can be granted on a table.
+	PrivilegeType_Table = PrivilegeType_Select | PrivilegeType_Insert | PrivilegeType_Update | PrivilegeType_Delete |
+		PrivilegeType_Create | PrivilegeType_Drop | PrivilegeType_References | PrivilegeType_Index | PrivilegeType_Alter |
+		PrivilegeType_CreateView | PrivilegeType_ShowView | PrivilegeType_Trigger | PrivilegeType_GrantOption
+	// PrivilegeType_Column holds the privileges which can be granted on a column.
+	PrivilegeType_Column = PrivilegeType_Select | PrivilegeType_Insert | PrivilegeType_Update | PrivilegeType_References
+)
+
+// ParsePrivilegeType returns the privilege with the name given, which is not case sensitive. ALL and ALL PRIVILEGES
+// return PrivilegeType_All.
+func ParsePrivilegeType(name string) (PrivilegeType, error) {
+	name = strings.ToUpper(strings.Join(strings.Fields(name), " "))
+	switch name {
+	case "ALL", "ALL PRIVILEGES":
+		return PrivilegeType_All, nil
+	case "USAGE":
+		return 0, nil
+	}
+	for _, p := range privilegeNames {
+		if p.name == name {
+			return p.priv, nil
+		}
+	}
+	return 0, ErrUnknownPrivilege.New(name)
+}
+
+// Has returns whether every privilege in |other| is in this set.
+func (p PrivilegeType) Has(other PrivilegeType) bool {
+	return p&other == other
+}
+
+// Names returns the names of the privileges in this set, in the order MySQL displays them.
+func (p PrivilegeType) Names() []string {
+	var names []string
+	for _, n := range privilegeNames {
+		if p&n.priv != 0 {
+			names = append(names, n.name)
+		}
+	}
+	return names
+}
+
+// String returns the privileges in this set as they appear in a GRANT statement, ignoring GRANT OPTION.
+func (p PrivilegeType) String() string {
+	p &^= PrivilegeType_GrantOption
+	switch p {
+	case 0:
+		return "USAGE"
+	default:
+		return strings.Join(p.Names(), ", ")
+	}
+}
+
+// UserIdentity identifies a user or a role by its name and host.
+type UserIdentity struct {
+	User string
+	Host string
+}
+
+// NewUserIdentity returns the identity of a user or role. An empty host is the same as '%'.
+func NewUserIdentity(user, host string) UserIdentity {
+	if host == "" {
+		host = "%"
+	}
+	return UserIdentity{User: user, Host: strings.ToLower(host)}
+}
+
+// String returns the identity quoted the way MySQL displays it, such as `root`@`localhost`.
+func (u UserIdentity) String() string {
+	return fmt.Sprintf("`%s`@`%s`", u.User, u.Host)
+}
+
+// Quoted returns the identity quoted the way MySQL displays it in error messages, such as 'root'@'localhost'.
+func (u UserIdentity) Quoted() string {
+	return fmt.Sprintf("'%s'@'%s'", u.User, u.Host)
+}
+
+// Account is a user or a role.
+type Account struct {
+	UserIdentity
+	// Plugin is the name of the authentication plugin for the account, such as mysql_native_password.
+	Plugin string
+	// AuthString is the credential for the account in the format expected by the plugin, such as a password hash.
+	AuthString string
+	// Locked accounts can't connect to the server.
+	Locked bool
+	// IsRole is true for accounts created with CREATE ROLE.
+	IsRole bool
+}
+
+// PrivilegeTarget identifies the objects a privilege applies to. An empty Database is every database, an empty Table
+// is every table in the database, and an empty Column is every column of the table.
+type PrivilegeTarget struct {
+	Database string
+	Table    string
+	Column   string
+}
+
+// String returns the target as it appears in the ON clause of a GRANT statement.
+func (t PrivilegeTarget) String() string {
+	switch {
+	case t.Database == "":
+		return "*.*"
+	case t.Table == "":
+		return fmt.Sprintf("`%s`.*", t.Database)
+	default:
+		return fmt.Sprintf("`%s`.`%s`", t.Database, t.Table)
+	}
+}
+
+// AllowedPrivileges returns the privileges which can be granted on this target.
+func (t PrivilegeTarget) AllowedPrivileges() PrivilegeType {
+	switch {
+	case t.Database == "":
+		return PrivilegeType_All | PrivilegeType_GrantOption
+	case t.Table == "":
+		return PrivilegeType_Database
+	case t.Column == "":
+		return PrivilegeType_Table
+	default:
+		return PrivilegeType_Column
+	}
+}
+
+// covers returns whether a privilege granted on this target applies to the target given.
+func (t PrivilegeTarget) covers(other PrivilegeTarget) bool {
+	if t.Database == "" {
+		return true
+	}
+	if !strings.EqualFold(t.Database, other.Database) {
+		return false
+	}
+	if t.Table == "" {
+		return true
+	}
+	if !strings.EqualFold(t.Table, other.Table) {
+		return false
+	}
+	if t.Column == "" {
+		return true
+	}
+	return strings.EqualFold(t.Column, other.Column)
+}
+
+func (t PrivilegeTarget) equals(other PrivilegeTarget) bool {
+	return strings.EqualFold(t.Database, other.Database) &&
+		strings.EqualFold(t.Table, other.Table) &&
+		strings.EqualFold(t.Column, other.Column)
+}
+
+// PrivilegeGrant is a set of privileges granted to a user or role on a single target.
+type PrivilegeGrant struct {
+	Grantee    UserIdentity
+	Target     PrivilegeTarget
+	Privileges PrivilegeType
+}
+
+// RoleEdge records that a role was granted to a user or another role.
+type RoleEdge struct {
+	Role        UserIdentity
+	Grantee     UserIdentity
+	AdminOption bool
+}
+
+// PrivilegeCheck is a set of privileges which a statement requires on a target.
+type PrivilegeCheck struct {
+	Target     PrivilegeTarget
+	Privileges PrivilegeType
+	// ByColumn is set when the privileges may be held on the Columns of the table target instead, which are the ones
+	// the statement uses. When it uses none, the privileges must be held on some column of the table.
+	ByColumn bool
+	Columns  []string
+}
+
+// PrivilegeStore holds the accounts, privileges and role grants used for authentication and authorization. The engine
+// only reads and writes the store through this interface, so integrators can keep this information wherever they like.
+type PrivilegeStore interface {
+	// Accounts returns every user and role.
+	Accounts(ctx *Context) ([]Account, error)
+	// Account returns the user or role with the identity given, and whether it exists.
+	Account(ctx *Context, id UserIdentity) (Account, bool, error)
+	// SetAccount creates the account given, or replaces the existing account with the same identity.
+	SetAccount(ctx *Context, account Account) error
+	// DropAccount removes the account given, along with every privilege and role granted to it, and every grant of
+	// it as a role.
+	DropAccount(ctx *Context, id UserIdentity) error
+	// Grants returns every privilege grant.
+	Grants(ctx *Context) ([]PrivilegeGrant, error)
+	// SetGrant replaces the privileges held by the grantee on the target of the grant given. A grant without any
+	// privileges removes them all.
+	SetGrant(ctx *Context, grant PrivilegeGrant) error
+	// RoleEdges returns every grant of a role.
+	RoleEdges(ctx *Context) ([]RoleEdge, error)
+	// SetRoleEdge grants a role, or updates the admin option of an existing grant of it.
+	SetRoleEdge(ctx *Context, edge RoleEdge) error
+	// RemoveRoleEdge revokes a role from a grantee.
+	RemoveRoleEdge(ctx *Context, role, grantee UserIdentity) error
+}
+
+// GrantsFor returns the privileges granted directly to the identity given.
+func GrantsFor(ctx *Context, store PrivilegeStore, id UserIdentity) ([]PrivilegeGrant, error) {
+	grants, err := store.Grants(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []PrivilegeGrant
+	for _, g := range grants {
+		if g.Grantee == id {
+			res = append(res, g)
+		}
+	}
+	return res, nil
+}
+
+// GrantOn returns the privileges held by the identity given directly on the target given, ignoring those held at
+// other levels or through roles.
+func GrantOn(ctx *Context, store PrivilegeStore, id UserIdentity, target PrivilegeTarget) (PrivilegeType, error) {
+	grants, err := GrantsFor(ctx, store, id)
+	if err != nil {
+		return 0, err
+	}
+	for _, g := range grants {
+		if g.Target.equals(target) {
+			return g.Privileges, nil
+		}
+	}
+	return 0, nil
+}
+
+// RolesGrantedTo returns the roles granted directly to the identity given.
+func RolesGrantedTo(ctx *Context, store PrivilegeStore, id UserIdentity) ([]RoleEdge, error) {
+	edges, err := store.RoleEdges(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []RoleEdge
+	for _, e := range edges {
+		if e.Grantee == id {
+			res = append(res, e)
+		}
+	}
+	return res, nil
+}
+
+// EffectivePrivileges returns the privileges on the target given held by the user with the identity given, when the
+// roles given are active. It includes privileges granted on any level which covers the target, and privileges of
+// roles granted to the active roles.
+func EffectivePrivileges(ctx *Context, store PrivilegeStore, user UserIdentity, activeRoles []UserIdentity, target PrivilegeTarget) (PrivilegeType, error) {
+	grants, err := store.Grants(ctx)
+	if err != nil {
+		return 0, err
+	}
+	edges, err := store.RoleEdges(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// Collect the user and every role reachable from the active ones
+	grantees := map[UserIdentity]bool{user: true}
+	pending := append([]UserIdentity(nil), activeRoles...)
+	for len(pending) > 0 {
+		id := pending[0]
+		pending = pending[1:]
+		if grantees[id] {
+			continue
+		}
+		grantees[id] = true
+		for _, e := range edges {
+			if e.Grantee == id {
+				pending = append(pending, e.Role)
+			}
+		}
+	}
+
+	var privs PrivilegeType
+	for _, g := range grants {
+		if grantees[g.Grantee] && g.Target.covers(target) {
+			privs |= g.Privileges
+		}
+	}
+	return privs, nil
+}
+
+// FindAccount returns the user account which a client connecting as |user| from |address| authenticates as. When more
+// than one account matches, the one with the most specific host is used, as MySQL does. Roles never match.
+func FindAccount(ctx *Context, store PrivilegeStore, user, address string) (Account, bool, error) {
+	accounts, err := store.Accounts(ctx)
+	if err != nil {
+		return Account{}, false, err
+	}
+
+	host := Client{Address: address}.Host()
+
+	var matches []Account
+	for _, a := range accounts {
+		if !a.IsRole && a.User == user && HostMatches(a.Host, host) {
+			matches = append(matches, a)
+		}
+	}
+	if len(matches) == 0 {
+		return Account{}, false, nil
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return hostSpecificity(matches[i].Host) > hostSpecificity(matches[j].Host)
+	})
+	return matches[0], true, nil
+}
+
+// HostMatches returns whether the host given matches an account host pattern, which may contain the wildcards % and _.
+// The pattern localhost matches loopback addresses as well.
+func HostMatches(pattern, host string) bool {
+	pattern = strings.ToLower(pattern)
+	host = strings.ToLower(host)
+	if pattern == "localhost" {
+		if host == "" || host == "localhost" {
+			return true
+		}
+		if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+			return true
+		}
+		return false
+	}
+	return wildcardMatches(pattern, host)
+}
+
+// wildcardMatches matches a string against a LIKE pattern which uses % and _ but has no escapes.
+func wildcardMatches(pattern, s string) bool {
+	if pattern == "" {
+		return s == ""
+	}
+	switch pattern[0] {
+	case '%':
+		for i := 0; i <= len(s); i++ {
+			if wildcardMatches(pattern[1:], s[i:]) {
+				return true
+			}
+		}
+		return false
+	case '_':
+		return len(s) > 0 && wildcardMatches(pattern[1:], s[1:])
+	default:
+		return len(s) > 0 && s[0] == pattern[0] && wildcardMatches(pattern[1:], s[1:])
+	}
+}
+
+// hostSpecificity ranks host patterns so that literal hosts sort before patterns, and patterns with longer literal
+// prefixes sort before shorter ones.
+func hostSpecificity(host string) int {
+	idx := strings.IndexAny(host, "%_")
+	if idx < 0 {
+		return len(host) + 1<<16
+	}
+	return idx
+}
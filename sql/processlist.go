@@ -17,6 +17,7 @@ package sql
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -73,6 +74,7 @@ type Process struct {
 	Pid        uint64
 	Connection uint32
 	User       string
+	Host       string
 	Query      string
 	Progress   map[string]TableProgress
 	StartedAt  time.Time
@@ -92,12 +94,28 @@ func (p *Process) Seconds() uint64 {
 type ProcessList struct {
 	mu    sync.RWMutex
 	procs map[uint64]*Process
+	conns map[uint32]*connection
+}
+
+// connection is a client connection registered in a ProcessList.
+type connection struct {
+	user  string
+	host  string
+	close func()
+}
+
+// ConnectionInfo describes a client connection registered in a ProcessList.
+type ConnectionInfo struct {
+	ID   uint32
+	User string
+	Host string
 }
 
 // NewProcessList creates a new process list.
 func NewProcessList() *ProcessList {
 	return &ProcessList{
 		procs: make(map[uint64]*Process),
+		conns: make(map[uint32]*connection),
 	}
 }
 
@@ -129,6 +147,7 @@ func (pl *ProcessList) AddProcess(
 		Query:      query,
 		Progress:   make(map[string]TableProgress),
 		User:       ctx.Session.Client().User,
+		Host:       ctx.Session.Client().Address,
 		StartedAt:  time.Now(),
 		Kill:       cancel,
 	}
@@ -272,6 +291,74 @@ func (pl *ProcessList) Kill(connID uint32) {
 	}
 }
 
+// AddConnection registers a client connection, which KillConnection closes with the function given. Its user is
+// unknown until SetConnectionClient is called.
+func (pl *ProcessList) AddConnection(connID uint32, close func()) {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+	pl.conns[connID] = &connection{close: close}
+}
+
+// SetConnectionClient sets the user and host of a registered connection, once it's authenticated or when it changes
+// user.
+func (pl *ProcessList) SetConnectionClient(connID uint32, client Client) {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+	if conn, ok := pl.conns[connID]; ok {
+		conn.user = client.User
+		conn.host = client.Address
+	}
+}
+
+// RemoveConnection unregisters a client connection.
+func (pl *ProcessList) RemoveConnection(connID uint32) {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+	delete(pl.conns, connID)
+}
+
+// Connections returns the registered client connections, sorted by id.
+func (pl *ProcessList) Connections() []ConnectionInfo {
+	pl.mu.RLock()
+	defer pl.mu.RUnlock()
+	conns := make([]ConnectionInfo, 0, len(pl.conns))
+	for id, conn := range pl.conns {
+		conns = append(conns, ConnectionInfo{ID: id, User: conn.user, Host: conn.host})
+	}
+	sort.Slice(conns, func(i, j int) bool { return conns[i].ID < conns[j].ID })
+	return conns
+}
+
+// ConnectionClient returns the user and host of the connection with the given id, and whether there's such a
+// connection. A connection exists if it's registered or has a running query.
+func (pl *ProcessList) ConnectionClient(connID uint32) (Client, bool) {
+	pl.mu.RLock()
+	defer pl.mu.RUnlock()
+
+	if conn, ok := pl.conns[connID]; ok {
+		return Client{User: conn.user, Address: conn.host}, true
+	}
+	for _, proc := range pl.procs {
+		if proc.Connection == connID {
+			return Client{User: proc.User, Address: proc.Host}, true
+		}
+	}
+	return Client{}, false
+}
+
+// KillConnection terminates all queries for a given connection id and closes the connection, if it's registered.
+func (pl *ProcessList) KillConnection(connID uint32) {
+	pl.Kill(connID)
+
+	pl.mu.RLock()
+	conn, ok := pl.conns[connID]
+	pl.mu.RUnlock()
+	if ok {
+		logrus.Infof("mysql/server kill connection: id %d", connID)
+		conn.close()
+	}
+}
+
 // Done removes the finished process with the given pid from the process list.
 // If the process does not exist, it will do nothing.
 func (pl *ProcessList) Done(pid uint64) {
@@ -302,3 +389,10 @@ func (pl *ProcessList) Processes() []Process {
 
 	return result
 }
+
+// Size returns the number of current running processes.
+func (pl *ProcessList) Size() int {
+	pl.mu.RLock()
+	defer pl.mu.RUnlock()
+	return len(pl.procs)
+}
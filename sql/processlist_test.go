@@ -45,6 +45,7 @@ func TestProcessList(t *testing.T) {
 			"b": {Progress{Name: "b", Done: 0, Total: 6}, map[string]PartitionProgress{}},
 		},
 		User:      "foo",
+		Host:      "127.0.0.1:34567",
 		Query:     "SELECT foo",
 		StartedAt: p.procs[ctx.Pid()].StartedAt,
 	}
@@ -150,3 +151,36 @@ func TestKillConnection(t *testing.T) {
 	require.False(t, killed[2])
 	require.True(t, killed[3])
 }
+
+func TestProcessListConnections(t *testing.T) {
+	require := require.New(t)
+	pl := NewProcessList()
+
+	closed := false
+	pl.AddConnection(1, func() { closed = true })
+	client, ok := pl.ConnectionClient(1)
+	require.True(ok)
+	require.Equal(Client{}, client)
+
+	pl.SetConnectionClient(1, Client{User: "foo", Address: "localhost"})
+	client, ok = pl.ConnectionClient(1)
+	require.True(ok)
+	require.Equal(Client{User: "foo", Address: "localhost"}, client)
+	require.Equal([]ConnectionInfo{{ID: 1, User: "foo", Host: "localhost"}}, pl.Connections())
+
+	// Connections which aren't registered exist while they run queries
+	_, ok = pl.ConnectionClient(2)
+	require.False(ok)
+	_, err := pl.AddProcess(NewContext(context.Background(), WithPid(1), WithSession(NewSession("", Client{User: "bar", Address: "127.0.0.1:34567"}, 2))), "foo")
+	require.NoError(err)
+	client, ok = pl.ConnectionClient(2)
+	require.True(ok)
+	require.Equal(Client{User: "bar", Address: "127.0.0.1:34567"}, client)
+
+	pl.KillConnection(1)
+	require.True(closed)
+
+	pl.RemoveConnection(1)
+	_, ok = pl.ConnectionClient(1)
+	require.False(ok)
+}
@@ -0,0 +1,140 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+// ErrReplicationNotSupported is returned by the replication statements when the engine can't act as a replica.
+var ErrReplicationNotSupported = errors.NewKind("This server can't act as a replica")
+
+// ErrReplicaNotConfigured is returned when starting a replica whose source wasn't set with CHANGE REPLICATION SOURCE TO.
+var ErrReplicaNotConfigured = errors.NewKind("The server is not configured as replica; fix in config file or with CHANGE REPLICATION SOURCE TO")
+
+// ErrReplicaRunning is returned when changing the source of a replica which is running.
+var ErrReplicaRunning = errors.NewKind("This operation cannot be performed with a running replica; run STOP REPLICA first")
+
+// ErrUnknownReplicationOption is returned when CHANGE REPLICATION SOURCE TO sets an option which isn't one of
+// ReplicationSource.
+var ErrUnknownReplicationOption = errors.NewKind("unknown replication source option %s")
+
+// Replica keeps the databases of the engine in sync with a MySQL primary, by applying the events of its binary log.
+// The replication statements control the replica set on the Catalog.
+type Replica interface {
+	// Source returns the source of the replica, which is the zero ReplicationSource if it wasn't set.
+	Source() ReplicationSource
+	// SetSource sets the source of the replica, which must not be running.
+	SetSource(ctx *Context, source ReplicationSource) error
+	// Start starts replicating from the source, if the replica isn't running yet.
+	Start(ctx *Context) error
+	// Stop stops replicating, if the replica is running.
+	Stop(ctx *Context) error
+	// Status returns the status of the replica.
+	Status() ReplicaStatus
+}
+
+// ReplicationSource is the primary a replica replicates from, and the position in its binary log of the next event to
+// apply.
+type ReplicationSource struct {
+	Host     string
+	Port     uint16
+	User     string
+	Password string
+	// LogFile is the name of the file of the binary log holding the next event to apply, or empty for the first one.
+	LogFile string
+	// LogPos is the position of the next event to apply in LogFile.
+	LogPos uint64
+	// ConnectRetry is the number of seconds between attempts to connect to the source.
+	ConnectRetry uint32
+}
+
+// Set sets the option of the source with the name given, such as SOURCE_HOST, to the value given. The options can also
+// be named with a MASTER_ prefix.
+func (s *ReplicationSource) Set(name string, value interface{}) error {
+	name = strings.ToUpper(name)
+	if strings.HasPrefix(name, "MASTER_") {
+		name = "SOURCE_" + strings.TrimPrefix(name, "MASTER_")
+	}
+
+	switch name {
+	case "SOURCE_HOST", "SOURCE_USER", "SOURCE_PASSWORD", "SOURCE_LOG_FILE":
+		val, err := LongText.Convert(value)
+		if err != nil {
+			return err
+		}
+		str := val.(string)
+		switch name {
+		case "SOURCE_HOST":
+			s.Host = str
+		case "SOURCE_USER":
+			s.User = str
+		case "SOURCE_PASSWORD":
+			s.Password = str
+		default:
+			s.LogFile = str
+		}
+	case "SOURCE_PORT":
+		port, err := Uint16.Convert(value)
+		if err != nil {
+			return err
+		}
+		s.Port = port.(uint16)
+	case "SOURCE_LOG_POS":
+		pos, err := Uint64.Convert(value)
+		if err != nil {
+			return err
+		}
+		s.LogPos = pos.(uint64)
+	case "SOURCE_CONNECT_RETRY":
+		retry, err := Uint32.Convert(value)
+		if err != nil {
+			return err
+		}
+		s.ConnectRetry = retry.(uint32)
+	default:
+		return ErrUnknownReplicationOption.New(name)
+	}
+	return nil
+}
+
+// Addr returns the address of the source.
+func (s ReplicationSource) Addr() string {
+	return fmt.Sprintf("%s:%d", s.Host, s.Port)
+}
+
+// ReplicaStatus is the status of a replica, as shown by SHOW REPLICA STATUS.
+type ReplicaStatus struct {
+	Source ReplicationSource
+	// IORunning is whether the replica is connected to its source, or trying to.
+	IORunning bool
+	// SQLRunning is whether the replica applies the events it reads.
+	SQLRunning bool
+	// ReadLogFile and ReadLogPos are the position in the binary log of the source of the next event to read.
+	ReadLogFile string
+	ReadLogPos  uint64
+	// ExecLogFile and ExecLogPos are the position in the binary log of the source up to which the events were applied.
+	ExecLogFile string
+	ExecLogPos  uint64
+	// SourceServerID is the server id of the source, once the replica read an event from it.
+	SourceServerID uint32
+	// LastIOError is the last error connecting to the source or reading its events, and LastSQLError the last error
+	// applying them. They are cleared when the replica is started.
+	LastIOError  string
+	LastSQLError string
+}
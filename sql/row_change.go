@@ -0,0 +1,216 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+	"sync"
+)
+
+// RowChange is a change made to a row of a table: an insert when Old is nil, a delete when New is nil, and an update
+// otherwise. A change which is a Reset removed every row of a table, or of some of its partitions, at once.
+type RowChange struct {
+	// Database is the name of the database of the table.
+	Database string
+	// Table is the name of the table.
+	Table string
+	// Schema is the schema of the table when the row was changed.
+	Schema Schema
+	// Old is the row before the change.
+	Old Row
+	// New is the row after the change.
+	New Row
+	// Reset is whether the change removed every row of the table without reading them, like TRUNCATE TABLE and DROP
+	// TABLE do, or every row of its Partitions when there are some, like ALTER TABLE ... TRUNCATE PARTITION and DROP
+	// PARTITION do. Old and New are nil, and Schema is the schema of the table before the change.
+	Reset bool
+	// Partitions are the names of the partitions whose rows a Reset removed, or nil if it removed every row.
+	Partitions []string
+}
+
+// PendingChanges holds the row changes made in a session which are yet to be passed to the RowChangeListeners: the
+// ones of its current transaction, and the ones of the transactions it committed since they were last taken. Changes
+// are only added to it while it's recording, which the engine turns on while there's a listener or a binary log.
+type PendingChanges struct {
+	mu         sync.Mutex
+	recording  bool
+	log        func(changes []RowChange) error
+	changes    []RowChange
+	savepoints []changesSavepoint
+	committed  [][]RowChange
+}
+
+// commitMu orders the commits of the transactions of every session, so that transactions are logged in the order
+// they're committed.
+var commitMu sync.Mutex
+
+// changesSavepoint is a savepoint of a transaction, along with the number of changes made before it was created.
+type changesSavepoint struct {
+	name string
+	len  int
+}
+
+// SetRecording sets whether changes are added to the pending changes.
+func (p *PendingChanges) SetRecording(recording bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.recording = recording
+}
+
+// Recording returns whether changes are added to the pending changes.
+func (p *PendingChanges) Recording() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.recording
+}
+
+// SetLog sets the function the changes of each transaction are logged with as it's committed, such as to a binary log,
+// or nil if they aren't logged.
+func (p *PendingChanges) SetLog(log func(changes []RowChange) error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.log = log
+}
+
+// Add adds a change to the current transaction, if the pending changes are recording.
+func (p *PendingChanges) Add(change RowChange) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.recording {
+		p.changes = append(p.changes, change)
+	}
+}
+
+// Len returns the number of changes made in the current transaction.
+func (p *PendingChanges) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.changes)
+}
+
+// Truncate discards the changes made in the current transaction after the first n, such as the ones of a statement
+// which failed.
+func (p *PendingChanges) Truncate(n int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if n < len(p.changes) {
+		p.changes = p.changes[:n]
+	}
+}
+
+// CreateSavepoint creates a savepoint with the name given in the current transaction, replacing any savepoint with the
+// same name.
+func (p *PendingChanges) CreateSavepoint(name string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if i := p.savepoint(name); i >= 0 {
+		p.savepoints = append(p.savepoints[:i], p.savepoints[i+1:]...)
+	}
+	p.savepoints = append(p.savepoints, changesSavepoint{name: name, len: len(p.changes)})
+}
+
+// RollbackToSavepoint discards the changes made in the current transaction after the savepoint with the name given was
+// created, along with the savepoints created after it. It does nothing if there's no such savepoint.
+func (p *PendingChanges) RollbackToSavepoint(name string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if i := p.savepoint(name); i >= 0 {
+		p.changes = p.changes[:p.savepoints[i].len]
+		p.savepoints = p.savepoints[:i+1]
+	}
+}
+
+// ReleaseSavepoint removes the savepoint with the name given from the current transaction, along with the savepoints
+// created after it, keeping the changes made since.
+func (p *PendingChanges) ReleaseSavepoint(name string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if i := p.savepoint(name); i >= 0 {
+		p.savepoints = p.savepoints[:i]
+	}
+}
+
+func (p *PendingChanges) savepoint(name string) int {
+	for i := len(p.savepoints) - 1; i >= 0; i-- {
+		if strings.EqualFold(p.savepoints[i].name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Commit ends the current transaction, whose changes are logged and kept to be taken by TakeCommitted.
+func (p *PendingChanges) Commit() error {
+	return p.CommitWith(nil)
+}
+
+// CommitWith commits the current transaction with the function given, if any, such as the commit of a
+// TransactionDatabase, and ends it once it succeeds. Its changes are logged and kept to be taken by TakeCommitted. While
+// recording, the transaction is committed and logged under a lock shared by every session, so that transactions are
+// logged in the order they're committed. An error logging the changes is returned once the transaction has ended.
+func (p *PendingChanges) CommitWith(commit func() error) error {
+	if p.Recording() {
+		commitMu.Lock()
+		defer commitMu.Unlock()
+	}
+	if commit != nil {
+		if err := commit(); err != nil {
+			return err
+		}
+	}
+
+	p.mu.Lock()
+	changes, log := p.changes, p.log
+	if len(changes) > 0 {
+		p.committed = append(p.committed, changes)
+	}
+	p.changes = nil
+	p.savepoints = nil
+	p.mu.Unlock()
+
+	if len(changes) > 0 && log != nil {
+		return log(changes)
+	}
+	return nil
+}
+
+// CommitReset adds a change which is a Reset as a transaction of its own, committed after the transactions committed
+// so far. Removing every row of a table isn't undone when the transaction it's made in is rolled back, so its change
+// isn't part of it. It does nothing if the pending changes aren't recording.
+func (p *PendingChanges) CommitReset(change RowChange) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.recording {
+		p.committed = append(p.committed, []RowChange{change})
+	}
+}
+
+// Rollback ends the current transaction, discarding its changes.
+func (p *PendingChanges) Rollback() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.changes = nil
+	p.savepoints = nil
+}
+
+// TakeCommitted returns the changes of the transactions committed since they were last taken, one slice for each
+// transaction in the order they were committed, and removes them from the pending changes.
+func (p *PendingChanges) TakeCommitted() [][]RowChange {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	committed := p.committed
+	p.committed = nil
+	return committed
+}
@@ -0,0 +1,114 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import "sync"
+
+// CommittedTransaction is a transaction committed through the engine, along with the rows it changed.
+type CommittedTransaction struct {
+	// ID identifies the transaction among the ones committed through the engine. IDs start at 1 and increase in the
+	// order listeners are notified of transactions.
+	ID uint64
+	// Changes are the row changes made by the transaction, in the order they were made.
+	Changes []RowChange
+}
+
+// RowChangeListener is notified of the row changes of the transactions committed through the engine, which are the
+// ones made by the inserters, updaters, deleters and replacers of the statements it executes. The changes of a
+// transaction are only known once it's committed, and never if it's rolled back; the ones of a statement which fails
+// are discarded.
+type RowChangeListener interface {
+	// TransactionCommitted is called with the changes of a transaction once it's committed, with the context of the
+	// session which committed it. Listeners are notified of one transaction at a time, so they must not commit
+	// transactions through the engine themselves. An error is returned to the statement which committed the
+	// transaction, whose changes are kept.
+	TransactionCommitted(ctx *Context, tx CommittedTransaction) error
+}
+
+// RowChangeListenerFunc is a function which is a RowChangeListener.
+type RowChangeListenerFunc func(ctx *Context, tx CommittedTransaction) error
+
+// TransactionCommitted implements the interface RowChangeListener.
+func (f RowChangeListenerFunc) TransactionCommitted(ctx *Context, tx CommittedTransaction) error {
+	return f(ctx, tx)
+}
+
+// RowChangeListeners are the listeners of the row changes committed through the engine. The engine only records the
+// row changes of its statements while there's one.
+type RowChangeListeners struct {
+	mu        sync.RWMutex
+	listeners []registeredListener
+	nextID    uint64
+	// notifying is held while listeners are notified of a transaction, so they're notified of one at a time.
+	notifying sync.Mutex
+	lastTxID  uint64
+}
+
+// registeredListener is a listener along with the id it was registered with, which removes it.
+type registeredListener struct {
+	id       uint64
+	listener RowChangeListener
+}
+
+// NewRowChangeListeners returns an empty RowChangeListeners.
+func NewRowChangeListeners() *RowChangeListeners {
+	return &RowChangeListeners{}
+}
+
+// Add registers a listener, which is notified after the ones registered before it, and returns a function removing it.
+func (l *RowChangeListeners) Add(listener RowChangeListener) (remove func()) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.nextID++
+	id := l.nextID
+	l.listeners = append(l.listeners, registeredListener{id: id, listener: listener})
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		for i, registered := range l.listeners {
+			if registered.id == id {
+				l.listeners = append(l.listeners[:i:i], l.listeners[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// Listening returns whether there's any listener.
+func (l *RowChangeListeners) Listening() bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return len(l.listeners) > 0
+}
+
+// TransactionCommitted notifies every listener of the changes of a committed transaction, which is given the next
+// transaction id. Every listener is notified even if some return an error, and the first error is returned.
+func (l *RowChangeListeners) TransactionCommitted(ctx *Context, changes []RowChange) error {
+	l.notifying.Lock()
+	defer l.notifying.Unlock()
+	l.lastTxID++
+	tx := CommittedTransaction{ID: l.lastTxID, Changes: changes}
+	l.mu.RLock()
+	listeners := l.listeners
+	l.mu.RUnlock()
+
+	var firstErr error
+	for _, registered := range listeners {
+		if err := registered.listener.TransactionCommitted(ctx, tx); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
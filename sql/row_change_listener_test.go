@@ -0,0 +1,55 @@
+// Copyright 2020-2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRowChangeListeners(t *testing.T) {
+	require := require.New(t)
+	ctx := NewEmptyContext()
+	l := NewRowChangeListeners()
+	require.False(l.Listening())
+	// Transactions are given ids even without listeners
+	require.NoError(l.TransactionCommitted(ctx, nil))
+
+	var notified []string
+	listener := func(name string, err error) RowChangeListener {
+		return RowChangeListenerFunc(func(ctx *Context, tx CommittedTransaction) error {
+			notified = append(notified, fmt.Sprintf("%s:%d:%d", name, tx.ID, len(tx.Changes)))
+			return err
+		})
+	}
+	removeA := l.Add(listener("a", nil))
+	removeB := l.Add(listener("b", fmt.Errorf("b failed")))
+	l.Add(listener("c", fmt.Errorf("c failed")))
+	require.True(l.Listening())
+
+	// Every listener is notified, in the order they were added, and the first error is returned
+	err := l.TransactionCommitted(ctx, []RowChange{{Table: "t", New: NewRow(1)}})
+	require.EqualError(err, "b failed")
+	require.Equal([]string{"a:2:1", "b:2:1", "c:2:1"}, notified)
+
+	notified = nil
+	removeB()
+	removeB()
+	removeA()
+	require.EqualError(l.TransactionCommitted(ctx, nil), "c failed")
+	require.Equal([]string{"c:3:0"}, notified)
+}
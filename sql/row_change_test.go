@@ -0,0 +1,106 @@
+// Copyright 2020-2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPendingChanges(t *testing.T) {
+	require := require.New(t)
+	var p PendingChanges
+	change := func(i int64) RowChange {
+		return RowChange{Database: "db", Table: "t", New: NewRow(i)}
+	}
+
+	// Changes are only added while recording
+	p.Add(change(0))
+	require.Equal(0, p.Len())
+	p.SetRecording(true)
+
+	p.Add(change(1))
+	p.CreateSavepoint("a")
+	p.Add(change(2))
+	p.CreateSavepoint("b")
+	p.Add(change(3))
+	p.RollbackToSavepoint("A")
+	require.Equal(1, p.Len())
+	// The savepoint rolled back to is kept, and the ones after it are removed
+	p.Add(change(4))
+	p.RollbackToSavepoint("b")
+	require.Equal(2, p.Len())
+	p.RollbackToSavepoint("a")
+	require.Equal(1, p.Len())
+	p.Add(change(5))
+	p.ReleaseSavepoint("a")
+	p.RollbackToSavepoint("a")
+	require.Equal(2, p.Len())
+	p.Commit()
+
+	p.Add(change(6))
+	p.Rollback()
+	p.Add(change(7))
+	p.Add(change(8))
+	p.Truncate(1)
+	p.Commit()
+	// Transactions without changes aren't kept
+	p.Commit()
+
+	require.Equal([][]RowChange{{change(1), change(5)}, {change(7)}}, p.TakeCommitted())
+	require.Empty(p.TakeCommitted())
+}
+
+func TestPendingChangesCommitWith(t *testing.T) {
+	require := require.New(t)
+	var p PendingChanges
+	p.SetRecording(true)
+	var logged [][]RowChange
+	p.SetLog(func(changes []RowChange) error {
+		logged = append(logged, changes)
+		return nil
+	})
+	change := RowChange{Database: "db", Table: "t", New: NewRow(int64(1))}
+
+	// A transaction whose commit fails isn't ended
+	p.Add(change)
+	err := p.CommitWith(func() error {
+		return errors.New("commit failed")
+	})
+	require.Error(err)
+	require.Empty(logged)
+	require.Equal(1, p.Len())
+
+	// Transactions are logged as they're committed
+	committed := false
+	require.NoError(p.CommitWith(func() error {
+		committed = true
+		return nil
+	}))
+	require.True(committed)
+	require.Equal([][]RowChange{{change}}, logged)
+	require.Equal(0, p.Len())
+	require.Equal([][]RowChange{{change}}, p.TakeCommitted())
+
+	// The error logging the changes is returned once the transaction has ended
+	p.SetLog(func(changes []RowChange) error {
+		return errors.New("log failed")
+	})
+	p.Add(change)
+	require.Error(p.Commit())
+	require.Equal(0, p.Len())
+}
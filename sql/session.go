@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net"
 	"os"
 	"strings"
 	"sync"
@@ -51,6 +52,14 @@ type Client struct {
 	Capabilities uint32
 }
 
+// Host returns the host of the client's address, without its port.
+func (c Client) Host() string {
+	if host, _, err := net.SplitHostPort(c.Address); err == nil {
+		return host
+	}
+	return c.Address
+}
+
 // Session holds the session data.
 type Session interface {
 	// Address of the server.
@@ -111,6 +120,103 @@ type Session interface {
 	SetLogger(*logrus.Entry)
 }
 
+// RoleSession is a Session which keeps track of the roles activated with SET ROLE. The other sessions have no active
+// roles, and can't activate any.
+type RoleSession interface {
+	Session
+	// ActiveRoles returns the roles activated for this session with SET ROLE
+	ActiveRoles() []UserIdentity
+	// SetActiveRoles replaces the roles which are active for this session
+	SetActiveRoles(roles []UserIdentity)
+}
+
+// PreparedStatementSession is a Session which holds the statements prepared with PREPARE. The other sessions can't
+// prepare statements.
+type PreparedStatementSession interface {
+	Session
+	// SetPreparedStatement stores a statement prepared with PREPARE under the name given, replacing any statement
+	// already prepared with that name.
+	SetPreparedStatement(name string, stmt *PreparedStatement)
+	// GetPreparedStatement returns the statement prepared with PREPARE under the name given, if any.
+	GetPreparedStatement(name string) (*PreparedStatement, bool)
+	// DeletePreparedStatement removes the statement prepared with PREPARE under the name given.
+	DeletePreparedStatement(name string)
+}
+
+// StatusVariableSession is a Session which holds its own values of the status variables which exist in the session
+// context. Only the global values of the status variables are kept for the other sessions.
+type StatusVariableSession interface {
+	Session
+	// IncrementStatusVariable adds delta to this session's value of the status variable with the given name, if it
+	// exists in the session context.
+	IncrementStatusVariable(name string, delta int64)
+	// GetAllStatusVariables returns a copy of this session's values of the status variables.
+	GetAllStatusVariables() map[string]int64
+}
+
+// RowChangeSession is a Session which holds the row changes made in it, for them to be passed to the listeners of row
+// changes and logged to the binary log. The changes of the other sessions aren't recorded.
+type RowChangeSession interface {
+	Session
+	// PendingChanges returns the row changes made in this session which are yet to be passed to the listeners of row
+	// changes.
+	PendingChanges() *PendingChanges
+}
+
+// SessionActiveRoles returns the roles active in the session of the context given, if it's a RoleSession.
+func SessionActiveRoles(ctx *Context) []UserIdentity {
+	if s, ok := ctx.Session.(RoleSession); ok {
+		return s.ActiveRoles()
+	}
+	return nil
+}
+
+// SessionPreparedStatement returns the statement prepared under the name given in the session of the context given,
+// if it's a PreparedStatementSession.
+func SessionPreparedStatement(ctx *Context, name string) (*PreparedStatement, bool) {
+	if s, ok := ctx.Session.(PreparedStatementSession); ok {
+		return s.GetPreparedStatement(name)
+	}
+	return nil, false
+}
+
+// SessionStatusVariables returns the values of the status variables in the session of the context given, which are
+// empty if it isn't a StatusVariableSession.
+func SessionStatusVariables(ctx *Context) map[string]int64 {
+	if s, ok := ctx.Session.(StatusVariableSession); ok {
+		return s.GetAllStatusVariables()
+	}
+	return nil
+}
+
+// SessionPendingChanges returns the pending row changes of the session of the context given. Sessions which aren't
+// RowChangeSessions get new, empty pending changes every time, which never record any change.
+func SessionPendingChanges(ctx *Context) *PendingChanges {
+	if s, ok := ctx.Session.(RowChangeSession); ok {
+		return s.PendingChanges()
+	}
+	return new(PendingChanges)
+}
+
+// SessionVariableInitializer is a Session whose system variables can be given values which SetSessionVariable refuses,
+// such as those of the read-only variables reflecting how the engine running its queries is configured.
+type SessionVariableInitializer interface {
+	Session
+	// InitSessionVariable sets the value of a system variable in the session, whatever its scope and whether it's
+	// dynamic.
+	InitSessionVariable(ctx *Context, sysVarName string, value interface{}) error
+}
+
+// PreparedStatement is a statement prepared in a session with PREPARE, to be run with EXECUTE.
+type PreparedStatement struct {
+	// Query is the text of the statement.
+	Query string
+	// Node is the parsed statement, with a BindVar for each of its parameters.
+	Node Node
+	// Params is the number of parameters of the statement, which EXECUTE must be given a value for.
+	Params int
+}
+
 // BaseSession is the basic session type.
 type BaseSession struct {
 	id               uint32
@@ -128,6 +234,10 @@ type BaseSession struct {
 	lastQueryInfo    map[string]int64
 	tx               Transaction
 	ignoreAutocommit bool
+	activeRoles      []UserIdentity
+	prepared         map[string]*PreparedStatement
+	statusVars       map[string]int64
+	pendingChanges   PendingChanges
 }
 
 func (s *BaseSession) GetLogger() *logrus.Entry {
@@ -155,11 +265,82 @@ func (s *BaseSession) GetIgnoreAutoCommit() bool {
 	return s.ignoreAutocommit
 }
 
-var _ Session = (*BaseSession)(nil)
+// ActiveRoles implements the RoleSession interface.
+func (s *BaseSession) ActiveRoles() []UserIdentity {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]UserIdentity(nil), s.activeRoles...)
+}
+
+// SetActiveRoles implements the RoleSession interface.
+func (s *BaseSession) SetActiveRoles(roles []UserIdentity) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.activeRoles = append([]UserIdentity(nil), roles...)
+}
+
+// SetPreparedStatement implements the PreparedStatementSession interface.
+func (s *BaseSession) SetPreparedStatement(name string, stmt *PreparedStatement) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.prepared == nil {
+		s.prepared = make(map[string]*PreparedStatement)
+	}
+	s.prepared[strings.ToLower(name)] = stmt
+}
+
+// GetPreparedStatement implements the PreparedStatementSession interface.
+func (s *BaseSession) GetPreparedStatement(name string) (*PreparedStatement, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	stmt, ok := s.prepared[strings.ToLower(name)]
+	return stmt, ok
+}
+
+// DeletePreparedStatement implements the PreparedStatementSession interface.
+func (s *BaseSession) DeletePreparedStatement(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.prepared, strings.ToLower(name))
+}
+
+// IncrementStatusVariable implements the StatusVariableSession interface.
+func (s *BaseSession) IncrementStatusVariable(name string, delta int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.statusVars[name]; ok {
+		s.statusVars[name] += delta
+	}
+}
+
+// GetAllStatusVariables implements the StatusVariableSession interface.
+func (s *BaseSession) GetAllStatusVariables() map[string]int64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	vals := make(map[string]int64, len(s.statusVars))
+	for name, val := range s.statusVars {
+		vals[name] = val
+	}
+	return vals
+}
 
-// CommitTransaction commits the current transaction for the current database.
-func (s *BaseSession) CommitTransaction(*Context, string, Transaction) error {
-	// no-op on BaseSession
+// PendingChanges implements the RowChangeSession interface.
+func (s *BaseSession) PendingChanges() *PendingChanges {
+	return &s.pendingChanges
+}
+
+var _ Session = (*BaseSession)(nil)
+var _ RoleSession = (*BaseSession)(nil)
+var _ PreparedStatementSession = (*BaseSession)(nil)
+var _ StatusVariableSession = (*BaseSession)(nil)
+var _ RowChangeSession = (*BaseSession)(nil)
+
+// CommitTransaction commits the current transaction for the current database. BaseSession only commits transactions
+// which are CommittableTransactions, for others it's a no-op.
+func (s *BaseSession) CommitTransaction(ctx *Context, _ string, tx Transaction) error {
+	if tx, ok := tx.(CommittableTransaction); ok {
+		return tx.Commit(ctx)
+	}
 	return nil
 }
 
@@ -203,6 +384,22 @@ func (s *BaseSession) SetSessionVariable(ctx *Context, sysVarName string, value
 	return nil
 }
 
+// InitSessionVariable implements the SessionVariableInitializer interface.
+func (s *BaseSession) InitSessionVariable(ctx *Context, sysVarName string, value interface{}) error {
+	sysVar, _, ok := SystemVariables.GetGlobal(sysVarName)
+	if !ok {
+		return ErrUnknownSystemVariable.New(sysVarName)
+	}
+	convertedVal, err := sysVar.Type.Convert(value)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.systemVars[sysVar.Name] = convertedVal
+	return nil
+}
+
 // SetUserVariable implements the Session interface.
 func (s *BaseSession) SetUserVariable(ctx *Context, varName string, value interface{}) error {
 	s.mu.Lock()
@@ -430,6 +627,7 @@ func NewSession(server string, client Client, id uint32) Session {
 		mu:            sync.RWMutex{},
 		locks:         make(map[string]bool),
 		lastQueryInfo: defaultLastQueryInfo(),
+		statusVars:    StatusVariables.NewSessionMap(),
 	}
 }
 
@@ -445,6 +643,7 @@ func NewBaseSession() Session {
 		mu:            sync.RWMutex{},
 		locks:         make(map[string]bool),
 		lastQueryInfo: defaultLastQueryInfo(),
+		statusVars:    StatusVariables.NewSessionMap(),
 	}
 }
 
@@ -460,6 +659,7 @@ type Context struct {
 	queryTime time.Time
 	tracer    opentracing.Tracer
 	rootSpan  opentracing.Span
+	stats     *QueryStats
 }
 
 // ContextOption is a function to configure the context.
@@ -544,7 +744,7 @@ func NewContext(
 	ctx context.Context,
 	opts ...ContextOption,
 ) *Context {
-	c := &Context{ctx, NewBaseSession(), nil, nil, nil, 0, "", ctxNowFunc(), opentracing.NoopTracer{}, nil}
+	c := &Context{ctx, NewBaseSession(), nil, nil, nil, 0, "", ctxNowFunc(), opentracing.NoopTracer{}, nil, nil}
 	for _, opt := range opts {
 		opt(c)
 	}
@@ -609,6 +809,7 @@ func (c *Context) Span(
 		queryTime:     c.queryTime,
 		tracer:        c.tracer,
 		rootSpan:      c.rootSpan,
+		stats:         c.stats,
 	}
 }
 
@@ -627,6 +828,7 @@ func (c *Context) NewSubContext() (*Context, context.CancelFunc) {
 		queryTime:     c.queryTime,
 		tracer:        c.tracer,
 		rootSpan:      c.rootSpan,
+		stats:         c.stats,
 	}, cancelFunc
 }
 
@@ -648,14 +850,73 @@ func (c *Context) WithContext(ctx context.Context) *Context {
 		queryTime:     c.queryTime,
 		tracer:        c.tracer,
 		rootSpan:      c.rootSpan,
+		stats:         c.stats,
 	}
 }
 
+// WithQuery returns a new context with the given query.
+func (c *Context) WithQuery(q string) *Context {
+	nc := c.WithContext(c.Context)
+	nc.query = q
+	return nc
+}
+
 // RootSpan returns the root span, if any.
 func (c *Context) RootSpan() opentracing.Span {
 	return c.rootSpan
 }
 
+// QueryStats are statistics about the execution of a query, which are collected from every context derived from the
+// context of the query.
+type QueryStats struct {
+	rowsExamined int64
+	lockTime     int64
+	fullScan     int32
+}
+
+// RowsExamined returns the number of rows read from tables.
+func (s *QueryStats) RowsExamined() int64 {
+	return atomic.LoadInt64(&s.rowsExamined)
+}
+
+// LockTime returns the time spent waiting for locks.
+func (s *QueryStats) LockTime() time.Duration {
+	return time.Duration(atomic.LoadInt64(&s.lockTime))
+}
+
+// FullScan returns whether any table was read without using an index.
+func (s *QueryStats) FullScan() bool {
+	return atomic.LoadInt32(&s.fullScan) != 0
+}
+
+// TrackQueryStats starts collecting the statistics of a new query in this context and the contexts derived from it
+// afterwards, and returns them.
+func (c *Context) TrackQueryStats() *QueryStats {
+	c.stats = new(QueryStats)
+	return c.stats
+}
+
+// AddRowsExamined adds to the number of rows read from tables by the query, if its statistics are tracked.
+func (c *Context) AddRowsExamined(n int64) {
+	if c.stats != nil {
+		atomic.AddInt64(&c.stats.rowsExamined, n)
+	}
+}
+
+// AddLockTime adds to the time the query spent waiting for locks, if its statistics are tracked.
+func (c *Context) AddLockTime(d time.Duration) {
+	if c.stats != nil {
+		atomic.AddInt64(&c.stats.lockTime, int64(d))
+	}
+}
+
+// SetFullScan records that the query read a table without using an index, if its statistics are tracked.
+func (c *Context) SetFullScan() {
+	if c.stats != nil {
+		atomic.StoreInt32(&c.stats.fullScan, 1)
+	}
+}
+
 // Error adds an error as warning to the session.
 func (c *Context) Error(code int, msg string, args ...interface{}) {
 	c.Session.Warn(&Warning{
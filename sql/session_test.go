@@ -142,3 +142,30 @@ func TestSessionIterator(t *testing.T) {
 
 	cancelFunc()
 }
+
+type testTransaction struct {
+	committed bool
+}
+
+func (t *testTransaction) String() string { return "test transaction" }
+
+func (t *testTransaction) Commit(*Context) error {
+	t.committed = true
+	return nil
+}
+
+type opaqueTransaction struct{}
+
+func (opaqueTransaction) String() string { return "opaque transaction" }
+
+func TestBaseSessionCommitTransaction(t *testing.T) {
+	require := require.New(t)
+	ctx := NewEmptyContext()
+	sess := NewBaseSession()
+
+	tx := &testTransaction{}
+	require.NoError(sess.CommitTransaction(ctx, "db", tx))
+	require.True(tx.committed)
+
+	require.NoError(sess.CommitTransaction(ctx, "db", opaqueTransaction{}))
+}
@@ -0,0 +1,216 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+var (
+	// ErrCannotGetGeometryObject is returned when a value which isn't a geometry of the type of a column is stored in
+	// it.
+	ErrCannotGetGeometryObject = errors.NewKind("Cannot get geometry object from data you send to the GEOMETRY field")
+
+	// ErrWrongSRIDForColumn is returned when a geometry whose SRID isn't the one of a column is stored in it.
+	ErrWrongSRIDForColumn = errors.NewKind("The SRID of the geometry does not match the SRID of the column. The SRID of the geometry is %d, but the SRID of the column is %d. Consider changing the SRID of the geometry or the SRID property of the column.")
+
+	// ErrInvalidSpatialType is returned when a spatial type is created with a name which isn't the one of a spatial type.
+	ErrInvalidSpatialType = errors.NewKind("%s is not a spatial type")
+)
+
+// Geometry is the GEOMETRY type, which holds geometries of any type and any SRID.
+var Geometry = MustCreateSpatialType("GEOMETRY", 0, false)
+
+// spatialTypeNames are the names of the spatial types.
+var spatialTypeNames = map[string]bool{
+	"GEOMETRY":           true,
+	"POINT":              true,
+	"LINESTRING":         true,
+	"POLYGON":            true,
+	"MULTIPOINT":         true,
+	"MULTILINESTRING":    true,
+	"MULTIPOLYGON":       true,
+	"GEOMETRYCOLLECTION": true,
+}
+
+// SpatialType is one of the spatial types, whose values are GeometryValues.
+type SpatialType interface {
+	Type
+	// SRID returns the SRID the values of the type are restricted to, and whether they're restricted to one.
+	SRID() (uint32, bool)
+}
+
+type spatialType struct {
+	name        string
+	srid        uint32
+	definedSRID bool
+}
+
+var _ SpatialType = spatialType{}
+
+// CreateSpatialType creates a spatial type of the name given, such as POINT. If definedSRID is true, the values of the
+// type are restricted to the spatial reference system of the SRID given.
+func CreateSpatialType(name string, srid uint32, definedSRID bool) (SpatialType, error) {
+	name = strings.ToUpper(name)
+	if !spatialTypeNames[name] {
+		return nil, ErrInvalidSpatialType.New(name)
+	}
+	if definedSRID {
+		if _, err := LookupSpatialReferenceSystem(srid); err != nil {
+			return nil, err
+		}
+	}
+	return spatialType{name: name, srid: srid, definedSRID: definedSRID}, nil
+}
+
+// MustCreateSpatialType is the same as CreateSpatialType except it panics on errors.
+func MustCreateSpatialType(name string, srid uint32, definedSRID bool) SpatialType {
+	t, err := CreateSpatialType(name, srid, definedSRID)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// Compare implements Type interface. Geometries are compared by the bytes they're stored as.
+func (t spatialType) Compare(a interface{}, b interface{}) (int, error) {
+	if hasNulls, res := compareNulls(a, b); hasNulls {
+		return res, nil
+	}
+	ag, err := toGeometry(a)
+	if err != nil {
+		return 0, err
+	}
+	bg, err := toGeometry(b)
+	if err != nil {
+		return 0, err
+	}
+	return bytes.Compare(SerializeGeometry(ag), SerializeGeometry(bg)), nil
+}
+
+// Convert implements Type interface. Geometries and their stored bytes are converted to geometries, which must be of the
+// type and of its SRID if it's restricted to one.
+func (t spatialType) Convert(v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	g, err := toGeometry(v)
+	if err != nil {
+		return nil, err
+	}
+	if !t.accepts(g) {
+		return nil, ErrCannotGetGeometryObject.New()
+	}
+	if t.definedSRID && g.GetSRID() != t.srid {
+		return nil, ErrWrongSRIDForColumn.New(g.GetSRID(), t.srid)
+	}
+	return g, nil
+}
+
+// toGeometry returns the geometry of a value, which is either a geometry or the bytes it's stored as.
+func toGeometry(v interface{}) (GeometryValue, error) {
+	switch v := v.(type) {
+	case GeometryValue:
+		return v, nil
+	case []byte:
+		if g, ok := DeserializeGeometry(v); ok {
+			return g, nil
+		}
+	case string:
+		if g, ok := DeserializeGeometry([]byte(v)); ok {
+			return g, nil
+		}
+	}
+	return nil, ErrCannotGetGeometryObject.New()
+}
+
+// accepts returns whether a geometry is of the type. Geometry collections hold the multi-geometries too.
+func (t spatialType) accepts(g GeometryValue) bool {
+	switch t.name {
+	case "GEOMETRY":
+		return true
+	case "GEOMETRYCOLLECTION":
+		switch g.(type) {
+		case GeometryCollection, MultiPoint, MultiLineString, MultiPolygon:
+			return true
+		}
+		return false
+	default:
+		return g.GeometryType() == t.name
+	}
+}
+
+// Promote implements the Type interface.
+func (t spatialType) Promote() Type {
+	return Geometry
+}
+
+// SQL implements Type interface. Geometries are sent as the bytes they're stored as.
+func (t spatialType) SQL(v interface{}) (sqltypes.Value, error) {
+	if v == nil {
+		return sqltypes.NULL, nil
+	}
+	g, err := t.Convert(v)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	return sqltypes.MakeTrusted(sqltypes.Geometry, SerializeGeometry(g.(GeometryValue))), nil
+}
+
+// String implements Type interface.
+func (t spatialType) String() string {
+	return t.name
+}
+
+// Type implements Type interface.
+func (t spatialType) Type() query.Type {
+	return sqltypes.Geometry
+}
+
+// Zero implements Type interface.
+func (t spatialType) Zero() interface{} {
+	return nil
+}
+
+// SRID implements SpatialType interface.
+func (t spatialType) SRID() (uint32, bool) {
+	return t.srid, t.definedSRID
+}
+
+// SRIDComment returns the versioned comment which declares the SRID of a column of the type, as it's shown by SHOW
+// CREATE TABLE, or an empty string if the type isn't restricted to an SRID.
+func SRIDComment(t Type) string {
+	st, ok := t.(SpatialType)
+	if !ok {
+		return ""
+	}
+	srid, ok := st.SRID()
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("/*!80003 SRID %d */", srid)
+}
+
+// IsSpatial checks if t is one of the spatial types.
+func IsSpatial(t Type) bool {
+	_, ok := t.(spatialType)
+	return ok
+}
@@ -0,0 +1,81 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+func TestSpatialTypeConvert(t *testing.T) {
+	point := Point{X: 1, Y: 2}
+	line := LineString{Points: []Point{{X: 0, Y: 0}, {X: 1, Y: 1}}}
+	multi := MultiPoint{Points: []Point{{X: 0, Y: 0}}}
+
+	tests := []struct {
+		typ      SpatialType
+		val      interface{}
+		expected interface{}
+		err      *errors.Kind
+	}{
+		{Geometry, nil, nil, nil},
+		{Geometry, point, point, nil},
+		{Geometry, SerializeGeometry(line), line, nil},
+		{Geometry, string(SerializeGeometry(line)), line, nil},
+		{Geometry, "POINT(1 2)", nil, ErrCannotGetGeometryObject},
+		{Geometry, 1, nil, ErrCannotGetGeometryObject},
+		{MustCreateSpatialType("POINT", 0, false), point, point, nil},
+		{MustCreateSpatialType("POINT", 0, false), line, nil, ErrCannotGetGeometryObject},
+		{MustCreateSpatialType("GEOMETRYCOLLECTION", 0, false), multi, multi, nil},
+		{MustCreateSpatialType("POINT", 4326, true), point, nil, ErrWrongSRIDForColumn},
+		{MustCreateSpatialType("POINT", 4326, true), point.SetSRID(4326), point.SetSRID(4326), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.typ.String(), func(t *testing.T) {
+			val, err := tt.typ.Convert(tt.val)
+			if tt.err != nil {
+				require.Error(t, err)
+				assert.True(t, tt.err.Is(err), "unexpected error %v", err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, val)
+			}
+		})
+	}
+}
+
+func TestCreateSpatialType(t *testing.T) {
+	require := require.New(t)
+
+	typ, err := CreateSpatialType("point", 4326, true)
+	require.NoError(err)
+	require.Equal("POINT", typ.String())
+	srid, ok := typ.SRID()
+	require.True(ok)
+	require.Equal(uint32(4326), srid)
+	require.Equal("/*!80003 SRID 4326 */", SRIDComment(typ))
+	require.Equal("", SRIDComment(Geometry))
+	require.True(IsSpatial(typ))
+	require.False(IsSpatial(Int64))
+
+	_, err = CreateSpatialType("CIRCLE", 0, false)
+	require.True(ErrInvalidSpatialType.Is(err))
+	_, err = CreateSpatialType("POINT", 1234, true)
+	require.True(ErrUnknownSRID.Is(err))
+}
@@ -0,0 +1,281 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultStatementHistorySize is the number of statements kept in the history of each thread, as the
+	// performance_schema_events_statements_history_size system variable defaults to in MySQL.
+	DefaultStatementHistorySize = 10
+	// DefaultStatementDigestsSize is the number of digests summarized, as the performance_schema_digests_size system
+	// variable defaults to in MySQL. Statements with other digests are summarized in a row without a digest.
+	DefaultStatementDigestsSize = 10000
+)
+
+// StatementEvent is the execution of a statement by a thread, which is a connection.
+type StatementEvent struct {
+	ThreadID uint32
+	// EventID numbers the statements of a thread from 1
+	EventID uint64
+	// EventName is the instrument of the statement, such as statement/sql/select
+	EventName  string
+	SQLText    string
+	Digest     string
+	DigestText string
+	// Schema is the current database of the thread when the statement started
+	Schema    string
+	StartedAt time.Time
+	// EndedAt is zero while the statement runs
+	EndedAt      time.Time
+	RowsAffected uint64
+	RowsSent     uint64
+	// Errors is 1 if the statement failed, and its error is described by ErrorNumber, SQLState and Message
+	Errors      uint64
+	ErrorNumber int
+	SQLState    string
+	Message     string
+}
+
+// Running returns whether the statement hasn't ended.
+func (e StatementEvent) Running() bool {
+	return e.EndedAt.IsZero()
+}
+
+// Duration returns how long the statement ran, or has been running.
+func (e StatementEvent) Duration() time.Duration {
+	if e.Running() {
+		return time.Since(e.StartedAt)
+	}
+	return e.EndedAt.Sub(e.StartedAt)
+}
+
+// StatementDigestSummary aggregates the statements with the same digest in the same schema.
+type StatementDigestSummary struct {
+	Schema string
+	// Digest and DigestText are empty for the summary of the statements whose digests didn't fit
+	Digest          string
+	DigestText      string
+	Count           uint64
+	SumDuration     time.Duration
+	MinDuration     time.Duration
+	MaxDuration     time.Duration
+	SumErrors       uint64
+	SumRowsAffected uint64
+	SumRowsSent     uint64
+	FirstSeen       time.Time
+	LastSeen        time.Time
+	// SampleText is the text of the slowest statement, which ran at SampleSeen for SampleDuration
+	SampleText     string
+	SampleSeen     time.Time
+	SampleDuration time.Duration
+}
+
+// StatementEvents records the statements executed by each thread, and summarizes them by digest.
+type StatementEvents struct {
+	mu sync.RWMutex
+	// startedAt is the start of the timers
+	startedAt   time.Time
+	historySize int
+	digestsSize int
+	eventIDs    map[uint32]uint64
+	current     map[uint32]*StatementEvent
+	history     map[uint32][]StatementEvent
+	digests     map[digestKey]*StatementDigestSummary
+}
+
+type digestKey struct {
+	schema, digest string
+}
+
+// NewStatementEvents returns an empty StatementEvents with the default history and digests sizes.
+func NewStatementEvents() *StatementEvents {
+	return &StatementEvents{
+		startedAt:   time.Now(),
+		historySize: DefaultStatementHistorySize,
+		digestsSize: DefaultStatementDigestsSize,
+		eventIDs:    make(map[uint32]uint64),
+		current:     make(map[uint32]*StatementEvent),
+		history:     make(map[uint32][]StatementEvent),
+		digests:     make(map[digestKey]*StatementDigestSummary),
+	}
+}
+
+// StartedAt returns the start of the timers of the events, whose values are the time elapsed since then.
+func (s *StatementEvents) StartedAt() time.Time {
+	return s.startedAt
+}
+
+// Start records the start of a statement, which becomes the current statement of its thread. The event given is
+// completed with its id and start time, and must be passed to End once the statement ends.
+func (s *StatementEvents) Start(event *StatementEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.eventIDs[event.ThreadID]++
+	event.EventID = s.eventIDs[event.ThreadID]
+	event.StartedAt = time.Now()
+	s.current[event.ThreadID] = event
+}
+
+// End records the end of a statement started with Start, with the rows it affected and sent and its error, if any.
+// It stays the current statement of its thread until the next one starts.
+func (s *StatementEvents) End(event *StatementEvent, rowsAffected, rowsSent uint64, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	event.EndedAt = time.Now()
+	event.RowsAffected = rowsAffected
+	event.RowsSent = rowsSent
+	if err != nil {
+		sqlErr, _ := CastSQLError(err)
+		event.Errors = 1
+		event.ErrorNumber = sqlErr.Num
+		event.SQLState = sqlErr.State
+		event.Message = sqlErr.Message
+	}
+
+	// The thread may have been removed while the statement ran
+	if s.current[event.ThreadID] != event {
+		return
+	}
+
+	history := append(s.history[event.ThreadID], *event)
+	if len(history) > s.historySize {
+		history = history[len(history)-s.historySize:]
+	}
+	s.history[event.ThreadID] = history
+
+	s.summarize(event)
+}
+
+func (s *StatementEvents) summarize(event *StatementEvent) {
+	key := digestKey{event.Schema, event.Digest}
+	summary, ok := s.digests[key]
+	if !ok {
+		if len(s.digests) >= s.digestsSize {
+			key = digestKey{}
+			summary, ok = s.digests[key]
+		}
+		if !ok {
+			summary = &StatementDigestSummary{
+				Schema:     key.schema,
+				Digest:     key.digest,
+				DigestText: event.DigestText,
+				FirstSeen:  event.StartedAt,
+			}
+			if key == (digestKey{}) {
+				summary.DigestText = ""
+			}
+			s.digests[key] = summary
+		}
+	}
+
+	duration := event.Duration()
+	summary.Count++
+	summary.SumDuration += duration
+	if summary.Count == 1 || duration < summary.MinDuration {
+		summary.MinDuration = duration
+	}
+	if duration >= summary.MaxDuration {
+		summary.MaxDuration = duration
+		summary.SampleText = event.SQLText
+		summary.SampleSeen = event.StartedAt
+		summary.SampleDuration = duration
+	}
+	summary.SumErrors += event.Errors
+	summary.SumRowsAffected += event.RowsAffected
+	summary.SumRowsSent += event.RowsSent
+	summary.LastSeen = event.StartedAt
+}
+
+// RemoveThread removes the current statement and the history of a thread, once its connection is closed.
+func (s *StatementEvents) RemoveThread(threadID uint32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.eventIDs, threadID)
+	delete(s.current, threadID)
+	delete(s.history, threadID)
+}
+
+// Current returns the current statement of each thread, sorted by thread.
+func (s *StatementEvents) Current() []StatementEvent {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	events := make([]StatementEvent, 0, len(s.current))
+	for _, e := range s.current {
+		events = append(events, *e)
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i].ThreadID < events[j].ThreadID })
+	return events
+}
+
+// History returns the statements which ended in each thread, sorted by thread and event id.
+func (s *StatementEvents) History() []StatementEvent {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var events []StatementEvent
+	for _, history := range s.history {
+		events = append(events, history...)
+	}
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].ThreadID != events[j].ThreadID {
+			return events[i].ThreadID < events[j].ThreadID
+		}
+		return events[i].EventID < events[j].EventID
+	})
+	return events
+}
+
+// Digests returns the summaries of the statements by digest, sorted by schema and digest.
+func (s *StatementEvents) Digests() []StatementDigestSummary {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	summaries := make([]StatementDigestSummary, 0, len(s.digests))
+	for _, summary := range s.digests {
+		summaries = append(summaries, *summary)
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].Schema != summaries[j].Schema {
+			return summaries[i].Schema < summaries[j].Schema
+		}
+		return summaries[i].Digest < summaries[j].Digest
+	})
+	return summaries
+}
+
+// TruncateHistory removes the history of every thread, and returns the number of statements removed.
+func (s *StatementEvents) TruncateHistory() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := 0
+	for _, history := range s.history {
+		n += len(history)
+	}
+	s.history = make(map[uint32][]StatementEvent)
+	return n
+}
+
+// TruncateDigests removes the summaries by digest, and returns the number of summaries removed.
+func (s *StatementEvents) TruncateDigests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := len(s.digests)
+	s.digests = make(map[digestKey]*StatementDigestSummary)
+	return n
+}
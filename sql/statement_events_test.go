@@ -0,0 +1,102 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatementEvents(t *testing.T) {
+	require := require.New(t)
+	s := NewStatementEvents()
+
+	first := &StatementEvent{ThreadID: 1, SQLText: "SELECT 1", Digest: "a", DigestText: "SELECT ?", Schema: "db"}
+	s.Start(first)
+	require.Equal(uint64(1), first.EventID)
+	require.True(s.Current()[0].Running())
+	require.Empty(s.History())
+
+	s.End(first, 0, 1, nil)
+	second := &StatementEvent{ThreadID: 1, SQLText: "SELECT 2", Digest: "a", DigestText: "SELECT ?", Schema: "db"}
+	s.Start(second)
+	s.End(second, 0, 1, ErrTableNotFound.New("t"))
+	other := &StatementEvent{ThreadID: 2, SQLText: "DELETE FROM t", Digest: "b", DigestText: "DELETE FROM `t`"}
+	s.Start(other)
+	s.End(other, 3, 0, nil)
+
+	current := s.Current()
+	require.Len(current, 2)
+	require.Equal(uint64(2), current[0].EventID)
+	require.False(current[0].Running())
+	require.Equal(uint64(1), current[0].Errors)
+	require.Equal(1146, current[0].ErrorNumber)
+
+	history := s.History()
+	require.Len(history, 3)
+	require.Equal("SELECT 1", history[0].SQLText)
+	require.Equal("SELECT 2", history[1].SQLText)
+	require.Equal("DELETE FROM t", history[2].SQLText)
+
+	digests := s.Digests()
+	require.Len(digests, 2)
+	require.Equal("", digests[0].Schema)
+	require.Equal(uint64(1), digests[0].Count)
+	require.Equal(uint64(3), digests[0].SumRowsAffected)
+	require.Equal("db", digests[1].Schema)
+	require.Equal("SELECT ?", digests[1].DigestText)
+	require.Equal(uint64(2), digests[1].Count)
+	require.Equal(uint64(1), digests[1].SumErrors)
+	require.Equal(uint64(2), digests[1].SumRowsSent)
+	require.True(digests[1].MinDuration <= digests[1].MaxDuration)
+
+	s.RemoveThread(1)
+	require.Len(s.Current(), 1)
+	require.Len(s.History(), 1)
+
+	require.Equal(1, s.TruncateHistory())
+	require.Empty(s.History())
+	require.Equal(2, s.TruncateDigests())
+	require.Empty(s.Digests())
+}
+
+func TestStatementEventsLimits(t *testing.T) {
+	require := require.New(t)
+	s := NewStatementEvents()
+	s.historySize = 2
+	s.digestsSize = 2
+
+	for i := 0; i < 4; i++ {
+		e := &StatementEvent{ThreadID: 1, SQLText: fmt.Sprint(i), Digest: fmt.Sprint(i), DigestText: fmt.Sprint(i)}
+		s.Start(e)
+		s.End(e, 0, 0, nil)
+	}
+
+	history := s.History()
+	require.Len(history, 2)
+	require.Equal(uint64(3), history[0].EventID)
+	require.Equal(uint64(4), history[1].EventID)
+
+	// Statements with digests which don't fit are summarized without a digest
+	digests := s.Digests()
+	require.Len(digests, 3)
+	require.Equal("", digests[0].Digest)
+	require.Equal("", digests[0].DigestText)
+	require.Equal(uint64(2), digests[0].Count)
+	require.Equal("0", digests[1].Digest)
+	require.Equal("1", digests[2].Digest)
+}
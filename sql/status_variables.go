@@ -0,0 +1,205 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// StatusVariableScope represents the scope of a status variable.
+type StatusVariableScope byte
+
+const (
+	// StatusVariableScope_Global is set when the status variable exists only in the global context.
+	StatusVariableScope_Global StatusVariableScope = iota
+	// StatusVariableScope_Session is set when the status variable exists only in the session context.
+	StatusVariableScope_Session
+	// StatusVariableScope_Both is set when the status variable exists in both the global and session contexts. Its
+	// global value is the sum of the values of every session, including the sessions which ended.
+	StatusVariableScope_Both
+)
+
+// String returns the scope as an uppercase string.
+func (s StatusVariableScope) String() string {
+	switch s {
+	case StatusVariableScope_Global:
+		return "GLOBAL"
+	case StatusVariableScope_Session:
+		return "SESSION"
+	case StatusVariableScope_Both:
+		return "GLOBAL, SESSION"
+	default:
+		return "UNKNOWN_STATUS_SCOPE"
+	}
+}
+
+// StatusVariable represents a status variable, which is a counter or a gauge maintained by the server.
+type StatusVariable struct {
+	// Name is the name of the status variable.
+	Name string
+	// Scope defines the scope of the status variable, which is either Global, Session, or Both.
+	Scope StatusVariableScope
+}
+
+// globalStatusVariables is the underlying type of StatusVariables.
+type globalStatusVariables struct {
+	mutex     *sync.RWMutex
+	startedAt time.Time
+	vals      map[string]int64
+}
+
+// StatusVariables is the collection of the global values of the status variables for this process.
+var StatusVariables = &globalStatusVariables{&sync.RWMutex{}, time.Now(), make(map[string]int64)}
+
+// AddStatusVariables adds the given status variables to the collection, with a global value of zero. If a name is
+// already used by an existing variable, then it is overwritten with the new one.
+func (sv *globalStatusVariables) AddStatusVariables(vars []StatusVariable) {
+	sv.mutex.Lock()
+	defer sv.mutex.Unlock()
+	for _, statusVar := range vars {
+		statusVars[statusVar.Name] = statusVar
+		sv.vals[statusVar.Name] = 0
+	}
+}
+
+// GetStatusVariable returns the definition of the status variable with the given name. Case-insensitive.
+func (sv *globalStatusVariables) GetStatusVariable(name string) (StatusVariable, bool) {
+	sv.mutex.RLock()
+	defer sv.mutex.RUnlock()
+	statusVar, ok := lookupStatusVar(name)
+	return statusVar, ok
+}
+
+// GetGlobal returns the global value of the status variable with the given name. If the variable does not exist,
+// returns false. Case-insensitive.
+func (sv *globalStatusVariables) GetGlobal(name string) (int64, bool) {
+	sv.mutex.RLock()
+	defer sv.mutex.RUnlock()
+	statusVar, ok := lookupStatusVar(name)
+	if !ok {
+		return 0, false
+	}
+	return sv.value(statusVar.Name), true
+}
+
+// GetAllGlobal returns a copy of the global values of every status variable.
+func (sv *globalStatusVariables) GetAllGlobal() map[string]int64 {
+	sv.mutex.RLock()
+	defer sv.mutex.RUnlock()
+	vals := make(map[string]int64, len(sv.vals))
+	for name := range sv.vals {
+		vals[name] = sv.value(name)
+	}
+	return vals
+}
+
+func (sv *globalStatusVariables) value(name string) int64 {
+	switch name {
+	case "Uptime", "Uptime_since_flush_status":
+		return int64(time.Since(sv.startedAt) / time.Second)
+	default:
+		return sv.vals[name]
+	}
+}
+
+// Increment adds delta, which is negative for gauges going down, to the global value of the status variable with the
+// given name. Variables which only exist in sessions, or don't exist, are ignored. Case-insensitive.
+func (sv *globalStatusVariables) Increment(name string, delta int64) {
+	sv.mutex.Lock()
+	defer sv.mutex.Unlock()
+	statusVar, ok := lookupStatusVar(name)
+	if !ok || statusVar.Scope == StatusVariableScope_Session {
+		return
+	}
+	sv.vals[statusVar.Name] += delta
+}
+
+// NewSessionMap returns a new map of status variable values for sessions, holding the variables which exist in the
+// session context.
+func (sv *globalStatusVariables) NewSessionMap() map[string]int64 {
+	sv.mutex.RLock()
+	defer sv.mutex.RUnlock()
+	sessionVals := make(map[string]int64)
+	for name, statusVar := range statusVars {
+		if statusVar.Scope != StatusVariableScope_Global {
+			sessionVals[name] = 0
+		}
+	}
+	return sessionVals
+}
+
+// IncrementStatusVariable adds delta to the value of the status variable with the given name in the session of the
+// context given, if it's a StatusVariableSession, and to its global value, as the scope of the variable allows.
+func IncrementStatusVariable(ctx *Context, name string, delta int64) {
+	if s, ok := ctx.Session.(StatusVariableSession); ok {
+		s.IncrementStatusVariable(name, delta)
+	}
+	StatusVariables.Increment(name, delta)
+}
+
+// lookupStatusVar returns the status variable with the given name, which is matched case-insensitively.
+func lookupStatusVar(name string) (StatusVariable, bool) {
+	if statusVar, ok := statusVars[name]; ok {
+		return statusVar, true
+	}
+	for _, statusVar := range statusVars {
+		if strings.EqualFold(statusVar.Name, name) {
+			return statusVar, true
+		}
+	}
+	return StatusVariable{}, false
+}
+
+// init initializes StatusVariables as it functions as a global variable.
+func init() {
+	for name := range statusVars {
+		StatusVariables.vals[name] = 0
+	}
+}
+
+// statusVars is the internal collection of the MySQL status variables maintained by the server, according to
+// https://dev.mysql.com/doc/refman/8.0/en/server-status-variables.html
+var statusVars = map[string]StatusVariable{
+	"Bytes_received":            {Name: "Bytes_received", Scope: StatusVariableScope_Both},
+	"Bytes_sent":                {Name: "Bytes_sent", Scope: StatusVariableScope_Both},
+	"Com_begin":                 {Name: "Com_begin", Scope: StatusVariableScope_Both},
+	"Com_call_procedure":        {Name: "Com_call_procedure", Scope: StatusVariableScope_Both},
+	"Com_change_db":             {Name: "Com_change_db", Scope: StatusVariableScope_Both},
+	"Com_commit":                {Name: "Com_commit", Scope: StatusVariableScope_Both},
+	"Com_dealloc_sql":           {Name: "Com_dealloc_sql", Scope: StatusVariableScope_Both},
+	"Com_delete":                {Name: "Com_delete", Scope: StatusVariableScope_Both},
+	"Com_execute_sql":           {Name: "Com_execute_sql", Scope: StatusVariableScope_Both},
+	"Com_insert":                {Name: "Com_insert", Scope: StatusVariableScope_Both},
+	"Com_kill":                  {Name: "Com_kill", Scope: StatusVariableScope_Both},
+	"Com_load":                  {Name: "Com_load", Scope: StatusVariableScope_Both},
+	"Com_prepare_sql":           {Name: "Com_prepare_sql", Scope: StatusVariableScope_Both},
+	"Com_replace":               {Name: "Com_replace", Scope: StatusVariableScope_Both},
+	"Com_rollback":              {Name: "Com_rollback", Scope: StatusVariableScope_Both},
+	"Com_select":                {Name: "Com_select", Scope: StatusVariableScope_Both},
+	"Com_set_option":            {Name: "Com_set_option", Scope: StatusVariableScope_Both},
+	"Com_truncate":              {Name: "Com_truncate", Scope: StatusVariableScope_Both},
+	"Com_update":                {Name: "Com_update", Scope: StatusVariableScope_Both},
+	"Connections":               {Name: "Connections", Scope: StatusVariableScope_Global},
+	"Queries":                   {Name: "Queries", Scope: StatusVariableScope_Both},
+	"Questions":                 {Name: "Questions", Scope: StatusVariableScope_Both},
+	"Select_scan":               {Name: "Select_scan", Scope: StatusVariableScope_Both},
+	"Slow_queries":              {Name: "Slow_queries", Scope: StatusVariableScope_Both},
+	"Threads_connected":         {Name: "Threads_connected", Scope: StatusVariableScope_Global},
+	"Threads_running":           {Name: "Threads_running", Scope: StatusVariableScope_Global},
+	"Uptime":                    {Name: "Uptime", Scope: StatusVariableScope_Global},
+	"Uptime_since_flush_status": {Name: "Uptime_since_flush_status", Scope: StatusVariableScope_Global},
+}
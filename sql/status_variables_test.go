@@ -0,0 +1,61 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusVariables(t *testing.T) {
+	require := require.New(t)
+	StatusVariables.AddStatusVariables([]StatusVariable{
+		{Name: "Test_global", Scope: StatusVariableScope_Global},
+		{Name: "Test_session", Scope: StatusVariableScope_Session},
+		{Name: "Test_both", Scope: StatusVariableScope_Both},
+	})
+
+	ctx := NewEmptyContext()
+	other := NewContext(ctx, WithSession(NewBaseSession()))
+	IncrementStatusVariable(ctx, "Test_global", 1)
+	IncrementStatusVariable(ctx, "Test_session", 2)
+	IncrementStatusVariable(ctx, "Test_both", 3)
+	IncrementStatusVariable(other, "Test_both", 4)
+	StatusVariables.Increment("Test_global", -3)
+
+	val, ok := StatusVariables.GetGlobal("test_global")
+	require.True(ok)
+	require.Equal(int64(-2), val)
+	val, ok = StatusVariables.GetGlobal("Test_session")
+	require.True(ok)
+	require.Equal(int64(0), val)
+	val, ok = StatusVariables.GetGlobal("TEST_BOTH")
+	require.True(ok)
+	require.Equal(int64(7), val)
+	_, ok = StatusVariables.GetGlobal("Test_nonexistent")
+	require.False(ok)
+
+	session := SessionStatusVariables(ctx)
+	require.NotContains(session, "Test_global")
+	require.Equal(int64(2), session["Test_session"])
+	require.Equal(int64(3), session["Test_both"])
+	require.Equal(int64(4), SessionStatusVariables(other)["Test_both"])
+
+	statusVar, ok := StatusVariables.GetStatusVariable("test_both")
+	require.True(ok)
+	require.Equal(StatusVariable{Name: "Test_both", Scope: StatusVariableScope_Both}, statusVar)
+	require.Contains(StatusVariables.GetAllGlobal(), "Uptime")
+}
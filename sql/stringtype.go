@@ -19,6 +19,7 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/dolthub/vitess/go/vt/proto/query"
@@ -213,12 +214,7 @@ func (t stringType) Compare(a interface{}, b interface{}) (int, error) {
 		bs = bi.(string)
 	}
 
-	// TODO: should be comparing based on the collation for many cases, but the way this function is used throughout the
-	// codebase causes problems if made case insensitive.  Need to revisit usings strings.Compare for now.
-	//
-	// return Collations[t.collationName].Compare(as, bs), nil
-
-	return strings.Compare(as, bs), nil
+	return t.Collation().Compare(as, bs), nil
 }
 
 // Convert implements Type interface.
@@ -282,17 +278,25 @@ func (t stringType) Convert(v interface{}) (interface{}, error) {
 		return nil, ErrConvertToSQL.New(t)
 	}
 
+	if err := t.CharacterSet().checkString(val); err != nil {
+		return nil, err
+	}
+
 	if t.baseType == sqltypes.Text {
 		// for TEXT types, we use the byte length instead of the character length
 		if int64(len(val)) > t.MaxByteLength() {
 			return nil, ErrLengthBeyondLimit.New()
 		}
 	} else {
-		if t.CharacterSet().MaxLength() == 1 {
-			// if the character set only has a max size of 1, we can just count the bytes
+		if t.CharacterSet() == CharacterSet_binary {
 			if int64(len(val)) > t.charLength {
 				return nil, ErrLengthBeyondLimit.New()
 			}
+		} else if t.CharacterSet().MaxLength() == 1 {
+			// if the character set only has a max size of 1, each character is a byte once the string is encoded
+			if int64(utf8.RuneCountInString(val)) > t.charLength {
+				return nil, ErrLengthBeyondLimit.New()
+			}
 		} else {
 			//TODO: this should count the string's length properly according to the character set
 			if int64(len(val)) > t.charLength {
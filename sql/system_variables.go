@@ -369,6 +369,30 @@ var systemVars = map[string]SystemVariable{
 		Type:              NewSystemStringType("bind_address"),
 		Default:           "*",
 	},
+	"binlog_checksum": {
+		Name:              "binlog_checksum",
+		Scope:             SystemVariableScope_Global,
+		Dynamic:           false,
+		SetVarHintApplies: false,
+		Type:              NewSystemEnumType("binlog_checksum", "NONE", "CRC32"),
+		Default:           "NONE",
+	},
+	"binlog_expire_logs_seconds": {
+		Name:              "binlog_expire_logs_seconds",
+		Scope:             SystemVariableScope_Global,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              NewSystemUintType("binlog_expire_logs_seconds", 0, 4294967295),
+		Default:           uint64(2592000),
+	},
+	"binlog_format": {
+		Name:              "binlog_format",
+		Scope:             SystemVariableScope_Both,
+		Dynamic:           false,
+		SetVarHintApplies: false,
+		Type:              NewSystemEnumType("binlog_format", "ROW", "STATEMENT", "MIXED"),
+		Default:           "ROW",
+	},
 	"binlog_gtid_simple_recovery": {
 		Name:              "binlog_gtid_simple_recovery",
 		Scope:             SystemVariableScope_Global,
@@ -377,6 +401,22 @@ var systemVars = map[string]SystemVariable{
 		Type:              NewSystemBoolType("binlog_gtid_simple_recovery"),
 		Default:           int8(1),
 	},
+	"binlog_row_image": {
+		Name:              "binlog_row_image",
+		Scope:             SystemVariableScope_Both,
+		Dynamic:           false,
+		SetVarHintApplies: false,
+		Type:              NewSystemEnumType("binlog_row_image", "FULL", "MINIMAL", "NOBLOB"),
+		Default:           "FULL",
+	},
+	"binlog_space_limit": {
+		Name:              "binlog_space_limit",
+		Scope:             SystemVariableScope_Global,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              NewSystemUintType("binlog_space_limit", 0, 18446744073709551615),
+		Default:           uint64(0),
+	},
 	"block_encryption_mode": {
 		Name:              "block_encryption_mode",
 		Scope:             SystemVariableScope_Both,
@@ -969,6 +1009,46 @@ var systemVars = map[string]SystemVariable{
 		Type:              NewSystemBoolType("inmemory_joins"),
 		Default:           int8(0),
 	},
+	"innodb_ft_enable_stopword": {
+		Name:              "innodb_ft_enable_stopword",
+		Scope:             SystemVariableScope_Both,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              NewSystemBoolType("innodb_ft_enable_stopword"),
+		Default:           int8(1),
+	},
+	"innodb_ft_max_token_size": {
+		Name:              "innodb_ft_max_token_size",
+		Scope:             SystemVariableScope_Global,
+		Dynamic:           false,
+		SetVarHintApplies: false,
+		Type:              NewSystemIntType("innodb_ft_max_token_size", 10, 84, false),
+		Default:           int64(84),
+	},
+	"innodb_ft_min_token_size": {
+		Name:              "innodb_ft_min_token_size",
+		Scope:             SystemVariableScope_Global,
+		Dynamic:           false,
+		SetVarHintApplies: false,
+		Type:              NewSystemIntType("innodb_ft_min_token_size", 0, 16, false),
+		Default:           int64(3),
+	},
+	"innodb_ft_server_stopword_table": {
+		Name:              "innodb_ft_server_stopword_table",
+		Scope:             SystemVariableScope_Global,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              NewSystemStringType("innodb_ft_server_stopword_table"),
+		Default:           "",
+	},
+	"innodb_ft_user_stopword_table": {
+		Name:              "innodb_ft_user_stopword_table",
+		Scope:             SystemVariableScope_Both,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              NewSystemStringType("innodb_ft_user_stopword_table"),
+		Default:           "",
+	},
 	"interactive_timeout": {
 		Name:              "interactive_timeout",
 		Scope:             SystemVariableScope_Both,
@@ -1121,6 +1201,14 @@ var systemVars = map[string]SystemVariable{
 		Type:              NewSystemIntType("lock_wait_timeout", 1, 31536000, false),
 		Default:           int64(31536000),
 	},
+	"log_bin": {
+		Name:              "log_bin",
+		Scope:             SystemVariableScope_Global,
+		Dynamic:           false,
+		SetVarHintApplies: false,
+		Type:              NewSystemBoolType("log_bin"),
+		Default:           int8(0),
+	},
 	"log_error": {
 		Name:              "log_error",
 		Scope:             SystemVariableScope_Global,
@@ -1289,6 +1377,14 @@ var systemVars = map[string]SystemVariable{
 		Type:              NewSystemIntType("max_allowed_packet", 1024, 1073741824, false),
 		Default:           int64(1073741824),
 	},
+	"max_binlog_size": {
+		Name:              "max_binlog_size",
+		Scope:             SystemVariableScope_Global,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              NewSystemUintType("max_binlog_size", 4096, 1073741824),
+		Default:           uint64(1073741824),
+	},
 	"max_connect_errors": {
 		Name:              "max_connect_errors",
 		Scope:             SystemVariableScope_Global,
@@ -2028,6 +2124,14 @@ var systemVars = map[string]SystemVariable{
 		Type:              NewSystemIntType("select_into_disk_sync_delay", 0, 31536000, false),
 		Default:           int64(0),
 	},
+	"server_id": {
+		Name:              "server_id",
+		Scope:             SystemVariableScope_Global,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              NewSystemUintType("server_id", 0, 4294967295),
+		Default:           uint64(1),
+	},
 	"session_track_gtids": {
 		Name:              "session_track_gtids",
 		Scope:             SystemVariableScope_Both,
@@ -2236,6 +2340,14 @@ var systemVars = map[string]SystemVariable{
 		Type:              NewSystemBoolType("sql_buffer_result"),
 		Default:           int8(0),
 	},
+	"sql_log_bin": {
+		Name:              "sql_log_bin",
+		Scope:             SystemVariableScope_Session,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              NewSystemBoolType("sql_log_bin"),
+		Default:           int8(1),
+	},
 	"sql_log_off": {
 		Name:              "sql_log_off",
 		Scope:             SystemVariableScope_Both,
@@ -62,6 +62,9 @@ func (i *TableRowIter) Next() (Row, error) {
 	}
 
 	row, err := i.rows.Next()
+	if err == nil {
+		i.ctx.AddRowsExamined(1)
+	}
 	if err != nil && err == io.EOF {
 		if err = i.rows.Close(i.ctx); err != nil {
 			return nil, err
@@ -426,18 +426,11 @@ func ColumnTypeToType(ct *sqlparser.ColumnType) (Type, error) {
 		return CreateSetType(ct.EnumValues, collation)
 	case "json":
 		return JSON, nil
-	case "geometry":
-	case "geometrycollection":
-	case "linestring":
-	case "multilinestring":
-	case "point":
-	case "multipoint":
-	case "polygon":
-	case "multipolygon":
+	case "geometry", "geometrycollection", "linestring", "multilinestring", "point", "multipoint", "polygon", "multipolygon":
+		return CreateSpatialType(ct.Type, 0, false)
 	default:
 		return nil, fmt.Errorf("unknown type: %v", ct.Type)
 	}
-	return nil, fmt.Errorf("type not yet implemented: %v", ct.Type)
 }
 
 func ConvertToBool(v interface{}) (bool, error) {
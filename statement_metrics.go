@@ -0,0 +1,170 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"io"
+
+	kitmetrics "github.com/go-kit/kit/metrics"
+
+	"github.com/linanh/go-mysql-server/metrics"
+	"github.com/linanh/go-mysql-server/sql"
+	"github.com/linanh/go-mysql-server/sql/parse"
+)
+
+// maxStatementDigests is the number of digests StatementHistogram keeps a series for, as MySQL keeps a limited number
+// of digests in its statement summaries.
+const maxStatementDigests = 1000
+
+// statementMetrics are the metrics of the statements executed by an engine.
+type statementMetrics struct {
+	statements kitmetrics.Counter
+	errors     kitmetrics.Counter
+	latency    kitmetrics.Histogram
+}
+
+// newStatementMetrics registers the metrics of the statements executed by an engine in the registry given.
+func newStatementMetrics(r *metrics.Registry) *statementMetrics {
+	return &statementMetrics{
+		statements: r.NewCounter("gms_statements_total", "Number of statements executed, by statement type."),
+		errors:     r.NewCounter("gms_statement_errors_total", "Number of statements which failed, by statement type."),
+		latency: r.NewHistogram("gms_statement_duration_seconds",
+			"Latency of statements in seconds, by statement digest.", metrics.DefaultBuckets).
+			LimitSeries(maxStatementDigests),
+	}
+}
+
+var defaultStatementMetrics = newStatementMetrics(metrics.Default)
+
+var (
+	// StatementCounter counts the statements executed by the engines without a metrics registry of their own, by
+	// statement type.
+	StatementCounter = defaultStatementMetrics.statements
+
+	// StatementErrorCounter counts the statements which failed, by statement type, for the engines without a metrics
+	// registry of their own.
+	StatementErrorCounter = defaultStatementMetrics.errors
+
+	// StatementHistogram describes the latency of statements, by the digest of their normalized text, for the engines
+	// without a metrics registry of their own.
+	StatementHistogram = defaultStatementMetrics.latency
+)
+
+// comStatusVariables are the Com_xxx status variables counting the statements of each type.
+var comStatusVariables = map[string]string{
+	"begin":      "Com_begin",
+	"call":       "Com_call_procedure",
+	"commit":     "Com_commit",
+	"deallocate": "Com_dealloc_sql",
+	"delete":     "Com_delete",
+	"execute":    "Com_execute_sql",
+	"insert":     "Com_insert",
+	"kill":       "Com_kill",
+	"load":       "Com_load",
+	"prepare":    "Com_prepare_sql",
+	"replace":    "Com_replace",
+	"rollback":   "Com_rollback",
+	"select":     "Com_select",
+	"set":        "Com_set_option",
+	"start":      "Com_begin",
+	"truncate":   "Com_truncate",
+	"update":     "Com_update",
+	"use":        "Com_change_db",
+}
+
+// observeStatement starts observing the execution of a statement, which is recorded in the statement events, the slow
+// query log given and the status variables, and returns the function to call with the rows it affected and sent and
+// its error, if any, once it finishes. Its metrics are observed in the ones given, or in StatementCounter,
+// StatementErrorCounter and StatementHistogram if they're nil.
+func observeStatement(ctx *sql.Context, events *sql.StatementEvents, slowLog *slowQueryLog, m *statementMetrics, query string) func(rowsAffected, rowsSent uint64, err error) {
+	if m == nil {
+		m = &statementMetrics{statements: StatementCounter, errors: StatementErrorCounter, latency: StatementHistogram}
+	}
+	typ, digest, digestText := parse.StatementDigest(query)
+	event := &sql.StatementEvent{
+		ThreadID:   ctx.Session.ID(),
+		EventName:  "statement/sql/" + typ,
+		SQLText:    parse.RedactPasswords(query),
+		Digest:     digest,
+		DigestText: digestText,
+		Schema:     ctx.GetCurrentDatabase(),
+	}
+	stats := ctx.TrackQueryStats()
+	events.Start(event)
+
+	sql.IncrementStatusVariable(ctx, "Questions", 1)
+	sql.IncrementStatusVariable(ctx, "Queries", 1)
+	if name, ok := comStatusVariables[typ]; ok {
+		sql.IncrementStatusVariable(ctx, name, 1)
+	}
+	sql.StatusVariables.Increment("Threads_running", 1)
+
+	return func(rowsAffected, rowsSent uint64, err error) {
+		events.End(event, rowsAffected, rowsSent, err)
+		sql.StatusVariables.Increment("Threads_running", -1)
+		if typ == "select" && stats.FullScan() {
+			sql.IncrementStatusVariable(ctx, "Select_scan", 1)
+		}
+		slowLog.log(ctx, slowStatement{
+			query:     query,
+			database:  event.Schema,
+			startedAt: event.StartedAt,
+			duration:  event.Duration(),
+			stats:     stats,
+			rowsSent:  rowsSent,
+		})
+
+		m.statements.With("type", typ).Add(1)
+		if err != nil {
+			m.errors.With("type", typ).Add(1)
+			return
+		}
+		m.latency.With("digest", digest).Observe(event.Duration().Seconds())
+	}
+}
+
+// statementIter is the sql.RowIter of an observed statement, which finishes once its rows are closed.
+type statementIter struct {
+	childIter    sql.RowIter
+	finish       func(rowsAffected, rowsSent uint64, err error)
+	rowsAffected uint64
+	rowsSent     uint64
+	err          error
+}
+
+func (s *statementIter) Next() (sql.Row, error) {
+	row, err := s.childIter.Next()
+	switch {
+	case err == nil && sql.IsOkResult(row):
+		s.rowsAffected += row[0].(sql.OkResult).RowsAffected
+	case err == nil:
+		s.rowsSent++
+	case err != io.EOF && s.err == nil:
+		s.err = err
+	}
+	return row, err
+}
+
+func (s *statementIter) Close(ctx *sql.Context) error {
+	err := s.childIter.Close(ctx)
+	if s.err == nil {
+		s.err = err
+	}
+	if s.finish != nil {
+		s.finish(s.rowsAffected, s.rowsSent, s.err)
+		s.finish = nil
+	}
+	return err
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"context"
+	"io"
+	"net"
+
+	"github.com/dolthub/vitess/go/vt/log"
+)
+
+// binlogDumpNonBlock is the flag of the COM_BINLOG_DUMP commands asking for the events of the binary log up to its
+// end only, rather than waiting for new ones.
+const binlogDumpNonBlock = 0x01
+
+// handleComChangeUser authenticates the user of a COM_CHANGE_USER command as the handshake does, and has the handler
+// change the connection to it. The connection is closed if that fails.
+func (c *Conn) handleComChangeUser(handler ChangeUserHandler, data []byte) error {
+	user, authMethod, authResponse, schemaName, ok := c.parseComChangeUser(data)
+	c.recycleReadPacket()
+	if !ok {
+		err := NewSQLError(CRMalformedPacket, SSUnknownSQLState, "malformed COM_CHANGE_USER packet")
+		c.writeErrorPacketFromError(err)
+		return err
+	}
+
+	userData, err := c.listener.authenticate(c, c.salt, user, authMethod, authResponse)
+	if err == nil {
+		c.User = user
+		c.UserData = userData
+		err = handler.ComChangeUser(c, schemaName)
+	}
+	if err != nil {
+		log.Warningf("Error changing the user of %s: %v", c, err)
+		c.writeErrorPacketFromError(err)
+		return err
+	}
+	c.schemaName = schemaName
+
+	if err := c.writeOKPacket(0, 0, c.StatusFlags, 0); err != nil {
+		log.Errorf("Error writing ComChangeUser result to %s: %v", c, err)
+		return err
+	}
+	return nil
+}
+
+// parseComChangeUser returns the user, authentication method and response and the database of a COM_CHANGE_USER
+// command. The connection attributes which may follow them are ignored.
+func (c *Conn) parseComChangeUser(data []byte) (user, authMethod string, authResponse []byte, schemaName string, ok bool) {
+	pos := 1
+	if user, pos, ok = readNullString(data, pos); !ok {
+		return
+	}
+	if c.Capabilities&CapabilityClientSecureConnection != 0 {
+		var l byte
+		if l, pos, ok = readByte(data, pos); !ok {
+			return
+		}
+		if authResponse, pos, ok = readBytesCopy(data, pos, int(l)); !ok {
+			return
+		}
+	} else {
+		var a string
+		if a, pos, ok = readNullString(data, pos); !ok {
+			return
+		}
+		authResponse = []byte(a)
+	}
+
+	authMethod = MysqlNativePassword
+	if pos == len(data) {
+		return user, authMethod, authResponse, "", true
+	}
+	if schemaName, pos, ok = readNullString(data, pos); !ok {
+		return
+	}
+	// The character set comes next, followed by the authentication method
+	if c.Capabilities&CapabilityClientPluginAuth != 0 && pos+2 < len(data) {
+		if authMethod, _, ok = readNullString(data, pos+2); !ok {
+			return
+		}
+	}
+	return user, authMethod, authResponse, schemaName, true
+}
+
+// handleComRegisterSlave passes the replica registered by a COM_REGISTER_SLAVE command to the handler.
+func (c *Conn) handleComRegisterSlave(handler BinlogDumpHandler, data []byte) error {
+	serverID, host, port, ok := parseComRegisterSlave(data)
+	c.recycleReadPacket()
+
+	var err error
+	if ok {
+		err = handler.ComRegisterReplica(c, serverID, host, port)
+	} else {
+		err = NewSQLError(CRMalformedPacket, SSUnknownSQLState, "malformed COM_REGISTER_SLAVE packet")
+	}
+	if err != nil {
+		if werr := c.writeErrorPacketFromError(err); werr != nil {
+			log.Errorf("Error writing error packet to %s: %s", c, werr)
+			return werr
+		}
+		return nil
+	}
+
+	if err := c.writeOKPacket(0, 0, c.StatusFlags, 0); err != nil {
+		log.Errorf("Error writing ComRegisterSlave result to %s: %v", c, err)
+		return err
+	}
+	return nil
+}
+
+// parseComRegisterSlave returns the server ID, host and port of a COM_REGISTER_SLAVE command. The user, password,
+// replication rank and master ID which follow them are ignored.
+func parseComRegisterSlave(data []byte) (serverID uint32, host string, port uint16, ok bool) {
+	pos := 1
+	if serverID, pos, ok = readUint32(data, pos); !ok {
+		return
+	}
+	var l byte
+	if l, pos, ok = readByte(data, pos); !ok {
+		return
+	}
+	var b []byte
+	if b, pos, ok = readBytes(data, pos, int(l)); !ok {
+		return
+	}
+	host = string(b)
+	// user and password
+	for i := 0; i < 2; i++ {
+		if l, pos, ok = readByte(data, pos); !ok {
+			return
+		}
+		if _, pos, ok = readBytes(data, pos, int(l)); !ok {
+			return
+		}
+	}
+	port, _, ok = readUint16(data, pos)
+	return serverID, host, port, ok
+}
+
+// handleComBinlogDump has the handler send the events of the binary log asked for by a COM_BINLOG_DUMP command, each
+// in a packet of its own. The events of non-blocking dumps are followed by an EOF packet. Blocking dumps last until
+// the replica disconnects or the binary log can't be read anymore, and the connection is closed once they end.
+func (c *Conn) handleComBinlogDump(handler BinlogDumpHandler, data []byte) error {
+	pos, flags, file, ok := parseComBinlogDump(data)
+	c.recycleReadPacket()
+	if !ok {
+		if err := c.writeErrorPacket(CRMalformedPacket, SSUnknownSQLState, "malformed COM_BINLOG_DUMP packet"); err != nil {
+			log.Errorf("Error writing error packet to %s: %s", c, err)
+			return err
+		}
+		return nil
+	}
+	nonBlock := flags&binlogDumpNonBlock != 0
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if !nonBlock {
+		// The replica sends nothing while events are streamed, so reading only returns once it disconnects. Nothing
+		// else reads from the connection until the dump ends, after which the connection is closed.
+		go func() {
+			defer cancel()
+			b := make([]byte, 1)
+			for {
+				_, err := c.Conn.Read(b)
+				if netErr, ok := err.(net.Error); ok && netErr.Timeout() && ctx.Err() == nil {
+					continue
+				}
+				return
+			}
+		}()
+	}
+
+	var sendErr error
+	err := handler.ComBinlogDump(ctx, c, file, pos, nonBlock, func(event []byte) error {
+		data := c.startEphemeralPacket(len(event) + 1)
+		data[0] = OKPacket
+		copy(data[1:], event)
+		sendErr = c.writeEphemeralPacket()
+		return sendErr
+	})
+	switch {
+	case sendErr != nil:
+		return sendErr
+	case ctx.Err() != nil:
+		// The replica disconnected
+		return io.EOF
+	case err != nil:
+		err = c.writeErrorPacketFromError(err)
+	default:
+		err = c.writeEOFPacket(c.StatusFlags, 0)
+	}
+	if err != nil {
+		log.Errorf("Error writing ComBinlogDump result to %s: %v", c, err)
+		return err
+	}
+	if !nonBlock {
+		return io.EOF
+	}
+	return nil
+}
+
+// parseComBinlogDump returns the position, flags and binary log file of a COM_BINLOG_DUMP command. The server ID of
+// the replica, which sits between the flags and the file, is ignored.
+func parseComBinlogDump(data []byte) (pos uint32, flags uint16, file string, ok bool) {
+	p := 1
+	if pos, p, ok = readUint32(data, p); !ok {
+		return
+	}
+	if flags, p, ok = readUint16(data, p); !ok {
+		return
+	}
+	if _, p, ok = readUint32(data, p); !ok {
+		return
+	}
+	file, _, ok = readEOFString(data, p)
+	return pos, flags, file, ok
+}
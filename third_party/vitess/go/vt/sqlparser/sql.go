@@ -0,0 +1,10703 @@
+// Code generated by goyacc -o sql.go sql.y. DO NOT EDIT.
+
+//line sql.y:18
+package sqlparser
+
+import __yyfmt__ "fmt"
+
+//line sql.y:18
+
+import "fmt"
+
+func setParseTree(yylex interface{}, stmt Statement) {
+	yylex.(*Tokenizer).ParseTree = stmt
+}
+
+func setAllowComments(yylex interface{}, allow bool) {
+	yylex.(*Tokenizer).AllowComments = allow
+}
+
+func setDDL(yylex interface{}, ddl *DDL) {
+	yylex.(*Tokenizer).partialDDL = ddl
+}
+
+func incNesting(yylex interface{}) bool {
+	yylex.(*Tokenizer).nesting++
+	if yylex.(*Tokenizer).nesting == 200 {
+		return true
+	}
+	return false
+}
+
+func decNesting(yylex interface{}) {
+	yylex.(*Tokenizer).nesting--
+}
+
+func yyPosition(yylex interface{}) int {
+	return yylex.(*Tokenizer).Position
+}
+
+func yyOldPosition(yylex interface{}) int {
+	return yylex.(*Tokenizer).OldPosition
+}
+
+// skipToEnd forces the lexer to end prematurely. Not all SQL statements
+// are supported by the Parser, thus calling skipToEnd will make the lexer
+// return EOF early.
+func skipToEnd(yylex interface{}) {
+	yylex.(*Tokenizer).SkipToEnd = true
+}
+
+//line sql.y:63
+type yySymType struct {
+	yys                      int
+	empty                    struct{}
+	statement                Statement
+	selStmt                  SelectStatement
+	ddl                      *DDL
+	ddls                     []*DDL
+	ins                      *Insert
+	byt                      byte
+	bytes                    []byte
+	bytes2                   [][]byte
+	str                      string
+	int                      int
+	strs                     []string
+	selectExprs              SelectExprs
+	selectExpr               SelectExpr
+	columns                  Columns
+	statements               Statements
+	partitions               Partitions
+	colName                  *ColName
+	tableExprs               TableExprs
+	tableExpr                TableExpr
+	subquery                 *Subquery
+	simpleTableExpr          SimpleTableExpr
+	joinCondition            JoinCondition
+	tableName                TableName
+	tableNames               TableNames
+	indexHints               *IndexHints
+	asOf                     *AsOf
+	expr                     Expr
+	exprs                    Exprs
+	boolVal                  BoolVal
+	boolean                  bool
+	sqlVal                   *SQLVal
+	colTuple                 ColTuple
+	values                   Values
+	valTuple                 ValTuple
+	whens                    []*When
+	when                     *When
+	orderBy                  OrderBy
+	order                    *Order
+	limit                    *Limit
+	assignExprs              AssignmentExprs
+	assignExpr               *AssignmentExpr
+	setVarExprs              SetVarExprs
+	setVarExpr               *SetVarExpr
+	setScope                 SetScope
+	colIdent                 ColIdent
+	colIdents                []ColIdent
+	tableIdent               TableIdent
+	convertType              *ConvertType
+	aliasedTableName         *AliasedTableExpr
+	TableSpec                *TableSpec
+	columnType               ColumnType
+	columnOrder              *ColumnOrder
+	triggerOrder             *TriggerOrder
+	colKeyOpt                ColumnKeyOption
+	optVal                   Expr
+	LengthScaleOption        LengthScaleOption
+	columnDefinition         *ColumnDefinition
+	indexDefinition          *IndexDefinition
+	indexInfo                *IndexInfo
+	indexOption              *IndexOption
+	indexOptions             []*IndexOption
+	indexColumn              *IndexColumn
+	indexColumns             []*IndexColumn
+	constraintDefinition     *ConstraintDefinition
+	constraintInfo           ConstraintInfo
+	ReferenceAction          ReferenceAction
+	partDefs                 []*PartitionDefinition
+	partDef                  *PartitionDefinition
+	partSpec                 *PartitionSpec
+	showFilter               *ShowFilter
+	over                     *Over
+	caseStatementCases       []CaseStatementCase
+	caseStatementCase        CaseStatementCase
+	ifStatementConditions    []IfStatementCondition
+	ifStatementCondition     IfStatementCondition
+	signalInfo               SignalInfo
+	signalInfos              []SignalInfo
+	signalConditionItemName  SignalConditionItemName
+	declareHandlerAction     DeclareHandlerAction
+	declareHandlerCondition  DeclareHandlerCondition
+	declareHandlerConditions []DeclareHandlerCondition
+	procedureParam           ProcedureParam
+	procedureParams          []ProcedureParam
+	characteristic           Characteristic
+	characteristics          []Characteristic
+	Fields                   *Fields
+	Lines                    *Lines
+	EnclosedBy               *EnclosedBy
+	tableAndLockType         *TableAndLockType
+	tableAndLockTypes        TableAndLockTypes
+	lockType                 LockType
+}
+
+const LEX_ERROR = 57346
+const UNION = 57347
+const SELECT = 57348
+const STREAM = 57349
+const INSERT = 57350
+const UPDATE = 57351
+const DELETE = 57352
+const FROM = 57353
+const WHERE = 57354
+const GROUP = 57355
+const HAVING = 57356
+const ORDER = 57357
+const BY = 57358
+const LIMIT = 57359
+const OFFSET = 57360
+const FOR = 57361
+const CALL = 57362
+const ALL = 57363
+const DISTINCT = 57364
+const AS = 57365
+const EXISTS = 57366
+const ASC = 57367
+const DESC = 57368
+const INTO = 57369
+const DUPLICATE = 57370
+const DEFAULT = 57371
+const SET = 57372
+const LOCK = 57373
+const UNLOCK = 57374
+const KEYS = 57375
+const OF = 57376
+const OUTFILE = 57377
+const DATA = 57378
+const LOAD = 57379
+const LINES = 57380
+const TERMINATED = 57381
+const ESCAPED = 57382
+const ENCLOSED = 57383
+const OPTIONALLY = 57384
+const STARTING = 57385
+const UNIQUE = 57386
+const KEY = 57387
+const SYSTEM_TIME = 57388
+const VALUES = 57389
+const LAST_INSERT_ID = 57390
+const SQL_CALC_FOUND_ROWS = 57391
+const NEXT = 57392
+const VALUE = 57393
+const SHARE = 57394
+const MODE = 57395
+const SQL_NO_CACHE = 57396
+const SQL_CACHE = 57397
+const JOIN = 57398
+const STRAIGHT_JOIN = 57399
+const LEFT = 57400
+const RIGHT = 57401
+const INNER = 57402
+const OUTER = 57403
+const CROSS = 57404
+const NATURAL = 57405
+const USE = 57406
+const FORCE = 57407
+const ON = 57408
+const USING = 57409
+const ID = 57410
+const HEX = 57411
+const STRING = 57412
+const INTEGRAL = 57413
+const FLOAT = 57414
+const HEXNUM = 57415
+const VALUE_ARG = 57416
+const LIST_ARG = 57417
+const COMMENT = 57418
+const COMMENT_KEYWORD = 57419
+const BIT_LITERAL = 57420
+const NULL = 57421
+const TRUE = 57422
+const FALSE = 57423
+const OFF = 57424
+const OR = 57425
+const AND = 57426
+const NOT = 57427
+const BETWEEN = 57428
+const CASE = 57429
+const WHEN = 57430
+const THEN = 57431
+const ELSE = 57432
+const ELSEIF = 57433
+const END = 57434
+const LE = 57435
+const GE = 57436
+const NE = 57437
+const NULL_SAFE_EQUAL = 57438
+const IS = 57439
+const LIKE = 57440
+const REGEXP = 57441
+const IN = 57442
+const SHIFT_LEFT = 57443
+const SHIFT_RIGHT = 57444
+const DIV = 57445
+const MOD = 57446
+const UNARY = 57447
+const COLLATE = 57448
+const BINARY = 57449
+const UNDERSCORE_BINARY = 57450
+const UNDERSCORE_UTF8MB4 = 57451
+const INTERVAL = 57452
+const JSON_EXTRACT_OP = 57453
+const JSON_UNQUOTE_EXTRACT_OP = 57454
+const CREATE = 57455
+const ALTER = 57456
+const DROP = 57457
+const RENAME = 57458
+const ANALYZE = 57459
+const ADD = 57460
+const FLUSH = 57461
+const MODIFY = 57462
+const CHANGE = 57463
+const SCHEMA = 57464
+const TABLE = 57465
+const INDEX = 57466
+const INDEXES = 57467
+const VIEW = 57468
+const TO = 57469
+const IGNORE = 57470
+const IF = 57471
+const PRIMARY = 57472
+const COLUMN = 57473
+const SPATIAL = 57474
+const FULLTEXT = 57475
+const KEY_BLOCK_SIZE = 57476
+const CHECK = 57477
+const ACTION = 57478
+const CASCADE = 57479
+const CONSTRAINT = 57480
+const FOREIGN = 57481
+const NO = 57482
+const REFERENCES = 57483
+const RESTRICT = 57484
+const FIRST = 57485
+const AFTER = 57486
+const SHOW = 57487
+const DESCRIBE = 57488
+const EXPLAIN = 57489
+const DATE = 57490
+const ESCAPE = 57491
+const REPAIR = 57492
+const OPTIMIZE = 57493
+const TRUNCATE = 57494
+const FORMAT = 57495
+const MAXVALUE = 57496
+const PARTITION = 57497
+const REORGANIZE = 57498
+const LESS = 57499
+const THAN = 57500
+const PROCEDURE = 57501
+const TRIGGER = 57502
+const TRIGGERS = 57503
+const FUNCTION = 57504
+const STATUS = 57505
+const VARIABLES = 57506
+const WARNINGS = 57507
+const SEQUENCE = 57508
+const EACH = 57509
+const ROW = 57510
+const BEFORE = 57511
+const FOLLOWS = 57512
+const PRECEDES = 57513
+const DEFINER = 57514
+const INVOKER = 57515
+const INOUT = 57516
+const OUT = 57517
+const DETERMINISTIC = 57518
+const CONTAINS = 57519
+const READS = 57520
+const MODIFIES = 57521
+const SQL = 57522
+const SECURITY = 57523
+const TEMPORARY = 57524
+const CLASS_ORIGIN = 57525
+const SUBCLASS_ORIGIN = 57526
+const MESSAGE_TEXT = 57527
+const MYSQL_ERRNO = 57528
+const CONSTRAINT_CATALOG = 57529
+const CONSTRAINT_SCHEMA = 57530
+const CONSTRAINT_NAME = 57531
+const CATALOG_NAME = 57532
+const SCHEMA_NAME = 57533
+const TABLE_NAME = 57534
+const COLUMN_NAME = 57535
+const CURSOR_NAME = 57536
+const SIGNAL = 57537
+const RESIGNAL = 57538
+const SQLSTATE = 57539
+const DECLARE = 57540
+const CONDITION = 57541
+const CURSOR = 57542
+const CONTINUE = 57543
+const EXIT = 57544
+const UNDO = 57545
+const HANDLER = 57546
+const FOUND = 57547
+const SQLWARNING = 57548
+const SQLEXCEPTION = 57549
+const BEGIN = 57550
+const START = 57551
+const TRANSACTION = 57552
+const COMMIT = 57553
+const ROLLBACK = 57554
+const SAVEPOINT = 57555
+const WORK = 57556
+const RELEASE = 57557
+const BIT = 57558
+const TINYINT = 57559
+const SMALLINT = 57560
+const MEDIUMINT = 57561
+const INT = 57562
+const INTEGER = 57563
+const BIGINT = 57564
+const INTNUM = 57565
+const REAL = 57566
+const DOUBLE = 57567
+const FLOAT_TYPE = 57568
+const DECIMAL = 57569
+const NUMERIC = 57570
+const DEC = 57571
+const FIXED = 57572
+const PRECISION = 57573
+const TIME = 57574
+const TIMESTAMP = 57575
+const DATETIME = 57576
+const YEAR = 57577
+const CHAR = 57578
+const VARCHAR = 57579
+const BOOL = 57580
+const CHARACTER = 57581
+const VARBINARY = 57582
+const NCHAR = 57583
+const NVARCHAR = 57584
+const NATIONAL = 57585
+const VARYING = 57586
+const TEXT = 57587
+const TINYTEXT = 57588
+const MEDIUMTEXT = 57589
+const LONGTEXT = 57590
+const LONG = 57591
+const BLOB = 57592
+const TINYBLOB = 57593
+const MEDIUMBLOB = 57594
+const LONGBLOB = 57595
+const JSON = 57596
+const ENUM = 57597
+const GEOMETRY = 57598
+const POINT = 57599
+const LINESTRING = 57600
+const POLYGON = 57601
+const GEOMETRYCOLLECTION = 57602
+const MULTIPOINT = 57603
+const MULTILINESTRING = 57604
+const MULTIPOLYGON = 57605
+const LOCAL = 57606
+const LOW_PRIORITY = 57607
+const NULLX = 57608
+const AUTO_INCREMENT = 57609
+const APPROXNUM = 57610
+const SIGNED = 57611
+const UNSIGNED = 57612
+const ZEROFILL = 57613
+const COLLATION = 57614
+const DATABASES = 57615
+const SCHEMAS = 57616
+const TABLES = 57617
+const FULL = 57618
+const PROCESSLIST = 57619
+const COLUMNS = 57620
+const FIELDS = 57621
+const ENGINES = 57622
+const PLUGINS = 57623
+const NAMES = 57624
+const CHARSET = 57625
+const GLOBAL = 57626
+const SESSION = 57627
+const ISOLATION = 57628
+const LEVEL = 57629
+const READ = 57630
+const WRITE = 57631
+const ONLY = 57632
+const REPEATABLE = 57633
+const COMMITTED = 57634
+const UNCOMMITTED = 57635
+const SERIALIZABLE = 57636
+const CURRENT_TIMESTAMP = 57637
+const DATABASE = 57638
+const CURRENT_DATE = 57639
+const CURRENT_USER = 57640
+const CURRENT_TIME = 57641
+const LOCALTIME = 57642
+const LOCALTIMESTAMP = 57643
+const UTC_DATE = 57644
+const UTC_TIME = 57645
+const UTC_TIMESTAMP = 57646
+const REPLACE = 57647
+const CONVERT = 57648
+const CAST = 57649
+const SUBSTR = 57650
+const SUBSTRING = 57651
+const GROUP_CONCAT = 57652
+const SEPARATOR = 57653
+const TIMESTAMPADD = 57654
+const TIMESTAMPDIFF = 57655
+const OVER = 57656
+const WINDOW = 57657
+const GROUPING = 57658
+const GROUPS = 57659
+const AVG = 57660
+const BIT_AND = 57661
+const BIT_OR = 57662
+const BIT_XOR = 57663
+const COUNT = 57664
+const JSON_ARRAYAGG = 57665
+const JSON_OBJECTAGG = 57666
+const MAX = 57667
+const MIN = 57668
+const STDDEV_POP = 57669
+const STDDEV = 57670
+const STD = 57671
+const STDDEV_SAMP = 57672
+const SUM = 57673
+const VAR_POP = 57674
+const VARIANCE = 57675
+const VAR_SAMP = 57676
+const CUME_DIST = 57677
+const DENSE_RANK = 57678
+const FIRST_VALUE = 57679
+const LAG = 57680
+const LAST_VALUE = 57681
+const LEAD = 57682
+const NTH_VALUE = 57683
+const NTILE = 57684
+const ROW_NUMBER = 57685
+const PERCENT_RANK = 57686
+const RANK = 57687
+const MATCH = 57688
+const AGAINST = 57689
+const BOOLEAN = 57690
+const LANGUAGE = 57691
+const WITH = 57692
+const QUERY = 57693
+const EXPANSION = 57694
+const UNUSED = 57695
+const ARRAY = 57696
+const DESCRIPTION = 57697
+const EMPTY = 57698
+const EXCEPT = 57699
+const JSON_TABLE = 57700
+const LATERAL = 57701
+const MEMBER = 57702
+const RECURSIVE = 57703
+const ACTIVE = 57704
+const ADMIN = 57705
+const BUCKETS = 57706
+const CLONE = 57707
+const COMPONENT = 57708
+const DEFINITION = 57709
+const ENFORCED = 57710
+const EXCLUDE = 57711
+const FOLLOWING = 57712
+const GEOMCOLLECTION = 57713
+const GET_MASTER_PUBLIC_KEY = 57714
+const HISTOGRAM = 57715
+const HISTORY = 57716
+const INACTIVE = 57717
+const INVISIBLE = 57718
+const LOCKED = 57719
+const MASTER_COMPRESSION_ALGORITHMS = 57720
+const MASTER_PUBLIC_KEY_PATH = 57721
+const MASTER_TLS_CIPHERSUITES = 57722
+const MASTER_ZSTD_COMPRESSION_LEVEL = 57723
+const NESTED = 57724
+const NETWORK_NAMESPACE = 57725
+const NOWAIT = 57726
+const NULLS = 57727
+const OJ = 57728
+const OLD = 57729
+const OPTIONAL = 57730
+const ORDINALITY = 57731
+const ORGANIZATION = 57732
+const OTHERS = 57733
+const PATH = 57734
+const PERSIST = 57735
+const PERSIST_ONLY = 57736
+const PRECEDING = 57737
+const PRIVILEGE_CHECKS_USER = 57738
+const PROCESS = 57739
+const RANDOM = 57740
+const REFERENCE = 57741
+const REQUIRE_ROW_FORMAT = 57742
+const RESOURCE = 57743
+const RESPECT = 57744
+const RESTART = 57745
+const RETAIN = 57746
+const REUSE = 57747
+const ROLE = 57748
+const SECONDARY = 57749
+const SECONDARY_ENGINE = 57750
+const SECONDARY_LOAD = 57751
+const SECONDARY_UNLOAD = 57752
+const SKIP = 57753
+const SRID = 57754
+const THREAD_PRIORITY = 57755
+const TIES = 57756
+const UNBOUNDED = 57757
+const VCPU = 57758
+const VISIBLE = 57759
+const SYSTEM = 57760
+const INFILE = 57761
+
+var yyToknames = [...]string{
+	"$end",
+	"error",
+	"$unk",
+	"LEX_ERROR",
+	"UNION",
+	"SELECT",
+	"STREAM",
+	"INSERT",
+	"UPDATE",
+	"DELETE",
+	"FROM",
+	"WHERE",
+	"GROUP",
+	"HAVING",
+	"ORDER",
+	"BY",
+	"LIMIT",
+	"OFFSET",
+	"FOR",
+	"CALL",
+	"ALL",
+	"DISTINCT",
+	"AS",
+	"EXISTS",
+	"ASC",
+	"DESC",
+	"INTO",
+	"DUPLICATE",
+	"DEFAULT",
+	"SET",
+	"LOCK",
+	"UNLOCK",
+	"KEYS",
+	"OF",
+	"OUTFILE",
+	"DATA",
+	"LOAD",
+	"LINES",
+	"TERMINATED",
+	"ESCAPED",
+	"ENCLOSED",
+	"OPTIONALLY",
+	"STARTING",
+	"UNIQUE",
+	"KEY",
+	"SYSTEM_TIME",
+	"VALUES",
+	"LAST_INSERT_ID",
+	"SQL_CALC_FOUND_ROWS",
+	"NEXT",
+	"VALUE",
+	"SHARE",
+	"MODE",
+	"SQL_NO_CACHE",
+	"SQL_CACHE",
+	"JOIN",
+	"STRAIGHT_JOIN",
+	"LEFT",
+	"RIGHT",
+	"INNER",
+	"OUTER",
+	"CROSS",
+	"NATURAL",
+	"USE",
+	"FORCE",
+	"ON",
+	"USING",
+	"'('",
+	"','",
+	"')'",
+	"ID",
+	"HEX",
+	"STRING",
+	"INTEGRAL",
+	"FLOAT",
+	"HEXNUM",
+	"VALUE_ARG",
+	"LIST_ARG",
+	"COMMENT",
+	"COMMENT_KEYWORD",
+	"BIT_LITERAL",
+	"NULL",
+	"TRUE",
+	"FALSE",
+	"OFF",
+	"OR",
+	"AND",
+	"NOT",
+	"'!'",
+	"BETWEEN",
+	"CASE",
+	"WHEN",
+	"THEN",
+	"ELSE",
+	"ELSEIF",
+	"END",
+	"'='",
+	"'<'",
+	"'>'",
+	"LE",
+	"GE",
+	"NE",
+	"NULL_SAFE_EQUAL",
+	"IS",
+	"LIKE",
+	"REGEXP",
+	"IN",
+	"'|'",
+	"'&'",
+	"SHIFT_LEFT",
+	"SHIFT_RIGHT",
+	"'+'",
+	"'-'",
+	"'*'",
+	"'/'",
+	"DIV",
+	"'%'",
+	"MOD",
+	"'^'",
+	"'~'",
+	"UNARY",
+	"COLLATE",
+	"BINARY",
+	"UNDERSCORE_BINARY",
+	"UNDERSCORE_UTF8MB4",
+	"INTERVAL",
+	"'.'",
+	"JSON_EXTRACT_OP",
+	"JSON_UNQUOTE_EXTRACT_OP",
+	"CREATE",
+	"ALTER",
+	"DROP",
+	"RENAME",
+	"ANALYZE",
+	"ADD",
+	"FLUSH",
+	"MODIFY",
+	"CHANGE",
+	"SCHEMA",
+	"TABLE",
+	"INDEX",
+	"INDEXES",
+	"VIEW",
+	"TO",
+	"IGNORE",
+	"IF",
+	"PRIMARY",
+	"COLUMN",
+	"SPATIAL",
+	"FULLTEXT",
+	"KEY_BLOCK_SIZE",
+	"CHECK",
+	"ACTION",
+	"CASCADE",
+	"CONSTRAINT",
+	"FOREIGN",
+	"NO",
+	"REFERENCES",
+	"RESTRICT",
+	"FIRST",
+	"AFTER",
+	"SHOW",
+	"DESCRIBE",
+	"EXPLAIN",
+	"DATE",
+	"ESCAPE",
+	"REPAIR",
+	"OPTIMIZE",
+	"TRUNCATE",
+	"FORMAT",
+	"MAXVALUE",
+	"PARTITION",
+	"REORGANIZE",
+	"LESS",
+	"THAN",
+	"PROCEDURE",
+	"TRIGGER",
+	"TRIGGERS",
+	"FUNCTION",
+	"STATUS",
+	"VARIABLES",
+	"WARNINGS",
+	"SEQUENCE",
+	"EACH",
+	"ROW",
+	"BEFORE",
+	"FOLLOWS",
+	"PRECEDES",
+	"DEFINER",
+	"INVOKER",
+	"INOUT",
+	"OUT",
+	"DETERMINISTIC",
+	"CONTAINS",
+	"READS",
+	"MODIFIES",
+	"SQL",
+	"SECURITY",
+	"TEMPORARY",
+	"CLASS_ORIGIN",
+	"SUBCLASS_ORIGIN",
+	"MESSAGE_TEXT",
+	"MYSQL_ERRNO",
+	"CONSTRAINT_CATALOG",
+	"CONSTRAINT_SCHEMA",
+	"CONSTRAINT_NAME",
+	"CATALOG_NAME",
+	"SCHEMA_NAME",
+	"TABLE_NAME",
+	"COLUMN_NAME",
+	"CURSOR_NAME",
+	"SIGNAL",
+	"RESIGNAL",
+	"SQLSTATE",
+	"DECLARE",
+	"CONDITION",
+	"CURSOR",
+	"CONTINUE",
+	"EXIT",
+	"UNDO",
+	"HANDLER",
+	"FOUND",
+	"SQLWARNING",
+	"SQLEXCEPTION",
+	"BEGIN",
+	"START",
+	"TRANSACTION",
+	"COMMIT",
+	"ROLLBACK",
+	"SAVEPOINT",
+	"WORK",
+	"RELEASE",
+	"BIT",
+	"TINYINT",
+	"SMALLINT",
+	"MEDIUMINT",
+	"INT",
+	"INTEGER",
+	"BIGINT",
+	"INTNUM",
+	"REAL",
+	"DOUBLE",
+	"FLOAT_TYPE",
+	"DECIMAL",
+	"NUMERIC",
+	"DEC",
+	"FIXED",
+	"PRECISION",
+	"TIME",
+	"TIMESTAMP",
+	"DATETIME",
+	"YEAR",
+	"CHAR",
+	"VARCHAR",
+	"BOOL",
+	"CHARACTER",
+	"VARBINARY",
+	"NCHAR",
+	"NVARCHAR",
+	"NATIONAL",
+	"VARYING",
+	"TEXT",
+	"TINYTEXT",
+	"MEDIUMTEXT",
+	"LONGTEXT",
+	"LONG",
+	"BLOB",
+	"TINYBLOB",
+	"MEDIUMBLOB",
+	"LONGBLOB",
+	"JSON",
+	"ENUM",
+	"GEOMETRY",
+	"POINT",
+	"LINESTRING",
+	"POLYGON",
+	"GEOMETRYCOLLECTION",
+	"MULTIPOINT",
+	"MULTILINESTRING",
+	"MULTIPOLYGON",
+	"LOCAL",
+	"LOW_PRIORITY",
+	"NULLX",
+	"AUTO_INCREMENT",
+	"APPROXNUM",
+	"SIGNED",
+	"UNSIGNED",
+	"ZEROFILL",
+	"COLLATION",
+	"DATABASES",
+	"SCHEMAS",
+	"TABLES",
+	"FULL",
+	"PROCESSLIST",
+	"COLUMNS",
+	"FIELDS",
+	"ENGINES",
+	"PLUGINS",
+	"NAMES",
+	"CHARSET",
+	"GLOBAL",
+	"SESSION",
+	"ISOLATION",
+	"LEVEL",
+	"READ",
+	"WRITE",
+	"ONLY",
+	"REPEATABLE",
+	"COMMITTED",
+	"UNCOMMITTED",
+	"SERIALIZABLE",
+	"CURRENT_TIMESTAMP",
+	"DATABASE",
+	"CURRENT_DATE",
+	"CURRENT_USER",
+	"CURRENT_TIME",
+	"LOCALTIME",
+	"LOCALTIMESTAMP",
+	"UTC_DATE",
+	"UTC_TIME",
+	"UTC_TIMESTAMP",
+	"REPLACE",
+	"CONVERT",
+	"CAST",
+	"SUBSTR",
+	"SUBSTRING",
+	"GROUP_CONCAT",
+	"SEPARATOR",
+	"TIMESTAMPADD",
+	"TIMESTAMPDIFF",
+	"OVER",
+	"WINDOW",
+	"GROUPING",
+	"GROUPS",
+	"AVG",
+	"BIT_AND",
+	"BIT_OR",
+	"BIT_XOR",
+	"COUNT",
+	"JSON_ARRAYAGG",
+	"JSON_OBJECTAGG",
+	"MAX",
+	"MIN",
+	"STDDEV_POP",
+	"STDDEV",
+	"STD",
+	"STDDEV_SAMP",
+	"SUM",
+	"VAR_POP",
+	"VARIANCE",
+	"VAR_SAMP",
+	"CUME_DIST",
+	"DENSE_RANK",
+	"FIRST_VALUE",
+	"LAG",
+	"LAST_VALUE",
+	"LEAD",
+	"NTH_VALUE",
+	"NTILE",
+	"ROW_NUMBER",
+	"PERCENT_RANK",
+	"RANK",
+	"MATCH",
+	"AGAINST",
+	"BOOLEAN",
+	"LANGUAGE",
+	"WITH",
+	"QUERY",
+	"EXPANSION",
+	"UNUSED",
+	"ARRAY",
+	"DESCRIPTION",
+	"EMPTY",
+	"EXCEPT",
+	"JSON_TABLE",
+	"LATERAL",
+	"MEMBER",
+	"RECURSIVE",
+	"ACTIVE",
+	"ADMIN",
+	"BUCKETS",
+	"CLONE",
+	"COMPONENT",
+	"DEFINITION",
+	"ENFORCED",
+	"EXCLUDE",
+	"FOLLOWING",
+	"GEOMCOLLECTION",
+	"GET_MASTER_PUBLIC_KEY",
+	"HISTOGRAM",
+	"HISTORY",
+	"INACTIVE",
+	"INVISIBLE",
+	"LOCKED",
+	"MASTER_COMPRESSION_ALGORITHMS",
+	"MASTER_PUBLIC_KEY_PATH",
+	"MASTER_TLS_CIPHERSUITES",
+	"MASTER_ZSTD_COMPRESSION_LEVEL",
+	"NESTED",
+	"NETWORK_NAMESPACE",
+	"NOWAIT",
+	"NULLS",
+	"OJ",
+	"OLD",
+	"OPTIONAL",
+	"ORDINALITY",
+	"ORGANIZATION",
+	"OTHERS",
+	"PATH",
+	"PERSIST",
+	"PERSIST_ONLY",
+	"PRECEDING",
+	"PRIVILEGE_CHECKS_USER",
+	"PROCESS",
+	"RANDOM",
+	"REFERENCE",
+	"REQUIRE_ROW_FORMAT",
+	"RESOURCE",
+	"RESPECT",
+	"RESTART",
+	"RETAIN",
+	"REUSE",
+	"ROLE",
+	"SECONDARY",
+	"SECONDARY_ENGINE",
+	"SECONDARY_LOAD",
+	"SECONDARY_UNLOAD",
+	"SKIP",
+	"SRID",
+	"THREAD_PRIORITY",
+	"TIES",
+	"UNBOUNDED",
+	"VCPU",
+	"VISIBLE",
+	"SYSTEM",
+	"INFILE",
+	"';'",
+}
+var yyStatenames = [...]string{}
+
+const yyEofCode = 1
+const yyErrCode = 2
+const yyInitialStackSize = 16
+
+//line yacctab:1
+var yyExca = [...]int{
+	-1, 1,
+	1, -1,
+	-2, 0,
+	-1, 33,
+	5, 49,
+	-2, 841,
+	-1, 41,
+	140, 901,
+	141, 927,
+	-2, 120,
+	-1, 48,
+	180, 501,
+	181, 501,
+	-2, 491,
+	-1, 55,
+	1, 1346,
+	437, 1346,
+	-2, 527,
+	-1, 438,
+	127, 937,
+	-2, 931,
+	-1, 439,
+	127, 938,
+	-2, 932,
+	-1, 541,
+	97, 1167,
+	127, 1167,
+	-2, 885,
+	-1, 542,
+	97, 1269,
+	127, 1269,
+	-2, 886,
+	-1, 547,
+	97, 1187,
+	127, 1187,
+	-2, 887,
+	-1, 548,
+	97, 1227,
+	127, 1227,
+	-2, 888,
+	-1, 549,
+	97, 1228,
+	127, 1228,
+	-2, 889,
+	-1, 550,
+	97, 1122,
+	127, 1122,
+	-2, 893,
+	-1, 552,
+	97, 1206,
+	127, 1206,
+	-2, 895,
+	-1, 961,
+	1, 579,
+	5, 579,
+	12, 579,
+	13, 579,
+	14, 579,
+	15, 579,
+	17, 579,
+	19, 579,
+	30, 579,
+	31, 579,
+	56, 579,
+	57, 579,
+	58, 579,
+	59, 579,
+	60, 579,
+	62, 579,
+	63, 579,
+	66, 579,
+	67, 579,
+	69, 579,
+	70, 579,
+	437, 579,
+	-2, 609,
+	-1, 965,
+	67, 66,
+	69, 66,
+	-2, 70,
+	-1, 1162,
+	127, 940,
+	-2, 936,
+	-1, 1326,
+	68, 358,
+	-2, 1087,
+	-1, 1329,
+	68, 354,
+	71, 354,
+	-2, 1022,
+	-1, 1330,
+	68, 355,
+	71, 355,
+	-2, 1032,
+	-1, 1418,
+	68, 437,
+	71, 437,
+	147, 401,
+	-2, 399,
+	-1, 1494,
+	5, 50,
+	-2, 675,
+	-1, 1782,
+	1, 630,
+	5, 630,
+	12, 630,
+	13, 630,
+	14, 630,
+	15, 630,
+	17, 630,
+	19, 630,
+	30, 630,
+	31, 630,
+	56, 630,
+	57, 630,
+	58, 630,
+	59, 630,
+	60, 630,
+	62, 630,
+	63, 630,
+	66, 630,
+	67, 630,
+	69, 630,
+	70, 630,
+	437, 630,
+	-2, 609,
+	-1, 1852,
+	147, 402,
+	-2, 400,
+	-1, 1909,
+	5, 50,
+	-2, 860,
+	-1, 2046,
+	41, 947,
+	-2, 945,
+	-1, 2154,
+	5, 50,
+	-2, 863,
+}
+
+const yyPrivate = 57344
+
+const yyLast = 25223
+
+var yyAct = [...]int{
+
+	501, 78, 2303, 2257, 2269, 2171, 2278, 2157, 1374, 2259,
+	2061, 2268, 1919, 2198, 2144, 2093, 7, 996, 2092, 6,
+	2091, 5, 2094, 8, 1984, 1795, 2046, 2020, 2139, 1776,
+	1372, 1756, 1683, 430, 2170, 914, 1693, 1527, 1555, 500,
+	1581, 1331, 1275, 1965, 1947, 1140, 2158, 1796, 423, 82,
+	1757, 1323, 963, 564, 1846, 1635, 1279, 457, 444, 1281,
+	753, 92, 1753, 1528, 103, 1313, 961, 1416, 1692, 368,
+	371, 1312, 1447, 364, 2090, 3, 1363, 1762, 1768, 78,
+	1133, 1187, 1729, 1196, 1077, 566, 730, 1254, 1400, 1327,
+	1148, 1659, 1703, 1319, 561, 1121, 1097, 976, 1258, 118,
+	1618, 1359, 823, 1265, 1246, 1164, 801, 816, 363, 741,
+	780, 560, 819, 975, 426, 1249, 539, 958, 441, 836,
+	388, 543, 957, 540, 535, 967, 779, 930, 2325, 708,
+	365, 366, 367, 2321, 2311, 532, 2293, 2291, 2273, 2252,
+	2206, 81, 534, 1119, 1941, 1827, 558, 1948, 442, 546,
+	706, 84, 931, 422, 67, 1950, 2284, 2192, 1429, 2267,
+	2152, 2240, 716, 2191, 1746, 2151, 1658, 827, 1469, 34,
+	725, 1901, 1428, 34, 34, 707, 1791, 1792, 1299, 1300,
+	389, 34, 34, 1302, 70, 37, 38, 86, 87, 88,
+	89, 90, 2072, 851, 850, 860, 861, 853, 854, 855,
+	856, 857, 858, 859, 852, 1522, 1564, 862, 1412, 1563,
+	2005, 446, 1565, 1790, 1433, 1277, 1125, 114, 110, 111,
+	1298, 112, 1523, 1427, 1953, 379, 391, 70, 37, 38,
+	710, 79, 977, 378, 978, 79, 79, 1601, 755, 1123,
+	1124, 1347, 1333, 79, 79, 756, 757, 556, 1411, 39,
+	798, 1348, 743, 1335, 116, 115, 1892, 1335, 1993, 735,
+	1951, 1952, 1954, 1955, 1956, 1339, 1341, 1353, 1340, 1348,
+	1890, 1360, 358, 1106, 1425, 1419, 1420, 377, 1418, 106,
+	1421, 1422, 514, 386, 520, 522, 521, 518, 519, 517,
+	516, 515, 2282, 2203, 2043, 764, 2201, 2202, 2042, 523,
+	524, 2041, 369, 1122, 2040, 2263, 2039, 2254, 2258, 2037,
+	353, 2038, 2123, 2124, 2159, 1431, 1434, 2195, 2196, 1381,
+	1921, 98, 2261, 1547, 2088, 750, 751, 734, 738, 752,
+	749, 740, 748, 712, 711, 2266, 2239, 2140, 1686, 742,
+	742, 1636, 83, 1259, 1380, 2317, 995, 562, 350, 2086,
+	1800, 742, 758, 372, 759, 756, 757, 361, 106, 359,
+	1665, 78, 78, 995, 736, 739, 994, 737, 1966, 1967,
+	995, 2126, 1851, 2326, 100, 2323, 769, 1637, 97, 771,
+	1058, 770, 806, 1798, 108, 107, 727, 2312, 995, 2294,
+	813, 113, 1800, 362, 373, 709, 718, 385, 1977, 1426,
+	334, 384, 1294, 385, 1640, 1067, 766, 337, 1554, 1553,
+	1552, 1877, 705, 1653, 809, 713, 333, 346, 351, 352,
+	1869, 109, 1568, 1976, 104, 1560, 2307, 1424, 874, 875,
+	1464, 802, 1107, 1949, 105, 768, 772, 810, 871, 1503,
+	1452, 873, 1826, 1348, 2248, 370, 1608, 370, 370, 744,
+	1338, 1362, 2073, 343, 765, 2021, 344, 2260, 2262, 349,
+	763, 1638, 1639, 108, 107, 1579, 1430, 2150, 2023, 1980,
+	884, 885, 886, 887, 888, 889, 890, 891, 892, 893,
+	894, 895, 896, 897, 898, 899, 900, 901, 902, 903,
+	904, 905, 906, 907, 908, 909, 910, 911, 71, 1880,
+	912, 733, 916, 917, 918, 919, 920, 921, 922, 923,
+	924, 925, 926, 1432, 929, 932, 932, 932, 938, 932,
+	932, 938, 932, 938, 947, 948, 949, 950, 951, 952,
+	77, 962, 803, 335, 77, 77, 805, 370, 814, 2022,
+	1437, 71, 77, 77, 1975, 1284, 1286, 1500, 99, 436,
+	2305, 1143, 1730, 2306, 988, 2304, 874, 875, 1680, 973,
+	842, 1578, 1125, 726, 1579, 1303, 348, 338, 339, 1816,
+	356, 1981, 1593, 956, 340, 342, 913, 336, 355, 354,
+	1579, 840, 955, 862, 965, 1123, 1124, 1598, 1597, 852,
+	1582, 1136, 862, 1098, 1732, 732, 835, 1684, 546, 833,
+	2297, 2279, 2296, 546, 834, 833, 989, 1391, 746, 1594,
+	855, 856, 857, 858, 859, 852, 835, 1054, 862, 1171,
+	760, 1817, 835, 1599, 1624, 1591, 1285, 1766, 1114, 986,
+	773, 1592, 95, 1748, 1169, 1170, 1168, 714, 1579, 1247,
+	834, 833, 2310, 933, 935, 937, 939, 941, 943, 944,
+	946, 2249, 872, 1198, 1247, 993, 1511, 1579, 835, 971,
+	1578, 980, 1060, 1804, 874, 875, 981, 966, 934, 936,
+	830, 940, 942, 1734, 945, 2173, 1578, 94, 1738, 1679,
+	1733, 2155, 1731, 1676, 1449, 1450, 1451, 1736, 731, 1099,
+	1596, 853, 854, 855, 856, 857, 858, 859, 852, 820,
+	1735, 862, 821, 1392, 747, 2318, 2199, 439, 2224, 990,
+	2223, 1940, 1053, 762, 93, 1737, 1739, 782, 783, 784,
+	785, 786, 787, 788, 789, 790, 791, 792, 793, 742,
+	1939, 1623, 834, 833, 1578, 1621, 742, 742, 742, 2314,
+	432, 1665, 964, 1602, 777, 995, 79, 2236, 1668, 1666,
+	835, 742, 742, 1578, 121, 717, 1167, 121, 2319, 1675,
+	1665, 1141, 1142, 121, 1672, 1667, 776, 1671, 1674, 1498,
+	534, 1497, 2235, 1072, 860, 861, 853, 854, 855, 856,
+	857, 858, 859, 852, 1667, 121, 862, 2208, 834, 833,
+	2222, 1089, 1090, 1091, 1154, 1156, 1157, 121, 1092, 2179,
+	1155, 121, 569, 834, 833, 121, 835, 78, 834, 833,
+	2251, 742, 1079, 1132, 2085, 2200, 1081, 121, 1145, 569,
+	2036, 835, 834, 833, 2000, 121, 835, 1595, 1188, 1566,
+	1189, 1567, 1117, 1937, 383, 1093, 1094, 1809, 1080, 1619,
+	835, 1146, 1408, 1064, 1147, 1086, 1087, 1088, 834, 833,
+	1111, 1101, 1102, 1068, 2199, 1082, 2012, 2241, 834, 833,
+	1095, 1096, 1128, 1084, 1085, 1750, 835, 2221, 720, 721,
+	722, 723, 724, 1499, 78, 1845, 835, 1702, 1847, 1131,
+	2083, 1127, 1930, 2238, 1150, 1165, 851, 850, 860, 861,
+	853, 854, 855, 856, 857, 858, 859, 852, 2185, 815,
+	862, 1109, 1110, 1930, 2183, 1112, 850, 860, 861, 853,
+	854, 855, 856, 857, 858, 859, 852, 916, 1126, 862,
+	1129, 1115, 529, 530, 1930, 2181, 815, 1160, 1162, 1930,
+	2087, 2049, 1130, 2012, 2079, 2030, 834, 833, 2055, 1267,
+	1270, 1271, 1272, 1268, 1401, 1269, 1273, 1582, 913, 1769,
+	1770, 2050, 1847, 1973, 835, 2012, 2026, 1158, 2012, 815,
+	391, 2012, 2011, 1278, 1930, 1929, 1912, 815, 962, 1436,
+	815, 2029, 962, 1871, 1556, 1205, 1864, 1860, 1856, 1209,
+	1855, 1236, 1239, 1853, 1837, 1836, 840, 1835, 1248, 1824,
+	1823, 1230, 1647, 1191, 1192, 1820, 1821, 876, 877, 878,
+	879, 880, 881, 882, 883, 1203, 1204, 1260, 1820, 1819,
+	1289, 1462, 815, 969, 1291, 1646, 1214, 1215, 1216, 1217,
+	1402, 1290, 1389, 1274, 964, 1262, 815, 1228, 1405, 1227,
+	1229, 1872, 1388, 546, 1190, 1233, 1108, 913, 1309, 1228,
+	815, 121, 1105, 1076, 1075, 1074, 569, 569, 1054, 1073,
+	1065, 1063, 1194, 1062, 1061, 1059, 1202, 1166, 569, 1079,
+	742, 799, 742, 728, 1287, 1211, 1212, 992, 991, 970,
+	376, 972, 374, 1308, 1162, 1556, 1222, 83, 969, 824,
+	1226, 2048, 1754, 1832, 1810, 1765, 121, 1320, 1556, 843,
+	1261, 1296, 121, 1301, 1244, 1161, 83, 1292, 1295, 1288,
+	1765, 968, 2187, 1138, 1317, 1310, 1369, 1370, 1907, 1833,
+	95, 1375, 391, 1822, 1778, 1656, 1570, 1383, 1297, 1384,
+	1385, 1462, 1516, 1386, 1515, 1365, 1366, 1367, 1368, 1262,
+	1113, 1228, 1262, 78, 970, 1387, 968, 968, 1139, 1872,
+	839, 1120, 1066, 1053, 1283, 1765, 974, 812, 1361, 811,
+	1462, 1284, 1286, 1396, 1137, 915, 79, 1267, 1270, 1271,
+	1272, 1268, 1453, 1269, 1273, 2193, 928, 2182, 557, 1376,
+	1777, 1378, 1904, 802, 964, 2054, 2052, 1942, 1335, 964,
+	1917, 1364, 1803, 964, 1393, 1769, 1770, 2288, 1360, 1399,
+	1574, 1382, 79, 1355, 1354, 851, 850, 860, 861, 853,
+	854, 855, 856, 857, 858, 859, 852, 913, 1165, 862,
+	1055, 851, 850, 860, 861, 853, 854, 855, 856, 857,
+	858, 859, 852, 796, 1403, 862, 1373, 1409, 1308, 1162,
+	79, 2286, 1285, 1404, 2270, 1831, 1772, 121, 121, 121,
+	1754, 1307, 1441, 1414, 1314, 562, 1625, 1070, 1448, 1435,
+	1439, 1440, 1775, 569, 1349, 1350, 1351, 1352, 1774, 1525,
+	1526, 1458, 1536, 962, 962, 962, 962, 962, 1539, 1535,
+	1537, 391, 2218, 1540, 1454, 1538, 427, 428, 2190, 1278,
+	1690, 1548, 462, 461, 464, 465, 466, 467, 1438, 962,
+	1149, 463, 468, 2216, 1413, 1446, 1468, 1470, 1541, 1445,
+	1271, 1272, 2003, 1410, 1972, 1584, 1460, 1480, 1481, 1482,
+	1483, 1463, 1104, 1932, 1492, 1859, 1465, 1466, 1858, 1808,
+	1529, 1471, 1472, 1473, 1474, 1475, 1476, 1807, 1558, 1479,
+	1559, 1557, 1576, 1524, 1484, 1485, 1486, 1487, 2128, 1489,
+	1490, 1491, 2131, 1551, 2178, 2177, 1494, 1495, 1496, 1334,
+	1510, 2047, 2207, 1230, 1502, 546, 2045, 1505, 1506, 1507,
+	2122, 2121, 375, 1650, 1513, 1612, 1514, 817, 1583, 1517,
+	1518, 987, 1519, 1520, 794, 78, 1054, 1542, 1571, 818,
+	1166, 1531, 1532, 778, 1534, 1530, 1083, 742, 1533, 742,
+	742, 1544, 1545, 775, 1163, 1561, 1161, 1172, 1173, 1174,
+	1175, 1176, 1177, 1178, 1179, 1180, 1181, 1182, 1183, 1184,
+	1185, 1186, 1573, 569, 1103, 1577, 1580, 1569, 980, 774,
+	729, 2231, 2059, 391, 2058, 121, 1905, 1982, 121, 1645,
+	1407, 391, 828, 829, 121, 2230, 569, 1141, 1142, 1377,
+	1642, 1069, 1687, 569, 569, 569, 121, 121, 121, 1627,
+	915, 1628, 1398, 121, 95, 1543, 1620, 1057, 569, 569,
+	2229, 826, 2228, 1550, 1622, 828, 829, 807, 808, 2033,
+	1710, 1053, 1444, 424, 964, 964, 964, 964, 964, 2210,
+	1443, 2209, 1250, 2175, 1649, 2132, 2063, 1151, 1152, 1991,
+	964, 1657, 425, 1648, 83, 1691, 1641, 2062, 1643, 1644,
+	964, 1985, 1556, 1504, 1655, 1652, 1654, 1501, 1660, 1673,
+	1678, 1670, 1662, 1663, 1630, 1631, 1632, 121, 569, 121,
+	1100, 569, 2290, 2289, 1759, 831, 78, 2289, 2290, 2076,
+	1806, 1611, 1135, 1613, 1614, 1615, 1616, 557, 380, 121,
+	382, 1696, 2104, 51, 1697, 839, 1701, 85, 1780, 1705,
+	1162, 2106, 19, 1784, 1785, 1786, 2105, 18, 1747, 54,
+	1755, 2107, 20, 1758, 1711, 1314, 1764, 1714, 1715, 1716,
+	915, 80, 1719, 1, 1234, 1235, 800, 1700, 1603, 1604,
+	1740, 1529, 1741, 1708, 2176, 1610, 1669, 1779, 1681, 1682,
+	2127, 569, 1685, 1717, 1718, 1617, 1789, 2108, 21, 2129,
+	1760, 2103, 15, 2044, 1724, 1787, 1761, 1961, 1728, 1946,
+	1783, 1945, 1773, 2102, 14, 2096, 10, 1634, 1801, 2115,
+	30, 1802, 2114, 29, 2113, 28, 1781, 1799, 1633, 1369,
+	2111, 25, 2110, 24, 2112, 26, 1829, 1830, 1794, 795,
+	1306, 1118, 1793, 2101, 13, 2098, 12, 2097, 11, 2095,
+	9, 1661, 1664, 1423, 1834, 569, 569, 2138, 1321, 1311,
+	559, 91, 121, 1390, 745, 1970, 341, 1318, 1589, 2130,
+	121, 121, 797, 499, 1588, 121, 121, 1585, 1828, 121,
+	121, 121, 1600, 1332, 1587, 1586, 2125, 1590, 1132, 1000,
+	998, 999, 997, 1838, 1002, 1001, 345, 982, 2165, 569,
+	569, 1849, 832, 101, 55, 1695, 1842, 1974, 1677, 1371,
+	1417, 96, 102, 754, 347, 870, 1442, 1161, 1562, 1455,
+	1456, 1457, 1054, 544, 1844, 545, 1850, 1873, 1843, 537,
+	1861, 1870, 2194, 1848, 822, 1839, 1863, 2141, 1509, 927,
+	1245, 445, 1546, 2143, 1854, 1153, 460, 1899, 459, 1255,
+	458, 455, 456, 1397, 1868, 1144, 1521, 844, 1825, 443,
+	1879, 434, 960, 953, 553, 1406, 121, 569, 565, 569,
+	1266, 1264, 121, 1263, 121, 121, 1071, 533, 121, 1771,
+	1767, 1276, 959, 387, 68, 719, 761, 1811, 1812, 360,
+	1900, 2071, 36, 824, 1815, 381, 429, 1925, 1926, 1927,
+	27, 1818, 17, 767, 22, 16, 121, 121, 121, 1415,
+	715, 1888, 1874, 40, 1913, 43, 1933, 1053, 1906, 42,
+	1629, 1695, 1379, 1314, 1529, 1314, 2164, 2256, 121, 1914,
+	121, 78, 781, 2277, 2197, 32, 1928, 31, 2109, 2116,
+	2100, 2099, 1923, 2243, 1924, 1884, 23, 1813, 2242, 4,
+	804, 69, 33, 1958, 1959, 1960, 1893, 1894, 555, 1571,
+	2, 0, 0, 0, 1934, 1968, 0, 0, 0, 0,
+	0, 0, 962, 0, 0, 1908, 1909, 1910, 1911, 0,
+	1969, 0, 1493, 0, 1944, 0, 0, 0, 0, 1957,
+	0, 0, 0, 0, 1841, 0, 0, 1922, 0, 964,
+	1964, 1512, 1962, 0, 1799, 1943, 1369, 1978, 1759, 1987,
+	1988, 2007, 1963, 0, 1986, 0, 0, 0, 0, 1979,
+	0, 0, 1780, 0, 0, 0, 0, 0, 0, 0,
+	2010, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1971, 0, 0, 0, 0, 0, 0, 1758, 0, 0,
+	0, 1651, 2032, 0, 2034, 0, 0, 0, 2004, 0,
+	0, 2013, 2009, 0, 0, 0, 0, 0, 2031, 0,
+	121, 121, 121, 121, 121, 0, 2019, 2025, 2024, 2014,
+	0, 0, 121, 2060, 0, 2006, 121, 0, 0, 0,
+	121, 2035, 0, 0, 0, 1990, 121, 1935, 0, 0,
+	0, 0, 0, 0, 0, 0, 1759, 0, 78, 1699,
+	2051, 2053, 565, 565, 0, 0, 1999, 0, 0, 0,
+	569, 2065, 0, 2057, 565, 2064, 0, 2066, 0, 0,
+	0, 0, 0, 0, 0, 78, 0, 2077, 0, 0,
+	2089, 2016, 2017, 2018, 0, 1758, 1314, 2082, 0, 962,
+	1742, 1743, 0, 1744, 1745, 2084, 0, 0, 0, 0,
+	0, 1936, 0, 1938, 0, 1751, 1752, 0, 0, 0,
+	0, 391, 0, 391, 0, 2081, 0, 0, 2134, 0,
+	2136, 569, 2078, 964, 2135, 0, 0, 0, 0, 2146,
+	0, 2133, 0, 0, 569, 121, 569, 569, 2160, 1782,
+	0, 0, 2147, 1283, 0, 2015, 0, 0, 0, 0,
+	2067, 2068, 2069, 2070, 2153, 0, 2148, 2074, 2075, 0,
+	2027, 78, 2028, 0, 0, 0, 0, 0, 1992, 0,
+	1695, 0, 0, 0, 0, 1529, 0, 0, 0, 2156,
+	0, 0, 0, 1805, 569, 569, 0, 0, 0, 0,
+	121, 0, 0, 0, 2174, 2172, 0, 2188, 0, 0,
+	569, 0, 0, 0, 0, 1749, 0, 0, 0, 0,
+	0, 0, 2180, 0, 0, 0, 0, 569, 0, 0,
+	0, 0, 0, 2204, 0, 0, 2082, 2149, 0, 2212,
+	0, 2214, 0, 0, 2154, 0, 0, 0, 0, 0,
+	1840, 78, 2217, 2220, 0, 2211, 2219, 78, 2213, 0,
+	1788, 2225, 2234, 553, 2215, 0, 0, 0, 553, 983,
+	0, 0, 2237, 0, 0, 78, 0, 0, 0, 0,
+	78, 0, 0, 569, 569, 2227, 2247, 0, 2253, 2246,
+	0, 2245, 0, 2244, 0, 0, 2265, 0, 1875, 78,
+	0, 2271, 78, 78, 2184, 0, 569, 78, 2234, 2250,
+	964, 0, 2280, 0, 2189, 0, 0, 2283, 0, 0,
+	0, 0, 0, 2285, 78, 0, 569, 78, 569, 2234,
+	569, 2287, 569, 2272, 2298, 2300, 2274, 0, 0, 0,
+	0, 0, 78, 2308, 78, 0, 0, 2234, 78, 2234,
+	0, 0, 0, 0, 2255, 0, 0, 0, 0, 0,
+	0, 2295, 78, 0, 0, 78, 0, 2234, 0, 0,
+	0, 0, 78, 0, 0, 0, 78, 2234, 0, 0,
+	0, 2234, 2313, 121, 0, 0, 0, 1865, 0, 0,
+	0, 0, 0, 1706, 1707, 0, 0, 432, 121, 2322,
+	1712, 1713, 0, 1336, 1337, 0, 1342, 1343, 1344, 1345,
+	1346, 121, 1720, 1721, 1722, 1723, 0, 1725, 1726, 1727,
+	0, 0, 2169, 0, 1356, 1357, 1358, 0, 0, 1056,
+	0, 569, 0, 0, 0, 121, 569, 0, 0, 0,
+	0, 0, 0, 569, 569, 0, 0, 0, 0, 0,
+	0, 0, 565, 0, 1902, 0, 0, 0, 0, 565,
+	565, 565, 0, 915, 0, 0, 0, 2315, 2316, 0,
+	1915, 815, 0, 1916, 565, 565, 1918, 0, 0, 0,
+	0, 0, 0, 0, 915, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1994, 1995, 1996, 1997, 1998,
+	0, 0, 0, 2001, 2002, 0, 0, 0, 2232, 851,
+	850, 860, 861, 853, 854, 855, 856, 857, 858, 859,
+	852, 0, 0, 862, 0, 0, 0, 0, 1903, 0,
+	0, 0, 0, 0, 565, 569, 0, 1134, 0, 0,
+	0, 0, 569, 569, 569, 0, 0, 0, 0, 0,
+	0, 569, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 569, 0, 0, 0, 0, 565, 851, 850, 860,
+	861, 853, 854, 855, 856, 857, 858, 859, 852, 0,
+	0, 862, 0, 0, 0, 0, 0, 0, 0, 121,
+	0, 846, 0, 849, 0, 0, 0, 0, 0, 0,
+	863, 864, 865, 866, 867, 868, 869, 1193, 847, 848,
+	845, 851, 850, 860, 861, 853, 854, 855, 856, 857,
+	858, 859, 852, 0, 0, 862, 0, 569, 1876, 121,
+	0, 0, 0, 0, 0, 569, 1878, 0, 0, 0,
+	0, 0, 0, 0, 0, 1881, 1882, 0, 0, 0,
+	0, 0, 1883, 553, 0, 0, 0, 0, 2137, 0,
+	0, 0, 0, 0, 0, 1898, 0, 0, 0, 0,
+	569, 1251, 1252, 0, 0, 569, 0, 0, 0, 0,
+	121, 0, 121, 0, 0, 1195, 1200, 1201, 569, 0,
+	0, 432, 1206, 1207, 1208, 915, 1210, 1897, 553, 1213,
+	569, 0, 0, 0, 1218, 1219, 1220, 1221, 0, 1223,
+	1224, 1225, 565, 0, 0, 565, 565, 1231, 1232, 0,
+	0, 0, 1238, 1241, 1242, 1243, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 569, 0, 0, 1605, 1606,
+	1607, 1609, 0, 0, 0, 0, 0, 0, 0, 1253,
+	0, 1256, 1257, 0, 851, 850, 860, 861, 853, 854,
+	855, 856, 857, 858, 859, 852, 2142, 2145, 862, 0,
+	569, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 565, 0, 565, 851, 850, 860, 861,
+	853, 854, 855, 856, 857, 858, 859, 852, 0, 0,
+	862, 0, 0, 0, 0, 0, 121, 0, 0, 0,
+	569, 0, 569, 0, 0, 0, 0, 0, 0, 825,
+	0, 2161, 2162, 0, 1698, 0, 0, 0, 0, 0,
+	0, 2264, 0, 0, 1022, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 121, 851, 850, 860, 861, 853,
+	854, 855, 856, 857, 858, 859, 852, 0, 0, 862,
+	0, 0, 0, 0, 0, 0, 119, 565, 0, 357,
+	0, 0, 0, 2205, 0, 119, 0, 569, 0, 0,
+	0, 1896, 0, 2301, 0, 0, 0, 0, 0, 0,
+	0, 2145, 0, 0, 0, 0, 0, 390, 569, 0,
+	0, 0, 0, 2226, 0, 0, 433, 0, 0, 536,
+	554, 0, 0, 119, 0, 0, 0, 119, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1009, 0, 119,
+	0, 0, 0, 0, 0, 0, 0, 119, 0, 0,
+	569, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 569, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 569, 0, 0, 0, 0, 0, 1023,
+	851, 850, 860, 861, 853, 854, 855, 856, 857, 858,
+	859, 852, 0, 2299, 862, 553, 0, 0, 0, 0,
+	0, 0, 0, 0, 1461, 0, 0, 0, 0, 0,
+	0, 0, 1467, 0, 0, 0, 0, 0, 1814, 0,
+	0, 1477, 1478, 0, 0, 0, 0, 0, 0, 0,
+	553, 0, 1488, 0, 0, 1895, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 565, 1036, 1039, 1040,
+	1041, 1042, 1043, 1044, 1508, 1045, 1046, 1047, 1048, 1049,
+	1050, 1051, 0, 1024, 1025, 1026, 1027, 1003, 1007, 1037,
+	1004, 1010, 1006, 1008, 1005, 0, 1011, 1012, 1013, 1014,
+	1015, 1016, 1017, 1018, 1019, 1020, 1021, 1028, 1029, 1030,
+	1031, 1032, 1033, 1034, 1035, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1022, 1626, 0, 0,
+	0, 1852, 0, 0, 0, 0, 0, 0, 0, 0,
+	565, 0, 565, 565, 851, 850, 860, 861, 853, 854,
+	855, 856, 857, 858, 859, 852, 0, 0, 862, 0,
+	1885, 1886, 0, 1887, 0, 0, 1889, 0, 1891, 0,
+	851, 850, 860, 861, 853, 854, 855, 856, 857, 858,
+	859, 852, 0, 119, 862, 0, 0, 0, 0, 0,
+	1688, 1689, 0, 0, 0, 0, 0, 0, 0, 1038,
+	0, 0, 0, 0, 0, 0, 565, 0, 0, 0,
+	0, 0, 995, 0, 0, 0, 0, 0, 565, 1009,
+	1459, 0, 0, 1709, 0, 0, 0, 1931, 119, 0,
+	0, 0, 0, 0, 119, 0, 0, 0, 0, 0,
+	413, 851, 850, 860, 861, 853, 854, 855, 856, 857,
+	858, 859, 852, 0, 0, 862, 0, 0, 0, 0,
+	0, 1023, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 553, 0, 0, 1134,
+	1763, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1763, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 565, 0, 565, 0, 565, 0, 1797, 1036,
+	1039, 1040, 1041, 1042, 1043, 1044, 0, 1045, 1046, 1047,
+	1048, 1049, 1050, 1051, 0, 1024, 1025, 1026, 1027, 1003,
+	1007, 1037, 1004, 1010, 1006, 1008, 1005, 0, 1011, 1012,
+	1013, 1014, 1015, 1016, 1017, 1018, 1019, 1020, 1021, 1028,
+	1029, 1030, 1031, 1032, 1033, 1034, 1035, 0, 0, 119,
+	119, 119, 0, 0, 0, 0, 0, 0, 0, 554,
+	0, 0, 0, 0, 554, 393, 394, 395, 396, 397,
+	401, 402, 406, 407, 416, 415, 414, 417, 418, 420,
+	419, 421, 398, 399, 400, 403, 404, 405, 408, 409,
+	412, 410, 411, 0, 0, 0, 0, 1857, 0, 0,
+	0, 0, 1862, 0, 0, 0, 0, 0, 0, 1866,
+	1867, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1038, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 34,
+	35, 70, 37, 38, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 61, 0, 0, 0, 0, 0, 76,
+	0, 0, 0, 39, 65, 66, 0, 0, 0, 0,
+	62, 0, 0, 0, 0, 0, 0, 0, 0, 553,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1920, 0, 0, 0, 0, 0, 49, 1920, 1920,
+	1920, 79, 0, 0, 0, 0, 0, 565, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1920, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 119, 0, 0,
+	119, 0, 0, 0, 0, 0, 1078, 0, 0, 1575,
+	0, 0, 0, 0, 0, 0, 0, 0, 119, 119,
+	119, 0, 0, 0, 0, 119, 0, 0, 0, 0,
+	0, 0, 0, 41, 72, 45, 44, 47, 0, 58,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1983, 0, 0, 0, 0, 0, 0,
+	0, 565, 0, 0, 0, 48, 75, 74, 0, 0,
+	56, 57, 46, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
+	995, 390, 0, 0, 0, 0, 2008, 0, 0, 0,
+	0, 1920, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 119, 0, 0, 1797, 59, 60, 0, 413, 0,
+	0, 0, 1078, 0, 0, 0, 1797, 0, 50, 73,
+	0, 52, 53, 63, 0, 64, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2056, 0, 0, 0, 1199, 1199, 1199, 0, 0,
+	0, 1199, 1199, 1199, 1199, 1199, 1199, 0, 0, 1199,
+	0, 0, 0, 0, 1199, 1199, 1199, 1199, 0, 1199,
+	1199, 1199, 0, 0, 0, 0, 2080, 1199, 1199, 0,
+	0, 0, 1199, 1199, 1199, 1199, 0, 0, 0, 554,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 71, 0, 0, 0, 1199,
+	1199, 1199, 1199, 0, 119, 0, 1797, 0, 1797, 0,
+	0, 0, 119, 390, 0, 0, 0, 119, 119, 0,
+	0, 119, 1293, 1078, 554, 0, 0, 0, 0, 0,
+	553, 0, 0, 0, 0, 0, 0, 0, 1078, 0,
+	77, 0, 0, 393, 394, 395, 396, 397, 401, 402,
+	406, 407, 416, 415, 414, 417, 418, 420, 419, 421,
+	398, 399, 400, 403, 404, 405, 408, 409, 412, 410,
+	411, 0, 0, 565, 0, 0, 0, 34, 0, 70,
+	37, 38, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 61, 0, 0, 2186, 0, 0, 76, 0, 0,
+	0, 39, 0, 0, 0, 0, 0, 0, 119, 0,
+	0, 0, 0, 0, 119, 0, 119, 119, 0, 0,
+	119, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1797, 0, 0, 79,
+	0, 0, 0, 0, 0, 0, 0, 1920, 1394, 1395,
+	119, 0, 0, 0, 0, 0, 0, 0, 0, 565,
+	0, 0, 2117, 0, 0, 2276, 2279, 2275, 0, 0,
+	119, 0, 390, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1078, 0, 34, 0, 70, 37, 38,
+	0, 41, 72, 45, 44, 47, 0, 0, 0, 61,
+	0, 0, 0, 0, 0, 76, 0, 2118, 0, 39,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 48, 75, 74, 0, 0, 0, 0,
+	46, 0, 0, 0, 1199, 0, 0, 0, 0, 0,
+	0, 0, 1199, 1052, 0, 0, 0, 79, 0, 0,
+	0, 1199, 1199, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1199, 0, 0, 0, 1199, 0, 0, 0,
+	2117, 0, 0, 59, 60, 2324, 2119, 0, 0, 0,
+	0, 0, 0, 0, 1199, 0, 2120, 73, 0, 52,
+	53, 63, 0, 64, 0, 0, 0, 0, 0, 0,
+	0, 554, 119, 119, 119, 119, 119, 0, 0, 41,
+	72, 45, 44, 47, 390, 0, 0, 0, 119, 0,
+	0, 0, 390, 0, 995, 2118, 0, 0, 119, 0,
+	0, 34, 0, 70, 37, 38, 554, 0, 0, 0,
+	0, 48, 75, 74, 0, 61, 0, 0, 46, 0,
+	0, 76, 413, 0, 0, 39, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 71, 0, 0, 0, 0, 0, 0,
+	0, 59, 60, 79, 2119, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2120, 73, 0, 52, 53, 63,
+	0, 64, 0, 0, 0, 0, 2117, 0, 0, 0,
+	0, 2320, 0, 0, 0, 0, 0, 119, 77, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 41, 72, 45, 44, 47,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2118, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 119, 0, 0, 0, 0, 48, 75, 74,
+	0, 0, 0, 1199, 46, 0, 0, 0, 0, 0,
+	0, 71, 1199, 0, 1078, 0, 0, 393, 394, 395,
+	396, 397, 401, 402, 406, 407, 416, 415, 414, 417,
+	418, 420, 419, 421, 398, 399, 400, 403, 404, 405,
+	408, 409, 412, 410, 411, 0, 0, 59, 60, 0,
+	2119, 0, 0, 0, 0, 0, 77, 0, 0, 0,
+	2120, 73, 0, 52, 53, 63, 0, 64, 0, 0,
+	0, 0, 554, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 298, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 233, 0, 0, 0, 0,
+	1282, 0, 0, 0, 0, 166, 0, 0, 0, 0,
+	196, 0, 0, 157, 204, 202, 0, 0, 0, 239,
+	297, 0, 0, 0, 0, 199, 0, 0, 320, 288,
+	218, 0, 0, 0, 0, 0, 0, 71, 0, 0,
+	0, 1284, 1286, 0, 0, 0, 0, 0, 120, 0,
+	392, 0, 0, 0, 0, 119, 0, 147, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	119, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 77, 119, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 119, 126, 0,
+	0, 0, 0, 176, 219, 137, 0, 0, 0, 189,
+	326, 0, 1285, 433, 253, 0, 293, 179, 197, 141,
+	123, 135, 151, 178, 229, 262, 272, 0, 0, 0,
+	0, 0, 158, 0, 265, 237, 315, 0, 0, 243,
+	264, 200, 304, 255, 313, 314, 180, 413, 323, 328,
+	285, 167, 0, 127, 0, 250, 162, 193, 0, 0,
+	0, 155, 0, 0, 0, 284, 302, 142, 299, 217,
+	223, 152, 154, 153, 136, 279, 301, 146, 156, 289,
+	268, 294, 161, 0, 0, 554, 0, 0, 0, 0,
+	0, 0, 128, 296, 312, 148, 276, 277, 329, 263,
+	130, 310, 292, 215, 190, 191, 129, 0, 260, 165,
+	175, 160, 232, 0, 174, 252, 307, 308, 159, 331,
+	138, 322, 132, 139, 321, 226, 0, 225, 324, 303,
+	311, 216, 208, 0, 131, 309, 214, 207, 195, 170,
+	182, 248, 203, 249, 183, 221, 220, 222, 205, 209,
+	0, 119, 0, 290, 318, 332, 144, 0, 278, 300,
+	0, 0, 145, 173, 169, 247, 224, 140, 185, 287,
+	194, 201, 259, 330, 236, 266, 149, 317, 286, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 119, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 393, 394, 395, 396, 397, 401, 402, 406,
+	407, 416, 415, 414, 417, 418, 420, 419, 421, 398,
+	399, 400, 403, 404, 405, 408, 409, 412, 410, 411,
+	0, 122, 133, 198, 0, 257, 172, 319, 0, 164,
+	0, 0, 390, 0, 390, 0, 124, 125, 134, 143,
+	150, 163, 168, 171, 177, 181, 184, 186, 187, 188,
+	192, 206, 210, 211, 212, 213, 227, 228, 230, 231,
+	234, 235, 238, 240, 241, 242, 244, 245, 246, 251,
+	254, 256, 258, 261, 267, 269, 270, 271, 273, 274,
+	275, 280, 281, 282, 283, 291, 295, 305, 306, 316,
+	325, 327, 0, 0, 0, 0, 0, 433, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 687, 667, 298, 625, 690, 597, 614, 701,
+	615, 618, 656, 583, 637, 233, 612, 584, 0, 601,
+	574, 608, 575, 598, 627, 166, 596, 669, 640, 689,
+	196, 652, 0, 157, 204, 202, 0, 0, 119, 239,
+	297, 688, 633, 0, 696, 199, 0, 649, 320, 288,
+	218, 0, 0, 629, 676, 635, 665, 624, 658, 590,
+	648, 691, 613, 654, 692, 0, 554, 0, 2163, 0,
+	0, 0, 0, 0, 0, 0, 119, 147, 0, 651,
+	686, 610, 653, 655, 572, 650, 0, 578, 585, 700,
+	682, 604, 605, 606, 0, 0, 0, 0, 0, 0,
+	0, 628, 636, 662, 621, 0, 0, 0, 0, 0,
+	0, 0, 0, 602, 0, 646, 0, 0, 0, 586,
+	579, 0, 0, 626, 0, 0, 0, 589, 126, 603,
+	663, 0, 570, 176, 219, 137, 666, 681, 623, 189,
+	326, 685, 620, 619, 253, 0, 293, 179, 197, 141,
+	123, 135, 151, 178, 229, 262, 272, 611, 571, 670,
+	599, 609, 158, 607, 265, 237, 315, 0, 643, 243,
+	264, 200, 304, 255, 313, 314, 180, 704, 323, 328,
+	285, 167, 0, 127, 0, 250, 162, 193, 622, 657,
+	600, 155, 660, 647, 675, 284, 302, 142, 299, 217,
+	223, 152, 154, 153, 136, 279, 301, 146, 156, 289,
+	268, 294, 161, 0, 0, 2166, 2167, 2168, 0, 0,
+	0, 0, 128, 296, 312, 148, 276, 277, 329, 263,
+	130, 310, 292, 215, 190, 191, 129, 0, 260, 165,
+	175, 160, 232, 0, 174, 252, 307, 308, 159, 331,
+	138, 322, 132, 139, 321, 226, 0, 225, 324, 303,
+	311, 216, 208, 0, 131, 309, 214, 207, 195, 170,
+	182, 248, 203, 249, 183, 221, 220, 222, 205, 209,
+	0, 576, 0, 290, 318, 332, 144, 595, 278, 300,
+	0, 0, 145, 173, 169, 247, 224, 140, 185, 287,
+	194, 201, 259, 330, 236, 266, 149, 317, 286, 593,
+	594, 591, 0, 592, 638, 639, 693, 694, 695, 664,
+	587, 0, 677, 678, 0, 668, 683, 684, 659, 702,
+	616, 617, 577, 580, 581, 582, 588, 630, 631, 642,
+	645, 673, 672, 671, 674, 679, 698, 697, 699, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	641, 122, 133, 198, 703, 257, 172, 319, 573, 164,
+	0, 0, 632, 634, 644, 661, 124, 125, 134, 143,
+	150, 163, 168, 171, 177, 181, 184, 186, 187, 188,
+	192, 206, 210, 211, 212, 213, 227, 228, 230, 231,
+	234, 235, 238, 240, 241, 242, 244, 245, 246, 251,
+	254, 256, 258, 261, 267, 269, 270, 271, 273, 274,
+	275, 280, 281, 282, 283, 291, 295, 305, 306, 316,
+	325, 327, 680, 687, 667, 298, 625, 690, 597, 614,
+	701, 615, 618, 656, 583, 637, 233, 612, 584, 0,
+	601, 574, 608, 575, 598, 627, 166, 596, 669, 640,
+	689, 196, 652, 0, 157, 204, 202, 0, 0, 0,
+	239, 297, 688, 633, 0, 696, 199, 0, 649, 320,
+	288, 218, 0, 0, 629, 676, 635, 665, 624, 658,
+	590, 648, 691, 613, 654, 692, 0, 0, 0, 568,
+	0, 1315, 1316, 0, 0, 0, 0, 0, 147, 0,
+	651, 686, 610, 653, 655, 572, 650, 0, 578, 585,
+	700, 682, 604, 605, 606, 1572, 0, 0, 0, 0,
+	0, 0, 628, 636, 662, 621, 0, 0, 0, 0,
+	0, 0, 0, 0, 602, 0, 646, 0, 0, 0,
+	586, 579, 0, 0, 626, 0, 0, 0, 589, 126,
+	603, 663, 0, 570, 176, 219, 137, 666, 681, 623,
+	189, 326, 685, 620, 619, 253, 0, 293, 179, 197,
+	141, 123, 135, 151, 178, 229, 262, 272, 611, 571,
+	670, 599, 609, 158, 607, 265, 237, 315, 0, 643,
+	243, 264, 200, 304, 255, 313, 314, 180, 704, 323,
+	328, 285, 167, 0, 127, 0, 250, 162, 193, 622,
+	657, 600, 155, 660, 647, 675, 284, 302, 142, 299,
+	217, 223, 152, 154, 153, 136, 279, 301, 146, 156,
+	289, 268, 294, 161, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 128, 296, 312, 148, 276, 277, 329,
+	263, 130, 310, 292, 215, 190, 191, 129, 0, 260,
+	165, 175, 160, 232, 0, 174, 252, 307, 308, 159,
+	331, 138, 322, 132, 139, 321, 226, 0, 225, 324,
+	303, 311, 216, 208, 0, 131, 309, 214, 207, 195,
+	170, 182, 248, 203, 249, 183, 221, 220, 222, 205,
+	209, 0, 576, 0, 290, 318, 332, 144, 595, 278,
+	300, 0, 0, 145, 173, 169, 247, 224, 140, 185,
+	287, 194, 201, 259, 330, 236, 266, 149, 317, 286,
+	593, 594, 591, 0, 592, 638, 639, 693, 694, 695,
+	664, 587, 0, 677, 678, 0, 668, 683, 684, 659,
+	702, 616, 617, 577, 580, 581, 582, 588, 630, 631,
+	642, 645, 673, 672, 671, 674, 679, 698, 697, 699,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 641, 122, 133, 198, 703, 257, 172, 319, 573,
+	164, 0, 0, 632, 634, 644, 661, 124, 125, 134,
+	143, 150, 163, 168, 171, 177, 181, 184, 186, 187,
+	188, 192, 206, 210, 211, 212, 213, 227, 228, 230,
+	231, 234, 235, 238, 240, 241, 242, 244, 245, 246,
+	251, 254, 256, 258, 261, 267, 269, 270, 271, 273,
+	274, 275, 280, 281, 282, 283, 291, 295, 305, 306,
+	316, 325, 327, 680, 687, 667, 298, 625, 690, 597,
+	614, 701, 615, 618, 656, 583, 637, 233, 612, 584,
+	0, 601, 574, 608, 575, 598, 627, 166, 596, 669,
+	640, 689, 196, 652, 0, 157, 204, 202, 0, 0,
+	0, 239, 297, 688, 633, 0, 696, 199, 0, 649,
+	320, 288, 218, 0, 0, 629, 676, 635, 665, 624,
+	658, 590, 648, 691, 613, 654, 692, 0, 0, 0,
+	568, 0, 1315, 1316, 0, 0, 0, 0, 0, 147,
+	0, 651, 686, 610, 653, 655, 572, 650, 0, 578,
+	585, 700, 682, 604, 605, 606, 0, 0, 0, 0,
+	0, 0, 0, 628, 636, 662, 621, 0, 0, 0,
+	0, 0, 0, 0, 0, 602, 0, 646, 0, 0,
+	0, 586, 579, 0, 0, 626, 0, 0, 0, 589,
+	126, 603, 663, 0, 570, 176, 219, 137, 666, 681,
+	623, 189, 326, 685, 620, 619, 253, 0, 293, 179,
+	197, 141, 123, 135, 151, 178, 229, 262, 272, 611,
+	571, 670, 599, 609, 158, 607, 265, 237, 315, 0,
+	643, 243, 264, 200, 304, 255, 313, 314, 180, 704,
+	323, 328, 285, 167, 0, 127, 0, 250, 162, 193,
+	622, 657, 600, 155, 660, 647, 675, 284, 302, 142,
+	299, 217, 223, 152, 154, 153, 136, 279, 301, 146,
+	156, 289, 268, 294, 161, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 128, 296, 312, 148, 276, 277,
+	329, 263, 130, 310, 292, 215, 190, 191, 129, 0,
+	260, 165, 175, 160, 232, 0, 174, 252, 307, 308,
+	159, 331, 138, 322, 132, 139, 321, 226, 0, 225,
+	324, 303, 311, 216, 208, 0, 131, 309, 214, 207,
+	195, 170, 182, 248, 203, 249, 183, 221, 220, 222,
+	205, 209, 0, 576, 0, 290, 318, 332, 144, 595,
+	278, 300, 0, 0, 145, 173, 169, 247, 224, 140,
+	185, 287, 194, 201, 259, 330, 236, 266, 149, 317,
+	286, 593, 594, 591, 0, 592, 638, 639, 693, 694,
+	695, 664, 587, 0, 677, 678, 0, 668, 683, 684,
+	659, 702, 616, 617, 577, 580, 581, 582, 588, 630,
+	631, 642, 645, 673, 672, 671, 674, 679, 698, 697,
+	699, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 641, 122, 133, 198, 703, 257, 172, 319,
+	573, 164, 0, 0, 632, 634, 644, 661, 124, 125,
+	134, 143, 150, 163, 168, 171, 177, 181, 184, 186,
+	187, 188, 192, 206, 210, 211, 212, 213, 227, 228,
+	230, 231, 234, 235, 238, 240, 241, 242, 244, 245,
+	246, 251, 254, 256, 258, 261, 267, 269, 270, 271,
+	273, 274, 275, 280, 281, 282, 283, 291, 295, 305,
+	306, 316, 325, 327, 680, 687, 667, 298, 625, 690,
+	597, 614, 701, 615, 618, 656, 583, 637, 233, 612,
+	584, 0, 601, 574, 608, 575, 598, 627, 166, 596,
+	669, 640, 689, 196, 652, 0, 157, 204, 202, 0,
+	0, 0, 239, 297, 688, 633, 0, 696, 199, 0,
+	649, 320, 288, 218, 0, 0, 629, 676, 635, 665,
+	624, 658, 590, 648, 691, 613, 654, 692, 0, 0,
+	0, 568, 0, 0, 0, 0, 0, 0, 0, 0,
+	147, 0, 651, 686, 610, 653, 655, 572, 650, 0,
+	578, 585, 700, 682, 604, 605, 606, 0, 0, 0,
+	0, 0, 0, 0, 628, 636, 662, 621, 0, 0,
+	0, 0, 0, 0, 1989, 0, 602, 0, 646, 0,
+	0, 0, 586, 579, 0, 0, 626, 0, 0, 0,
+	589, 126, 603, 663, 0, 570, 176, 219, 137, 666,
+	681, 623, 189, 326, 685, 620, 619, 253, 0, 293,
+	179, 197, 141, 123, 135, 151, 178, 229, 262, 272,
+	611, 571, 670, 599, 609, 158, 607, 265, 237, 315,
+	0, 643, 243, 264, 200, 304, 255, 313, 314, 180,
+	704, 323, 328, 285, 167, 0, 127, 0, 250, 162,
+	193, 622, 657, 600, 155, 660, 647, 675, 284, 302,
+	142, 299, 217, 223, 152, 154, 153, 136, 279, 301,
+	146, 156, 289, 268, 294, 161, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 128, 296, 312, 148, 276,
+	277, 329, 263, 130, 310, 292, 215, 190, 191, 129,
+	0, 260, 165, 175, 160, 232, 0, 174, 252, 307,
+	308, 159, 331, 138, 322, 132, 139, 321, 226, 0,
+	225, 324, 303, 311, 216, 208, 0, 131, 309, 214,
+	207, 195, 170, 182, 248, 203, 249, 183, 221, 220,
+	222, 205, 209, 0, 576, 0, 290, 318, 332, 144,
+	595, 278, 300, 0, 0, 145, 173, 169, 247, 224,
+	140, 185, 287, 194, 201, 259, 330, 236, 266, 149,
+	317, 286, 593, 594, 591, 0, 592, 638, 639, 693,
+	694, 695, 664, 587, 0, 677, 678, 0, 668, 683,
+	684, 659, 702, 616, 617, 577, 580, 581, 582, 588,
+	630, 631, 642, 645, 673, 672, 671, 674, 679, 698,
+	697, 699, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 641, 122, 133, 198, 703, 257, 172,
+	319, 573, 164, 0, 0, 632, 634, 644, 661, 124,
+	125, 134, 143, 150, 163, 168, 171, 177, 181, 184,
+	186, 187, 188, 192, 206, 210, 211, 212, 213, 227,
+	228, 230, 231, 234, 235, 238, 240, 241, 242, 244,
+	245, 246, 251, 254, 256, 258, 261, 267, 269, 270,
+	271, 273, 274, 275, 280, 281, 282, 283, 291, 295,
+	305, 306, 316, 325, 327, 680, 687, 667, 298, 625,
+	690, 597, 614, 701, 615, 618, 656, 583, 637, 233,
+	612, 584, 0, 601, 574, 608, 575, 598, 627, 166,
+	596, 669, 640, 689, 196, 652, 0, 157, 204, 202,
+	0, 0, 0, 239, 297, 688, 633, 0, 696, 199,
+	0, 649, 320, 288, 218, 0, 0, 629, 676, 635,
+	665, 624, 658, 590, 648, 691, 613, 654, 692, 0,
+	0, 0, 438, 0, 0, 0, 0, 0, 0, 0,
+	0, 147, 0, 651, 686, 610, 653, 655, 572, 650,
+	0, 578, 585, 700, 682, 604, 605, 606, 0, 0,
+	0, 0, 0, 0, 0, 628, 636, 662, 621, 0,
+	0, 0, 0, 0, 0, 1704, 0, 602, 0, 646,
+	0, 0, 0, 586, 579, 0, 0, 626, 0, 0,
+	0, 589, 126, 603, 663, 0, 570, 176, 219, 137,
+	666, 681, 623, 189, 326, 685, 620, 619, 253, 0,
+	293, 179, 197, 141, 123, 135, 151, 178, 229, 262,
+	272, 611, 571, 670, 599, 609, 158, 607, 265, 237,
+	315, 0, 643, 243, 264, 200, 304, 255, 313, 314,
+	180, 704, 323, 328, 285, 167, 0, 127, 0, 250,
+	162, 193, 622, 657, 600, 155, 660, 647, 675, 284,
+	302, 142, 299, 217, 223, 152, 154, 153, 136, 279,
+	301, 146, 156, 289, 268, 294, 161, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 128, 296, 312, 148,
+	276, 277, 329, 263, 130, 310, 292, 215, 190, 191,
+	129, 0, 260, 165, 175, 160, 232, 0, 174, 252,
+	307, 308, 159, 331, 138, 322, 132, 139, 321, 226,
+	0, 225, 324, 303, 311, 216, 208, 0, 131, 309,
+	214, 207, 195, 170, 182, 248, 203, 249, 183, 221,
+	220, 222, 205, 209, 0, 576, 0, 290, 318, 332,
+	144, 595, 278, 300, 0, 0, 145, 173, 169, 247,
+	224, 140, 185, 287, 194, 201, 259, 330, 236, 266,
+	149, 317, 286, 593, 594, 591, 0, 592, 638, 639,
+	693, 694, 695, 664, 587, 0, 677, 678, 0, 668,
+	683, 684, 659, 702, 616, 617, 577, 580, 581, 582,
+	588, 630, 631, 642, 645, 673, 672, 671, 674, 679,
+	698, 697, 699, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 641, 122, 133, 198, 703, 257,
+	172, 319, 573, 164, 0, 0, 632, 634, 644, 661,
+	124, 125, 134, 143, 150, 163, 168, 171, 177, 181,
+	184, 186, 187, 188, 192, 206, 210, 211, 212, 213,
+	227, 228, 230, 231, 234, 235, 238, 240, 241, 242,
+	244, 245, 246, 251, 254, 256, 258, 261, 267, 269,
+	270, 271, 273, 274, 275, 280, 281, 282, 283, 291,
+	295, 305, 306, 316, 325, 327, 680, 687, 667, 298,
+	625, 690, 597, 614, 701, 615, 618, 656, 583, 637,
+	233, 612, 584, 0, 601, 574, 608, 575, 598, 627,
+	166, 596, 669, 640, 689, 196, 652, 0, 157, 204,
+	202, 0, 0, 0, 239, 297, 688, 633, 0, 696,
+	199, 0, 649, 320, 288, 218, 0, 0, 629, 676,
+	635, 665, 624, 658, 590, 648, 691, 613, 654, 692,
+	0, 0, 0, 568, 0, 0, 0, 0, 0, 0,
+	0, 0, 147, 0, 651, 686, 610, 653, 655, 572,
+	650, 0, 578, 585, 700, 682, 604, 605, 606, 0,
+	0, 0, 0, 0, 0, 0, 628, 636, 662, 621,
+	0, 0, 0, 0, 0, 0, 1694, 0, 602, 0,
+	646, 0, 0, 0, 586, 579, 0, 0, 626, 0,
+	0, 0, 589, 126, 603, 663, 0, 570, 176, 219,
+	137, 666, 681, 623, 189, 326, 685, 620, 619, 253,
+	0, 293, 179, 197, 141, 123, 135, 151, 178, 229,
+	262, 272, 611, 571, 670, 599, 609, 158, 607, 265,
+	237, 315, 0, 643, 243, 264, 200, 304, 255, 313,
+	314, 180, 704, 323, 328, 285, 167, 0, 127, 0,
+	250, 162, 193, 622, 657, 600, 155, 660, 647, 675,
+	284, 302, 142, 299, 217, 223, 152, 154, 153, 136,
+	279, 301, 146, 156, 289, 268, 294, 161, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 128, 296, 312,
+	148, 276, 277, 329, 263, 130, 310, 292, 215, 190,
+	191, 129, 0, 260, 165, 175, 160, 232, 0, 174,
+	252, 307, 308, 159, 331, 138, 322, 132, 139, 321,
+	226, 0, 225, 324, 303, 311, 216, 208, 0, 131,
+	309, 214, 207, 195, 170, 182, 248, 203, 249, 183,
+	221, 220, 222, 205, 209, 0, 576, 0, 290, 318,
+	332, 144, 595, 278, 300, 0, 0, 145, 173, 169,
+	247, 224, 140, 185, 287, 194, 201, 259, 330, 236,
+	266, 149, 317, 286, 593, 594, 591, 0, 592, 638,
+	639, 693, 694, 695, 664, 587, 0, 677, 678, 0,
+	668, 683, 684, 659, 702, 616, 617, 577, 580, 581,
+	582, 588, 630, 631, 642, 645, 673, 672, 671, 674,
+	679, 698, 697, 699, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 641, 122, 133, 198, 703,
+	257, 172, 319, 573, 164, 0, 0, 632, 634, 644,
+	661, 124, 125, 134, 143, 150, 163, 168, 171, 177,
+	181, 184, 186, 187, 188, 192, 206, 210, 211, 212,
+	213, 227, 228, 230, 231, 234, 235, 238, 240, 241,
+	242, 244, 245, 246, 251, 254, 256, 258, 261, 267,
+	269, 270, 271, 273, 274, 275, 280, 281, 282, 283,
+	291, 295, 305, 306, 316, 325, 327, 680, 687, 667,
+	298, 625, 690, 597, 614, 701, 615, 618, 656, 583,
+	637, 233, 612, 584, 0, 601, 574, 608, 575, 598,
+	627, 166, 596, 669, 640, 689, 196, 652, 0, 157,
+	204, 202, 0, 0, 0, 239, 297, 688, 633, 0,
+	696, 199, 0, 649, 320, 288, 218, 0, 0, 629,
+	676, 635, 665, 624, 658, 590, 648, 691, 613, 654,
+	692, 79, 0, 0, 568, 0, 0, 0, 0, 0,
+	0, 0, 0, 147, 0, 651, 686, 610, 653, 655,
+	572, 650, 0, 578, 585, 700, 682, 604, 605, 606,
+	0, 0, 0, 0, 0, 0, 0, 628, 636, 662,
+	621, 0, 0, 0, 0, 0, 0, 0, 0, 602,
+	0, 646, 0, 0, 0, 586, 579, 0, 0, 626,
+	0, 0, 0, 589, 126, 603, 663, 0, 570, 176,
+	219, 137, 666, 681, 623, 189, 326, 685, 620, 619,
+	253, 0, 293, 179, 197, 141, 123, 135, 151, 178,
+	229, 262, 272, 611, 571, 670, 599, 609, 158, 607,
+	265, 237, 315, 0, 643, 243, 264, 200, 304, 255,
+	313, 314, 180, 704, 323, 328, 285, 167, 0, 127,
+	0, 250, 162, 193, 622, 657, 600, 155, 660, 647,
+	675, 284, 302, 142, 299, 217, 223, 152, 154, 153,
+	136, 279, 301, 146, 156, 289, 268, 294, 161, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 128, 296,
+	312, 148, 276, 277, 329, 263, 130, 310, 292, 215,
+	190, 191, 129, 0, 260, 165, 175, 160, 232, 0,
+	174, 252, 307, 308, 159, 331, 138, 322, 132, 139,
+	321, 226, 0, 225, 324, 303, 311, 216, 208, 0,
+	131, 309, 214, 207, 195, 170, 182, 248, 203, 249,
+	183, 221, 220, 222, 205, 209, 0, 576, 0, 290,
+	318, 332, 144, 595, 278, 300, 0, 0, 145, 173,
+	169, 247, 224, 140, 185, 287, 194, 201, 259, 330,
+	236, 266, 149, 317, 286, 593, 594, 591, 0, 592,
+	638, 639, 693, 694, 695, 664, 587, 0, 677, 678,
+	0, 668, 683, 684, 659, 702, 616, 617, 577, 580,
+	581, 582, 588, 630, 631, 642, 645, 673, 672, 671,
+	674, 679, 698, 697, 699, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 641, 122, 133, 198,
+	703, 257, 172, 319, 573, 164, 0, 0, 632, 634,
+	644, 661, 124, 125, 134, 143, 150, 163, 168, 171,
+	177, 181, 184, 186, 187, 188, 192, 206, 210, 211,
+	212, 213, 227, 228, 230, 231, 234, 235, 238, 240,
+	241, 242, 244, 245, 246, 251, 254, 256, 258, 261,
+	267, 269, 270, 271, 273, 274, 275, 280, 281, 282,
+	283, 291, 295, 305, 306, 316, 325, 327, 680, 687,
+	667, 298, 625, 690, 597, 614, 701, 615, 618, 656,
+	583, 637, 233, 612, 584, 0, 601, 574, 608, 575,
+	598, 627, 166, 596, 669, 640, 689, 196, 652, 0,
+	157, 204, 202, 0, 0, 0, 239, 297, 688, 633,
+	0, 696, 199, 0, 649, 320, 288, 218, 0, 0,
+	629, 676, 635, 665, 624, 658, 590, 648, 691, 613,
+	654, 692, 0, 0, 0, 120, 0, 0, 0, 0,
+	0, 0, 0, 0, 147, 0, 651, 686, 610, 653,
+	655, 572, 650, 0, 578, 585, 700, 682, 604, 605,
+	606, 0, 0, 0, 0, 0, 0, 0, 628, 636,
+	662, 621, 0, 0, 0, 0, 0, 0, 1294, 0,
+	602, 0, 646, 0, 0, 0, 586, 579, 0, 0,
+	626, 0, 0, 0, 589, 126, 603, 663, 0, 570,
+	176, 219, 137, 666, 681, 623, 189, 326, 685, 620,
+	619, 253, 0, 293, 179, 197, 141, 123, 135, 151,
+	178, 229, 262, 272, 611, 571, 670, 599, 609, 158,
+	607, 265, 237, 315, 0, 643, 243, 264, 200, 304,
+	255, 313, 314, 180, 704, 323, 328, 285, 167, 0,
+	127, 0, 250, 162, 193, 622, 657, 600, 155, 660,
+	647, 675, 284, 302, 142, 299, 217, 223, 152, 154,
+	153, 136, 279, 301, 146, 156, 289, 268, 294, 161,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 128,
+	296, 312, 148, 276, 277, 329, 263, 130, 310, 292,
+	215, 190, 191, 129, 0, 260, 165, 175, 160, 232,
+	0, 174, 252, 307, 308, 159, 331, 138, 322, 132,
+	139, 321, 226, 0, 225, 324, 303, 311, 216, 208,
+	0, 131, 309, 214, 207, 195, 170, 182, 248, 203,
+	249, 183, 221, 220, 222, 205, 209, 0, 576, 0,
+	290, 318, 332, 144, 595, 278, 300, 0, 0, 145,
+	173, 169, 247, 224, 140, 185, 287, 194, 201, 259,
+	330, 236, 266, 149, 317, 286, 593, 594, 591, 0,
+	592, 638, 639, 693, 694, 695, 664, 587, 0, 677,
+	678, 0, 668, 683, 684, 659, 702, 616, 617, 577,
+	580, 581, 582, 588, 630, 631, 642, 645, 673, 672,
+	671, 674, 679, 698, 697, 699, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 641, 122, 133,
+	198, 703, 257, 172, 319, 573, 164, 0, 0, 632,
+	634, 644, 661, 124, 125, 134, 143, 150, 163, 168,
+	171, 177, 181, 184, 186, 187, 188, 192, 206, 210,
+	211, 212, 213, 227, 228, 230, 231, 234, 235, 238,
+	240, 241, 242, 244, 245, 246, 251, 254, 256, 258,
+	261, 267, 269, 270, 271, 273, 274, 275, 280, 281,
+	282, 283, 291, 295, 305, 306, 316, 325, 327, 680,
+	687, 667, 298, 625, 690, 597, 614, 701, 615, 618,
+	656, 583, 637, 233, 612, 584, 0, 601, 574, 608,
+	575, 598, 627, 166, 596, 669, 640, 689, 196, 652,
+	0, 157, 204, 202, 0, 0, 0, 239, 297, 688,
+	633, 0, 696, 199, 0, 649, 320, 288, 218, 0,
+	0, 629, 676, 635, 665, 624, 658, 590, 648, 691,
+	613, 654, 692, 0, 0, 0, 438, 0, 0, 0,
+	0, 0, 0, 0, 0, 147, 0, 651, 686, 610,
+	653, 655, 572, 650, 0, 578, 585, 700, 682, 604,
+	605, 606, 0, 0, 0, 0, 0, 0, 0, 628,
+	636, 662, 621, 0, 0, 0, 0, 0, 0, 1159,
+	0, 602, 0, 646, 0, 0, 0, 586, 579, 0,
+	0, 626, 0, 0, 0, 589, 126, 603, 663, 0,
+	570, 176, 219, 137, 666, 681, 623, 189, 326, 685,
+	620, 619, 253, 0, 293, 179, 197, 141, 123, 135,
+	151, 178, 229, 262, 272, 611, 571, 670, 599, 609,
+	158, 607, 265, 237, 315, 0, 643, 243, 264, 200,
+	304, 255, 313, 314, 180, 704, 323, 328, 285, 167,
+	0, 127, 0, 250, 162, 193, 622, 657, 600, 155,
+	660, 647, 675, 284, 302, 142, 299, 217, 223, 152,
+	154, 153, 136, 279, 301, 146, 156, 289, 268, 294,
+	161, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	128, 296, 312, 148, 276, 277, 329, 263, 130, 310,
+	292, 215, 190, 191, 129, 0, 260, 165, 175, 160,
+	232, 0, 174, 252, 307, 308, 159, 331, 138, 322,
+	132, 139, 321, 226, 0, 225, 324, 303, 311, 216,
+	208, 0, 131, 309, 214, 207, 195, 170, 182, 248,
+	203, 249, 183, 221, 220, 222, 205, 209, 0, 576,
+	0, 290, 318, 332, 144, 595, 278, 300, 0, 0,
+	145, 173, 169, 247, 224, 140, 185, 287, 194, 201,
+	259, 330, 236, 266, 149, 317, 286, 593, 594, 591,
+	0, 592, 638, 639, 693, 694, 695, 664, 587, 0,
+	677, 678, 0, 668, 683, 684, 659, 702, 616, 617,
+	577, 580, 581, 582, 588, 630, 631, 642, 645, 673,
+	672, 671, 674, 679, 698, 697, 699, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 641, 122,
+	133, 198, 703, 257, 172, 319, 573, 164, 0, 0,
+	632, 634, 644, 661, 124, 125, 134, 143, 150, 163,
+	168, 171, 177, 181, 184, 186, 187, 188, 192, 206,
+	210, 211, 212, 213, 227, 228, 230, 231, 234, 235,
+	238, 240, 241, 242, 244, 245, 246, 251, 254, 256,
+	258, 261, 267, 269, 270, 271, 273, 274, 275, 280,
+	281, 282, 283, 291, 295, 305, 306, 316, 325, 327,
+	680, 687, 667, 298, 625, 690, 597, 614, 701, 615,
+	618, 656, 583, 637, 233, 612, 584, 0, 601, 574,
+	608, 575, 598, 627, 166, 596, 669, 640, 689, 196,
+	652, 0, 157, 204, 202, 0, 0, 0, 239, 297,
+	688, 633, 0, 696, 199, 0, 649, 320, 288, 218,
+	0, 0, 629, 676, 635, 665, 624, 658, 590, 648,
+	691, 613, 654, 692, 0, 0, 0, 568, 0, 0,
+	0, 0, 0, 0, 0, 0, 147, 0, 651, 686,
+	610, 653, 655, 572, 650, 0, 578, 585, 700, 682,
+	604, 605, 606, 0, 0, 0, 0, 0, 0, 0,
+	628, 636, 662, 621, 0, 0, 0, 0, 0, 0,
+	0, 0, 602, 0, 646, 0, 0, 0, 586, 579,
+	0, 0, 626, 0, 0, 0, 589, 126, 603, 663,
+	0, 570, 176, 219, 137, 666, 681, 623, 189, 326,
+	685, 620, 619, 253, 0, 293, 179, 197, 141, 123,
+	135, 151, 178, 229, 262, 272, 611, 571, 670, 599,
+	609, 158, 607, 265, 237, 315, 0, 643, 243, 264,
+	200, 304, 255, 313, 314, 180, 704, 323, 328, 285,
+	167, 0, 127, 0, 250, 162, 193, 622, 657, 600,
+	155, 660, 647, 675, 284, 302, 142, 299, 217, 223,
+	152, 154, 153, 136, 279, 301, 146, 156, 289, 268,
+	294, 161, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 128, 296, 312, 148, 276, 277, 329, 263, 130,
+	310, 292, 215, 190, 191, 129, 0, 260, 165, 175,
+	160, 232, 0, 174, 252, 307, 308, 159, 331, 138,
+	322, 132, 139, 321, 226, 0, 225, 324, 303, 311,
+	216, 208, 0, 131, 309, 214, 207, 195, 170, 182,
+	248, 203, 249, 183, 221, 220, 222, 205, 209, 0,
+	576, 0, 290, 318, 332, 144, 595, 278, 300, 0,
+	0, 145, 173, 169, 247, 224, 140, 185, 287, 194,
+	201, 259, 330, 236, 266, 149, 317, 286, 593, 594,
+	591, 0, 592, 638, 639, 693, 694, 695, 664, 587,
+	0, 677, 678, 0, 668, 683, 684, 659, 702, 616,
+	617, 577, 580, 581, 582, 588, 630, 631, 642, 645,
+	673, 672, 671, 674, 679, 698, 697, 699, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 641,
+	122, 133, 198, 703, 257, 172, 319, 573, 164, 0,
+	0, 632, 634, 644, 661, 124, 125, 134, 143, 150,
+	163, 168, 171, 177, 181, 184, 186, 187, 188, 192,
+	206, 210, 211, 212, 213, 227, 228, 230, 231, 234,
+	235, 238, 240, 241, 242, 244, 245, 246, 251, 254,
+	256, 258, 261, 267, 269, 270, 271, 273, 274, 275,
+	280, 281, 282, 283, 291, 295, 305, 306, 316, 325,
+	327, 680, 687, 667, 298, 625, 690, 597, 614, 701,
+	615, 618, 656, 583, 637, 233, 612, 584, 0, 601,
+	574, 608, 575, 598, 627, 166, 596, 669, 640, 689,
+	196, 652, 0, 157, 204, 202, 0, 0, 0, 239,
+	297, 688, 633, 0, 696, 199, 0, 649, 320, 288,
+	218, 0, 0, 629, 676, 635, 665, 624, 658, 590,
+	648, 691, 613, 654, 692, 0, 0, 0, 438, 0,
+	0, 0, 0, 0, 0, 0, 0, 147, 0, 651,
+	686, 610, 653, 655, 572, 650, 0, 578, 585, 700,
+	682, 604, 605, 606, 0, 0, 0, 0, 0, 0,
+	0, 628, 636, 662, 621, 0, 0, 0, 0, 0,
+	0, 0, 0, 602, 0, 646, 0, 0, 0, 586,
+	579, 0, 0, 626, 0, 0, 0, 589, 126, 603,
+	663, 0, 570, 176, 219, 137, 666, 681, 623, 189,
+	326, 685, 620, 619, 253, 0, 293, 179, 197, 141,
+	123, 135, 151, 178, 229, 262, 272, 611, 571, 670,
+	599, 609, 158, 607, 265, 237, 315, 0, 643, 243,
+	264, 200, 304, 255, 313, 314, 180, 704, 323, 328,
+	285, 167, 0, 127, 0, 250, 162, 193, 622, 657,
+	600, 155, 660, 647, 675, 284, 302, 142, 299, 217,
+	223, 152, 154, 153, 136, 279, 301, 146, 156, 289,
+	268, 294, 161, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 128, 296, 312, 148, 276, 277, 329, 263,
+	130, 310, 292, 215, 190, 191, 129, 0, 260, 165,
+	175, 160, 232, 0, 174, 252, 307, 308, 159, 331,
+	138, 322, 132, 139, 321, 226, 0, 225, 324, 303,
+	311, 216, 208, 0, 131, 309, 214, 207, 195, 170,
+	182, 248, 203, 249, 183, 221, 220, 222, 205, 209,
+	0, 576, 0, 290, 318, 332, 144, 595, 278, 300,
+	0, 0, 145, 173, 169, 247, 224, 140, 185, 287,
+	194, 201, 259, 330, 236, 266, 149, 317, 286, 593,
+	594, 591, 0, 592, 638, 639, 693, 694, 695, 664,
+	587, 0, 677, 678, 0, 668, 683, 684, 659, 702,
+	616, 617, 577, 580, 581, 582, 588, 630, 631, 642,
+	645, 673, 672, 671, 674, 679, 698, 697, 699, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	641, 122, 133, 198, 703, 257, 172, 319, 573, 164,
+	0, 0, 632, 634, 644, 661, 124, 125, 134, 143,
+	150, 163, 168, 171, 177, 181, 184, 186, 187, 188,
+	192, 206, 210, 211, 212, 213, 227, 228, 230, 231,
+	234, 235, 238, 240, 241, 242, 244, 245, 246, 251,
+	254, 256, 258, 261, 267, 269, 270, 271, 273, 274,
+	275, 280, 281, 282, 283, 291, 295, 305, 306, 316,
+	325, 327, 680, 687, 667, 298, 625, 690, 597, 614,
+	701, 615, 618, 656, 583, 637, 233, 612, 584, 0,
+	601, 574, 608, 575, 598, 627, 166, 596, 669, 640,
+	689, 196, 652, 0, 157, 204, 202, 0, 0, 0,
+	239, 297, 1326, 1330, 0, 696, 199, 0, 649, 320,
+	288, 218, 0, 0, 629, 676, 635, 665, 624, 658,
+	590, 648, 691, 613, 654, 692, 0, 0, 0, 568,
+	0, 0, 0, 0, 0, 0, 0, 0, 147, 0,
+	651, 686, 610, 653, 655, 572, 650, 0, 578, 585,
+	700, 682, 604, 605, 606, 0, 0, 0, 0, 0,
+	0, 0, 628, 636, 662, 621, 0, 0, 0, 0,
+	0, 0, 0, 0, 602, 0, 646, 0, 0, 0,
+	586, 579, 0, 0, 626, 0, 0, 0, 589, 126,
+	603, 663, 0, 570, 176, 219, 137, 666, 681, 1329,
+	189, 326, 685, 620, 619, 1324, 0, 1325, 179, 197,
+	567, 123, 135, 1322, 1328, 229, 262, 272, 611, 571,
+	670, 599, 609, 158, 607, 265, 237, 315, 0, 643,
+	243, 264, 200, 304, 255, 313, 314, 180, 704, 323,
+	328, 285, 167, 0, 127, 0, 250, 162, 193, 622,
+	657, 600, 155, 660, 647, 675, 284, 302, 142, 299,
+	217, 223, 152, 154, 153, 136, 279, 301, 146, 156,
+	289, 268, 294, 161, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 128, 296, 312, 148, 276, 277, 329,
+	263, 130, 310, 292, 215, 190, 191, 129, 0, 260,
+	165, 175, 160, 232, 0, 174, 252, 307, 308, 159,
+	331, 138, 322, 132, 139, 321, 226, 0, 225, 324,
+	303, 311, 216, 208, 0, 131, 309, 214, 207, 195,
+	170, 182, 248, 203, 249, 183, 221, 220, 222, 205,
+	209, 0, 576, 0, 290, 318, 332, 144, 595, 278,
+	300, 0, 0, 145, 173, 169, 247, 224, 140, 185,
+	287, 194, 201, 259, 330, 236, 266, 149, 317, 286,
+	593, 594, 591, 0, 592, 638, 639, 693, 694, 695,
+	664, 587, 0, 677, 678, 0, 668, 683, 684, 659,
+	702, 616, 617, 577, 580, 581, 582, 588, 630, 631,
+	642, 645, 673, 672, 671, 674, 679, 698, 697, 699,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 641, 122, 133, 198, 703, 257, 172, 319, 573,
+	164, 0, 0, 632, 634, 644, 661, 124, 125, 134,
+	143, 150, 163, 168, 171, 177, 181, 184, 186, 187,
+	188, 192, 206, 210, 211, 212, 213, 227, 228, 230,
+	231, 234, 235, 238, 240, 241, 242, 244, 245, 246,
+	251, 254, 256, 258, 261, 267, 269, 270, 271, 273,
+	274, 275, 280, 281, 282, 283, 291, 295, 305, 306,
+	316, 325, 327, 680, 687, 667, 298, 625, 690, 597,
+	614, 701, 615, 618, 656, 583, 637, 233, 612, 584,
+	0, 601, 574, 608, 575, 598, 627, 166, 596, 669,
+	640, 689, 196, 652, 0, 157, 204, 202, 0, 0,
+	0, 239, 297, 688, 633, 0, 696, 199, 0, 649,
+	320, 288, 218, 0, 0, 629, 676, 635, 665, 624,
+	658, 590, 648, 691, 613, 654, 692, 0, 0, 0,
+	120, 0, 0, 0, 0, 0, 0, 0, 0, 147,
+	0, 651, 686, 610, 653, 655, 572, 650, 0, 578,
+	585, 700, 682, 604, 605, 606, 0, 0, 0, 0,
+	0, 0, 0, 628, 636, 662, 621, 0, 0, 0,
+	0, 0, 0, 0, 0, 602, 0, 646, 0, 0,
+	0, 586, 579, 0, 0, 626, 0, 0, 0, 589,
+	126, 603, 663, 0, 570, 176, 219, 137, 666, 681,
+	623, 189, 326, 685, 620, 619, 253, 0, 293, 179,
+	197, 141, 123, 135, 151, 178, 229, 262, 272, 611,
+	571, 670, 599, 609, 158, 607, 265, 237, 315, 0,
+	643, 243, 264, 200, 304, 255, 313, 314, 180, 704,
+	323, 328, 285, 167, 0, 127, 0, 250, 162, 193,
+	622, 657, 600, 155, 660, 647, 675, 284, 302, 142,
+	299, 217, 223, 152, 154, 153, 136, 279, 301, 146,
+	156, 289, 268, 294, 161, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 128, 296, 312, 148, 276, 277,
+	329, 263, 130, 310, 292, 215, 190, 191, 129, 0,
+	260, 165, 175, 160, 232, 0, 174, 252, 307, 308,
+	159, 331, 138, 322, 132, 139, 321, 226, 0, 225,
+	324, 303, 311, 216, 208, 0, 131, 309, 214, 207,
+	195, 170, 182, 248, 203, 249, 183, 221, 220, 222,
+	205, 209, 0, 576, 0, 290, 318, 332, 144, 595,
+	278, 300, 0, 0, 145, 173, 169, 247, 224, 140,
+	185, 287, 194, 201, 259, 330, 236, 266, 149, 317,
+	286, 593, 594, 591, 0, 592, 638, 639, 693, 694,
+	695, 664, 587, 0, 677, 678, 0, 668, 683, 684,
+	659, 702, 616, 617, 577, 580, 581, 582, 588, 630,
+	631, 642, 645, 673, 672, 671, 674, 679, 698, 697,
+	699, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 641, 122, 133, 198, 703, 257, 172, 319,
+	573, 164, 0, 0, 632, 634, 644, 661, 124, 125,
+	134, 143, 150, 163, 168, 171, 177, 181, 184, 186,
+	187, 188, 192, 206, 210, 211, 212, 213, 227, 228,
+	230, 231, 234, 235, 238, 240, 241, 242, 244, 245,
+	246, 251, 254, 256, 258, 261, 267, 269, 270, 271,
+	273, 274, 275, 280, 281, 282, 283, 291, 295, 305,
+	306, 316, 325, 327, 680, 687, 667, 298, 625, 690,
+	597, 614, 701, 615, 618, 656, 583, 637, 233, 612,
+	584, 0, 601, 574, 608, 575, 598, 627, 166, 596,
+	669, 640, 689, 196, 652, 0, 157, 204, 202, 0,
+	0, 0, 239, 297, 688, 633, 0, 696, 199, 0,
+	649, 320, 288, 218, 0, 0, 629, 676, 635, 665,
+	624, 658, 590, 648, 691, 613, 654, 692, 0, 0,
+	0, 568, 0, 0, 0, 0, 0, 0, 0, 0,
+	147, 0, 651, 686, 610, 653, 655, 572, 650, 0,
+	578, 585, 700, 682, 604, 605, 606, 0, 0, 0,
+	0, 0, 0, 0, 628, 636, 662, 621, 0, 0,
+	0, 0, 0, 0, 0, 0, 602, 0, 646, 0,
+	0, 0, 586, 579, 0, 0, 626, 0, 0, 0,
+	589, 126, 603, 663, 0, 570, 176, 219, 137, 666,
+	681, 623, 189, 326, 685, 620, 619, 253, 0, 293,
+	179, 197, 567, 123, 135, 563, 178, 229, 262, 272,
+	611, 571, 670, 599, 609, 158, 607, 265, 237, 315,
+	0, 643, 243, 264, 200, 304, 255, 313, 314, 180,
+	704, 323, 328, 285, 167, 0, 127, 0, 250, 162,
+	193, 622, 657, 600, 155, 660, 647, 675, 284, 302,
+	142, 299, 217, 223, 152, 154, 153, 136, 279, 301,
+	146, 156, 289, 268, 294, 161, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 128, 296, 312, 148, 276,
+	277, 329, 263, 130, 310, 292, 215, 190, 191, 129,
+	0, 260, 165, 175, 160, 232, 0, 174, 252, 307,
+	308, 159, 331, 138, 322, 132, 139, 321, 226, 0,
+	225, 324, 303, 311, 216, 208, 0, 131, 309, 214,
+	207, 195, 170, 182, 248, 203, 249, 183, 221, 220,
+	222, 205, 209, 0, 576, 0, 290, 318, 332, 144,
+	595, 278, 300, 0, 0, 145, 173, 169, 247, 224,
+	140, 185, 287, 194, 201, 259, 330, 236, 266, 149,
+	317, 286, 593, 594, 591, 0, 592, 638, 639, 693,
+	694, 695, 664, 587, 0, 677, 678, 0, 668, 683,
+	684, 659, 702, 616, 617, 577, 580, 581, 582, 588,
+	630, 631, 642, 645, 673, 672, 671, 674, 679, 698,
+	697, 699, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 641, 122, 133, 198, 703, 257, 172,
+	319, 573, 164, 0, 0, 632, 634, 644, 661, 124,
+	125, 134, 143, 150, 163, 168, 171, 177, 181, 184,
+	186, 187, 188, 192, 206, 210, 211, 212, 213, 227,
+	228, 230, 231, 234, 235, 238, 240, 241, 242, 244,
+	245, 246, 251, 254, 256, 258, 261, 267, 269, 270,
+	271, 273, 274, 275, 280, 281, 282, 283, 291, 295,
+	305, 306, 316, 325, 327, 680, 298, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 233, 0, 0,
+	0, 0, 0, 440, 0, 0, 0, 166, 437, 0,
+	0, 0, 196, 0, 0, 157, 204, 202, 0, 0,
+	0, 239, 297, 0, 0, 0, 513, 199, 0, 0,
+	320, 288, 218, 0, 0, 0, 0, 502, 503, 0,
+	0, 0, 0, 0, 0, 1304, 0, 79, 0, 0,
+	438, 462, 461, 464, 465, 466, 467, 0, 0, 147,
+	463, 468, 497, 498, 1305, 0, 0, 435, 453, 0,
+	512, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 450, 451, 0, 0, 0, 0, 527, 0, 452,
+	0, 0, 447, 448, 449, 454, 0, 0, 0, 0,
+	126, 0, 0, 0, 0, 176, 219, 137, 504, 0,
+	0, 189, 326, 0, 0, 525, 253, 0, 293, 179,
+	197, 141, 123, 135, 151, 178, 229, 262, 272, 510,
+	0, 0, 0, 0, 158, 0, 265, 237, 315, 0,
+	0, 243, 264, 200, 304, 255, 313, 314, 180, 413,
+	323, 328, 285, 167, 0, 127, 0, 250, 162, 193,
+	0, 0, 0, 155, 0, 0, 0, 284, 302, 142,
+	299, 217, 223, 152, 154, 153, 136, 279, 301, 146,
+	156, 289, 268, 294, 161, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 128, 296, 312, 148, 276, 277,
+	329, 263, 130, 310, 292, 215, 190, 191, 129, 0,
+	260, 165, 175, 160, 232, 0, 174, 252, 307, 308,
+	159, 331, 138, 322, 132, 139, 321, 226, 0, 225,
+	324, 303, 311, 216, 208, 0, 131, 309, 214, 207,
+	195, 170, 182, 248, 203, 249, 183, 221, 220, 222,
+	205, 209, 0, 0, 0, 290, 318, 332, 144, 0,
+	278, 300, 0, 0, 145, 173, 169, 247, 224, 140,
+	185, 287, 194, 201, 259, 330, 236, 266, 149, 317,
+	286, 514, 526, 520, 522, 521, 518, 519, 517, 516,
+	515, 528, 505, 506, 507, 508, 511, 0, 523, 524,
+	0, 0, 0, 0, 469, 470, 471, 472, 473, 477,
+	478, 482, 483, 491, 490, 489, 492, 493, 495, 494,
+	496, 474, 475, 476, 479, 480, 481, 484, 485, 488,
+	486, 487, 509, 122, 133, 198, 0, 257, 172, 319,
+	0, 164, 0, 0, 0, 0, 0, 0, 124, 125,
+	134, 143, 150, 163, 168, 171, 177, 181, 184, 186,
+	187, 188, 192, 206, 210, 211, 212, 213, 227, 228,
+	230, 231, 234, 235, 238, 240, 241, 242, 244, 245,
+	246, 251, 254, 256, 258, 261, 267, 269, 270, 271,
+	273, 274, 275, 280, 281, 282, 283, 291, 295, 305,
+	306, 316, 325, 327, 34, 298, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 233, 0, 0, 0,
+	0, 0, 440, 0, 0, 0, 166, 437, 0, 0,
+	0, 196, 0, 0, 157, 204, 202, 0, 0, 0,
+	239, 297, 0, 0, 0, 513, 199, 0, 0, 320,
+	288, 218, 0, 0, 0, 0, 502, 503, 0, 0,
+	0, 0, 0, 0, 0, 0, 79, 0, 0, 438,
+	462, 461, 464, 465, 466, 467, 0, 0, 147, 463,
+	468, 497, 498, 0, 0, 0, 435, 453, 0, 512,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	450, 451, 0, 0, 0, 0, 527, 0, 452, 0,
+	0, 447, 448, 449, 454, 0, 0, 0, 0, 126,
+	0, 0, 0, 0, 176, 219, 137, 504, 0, 0,
+	189, 326, 0, 0, 525, 253, 0, 293, 179, 197,
+	141, 123, 135, 151, 178, 229, 262, 272, 510, 0,
+	0, 0, 0, 158, 0, 265, 237, 315, 0, 0,
+	243, 264, 200, 304, 255, 313, 314, 180, 413, 323,
+	328, 285, 167, 0, 127, 0, 250, 162, 193, 0,
+	0, 0, 155, 0, 0, 0, 284, 302, 142, 299,
+	217, 223, 152, 154, 153, 136, 279, 301, 146, 156,
+	289, 268, 294, 161, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 128, 296, 312, 148, 276, 277, 329,
+	263, 130, 310, 292, 215, 190, 191, 129, 0, 260,
+	165, 175, 160, 232, 0, 174, 252, 307, 308, 159,
+	331, 138, 322, 132, 139, 321, 226, 0, 225, 324,
+	303, 311, 216, 208, 0, 131, 309, 214, 207, 195,
+	170, 182, 248, 203, 249, 183, 221, 220, 222, 205,
+	209, 0, 0, 0, 290, 318, 332, 144, 0, 278,
+	300, 0, 0, 145, 173, 169, 247, 224, 140, 185,
+	287, 194, 201, 259, 330, 236, 266, 149, 317, 286,
+	514, 526, 520, 522, 521, 518, 519, 517, 516, 515,
+	528, 505, 506, 507, 508, 511, 0, 523, 524, 0,
+	0, 0, 0, 469, 470, 471, 472, 473, 477, 478,
+	482, 483, 491, 490, 489, 492, 493, 495, 494, 496,
+	474, 475, 476, 479, 480, 481, 484, 485, 488, 486,
+	487, 509, 122, 133, 198, 77, 257, 172, 319, 0,
+	164, 0, 0, 0, 0, 0, 0, 124, 125, 134,
+	143, 150, 163, 168, 171, 177, 181, 184, 186, 187,
+	188, 192, 206, 210, 211, 212, 213, 227, 228, 230,
+	231, 234, 235, 238, 240, 241, 242, 244, 245, 246,
+	251, 254, 256, 258, 261, 267, 269, 270, 271, 273,
+	274, 275, 280, 281, 282, 283, 291, 295, 305, 306,
+	316, 325, 327, 298, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
+	440, 0, 0, 0, 166, 437, 0, 0, 0, 196,
+	0, 0, 157, 204, 202, 0, 0, 0, 239, 297,
+	0, 0, 0, 513, 199, 0, 0, 320, 288, 218,
+	0, 0, 0, 0, 502, 503, 0, 0, 0, 0,
+	0, 0, 0, 0, 79, 0, 0, 438, 462, 461,
+	464, 465, 466, 467, 0, 0, 147, 463, 468, 497,
+	498, 0, 0, 0, 435, 453, 0, 512, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 450, 451,
+	431, 0, 0, 0, 527, 0, 452, 0, 0, 447,
+	448, 449, 454, 0, 0, 0, 0, 126, 0, 0,
+	0, 0, 176, 219, 137, 504, 0, 0, 189, 326,
+	0, 0, 525, 253, 0, 293, 179, 197, 141, 123,
+	135, 151, 178, 229, 262, 272, 510, 0, 0, 0,
+	0, 158, 0, 265, 237, 315, 0, 0, 243, 264,
+	200, 304, 255, 313, 314, 180, 413, 323, 328, 285,
+	167, 0, 127, 0, 250, 162, 193, 0, 0, 0,
+	155, 0, 0, 0, 284, 302, 142, 299, 217, 223,
+	152, 154, 153, 136, 279, 301, 146, 156, 289, 268,
+	294, 161, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 128, 296, 312, 148, 276, 277, 329, 263, 130,
+	310, 292, 215, 190, 191, 129, 0, 260, 165, 175,
+	160, 232, 0, 174, 252, 307, 308, 159, 331, 138,
+	322, 132, 139, 321, 226, 0, 225, 324, 303, 311,
+	216, 208, 0, 131, 309, 214, 207, 195, 170, 182,
+	248, 203, 249, 183, 221, 220, 222, 205, 209, 0,
+	0, 0, 290, 318, 332, 144, 0, 278, 300, 0,
+	0, 145, 173, 169, 247, 224, 140, 185, 287, 194,
+	201, 259, 330, 236, 266, 149, 317, 286, 514, 526,
+	520, 522, 521, 518, 519, 517, 516, 515, 528, 505,
+	506, 507, 508, 511, 0, 523, 524, 0, 0, 0,
+	0, 469, 470, 471, 472, 473, 477, 478, 482, 483,
+	491, 490, 489, 492, 493, 495, 494, 496, 474, 475,
+	476, 479, 480, 481, 484, 485, 488, 486, 487, 509,
+	122, 133, 198, 0, 257, 172, 319, 0, 164, 0,
+	0, 0, 0, 0, 0, 124, 125, 134, 143, 150,
+	163, 168, 171, 177, 181, 184, 186, 187, 188, 192,
+	206, 210, 211, 212, 213, 227, 228, 230, 231, 234,
+	235, 238, 240, 241, 242, 244, 245, 246, 251, 254,
+	256, 258, 261, 267, 269, 270, 271, 273, 274, 275,
+	280, 281, 282, 283, 291, 295, 305, 306, 316, 325,
+	327, 298, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 233, 0, 0, 0, 0, 0, 440, 0,
+	0, 0, 166, 437, 0, 0, 0, 196, 0, 0,
+	157, 204, 202, 0, 0, 0, 239, 297, 0, 0,
+	0, 513, 199, 0, 0, 320, 288, 218, 0, 0,
+	0, 0, 502, 503, 0, 0, 0, 0, 0, 0,
+	0, 0, 79, 0, 815, 438, 462, 461, 464, 465,
+	466, 467, 0, 0, 147, 463, 468, 497, 498, 0,
+	0, 0, 435, 453, 0, 512, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 450, 451, 0, 0,
+	0, 0, 527, 0, 452, 0, 0, 447, 448, 449,
+	454, 0, 0, 0, 0, 126, 0, 0, 0, 0,
+	176, 219, 137, 504, 0, 0, 189, 326, 0, 0,
+	525, 253, 0, 293, 179, 197, 141, 123, 135, 151,
+	178, 229, 262, 272, 510, 0, 0, 0, 0, 158,
+	0, 265, 237, 315, 0, 0, 243, 264, 200, 304,
+	255, 313, 314, 180, 413, 323, 328, 285, 167, 0,
+	127, 0, 250, 162, 193, 0, 0, 0, 155, 0,
+	0, 0, 284, 302, 142, 299, 217, 223, 152, 154,
+	153, 136, 279, 301, 146, 156, 289, 268, 294, 161,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 128,
+	296, 312, 148, 276, 277, 329, 263, 130, 310, 292,
+	215, 190, 191, 129, 0, 260, 165, 175, 160, 232,
+	0, 174, 252, 307, 308, 159, 331, 138, 322, 132,
+	139, 321, 226, 0, 225, 324, 303, 311, 216, 208,
+	0, 131, 309, 214, 207, 195, 170, 182, 248, 203,
+	249, 183, 221, 220, 222, 205, 209, 0, 0, 0,
+	290, 318, 332, 144, 0, 278, 300, 0, 0, 145,
+	173, 169, 247, 224, 140, 185, 287, 194, 201, 259,
+	330, 236, 266, 149, 317, 286, 514, 526, 520, 522,
+	521, 518, 519, 517, 516, 515, 528, 505, 506, 507,
+	508, 511, 0, 523, 524, 0, 0, 0, 0, 469,
+	470, 471, 472, 473, 477, 478, 482, 483, 491, 490,
+	489, 492, 493, 495, 494, 496, 474, 475, 476, 479,
+	480, 481, 484, 485, 488, 486, 487, 509, 122, 133,
+	198, 0, 257, 172, 319, 0, 164, 0, 0, 0,
+	0, 0, 0, 124, 125, 134, 143, 150, 163, 168,
+	171, 177, 181, 184, 186, 187, 188, 192, 206, 210,
+	211, 212, 213, 227, 228, 230, 231, 234, 235, 238,
+	240, 241, 242, 244, 245, 246, 251, 254, 256, 258,
+	261, 267, 269, 270, 271, 273, 274, 275, 280, 281,
+	282, 283, 291, 295, 305, 306, 316, 325, 327, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	233, 0, 0, 0, 0, 0, 440, 0, 0, 0,
+	166, 437, 0, 0, 0, 196, 0, 0, 157, 204,
+	202, 0, 0, 0, 239, 297, 0, 0, 0, 513,
+	199, 0, 0, 320, 288, 218, 0, 0, 0, 0,
+	502, 503, 0, 0, 0, 0, 0, 0, 0, 0,
+	79, 0, 0, 438, 462, 461, 464, 465, 466, 467,
+	0, 0, 147, 463, 468, 497, 498, 0, 0, 0,
+	435, 453, 0, 512, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 450, 451, 1197, 0, 0, 0,
+	527, 0, 452, 0, 0, 447, 448, 449, 454, 0,
+	0, 0, 0, 126, 0, 0, 0, 0, 176, 219,
+	137, 504, 0, 0, 189, 326, 0, 0, 525, 253,
+	0, 293, 179, 197, 141, 123, 135, 151, 178, 229,
+	262, 272, 510, 0, 0, 0, 0, 158, 0, 265,
+	237, 315, 0, 0, 243, 264, 200, 304, 255, 313,
+	314, 180, 413, 323, 328, 285, 167, 0, 127, 0,
+	250, 162, 193, 0, 0, 0, 155, 0, 0, 0,
+	284, 302, 142, 299, 217, 223, 152, 154, 153, 136,
+	279, 301, 146, 156, 289, 268, 294, 161, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 128, 296, 312,
+	148, 276, 277, 329, 263, 130, 310, 292, 215, 190,
+	191, 129, 0, 260, 165, 175, 160, 232, 0, 174,
+	252, 307, 308, 159, 331, 138, 322, 132, 139, 321,
+	226, 0, 225, 324, 303, 311, 216, 208, 0, 131,
+	309, 214, 207, 195, 170, 182, 248, 203, 249, 183,
+	221, 220, 222, 205, 209, 0, 0, 0, 290, 318,
+	332, 144, 0, 278, 300, 0, 0, 145, 173, 169,
+	247, 224, 140, 185, 287, 194, 201, 259, 330, 236,
+	266, 149, 317, 286, 514, 526, 520, 522, 521, 518,
+	519, 517, 516, 515, 528, 505, 506, 507, 508, 511,
+	0, 523, 524, 0, 0, 0, 0, 469, 470, 471,
+	472, 473, 477, 478, 482, 483, 491, 490, 489, 492,
+	493, 495, 494, 496, 474, 475, 476, 479, 480, 481,
+	484, 485, 488, 486, 487, 509, 122, 133, 198, 0,
+	257, 172, 319, 0, 164, 0, 0, 0, 0, 0,
+	0, 124, 125, 134, 143, 150, 163, 168, 171, 177,
+	181, 184, 186, 187, 188, 192, 206, 210, 211, 212,
+	213, 227, 228, 230, 231, 234, 235, 238, 240, 241,
+	242, 244, 245, 246, 251, 254, 256, 258, 261, 267,
+	269, 270, 271, 273, 274, 275, 280, 281, 282, 283,
+	291, 295, 305, 306, 316, 325, 327, 298, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 233, 0,
+	0, 0, 0, 0, 440, 0, 0, 0, 166, 437,
+	0, 0, 0, 196, 0, 0, 157, 204, 202, 0,
+	0, 0, 239, 297, 0, 0, 0, 513, 199, 0,
+	0, 320, 288, 218, 0, 0, 0, 0, 502, 503,
+	0, 0, 0, 0, 0, 0, 0, 0, 79, 0,
+	0, 438, 462, 1240, 464, 465, 466, 467, 0, 0,
+	147, 463, 468, 497, 498, 0, 0, 0, 435, 453,
+	0, 512, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 450, 451, 1197, 0, 0, 0, 527, 0,
+	452, 0, 0, 447, 448, 449, 454, 0, 0, 0,
+	0, 126, 0, 0, 0, 0, 176, 219, 137, 504,
+	0, 0, 189, 326, 0, 0, 525, 253, 0, 293,
+	179, 197, 141, 123, 135, 151, 178, 229, 262, 272,
+	510, 0, 0, 0, 0, 158, 0, 265, 237, 315,
+	0, 0, 243, 264, 200, 304, 255, 313, 314, 180,
+	413, 323, 328, 285, 167, 0, 127, 0, 250, 162,
+	193, 0, 0, 0, 155, 0, 0, 0, 284, 302,
+	142, 299, 217, 223, 152, 154, 153, 136, 279, 301,
+	146, 156, 289, 268, 294, 161, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 128, 296, 312, 148, 276,
+	277, 329, 263, 130, 310, 292, 215, 190, 191, 129,
+	0, 260, 165, 175, 160, 232, 0, 174, 252, 307,
+	308, 159, 331, 138, 322, 132, 139, 321, 226, 0,
+	225, 324, 303, 311, 216, 208, 0, 131, 309, 214,
+	207, 195, 170, 182, 248, 203, 249, 183, 221, 220,
+	222, 205, 209, 0, 0, 0, 290, 318, 332, 144,
+	0, 278, 300, 0, 0, 145, 173, 169, 247, 224,
+	140, 185, 287, 194, 201, 259, 330, 236, 266, 149,
+	317, 286, 514, 526, 520, 522, 521, 518, 519, 517,
+	516, 515, 528, 505, 506, 507, 508, 511, 0, 523,
+	524, 0, 0, 0, 0, 469, 470, 471, 472, 473,
+	477, 478, 482, 483, 491, 490, 489, 492, 493, 495,
+	494, 496, 474, 475, 476, 479, 480, 481, 484, 485,
+	488, 486, 487, 509, 122, 133, 198, 0, 257, 172,
+	319, 0, 164, 0, 0, 0, 0, 0, 0, 124,
+	125, 134, 143, 150, 163, 168, 171, 177, 181, 184,
+	186, 187, 188, 192, 206, 210, 211, 212, 213, 227,
+	228, 230, 231, 234, 235, 238, 240, 241, 242, 244,
+	245, 246, 251, 254, 256, 258, 261, 267, 269, 270,
+	271, 273, 274, 275, 280, 281, 282, 283, 291, 295,
+	305, 306, 316, 325, 327, 298, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 233, 0, 0, 0,
+	0, 0, 440, 0, 0, 0, 166, 437, 0, 0,
+	0, 196, 0, 0, 157, 204, 202, 0, 0, 0,
+	239, 297, 0, 0, 0, 513, 199, 0, 0, 320,
+	288, 218, 0, 0, 0, 0, 502, 503, 0, 0,
+	0, 0, 0, 0, 0, 0, 79, 0, 0, 438,
+	462, 1237, 464, 465, 466, 467, 0, 0, 147, 463,
+	468, 497, 498, 0, 0, 0, 435, 453, 0, 512,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	450, 451, 1197, 0, 0, 0, 527, 0, 452, 0,
+	0, 447, 448, 449, 454, 0, 0, 0, 0, 126,
+	0, 0, 0, 0, 176, 219, 137, 504, 0, 0,
+	189, 326, 0, 0, 525, 253, 0, 293, 179, 197,
+	141, 123, 135, 151, 178, 229, 262, 272, 510, 0,
+	0, 0, 0, 158, 0, 265, 237, 315, 0, 0,
+	243, 264, 200, 304, 255, 313, 314, 180, 413, 323,
+	328, 285, 167, 0, 127, 0, 250, 162, 193, 0,
+	0, 0, 155, 0, 0, 0, 284, 302, 142, 299,
+	217, 223, 152, 154, 153, 136, 279, 301, 146, 156,
+	289, 268, 294, 161, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 128, 296, 312, 148, 276, 277, 329,
+	263, 130, 310, 292, 215, 190, 191, 129, 0, 260,
+	165, 175, 160, 232, 0, 174, 252, 307, 308, 159,
+	331, 138, 322, 132, 139, 321, 226, 0, 225, 324,
+	303, 311, 216, 208, 0, 131, 309, 214, 207, 195,
+	170, 182, 248, 203, 249, 183, 221, 220, 222, 205,
+	209, 0, 0, 0, 290, 318, 332, 144, 0, 278,
+	300, 0, 0, 145, 173, 169, 247, 224, 140, 185,
+	287, 194, 201, 259, 330, 236, 266, 149, 317, 286,
+	514, 526, 520, 522, 521, 518, 519, 517, 516, 515,
+	528, 505, 506, 507, 508, 511, 0, 523, 524, 0,
+	0, 0, 0, 469, 470, 471, 472, 473, 477, 478,
+	482, 483, 491, 490, 489, 492, 493, 495, 494, 496,
+	474, 475, 476, 479, 480, 481, 484, 485, 488, 486,
+	487, 509, 122, 133, 198, 0, 257, 172, 319, 0,
+	164, 0, 0, 0, 0, 0, 0, 124, 125, 134,
+	143, 150, 163, 168, 171, 177, 181, 184, 186, 187,
+	188, 192, 206, 210, 211, 212, 213, 227, 228, 230,
+	231, 234, 235, 238, 240, 241, 242, 244, 245, 246,
+	251, 254, 256, 258, 261, 267, 269, 270, 271, 273,
+	274, 275, 280, 281, 282, 283, 291, 295, 305, 306,
+	316, 325, 327, 298, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
+	440, 0, 0, 0, 166, 437, 0, 0, 0, 196,
+	0, 0, 157, 204, 202, 0, 0, 0, 239, 297,
+	0, 0, 0, 513, 199, 0, 0, 320, 288, 218,
+	0, 0, 0, 0, 502, 503, 0, 0, 0, 0,
+	0, 0, 0, 0, 79, 0, 1116, 438, 462, 461,
+	464, 465, 466, 467, 0, 0, 147, 463, 468, 497,
+	498, 0, 0, 0, 435, 453, 0, 512, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 450, 451,
+	0, 0, 0, 0, 527, 0, 452, 0, 0, 447,
+	448, 449, 454, 0, 0, 0, 0, 126, 0, 0,
+	0, 0, 176, 219, 137, 504, 0, 0, 189, 326,
+	0, 0, 525, 253, 0, 293, 179, 197, 141, 123,
+	135, 151, 178, 229, 262, 272, 510, 0, 0, 0,
+	0, 158, 0, 265, 237, 315, 0, 0, 243, 264,
+	200, 304, 255, 313, 314, 180, 413, 323, 328, 285,
+	167, 0, 127, 0, 250, 162, 193, 0, 0, 0,
+	155, 0, 0, 0, 284, 302, 142, 299, 217, 223,
+	152, 154, 153, 136, 279, 301, 146, 156, 289, 268,
+	294, 161, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 128, 296, 312, 148, 276, 277, 329, 263, 130,
+	310, 292, 215, 190, 191, 129, 0, 260, 165, 175,
+	160, 232, 0, 174, 252, 307, 308, 159, 331, 138,
+	322, 132, 139, 321, 226, 0, 225, 324, 303, 311,
+	216, 208, 0, 131, 309, 214, 207, 195, 170, 182,
+	248, 203, 249, 183, 221, 220, 222, 205, 209, 0,
+	0, 0, 290, 318, 332, 144, 0, 278, 300, 0,
+	0, 145, 173, 169, 247, 224, 140, 185, 287, 194,
+	201, 259, 330, 236, 266, 149, 317, 286, 514, 526,
+	520, 522, 521, 518, 519, 517, 516, 515, 528, 505,
+	506, 507, 508, 511, 0, 523, 524, 0, 0, 0,
+	0, 469, 470, 471, 472, 473, 477, 478, 482, 483,
+	491, 490, 489, 492, 493, 495, 494, 496, 474, 475,
+	476, 479, 480, 481, 484, 485, 488, 486, 487, 509,
+	122, 133, 198, 0, 257, 172, 319, 0, 164, 0,
+	0, 0, 0, 0, 0, 124, 125, 134, 143, 150,
+	163, 168, 171, 177, 181, 184, 186, 187, 188, 192,
+	206, 210, 211, 212, 213, 227, 228, 230, 231, 234,
+	235, 238, 240, 241, 242, 244, 245, 246, 251, 254,
+	256, 258, 261, 267, 269, 270, 271, 273, 274, 275,
+	280, 281, 282, 283, 291, 295, 305, 306, 316, 325,
+	327, 298, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 233, 0, 0, 0, 0, 0, 440, 0,
+	0, 0, 166, 437, 0, 0, 0, 196, 0, 0,
+	157, 204, 202, 0, 0, 0, 239, 297, 0, 0,
+	0, 513, 199, 0, 0, 320, 288, 218, 0, 0,
+	0, 0, 502, 503, 0, 0, 0, 0, 0, 0,
+	0, 0, 79, 0, 0, 438, 462, 461, 464, 465,
+	466, 467, 0, 0, 147, 463, 468, 497, 498, 0,
+	0, 0, 435, 453, 0, 512, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 450, 451, 0, 0,
+	0, 0, 527, 0, 452, 0, 0, 447, 448, 449,
+	454, 0, 0, 0, 0, 126, 0, 0, 0, 0,
+	176, 219, 137, 504, 0, 0, 189, 326, 0, 0,
+	525, 253, 0, 293, 179, 197, 141, 123, 135, 151,
+	178, 229, 262, 272, 510, 0, 0, 0, 0, 158,
+	0, 265, 237, 315, 0, 0, 243, 264, 200, 304,
+	255, 313, 314, 180, 413, 323, 328, 285, 167, 0,
+	127, 0, 250, 162, 193, 0, 0, 0, 155, 0,
+	0, 0, 284, 302, 142, 299, 217, 223, 152, 154,
+	153, 136, 279, 301, 146, 156, 289, 268, 294, 161,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 128,
+	296, 312, 148, 276, 277, 329, 263, 130, 310, 292,
+	215, 190, 191, 129, 0, 260, 165, 175, 160, 232,
+	0, 174, 252, 307, 308, 159, 331, 138, 322, 132,
+	139, 321, 226, 0, 225, 324, 303, 311, 216, 208,
+	0, 131, 309, 214, 207, 195, 170, 182, 248, 203,
+	249, 183, 221, 220, 222, 205, 209, 0, 0, 0,
+	290, 318, 332, 144, 0, 278, 300, 0, 0, 145,
+	173, 169, 247, 224, 140, 185, 287, 194, 201, 259,
+	330, 236, 266, 149, 317, 286, 514, 526, 520, 522,
+	521, 518, 519, 517, 516, 515, 528, 505, 506, 507,
+	508, 511, 0, 523, 524, 0, 0, 0, 0, 469,
+	470, 471, 472, 473, 477, 478, 482, 483, 491, 490,
+	489, 492, 493, 495, 494, 496, 474, 475, 476, 479,
+	480, 481, 484, 485, 488, 486, 487, 509, 122, 133,
+	198, 0, 257, 172, 319, 0, 164, 0, 0, 0,
+	0, 0, 0, 124, 125, 134, 143, 150, 163, 168,
+	171, 177, 181, 184, 186, 187, 188, 192, 206, 210,
+	211, 212, 213, 227, 228, 230, 231, 234, 235, 238,
+	240, 241, 242, 244, 245, 246, 251, 254, 256, 258,
+	261, 267, 269, 270, 271, 273, 274, 275, 280, 281,
+	282, 283, 291, 295, 305, 306, 316, 325, 327, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	233, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	166, 0, 0, 0, 0, 196, 0, 0, 157, 204,
+	202, 0, 0, 0, 239, 297, 0, 0, 0, 513,
+	199, 0, 0, 320, 288, 218, 0, 0, 0, 0,
+	502, 503, 0, 0, 0, 0, 0, 0, 0, 0,
+	79, 0, 0, 438, 462, 461, 464, 465, 466, 467,
+	0, 0, 147, 463, 468, 497, 498, 0, 0, 0,
+	0, 453, 0, 512, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 450, 451, 0, 0, 0, 0,
+	527, 0, 452, 0, 0, 447, 448, 449, 454, 0,
+	0, 0, 0, 126, 0, 0, 0, 0, 176, 219,
+	137, 504, 0, 0, 189, 326, 0, 0, 525, 253,
+	0, 293, 179, 197, 141, 123, 135, 151, 178, 229,
+	262, 272, 510, 0, 0, 0, 0, 158, 0, 265,
+	237, 315, 0, 2302, 243, 264, 200, 304, 255, 313,
+	314, 180, 413, 323, 328, 285, 167, 0, 127, 0,
+	250, 162, 193, 0, 0, 0, 155, 0, 0, 0,
+	284, 302, 142, 299, 217, 223, 152, 154, 153, 136,
+	279, 301, 146, 156, 289, 268, 294, 161, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 128, 296, 312,
+	148, 276, 277, 329, 263, 130, 310, 292, 215, 190,
+	191, 129, 0, 260, 165, 175, 160, 232, 0, 174,
+	252, 307, 308, 159, 331, 138, 322, 132, 139, 321,
+	226, 0, 225, 324, 303, 311, 216, 208, 0, 131,
+	309, 214, 207, 195, 170, 182, 248, 203, 249, 183,
+	221, 220, 222, 205, 209, 0, 0, 0, 290, 318,
+	332, 144, 0, 278, 300, 0, 0, 145, 173, 169,
+	247, 224, 140, 185, 287, 194, 201, 259, 330, 236,
+	266, 149, 317, 286, 514, 526, 520, 522, 521, 518,
+	519, 517, 516, 515, 528, 505, 506, 507, 508, 511,
+	0, 523, 524, 0, 0, 0, 0, 469, 470, 471,
+	472, 473, 477, 478, 482, 483, 491, 490, 489, 492,
+	493, 495, 494, 496, 474, 475, 476, 479, 480, 481,
+	484, 485, 488, 486, 487, 509, 122, 133, 198, 0,
+	257, 172, 319, 0, 164, 0, 0, 0, 0, 0,
+	0, 124, 125, 134, 143, 150, 163, 168, 171, 177,
+	181, 184, 186, 187, 188, 192, 206, 210, 211, 212,
+	213, 227, 228, 230, 231, 234, 235, 238, 240, 241,
+	242, 244, 245, 246, 251, 254, 256, 258, 261, 267,
+	269, 270, 271, 273, 274, 275, 280, 281, 282, 283,
+	291, 295, 305, 306, 316, 325, 327, 298, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 233, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 166, 0,
+	0, 0, 0, 196, 0, 0, 157, 204, 202, 0,
+	0, 0, 239, 297, 0, 0, 0, 513, 199, 0,
+	0, 320, 288, 218, 0, 0, 0, 0, 502, 503,
+	0, 0, 0, 0, 0, 0, 0, 0, 79, 0,
+	815, 438, 462, 461, 464, 465, 466, 467, 0, 0,
+	147, 463, 468, 497, 498, 0, 0, 0, 0, 453,
+	0, 512, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 450, 451, 0, 0, 0, 0, 527, 0,
+	452, 0, 0, 447, 448, 449, 454, 0, 0, 0,
+	0, 126, 0, 0, 0, 0, 176, 219, 137, 504,
+	0, 0, 189, 326, 0, 0, 525, 253, 0, 293,
+	179, 197, 141, 123, 135, 151, 178, 229, 262, 272,
+	510, 0, 0, 0, 0, 158, 0, 265, 237, 315,
+	0, 0, 243, 264, 200, 304, 255, 313, 314, 180,
+	413, 323, 328, 285, 167, 0, 127, 0, 250, 162,
+	193, 0, 0, 0, 155, 0, 0, 0, 284, 302,
+	142, 299, 217, 223, 152, 154, 153, 136, 279, 301,
+	146, 156, 289, 268, 294, 161, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 128, 296, 312, 148, 276,
+	277, 329, 263, 130, 310, 292, 215, 190, 191, 129,
+	0, 260, 165, 175, 160, 232, 0, 174, 252, 307,
+	308, 159, 331, 138, 322, 132, 139, 321, 226, 0,
+	225, 324, 303, 311, 216, 208, 0, 131, 309, 214,
+	207, 195, 170, 182, 248, 203, 249, 183, 221, 220,
+	222, 205, 209, 0, 0, 0, 290, 318, 332, 144,
+	0, 278, 300, 0, 0, 145, 173, 169, 247, 224,
+	140, 185, 287, 194, 201, 259, 330, 236, 266, 149,
+	317, 286, 514, 526, 520, 522, 521, 518, 519, 517,
+	516, 515, 528, 505, 506, 507, 508, 511, 0, 523,
+	524, 0, 0, 0, 0, 469, 470, 471, 472, 473,
+	477, 478, 482, 483, 491, 490, 489, 492, 493, 495,
+	494, 496, 474, 475, 476, 479, 480, 481, 484, 485,
+	488, 486, 487, 509, 122, 133, 198, 0, 257, 172,
+	319, 0, 164, 0, 0, 0, 0, 0, 0, 124,
+	125, 134, 143, 150, 163, 168, 171, 177, 181, 184,
+	186, 187, 188, 192, 206, 210, 211, 212, 213, 227,
+	228, 230, 231, 234, 235, 238, 240, 241, 242, 244,
+	245, 246, 251, 254, 256, 258, 261, 267, 269, 270,
+	271, 273, 274, 275, 280, 281, 282, 283, 291, 295,
+	305, 306, 316, 325, 327, 298, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 233, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 166, 0, 0, 0,
+	0, 196, 0, 0, 157, 204, 202, 0, 0, 0,
+	239, 297, 0, 0, 0, 513, 199, 0, 0, 320,
+	288, 218, 0, 0, 0, 0, 502, 503, 0, 0,
+	0, 0, 0, 0, 0, 0, 79, 0, 0, 438,
+	462, 461, 464, 465, 466, 467, 0, 0, 147, 463,
+	468, 497, 498, 0, 0, 0, 0, 453, 0, 512,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	450, 451, 0, 0, 0, 0, 527, 0, 452, 0,
+	0, 447, 448, 449, 454, 0, 0, 0, 0, 126,
+	0, 0, 0, 0, 176, 219, 137, 504, 0, 0,
+	189, 326, 0, 0, 525, 253, 0, 293, 179, 197,
+	141, 123, 135, 151, 178, 229, 262, 272, 510, 0,
+	0, 0, 0, 158, 0, 265, 237, 315, 0, 0,
+	243, 264, 200, 304, 255, 313, 314, 180, 413, 323,
+	328, 285, 167, 0, 127, 0, 250, 162, 193, 0,
+	0, 0, 155, 0, 0, 0, 284, 302, 142, 299,
+	217, 223, 152, 154, 153, 136, 279, 301, 146, 156,
+	289, 268, 294, 161, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 128, 296, 312, 148, 276, 277, 329,
+	263, 130, 310, 292, 215, 190, 191, 129, 0, 260,
+	165, 175, 160, 232, 0, 174, 252, 307, 308, 159,
+	331, 138, 322, 132, 139, 321, 226, 0, 225, 324,
+	303, 311, 216, 208, 0, 131, 309, 214, 207, 195,
+	170, 182, 248, 203, 249, 183, 221, 220, 222, 205,
+	209, 0, 0, 0, 290, 318, 332, 144, 0, 278,
+	300, 0, 0, 145, 173, 169, 247, 224, 140, 185,
+	287, 194, 201, 259, 330, 236, 266, 149, 317, 286,
+	514, 526, 520, 522, 521, 518, 519, 517, 516, 515,
+	528, 505, 506, 507, 508, 511, 0, 523, 524, 0,
+	0, 0, 0, 469, 470, 471, 472, 473, 477, 478,
+	482, 483, 491, 490, 489, 492, 493, 495, 494, 496,
+	474, 475, 476, 479, 480, 481, 484, 485, 488, 486,
+	487, 509, 122, 133, 198, 0, 257, 172, 319, 0,
+	164, 0, 0, 0, 0, 0, 0, 124, 125, 134,
+	143, 150, 163, 168, 171, 177, 181, 184, 186, 187,
+	188, 192, 206, 210, 211, 212, 213, 227, 228, 230,
+	231, 234, 235, 238, 240, 241, 242, 244, 245, 246,
+	251, 254, 256, 258, 261, 267, 269, 270, 271, 273,
+	274, 275, 280, 281, 282, 283, 291, 295, 305, 306,
+	316, 325, 327, 298, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 233, 0, 0, 0, 0, 1282,
+	0, 0, 0, 0, 166, 0, 0, 0, 0, 196,
+	0, 0, 157, 204, 202, 0, 0, 0, 239, 297,
+	0, 0, 0, 0, 199, 0, 0, 320, 288, 218,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1284, 1286, 0, 0, 0, 0, 0, 120, 0, 392,
+	0, 0, 0, 0, 0, 0, 147, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 126, 0, 0,
+	0, 0, 176, 219, 137, 0, 0, 0, 189, 326,
+	0, 1285, 0, 253, 0, 293, 179, 197, 141, 123,
+	135, 151, 178, 229, 262, 272, 0, 0, 0, 0,
+	0, 158, 0, 265, 237, 315, 0, 0, 1280, 264,
+	200, 304, 255, 313, 314, 180, 413, 323, 328, 285,
+	167, 0, 127, 0, 250, 162, 193, 0, 0, 0,
+	155, 0, 0, 0, 284, 302, 142, 299, 217, 223,
+	152, 154, 153, 136, 279, 301, 146, 156, 289, 268,
+	294, 161, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 128, 296, 312, 148, 276, 277, 329, 263, 130,
+	310, 292, 215, 190, 191, 129, 0, 260, 165, 175,
+	160, 232, 0, 174, 252, 307, 308, 159, 331, 138,
+	322, 132, 139, 321, 226, 0, 225, 324, 303, 311,
+	216, 208, 0, 131, 309, 214, 207, 195, 170, 182,
+	248, 203, 249, 183, 221, 220, 222, 205, 209, 0,
+	0, 0, 290, 318, 332, 144, 0, 278, 300, 0,
+	0, 145, 173, 169, 247, 224, 140, 185, 287, 194,
+	201, 259, 330, 236, 266, 149, 317, 286, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 393, 394, 395, 396, 397, 401, 402, 406, 407,
+	416, 415, 414, 417, 418, 420, 419, 421, 398, 399,
+	400, 403, 404, 405, 408, 409, 412, 410, 411, 0,
+	122, 133, 198, 0, 257, 172, 319, 0, 164, 0,
+	0, 0, 0, 0, 0, 124, 125, 134, 143, 150,
+	163, 168, 171, 177, 181, 184, 186, 187, 188, 192,
+	206, 210, 211, 212, 213, 227, 228, 230, 231, 234,
+	235, 238, 240, 241, 242, 244, 245, 246, 251, 254,
+	256, 258, 261, 267, 269, 270, 271, 273, 274, 275,
+	280, 281, 282, 283, 291, 295, 305, 306, 316, 325,
+	327, 298, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 233, 0, 0, 0, 0, 837, 0, 0,
+	0, 0, 166, 0, 0, 0, 0, 196, 0, 0,
+	157, 204, 202, 0, 0, 0, 239, 297, 0, 0,
+	0, 0, 199, 0, 0, 320, 288, 218, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 838, 0, 841, 0, 0,
+	0, 0, 0, 0, 147, 0, 0, 0, 0, 0,
+	834, 833, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 835, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 126, 0, 0, 0, 0,
+	176, 219, 137, 0, 0, 0, 189, 326, 0, 0,
+	0, 253, 0, 293, 179, 197, 141, 123, 135, 151,
+	178, 229, 262, 272, 0, 0, 0, 0, 0, 158,
+	0, 265, 237, 315, 0, 0, 243, 264, 200, 304,
+	255, 313, 314, 180, 413, 323, 328, 285, 167, 0,
+	127, 0, 250, 162, 193, 0, 0, 0, 155, 0,
+	0, 0, 284, 302, 142, 299, 217, 223, 152, 154,
+	153, 136, 279, 301, 146, 156, 289, 268, 294, 161,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 128,
+	296, 312, 148, 276, 277, 329, 263, 130, 310, 292,
+	215, 190, 191, 129, 0, 260, 165, 175, 160, 232,
+	0, 174, 252, 307, 308, 159, 331, 138, 322, 132,
+	139, 321, 226, 0, 225, 324, 303, 311, 216, 208,
+	0, 131, 309, 214, 207, 195, 170, 182, 248, 203,
+	249, 183, 221, 220, 222, 205, 209, 0, 0, 0,
+	290, 318, 332, 144, 0, 278, 300, 0, 0, 145,
+	173, 169, 247, 224, 140, 185, 287, 194, 201, 259,
+	330, 236, 266, 149, 317, 286, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 393,
+	394, 395, 396, 397, 401, 402, 406, 407, 416, 415,
+	414, 417, 418, 420, 419, 421, 398, 399, 400, 403,
+	404, 405, 408, 409, 412, 410, 411, 0, 122, 133,
+	198, 0, 257, 172, 319, 0, 164, 0, 0, 0,
+	0, 0, 0, 124, 125, 134, 143, 150, 163, 168,
+	171, 177, 181, 184, 186, 187, 188, 192, 206, 210,
+	211, 212, 213, 227, 228, 230, 231, 234, 235, 238,
+	240, 241, 242, 244, 245, 246, 251, 254, 256, 258,
+	261, 267, 269, 270, 271, 273, 274, 275, 280, 281,
+	282, 283, 291, 295, 305, 306, 316, 325, 327, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	233, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	166, 0, 0, 0, 0, 196, 1549, 0, 157, 204,
+	202, 0, 0, 0, 239, 297, 0, 0, 0, 0,
+	199, 0, 0, 320, 288, 218, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 120, 0, 392, 0, 0, 0, 0,
+	0, 0, 147, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 126, 0, 0, 0, 0, 176, 219,
+	137, 0, 0, 0, 189, 326, 0, 0, 0, 253,
+	0, 293, 179, 197, 141, 123, 135, 151, 178, 229,
+	262, 272, 0, 0, 0, 0, 0, 158, 0, 265,
+	237, 315, 0, 0, 243, 264, 200, 304, 255, 313,
+	314, 180, 413, 323, 328, 285, 167, 0, 127, 0,
+	250, 162, 193, 0, 0, 0, 155, 0, 0, 0,
+	284, 302, 142, 299, 217, 223, 152, 154, 153, 136,
+	279, 301, 146, 156, 289, 268, 294, 161, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 128, 296, 312,
+	148, 276, 277, 329, 263, 130, 310, 292, 215, 190,
+	191, 129, 0, 260, 165, 175, 160, 232, 0, 174,
+	252, 307, 308, 159, 331, 138, 322, 132, 139, 321,
+	226, 0, 225, 324, 303, 311, 216, 208, 0, 131,
+	309, 214, 207, 195, 170, 182, 248, 203, 249, 183,
+	221, 220, 222, 205, 209, 0, 0, 0, 290, 318,
+	332, 144, 0, 278, 300, 0, 0, 145, 173, 169,
+	247, 224, 140, 185, 287, 194, 201, 259, 330, 236,
+	266, 149, 317, 286, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 393, 394, 395,
+	396, 397, 401, 402, 406, 407, 416, 415, 414, 417,
+	418, 420, 419, 421, 398, 399, 400, 403, 404, 405,
+	408, 409, 412, 410, 411, 0, 122, 133, 198, 0,
+	257, 172, 319, 0, 164, 0, 0, 0, 0, 0,
+	0, 124, 125, 134, 143, 150, 163, 168, 171, 177,
+	181, 184, 186, 187, 188, 192, 206, 210, 211, 212,
+	213, 227, 228, 230, 231, 234, 235, 238, 240, 241,
+	242, 244, 245, 246, 251, 254, 256, 258, 261, 267,
+	269, 270, 271, 273, 274, 275, 280, 281, 282, 283,
+	291, 295, 305, 306, 316, 325, 327, 298, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 233, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 166, 0,
+	0, 0, 0, 196, 0, 0, 157, 204, 202, 0,
+	0, 0, 239, 297, 0, 0, 0, 0, 199, 0,
+	0, 320, 288, 218, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 120, 0, 392, 0, 0, 0, 0, 0, 0,
+	147, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 126, 0, 0, 0, 0, 176, 219, 137, 0,
+	0, 0, 189, 326, 0, 0, 0, 253, 0, 293,
+	179, 197, 141, 123, 135, 151, 178, 229, 262, 272,
+	0, 0, 0, 0, 0, 158, 0, 265, 237, 315,
+	0, 0, 243, 264, 200, 304, 255, 313, 314, 180,
+	413, 323, 328, 285, 167, 0, 127, 0, 250, 162,
+	193, 0, 0, 0, 155, 0, 0, 0, 284, 302,
+	142, 299, 217, 223, 152, 154, 153, 136, 279, 301,
+	146, 156, 289, 268, 294, 161, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 128, 296, 312, 148, 276,
+	277, 329, 263, 130, 310, 292, 215, 190, 191, 129,
+	0, 260, 165, 175, 160, 232, 0, 174, 252, 307,
+	308, 159, 331, 138, 322, 132, 139, 321, 226, 0,
+	225, 324, 303, 311, 216, 208, 0, 131, 309, 214,
+	207, 195, 170, 182, 248, 203, 249, 183, 221, 220,
+	222, 205, 209, 0, 0, 0, 290, 318, 332, 144,
+	0, 278, 300, 0, 0, 145, 173, 169, 247, 224,
+	140, 185, 287, 194, 201, 259, 330, 236, 266, 149,
+	317, 286, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 393, 394, 395, 396, 397,
+	401, 402, 406, 407, 416, 415, 414, 417, 418, 420,
+	419, 421, 398, 399, 400, 403, 404, 405, 408, 409,
+	412, 410, 411, 0, 122, 133, 198, 0, 257, 172,
+	319, 0, 164, 0, 0, 0, 0, 0, 0, 124,
+	125, 134, 143, 150, 163, 168, 171, 177, 181, 184,
+	186, 187, 188, 192, 206, 210, 211, 212, 213, 227,
+	228, 230, 231, 234, 235, 238, 240, 241, 242, 244,
+	245, 246, 251, 254, 256, 258, 261, 267, 269, 270,
+	271, 273, 274, 275, 280, 281, 282, 283, 291, 295,
+	305, 306, 316, 325, 327, 298, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 233, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 166, 0, 0, 0,
+	0, 196, 0, 0, 157, 204, 202, 0, 0, 0,
+	239, 297, 0, 0, 0, 0, 199, 0, 0, 320,
+	288, 218, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 838,
+	0, 841, 0, 0, 0, 0, 0, 0, 147, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 126,
+	0, 0, 0, 0, 176, 219, 137, 0, 0, 0,
+	189, 326, 0, 0, 0, 253, 0, 293, 179, 197,
+	141, 123, 135, 151, 178, 229, 262, 272, 0, 0,
+	0, 0, 0, 158, 0, 265, 237, 315, 0, 0,
+	243, 264, 200, 304, 255, 313, 314, 180, 413, 323,
+	328, 285, 167, 0, 127, 0, 250, 162, 193, 0,
+	0, 0, 155, 0, 0, 0, 284, 302, 142, 299,
+	217, 223, 152, 154, 153, 136, 279, 301, 146, 156,
+	289, 268, 294, 161, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 128, 296, 312, 148, 276, 277, 329,
+	263, 130, 310, 292, 215, 190, 191, 129, 0, 260,
+	165, 175, 160, 232, 0, 174, 252, 307, 308, 159,
+	331, 138, 322, 132, 139, 321, 226, 0, 225, 324,
+	303, 311, 216, 208, 0, 131, 309, 214, 207, 195,
+	170, 182, 248, 203, 249, 183, 221, 220, 222, 205,
+	209, 0, 0, 0, 290, 318, 332, 144, 0, 278,
+	300, 0, 0, 145, 173, 169, 247, 224, 140, 185,
+	287, 194, 201, 259, 330, 236, 266, 149, 317, 286,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 393, 394, 395, 396, 397, 401, 402,
+	406, 407, 416, 415, 414, 417, 418, 420, 419, 421,
+	398, 399, 400, 403, 404, 405, 408, 409, 412, 410,
+	411, 0, 122, 133, 198, 0, 257, 172, 319, 0,
+	164, 0, 0, 0, 0, 0, 0, 124, 125, 134,
+	143, 150, 163, 168, 171, 177, 181, 184, 186, 187,
+	188, 192, 206, 210, 211, 212, 213, 227, 228, 230,
+	231, 234, 235, 238, 240, 241, 242, 244, 245, 246,
+	251, 254, 256, 258, 261, 267, 269, 270, 271, 273,
+	274, 275, 280, 281, 282, 283, 291, 295, 305, 306,
+	316, 325, 327, 298, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 166, 0, 0, 0, 0, 196,
+	0, 0, 157, 204, 202, 0, 0, 0, 239, 297,
+	0, 0, 0, 0, 199, 0, 0, 320, 288, 218,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 568, 0, 0,
+	0, 0, 0, 0, 0, 0, 147, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 851, 850, 860, 861, 853, 854,
+	855, 856, 857, 858, 859, 852, 0, 0, 862, 0,
+	0, 0, 0, 0, 0, 0, 0, 126, 0, 0,
+	0, 0, 176, 219, 137, 0, 0, 0, 189, 326,
+	0, 0, 0, 253, 0, 293, 179, 197, 141, 123,
+	135, 151, 178, 229, 262, 272, 0, 0, 0, 0,
+	0, 158, 0, 265, 237, 315, 0, 0, 243, 264,
+	200, 304, 255, 313, 314, 180, 0, 323, 328, 285,
+	167, 0, 127, 0, 250, 162, 193, 0, 0, 0,
+	155, 0, 0, 0, 284, 302, 142, 299, 217, 223,
+	152, 154, 153, 136, 279, 301, 146, 156, 289, 268,
+	294, 161, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 128, 296, 312, 148, 276, 277, 329, 263, 130,
+	310, 292, 215, 190, 191, 129, 0, 260, 165, 175,
+	160, 232, 0, 174, 252, 307, 308, 159, 331, 138,
+	322, 132, 139, 321, 226, 0, 225, 324, 303, 311,
+	216, 208, 0, 131, 309, 214, 207, 195, 170, 182,
+	248, 203, 249, 183, 221, 220, 222, 205, 209, 0,
+	0, 0, 290, 318, 332, 144, 0, 278, 300, 0,
+	0, 145, 173, 169, 247, 224, 140, 185, 287, 194,
+	201, 259, 330, 236, 266, 149, 317, 286, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	122, 133, 198, 0, 257, 172, 319, 0, 164, 0,
+	0, 0, 0, 0, 0, 124, 125, 134, 143, 150,
+	163, 168, 171, 177, 181, 184, 186, 187, 188, 192,
+	206, 210, 211, 212, 213, 227, 228, 230, 231, 234,
+	235, 238, 240, 241, 242, 244, 245, 246, 251, 254,
+	256, 258, 261, 267, 269, 270, 271, 273, 274, 275,
+	280, 281, 282, 283, 291, 295, 305, 306, 316, 325,
+	327, 34, 298, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 233, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 166, 0, 0, 0, 0, 196, 0,
+	0, 157, 204, 202, 0, 0, 0, 239, 297, 0,
+	0, 0, 1277, 199, 0, 0, 320, 288, 218, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 79, 0, 0, 120, 0, 0, 0,
+	0, 0, 0, 0, 0, 147, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 126, 0, 0, 0,
+	0, 176, 219, 137, 0, 0, 0, 189, 326, 0,
+	0, 0, 253, 0, 293, 179, 197, 141, 123, 135,
+	151, 178, 229, 262, 272, 0, 0, 0, 0, 0,
+	158, 0, 265, 237, 315, 0, 0, 243, 264, 200,
+	304, 255, 313, 314, 180, 0, 323, 328, 285, 167,
+	0, 127, 0, 250, 162, 193, 0, 0, 0, 155,
+	0, 0, 0, 284, 302, 142, 299, 217, 223, 152,
+	154, 153, 136, 279, 301, 146, 156, 289, 268, 294,
+	161, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	128, 296, 312, 148, 276, 277, 329, 263, 130, 310,
+	292, 215, 190, 191, 129, 0, 260, 165, 175, 160,
+	232, 0, 174, 252, 307, 308, 159, 331, 138, 322,
+	132, 139, 321, 226, 0, 225, 324, 303, 311, 216,
+	208, 0, 131, 309, 214, 207, 195, 170, 182, 248,
+	203, 249, 183, 221, 220, 222, 205, 209, 0, 0,
+	0, 290, 318, 332, 144, 0, 278, 300, 0, 0,
+	145, 173, 169, 247, 224, 140, 185, 287, 194, 201,
+	259, 330, 236, 266, 149, 317, 286, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 122,
+	133, 198, 77, 257, 172, 319, 0, 164, 0, 0,
+	0, 0, 0, 0, 124, 125, 134, 143, 150, 163,
+	168, 171, 177, 181, 184, 186, 187, 188, 192, 206,
+	210, 211, 212, 213, 227, 228, 230, 231, 234, 235,
+	238, 240, 241, 242, 244, 245, 246, 251, 254, 256,
+	258, 261, 267, 269, 270, 271, 273, 274, 275, 280,
+	281, 282, 283, 291, 295, 305, 306, 316, 325, 327,
+	34, 298, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 233, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 166, 0, 0, 0, 0, 196, 0, 0,
+	157, 204, 202, 0, 0, 0, 239, 297, 0, 0,
+	0, 0, 199, 0, 0, 320, 288, 218, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 79, 0, 0, 568, 0, 0, 0, 0,
+	0, 0, 0, 0, 147, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 126, 0, 0, 0, 0,
+	176, 219, 137, 0, 0, 0, 189, 326, 0, 0,
+	0, 253, 0, 293, 179, 197, 141, 123, 135, 151,
+	178, 229, 262, 272, 0, 0, 0, 0, 0, 158,
+	0, 265, 237, 315, 0, 0, 243, 264, 200, 304,
+	255, 313, 314, 180, 0, 323, 328, 285, 167, 0,
+	127, 0, 250, 162, 193, 0, 0, 0, 155, 0,
+	0, 0, 284, 302, 142, 299, 217, 223, 152, 154,
+	153, 136, 279, 301, 146, 156, 289, 268, 294, 161,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 128,
+	296, 312, 148, 276, 277, 329, 263, 130, 310, 292,
+	215, 190, 191, 129, 0, 260, 165, 175, 160, 232,
+	0, 174, 252, 307, 308, 159, 331, 138, 322, 132,
+	139, 321, 226, 0, 225, 324, 303, 311, 216, 208,
+	0, 131, 309, 214, 207, 195, 170, 182, 248, 203,
+	249, 183, 221, 220, 222, 205, 209, 0, 0, 0,
+	290, 318, 332, 144, 0, 278, 300, 0, 0, 145,
+	173, 169, 247, 224, 140, 185, 287, 194, 201, 259,
+	330, 236, 266, 149, 317, 286, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 122, 133,
+	198, 77, 257, 172, 319, 0, 164, 0, 0, 0,
+	0, 0, 0, 124, 125, 134, 143, 150, 163, 168,
+	171, 177, 181, 184, 186, 187, 188, 192, 206, 210,
+	211, 212, 213, 227, 228, 230, 231, 234, 235, 238,
+	240, 241, 242, 244, 245, 246, 251, 254, 256, 258,
+	261, 267, 269, 270, 271, 273, 274, 275, 280, 281,
+	282, 283, 291, 295, 305, 306, 316, 325, 327, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	233, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	166, 985, 0, 0, 0, 196, 0, 0, 157, 204,
+	202, 0, 0, 0, 239, 297, 0, 0, 0, 0,
+	199, 0, 0, 320, 288, 218, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 568, 0, 984, 0, 0, 0, 0,
+	0, 0, 147, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 126, 0, 0, 0, 0, 176, 219,
+	137, 0, 0, 0, 189, 326, 0, 0, 0, 253,
+	0, 293, 179, 197, 141, 123, 135, 151, 178, 229,
+	262, 272, 0, 0, 0, 0, 0, 158, 0, 265,
+	237, 315, 0, 0, 243, 264, 200, 304, 255, 313,
+	314, 180, 0, 323, 328, 285, 167, 0, 127, 0,
+	250, 162, 193, 0, 0, 0, 155, 0, 0, 0,
+	284, 302, 142, 299, 217, 223, 152, 154, 153, 136,
+	279, 301, 146, 156, 289, 268, 294, 161, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 128, 296, 312,
+	148, 276, 277, 329, 263, 130, 310, 292, 215, 190,
+	191, 129, 0, 260, 165, 175, 160, 232, 0, 174,
+	252, 307, 308, 159, 331, 138, 322, 132, 139, 321,
+	226, 0, 225, 324, 303, 311, 216, 208, 0, 131,
+	309, 214, 207, 195, 170, 182, 248, 203, 249, 183,
+	221, 220, 222, 205, 209, 0, 0, 0, 290, 318,
+	332, 144, 0, 278, 300, 0, 0, 145, 173, 169,
+	247, 224, 140, 185, 287, 194, 201, 259, 330, 236,
+	266, 149, 317, 286, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 122, 133, 198, 0,
+	257, 172, 319, 0, 164, 0, 0, 0, 0, 0,
+	0, 124, 125, 134, 143, 150, 163, 168, 171, 177,
+	181, 184, 186, 187, 188, 192, 206, 210, 211, 212,
+	213, 227, 228, 230, 231, 234, 235, 238, 240, 241,
+	242, 244, 245, 246, 251, 254, 256, 258, 261, 267,
+	269, 270, 271, 273, 274, 275, 280, 281, 282, 283,
+	291, 295, 305, 306, 316, 325, 327, 298, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 233, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 166, 0,
+	0, 0, 0, 196, 0, 0, 157, 204, 202, 0,
+	0, 0, 239, 297, 0, 0, 0, 0, 199, 0,
+	0, 320, 288, 218, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 79, 0,
+	0, 120, 0, 0, 0, 0, 0, 0, 0, 0,
+	147, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 126, 0, 0, 0, 0, 176, 219, 137, 0,
+	0, 0, 189, 326, 0, 0, 0, 253, 0, 293,
+	179, 197, 141, 123, 135, 151, 178, 229, 262, 272,
+	0, 0, 0, 0, 0, 158, 0, 265, 237, 315,
+	0, 0, 243, 264, 200, 304, 255, 313, 314, 180,
+	0, 323, 328, 285, 167, 0, 127, 0, 250, 162,
+	193, 0, 0, 0, 155, 0, 0, 0, 284, 302,
+	142, 299, 217, 223, 152, 154, 153, 136, 279, 301,
+	146, 156, 289, 268, 294, 161, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 128, 296, 312, 148, 276,
+	277, 329, 263, 130, 310, 292, 215, 190, 191, 129,
+	0, 260, 165, 175, 160, 232, 0, 174, 252, 307,
+	308, 159, 331, 138, 322, 132, 139, 321, 226, 0,
+	225, 324, 303, 311, 216, 208, 0, 131, 309, 214,
+	207, 195, 170, 182, 248, 203, 249, 183, 221, 220,
+	222, 205, 209, 0, 0, 0, 290, 318, 332, 144,
+	0, 278, 300, 0, 0, 145, 173, 169, 247, 224,
+	140, 185, 287, 194, 201, 259, 330, 236, 266, 149,
+	317, 286, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 122, 133, 198, 0, 257, 172,
+	319, 0, 164, 0, 0, 0, 0, 0, 0, 124,
+	125, 134, 143, 150, 163, 168, 171, 177, 181, 184,
+	186, 187, 188, 192, 206, 210, 211, 212, 213, 227,
+	228, 230, 231, 234, 235, 238, 240, 241, 242, 244,
+	245, 246, 251, 254, 256, 258, 261, 267, 269, 270,
+	271, 273, 274, 275, 280, 281, 282, 283, 291, 295,
+	305, 306, 316, 325, 327, 298, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 233, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 166, 0, 0, 0,
+	0, 196, 0, 0, 157, 204, 202, 0, 0, 0,
+	239, 297, 0, 0, 0, 0, 199, 0, 0, 320,
+	288, 218, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 79, 0, 0, 568,
+	0, 0, 0, 0, 0, 0, 0, 0, 147, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 126,
+	0, 0, 0, 0, 176, 219, 137, 0, 0, 0,
+	189, 326, 0, 0, 0, 253, 0, 293, 179, 197,
+	141, 123, 135, 151, 178, 229, 262, 272, 0, 0,
+	0, 0, 0, 158, 0, 265, 237, 315, 0, 0,
+	243, 264, 200, 304, 255, 313, 314, 180, 0, 323,
+	328, 285, 167, 0, 127, 0, 250, 162, 193, 0,
+	0, 0, 155, 0, 0, 0, 284, 302, 142, 299,
+	217, 223, 152, 154, 153, 136, 279, 301, 146, 156,
+	289, 268, 294, 161, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 128, 296, 312, 148, 276, 277, 329,
+	263, 130, 310, 292, 215, 190, 191, 129, 0, 260,
+	165, 175, 160, 232, 0, 174, 252, 307, 308, 159,
+	331, 138, 322, 132, 139, 321, 226, 0, 225, 324,
+	303, 311, 216, 208, 0, 131, 309, 214, 207, 195,
+	170, 182, 248, 203, 249, 183, 221, 220, 222, 205,
+	209, 0, 0, 0, 290, 318, 332, 144, 0, 278,
+	300, 0, 0, 145, 173, 169, 247, 224, 140, 185,
+	287, 194, 201, 259, 330, 236, 266, 149, 317, 286,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 122, 133, 198, 0, 257, 172, 319, 0,
+	164, 0, 0, 0, 0, 0, 0, 124, 125, 134,
+	143, 150, 163, 168, 171, 177, 181, 184, 186, 187,
+	188, 192, 206, 210, 211, 212, 213, 227, 228, 230,
+	231, 234, 235, 238, 240, 241, 242, 244, 245, 246,
+	251, 254, 256, 258, 261, 267, 269, 270, 271, 273,
+	274, 275, 280, 281, 282, 283, 291, 295, 305, 306,
+	316, 325, 327, 298, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
+	0, 0, 0, 954, 166, 0, 0, 0, 0, 196,
+	0, 0, 157, 204, 202, 0, 0, 0, 239, 297,
+	0, 0, 0, 0, 199, 0, 0, 320, 288, 218,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 120, 0, 0,
+	0, 0, 0, 0, 0, 0, 147, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 126, 0, 0,
+	0, 0, 176, 219, 137, 0, 0, 0, 189, 326,
+	0, 0, 0, 253, 0, 293, 179, 197, 141, 123,
+	135, 151, 178, 229, 262, 272, 0, 0, 0, 0,
+	0, 158, 0, 265, 237, 315, 0, 0, 243, 264,
+	200, 304, 255, 313, 314, 180, 0, 323, 328, 285,
+	167, 0, 127, 0, 250, 162, 193, 0, 0, 0,
+	155, 0, 0, 0, 284, 302, 142, 299, 217, 223,
+	152, 154, 153, 136, 279, 301, 146, 156, 289, 268,
+	294, 161, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 128, 296, 312, 148, 276, 277, 329, 263, 130,
+	310, 292, 215, 190, 191, 129, 0, 260, 165, 175,
+	160, 232, 0, 174, 252, 307, 308, 159, 331, 138,
+	322, 132, 139, 321, 226, 0, 225, 324, 303, 311,
+	216, 208, 0, 131, 309, 214, 207, 195, 170, 182,
+	248, 203, 249, 183, 221, 220, 222, 205, 209, 0,
+	0, 0, 290, 318, 332, 144, 0, 278, 300, 0,
+	0, 145, 173, 169, 247, 224, 140, 185, 287, 194,
+	201, 259, 330, 236, 266, 149, 317, 286, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	122, 133, 198, 0, 257, 172, 319, 0, 164, 0,
+	0, 0, 0, 0, 0, 124, 125, 134, 143, 150,
+	163, 168, 171, 177, 181, 184, 186, 187, 188, 192,
+	206, 210, 211, 212, 213, 227, 228, 230, 231, 234,
+	235, 238, 240, 241, 242, 244, 245, 246, 251, 254,
+	256, 258, 261, 267, 269, 270, 271, 273, 274, 275,
+	280, 281, 282, 283, 291, 295, 305, 306, 316, 325,
+	327, 298, 0, 0, 0, 531, 0, 0, 0, 0,
+	0, 0, 233, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 166, 0, 0, 0, 0, 196, 0, 0,
+	157, 204, 202, 0, 0, 0, 239, 297, 0, 0,
+	0, 0, 199, 0, 0, 320, 288, 218, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 120, 0, 0, 0, 0,
+	0, 0, 0, 0, 147, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 126, 0, 0, 0, 0,
+	176, 219, 137, 0, 0, 0, 189, 326, 0, 0,
+	0, 253, 0, 293, 179, 197, 141, 123, 135, 151,
+	178, 229, 262, 272, 0, 0, 0, 0, 0, 158,
+	0, 265, 237, 315, 0, 0, 243, 264, 200, 304,
+	255, 313, 314, 180, 0, 323, 328, 285, 167, 0,
+	127, 0, 250, 162, 193, 0, 0, 0, 155, 0,
+	0, 0, 284, 302, 142, 299, 217, 223, 152, 154,
+	153, 136, 279, 301, 146, 156, 289, 268, 294, 161,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 128,
+	296, 312, 148, 276, 277, 329, 263, 130, 310, 292,
+	215, 190, 191, 129, 0, 260, 165, 175, 160, 232,
+	0, 174, 252, 307, 308, 159, 331, 138, 322, 132,
+	139, 321, 226, 0, 225, 324, 303, 311, 216, 208,
+	0, 131, 309, 214, 207, 195, 170, 182, 248, 203,
+	249, 183, 221, 220, 222, 205, 209, 0, 0, 0,
+	290, 318, 332, 144, 0, 278, 300, 0, 0, 145,
+	173, 169, 247, 224, 140, 185, 287, 194, 201, 259,
+	330, 236, 266, 149, 317, 286, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 122, 133,
+	198, 0, 257, 172, 319, 0, 164, 0, 0, 0,
+	0, 0, 0, 124, 125, 134, 143, 150, 163, 168,
+	171, 177, 181, 184, 186, 187, 188, 192, 206, 210,
+	211, 212, 213, 227, 228, 230, 231, 234, 235, 238,
+	240, 241, 242, 244, 245, 246, 251, 254, 256, 258,
+	261, 267, 269, 270, 271, 273, 274, 275, 280, 281,
+	282, 283, 291, 295, 305, 306, 316, 325, 327, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	233, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	166, 0, 0, 0, 0, 196, 0, 0, 157, 204,
+	202, 0, 0, 0, 239, 297, 0, 0, 0, 0,
+	199, 0, 0, 320, 288, 218, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 120, 0, 0, 0, 0, 0, 0,
+	0, 0, 147, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 126, 0, 0, 0, 0, 176, 219,
+	137, 0, 117, 0, 189, 326, 0, 0, 0, 253,
+	0, 293, 179, 197, 141, 123, 135, 151, 178, 229,
+	262, 272, 0, 0, 0, 0, 0, 158, 0, 265,
+	237, 315, 0, 0, 243, 264, 200, 304, 255, 313,
+	314, 180, 0, 323, 328, 285, 167, 0, 127, 0,
+	250, 162, 193, 0, 0, 0, 155, 0, 0, 0,
+	284, 302, 142, 299, 217, 223, 152, 154, 153, 136,
+	279, 301, 146, 156, 289, 268, 294, 161, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 128, 296, 312,
+	148, 276, 277, 329, 263, 130, 310, 292, 215, 190,
+	191, 129, 0, 260, 165, 175, 160, 232, 0, 174,
+	252, 307, 308, 159, 331, 138, 322, 132, 139, 321,
+	226, 0, 225, 324, 303, 311, 216, 208, 0, 131,
+	309, 214, 207, 195, 170, 182, 248, 203, 249, 183,
+	221, 220, 222, 205, 209, 0, 0, 0, 290, 318,
+	332, 144, 0, 278, 300, 0, 0, 145, 173, 169,
+	247, 224, 140, 185, 287, 194, 201, 259, 330, 236,
+	266, 149, 317, 286, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 122, 133, 198, 0,
+	257, 172, 319, 0, 164, 0, 0, 0, 0, 0,
+	0, 124, 125, 134, 143, 150, 163, 168, 171, 177,
+	181, 184, 186, 187, 188, 192, 206, 210, 211, 212,
+	213, 227, 228, 230, 231, 234, 235, 238, 240, 241,
+	242, 244, 245, 246, 251, 254, 256, 258, 261, 267,
+	269, 270, 271, 273, 274, 275, 280, 281, 282, 283,
+	291, 295, 305, 306, 316, 325, 327, 298, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 233, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 166, 0,
+	0, 0, 0, 196, 0, 0, 157, 204, 202, 0,
+	0, 0, 239, 297, 0, 0, 0, 0, 199, 0,
+	0, 320, 288, 218, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 568, 0, 0, 0, 0, 0, 0, 0, 0,
+	147, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 126, 0, 0, 0, 0, 176, 219, 137, 0,
+	0, 0, 189, 326, 0, 0, 0, 253, 0, 293,
+	179, 197, 141, 123, 135, 151, 178, 229, 262, 272,
+	0, 0, 0, 0, 0, 158, 0, 265, 237, 315,
+	0, 0, 243, 264, 200, 304, 255, 313, 314, 180,
+	0, 323, 328, 285, 167, 0, 127, 0, 250, 162,
+	193, 0, 0, 0, 155, 0, 0, 0, 284, 302,
+	142, 299, 217, 223, 152, 154, 153, 136, 279, 301,
+	146, 156, 289, 268, 294, 161, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 128, 296, 312, 148, 276,
+	277, 329, 263, 130, 310, 292, 215, 190, 191, 129,
+	0, 260, 165, 175, 160, 232, 0, 174, 252, 307,
+	308, 159, 331, 138, 322, 132, 139, 321, 226, 0,
+	225, 324, 303, 311, 216, 208, 0, 131, 309, 214,
+	207, 195, 170, 182, 248, 203, 249, 183, 221, 220,
+	222, 205, 209, 0, 0, 0, 290, 318, 332, 144,
+	0, 278, 300, 0, 0, 145, 173, 169, 247, 224,
+	140, 185, 287, 194, 201, 259, 330, 236, 266, 149,
+	317, 286, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 122, 133, 198, 0, 257, 172,
+	319, 0, 164, 0, 0, 0, 0, 0, 0, 124,
+	125, 134, 143, 150, 163, 168, 171, 177, 181, 184,
+	186, 187, 188, 192, 206, 210, 211, 212, 213, 227,
+	228, 230, 231, 234, 235, 238, 240, 241, 242, 244,
+	245, 246, 251, 254, 256, 258, 261, 267, 269, 270,
+	271, 273, 274, 275, 280, 281, 282, 283, 291, 295,
+	305, 306, 316, 325, 327, 298, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 233, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 166, 0, 0, 0,
+	0, 196, 0, 0, 157, 204, 202, 0, 0, 0,
+	239, 297, 0, 0, 0, 0, 199, 0, 0, 320,
+	288, 218, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 120,
+	0, 0, 0, 0, 0, 0, 0, 0, 147, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 126,
+	0, 0, 0, 0, 176, 219, 137, 0, 0, 0,
+	189, 326, 0, 0, 0, 253, 0, 293, 179, 197,
+	141, 123, 135, 151, 178, 229, 262, 272, 0, 0,
+	0, 0, 0, 158, 0, 265, 237, 315, 0, 0,
+	243, 264, 200, 304, 255, 313, 314, 180, 0, 323,
+	328, 285, 167, 0, 127, 0, 250, 162, 193, 0,
+	0, 0, 155, 0, 0, 0, 284, 302, 142, 299,
+	217, 223, 152, 154, 153, 136, 279, 301, 146, 156,
+	289, 268, 294, 161, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 128, 296, 312, 148, 276, 277, 329,
+	263, 130, 310, 292, 215, 190, 191, 129, 0, 260,
+	165, 175, 160, 232, 0, 174, 252, 307, 308, 159,
+	331, 138, 322, 132, 139, 321, 226, 0, 225, 324,
+	303, 311, 216, 208, 0, 131, 309, 214, 207, 195,
+	170, 182, 248, 203, 249, 183, 221, 220, 222, 205,
+	209, 0, 0, 0, 290, 318, 332, 144, 0, 278,
+	300, 0, 0, 145, 173, 169, 247, 224, 140, 185,
+	287, 194, 201, 259, 330, 236, 266, 149, 317, 286,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 122, 133, 198, 0, 257, 172, 319, 0,
+	164, 0, 0, 0, 0, 0, 0, 124, 125, 134,
+	143, 150, 163, 168, 171, 177, 181, 184, 186, 187,
+	188, 192, 206, 210, 211, 212, 213, 227, 228, 230,
+	231, 234, 235, 238, 240, 241, 242, 244, 245, 246,
+	251, 254, 256, 258, 261, 267, 269, 270, 271, 273,
+	274, 275, 280, 281, 282, 283, 291, 295, 305, 306,
+	316, 325, 327, 298, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 166, 0, 0, 0, 0, 196,
+	0, 0, 157, 204, 202, 0, 0, 0, 239, 297,
+	0, 0, 0, 0, 199, 0, 0, 320, 288, 218,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 438, 0, 0,
+	0, 0, 0, 0, 0, 0, 147, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 126, 0, 0,
+	0, 0, 176, 219, 137, 0, 0, 0, 189, 326,
+	0, 0, 0, 253, 0, 293, 179, 197, 141, 123,
+	135, 151, 178, 229, 262, 272, 0, 0, 0, 0,
+	0, 158, 0, 265, 237, 315, 0, 0, 243, 264,
+	200, 304, 255, 313, 314, 180, 0, 323, 328, 285,
+	167, 0, 127, 0, 250, 162, 193, 0, 0, 0,
+	155, 0, 0, 0, 284, 302, 142, 299, 217, 223,
+	152, 154, 153, 136, 279, 301, 146, 156, 289, 268,
+	294, 161, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 128, 296, 312, 148, 276, 277, 329, 263, 130,
+	310, 292, 215, 190, 191, 129, 0, 260, 165, 175,
+	160, 232, 0, 174, 252, 307, 308, 159, 331, 138,
+	322, 132, 139, 321, 226, 0, 225, 324, 303, 311,
+	216, 208, 0, 131, 309, 214, 207, 195, 170, 182,
+	248, 203, 249, 183, 221, 220, 222, 205, 209, 0,
+	0, 0, 290, 318, 332, 144, 0, 278, 300, 0,
+	0, 145, 173, 169, 247, 224, 140, 185, 287, 194,
+	201, 259, 330, 236, 266, 149, 317, 286, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	122, 133, 198, 0, 257, 172, 319, 0, 164, 0,
+	0, 0, 0, 0, 0, 124, 125, 134, 143, 150,
+	163, 168, 171, 177, 181, 184, 186, 187, 188, 192,
+	206, 210, 211, 212, 213, 227, 228, 230, 231, 234,
+	235, 238, 240, 241, 242, 244, 245, 246, 251, 254,
+	256, 258, 261, 267, 269, 270, 271, 273, 274, 275,
+	280, 281, 282, 283, 291, 295, 305, 306, 316, 325,
+	327, 298, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 233, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 166, 0, 0, 0, 0, 196, 0, 0,
+	157, 204, 202, 0, 0, 0, 239, 297, 0, 0,
+	0, 0, 199, 0, 0, 320, 288, 218, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 438, 0, 0, 0, 0,
+	0, 0, 0, 0, 147, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 126, 0, 0, 0, 0,
+	176, 219, 137, 0, 0, 0, 189, 326, 0, 0,
+	0, 253, 0, 293, 179, 197, 141, 123, 135, 151,
+	178, 229, 262, 272, 0, 0, 0, 0, 0, 158,
+	0, 265, 237, 315, 0, 0, 243, 264, 200, 304,
+	255, 313, 314, 180, 0, 323, 328, 285, 167, 0,
+	127, 0, 250, 162, 193, 0, 0, 0, 155, 0,
+	0, 0, 284, 302, 142, 299, 217, 223, 152, 154,
+	153, 136, 279, 301, 146, 156, 289, 268, 294, 161,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 128,
+	296, 312, 148, 276, 277, 329, 263, 130, 310, 292,
+	215, 190, 191, 129, 0, 260, 165, 175, 160, 232,
+	0, 174, 252, 307, 308, 159, 331, 138, 322, 132,
+	551, 321, 226, 0, 225, 324, 303, 311, 216, 208,
+	0, 131, 309, 214, 207, 195, 170, 182, 248, 203,
+	249, 183, 221, 220, 222, 547, 209, 0, 0, 0,
+	290, 318, 332, 144, 0, 278, 300, 0, 0, 145,
+	173, 169, 247, 552, 550, 541, 542, 194, 201, 259,
+	330, 236, 266, 149, 317, 286, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 122, 133,
+	198, 0, 257, 172, 319, 0, 164, 0, 0, 0,
+	0, 0, 0, 124, 125, 134, 143, 150, 163, 168,
+	171, 177, 181, 184, 186, 187, 188, 192, 206, 210,
+	211, 212, 213, 227, 228, 230, 231, 234, 235, 238,
+	240, 241, 242, 244, 548, 549, 251, 254, 256, 258,
+	261, 267, 269, 270, 271, 273, 274, 275, 280, 281,
+	282, 283, 291, 295, 305, 306, 316, 325, 327, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	233, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	166, 0, 0, 0, 0, 196, 0, 0, 157, 204,
+	202, 0, 0, 0, 239, 297, 0, 0, 0, 0,
+	199, 0, 0, 320, 288, 218, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 438, 0, 0, 0, 0, 0, 0,
+	0, 0, 147, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 126, 0, 0, 0, 0, 176, 219,
+	137, 0, 0, 0, 189, 326, 0, 0, 0, 253,
+	0, 293, 179, 197, 141, 123, 135, 151, 178, 229,
+	262, 272, 0, 0, 0, 0, 0, 158, 0, 265,
+	237, 315, 0, 0, 243, 264, 200, 304, 255, 313,
+	314, 180, 0, 323, 328, 285, 167, 0, 127, 0,
+	250, 162, 193, 0, 0, 0, 155, 0, 0, 0,
+	284, 302, 142, 299, 217, 223, 152, 154, 153, 136,
+	279, 301, 146, 156, 289, 268, 294, 161, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 128, 296, 979,
+	148, 276, 277, 329, 263, 130, 310, 292, 215, 190,
+	191, 129, 0, 260, 165, 175, 160, 232, 0, 174,
+	252, 307, 308, 159, 331, 138, 322, 132, 139, 321,
+	226, 0, 225, 324, 303, 311, 216, 208, 0, 131,
+	309, 214, 207, 195, 170, 182, 248, 203, 249, 183,
+	221, 220, 222, 205, 209, 0, 0, 0, 290, 318,
+	332, 144, 0, 278, 300, 0, 0, 145, 173, 169,
+	247, 224, 140, 185, 287, 194, 201, 259, 330, 236,
+	266, 149, 317, 286, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 122, 133, 198, 0,
+	257, 172, 319, 0, 164, 0, 0, 0, 0, 0,
+	0, 124, 125, 134, 143, 150, 163, 168, 171, 177,
+	181, 184, 186, 187, 188, 192, 206, 210, 211, 212,
+	213, 227, 228, 230, 231, 234, 235, 238, 240, 241,
+	242, 244, 245, 246, 251, 254, 256, 258, 261, 267,
+	269, 270, 271, 273, 274, 275, 280, 281, 282, 283,
+	291, 295, 305, 306, 316, 325, 327, 298, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 233, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 166, 0,
+	0, 0, 0, 196, 0, 0, 157, 204, 202, 0,
+	0, 0, 239, 297, 0, 0, 0, 0, 199, 0,
+	0, 320, 288, 218, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 438, 0, 0, 0, 0, 0, 0, 0, 0,
+	147, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 126, 0, 0, 0, 0, 176, 219, 137, 0,
+	0, 0, 189, 326, 0, 0, 0, 253, 0, 293,
+	179, 197, 141, 123, 135, 151, 178, 229, 262, 272,
+	0, 0, 0, 0, 0, 158, 0, 265, 237, 315,
+	0, 0, 243, 264, 200, 304, 255, 313, 314, 180,
+	0, 323, 328, 285, 167, 0, 127, 0, 250, 162,
+	193, 0, 0, 0, 155, 0, 0, 0, 284, 302,
+	142, 299, 217, 223, 152, 154, 153, 136, 279, 301,
+	146, 156, 289, 268, 294, 161, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 128, 296, 538, 148, 276,
+	277, 329, 263, 130, 310, 292, 215, 190, 191, 129,
+	0, 260, 165, 175, 160, 232, 0, 174, 252, 307,
+	308, 159, 331, 138, 322, 132, 551, 321, 226, 0,
+	225, 324, 303, 311, 216, 208, 0, 131, 309, 214,
+	207, 195, 170, 182, 248, 203, 249, 183, 221, 220,
+	222, 547, 209, 0, 0, 0, 290, 318, 332, 144,
+	0, 278, 300, 0, 0, 145, 173, 169, 247, 552,
+	550, 541, 542, 194, 201, 259, 330, 236, 266, 149,
+	317, 286, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 122, 133, 198, 0, 257, 172,
+	319, 0, 164, 0, 0, 0, 0, 0, 0, 124,
+	125, 134, 143, 150, 163, 168, 171, 177, 181, 184,
+	186, 187, 188, 192, 206, 210, 211, 212, 213, 227,
+	228, 230, 231, 234, 235, 238, 240, 241, 242, 244,
+	548, 549, 251, 254, 256, 258, 261, 267, 269, 270,
+	271, 273, 274, 275, 280, 281, 282, 283, 291, 295,
+	305, 306, 316, 325, 327, 34, 0, 70, 37, 38,
+	0, 0, 0, 34, 0, 70, 37, 38, 0, 61,
+	0, 0, 0, 0, 0, 76, 0, 61, 0, 39,
+	0, 0, 0, 76, 0, 0, 0, 39, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 79, 0, 0,
+	0, 0, 0, 0, 0, 79, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2117, 0, 0, 0, 0, 2309, 0, 0, 2117, 0,
+	0, 0, 0, 2292, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 41,
+	72, 45, 44, 47, 0, 0, 0, 41, 72, 45,
+	44, 47, 0, 0, 0, 2118, 0, 0, 0, 0,
+	0, 0, 0, 2118, 0, 0, 0, 0, 0, 0,
+	0, 48, 75, 74, 0, 0, 0, 0, 46, 48,
+	75, 74, 0, 0, 0, 0, 46, 0, 34, 0,
+	70, 37, 38, 0, 0, 0, 34, 0, 70, 37,
+	38, 0, 61, 0, 0, 0, 0, 0, 76, 0,
+	61, 0, 39, 0, 0, 0, 76, 0, 0, 0,
+	39, 59, 60, 0, 2119, 0, 0, 0, 0, 59,
+	60, 0, 2119, 0, 2120, 73, 0, 52, 53, 63,
+	0, 64, 2120, 73, 0, 52, 53, 63, 0, 64,
+	79, 2281, 0, 0, 0, 0, 0, 0, 79, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2117, 0, 0, 0, 0, 0, 0,
+	0, 2117, 0, 0, 0, 0, 2233, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 41, 72, 45, 44, 47, 0, 0, 0,
+	41, 72, 45, 44, 47, 0, 0, 0, 2118, 0,
+	0, 71, 0, 0, 0, 0, 2118, 0, 0, 71,
+	0, 0, 0, 0, 48, 75, 74, 0, 0, 0,
+	0, 46, 48, 75, 74, 0, 0, 0, 0, 46,
+	0, 34, 0, 70, 37, 38, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 61, 77, 0, 0, 0,
+	0, 76, 0, 0, 77, 39, 0, 0, 0, 0,
+	0, 0, 0, 0, 59, 60, 0, 2119, 0, 0,
+	0, 0, 59, 60, 0, 2119, 0, 2120, 73, 0,
+	52, 53, 63, 0, 64, 2120, 73, 0, 52, 53,
+	63, 0, 64, 79, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2117, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 41, 72, 45, 44, 47,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2118, 0, 0, 71, 0, 0, 0, 0, 0,
+	0, 0, 71, 0, 0, 0, 0, 48, 75, 74,
+	0, 0, 0, 0, 46, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 77,
+	0, 0, 0, 0, 0, 0, 0, 77, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 59, 60, 0,
+	2119, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2120, 73, 0, 52, 53, 63, 0, 64, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 71, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 77,
+}
+var yyPact = [...]int{
+
+	3393, -1000, -296, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1479, -1000, -1000, -1000, -1000, -1000, -1000,
+	609, 235, -1000, -1000, 281, 78, 21512, 276, 277, 22368,
+	-1000, -1000, -1000, 128, 223, 22368, -1000, -1000, -1000, 231,
+	323, 1001, 1326, 999, 47, -59, -67, -1000, 1532, 1535,
+	-1000, -1000, 258, 56, -1000, -1000, -1000, 17230, 167, -1000,
+	-1000, -1000, 1456, 1476, 1222, -1000, 11666, 252, 252, 21084,
+	24080, -1000, 1531, 22368, 10380, -1000, 271, 22368, -147, 249,
+	249, 157, 275, -1000, 540, -1000, -1000, -1000, -1000, 22368,
+	250, 21940, 250, 250, 250, 250, 250, 22368, -1000, 436,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 22368, 992, 1390, 583, 188, 7363, 7363,
+	-1000, 597, -1000, 152, 150, 145, 149, 60, 608, -1000,
+	7363, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 224, 262,
+	176, 167, 533, -1000, -1000, -1000, -1000, -1000, 1389, 1363,
+	693, 1353, 517, 1344, 1155, -31, -1000, 990, 22368, -1000,
+	-1000, 1162, 1446, 274, 22368, -1000, -1000, 1080, -1000, 1124,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 856, 1348, 625, 14234, 1411, -1000, -1000, 591,
+	1514, -1000, 16374, 433, -1000, 14234, 2453, 1088, -1000, -1000,
+	1088, -1000, -1000, -1000, 300, -1000, -1000, 15518, 15518, 15518,
+	15518, 15518, 15518, 15518, 15518, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1088,
+	1088, 1088, 1088, 1088, 1088, 1088, 1088, 1088, 1088, 1088,
+	1088, 1088, 1088, 1088, 1088, 1088, 1088, 1088, 1088, 1088,
+	1088, 1088, 1088, 1088, 1088, 1088, 1088, -1000, -1000, 1088,
+	-1000, 11238, 1088, 1088, 1088, 1088, 1088, 1088, 1088, 1088,
+	1088, 1088, 14234, 1088, 1088, 1088, 1088, 1088, 1088, 1088,
+	1088, 1088, 1088, 1088, 1088, 1088, 1088, 1088, 1088, 20656,
+	19800, 22368, 1067, 1002, -1000, -1000, 432, 1077, -71, 23652,
+	-1000, -1000, -1000, -1000, 22796, 19372, 532, -1000, -1000, -1000,
+	-1000, 1341, -1000, -1000, 427, -1000, 1479, -1000, -1000, 998,
+	211, -1000, 3016, 3902, -1000, -1000, -1000, 1142, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 21940, 1434, 237, 984, 574,
+	983, 982, 980, 249, 979, 1073, 261, 22368, 1417, 1181,
+	22368, 978, 974, 973, 972, -1000, 9949, -1000, 7363, 583,
+	-1000, 782, 14234, 249, 249, 7363, 7363, 7363, 22368, 22368,
+	22368, -1000, -1000, -1000, -1000, 22368, -1000, -1000, 583, 583,
+	7363, 7363, 582, 1509, 582, 582, -1000, -1000, -1000, -1000,
+	14234, -1000, 15518, -1000, -1000, 971, 202, -1000, -1000, -1000,
+	-1000, -1000, -1000, 965, 517, 517, -1000, 777, 517, 1061,
+	-1000, 531, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 517, -1000, 13806, -293, -1000, -1000,
+	1072, -1000, 280, 1222, -1000, -1000, 167, -1000, -1000, 22368,
+	7363, 17230, 1088, 21940, -1000, -1000, -1000, 1523, 484, 1085,
+	-1000, -1000, 1069, -1000, 736, 424, 767, 1241, -1000, -1000,
+	-1000, 22368, -1000, 14234, 14234, 712, -1000, 17658, -1000, -1000,
+	-1000, -1000, 8225, 492, 15518, 678, 529, 15518, 15518, 15518,
+	15518, 15518, 15518, 15518, 15518, 15518, 15518, 15518, 15518, 15518,
+	15518, 15518, 757, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 963, -1000, 167, 1210, 1210, 461, 461, 461, 461,
+	461, 461, 461, 18086, 1444, 12522, 12522, 12522, 1444, 856,
+	856, 12522, 12522, 12522, 12522, 12522, 12522, 1444, 1444, 12522,
+	856, 856, 856, 856, 12522, 12522, 12522, 12522, 1444, 12522,
+	12522, 12522, 1444, 856, 970, 518, 11238, 12522, 12522, 856,
+	14234, 14234, 13378, 12950, 12522, 12522, 1444, 547, 518, 22796,
+	-1000, -1000, 15090, -1000, -1000, -1000, -1000, -1000, 856, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 21940, 21940, 12522,
+	12522, 12522, 12522, 171, 22368, -1000, 1060, 1101, -1000, -1000,
+	-1000, 1431, 18515, 15946, -1000, 171, 1032, 19800, 22368, -1000,
+	-1000, 19800, 22368, 7794, 23224, 1049, -1000, -84, -128, -71,
+	-1000, -1000, 443, -1000, -1000, -1000, 10809, -1000, 9087, 1456,
+	1222, 5639, 9518, -1000, 3902, 1142, -1000, -45, -1000, -1000,
+	-1000, 1110, -1000, 1110, 189, 12, 1110, 1110, 1110, 1110,
+	1110, -5, -5, -5, -5, 13, -1000, -1000, -1000, -1000,
+	-1000, 1126, 1125, -1000, 1110, 1110, 1110, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1120, 203, 1113, 1113, 1113,
+	1113, 1113, 218, 218, -1000, 14234, 1159, -1000, 22368, 7363,
+	1415, 7363, 158, 1123, 22368, -1000, 22368, 22368, 1068, -1000,
+	22368, 1066, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 518, 961, 951, -1000, -1000, -1000, -1000,
+	-1000, -1000, 596, -1000, -1000, -1000, -1000, 583, 22368, 22368,
+	22368, 1429, 583, 518, 778, -1000, -1000, 949, -1000, 1061,
+	1061, -1000, 1061, 517, 1210, 1061, -1000, 958, 1403, 769,
+	22368, -1000, 17230, -33, -1000, -98, 1444, 856, 143, -1000,
+	-1000, -1000, 168, 900, 413, -1000, 1236, 625, 625, 14234,
+	-1000, -1000, -1000, 9087, 1461, -1000, 1252, 1248, 1191, -1000,
+	-1000, 492, 512, -1000, -1000, 602, -1000, -1000, -1000, -1000,
+	313, 1088, -1000, 2982, -1000, -1000, -1000, -1000, 678, 15518,
+	15518, 15518, 778, 2982, 3053, 664, 797, 461, 496, 496,
+	470, 470, 470, 470, 470, 579, 579, -1000, -1000, -1000,
+	856, -1000, -1000, -1000, 12522, 942, -1000, -1000, 518, 303,
+	942, 942, 12522, -163, -163, 856, 942, 942, 942, 856,
+	942, 12522, 12522, 942, -163, -163, -163, -163, 942, 942,
+	942, 942, 12522, 942, 942, 942, 12522, -1000, 14234, -1000,
+	856, 942, 942, -1000, 702, 850, 536, 1496, 942, 428,
+	1492, 942, 942, 942, 12522, 562, -1000, 14234, 856, -1000,
+	2351, 1055, 1053, 942, 856, 1052, 942, 942, 175, 1088,
+	-1000, 22796, 19800, 19800, 19800, 19800, 19800, -1000, 1213, 1206,
+	-1000, 1214, 1212, 1242, 17230, 956, 856, 138, 18515, -1000,
+	1088, -1000, 16802, 481, 269, 268, 267, 1490, 19800, 1063,
+	-1000, 1063, -1000, 298, -1000, -1000, 22796, -71, -99, -1000,
+	-1000, 1049, -1000, 758, -1000, -1000, 518, -1000, 295, 1348,
+	1444, 1047, 5208, -1000, -1000, -1000, -1000, 211, -1000, -1000,
+	-1000, 1122, 3428, -1000, 1287, 420, 519, 876, 1260, -1000,
+	-1000, 543, -51, -1000, -1000, 669, -5, -5, 1110, 1110,
+	185, 1110, -1000, -5, -1000, -1000, -1000, 443, 1335, 443,
+	443, 443, 443, -5, 766, 766, -1000, -1000, -1000, -1000,
+	661, -1000, 1120, -1000, 657, -1000, -1000, -1000, -1000, -1000,
+	-1000, 554, 1180, 21940, 167, 1428, -1000, -1000, -1000, 1506,
+	-1000, -1000, 270, -1000, 260, -1000, 7363, 22368, 7363, 7363,
+	1490, 944, 921, -1000, -1000, -1000, 582, 583, 1329, -1000,
+	-1000, 15518, -1000, -1000, -1000, -1000, 171, 273, -1000, -1000,
+	-66, -1000, -1000, 1241, -1000, 1046, -1000, -1000, 593, 612,
+	535, 212, 212, -1000, 500, 212, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 166, 1419, 21940, 21940, 1227, -1000,
+	-1000, -1000, 22368, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 6932, 12522, -1000, 778, 2982, 2687, -1000, 15518,
+	-1000, 942, 12522, -163, 6501, -163, -163, 942, -1000, 20228,
+	-1000, -163, -163, -163, -163, -163, -163, 942, 942, -163,
+	-1000, -1000, -1000, -1000, -163, -163, -163, -163, 942, -163,
+	-163, -163, 856, 518, -1000, -1000, -1000, 429, 757, 429,
+	15518, 15518, -1000, 15518, 15518, -1000, -200, -1000, 1081, 537,
+	-1000, 14234, 772, -1000, -1000, 15518, 15518, -1000, -1000, -1000,
+	-1000, 1174, 22796, 1088, -1000, 18944, 21940, 1076, -1000, 530,
+	1101, 1119, 1170, 883, -1000, -1000, -1000, -1000, 1202, -1000,
+	1196, -1000, -1000, 1102, -1000, -1000, 1045, 1088, 21940, 15518,
+	481, -1000, 1088, 1088, 1088, 1479, 14234, 1063, -1000, -1000,
+	288, -1000, -1000, -92, -133, -1000, -1000, -1000, 8656, -1000,
+	5639, -1000, 5639, -1000, 21940, 236, -1000, 876, -1000, -1000,
+	876, -1000, -1000, -1000, 1114, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 581, 15518, 1521, -1000, 1282, -1000, 1274, 764,
+	-1000, -1000, 1014, 443, 443, -5, -1000, -1000, 1110, -1000,
+	443, -1000, 498, -1000, -1000, -1000, -1000, 443, 939, -1000,
+	926, 1044, -1000, 920, 57, 22368, -1000, -1000, -1000, 1169,
+	-1000, -1000, -1000, 1013, 1040, -1000, 3016, 916, 914, 913,
+	22368, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 583, -1000,
+	15518, 2982, -5, 22368, -1000, 1191, 143, -1000, 807, -1000,
+	876, 314, -1000, -1000, 225, -1000, 1260, -1000, 2980, 912,
+	-1000, 909, 907, 21940, 1273, 1270, 906, 22368, 21940, -1000,
+	-1000, 881, 905, 14234, -1000, 21940, 21940, 1088, 293, -1000,
+	-1000, -1000, 962, 11666, -1000, -1000, 856, -1000, 15518, 2982,
+	-163, -1000, -1000, -1000, -1000, 284, -1000, -1000, -163, -1000,
+	327, -1000, -1000, -1000, -1000, -1000, -1000, -163, -163, -1000,
+	-1000, -1000, -1000, -1000, -163, -1000, -1000, -1000, -1000, 856,
+	1110, 1110, -1000, 1110, 1113, -1000, 1110, 32, 1110, 18,
+	856, 856, 2956, 2812, 2628, 2596, 1088, -157, -1000, 518,
+	14234, 2409, 1103, -1000, 1398, 1016, 1039, -1000, -1000, 12094,
+	856, 900, 897, -1000, 1479, 22796, 14234, -1000, -1000, 14234,
+	1112, -1000, 14234, -1000, -1000, -1000, -1000, 21940, 135, -1000,
+	14234, 897, 1087, -1000, 21940, 21940, 21940, 1456, 518, -1000,
+	-1000, -1000, -1000, 5208, -1000, 895, -1000, 1110, 1268, -1000,
+	1260, -1000, -1000, 21940, -1000, 2982, -30, -1000, -1000, -1000,
+	-1000, -1000, -1000, 443, -1000, -1000, -1000, -1000, -1000, -5,
+	760, -5, 656, -1000, 637, -1000, -1000, -241, 1109, -1000,
+	167, 22368, 67, 270, -1000, 3016, 3016, 3016, -1000, -1000,
+	2982, -50, -1000, -1000, -1000, 881, 208, 3016, -1000, 1159,
+	420, 1259, 194, -1000, -1000, -1000, -1000, -1000, 882, -1000,
+	400, -1000, 254, 208, 881, 518, 439, 1400, -1000, 21940,
+	1488, 19800, -1000, -1000, -1000, 2982, -1000, 6070, -1000, 856,
+	1473, -1000, -1000, -1000, -1000, -1000, 187, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 15518, 15518, 15518, 15518, 15518,
+	856, 751, 518, 15518, 15518, 1257, -1000, 1088, -1000, -1000,
+	163, -1000, 21940, 1456, -1000, 518, 518, 21940, 518, 892,
+	-1000, 1088, 4317, -1000, 17230, 889, 889, 889, -1000, 388,
+	21940, 1412, -1000, 886, -1000, -1000, 443, -1000, 443, 901,
+	865, -1000, 21940, -1000, 1450, -1000, 67, -1000, 747, 112,
+	118, -1000, 109, 107, 104, 101, 96, -1000, -1000, -1000,
+	-1000, 1318, 1312, 1070, 861, -1000, -1000, 880, -1000, 1108,
+	876, -1000, 1107, -1000, 867, -1000, -1000, 21940, -1000, 208,
+	1395, 1393, 1088, -1000, 1483, 1470, 1063, 11666, -1000, -1000,
+	-1000, 14234, -1000, -1000, 2351, 2351, 2351, 2351, 85, -1000,
+	-1000, 2351, 2351, 1520, -1000, 1088, -1000, 167, -1000, -1000,
+	864, -1000, 21940, -1000, -1000, 481, -1000, -1000, -1000, 388,
+	-1000, 809, 500, 741, -1000, -1000, 191, -1000, -1000, -1000,
+	-1000, 860, -1000, 140, 24855, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1325, 1324, 123, 226, 1295, 1300, 1469, 19800, -1000,
+	-1000, 543, 21940, 1159, 21940, -1000, -1000, -1000, 15518, -1000,
+	165, -1000, 14234, 14234, 1488, -1000, 1062, -1000, -1000, -1000,
+	-1000, 856, 102, -208, -1000, -1000, 22796, 1039, 856, -1000,
+	-1000, -1000, -1000, -1000, 607, -1000, 22368, 388, 129, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 14234, 14234, 4777,
+	24855, -1000, -1000, -1000, -1000, 1102, 601, 1312, 1467, 1305,
+	1303, -1000, 726, 1063, 855, 1099, 834, 2982, 829, -1000,
+	21940, -1000, 518, 1033, -1000, 518, 1483, -1000, 856, -1000,
+	1225, -203, -212, 1031, -1000, -1000, 1097, -1000, 388, 130,
+	-1000, 762, 722, 80, 72, 2754, -1000, -1000, -1000, -1000,
+	-297, -1000, -1000, 1314, -1000, 714, -1000, 1465, 1463, -1000,
+	1488, 388, 21940, 388, -1000, 165, 1246, 14234, -1000, -1000,
+	-1000, 1219, -1000, 21940, -1000, 796, 719, 614, -1000, 14234,
+	24855, 1443, 1441, 1416, 1392, 8656, 24690, -1000, -1000, 699,
+	674, 1483, -1000, 813, -1000, -1000, 162, -1000, -206, 787,
+	219, -1000, -1000, 560, 24855, -1000, 717, -298, 233, 167,
+	234, 15518, -1000, -1000, -1000, -1000, -1000, -1000, 388, 160,
+	-209, 1168, -1000, -1000, -1000, -1000, -1000, -1000, 24855, -1000,
+	-299, 24855, 3791, -1000, -1000, -1000, 24682, -1000, -1000, -1000,
+	-1000, 70, -1000, -1000, 2982, -1000, 1088, -213, 1165, 1121,
+	1513, -1000, -300, 24517, -301, 243, 24855, 506, -1000, 14234,
+	-1000, 234, -1000, 14662, -1000, -1000, 1519, -1000, 1517, 396,
+	396, 24509, 551, 24855, -1000, -303, 241, 24855, -1000, 646,
+	-1000, 2351, 856, -1000, -1000, -1000, 192, 676, -1000, -1000,
+	-1000, 4055, -1000, -304, 24855, -1000, -1000, -1000, -1000, -1000,
+	229, 3909, -309, -1000, 227, 24855, -1000,
+}
+var yyPgo = [...]int{
+
+	0, 1860, 1858, 74, 1852, 154, 1851, 1850, 1849, 20,
+	18, 15, 22, 1848, 1649, 1647, 1645, 1643, 1846, 1634,
+	1843, 5, 1841, 1840, 1632, 1839, 1838, 1630, 1624, 1622,
+	1619, 1837, 1835, 34, 1834, 13, 1833, 6, 110, 126,
+	1832, 3, 1827, 1826, 9, 1822, 1820, 1615, 1819, 1815,
+	1813, 1810, 67, 1809, 1613, 1601, 1805, 1804, 1597, 1561,
+	1803, 1802, 1556, 1551, 1542, 1800, 151, 1796, 1795, 1792,
+	167, 72, 114, 1791, 1790, 1789, 88, 68, 1749, 87,
+	33, 83, 653, 1786, 14, 42, 1784, 1783, 122, 117,
+	1782, 120, 1781, 66, 211, 78, 1780, 1779, 135, 1777,
+	1776, 1773, 103, 1771, 1770, 52, 1765, 1763, 124, 1762,
+	56, 59, 38, 1761, 1759, 1758, 1757, 1756, 118, 549,
+	1755, 1753, 112, 1752, 57, 1751, 1750, 152, 1748, 1746,
+	1745, 105, 35, 1743, 31, 1742, 39, 50, 1741, 58,
+	1740, 104, 1739, 1738, 24, 10, 1737, 49, 1734, 43,
+	1732, 102, 92, 877, 8, 36, 45, 48, 107, 80,
+	12, 29, 98, 77, 62, 37, 1729, 113, 1725, 63,
+	123, 121, 97, 116, 1723, 1718, 1716, 834, 1715, 1714,
+	96, 1713, 60, 86, 755, 129, 90, 1712, 64, 1711,
+	1710, 1708, 1707, 61, 166, 1704, 1703, 73, 109, 85,
+	707, 148, 1673, 252, 119, 1702, 30, 1698, 1697, 2769,
+	84, 180, 81, 1696, 82, 17, 41, 1695, 1694, 1692,
+	1691, 1690, 1689, 1349, 1687, 1686, 1685, 1684, 241, 183,
+	1683, 1682, 101, 76, 1677, 1674, 1672, 1669, 1668, 100,
+	54, 111, 1667, 93, 94, 89, 1666, 1665, 1664, 1663,
+	40, 32, 1661, 1660, 1659, 71, 65, 1658, 47, 25,
+	27, 46, 7, 51, 53, 1657, 28, 1653, 91, 2,
+	11, 4, 1652, 1651, 1641, 1639, 1628, 1617, 55, 1611,
+	1609, 44, 1607, 1603, 1599, 26, 1590, 1584, 1576, 106,
+	95, 1573, 1571, 0, 115, 125, 1559, 1547, 127,
+}
+var yyR1 = [...]int{
+
+	0, 291, 292, 292, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 65, 3, 3, 8, 4,
+	4, 4, 5, 5, 86, 87, 87, 91, 91, 6,
+	6, 7, 7, 9, 9, 69, 69, 10, 11, 11,
+	11, 11, 295, 295, 100, 100, 98, 98, 99, 99,
+	162, 162, 12, 12, 12, 167, 167, 172, 172, 172,
+	175, 175, 175, 175, 154, 155, 14, 14, 14, 14,
+	14, 14, 14, 14, 14, 14, 2, 276, 276, 277,
+	277, 278, 278, 278, 278, 279, 279, 280, 280, 281,
+	281, 281, 281, 281, 281, 281, 281, 281, 281, 25,
+	196, 196, 45, 45, 46, 46, 46, 150, 150, 150,
+	13, 13, 13, 13, 13, 20, 22, 22, 34, 34,
+	35, 23, 23, 23, 23, 36, 36, 37, 26, 26,
+	26, 26, 26, 26, 43, 43, 43, 42, 42, 41,
+	41, 41, 41, 41, 41, 24, 24, 24, 24, 44,
+	44, 39, 39, 38, 40, 40, 40, 40, 40, 40,
+	40, 40, 40, 40, 40, 40, 27, 27, 27, 27,
+	27, 27, 19, 275, 275, 275, 33, 33, 21, 21,
+	21, 21, 21, 21, 21, 21, 21, 21, 21, 21,
+	21, 21, 21, 21, 21, 21, 21, 21, 21, 21,
+	21, 21, 21, 21, 21, 50, 252, 253, 253, 253,
+	253, 253, 253, 253, 253, 240, 241, 216, 216, 216,
+	216, 216, 216, 216, 215, 215, 215, 215, 219, 219,
+	217, 217, 217, 217, 217, 217, 217, 217, 217, 218,
+	218, 218, 218, 218, 218, 218, 218, 220, 220, 220,
+	220, 220, 221, 221, 221, 221, 221, 221, 221, 221,
+	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
+	221, 221, 221, 221, 221, 221, 221, 222, 222, 222,
+	222, 222, 222, 222, 222, 239, 239, 223, 223, 232,
+	232, 233, 233, 233, 230, 230, 231, 231, 234, 234,
+	226, 227, 235, 228, 228, 228, 229, 229, 229, 238,
+	238, 238, 238, 224, 242, 242, 262, 262, 261, 261,
+	260, 260, 260, 251, 251, 257, 257, 257, 257, 257,
+	257, 246, 246, 246, 245, 245, 247, 247, 250, 250,
+	259, 259, 258, 243, 243, 263, 263, 263, 263, 263,
+	244, 244, 244, 244, 264, 248, 248, 249, 249, 249,
+	270, 271, 269, 269, 269, 269, 269, 115, 115, 115,
+	254, 254, 254, 255, 255, 255, 256, 256, 256, 273,
+	273, 272, 272, 47, 47, 48, 53, 53, 52, 52,
+	52, 52, 52, 52, 52, 52, 52, 52, 52, 52,
+	52, 52, 52, 52, 52, 52, 52, 52, 52, 52,
+	52, 52, 52, 149, 149, 149, 49, 194, 194, 268,
+	268, 267, 265, 265, 266, 266, 15, 51, 51, 16,
+	16, 16, 16, 16, 16, 16, 17, 17, 54, 55,
+	55, 55, 55, 55, 55, 55, 55, 55, 55, 55,
+	55, 55, 55, 55, 55, 55, 55, 55, 55, 55,
+	55, 55, 55, 55, 55, 55, 55, 55, 83, 181,
+	181, 179, 179, 182, 182, 180, 180, 180, 183, 183,
+	183, 213, 213, 213, 56, 56, 61, 61, 64, 62,
+	63, 28, 29, 29, 29, 29, 30, 195, 195, 59,
+	59, 60, 60, 60, 60, 75, 75, 296, 296, 58,
+	58, 57, 57, 18, 297, 66, 67, 67, 68, 68,
+	68, 186, 186, 72, 72, 72, 70, 70, 70, 71,
+	71, 77, 77, 81, 81, 81, 81, 80, 80, 80,
+	80, 152, 152, 152, 153, 153, 205, 205, 205, 204,
+	204, 204, 204, 85, 85, 88, 88, 89, 89, 89,
+	89, 92, 135, 135, 109, 109, 110, 110, 110, 110,
+	110, 121, 121, 161, 161, 160, 160, 163, 163, 90,
+	90, 90, 90, 95, 95, 96, 96, 97, 97, 193,
+	193, 211, 211, 211, 101, 101, 101, 103, 102, 102,
+	102, 102, 104, 104, 106, 107, 107, 105, 105, 108,
+	111, 111, 111, 111, 112, 112, 82, 82, 82, 82,
+	82, 82, 82, 178, 178, 114, 114, 113, 113, 113,
+	113, 113, 113, 113, 113, 113, 113, 130, 130, 130,
+	130, 130, 130, 116, 116, 116, 116, 116, 116, 116,
+	76, 76, 131, 131, 131, 94, 93, 93, 79, 79,
+	78, 78, 132, 132, 119, 119, 119, 119, 119, 119,
+	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
+	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
+	119, 119, 119, 119, 119, 119, 119, 119, 119, 125,
+	125, 129, 129, 129, 129, 129, 129, 129, 129, 129,
+	129, 129, 129, 129, 129, 129, 129, 129, 128, 128,
+	128, 128, 128, 128, 128, 128, 128, 128, 128, 123,
+	123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
+	123, 123, 123, 123, 124, 124, 124, 124, 124, 124,
+	124, 124, 124, 124, 124, 124, 124, 124, 124, 124,
+	124, 298, 298, 127, 126, 126, 126, 126, 126, 126,
+	73, 73, 73, 73, 73, 212, 212, 214, 214, 214,
+	214, 214, 214, 214, 214, 214, 214, 214, 214, 214,
+	142, 142, 74, 74, 140, 140, 141, 143, 143, 139,
+	139, 139, 118, 118, 118, 118, 118, 118, 118, 118,
+	120, 120, 120, 144, 144, 133, 133, 84, 145, 145,
+	146, 147, 147, 148, 148, 151, 156, 156, 156, 157,
+	157, 157, 157, 122, 122, 158, 158, 158, 117, 117,
+	117, 117, 117, 117, 159, 159, 159, 159, 164, 164,
+	134, 134, 137, 137, 136, 138, 165, 165, 169, 166,
+	166, 170, 170, 170, 170, 173, 173, 174, 174, 174,
+	171, 171, 171, 168, 168, 168, 208, 208, 208, 176,
+	176, 187, 187, 184, 184, 185, 185, 177, 177, 225,
+	225, 190, 190, 190, 190, 190, 190, 190, 190, 192,
+	192, 191, 191, 191, 188, 188, 188, 189, 189, 206,
+	206, 202, 202, 207, 207, 203, 203, 209, 209, 210,
+	210, 274, 274, 236, 236, 284, 284, 237, 237, 285,
+	285, 287, 287, 282, 282, 283, 283, 286, 286, 31,
+	288, 288, 289, 289, 290, 290, 290, 290, 32, 199,
+	199, 199, 199, 199, 199, 199, 199, 199, 199, 199,
+	199, 199, 199, 199, 199, 199, 199, 199, 199, 199,
+	199, 199, 199, 199, 199, 199, 199, 199, 199, 199,
+	199, 199, 199, 199, 199, 199, 199, 199, 199, 199,
+	199, 199, 199, 199, 199, 199, 199, 199, 199, 199,
+	199, 199, 199, 199, 199, 199, 199, 199, 199, 199,
+	199, 199, 199, 199, 199, 199, 199, 199, 199, 199,
+	199, 199, 199, 199, 199, 199, 199, 199, 199, 199,
+	199, 199, 199, 199, 199, 199, 199, 199, 199, 199,
+	199, 199, 199, 199, 199, 199, 199, 199, 199, 199,
+	199, 199, 199, 199, 199, 199, 199, 199, 199, 199,
+	199, 199, 199, 199, 199, 199, 199, 199, 199, 199,
+	199, 199, 199, 199, 199, 199, 199, 199, 199, 199,
+	199, 199, 199, 199, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 293, 294, 197, 198, 198, 198,
+}
+var yyR2 = [...]int{
+
+	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 0, 11, 4, 7, 5, 1,
+	13, 3, 10, 12, 2, 1, 3, 3, 6, 1,
+	3, 1, 3, 7, 8, 1, 1, 9, 8, 7,
+	6, 6, 1, 1, 1, 3, 1, 3, 1, 3,
+	0, 4, 3, 4, 5, 1, 3, 3, 2, 2,
+	2, 2, 2, 1, 0, 0, 2, 3, 3, 11,
+	7, 9, 5, 5, 15, 11, 4, 0, 1, 1,
+	3, 2, 3, 3, 3, 0, 1, 1, 2, 2,
+	2, 2, 1, 2, 2, 3, 3, 3, 3, 4,
+	0, 3, 1, 1, 1, 1, 1, 0, 2, 2,
+	1, 1, 1, 1, 1, 4, 5, 8, 1, 2,
+	5, 7, 10, 8, 11, 1, 2, 5, 5, 5,
+	5, 6, 3, 5, 1, 1, 1, 1, 3, 1,
+	1, 1, 2, 1, 1, 2, 4, 2, 4, 2,
+	3, 1, 3, 3, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 2, 4, 3,
+	2, 4, 3, 0, 2, 3, 1, 3, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 5, 4, 1, 1, 2,
+	3, 4, 3, 3, 3, 3, 3, 0, 2, 2,
+	2, 2, 2, 2, 3, 1, 1, 1, 2, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 2,
+	2, 3, 2, 2, 2, 2, 2, 1, 2, 2,
+	2, 1, 4, 4, 3, 3, 2, 4, 5, 2,
+	3, 4, 2, 2, 3, 3, 3, 3, 3, 4,
+	1, 1, 1, 1, 1, 6, 6, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 3, 0, 3, 0,
+	5, 0, 3, 5, 0, 1, 0, 1, 1, 2,
+	2, 3, 1, 0, 3, 3, 0, 2, 2, 2,
+	1, 2, 1, 2, 5, 4, 0, 1, 1, 2,
+	2, 3, 2, 0, 1, 2, 4, 3, 3, 2,
+	2, 1, 1, 1, 1, 1, 0, 1, 0, 1,
+	1, 3, 3, 3, 1, 10, 11, 11, 12, 12,
+	3, 3, 2, 1, 5, 1, 1, 0, 2, 2,
+	3, 3, 1, 1, 2, 2, 2, 0, 1, 2,
+	0, 1, 3, 1, 2, 3, 1, 1, 1, 0,
+	2, 0, 2, 1, 1, 5, 1, 3, 2, 5,
+	4, 3, 3, 3, 5, 3, 8, 10, 3, 3,
+	3, 5, 4, 5, 1, 2, 2, 4, 3, 6,
+	5, 3, 8, 0, 1, 2, 4, 0, 1, 1,
+	1, 7, 1, 3, 8, 8, 3, 3, 5, 4,
+	6, 4, 4, 4, 4, 4, 3, 2, 3, 4,
+	4, 3, 6, 6, 4, 4, 4, 4, 4, 4,
+	3, 3, 2, 6, 2, 4, 4, 4, 5, 7,
+	6, 5, 4, 2, 4, 3, 2, 3, 3, 1,
+	1, 0, 1, 1, 1, 0, 2, 2, 0, 2,
+	2, 0, 1, 1, 2, 1, 1, 1, 2, 1,
+	1, 2, 3, 4, 4, 5, 3, 1, 1, 3,
+	3, 1, 1, 1, 1, 0, 3, 1, 1, 2,
+	2, 2, 2, 2, 0, 2, 0, 2, 1, 2,
+	2, 0, 1, 0, 1, 1, 0, 1, 1, 0,
+	1, 3, 5, 1, 1, 3, 5, 1, 2, 3,
+	5, 2, 4, 7, 0, 1, 0, 1, 2, 1,
+	1, 1, 1, 1, 3, 1, 1, 1, 4, 1,
+	3, 2, 2, 4, 2, 6, 1, 4, 6, 3,
+	2, 0, 3, 0, 3, 1, 3, 1, 3, 4,
+	4, 4, 3, 2, 4, 0, 1, 0, 2, 0,
+	1, 1, 1, 1, 1, 2, 2, 1, 2, 3,
+	2, 3, 2, 2, 3, 2, 1, 1, 3, 3,
+	0, 5, 5, 5, 0, 2, 1, 3, 3, 2,
+	3, 1, 2, 0, 3, 1, 1, 3, 3, 4,
+	4, 5, 3, 4, 5, 6, 2, 1, 2, 1,
+	2, 1, 2, 1, 1, 1, 1, 1, 1, 1,
+	0, 2, 1, 1, 1, 3, 1, 3, 0, 1,
+	1, 3, 1, 3, 1, 1, 1, 1, 1, 1,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 2, 2, 2, 2, 2,
+	2, 2, 3, 1, 1, 1, 1, 1, 1, 5,
+	6, 6, 6, 5, 5, 5, 6, 5, 5, 6,
+	5, 5, 5, 5, 6, 5, 5, 5, 4, 4,
+	5, 5, 5, 5, 5, 4, 4, 4, 4, 4,
+	4, 3, 6, 6, 6, 8, 8, 8, 8, 9,
+	4, 7, 5, 4, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 8,
+	8, 0, 2, 3, 4, 4, 4, 4, 4, 4,
+	0, 3, 4, 7, 3, 1, 1, 2, 3, 3,
+	1, 2, 2, 1, 2, 1, 2, 2, 1, 2,
+	0, 1, 0, 2, 1, 2, 4, 0, 2, 1,
+	3, 5, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 2, 2, 0, 3, 1, 3, 1, 0, 2,
+	1, 0, 3, 1, 3, 2, 0, 1, 1, 0,
+	2, 4, 4, 1, 1, 0, 2, 4, 2, 1,
+	3, 5, 4, 6, 1, 3, 3, 5, 0, 5,
+	1, 3, 1, 2, 3, 1, 1, 3, 3, 1,
+	3, 1, 2, 2, 3, 1, 1, 1, 1, 1,
+	3, 3, 3, 1, 2, 1, 1, 1, 1, 1,
+	1, 0, 1, 0, 2, 0, 3, 0, 1, 0,
+	3, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 0, 1, 1, 1, 1, 1, 0, 1, 0,
+	2, 1, 1, 1, 3, 1, 1, 1, 1, 1,
+	1, 0, 2, 0, 1, 0, 4, 0, 1, 0,
+	3, 0, 3, 0, 4, 0, 3, 0, 3, 3,
+	1, 3, 2, 4, 1, 2, 1, 2, 2, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 0, 0, 1, 1,
+}
+var yyChk = [...]int{
+
+	-1000, -291, -1, -3, -8, -9, -10, -11, -12, -14,
+	-47, -15, -16, -17, -54, -55, -56, -61, -62, -63,
+	-59, -58, -57, -18, -24, -27, -19, -65, -28, -29,
+	-30, -31, -32, -4, 6, 7, -69, 9, 10, 30,
+	-50, 130, -48, -49, 133, 132, 169, 134, 162, 64,
+	225, -64, 228, 229, -296, -195, 167, 168, 136, 212,
+	213, 20, 37, 230, 232, 31, 32, -5, -86, -6,
+	8, 322, 131, 226, 164, 163, 26, 367, -293, 68,
+	-292, 437, -147, 15, -66, -297, -66, -66, -66, -66,
+	-66, -252, -193, 105, 68, 23, -189, 143, 86, 313,
+	139, -196, -187, -188, 189, 199, 44, 150, 149, 140,
+	140, 141, 143, 313, 139, 177, 176, 140, -105, -209,
+	71, -200, 364, 153, 379, 380, 131, 186, 225, 239,
+	233, 267, 255, 365, 381, 154, 207, 138, 253, 256,
+	300, 152, 200, 382, 289, 295, 210, 80, 228, 309,
+	383, 155, 204, 206, 205, 194, 211, 36, 165, 251,
+	244, 215, 189, 384, 372, 242, 28, 184, 385, 297,
+	272, 386, 369, 296, 247, 243, 136, 387, 156, 150,
+	179, 388, 273, 277, 389, 301, 390, 391, 392, 142,
+	237, 238, 393, 190, 303, 271, 33, 151, 366, 48,
+	174, 304, 38, 275, 37, 281, 394, 270, 265, 282,
+	395, 396, 397, 398, 269, 236, 264, 202, 53, 137,
+	279, 278, 280, 203, 299, 260, 258, 399, 400, 157,
+	401, 402, 245, 18, 403, 404, 307, 168, 405, 42,
+	406, 407, 408, 172, 409, 410, 411, 298, 274, 276,
+	188, 412, 248, 147, 413, 176, 414, 368, 415, 305,
+	241, 416, 158, 232, 173, 167, 308, 417, 213, 418,
+	419, 420, 159, 421, 422, 423, 229, 230, 291, 208,
+	424, 425, 426, 427, 198, 183, 311, 302, 52, 212,
+	286, 428, 235, 149, 214, 429, 226, 43, 7, 201,
+	292, 209, 199, 262, 175, 430, 431, 249, 250, 268,
+	234, 263, 227, 177, 178, 169, 432, 310, 287, 370,
+	51, 257, 254, 181, 261, 433, 143, 434, 182, 231,
+	306, 252, 288, 140, 123, 256, 300, 130, 290, 291,
+	297, -246, 298, 176, 179, -213, 140, -179, 289, 182,
+	71, 141, 142, 33, 302, 301, 293, -209, 144, 231,
+	-75, 134, 170, -105, -197, -197, -197, -197, -44, 71,
+	214, -44, 30, 71, 71, 36, 71, 230, 292, 292,
+	6, -68, 5, -177, 143, 145, 227, -87, -91, -211,
+	-209, -201, 73, 335, 336, 337, 338, 339, 352, 353,
+	354, 340, 341, 355, 356, 357, 342, 343, 358, 359,
+	361, 362, 360, 180, 346, 345, 344, 347, 348, 350,
+	349, 351, -3, -157, 17, 16, -72, 54, 55, -67,
+	-80, 114, -82, -209, -113, 88, -119, 29, 71, -200,
+	24, -118, -201, -114, -139, -138, -94, 123, 124, 125,
+	112, 113, 120, 89, 126, -125, -123, -124, -126, -128,
+	-129, 73, 72, 81, 74, 75, 76, 77, 82, 335,
+	336, 337, 338, 339, 352, 353, 354, 340, 341, 355,
+	356, 357, 342, 343, 358, 359, 361, 362, 360, 346,
+	345, 344, 347, 348, 350, 349, 351, 83, 84, -202,
+	-136, -293, 58, 59, 139, 323, 324, 325, 326, 363,
+	160, 327, 91, 47, 312, 321, 320, 319, 317, 318,
+	314, 316, 315, 329, 330, 146, 313, 118, 322, -177,
+	-177, 11, -98, -99, -105, -108, -209, -166, 227, -173,
+	-170, 301, 302, -171, -174, -168, -139, 281, 410, 411,
+	300, 256, 299, -202, -209, -2, -5, 6, -105, -253,
+	-241, -244, -203, 155, -264, -202, -199, 152, 71, -200,
+	135, 161, 87, 371, 23, 25, 284, 335, 90, 123,
+	336, 337, 338, 16, 20, 91, 122, 323, 339, 130,
+	62, 314, 316, 312, 313, 290, 29, 10, 26, 163,
+	193, 22, 116, 132, 94, 95, 96, 166, 24, 164,
+	84, 160, 19, 65, 11, 13, 333, 334, 14, 146,
+	145, 107, 191, 141, 60, 8, 126, 27, 104, 56,
+	340, 341, 375, 45, 376, 58, 105, 17, 317, 318,
+	31, 363, 342, 171, 377, 343, 118, 196, 63, 50,
+	88, 82, 34, 85, 66, 86, 15, 192, 61, 331,
+	195, 378, 106, 133, 322, 59, 139, 6, 328, 30,
+	162, 346, 345, 344, 347, 197, 57, 325, 326, 348,
+	435, 140, 93, 329, 330, 144, 83, 5, 44, 32,
+	9, 64, 67, 319, 320, 321, 47, 350, 349, 351,
+	92, 12, 332, 367, 180, 141, -105, 322, -185, 146,
+	-185, 177, 176, 140, 97, -51, -105, -184, 146, -202,
+	-184, -184, -184, -184, -184, -105, 127, -105, 71, 30,
+	-183, 105, 12, 313, 139, 71, 176, 179, 140, 177,
+	143, -198, -293, -203, -198, -248, 11, 107, 180, 180,
+	180, 181, 180, -182, -181, 178, 295, 296, 292, 294,
+	12, -83, 105, -198, 71, 230, 144, -60, -3, -11,
+	-9, -10, -3, 97, 30, 30, 73, 51, 30, -39,
+	-38, -40, 200, 201, 202, 203, 204, 205, 206, 207,
+	208, 209, 210, 211, 30, -275, 68, -236, 281, 71,
+	-288, -289, -105, -66, -7, -5, -293, 21, 22, 140,
+	-105, 69, 23, -293, -294, 70, -158, 19, 31, -122,
+	74, 77, -148, -151, -82, -209, 50, -70, 21, 22,
+	79, 11, -205, 87, 86, 104, -204, 23, 71, -200,
+	-201, 73, 127, -82, -116, 107, 88, 105, 106, 90,
+	109, 108, 119, 112, 113, 114, 115, 116, 117, 118,
+	110, 111, 122, 97, 98, 99, 100, 101, 102, 103,
+	-178, -293, -94, -293, 128, 129, -119, -119, -119, -119,
+	-119, -119, -119, -119, -293, -293, -293, -293, -293, -293,
+	-293, -293, -293, -293, -293, -293, -293, -293, -293, -293,
+	-293, -293, -293, -293, -293, -293, -293, -293, -293, -293,
+	-293, -293, -293, -3, -132, -82, -293, -293, -293, -293,
+	-293, -293, -293, -293, -293, -293, -293, -142, -82, -293,
+	-298, -127, -293, -298, -127, -298, -127, -298, -293, -298,
+	-127, -298, -127, -298, -298, -127, -298, -293, -293, -293,
+	-293, -293, -293, -107, 27, -105, -85, -88, -89, -90,
+	-109, -93, -293, -105, -94, -105, -98, -295, 69, 11,
+	67, -295, 69, 127, 69, -167, -172, 303, 305, 227,
+	-171, -171, -208, -202, 73, 29, 97, 30, 127, -147,
+	-66, 70, 69, -244, 155, 152, -215, -219, -221, -220,
+	-222, -217, -218, 253, 256, 260, 258, 254, 259, 123,
+	257, 262, 263, 264, 265, 266, 267, 268, 269, 270,
+	271, 272, 30, 165, 249, 250, 251, 252, 273, 274,
+	275, 276, 277, 278, 279, 280, 233, 255, 365, 234,
+	235, 236, 237, 238, 239, 241, 242, 243, 244, 245,
+	246, 247, 71, -201, -264, 68, -202, 23, 143, 71,
+	88, 71, 71, 71, -185, 71, 69, 144, -98, 24,
+	66, -100, -105, 71, 71, 71, 71, -210, -209, -199,
+	-198, -183, 73, -82, -185, -185, -198, -198, -198, -105,
+	-105, -105, -105, -183, -183, -198, -198, -180, 11, 107,
+	11, -180, -180, -82, -119, 71, 71, 230, 71, -39,
+	-39, 73, -39, 69, 97, -39, 70, -132, -274, 436,
+	69, -290, 23, 305, 306, 282, -72, -3, -105, -198,
+	-91, -93, -293, -159, -202, 9, 107, 69, 18, 69,
+	-156, 25, 26, 127, -120, 51, 74, 77, -186, 49,
+	-105, -82, -82, -130, 82, 88, 83, 84, -204, 114,
+	-210, -203, -199, -119, -131, -136, -94, 78, 107, 105,
+	106, 90, -119, -119, -119, -119, -119, -119, -119, -119,
+	-119, -119, -119, -119, -119, -119, -119, -212, 71, 73,
+	71, -118, -118, -202, -70, -78, -81, 114, -82, -209,
+	-78, -78, -70, -294, -294, -81, -78, -78, -78, -81,
+	-78, -70, -70, -78, -294, -294, -294, -294, -78, -78,
+	-78, -78, -70, -78, -78, -78, -70, -294, 69, -294,
+	-3, -78, -78, -294, -82, -82, -139, 73, -78, -139,
+	73, -78, -78, -78, -70, -140, -141, 92, -139, -294,
+	-119, -202, -202, -78, -79, -78, -78, -78, -162, 172,
+	-105, 30, 69, -101, -103, -102, -104, 56, 60, 62,
+	57, 58, 59, 63, -193, -85, -92, 47, -293, -110,
+	172, -111, 23, -211, 64, 145, 65, -162, 67, -85,
+	-105, -85, -108, -209, 114, -170, -173, 69, 304, 306,
+	307, -167, -229, 122, 66, 85, -82, -203, -210, -157,
+	-72, -254, -255, -256, -203, 73, 74, -241, -242, -243,
+	-244, -257, 155, -263, 147, 149, 44, -245, 156, 141,
+	45, -216, -230, 287, -223, 68, -223, -223, 261, 253,
+	256, 254, -223, -223, -223, -223, -223, -228, 256, -228,
+	-228, -228, -228, 254, 68, 68, -223, -223, -223, -232,
+	68, -232, 248, -233, 68, -233, -233, -233, -233, -264,
+	-264, -82, -206, 67, -154, -105, -198, 24, -198, -45,
+	186, 161, 68, -105, -105, -105, -105, 69, 71, 71,
+	-249, 11, 107, -183, -209, -209, -105, -121, 23, -183,
+	-76, 166, 71, -38, -118, 70, -106, 27, 73, -289,
+	-211, 281, 306, -70, -294, -53, -52, -190, 135, 132,
+	133, 137, 138, -267, 284, 131, 256, 80, 29, 15,
+	323, 172, 370, 71, 173, -294, 69, 127, 52, -122,
+	-122, -151, -176, 19, 11, 47, 47, -71, 57, 82,
+	83, 84, 127, -293, -131, -119, -119, -119, -76, 87,
+	-294, -78, 69, -294, 127, -294, -294, -78, -152, 331,
+	-152, -294, -294, -294, -294, -294, -294, -78, -78, -294,
+	-152, -152, -152, -152, -294, -294, -294, -294, -78, -294,
+	-294, -294, -79, -82, -294, -294, -294, 69, 67, 23,
+	11, 11, -294, 11, 11, -294, -294, -294, -78, -143,
+	-141, 94, -82, -294, -294, 69, 69, -294, -294, -294,
+	-294, -117, 30, 47, -3, -293, -293, -165, -169, -139,
+	-88, -89, -89, -88, -89, 56, 56, 56, 61, 56,
+	61, 56, -102, -211, -294, -294, -135, 185, -293, 34,
+	-211, -111, 141, 141, 141, -112, 12, -85, -112, -112,
+	127, -172, -175, 308, 305, 311, 71, 73, 127, -158,
+	69, -256, 97, -244, 68, 71, 45, -245, 141, 45,
+	-245, -250, 71, -250, 45, -234, -226, -227, -235, -238,
+	-224, 82, 88, 29, 66, 284, 147, 45, 44, 80,
+	-231, 288, 74, -228, -228, -223, -223, -223, 261, -223,
+	-228, -229, 30, -229, -229, -229, -229, -228, -239, 73,
+	-239, 74, -232, 74, 70, 66, -202, -3, 23, -46,
+	8, 9, 10, -276, -277, -278, 71, 107, 191, 192,
+	144, -198, -105, -198, -198, -112, 71, 71, -180, -183,
+	34, -119, -162, 140, -290, -186, 69, -197, -194, -268,
+	-245, -273, -243, -244, -272, 148, 156, 172, 155, -194,
+	-268, 155, 152, -245, 156, 147, 148, -191, -245, 144,
+	23, -194, -194, -251, 97, -194, 172, 23, -202, -202,
+	53, -105, -77, -155, 114, -203, -79, -76, 87, -119,
+	-294, -81, -153, -152, 114, -210, -153, -153, -294, -202,
+	-293, -152, -153, -153, -152, -152, -152, -294, -294, -152,
+	-153, -153, -153, -153, -294, -153, -153, -153, -294, -214,
+	123, 253, 165, 251, 244, 271, 258, 286, 249, 287,
+	-212, -214, -119, -119, -119, -119, 364, -147, 96, -82,
+	93, -119, -119, -164, 66, -165, -134, -137, -136, -293,
+	-3, -159, -163, -202, -112, 69, 97, -96, -95, 66,
+	67, -97, 66, -95, 56, 56, -161, 68, 69, -136,
+	-293, -163, -119, -111, -293, -293, -293, -147, -82, -112,
+	305, 309, 310, -255, -256, -259, -258, -202, 147, -263,
+	156, -250, -250, 68, 82, -119, 9, 45, 45, 73,
+	70, -229, -229, -228, -223, -229, 71, 123, -229, 70,
+	69, 70, 69, 70, 69, -115, 385, 88, -105, -154,
+	-154, 66, 70, 69, -215, 71, 71, 71, -105, -183,
+	-119, -228, -105, -71, -52, 68, -240, 71, -197, -250,
+	-188, 147, 71, 71, -197, 71, 71, -202, 45, 45,
+	71, -105, -202, -240, 71, -82, -202, -202, -93, 127,
+	-112, 11, 69, -80, -294, -119, -153, 127, -153, -147,
+	172, -153, -153, -153, -294, -223, -223, -223, -233, -223,
+	238, -223, 238, -294, -294, 19, 19, 19, 19, -293,
+	-74, 328, -82, 69, 69, 28, -164, 69, -294, -294,
+	-294, -294, 69, -147, -169, -82, -82, 68, -82, -160,
+	-202, 185, -294, -111, -193, -160, -160, -160, -157, 70,
+	69, -223, 45, -160, -124, -229, -228, 73, -228, 74,
+	74, 385, 68, -3, -105, -279, -280, -281, 80, 366,
+	88, 193, 194, 157, 195, 196, 197, -278, -215, -215,
+	-215, -282, -182, -77, -240, -149, 160, 161, -215, -206,
+	-247, -245, 45, 71, -192, 144, 23, 144, -149, -240,
+	30, 132, 27, -202, -144, 13, -85, -155, -155, 114,
+	-294, 16, -228, 71, -119, -119, -119, -119, -119, -294,
+	73, -119, -119, 45, -137, 47, -3, -293, -202, -157,
+	-160, 70, 69, -136, -110, -211, -294, -294, -294, -261,
+	-260, 67, 151, 80, -258, -156, 70, -229, -229, 70,
+	70, -259, -154, 19, -154, -281, 73, 197, 193, 197,
+	197, 197, 197, 198, -283, 38, -285, 39, 11, 70,
+	71, -216, 68, -250, 68, 71, -202, -149, 29, 29,
+	-293, -145, 14, 16, -112, -80, -132, -294, -294, -294,
+	-294, -73, 107, 367, -294, -294, 9, -134, -3, 70,
+	-202, -111, -260, 71, -251, 73, 158, 70, 184, -21,
+	-3, -9, -10, -11, -12, -14, -47, -15, -16, -22,
+	-23, -17, -54, -55, -64, -62, -63, -59, -58, -26,
+	-24, -27, -19, -28, -29, -30, -25, 91, 146, 215,
+	225, 36, 36, 189, 190, -225, 145, -286, 43, -284,
+	-237, 42, 16, -85, -259, -206, -259, -119, -265, -266,
+	172, -146, -82, -133, -84, -82, -144, -155, -147, -294,
+	365, 63, 368, -165, -294, 74, -105, -262, -261, 185,
+	-154, -82, -82, 71, -43, -207, 218, 219, 220, -203,
+	-33, -21, -161, 74, -285, 16, -287, 40, 41, 73,
+	-112, 70, 68, 70, -294, 69, -202, 69, -145, -294,
+	53, 366, 369, 68, -150, 187, 188, -34, -35, 92,
+	93, 216, 217, 221, -215, 69, 437, 38, 73, 16,
+	16, -144, -262, -259, -262, -266, 47, -84, 53, -160,
+	-154, 71, 71, 96, 94, -35, -82, -33, 19, 19,
+	19, 29, -203, 96, -21, 73, 73, -145, 70, 174,
+	367, 70, -13, -20, -12, -9, -10, -11, 225, 91,
+	-33, 93, 437, -44, 74, -3, -42, -41, 74, -44,
+	223, 88, 224, 71, -119, -262, 175, 368, -270, -271,
+	66, -154, -33, 437, -33, 96, 94, -36, -37, 95,
+	-21, 69, 222, -293, 369, -271, 66, -270, 66, 10,
+	9, 437, 96, 437, 146, -33, 96, 94, -37, -82,
+	-41, -119, 171, -269, 159, 154, 157, 30, -269, 96,
+	91, 437, 146, -33, 93, -294, -294, 153, 29, 82,
+	96, 437, -33, 146, 96, 437, 146,
+}
+var yyDef = [...]int{
+
+	34, -2, 2, 4, 5, 6, 7, 8, 9, 10,
+	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
+	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
+	31, 32, 33, -2, 534, 534, 534, 534, 534, 534,
+	609, -2, 403, 404, 0, 0, 0, 0, -2, 505,
+	506, 507, 509, 510, 525, -2, 1346, 1346, 1346, 0,
+	186, 0, 0, 0, 0, 0, 0, 39, 0, 0,
+	55, 56, 907, 0, 528, 517, 518, 0, 0, 1344,
+	1, 3, 849, 0, 543, 536, 0, 907, 907, 0,
+	0, 86, 0, 0, 0, 610, 0, 0, 0, 905,
+	905, 0, 0, 928, 0, 902, 924, 925, 926, 0,
+	903, 0, 903, 903, 903, 903, 903, 0, 457, 627,
+	937, 938, 1104, 1105, 1106, 1107, 1108, 1109, 1110, 1111,
+	1112, 1113, 1114, 1115, 1116, 1117, 1118, 1119, 1120, 1121,
+	1122, 1123, 1124, 1125, 1126, 1127, 1128, 1129, 1130, 1131,
+	1132, 1133, 1134, 1135, 1136, 1137, 1138, 1139, 1140, 1141,
+	1142, 1143, 1144, 1145, 1146, 1147, 1148, 1149, 1150, 1151,
+	1152, 1153, 1154, 1155, 1156, 1157, 1158, 1159, 1160, 1161,
+	1162, 1163, 1164, 1165, 1166, 1167, 1168, 1169, 1170, 1171,
+	1172, 1173, 1174, 1175, 1176, 1177, 1178, 1179, 1180, 1181,
+	1182, 1183, 1184, 1185, 1186, 1187, 1188, 1189, 1190, 1191,
+	1192, 1193, 1194, 1195, 1196, 1197, 1198, 1199, 1200, 1201,
+	1202, 1203, 1204, 1205, 1206, 1207, 1208, 1209, 1210, 1211,
+	1212, 1213, 1214, 1215, 1216, 1217, 1218, 1219, 1220, 1221,
+	1222, 1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231,
+	1232, 1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241,
+	1242, 1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251,
+	1252, 1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260, 1261,
+	1262, 1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271,
+	1272, 1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281,
+	1282, 1283, 1284, 1285, 1286, 1287, 1288, 1289, 1290, 1291,
+	1292, 1293, 1294, 1295, 1296, 1297, 1298, 1299, 1300, 1301,
+	1302, 1303, 1304, 1305, 1306, 1307, 1308, 1309, 1310, 1311,
+	1312, 1313, 1314, 0, 0, 0, 498, 0, 1347, 1347,
+	472, 0, 474, 0, 0, 0, 0, 0, 483, 486,
+	1347, 351, 352, 353, 502, 503, 492, 504, 0, 0,
+	0, 0, 0, 529, 530, 531, 532, 533, 165, 167,
+	0, 187, 0, 190, 193, 943, 511, 0, 0, 968,
+	534, 0, 538, 0, 0, 908, 508, 44, 45, 0,
+	611, 612, 613, 1315, 1316, 1317, 1318, 1319, 1320, 1321,
+	1322, 1323, 1324, 1325, 1326, 1327, 1328, 1329, 1330, 1331,
+	1332, 1333, 1334, 1335, 1336, 1337, 1338, 1339, 1340, 1341,
+	1342, 1343, 0, 855, 0, 0, 546, 544, 545, 535,
+	0, 557, 566, 0, 636, 0, 641, 643, -2, -2,
+	0, 684, 685, 686, 687, 688, 689, 0, 0, 0,
+	0, 0, 0, 0, 0, 713, 714, 715, 716, 717,
+	718, 822, 823, 824, 825, 826, 827, 828, 829, 1315,
+	1316, 1317, 1318, 1319, 1320, 1321, 1322, 1323, 1324, 1325,
+	1326, 1327, 1328, 1329, 1330, 1331, 1332, 1333, 1334, 1336,
+	1337, 1338, 1339, 1340, 1341, 1342, 1343, 645, 646, 819,
+	875, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 810, 0, 781, 781, 781, 781, 781, 781,
+	781, 781, 781, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 66, 68, 627, 72, 1294, 0,
+	879, -2, -2, 881, 0, 0, 0, -2, -2, -2,
+	-2, 1121, -2, 819, 0, 87, 841, 534, 88, 0,
+	227, 228, 0, 1133, 373, 935, 936, 1123, 931, 932,
+	969, 970, 971, 972, 973, 974, 975, 976, 977, 978,
+	979, 980, 981, 982, 983, 984, 985, 986, 987, 988,
+	989, 990, 991, 992, 993, 994, 995, 996, 997, 998,
+	999, 1000, 1001, 1002, 1003, 1004, 1005, 1006, 1007, 1008,
+	1009, 1010, 1011, 1012, 1013, 1014, 1015, 1016, 1017, 1018,
+	1019, 1020, 1021, 1022, 1023, 1024, 1025, 1026, 1027, 1028,
+	1029, 1030, 1031, 1032, 1033, 1034, 1035, 1036, 1037, 1038,
+	1039, 1040, 1041, 1042, 1043, 1044, 1045, 1046, 1047, 1048,
+	1049, 1050, 1051, 1052, 1053, 1054, 1055, 1056, 1057, 1058,
+	1059, 1060, 1061, 1062, 1063, 1064, 1065, 1066, 1067, 1068,
+	1069, 1070, 1071, 1072, 1073, 1074, 1075, 1076, 1077, 1078,
+	1079, 1080, 1081, 1082, 1083, 1084, 1085, 1086, 1087, 1088,
+	1089, 1090, 1091, 1092, 1093, 1094, 1095, 1096, 1097, 1098,
+	1099, 1100, 1101, 1102, 1103, 0, 0, 0, 0, 0,
+	0, 0, 0, 905, 0, 446, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 456, 0, 458, 1347, 498,
+	461, 0, 0, 905, 905, 1347, 1347, 1347, 0, 0,
+	0, 470, 1348, 1349, 471, 0, 375, 376, 498, 498,
+	1347, 1347, 495, 0, 495, 495, 493, 494, 489, 490,
+	0, 485, 0, 487, 512, 0, 0, 519, 521, 522,
+	523, 524, 520, 0, 0, 0, 169, 0, 0, 189,
+	171, 0, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 0, 192, 0, 941, 944, 516,
+	959, 960, 0, 543, 41, 51, 0, 539, 540, 0,
+	1347, 0, 0, 0, 50, 1345, 36, 0, 0, 850,
+	853, 854, 842, 843, 846, 0, 0, 541, 547, 548,
+	537, 0, 558, 0, 0, 0, 567, 0, 569, 570,
+	571, 572, 0, 639, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 663, 664, 665, 666, 667, 668, 669,
+	642, 0, 656, 0, 0, 0, 705, 706, 707, 708,
+	709, 710, 711, 0, 546, 0, 0, 0, 546, 0,
+	0, 0, 0, 0, 0, 0, 0, 546, 546, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 546, 0,
+	0, 0, 546, 0, 0, 682, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 546, 0, 811, 0,
+	764, 773, 0, 765, 774, 766, 775, 767, 0, 768,
+	776, 769, 777, 770, 771, 778, 772, 0, 0, 0,
+	678, 0, 0, 70, 0, 626, 0, 573, 575, 576,
+	577, -2, 0, 630, 676, -2, 0, 0, 0, 62,
+	63, 0, 0, 0, 0, 73, 75, 0, 0, 1294,
+	882, 883, 326, 896, 897, 898, 0, 894, 0, 849,
+	543, 390, 0, 229, 0, 0, 237, 314, 245, 246,
+	247, 307, 249, 307, 307, 0, 307, 307, 307, 307,
+	307, 323, 323, 323, 323, 323, 290, 291, 292, 293,
+	294, 0, 0, 267, 307, 307, 307, 271, 297, 298,
+	299, 300, 301, 302, 303, 304, 250, 251, 252, 253,
+	254, 255, 256, 257, 258, 309, 309, 311, 311, 311,
+	311, 311, 0, 0, 372, 0, 929, 84, 0, 1347,
+	0, 1347, 0, 0, 0, 121, 0, 0, 449, 904,
+	0, 451, 64, 452, 453, 454, 455, 628, 939, 940,
+	459, 460, 499, 500, 0, 0, 464, 465, 466, 467,
+	468, 469, 377, 475, 476, 477, 482, 498, 0, 0,
+	0, 591, 498, 484, 670, 514, 513, 0, 526, 166,
+	168, 170, 188, 0, 0, 191, 194, 0, 0, 0,
+	0, 962, 0, 964, 966, 0, 546, 0, 0, 436,
+	46, 47, 0, 0, 864, 856, 0, 0, 0, 0,
+	845, 847, 848, 0, 0, 830, 0, 0, 549, 542,
+	38, 637, 638, 640, 657, 0, 659, 661, 568, 559,
+	0, 820, -2, 647, 648, 672, 673, 674, 0, 0,
+	0, 0, 670, 652, 0, 690, 691, 692, 693, 694,
+	695, 696, 697, 698, 699, 700, 701, 704, 795, 796,
+	0, 702, 703, 712, 0, 0, 680, 553, 554, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 678, 675, 0, 874,
+	0, 0, 0, 751, 0, 0, 687, 822, 0, 687,
+	822, 0, 0, 0, 0, 817, 814, 0, 0, 782,
+	0, 0, 0, 0, 0, 679, 0, 0, 0, 0,
+	625, 0, 0, 0, 0, 0, 0, 614, 0, 0,
+	617, 0, 0, 0, 0, 0, 0, 0, 0, 584,
+	1225, 586, 0, 630, 0, 0, 0, 634, 0, 634,
+	67, 634, 69, 0, 629, 880, 0, 0, 0, 78,
+	79, 74, 884, 0, 890, 891, 892, 820, 0, 855,
+	546, 226, 391, 393, 396, 397, 398, 230, 232, 233,
+	234, 0, 1133, 364, 1235, 1275, -2, 358, 1160, -2,
+	-2, 236, 316, 315, 248, 0, 323, 323, 307, 307,
+	307, 307, 276, 323, 279, 282, 283, 326, 0, 326,
+	326, 326, 326, 323, 0, 0, 268, 269, 270, 259,
+	0, 260, 309, 262, 0, 263, 264, 265, 266, 370,
+	371, 0, 0, 0, 0, 0, 92, 906, 93, 0,
+	122, 123, 97, 225, 0, 447, 1347, 0, 1347, 1347,
+	634, 0, 0, 478, 496, 497, 495, 498, 0, 481,
+	488, 0, 515, 172, 173, 195, 70, 0, 942, 961,
+	0, 965, 967, 541, 52, 405, 406, 1346, -2, 437,
+	921, 437, 437, 424, 343, 437, 911, 912, 913, 914,
+	915, 916, 917, 918, 0, 0, 0, 0, 0, 851,
+	852, 844, 0, 899, 900, 831, 832, 85, 550, 658,
+	660, 662, 0, 678, 649, 670, 653, 0, 650, 0,
+	644, 0, 0, 564, 0, 564, 564, 0, 738, 0,
+	739, 0, 564, 564, 0, 0, 0, 0, 0, 0,
+	745, 746, 747, 748, 564, 564, 564, 564, 0, 564,
+	564, 564, 0, 683, -2, 749, 750, 0, 0, 0,
+	0, 0, 788, 0, 0, 789, 0, 760, 841, 0,
+	815, 0, 0, 763, 783, 0, 0, 784, 785, 786,
+	787, 868, 0, 0, 859, 0, 0, 634, 876, 0,
+	574, 605, 607, 0, 602, 615, 616, 618, 0, 620,
+	0, 622, 623, 593, 580, 677, 581, 0, 0, 0,
+	630, 590, 0, 0, 0, 841, 0, 634, 60, 61,
+	0, 76, 77, 0, 0, 83, 327, 328, 0, 96,
+	0, 394, 0, 231, 0, 0, 345, 358, 354, 355,
+	358, 349, 359, 350, 0, 238, 239, 240, 241, 242,
+	243, 318, 0, 0, 0, 322, 0, 330, 332, 0,
+	244, 317, 0, 326, 326, 323, 274, 275, 307, 280,
+	326, 284, 0, 285, 286, 287, 288, 326, 0, 305,
+	0, 0, 261, 0, 387, 0, 930, 84, 84, 0,
+	124, 125, 126, 0, 98, 99, 0, 0, 0, 0,
+	0, 450, 65, 462, 463, 473, 378, 379, 498, 480,
+	0, 671, 323, 0, 963, 549, 0, 408, 0, 1346,
+	358, 0, 425, 426, 0, 438, 439, 440, 0, 0,
+	1346, 0, 0, 0, 439, 0, 0, 0, 0, 922,
+	923, 0, 0, 0, 344, 0, 0, 0, 866, 865,
+	857, 37, 634, 0, 560, 821, 0, 651, 0, 654,
+	564, 681, 723, 565, 555, 0, 724, 725, 564, 561,
+	841, 740, 727, 728, 741, 742, 743, 564, 564, 744,
+	732, 731, 730, 733, 564, 736, 735, 737, 719, 0,
+	307, 307, 800, 307, 311, 803, 307, 805, 307, 808,
+	0, 0, 0, 0, 0, 0, 0, 812, 762, 818,
+	0, 0, 0, 53, 0, 868, 858, 870, 872, 0,
+	0, 0, 0, 597, 841, 0, 0, 599, 606, 0,
+	0, 600, 0, 601, 619, 621, 578, 0, 0, 582,
+	0, 0, -2, 589, 0, 0, 0, 849, 635, 59,
+	80, 81, 82, 392, 395, 0, 360, 307, 0, 363,
+	0, 347, 348, 0, 319, 320, 0, 329, 331, 333,
+	308, 272, 273, 326, 281, 277, 324, 325, 289, 323,
+	0, 323, 0, 312, 0, 374, 388, 0, 0, 90,
+	0, 0, 105, 0, 101, 0, 0, 0, 448, 479,
+	592, 953, 624, 85, 407, 0, 433, 0, 411, 929,
+	356, 0, -2, 412, 413, 418, 419, 420, 0, 431,
+	0, 415, 0, 433, 0, 428, 0, 0, 48, 0,
+	833, 0, 85, 85, 720, 655, 722, 0, 726, 0,
+	0, 721, 729, 734, 752, 797, 323, 801, 802, 804,
+	806, 807, 809, 754, 753, 0, 0, 0, 0, 0,
+	0, 0, 816, 0, 0, 0, 54, 0, 873, -2,
+	0, 71, 0, 849, 877, 878, 603, 0, 608, 0,
+	595, 0, 630, 587, 0, 0, 0, 0, 58, 335,
+	0, 846, 346, 0, 321, 278, 326, 306, 326, 0,
+	0, 389, 0, 84, 0, 84, 106, 107, 0, 0,
+	0, 112, 0, 0, 0, 0, 0, 100, 102, 103,
+	104, 955, 949, 0, 0, 410, 434, 0, 237, 0,
+	358, 357, 0, 427, 0, 919, 920, 0, 422, 433,
+	0, 0, 0, 867, 838, 0, 634, 0, 551, 556,
+	562, 0, 798, 799, 0, 0, 0, 0, 790, 761,
+	813, 0, 0, 0, 871, 0, 862, 0, 598, 57,
+	0, 594, 0, 583, 585, 630, 631, 632, 633, 334,
+	338, 0, 343, 0, 361, 362, 0, 295, 296, 310,
+	313, 0, 91, 0, 0, 108, 109, 110, 111, 113,
+	114, 0, 0, 0, 909, 957, -2, 0, 0, 409,
+	435, 235, 0, 929, 0, 414, 421, 423, 0, 430,
+	0, 42, 0, 0, 833, 85, 841, 755, 757, 756,
+	758, 0, 0, 0, 779, 780, 0, 861, 0, 604,
+	596, 588, 339, 340, 0, 342, 0, 336, 0, 84,
+	198, 199, 200, 201, 202, 203, 204, 205, 206, 207,
+	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
+	218, 219, 220, 221, 222, 223, 224, 0, 0, 0,
+	0, 115, 116, 117, 118, 593, 0, 949, 0, 951,
+	0, 948, 0, 634, 0, 0, 0, 429, 0, 442,
+	0, 839, 840, 834, 835, 837, 838, 552, 0, 759,
+	0, 0, 0, 869, -2, 341, 0, 89, 337, 127,
+	95, 0, 0, 931, 0, 0, 154, 155, 156, 933,
+	0, 196, 35, 0, 956, 0, 954, 0, 0, 950,
+	833, 336, 0, 336, 441, 0, 0, 0, 43, 563,
+	791, 0, 794, 0, 84, 0, 0, 0, 138, 0,
+	0, 0, 0, 0, 152, 0, 0, 910, 958, 0,
+	0, 838, 416, 0, 432, 443, 0, 836, 792, 0,
+	0, 128, 129, 0, 0, 139, 0, 0, 0, 0,
+	0, 0, 934, 119, 197, 952, 946, 40, 336, 0,
+	0, 365, 84, 130, 131, 132, 133, 134, 0, 136,
+	0, 0, 0, 148, 149, 150, 0, 157, 159, 160,
+	161, 0, 163, 164, 153, 417, 0, 0, 366, 367,
+	0, 94, 0, 0, 0, 0, 0, 0, 145, 0,
+	151, 0, 162, 0, 793, 368, 0, 369, 0, 0,
+	0, 0, 0, 140, 141, 0, 0, 0, 146, 0,
+	158, 0, 0, 380, 382, 383, 0, 0, 381, 135,
+	137, 0, 143, 0, 0, 444, 445, 384, 385, 386,
+	0, 0, 0, 142, 0, 147, 144,
+}
+var yyTok1 = [...]int{
+
+	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 89, 3, 3, 3, 117, 109, 3,
+	68, 70, 114, 112, 69, 113, 127, 115, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 437,
+	98, 97, 99, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 119, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 108, 3, 120,
+}
+var yyTok2 = [...]int{
+
+	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
+	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
+	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
+	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
+	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
+	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
+	62, 63, 64, 65, 66, 67, 71, 72, 73, 74,
+	75, 76, 77, 78, 79, 80, 81, 82, 83, 84,
+	85, 86, 87, 88, 90, 91, 92, 93, 94, 95,
+	96, 100, 101, 102, 103, 104, 105, 106, 107, 110,
+	111, 116, 118, 121, 122, 123, 124, 125, 126, 128,
+	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
+	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
+	149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
+	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
+	169, 170, 171, 172, 173, 174, 175, 176, 177, 178,
+	179, 180, 181, 182, 183, 184, 185, 186, 187, 188,
+	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 203, 204, 205, 206, 207, 208,
+	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
+	219, 220, 221, 222, 223, 224, 225, 226, 227, 228,
+	229, 230, 231, 232, 233, 234, 235, 236, 237, 238,
+	239, 240, 241, 242, 243, 244, 245, 246, 247, 248,
+	249, 250, 251, 252, 253, 254, 255, 256, 257, 258,
+	259, 260, 261, 262, 263, 264, 265, 266, 267, 268,
+	269, 270, 271, 272, 273, 274,
+}
+var yyTok3 = [...]int{
+	57600, 275, 57601, 276, 57602, 277, 57603, 278, 57604, 279,
+	57605, 280, 57606, 281, 57607, 282, 57608, 283, 57609, 284,
+	57610, 285, 57611, 286, 57612, 287, 57613, 288, 57614, 289,
+	57615, 290, 57616, 291, 57617, 292, 57618, 293, 57619, 294,
+	57620, 295, 57621, 296, 57622, 297, 57623, 298, 57624, 299,
+	57625, 300, 57626, 301, 57627, 302, 57628, 303, 57629, 304,
+	57630, 305, 57631, 306, 57632, 307, 57633, 308, 57634, 309,
+	57635, 310, 57636, 311, 57637, 312, 57638, 313, 57639, 314,
+	57640, 315, 57641, 316, 57642, 317, 57643, 318, 57644, 319,
+	57645, 320, 57646, 321, 57647, 322, 57648, 323, 57649, 324,
+	57650, 325, 57651, 326, 57652, 327, 57653, 328, 57654, 329,
+	57655, 330, 57656, 331, 57657, 332, 57658, 333, 57659, 334,
+	57660, 335, 57661, 336, 57662, 337, 57663, 338, 57664, 339,
+	57665, 340, 57666, 341, 57667, 342, 57668, 343, 57669, 344,
+	57670, 345, 57671, 346, 57672, 347, 57673, 348, 57674, 349,
+	57675, 350, 57676, 351, 57677, 352, 57678, 353, 57679, 354,
+	57680, 355, 57681, 356, 57682, 357, 57683, 358, 57684, 359,
+	57685, 360, 57686, 361, 57687, 362, 57688, 363, 57689, 364,
+	57690, 365, 57691, 366, 57692, 367, 57693, 368, 57694, 369,
+	57695, 370, 57696, 371, 57697, 372, 57698, 373, 57699, 374,
+	57700, 375, 57701, 376, 57702, 377, 57703, 378, 57704, 379,
+	57705, 380, 57706, 381, 57707, 382, 57708, 383, 57709, 384,
+	57710, 385, 57711, 386, 57712, 387, 57713, 388, 57714, 389,
+	57715, 390, 57716, 391, 57717, 392, 57718, 393, 57719, 394,
+	57720, 395, 57721, 396, 57722, 397, 57723, 398, 57724, 399,
+	57725, 400, 57726, 401, 57727, 402, 57728, 403, 57729, 404,
+	57730, 405, 57731, 406, 57732, 407, 57733, 408, 57734, 409,
+	57735, 410, 57736, 411, 57737, 412, 57738, 413, 57739, 414,
+	57740, 415, 57741, 416, 57742, 417, 57743, 418, 57744, 419,
+	57745, 420, 57746, 421, 57747, 422, 57748, 423, 57749, 424,
+	57750, 425, 57751, 426, 57752, 427, 57753, 428, 57754, 429,
+	57755, 430, 57756, 431, 57757, 432, 57758, 433, 57759, 434,
+	57760, 435, 57761, 436, 0,
+}
+
+var yyErrorMessages = [...]struct {
+	state int
+	token int
+	msg   string
+}{}
+
+//line yaccpar:1
+
+/*	parser for yacc output	*/
+
+var (
+	yyDebug        = 0
+	yyErrorVerbose = false
+)
+
+type yyLexer interface {
+	Lex(lval *yySymType) int
+	Error(s string)
+}
+
+type yyParser interface {
+	Parse(yyLexer) int
+	Lookahead() int
+}
+
+type yyParserImpl struct {
+	lval  yySymType
+	stack [yyInitialStackSize]yySymType
+	char  int
+}
+
+func (p *yyParserImpl) Lookahead() int {
+	return p.char
+}
+
+func yyNewParser() yyParser {
+	return &yyParserImpl{}
+}
+
+const yyFlag = -1000
+
+func yyTokname(c int) string {
+	if c >= 1 && c-1 < len(yyToknames) {
+		if yyToknames[c-1] != "" {
+			return yyToknames[c-1]
+		}
+	}
+	return __yyfmt__.Sprintf("tok-%v", c)
+}
+
+func yyStatname(s int) string {
+	if s >= 0 && s < len(yyStatenames) {
+		if yyStatenames[s] != "" {
+			return yyStatenames[s]
+		}
+	}
+	return __yyfmt__.Sprintf("state-%v", s)
+}
+
+func yyErrorMessage(state, lookAhead int) string {
+	const TOKSTART = 4
+
+	if !yyErrorVerbose {
+		return "syntax error"
+	}
+
+	for _, e := range yyErrorMessages {
+		if e.state == state && e.token == lookAhead {
+			return "syntax error: " + e.msg
+		}
+	}
+
+	res := "syntax error: unexpected " + yyTokname(lookAhead)
+
+	// To match Bison, suggest at most four expected tokens.
+	expected := make([]int, 0, 4)
+
+	// Look for shiftable tokens.
+	base := yyPact[state]
+	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
+		if n := base + tok; n >= 0 && n < yyLast && yyChk[yyAct[n]] == tok {
+			if len(expected) == cap(expected) {
+				return res
+			}
+			expected = append(expected, tok)
+		}
+	}
+
+	if yyDef[state] == -2 {
+		i := 0
+		for yyExca[i] != -1 || yyExca[i+1] != state {
+			i += 2
+		}
+
+		// Look for tokens that we accept or reduce.
+		for i += 2; yyExca[i] >= 0; i += 2 {
+			tok := yyExca[i]
+			if tok < TOKSTART || yyExca[i+1] == 0 {
+				continue
+			}
+			if len(expected) == cap(expected) {
+				return res
+			}
+			expected = append(expected, tok)
+		}
+
+		// If the default action is to accept or reduce, give up.
+		if yyExca[i+1] != 0 {
+			return res
+		}
+	}
+
+	for i, tok := range expected {
+		if i == 0 {
+			res += ", expecting "
+		} else {
+			res += " or "
+		}
+		res += yyTokname(tok)
+	}
+	return res
+}
+
+func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
+	token = 0
+	char = lex.Lex(lval)
+	if char <= 0 {
+		token = yyTok1[0]
+		goto out
+	}
+	if char < len(yyTok1) {
+		token = yyTok1[char]
+		goto out
+	}
+	if char >= yyPrivate {
+		if char < yyPrivate+len(yyTok2) {
+			token = yyTok2[char-yyPrivate]
+			goto out
+		}
+	}
+	for i := 0; i < len(yyTok3); i += 2 {
+		token = yyTok3[i+0]
+		if token == char {
+			token = yyTok3[i+1]
+			goto out
+		}
+	}
+
+out:
+	if token == 0 {
+		token = yyTok2[1] /* unknown char */
+	}
+	if yyDebug >= 3 {
+		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
+	}
+	return char, token
+}
+
+func yyParse(yylex yyLexer) int {
+	return yyNewParser().Parse(yylex)
+}
+
+func (yyrcvr *yyParserImpl) Parse(yylex yyLexer) int {
+	var yyn int
+	var yyVAL yySymType
+	var yyDollar []yySymType
+	_ = yyDollar // silence set and not used
+	yyS := yyrcvr.stack[:]
+
+	Nerrs := 0   /* number of errors */
+	Errflag := 0 /* error recovery flag */
+	yystate := 0
+	yyrcvr.char = -1
+	yytoken := -1 // yyrcvr.char translated into internal numbering
+	defer func() {
+		// Make sure we report no lookahead when not parsing.
+		yystate = -1
+		yyrcvr.char = -1
+		yytoken = -1
+	}()
+	yyp := -1
+	goto yystack
+
+ret0:
+	return 0
+
+ret1:
+	return 1
+
+yystack:
+	/* put a state and value onto the stack */
+	if yyDebug >= 4 {
+		__yyfmt__.Printf("char %v in %v\n", yyTokname(yytoken), yyStatname(yystate))
+	}
+
+	yyp++
+	if yyp >= len(yyS) {
+		nyys := make([]yySymType, len(yyS)*2)
+		copy(nyys, yyS)
+		yyS = nyys
+	}
+	yyS[yyp] = yyVAL
+	yyS[yyp].yys = yystate
+
+yynewstate:
+	yyn = yyPact[yystate]
+	if yyn <= yyFlag {
+		goto yydefault /* simple state */
+	}
+	if yyrcvr.char < 0 {
+		yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
+	}
+	yyn += yytoken
+	if yyn < 0 || yyn >= yyLast {
+		goto yydefault
+	}
+	yyn = yyAct[yyn]
+	if yyChk[yyn] == yytoken { /* valid shift */
+		yyrcvr.char = -1
+		yytoken = -1
+		yyVAL = yyrcvr.lval
+		yystate = yyn
+		if Errflag > 0 {
+			Errflag--
+		}
+		goto yystack
+	}
+
+yydefault:
+	/* default state action */
+	yyn = yyDef[yystate]
+	if yyn == -2 {
+		if yyrcvr.char < 0 {
+			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
+		}
+
+		/* look through exception table */
+		xi := 0
+		for {
+			if yyExca[xi+0] == -1 && yyExca[xi+1] == yystate {
+				break
+			}
+			xi += 2
+		}
+		for xi += 2; ; xi += 2 {
+			yyn = yyExca[xi+0]
+			if yyn < 0 || yyn == yytoken {
+				break
+			}
+		}
+		yyn = yyExca[xi+1]
+		if yyn < 0 {
+			goto ret0
+		}
+	}
+	if yyn == 0 {
+		/* error ... attempt to resume parsing */
+		switch Errflag {
+		case 0: /* brand new error */
+			yylex.Error(yyErrorMessage(yystate, yytoken))
+			Nerrs++
+			if yyDebug >= 1 {
+				__yyfmt__.Printf("%s", yyStatname(yystate))
+				__yyfmt__.Printf(" saw %s\n", yyTokname(yytoken))
+			}
+			fallthrough
+
+		case 1, 2: /* incompletely recovered error ... try again */
+			Errflag = 3
+
+			/* find a state where "error" is a legal shift action */
+			for yyp >= 0 {
+				yyn = yyPact[yyS[yyp].yys] + yyErrCode
+				if yyn >= 0 && yyn < yyLast {
+					yystate = yyAct[yyn] /* simulate a shift of "error" */
+					if yyChk[yystate] == yyErrCode {
+						goto yystack
+					}
+				}
+
+				/* the current p has no shift on "error", pop stack */
+				if yyDebug >= 2 {
+					__yyfmt__.Printf("error recovery pops state %d\n", yyS[yyp].yys)
+				}
+				yyp--
+			}
+			/* there is no state on the stack with an error shift ... abort */
+			goto ret1
+
+		case 3: /* no shift yet; clobber input char */
+			if yyDebug >= 2 {
+				__yyfmt__.Printf("error recovery discards %s\n", yyTokname(yytoken))
+			}
+			if yytoken == yyEofCode {
+				goto ret1
+			}
+			yyrcvr.char = -1
+			yytoken = -1
+			goto yynewstate /* try again in the same state */
+		}
+	}
+
+	/* reduction by production yyn */
+	if yyDebug >= 2 {
+		__yyfmt__.Printf("reduce %v in:\n\t%v\n", yyn, yyStatname(yystate))
+	}
+
+	yynt := yyn
+	yypt := yyp
+	_ = yypt // guard against "declared and not used"
+
+	yyp -= yyR2[yyn]
+	// yyp is now the index of $0. Perform the default action. Iff the
+	// reduced production is ε, $1 is possibly out of range.
+	if yyp+1 >= len(yyS) {
+		nyys := make([]yySymType, len(yyS)*2)
+		copy(nyys, yyS)
+		yyS = nyys
+	}
+	yyVAL = yyS[yyp+1]
+
+	/* consult goto table to find next state */
+	yyn = yyR1[yyn]
+	yyg := yyPgo[yyn]
+	yyj := yyg + yyS[yyp].yys + 1
+
+	if yyj >= yyLast {
+		yystate = yyAct[yyg]
+	} else {
+		yystate = yyAct[yyj]
+		if yyChk[yystate] != -yyn {
+			yystate = yyAct[yyg]
+		}
+	}
+	// dummy call; replaced with literal code
+	switch yynt {
+
+	case 1:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:421
+		{
+			setParseTree(yylex, yyDollar[1].statement)
+		}
+	case 2:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:426
+		{
+		}
+	case 3:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:427
+		{
+		}
+	case 4:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:431
+		{
+			yyVAL.statement = yyDollar[1].selStmt
+		}
+	case 34:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:464
+		{
+			setParseTree(yylex, nil)
+		}
+	case 35:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:470
+		{
+			yyVAL.statement = &Load{Local: yyDollar[3].boolVal, Infile: yyDollar[4].str, Table: yyDollar[5].tableName, Partition: yyDollar[6].partitions, Charset: yyDollar[7].str, Fields: yyDollar[8].Fields, Lines: yyDollar[9].Lines, IgnoreNum: yyDollar[10].sqlVal, Columns: yyDollar[11].columns}
+		}
+	case 36:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:476
+		{
+			yyDollar[1].selStmt.SetOrderBy(yyDollar[2].orderBy)
+			yyDollar[1].selStmt.SetLimit(yyDollar[3].limit)
+			yyDollar[1].selStmt.SetLock(yyDollar[4].str)
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 37:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:483
+		{
+			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, SelectExprs: SelectExprs{Nextval{Expr: yyDollar[5].expr}}, From: TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}}
+		}
+	case 38:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:489
+		{
+			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
+		}
+	case 39:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:496
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 40:
+		yyDollar = yyS[yypt-13 : yypt+1]
+//line sql.y:500
+		{
+			yyVAL.selStmt = &Select{CommonTableExprs: yyDollar[1].tableExprs, Comments: Comments(yyDollar[3].bytes2), Cache: yyDollar[4].str, Distinct: yyDollar[5].str, Hints: yyDollar[7].str, SelectExprs: yyDollar[8].selectExprs, From: yyDollar[10].tableExprs, Where: NewWhere(WhereStr, yyDollar[11].expr), GroupBy: GroupBy(yyDollar[12].exprs), Having: NewWhere(HavingStr, yyDollar[13].expr)}
+			if yyDollar[6].byt == 1 {
+				yyVAL.selStmt.(*Select).CalcFoundRows = true
+			}
+		}
+	case 41:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:507
+		{
+			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt}
+		}
+	case 42:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:513
+		{
+			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[6].str, SelectExprs: yyDollar[7].selectExprs, From: TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr)}
+			if yyDollar[5].byt == 1 {
+				yyVAL.selStmt.(*Select).CalcFoundRows = true
+			}
+		}
+	case 43:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:520
+		{
+			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[6].str, SelectExprs: yyDollar[7].selectExprs, From: yyDollar[9].tableExprs, Where: NewWhere(WhereStr, yyDollar[10].expr), GroupBy: GroupBy(yyDollar[11].exprs), Having: NewWhere(HavingStr, yyDollar[12].expr)}
+			if yyDollar[5].byt == 1 {
+				yyVAL.selStmt.(*Select).CalcFoundRows = true
+			}
+		}
+	case 44:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:529
+		{
+			yyVAL.tableExprs = yyDollar[2].tableExprs
+		}
+	case 45:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:535
+		{
+			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
+		}
+	case 46:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:539
+		{
+			yyVAL.tableExprs = append(yyDollar[1].tableExprs, yyDollar[3].tableExpr)
+		}
+	case 47:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:545
+		{
+			yyVAL.tableExpr = &CommonTableExpr{&AliasedTableExpr{Expr: yyDollar[3].simpleTableExpr, As: yyDollar[1].tableIdent}, nil}
+		}
+	case 48:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:549
+		{
+			yyVAL.tableExpr = &CommonTableExpr{&AliasedTableExpr{Expr: yyDollar[6].simpleTableExpr, As: yyDollar[1].tableIdent}, yyDollar[3].columns}
+		}
+	case 49:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:555
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 50:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:559
+		{
+			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
+		}
+	case 51:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:565
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 52:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:569
+		{
+			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
+		}
+	case 53:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:575
+		{
+			// insert_data returns a *Insert pre-filled with Columns & Values
+			ins := yyDollar[6].ins
+			ins.Action = yyDollar[1].str
+			ins.Comments = yyDollar[2].bytes2
+			ins.Ignore = yyDollar[3].str
+			ins.Table = yyDollar[4].tableName
+			ins.Partitions = yyDollar[5].partitions
+			ins.OnDup = OnDup(yyDollar[7].assignExprs)
+			yyVAL.statement = ins
+		}
+	case 54:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:587
+		{
+			cols := make(Columns, 0, len(yyDollar[7].assignExprs))
+			vals := make(ValTuple, 0, len(yyDollar[8].assignExprs))
+			for _, updateList := range yyDollar[7].assignExprs {
+				cols = append(cols, updateList.Name.Name)
+				vals = append(vals, updateList.Expr)
+			}
+			yyVAL.statement = &Insert{Action: yyDollar[1].str, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].assignExprs)}
+		}
+	case 55:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:599
+		{
+			yyVAL.str = InsertStr
+		}
+	case 56:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:603
+		{
+			yyVAL.str = ReplaceStr
+		}
+	case 57:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:609
+		{
+			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, TableExprs: yyDollar[4].tableExprs, Exprs: yyDollar[6].assignExprs, Where: NewWhere(WhereStr, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
+		}
+	case 58:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:615
+		{
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[4].tableName}}, Partitions: yyDollar[5].partitions, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
+		}
+	case 59:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:619
+		{
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereStr, yyDollar[7].expr)}
+		}
+	case 60:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:623
+		{
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
+		}
+	case 61:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:627
+		{
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
+		}
+	case 62:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:632
+		{
+		}
+	case 63:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:633
+		{
+		}
+	case 64:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:637
+		{
+			yyVAL.tableNames = TableNames{yyDollar[1].tableName.ToViewName()}
+		}
+	case 65:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:641
+		{
+			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName.ToViewName())
+		}
+	case 66:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:647
+		{
+			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
+		}
+	case 67:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:651
+		{
+			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
+		}
+	case 68:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:657
+		{
+			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
+		}
+	case 69:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:661
+		{
+			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
+		}
+	case 70:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:666
+		{
+			yyVAL.partitions = nil
+		}
+	case 71:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:670
+		{
+			yyVAL.partitions = yyDollar[3].partitions
+		}
+	case 72:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:676
+		{
+			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setVarExprs}
+		}
+	case 73:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:680
+		{
+			for i := 0; i < len(yyDollar[4].setVarExprs); i++ {
+				yyDollar[4].setVarExprs[i].Scope = SetScope_None
+			}
+			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[4].setVarExprs}
+		}
+	case 74:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:687
+		{
+			for i := 0; i < len(yyDollar[5].setVarExprs); i++ {
+				yyDollar[5].setVarExprs[i].Scope = yyDollar[3].setScope
+			}
+			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[5].setVarExprs}
+		}
+	case 75:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:696
+		{
+			yyVAL.setVarExprs = SetVarExprs{yyDollar[1].setVarExpr}
+		}
+	case 76:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:700
+		{
+			yyVAL.setVarExprs = append(yyVAL.setVarExprs, yyDollar[3].setVarExpr)
+		}
+	case 77:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:706
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: NewColName(TransactionStr), Expr: NewStrVal([]byte(yyDollar[3].str))}
+		}
+	case 78:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:710
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: NewColName(TransactionStr), Expr: NewStrVal([]byte(TxReadWrite))}
+		}
+	case 79:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:714
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: NewColName(TransactionStr), Expr: NewStrVal([]byte(TxReadOnly))}
+		}
+	case 80:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:720
+		{
+			yyVAL.str = IsolationLevelRepeatableRead
+		}
+	case 81:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:724
+		{
+			yyVAL.str = IsolationLevelReadCommitted
+		}
+	case 82:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:728
+		{
+			yyVAL.str = IsolationLevelReadUncommitted
+		}
+	case 83:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:732
+		{
+			yyVAL.str = IsolationLevelSerializable
+		}
+	case 84:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:737
+		{
+			yyVAL.int = yyPosition(yylex)
+		}
+	case 85:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:742
+		{
+			yyVAL.int = yyOldPosition(yylex)
+		}
+	case 86:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:748
+		{
+			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
+			if len(yyDollar[1].ddl.TableSpec.Constraints) > 0 {
+				yyDollar[1].ddl.ConstraintAction = AddStr
+			}
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 87:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:757
+		{
+			yyDollar[1].ddl.OptSelect = &OptSelect{Select: yyDollar[3].selStmt}
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 88:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:762
+		{
+			yyDollar[1].ddl.OptLike = &OptLike{LikeTable: yyDollar[3].tableName}
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 89:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:767
+		{
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[7].tableName, IndexSpec: &IndexSpec{Action: CreateStr, ToName: yyDollar[4].colIdent, Using: yyDollar[5].colIdent, Type: yyDollar[2].str, Columns: yyDollar[9].indexColumns, Options: yyDollar[11].indexOptions}}
+		}
+	case 90:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:771
+		{
+			yyVAL.statement = &DDL{Action: CreateStr, View: yyDollar[3].tableName.ToViewName(), ViewExpr: yyDollar[6].selStmt, SubStatementPositionStart: yyDollar[5].int, SubStatementPositionEnd: yyDollar[7].int - 1}
+		}
+	case 91:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:775
+		{
+			yyVAL.statement = &DDL{Action: CreateStr, View: yyDollar[5].tableName.ToViewName(), ViewExpr: yyDollar[8].selStmt, SubStatementPositionStart: yyDollar[7].int, SubStatementPositionEnd: yyDollar[9].int - 1, OrReplace: true}
+		}
+	case 92:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:779
+		{
+			var ne bool
+			if yyDollar[3].byt != 0 {
+				ne = true
+			}
+			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes), IfNotExists: ne}
+		}
+	case 93:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:787
+		{
+			var ne bool
+			if yyDollar[3].byt != 0 {
+				ne = true
+			}
+			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes), IfNotExists: ne}
+		}
+	case 94:
+		yyDollar = yyS[yypt-15 : yypt+1]
+//line sql.y:795
+		{
+			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[8].tableName, TriggerSpec: &TriggerSpec{Name: string(yyDollar[4].bytes), Time: yyDollar[5].str, Event: yyDollar[6].str, Order: yyDollar[12].triggerOrder, Body: yyDollar[14].statement}, SubStatementPositionStart: yyDollar[13].int, SubStatementPositionEnd: yyDollar[15].int - 1}
+		}
+	case 95:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:799
+		{
+			yyVAL.statement = &DDL{Action: CreateStr, ProcedureSpec: &ProcedureSpec{Name: string(yyDollar[4].bytes), Definer: yyDollar[2].str, Params: yyDollar[6].procedureParams, Characteristics: yyDollar[8].characteristics, Body: yyDollar[10].statement}, SubStatementPositionStart: yyDollar[9].int, SubStatementPositionEnd: yyDollar[11].int - 1}
+		}
+	case 96:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:806
+		{
+			yyDollar[1].selStmt.SetOrderBy(yyDollar[2].orderBy)
+			yyDollar[1].selStmt.SetLimit(yyDollar[3].limit)
+			yyDollar[1].selStmt.SetLock(yyDollar[4].str)
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 97:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:814
+		{
+			yyVAL.procedureParams = nil
+		}
+	case 98:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:818
+		{
+			yyVAL.procedureParams = yyDollar[1].procedureParams
+		}
+	case 99:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:824
+		{
+			yyVAL.procedureParams = []ProcedureParam{yyDollar[1].procedureParam}
+		}
+	case 100:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:828
+		{
+			yyVAL.procedureParams = append(yyVAL.procedureParams, yyDollar[3].procedureParam)
+		}
+	case 101:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:834
+		{
+			yyVAL.procedureParam = ProcedureParam{Direction: ProcedureParamDirection_In, Name: string(yyDollar[1].bytes), Type: yyDollar[2].columnType}
+		}
+	case 102:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:838
+		{
+			yyVAL.procedureParam = ProcedureParam{Direction: ProcedureParamDirection_In, Name: string(yyDollar[2].bytes), Type: yyDollar[3].columnType}
+		}
+	case 103:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:842
+		{
+			yyVAL.procedureParam = ProcedureParam{Direction: ProcedureParamDirection_Inout, Name: string(yyDollar[2].bytes), Type: yyDollar[3].columnType}
+		}
+	case 104:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:846
+		{
+			yyVAL.procedureParam = ProcedureParam{Direction: ProcedureParamDirection_Out, Name: string(yyDollar[2].bytes), Type: yyDollar[3].columnType}
+		}
+	case 105:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:851
+		{
+			yyVAL.characteristics = nil
+		}
+	case 106:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:855
+		{
+			yyVAL.characteristics = yyDollar[1].characteristics
+		}
+	case 107:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:861
+		{
+			yyVAL.characteristics = []Characteristic{yyDollar[1].characteristic}
+		}
+	case 108:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:865
+		{
+			yyVAL.characteristics = append(yyVAL.characteristics, yyDollar[2].characteristic)
+		}
+	case 109:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:871
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_Comment, Comment: string(yyDollar[2].bytes)}
+		}
+	case 110:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:875
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_LanguageSql}
+		}
+	case 111:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:879
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_NotDeterministic}
+		}
+	case 112:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:883
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_Deterministic}
+		}
+	case 113:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:887
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_ContainsSql}
+		}
+	case 114:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:891
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_NoSql}
+		}
+	case 115:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:895
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_ReadsSqlData}
+		}
+	case 116:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:899
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_ModifiesSqlData}
+		}
+	case 117:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:903
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_SqlSecurityDefiner}
+		}
+	case 118:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:907
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_SqlSecurityInvoker}
+		}
+	case 119:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:913
+		{
+			yyVAL.statement = &BeginEndBlock{Statements: yyDollar[2].statements}
+		}
+	case 120:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:918
+		{
+			yyVAL.str = ""
+		}
+	case 121:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:922
+		{
+			yyVAL.str = string(yyDollar[3].bytes)
+		}
+	case 122:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:928
+		{
+			yyVAL.str = BeforeStr
+		}
+	case 123:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:932
+		{
+			yyVAL.str = AfterStr
+		}
+	case 124:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:938
+		{
+			yyVAL.str = InsertStr
+		}
+	case 125:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:942
+		{
+			yyVAL.str = UpdateStr
+		}
+	case 126:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:946
+		{
+			yyVAL.str = DeleteStr
+		}
+	case 127:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:951
+		{
+			yyVAL.triggerOrder = nil
+		}
+	case 128:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:955
+		{
+			yyVAL.triggerOrder = &TriggerOrder{PrecedesOrFollows: FollowsStr, OtherTriggerName: string(yyDollar[2].bytes)}
+		}
+	case 129:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:959
+		{
+			yyVAL.triggerOrder = &TriggerOrder{PrecedesOrFollows: PrecedesStr, OtherTriggerName: string(yyDollar[2].bytes)}
+		}
+	case 130:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:965
+		{
+			yyVAL.statement = yyDollar[1].statement
+		}
+	case 135:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:975
+		{
+			yyVAL.statement = &BeginEndBlock{Statements: yyDollar[2].statements}
+		}
+	case 136:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:981
+		{
+			yyVAL.statement = &CaseStatement{Expr: yyDollar[2].expr, Cases: yyDollar[3].caseStatementCases}
+		}
+	case 137:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:985
+		{
+			yyVAL.statement = &CaseStatement{Expr: yyDollar[2].expr, Cases: yyDollar[3].caseStatementCases, Else: yyDollar[5].statements}
+		}
+	case 138:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:991
+		{
+			yyVAL.caseStatementCases = []CaseStatementCase{yyDollar[1].caseStatementCase}
+		}
+	case 139:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:995
+		{
+			yyVAL.caseStatementCases = append(yyVAL.caseStatementCases, yyDollar[2].caseStatementCase)
+		}
+	case 140:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1001
+		{
+			yyVAL.caseStatementCase = CaseStatementCase{Case: yyDollar[2].expr, Statements: yyDollar[4].statements}
+		}
+	case 141:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1007
+		{
+			conds := []IfStatementCondition{IfStatementCondition{Expr: yyDollar[2].expr, Statements: yyDollar[4].statements}}
+			yyVAL.statement = &IfStatement{Conditions: conds}
+		}
+	case 142:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:1012
+		{
+			conds := []IfStatementCondition{IfStatementCondition{Expr: yyDollar[2].expr, Statements: yyDollar[4].statements}}
+			yyVAL.statement = &IfStatement{Conditions: conds, Else: yyDollar[7].statements}
+		}
+	case 143:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:1017
+		{
+			conds := yyDollar[6].ifStatementConditions
+			conds = append([]IfStatementCondition{IfStatementCondition{Expr: yyDollar[2].expr, Statements: yyDollar[4].statements}}, conds...)
+			yyVAL.statement = &IfStatement{Conditions: conds}
+		}
+	case 144:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:1023
+		{
+			conds := yyDollar[6].ifStatementConditions
+			conds = append([]IfStatementCondition{IfStatementCondition{Expr: yyDollar[2].expr, Statements: yyDollar[4].statements}}, conds...)
+			yyVAL.statement = &IfStatement{Conditions: conds, Else: yyDollar[8].statements}
+		}
+	case 145:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1031
+		{
+			yyVAL.ifStatementConditions = []IfStatementCondition{yyDollar[1].ifStatementCondition}
+		}
+	case 146:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1035
+		{
+			yyVAL.ifStatementConditions = append(yyVAL.ifStatementConditions, yyDollar[2].ifStatementCondition)
+		}
+	case 147:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1041
+		{
+			yyVAL.ifStatementCondition = IfStatementCondition{Expr: yyDollar[2].expr, Statements: yyDollar[4].statements}
+		}
+	case 148:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1047
+		{
+			yyVAL.statement = &Declare{Condition: &DeclareCondition{Name: string(yyDollar[2].bytes), SqlStateValue: string(yyDollar[5].bytes)}}
+		}
+	case 149:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1051
+		{
+			yyVAL.statement = &Declare{Condition: &DeclareCondition{Name: string(yyDollar[2].bytes), MysqlErrorCode: NewIntVal(yyDollar[5].bytes)}}
+		}
+	case 150:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1055
+		{
+			yyVAL.statement = &Declare{Cursor: &DeclareCursor{Name: string(yyDollar[2].bytes), SelectStmt: yyDollar[5].selStmt}}
+		}
+	case 151:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1059
+		{
+			yyVAL.statement = &Declare{Handler: &DeclareHandler{Action: yyDollar[2].declareHandlerAction, ConditionValues: yyDollar[5].declareHandlerConditions, Statement: yyDollar[6].statement}}
+		}
+	case 152:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1063
+		{
+			yyVAL.statement = &Declare{Variables: &DeclareVariables{Names: yyDollar[2].colIdents, VarType: yyDollar[3].columnType}}
+		}
+	case 153:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1067
+		{
+			yyDollar[3].columnType.Default = yyDollar[5].expr
+			yyVAL.statement = &Declare{Variables: &DeclareVariables{Names: yyDollar[2].colIdents, VarType: yyDollar[3].columnType}}
+		}
+	case 154:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1074
+		{
+			yyVAL.declareHandlerAction = DeclareHandlerAction_Continue
+		}
+	case 155:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1078
+		{
+			yyVAL.declareHandlerAction = DeclareHandlerAction_Exit
+		}
+	case 156:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1082
+		{
+			yyVAL.declareHandlerAction = DeclareHandlerAction_Undo
+		}
+	case 157:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1088
+		{
+			yyVAL.declareHandlerConditions = []DeclareHandlerCondition{yyDollar[1].declareHandlerCondition}
+		}
+	case 158:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1092
+		{
+			yyVAL.declareHandlerConditions = append(yyVAL.declareHandlerConditions, yyDollar[3].declareHandlerCondition)
+		}
+	case 159:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1098
+		{
+			yyVAL.declareHandlerCondition = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_MysqlErrorCode, MysqlErrorCode: NewIntVal(yyDollar[1].bytes)}
+		}
+	case 160:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1102
+		{
+			yyVAL.declareHandlerCondition = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_SqlState, String: string(yyDollar[1].bytes)}
+		}
+	case 161:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1106
+		{
+			yyVAL.declareHandlerCondition = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_SqlWarning}
+		}
+	case 162:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1110
+		{
+			yyVAL.declareHandlerCondition = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_NotFound}
+		}
+	case 163:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1114
+		{
+			yyVAL.declareHandlerCondition = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_SqlException}
+		}
+	case 164:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1118
+		{
+			yyVAL.declareHandlerCondition = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_ConditionName, String: string(yyDollar[1].bytes)}
+		}
+	case 165:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1124
+		{
+			yyVAL.statement = &Signal{SqlStateValue: string(yyDollar[2].bytes)}
+		}
+	case 166:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1128
+		{
+			yyVAL.statement = &Signal{SqlStateValue: string(yyDollar[2].bytes), Info: yyDollar[4].signalInfos}
+		}
+	case 167:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1132
+		{
+			yyVAL.statement = &Signal{ConditionName: string(yyDollar[2].bytes)}
+		}
+	case 168:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1136
+		{
+			yyVAL.statement = &Signal{ConditionName: string(yyDollar[2].bytes), Info: yyDollar[4].signalInfos}
+		}
+	case 169:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1142
+		{
+			yyVAL.bytes = yyDollar[2].bytes
+		}
+	case 170:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1146
+		{
+			yyVAL.bytes = yyDollar[3].bytes
+		}
+	case 171:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1152
+		{
+			yyVAL.signalInfos = []SignalInfo{yyDollar[1].signalInfo}
+		}
+	case 172:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1156
+		{
+			yyVAL.signalInfos = append(yyVAL.signalInfos, yyDollar[3].signalInfo)
+		}
+	case 173:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1162
+		{
+			yyVAL.signalInfo = SignalInfo{ConditionItemName: yyDollar[1].signalConditionItemName, Value: yyDollar[3].expr.(*SQLVal)}
+		}
+	case 174:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1168
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_ClassOrigin
+		}
+	case 175:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1172
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_SubclassOrigin
+		}
+	case 176:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1176
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_MessageText
+		}
+	case 177:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1180
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_MysqlErrno
+		}
+	case 178:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1184
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_ConstraintCatalog
+		}
+	case 179:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1188
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_ConstraintSchema
+		}
+	case 180:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1192
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_ConstraintName
+		}
+	case 181:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1196
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_CatalogName
+		}
+	case 182:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1200
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_SchemaName
+		}
+	case 183:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1204
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_TableName
+		}
+	case 184:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1208
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_ColumnName
+		}
+	case 185:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1212
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_CursorName
+		}
+	case 186:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1218
+		{
+			yyVAL.statement = &Resignal{}
+		}
+	case 187:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1222
+		{
+			yyVAL.statement = &Resignal{Signal{SqlStateValue: string(yyDollar[2].bytes)}}
+		}
+	case 188:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1226
+		{
+			yyVAL.statement = &Resignal{Signal{SqlStateValue: string(yyDollar[2].bytes), Info: yyDollar[4].signalInfos}}
+		}
+	case 189:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1230
+		{
+			yyVAL.statement = &Resignal{Signal{Info: yyDollar[3].signalInfos}}
+		}
+	case 190:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1234
+		{
+			yyVAL.statement = &Resignal{Signal{ConditionName: string(yyDollar[2].bytes)}}
+		}
+	case 191:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1238
+		{
+			yyVAL.statement = &Resignal{Signal{ConditionName: string(yyDollar[2].bytes), Info: yyDollar[4].signalInfos}}
+		}
+	case 192:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1244
+		{
+			yyVAL.statement = &Call{FuncName: string(yyDollar[2].bytes), Params: yyDollar[3].exprs}
+		}
+	case 193:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1249
+		{
+			yyVAL.exprs = nil
+		}
+	case 194:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1253
+		{
+			yyVAL.exprs = nil
+		}
+	case 195:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1257
+		{
+			yyVAL.exprs = yyDollar[2].exprs
+		}
+	case 196:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1263
+		{
+			yyVAL.statements = Statements{yyDollar[1].statement}
+		}
+	case 197:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1267
+		{
+			yyVAL.statements = append(yyVAL.statements, yyDollar[3].statement)
+		}
+	case 198:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1273
+		{
+			yyVAL.statement = yyDollar[1].selStmt
+		}
+	case 225:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1305
+		{
+			var ne bool
+			if yyDollar[4].byt != 0 {
+				ne = true
+			}
+
+			var neTemp bool
+			if yyDollar[2].byt != 0 {
+				neTemp = true
+			}
+
+			yyVAL.ddl = &DDL{Action: CreateStr, Table: yyDollar[5].tableName, IfNotExists: ne, Temporary: neTemp}
+			setDDL(yylex, yyVAL.ddl)
+		}
+	case 226:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1322
+		{
+			yyVAL.TableSpec = yyDollar[2].TableSpec
+			yyVAL.TableSpec.Options = yyDollar[4].str
+		}
+	case 227:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1329
+		{
+			yyVAL.TableSpec = &TableSpec{}
+			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
+		}
+	case 228:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1334
+		{
+			yyVAL.TableSpec = &TableSpec{}
+			yyVAL.TableSpec.AddConstraint(yyDollar[1].constraintDefinition)
+		}
+	case 229:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1339
+		{
+			yyVAL.TableSpec = &TableSpec{}
+			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
+			yyVAL.TableSpec.AddConstraint(yyDollar[2].constraintDefinition)
+		}
+	case 230:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1345
+		{
+			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
+		}
+	case 231:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1349
+		{
+			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
+			yyVAL.TableSpec.AddConstraint(yyDollar[4].constraintDefinition)
+		}
+	case 232:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1354
+		{
+			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
+		}
+	case 233:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1358
+		{
+			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
+		}
+	case 234:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1362
+		{
+			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
+		}
+	case 235:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1368
+		{
+			if err := yyDollar[2].columnType.merge(yyDollar[3].columnType); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
+		}
+	case 236:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1378
+		{
+			if err := yyDollar[2].columnType.merge(yyDollar[3].columnType); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnDefinition = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
+		}
+	case 237:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1387
+		{
+			yyVAL.columnType = ColumnType{}
+		}
+	case 238:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1391
+		{
+			opt := ColumnType{NotNull: yyDollar[2].boolVal, sawnull: true}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 239:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1400
+		{
+			opt := ColumnType{Default: yyDollar[2].optVal}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 240:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1409
+		{
+			opt := ColumnType{OnUpdate: yyDollar[2].optVal}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 241:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1418
+		{
+			opt := ColumnType{Autoincrement: yyDollar[2].boolVal, sawai: true}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 242:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1427
+		{
+			opt := ColumnType{KeyOpt: yyDollar[2].colKeyOpt}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 243:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1436
+		{
+			opt := ColumnType{Comment: yyDollar[2].sqlVal}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 244:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1447
+		{
+			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.columnType.Unsigned = yyDollar[2].boolVal
+			yyVAL.columnType.Zerofill = yyDollar[3].boolVal
+		}
+	case 248:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1458
+		{
+			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.columnType.Length = yyDollar[2].sqlVal
+		}
+	case 249:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1463
+		{
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 250:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1469
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 251:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1473
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 252:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1477
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 253:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1481
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 254:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1485
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 255:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1489
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 256:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1493
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 257:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1497
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 258:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1501
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 259:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1507
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 260:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1513
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 261:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1519
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)}
+			yyVAL.columnType.Length = yyDollar[3].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[3].LengthScaleOption.Scale
+		}
+	case 262:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1525
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 263:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1531
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 264:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1537
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 265:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1543
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 266:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1549
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 267:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1557
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 268:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1561
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 269:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1565
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 270:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1569
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 271:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1573
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 272:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1579
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
+		}
+	case 273:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1583
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
+		}
+	case 274:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1587
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Length: yyDollar[3].sqlVal}
+		}
+	case 275:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1591
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Length: yyDollar[3].sqlVal}
+		}
+	case 276:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1595
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 277:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1599
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
+		}
+	case 278:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1603
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Length: yyDollar[3].sqlVal, Charset: yyDollar[4].str, Collate: yyDollar[5].str}
+		}
+	case 279:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1607
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 280:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1611
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Length: yyDollar[3].sqlVal}
+		}
+	case 281:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1615
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Length: yyDollar[4].sqlVal}
+		}
+	case 282:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1619
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 283:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1623
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 284:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1627
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+		}
+	case 285:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1631
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+		}
+	case 286:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1635
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+		}
+	case 287:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1639
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+		}
+	case 288:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1643
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+		}
+	case 289:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1647
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Charset: yyDollar[3].str, Collate: yyDollar[4].str}
+		}
+	case 290:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1651
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 291:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1655
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 292:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1659
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 293:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1663
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 294:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1667
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 295:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1671
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
+		}
+	case 296:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1676
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
+		}
+	case 297:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1682
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 298:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1686
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 299:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1690
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 300:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1694
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 301:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1698
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 302:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1702
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 303:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1706
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 304:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1710
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 305:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1716
+		{
+			yyVAL.strs = make([]string, 0, 4)
+			yyVAL.strs = append(yyVAL.strs, string(yyDollar[1].bytes))
+		}
+	case 306:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1721
+		{
+			yyVAL.strs = append(yyDollar[1].strs, string(yyDollar[3].bytes))
+		}
+	case 307:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1726
+		{
+			yyVAL.sqlVal = nil
+		}
+	case 308:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1730
+		{
+			yyVAL.sqlVal = NewIntVal(yyDollar[2].bytes)
+		}
+	case 309:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1735
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{}
+		}
+	case 310:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1739
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{
+				Length: NewIntVal(yyDollar[2].bytes),
+				Scale:  NewIntVal(yyDollar[4].bytes),
+			}
+		}
+	case 311:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1747
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{}
+		}
+	case 312:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1751
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{
+				Length: NewIntVal(yyDollar[2].bytes),
+			}
+		}
+	case 313:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1757
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{
+				Length: NewIntVal(yyDollar[2].bytes),
+				Scale:  NewIntVal(yyDollar[4].bytes),
+			}
+		}
+	case 314:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1765
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 315:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1769
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 316:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1774
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 317:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1778
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 318:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1785
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 319:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1789
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 320:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1795
+		{
+			yyVAL.optVal = yyDollar[2].expr
+		}
+	case 321:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1801
+		{
+			yyVAL.optVal = yyDollar[3].expr
+		}
+	case 322:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1807
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 323:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1812
+		{
+			yyVAL.str = ""
+		}
+	case 324:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1816
+		{
+			yyVAL.str = string(yyDollar[3].bytes)
+		}
+	case 325:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1820
+		{
+			yyVAL.str = string(yyDollar[3].bytes)
+		}
+	case 326:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1825
+		{
+			yyVAL.str = ""
+		}
+	case 327:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1829
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 328:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1833
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 329:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1839
+		{
+			yyVAL.colKeyOpt = colKeyPrimary
+		}
+	case 330:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1843
+		{
+			yyVAL.colKeyOpt = colKey
+		}
+	case 331:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1847
+		{
+			yyVAL.colKeyOpt = colKeyUniqueKey
+		}
+	case 332:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1851
+		{
+			yyVAL.colKeyOpt = colKeyUnique
+		}
+	case 333:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1857
+		{
+			yyVAL.sqlVal = NewStrVal(yyDollar[2].bytes)
+		}
+	case 334:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1863
+		{
+			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
+		}
+	case 335:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1867
+		{
+			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns}
+		}
+	case 336:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1872
+		{
+			yyVAL.indexOptions = nil
+		}
+	case 337:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1876
+		{
+			yyVAL.indexOptions = yyDollar[1].indexOptions
+		}
+	case 338:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1882
+		{
+			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
+		}
+	case 339:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1886
+		{
+			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
+		}
+	case 340:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1892
+		{
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Using: string(yyDollar[2].bytes)}
+		}
+	case 341:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1896
+		{
+			// should not be string
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
+		}
+	case 342:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1901
+		{
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[2].bytes)}
+		}
+	case 343:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1907
+		{
+			yyVAL.str = ""
+		}
+	case 344:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1911
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 345:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1917
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
+		}
+	case 346:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1921
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[3].bytes) + " " + string(yyDollar[4].bytes), Name: NewColIdent(string(yyDollar[2].bytes)), Primary: true, Unique: true}
+		}
+	case 347:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1925
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
+		}
+	case 348:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1929
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Unique: true}
+		}
+	case 349:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1933
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(yyDollar[2].str), Unique: true}
+		}
+	case 350:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1937
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
+		}
+	case 351:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1943
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 352:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1947
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 353:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1951
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 354:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1957
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 355:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1961
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 356:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1966
+		{
+			yyVAL.str = ""
+		}
+	case 357:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1970
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 358:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1975
+		{
+			yyVAL.str = ""
+		}
+	case 359:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1979
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 360:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1985
+		{
+			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
+		}
+	case 361:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1989
+		{
+			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
+		}
+	case 362:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1995
+		{
+			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].sqlVal, Order: yyDollar[3].str}
+		}
+	case 363:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2001
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].bytes), Details: yyDollar[3].constraintInfo}
+		}
+	case 364:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2005
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
+		}
+	case 365:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:2011
+		{
+			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns}
+		}
+	case 366:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:2015
+		{
+			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction}
+		}
+	case 367:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:2019
+		{
+			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnUpdate: yyDollar[11].ReferenceAction}
+		}
+	case 368:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:2023
+		{
+			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction, OnUpdate: yyDollar[12].ReferenceAction}
+		}
+	case 369:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:2027
+		{
+			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[12].ReferenceAction, OnUpdate: yyDollar[11].ReferenceAction}
+		}
+	case 370:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2033
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].bytes), Details: yyDollar[3].constraintInfo}
+		}
+	case 371:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2037
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].bytes), Details: yyDollar[3].constraintInfo}
+		}
+	case 372:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2041
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[2].constraintInfo}
+		}
+	case 373:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2045
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
+		}
+	case 374:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2051
+		{
+			yyVAL.constraintInfo = &CheckConstraintDefinition{Expr: yyDollar[3].expr, Enforced: yyDollar[5].boolean}
+		}
+	case 375:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2057
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 376:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2061
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 377:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2066
+		{
+			yyVAL.str = ""
+		}
+	case 378:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2070
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 379:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2074
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 380:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2080
+		{
+			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
+		}
+	case 381:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2086
+		{
+			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
+		}
+	case 382:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2092
+		{
+			yyVAL.ReferenceAction = Restrict
+		}
+	case 383:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2096
+		{
+			yyVAL.ReferenceAction = Cascade
+		}
+	case 384:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2100
+		{
+			yyVAL.ReferenceAction = NoAction
+		}
+	case 385:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2104
+		{
+			yyVAL.ReferenceAction = SetDefault
+		}
+	case 386:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2108
+		{
+			yyVAL.ReferenceAction = SetNull
+		}
+	case 387:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2113
+		{
+			yyVAL.boolean = true
+		}
+	case 388:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2117
+		{
+			yyVAL.boolean = true
+		}
+	case 389:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2121
+		{
+			yyVAL.boolean = false
+		}
+	case 390:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2126
+		{
+			yyVAL.str = ""
+		}
+	case 391:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2130
+		{
+			yyVAL.str = " " + string(yyDollar[1].str)
+		}
+	case 392:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2134
+		{
+			yyVAL.str = string(yyDollar[1].str) + ", " + string(yyDollar[3].str)
+		}
+	case 393:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2142
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 394:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2146
+		{
+			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].str
+		}
+	case 395:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2150
+		{
+			yyVAL.str = yyDollar[1].str + "=" + yyDollar[3].str
+		}
+	case 396:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2156
+		{
+			yyVAL.str = yyDollar[1].colIdent.String()
+		}
+	case 397:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2160
+		{
+			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
+		}
+	case 398:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2164
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 399:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2169
+		{
+			yyVAL.str = ""
+		}
+	case 400:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2173
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 401:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2178
+		{
+			yyVAL.str = string("")
+		}
+	case 402:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2182
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 405:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2192
+		{
+			for i := 0; i < len(yyDollar[5].ddls); i++ {
+				if yyDollar[5].ddls[i].Action == RenameStr {
+					yyDollar[5].ddls[i].FromTables = append(TableNames{yyDollar[4].tableName}, yyDollar[5].ddls[i].FromTables...)
+				} else {
+					yyDollar[5].ddls[i].Table = yyDollar[4].tableName
+				}
+			}
+			yyVAL.statement = &MultiAlterDDL{Table: yyDollar[4].tableName, Statements: yyDollar[5].ddls}
+		}
+	case 406:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2205
+		{
+			yyVAL.ddls = []*DDL{yyDollar[1].ddl}
+		}
+	case 407:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2209
+		{
+			yyVAL.ddls = append(yyVAL.ddls, yyDollar[3].ddl)
+		}
+	case 408:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2215
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr}
+		}
+	case 409:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2219
+		{
+			ddl := &DDL{Action: AlterStr, ColumnAction: AddStr, TableSpec: &TableSpec{}}
+			ddl.TableSpec.AddColumn(yyDollar[4].columnDefinition)
+			ddl.Column = yyDollar[4].columnDefinition.Name
+			yyVAL.ddl = ddl
+		}
+	case 410:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2226
+		{
+			ddl := &DDL{Action: AlterStr, ColumnAction: AddStr, TableSpec: &TableSpec{}, ColumnOrder: yyDollar[4].columnOrder}
+			ddl.TableSpec.AddColumn(yyDollar[3].columnDefinition)
+			ddl.Column = yyDollar[3].columnDefinition.Name
+			yyVAL.ddl = ddl
+		}
+	case 411:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2233
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr}
+		}
+	case 412:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2237
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ColumnAction: DropStr, Column: NewColIdent(string(yyDollar[3].bytes))}
+		}
+	case 413:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2241
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr}
+		}
+	case 414:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2245
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ColumnAction: RenameStr, Column: NewColIdent(string(yyDollar[3].bytes)), ToColumn: NewColIdent(string(yyDollar[5].bytes))}
+		}
+	case 415:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2249
+		{
+			// Change this to a rename statement
+			yyVAL.ddl = &DDL{Action: RenameStr, ToTables: TableNames{yyDollar[3].tableName}}
+		}
+	case 416:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2254
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, IndexSpec: &IndexSpec{Action: CreateStr, ToName: NewColIdent(yyDollar[3].str), Using: yyDollar[4].colIdent, Columns: yyDollar[6].indexColumns, Options: yyDollar[8].indexOptions}}
+		}
+	case 417:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:2258
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, IndexSpec: &IndexSpec{Action: CreateStr, ToName: NewColIdent(yyDollar[5].str), Type: yyDollar[3].str, Using: yyDollar[6].colIdent, Columns: yyDollar[8].indexColumns, Options: yyDollar[10].indexOptions}}
+		}
+	case 418:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2262
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ConstraintAction: DropStr, TableSpec: &TableSpec{Constraints: []*ConstraintDefinition{&ConstraintDefinition{Name: string(yyDollar[3].bytes)}}}}
+		}
+	case 419:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2267
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ConstraintAction: DropStr, TableSpec: &TableSpec{Constraints: []*ConstraintDefinition{&ConstraintDefinition{Name: string(yyDollar[3].bytes), Details: &CheckConstraintDefinition{}}}}}
+		}
+	case 420:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2272
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, IndexSpec: &IndexSpec{Action: DropStr, ToName: yyDollar[3].colIdent}}
+		}
+	case 421:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2276
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, IndexSpec: &IndexSpec{Action: RenameStr, FromName: yyDollar[3].colIdent, ToName: yyDollar[5].colIdent}}
+		}
+	case 422:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2280
+		{
+			ddl := &DDL{Action: AlterStr, ColumnAction: ModifyStr, TableSpec: &TableSpec{}, ColumnOrder: yyDollar[4].columnOrder}
+			ddl.TableSpec.AddColumn(yyDollar[3].columnDefinition)
+			ddl.Column = yyDollar[3].columnDefinition.Name
+			yyVAL.ddl = ddl
+		}
+	case 423:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2287
+		{
+			ddl := &DDL{Action: AlterStr, ColumnAction: ChangeStr, TableSpec: &TableSpec{}, Column: NewColIdent(string(yyDollar[3].bytes)), ColumnOrder: yyDollar[5].columnOrder}
+			ddl.TableSpec.AddColumn(yyDollar[4].columnDefinition)
+			yyVAL.ddl = ddl
+		}
+	case 424:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2293
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, PartitionSpec: yyDollar[1].partSpec}
+		}
+	case 425:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2297
+		{
+			ddl := &DDL{Action: AlterStr, ConstraintAction: AddStr, TableSpec: &TableSpec{}}
+			ddl.TableSpec.AddConstraint(yyDollar[2].constraintDefinition)
+			yyVAL.ddl = ddl
+		}
+	case 426:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2303
+		{
+			ddl := &DDL{Action: AlterStr, ConstraintAction: AddStr, TableSpec: &TableSpec{}}
+			ddl.TableSpec.AddConstraint(yyDollar[2].constraintDefinition)
+			yyVAL.ddl = ddl
+		}
+	case 427:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2309
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ConstraintAction: DropStr, TableSpec: &TableSpec{Constraints: []*ConstraintDefinition{&ConstraintDefinition{Name: string(yyDollar[4].bytes), Details: &ForeignKeyDefinition{}}}}}
+		}
+	case 428:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2314
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, AutoIncSpec: &AutoIncSpec{Value: yyDollar[3].expr}}
+		}
+	case 429:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2318
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, DefaultSpec: &DefaultSpec{Action: SetStr, Column: yyDollar[3].colIdent, Value: yyDollar[6].expr}}
+		}
+	case 430:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2322
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, DefaultSpec: &DefaultSpec{Action: DropStr, Column: yyDollar[3].colIdent}}
+		}
+	case 431:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2326
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, IndexSpec: &IndexSpec{Action: DropStr, Type: PrimaryStr}}
+		}
+	case 432:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2330
+		{
+			ddl := &DDL{Action: AlterStr, IndexSpec: &IndexSpec{Action: CreateStr}}
+			ddl.IndexSpec = &IndexSpec{Action: CreateStr, Using: NewColIdent(""), ToName: NewColIdent(yyDollar[2].str), Type: PrimaryStr, Columns: yyDollar[6].indexColumns, Options: yyDollar[8].indexOptions}
+			yyVAL.ddl = ddl
+		}
+	case 433:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2337
+		{
+			yyVAL.columnOrder = nil
+		}
+	case 434:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2341
+		{
+			yyVAL.columnOrder = &ColumnOrder{First: true}
+		}
+	case 435:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2345
+		{
+			yyVAL.columnOrder = &ColumnOrder{AfterColumn: NewColIdent(string(yyDollar[2].bytes))}
+		}
+	case 436:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2351
+		{
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName.ToViewName()}
+		}
+	case 437:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2357
+		{
+		}
+	case 438:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2359
+		{
+		}
+	case 441:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2367
+		{
+			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeStr, Name: yyDollar[3].colIdent, Definitions: yyDollar[6].partDefs}
+		}
+	case 442:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2373
+		{
+			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
+		}
+	case 443:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2377
+		{
+			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
+		}
+	case 444:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2383
+		{
+			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
+		}
+	case 445:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2387
+		{
+			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
+		}
+	case 446:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2393
+		{
+			yyVAL.statement = yyDollar[3].ddl
+		}
+	case 447:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2399
+		{
+			yyVAL.ddl = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[1].tableName}, ToTables: TableNames{yyDollar[3].tableName}}
+		}
+	case 448:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2403
+		{
+			yyVAL.ddl = yyDollar[1].ddl
+			yyVAL.ddl.FromTables = append(yyVAL.ddl.FromTables, yyDollar[3].tableName)
+			yyVAL.ddl.ToTables = append(yyVAL.ddl.ToTables, yyDollar[5].tableName)
+		}
+	case 449:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2411
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropStr, FromTables: yyDollar[4].tableNames, IfExists: exists}
+		}
+	case 450:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2419
+		{
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[5].tableName, IndexSpec: &IndexSpec{Action: DropStr, ToName: yyDollar[3].colIdent}}
+		}
+	case 451:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2423
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropStr, FromViews: yyDollar[4].tableNames, IfExists: exists}
+		}
+	case 452:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2431
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes), IfExists: exists}
+		}
+	case 453:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2439
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes), IfExists: exists}
+		}
+	case 454:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2447
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropStr, TriggerSpec: &TriggerSpec{Name: string(yyDollar[4].bytes)}, IfExists: exists}
+		}
+	case 455:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2455
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropStr, ProcedureSpec: &ProcedureSpec{Name: string(yyDollar[4].bytes)}, IfExists: exists}
+		}
+	case 456:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2465
+		{
+			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[3].tableName}
+		}
+	case 457:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2469
+		{
+			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[2].tableName}
+		}
+	case 458:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2474
+		{
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName}
+		}
+	case 459:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2480
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 460:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2485
+		{
+			yyVAL.statement = &Show{Type: CharsetStr, Filter: yyDollar[4].showFilter}
+		}
+	case 461:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2489
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), Filter: yyDollar[3].showFilter}
+		}
+	case 462:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2493
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), IfNotExists: yyDollar[4].byt == 1, Database: string(yyDollar[5].bytes)}
+		}
+	case 463:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2497
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), IfNotExists: yyDollar[4].byt == 1, Database: string(yyDollar[5].bytes)}
+		}
+	case 464:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2502
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 465:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2506
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 466:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2510
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 467:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2514
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName}
+		}
+	case 468:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2518
+		{
+			yyVAL.statement = &Show{Type: CreateTriggerStr, Table: yyDollar[4].tableName}
+		}
+	case 469:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2522
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName}
+		}
+	case 470:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2526
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 471:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2530
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 472:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2534
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 473:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2538
+		{
+			yyVAL.statement = &Show{Type: IndexStr, Table: yyDollar[4].tableName, Database: yyDollar[5].str, ShowIndexFilterOpt: yyDollar[6].expr}
+		}
+	case 474:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2542
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 475:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2546
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Filter: yyDollar[4].showFilter}
+		}
+	case 476:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2550
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Filter: yyDollar[4].showFilter}
+		}
+	case 477:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2554
+		{
+			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
+		}
+	case 478:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2558
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Database: yyDollar[4].str, Filter: yyDollar[5].showFilter}
+		}
+	case 479:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2562
+		{
+			showTablesOpt := &ShowTablesOpt{Full: yyDollar[2].str, DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}
+			yyVAL.statement = &Show{Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt, OnTable: yyDollar[5].tableName}
+		}
+	case 480:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2567
+		{
+			// this is ugly, but I couldn't find a better way for now
+			if yyDollar[3].str == "processlist" {
+				yyVAL.statement = &Show{Type: yyDollar[3].str}
+			} else {
+				showTablesOpt := &ShowTablesOpt{Full: yyDollar[2].str, DbName: yyDollar[4].str, Filter: yyDollar[6].showFilter, AsOf: yyDollar[5].expr}
+				yyVAL.statement = &Show{Type: yyDollar[3].str, ShowTablesOpt: showTablesOpt}
+			}
+		}
+	case 481:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2577
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[3].bytes), ShowTablesOpt: &ShowTablesOpt{DbName: yyDollar[4].str, Filter: yyDollar[5].showFilter}}
+		}
+	case 482:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2581
+		{
+			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
+		}
+	case 483:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2585
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 484:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2589
+		{
+			// Cannot dereference $4 directly, or else the parser stackcannot be pooled. See yyParsePooled
+			showCollationFilterOpt := yyDollar[4].expr
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowCollationFilterOpt: &showCollationFilterOpt}
+		}
+	case 485:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2595
+		{
+			// Cannot dereference $3 directly, or else the parser stackcannot be pooled. See yyParsePooled
+			cmp := yyDollar[3].expr.(*ComparisonExpr)
+			cmp.Left = &ColName{Name: NewColIdent("collation")}
+			var ex Expr = cmp
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowCollationFilterOpt: &ex}
+		}
+	case 486:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2603
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 487:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2613
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 488:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2619
+		{
+			yyVAL.expr = &ComparisonExpr{Operator: LikeStr, Right: yyDollar[2].expr, Escape: yyDollar[3].expr}
+		}
+	case 489:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2625
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 490:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2629
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 491:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2635
+		{
+			yyVAL.str = ""
+		}
+	case 492:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2639
+		{
+			yyVAL.str = "full "
+		}
+	case 493:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2645
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 494:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2649
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 495:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2655
+		{
+			yyVAL.str = ""
+		}
+	case 496:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2659
+		{
+			yyVAL.str = yyDollar[2].tableIdent.v
+		}
+	case 497:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2663
+		{
+			yyVAL.str = yyDollar[2].tableIdent.v
+		}
+	case 498:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2669
+		{
+			yyVAL.showFilter = nil
+		}
+	case 499:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2673
+		{
+			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
+		}
+	case 500:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2677
+		{
+			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
+		}
+	case 501:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2683
+		{
+			yyVAL.str = ""
+		}
+	case 502:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2687
+		{
+			yyVAL.str = SessionStr
+		}
+	case 503:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2691
+		{
+			yyVAL.str = GlobalStr
+		}
+	case 504:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2697
+		{
+			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
+		}
+	case 505:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2701
+		{
+			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
+		}
+	case 506:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2707
+		{
+			yyVAL.statement = &Begin{}
+		}
+	case 507:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2711
+		{
+			yyVAL.statement = yyDollar[1].statement
+		}
+	case 508:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2717
+		{
+			yyVAL.statement = &Begin{}
+		}
+	case 509:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2723
+		{
+			yyVAL.statement = &Commit{}
+		}
+	case 510:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2729
+		{
+			yyVAL.statement = &Rollback{}
+		}
+	case 511:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2735
+		{
+			yyVAL.statement = &Savepoint{Identifier: string(yyDollar[2].bytes)}
+		}
+	case 512:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2741
+		{
+			yyVAL.statement = &RollbackSavepoint{Identifier: string(yyDollar[3].bytes)}
+		}
+	case 513:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2745
+		{
+			yyVAL.statement = &RollbackSavepoint{Identifier: string(yyDollar[4].bytes)}
+		}
+	case 514:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2749
+		{
+			yyVAL.statement = &RollbackSavepoint{Identifier: string(yyDollar[4].bytes)}
+		}
+	case 515:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2753
+		{
+			yyVAL.statement = &RollbackSavepoint{Identifier: string(yyDollar[5].bytes)}
+		}
+	case 516:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2759
+		{
+			yyVAL.statement = &ReleaseSavepoint{Identifier: string(yyDollar[3].bytes)}
+		}
+	case 517:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2764
+		{
+		}
+	case 518:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2765
+		{
+		}
+	case 519:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2769
+		{
+			yyVAL.statement = &Explain{ExplainFormat: yyDollar[2].str, Statement: yyDollar[3].statement}
+		}
+	case 520:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2773
+		{
+			yyVAL.statement = &Explain{Analyze: true, ExplainFormat: TreeStr, Statement: yyDollar[3].selStmt}
+		}
+	case 521:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2779
+		{
+			yyVAL.statement = yyDollar[1].selStmt
+		}
+	case 525:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2787
+		{
+			yyVAL.str = ""
+		}
+	case 526:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2791
+		{
+			yyVAL.str = string(yyDollar[3].bytes)
+		}
+	case 529:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2802
+		{
+			yyVAL.statement = &Show{Type: "columns", OnTable: yyDollar[2].tableName}
+		}
+	case 530:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2806
+		{
+			yyVAL.statement = &OtherRead{}
+		}
+	case 531:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2812
+		{
+			yyVAL.statement = &OtherAdmin{}
+		}
+	case 532:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2816
+		{
+			yyVAL.statement = &OtherAdmin{}
+		}
+	case 533:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2822
+		{
+			yyVAL.statement = &DDL{Action: FlushStr}
+		}
+	case 534:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2826
+		{
+			setAllowComments(yylex, true)
+		}
+	case 535:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2830
+		{
+			yyVAL.bytes2 = yyDollar[2].bytes2
+			setAllowComments(yylex, false)
+		}
+	case 536:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2836
+		{
+			yyVAL.bytes2 = nil
+		}
+	case 537:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2840
+		{
+			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
+		}
+	case 538:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2846
+		{
+			yyVAL.str = UnionStr
+		}
+	case 539:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2850
+		{
+			yyVAL.str = UnionAllStr
+		}
+	case 540:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2854
+		{
+			yyVAL.str = UnionDistinctStr
+		}
+	case 541:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2859
+		{
+			yyVAL.byt = 0
+		}
+	case 542:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2863
+		{
+			yyVAL.byt = 1
+		}
+	case 543:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2868
+		{
+			yyVAL.str = ""
+		}
+	case 544:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2872
+		{
+			yyVAL.str = SQLNoCacheStr
+		}
+	case 545:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2876
+		{
+			yyVAL.str = SQLCacheStr
+		}
+	case 546:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2881
+		{
+			yyVAL.str = ""
+		}
+	case 547:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2885
+		{
+			yyVAL.str = ""
+		}
+	case 548:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2889
+		{
+			yyVAL.str = DistinctStr
+		}
+	case 549:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2894
+		{
+			yyVAL.str = ""
+		}
+	case 550:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2898
+		{
+			yyVAL.str = StraightJoinHint
+		}
+	case 551:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2904
+		{
+			if ae, ok := yyDollar[2].selectExpr.(*AliasedExpr); ok {
+				ae.StartParsePos = yyDollar[1].int
+				ae.EndParsePos = yyDollar[3].int - 1
+			}
+			yyVAL.selectExprs = SelectExprs{yyDollar[2].selectExpr}
+		}
+	case 552:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2912
+		{
+			if ae, ok := yyDollar[4].selectExpr.(*AliasedExpr); ok {
+				ae.StartParsePos = yyDollar[3].int
+				ae.EndParsePos = yyDollar[5].int - 1
+			}
+			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[4].selectExpr)
+		}
+	case 553:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2923
+		{
+			yyVAL.selectExpr = &StarExpr{}
+		}
+	case 554:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2927
+		{
+			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr}
+		}
+	case 555:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2931
+		{
+			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
+		}
+	case 556:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2935
+		{
+			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
+		}
+	case 557:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2941
+		{
+			yyVAL.selectExpr = &StarExpr{}
+		}
+	case 558:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2945
+		{
+			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
+		}
+	case 559:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2949
+		{
+			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
+		}
+	case 560:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2953
+		{
+			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
+		}
+	case 561:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2960
+		{
+			yyVAL.over = &Over{WindowName: yyDollar[2].colIdent}
+		}
+	case 562:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2964
+		{
+			yyVAL.over = &Over{OrderBy: yyDollar[3].orderBy}
+		}
+	case 563:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2968
+		{
+			yyVAL.over = &Over{PartitionBy: yyDollar[5].exprs, OrderBy: yyDollar[6].orderBy}
+		}
+	case 564:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2973
+		{
+			yyVAL.over = nil
+		}
+	case 565:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2977
+		{
+			yyVAL.over = yyDollar[1].over
+		}
+	case 566:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2982
+		{
+			yyVAL.colIdent = ColIdent{}
+		}
+	case 567:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2986
+		{
+			yyVAL.colIdent = yyDollar[1].colIdent
+		}
+	case 568:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2990
+		{
+			yyVAL.colIdent = yyDollar[2].colIdent
+		}
+	case 569:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2996
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 570:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3000
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 571:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3004
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 572:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3008
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 573:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3014
+		{
+			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
+		}
+	case 574:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3018
+		{
+			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
+		}
+	case 577:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3028
+		{
+			yyVAL.tableExpr = yyDollar[1].aliasedTableName
+		}
+	case 578:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3032
+		{
+			switch n := yyDollar[1].simpleTableExpr.(type) {
+			case *Subquery:
+				n.Columns = yyDollar[4].columns
+			case *ValuesStatement:
+				n.Columns = yyDollar[4].columns
+			}
+			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].simpleTableExpr, As: yyDollar[3].tableIdent}
+		}
+	case 579:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3042
+		{
+			// missed alias for subquery
+			yylex.Error("Every derived table must have its own alias")
+			return 1
+		}
+	case 580:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3048
+		{
+			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
+		}
+	case 581:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3054
+		{
+			yyVAL.simpleTableExpr = &ValuesStatement{Rows: yyDollar[2].values}
+		}
+	case 582:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3060
+		{
+			yyVAL.values = Values{yyDollar[2].valTuple}
+		}
+	case 583:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3064
+		{
+			yyVAL.values = append(yyVAL.values, yyDollar[4].valTuple)
+		}
+	case 584:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3070
+		{
+			yyVAL.aliasedTableName = yyDollar[2].aliasedTableName
+			yyVAL.aliasedTableName.Expr = yyDollar[1].tableName
+		}
+	case 585:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3075
+		{
+			yyVAL.aliasedTableName = yyDollar[6].aliasedTableName
+			yyVAL.aliasedTableName.Expr = yyDollar[1].tableName
+			yyVAL.aliasedTableName.Partitions = yyDollar[4].partitions
+		}
+	case 586:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3086
+		{
+			yyVAL.aliasedTableName = &AliasedTableExpr{Hints: yyDollar[1].indexHints}
+		}
+	case 587:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3090
+		{
+			yyVAL.aliasedTableName = &AliasedTableExpr{AsOf: &AsOf{Time: yyDollar[3].expr}, Hints: yyDollar[4].indexHints}
+		}
+	case 588:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3094
+		{
+			yyVAL.aliasedTableName = &AliasedTableExpr{AsOf: &AsOf{Time: yyDollar[3].expr}, As: yyDollar[5].tableIdent, Hints: yyDollar[6].indexHints}
+		}
+	case 589:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3098
+		{
+			yyVAL.aliasedTableName = &AliasedTableExpr{As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
+		}
+	case 590:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3102
+		{
+			yyVAL.aliasedTableName = &AliasedTableExpr{As: yyDollar[1].tableIdent, Hints: yyDollar[2].indexHints}
+		}
+	case 591:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3113
+		{
+			yyVAL.expr = nil
+		}
+	case 592:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3117
+		{
+			yyVAL.expr = yyDollar[3].expr
+		}
+	case 593:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3122
+		{
+			yyVAL.columns = nil
+		}
+	case 594:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3126
+		{
+			yyVAL.columns = yyDollar[2].columns
+		}
+	case 595:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3132
+		{
+			yyVAL.columns = Columns{yyDollar[1].colIdent}
+		}
+	case 596:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3136
+		{
+			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
+		}
+	case 597:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3142
+		{
+			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
+		}
+	case 598:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3146
+		{
+			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
+		}
+	case 599:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3159
+		{
+			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
+		}
+	case 600:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3163
+		{
+			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
+		}
+	case 601:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3167
+		{
+			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
+		}
+	case 602:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3171
+		{
+			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
+		}
+	case 603:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3177
+		{
+			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
+		}
+	case 604:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3179
+		{
+			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
+		}
+	case 605:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3183
+		{
+			yyVAL.joinCondition = JoinCondition{}
+		}
+	case 606:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3185
+		{
+			yyVAL.joinCondition = yyDollar[1].joinCondition
+		}
+	case 607:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3189
+		{
+			yyVAL.joinCondition = JoinCondition{}
+		}
+	case 608:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3191
+		{
+			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
+		}
+	case 609:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3194
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 610:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3196
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 612:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3201
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 613:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3205
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 614:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3211
+		{
+			yyVAL.str = JoinStr
+		}
+	case 615:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3215
+		{
+			yyVAL.str = JoinStr
+		}
+	case 616:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3219
+		{
+			yyVAL.str = JoinStr
+		}
+	case 617:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3225
+		{
+			yyVAL.str = StraightJoinStr
+		}
+	case 618:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3231
+		{
+			yyVAL.str = LeftJoinStr
+		}
+	case 619:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3235
+		{
+			yyVAL.str = LeftJoinStr
+		}
+	case 620:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3239
+		{
+			yyVAL.str = RightJoinStr
+		}
+	case 621:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3243
+		{
+			yyVAL.str = RightJoinStr
+		}
+	case 622:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3249
+		{
+			yyVAL.str = NaturalJoinStr
+		}
+	case 623:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3253
+		{
+			if yyDollar[2].str == LeftJoinStr {
+				yyVAL.str = NaturalLeftJoinStr
+			} else {
+				yyVAL.str = NaturalRightJoinStr
+			}
+		}
+	case 624:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3263
+		{
+			yyVAL.tableName = yyDollar[3].tableName
+		}
+	case 625:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3269
+		{
+			yyVAL.tableName = yyDollar[2].tableName
+		}
+	case 626:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3273
+		{
+			yyVAL.tableName = yyDollar[1].tableName
+		}
+	case 627:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3279
+		{
+			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
+		}
+	case 628:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3283
+		{
+			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
+		}
+	case 629:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3289
+		{
+			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
+		}
+	case 630:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3294
+		{
+			yyVAL.indexHints = nil
+		}
+	case 631:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3298
+		{
+			yyVAL.indexHints = &IndexHints{Type: UseStr, Indexes: yyDollar[4].columns}
+		}
+	case 632:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3302
+		{
+			yyVAL.indexHints = &IndexHints{Type: IgnoreStr, Indexes: yyDollar[4].columns}
+		}
+	case 633:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3306
+		{
+			yyVAL.indexHints = &IndexHints{Type: ForceStr, Indexes: yyDollar[4].columns}
+		}
+	case 634:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3311
+		{
+			yyVAL.expr = nil
+		}
+	case 635:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3315
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 636:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3321
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 637:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3325
+		{
+			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
+		}
+	case 638:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3329
+		{
+			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
+		}
+	case 639:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3333
+		{
+			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
+		}
+	case 640:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3337
+		{
+			yyVAL.expr = &IsExpr{Operator: yyDollar[3].str, Expr: yyDollar[1].expr}
+		}
+	case 641:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3341
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 642:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3345
+		{
+			yyVAL.expr = &Default{ColName: yyDollar[2].str}
+		}
+	case 643:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3351
+		{
+			yyVAL.str = ""
+		}
+	case 644:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3355
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 645:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3361
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 646:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3365
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 647:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3371
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[3].expr}
+		}
+	case 648:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3375
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InStr, Right: yyDollar[3].colTuple}
+		}
+	case 649:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3379
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInStr, Right: yyDollar[4].colTuple}
+		}
+	case 650:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3383
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
+		}
+	case 651:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3387
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeStr, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
+		}
+	case 652:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3391
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpStr, Right: yyDollar[3].expr}
+		}
+	case 653:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3395
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpStr, Right: yyDollar[4].expr}
+		}
+	case 654:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3399
+		{
+			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenStr, From: yyDollar[3].expr, To: yyDollar[5].expr}
+		}
+	case 655:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3403
+		{
+			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenStr, From: yyDollar[4].expr, To: yyDollar[6].expr}
+		}
+	case 656:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3407
+		{
+			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
+		}
+	case 657:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3413
+		{
+			yyVAL.str = IsNullStr
+		}
+	case 658:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3417
+		{
+			yyVAL.str = IsNotNullStr
+		}
+	case 659:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3421
+		{
+			yyVAL.str = IsTrueStr
+		}
+	case 660:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3425
+		{
+			yyVAL.str = IsNotTrueStr
+		}
+	case 661:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3429
+		{
+			yyVAL.str = IsFalseStr
+		}
+	case 662:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3433
+		{
+			yyVAL.str = IsNotFalseStr
+		}
+	case 663:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3439
+		{
+			yyVAL.str = EqualStr
+		}
+	case 664:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3443
+		{
+			yyVAL.str = LessThanStr
+		}
+	case 665:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3447
+		{
+			yyVAL.str = GreaterThanStr
+		}
+	case 666:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3451
+		{
+			yyVAL.str = LessEqualStr
+		}
+	case 667:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3455
+		{
+			yyVAL.str = GreaterEqualStr
+		}
+	case 668:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3459
+		{
+			yyVAL.str = NotEqualStr
+		}
+	case 669:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3463
+		{
+			yyVAL.str = NullSafeEqualStr
+		}
+	case 670:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3468
+		{
+			yyVAL.expr = nil
+		}
+	case 671:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3472
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 672:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3478
+		{
+			yyVAL.colTuple = yyDollar[1].valTuple
+		}
+	case 673:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3482
+		{
+			yyVAL.colTuple = yyDollar[1].subquery
+		}
+	case 674:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3486
+		{
+			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
+		}
+	case 675:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3492
+		{
+			yyVAL.subquery = &Subquery{Select: yyDollar[2].selStmt}
+		}
+	case 676:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3498
+		{
+			yyVAL.simpleTableExpr = yyDollar[1].subquery
+		}
+	case 677:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3502
+		{
+			yyVAL.simpleTableExpr = yyDollar[2].simpleTableExpr
+		}
+	case 678:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3508
+		{
+			yyVAL.selectExprs = nil
+		}
+	case 680:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3515
+		{
+			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
+		}
+	case 681:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3519
+		{
+			yyVAL.selectExprs = append(yyDollar[1].selectExprs, yyDollar[3].selectExpr)
+		}
+	case 682:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3525
+		{
+			yyVAL.exprs = Exprs{yyDollar[1].expr}
+		}
+	case 683:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3529
+		{
+			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
+		}
+	case 684:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3535
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 685:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3539
+		{
+			yyVAL.expr = &ColName{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 686:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3543
+		{
+			yyVAL.expr = yyDollar[1].boolVal
+		}
+	case 687:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3547
+		{
+			yyVAL.expr = yyDollar[1].colName
+		}
+	case 688:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3551
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 689:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3555
+		{
+			yyVAL.expr = yyDollar[1].subquery
+		}
+	case 690:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3559
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndStr, Right: yyDollar[3].expr}
+		}
+	case 691:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3563
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrStr, Right: yyDollar[3].expr}
+		}
+	case 692:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3567
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorStr, Right: yyDollar[3].expr}
+		}
+	case 693:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3571
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
+		}
+	case 694:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3575
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
+		}
+	case 695:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3579
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
+		}
+	case 696:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3583
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
+		}
+	case 697:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3587
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivStr, Right: yyDollar[3].expr}
+		}
+	case 698:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3591
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
+		}
+	case 699:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3595
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
+		}
+	case 700:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3599
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftStr, Right: yyDollar[3].expr}
+		}
+	case 701:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3603
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightStr, Right: yyDollar[3].expr}
+		}
+	case 702:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3607
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
+		}
+	case 703:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3611
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
+		}
+	case 704:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3615
+		{
+			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
+		}
+	case 705:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3619
+		{
+			yyVAL.expr = &UnaryExpr{Operator: BinaryStr, Expr: yyDollar[2].expr}
+		}
+	case 706:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3623
+		{
+			yyVAL.expr = &UnaryExpr{Operator: UBinaryStr, Expr: yyDollar[2].expr}
+		}
+	case 707:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3627
+		{
+			yyVAL.expr = &UnaryExpr{Operator: Utf8mb4Str, Expr: yyDollar[2].expr}
+		}
+	case 708:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3631
+		{
+			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
+				yyVAL.expr = num
+			} else {
+				yyVAL.expr = &UnaryExpr{Operator: UPlusStr, Expr: yyDollar[2].expr}
+			}
+		}
+	case 709:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3639
+		{
+			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
+				// Handle double negative
+				if num.Val[0] == '-' {
+					num.Val = num.Val[1:]
+					yyVAL.expr = num
+				} else {
+					yyVAL.expr = NewIntVal(append([]byte("-"), num.Val...))
+				}
+			} else {
+				yyVAL.expr = &UnaryExpr{Operator: UMinusStr, Expr: yyDollar[2].expr}
+			}
+		}
+	case 710:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3653
+		{
+			yyVAL.expr = &UnaryExpr{Operator: TildaStr, Expr: yyDollar[2].expr}
+		}
+	case 711:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3657
+		{
+			yyVAL.expr = &UnaryExpr{Operator: BangStr, Expr: yyDollar[2].expr}
+		}
+	case 712:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3661
+		{
+			// This rule prevents the usage of INTERVAL
+			// as a function. If support is needed for that,
+			// we'll need to revisit this. The solution
+			// will be non-trivial because of grammar conflicts.
+			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
+		}
+	case 719:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3681
+		{
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: yyDollar[3].str == DistinctStr, Exprs: yyDollar[4].selectExprs}
+		}
+	case 720:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3685
+		{
+			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
+		}
+	case 721:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3695
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[4].selectExprs, Distinct: yyDollar[3].str == DistinctStr, Over: yyDollar[6].over}
+		}
+	case 722:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3699
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[4].selectExprs, Distinct: yyDollar[3].str == DistinctStr, Over: yyDollar[6].over}
+		}
+	case 723:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3703
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 724:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3707
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 725:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3711
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 726:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3715
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[4].selectExprs, Distinct: yyDollar[3].str == DistinctStr, Over: yyDollar[6].over}
+		}
+	case 727:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3719
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 728:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3723
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 729:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3727
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[4].selectExprs, Distinct: yyDollar[3].str == DistinctStr, Over: yyDollar[6].over}
+		}
+	case 730:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3731
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 731:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3735
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 732:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3739
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 733:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3743
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 734:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3747
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[4].selectExprs, Distinct: yyDollar[3].str == DistinctStr, Over: yyDollar[6].over}
+		}
+	case 735:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3751
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 736:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3755
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 737:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3759
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 738:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3768
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].over}
+		}
+	case 739:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3772
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].over}
+		}
+	case 740:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3776
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: SelectExprs{yyDollar[3].selectExpr}, Over: yyDollar[5].over}
+		}
+	case 741:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3780
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 742:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3784
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: SelectExprs{yyDollar[3].selectExpr}, Over: yyDollar[5].over}
+		}
+	case 743:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3788
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 744:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3792
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 745:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3796
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].over}
+		}
+	case 746:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3800
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].over}
+		}
+	case 747:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3804
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].over}
+		}
+	case 748:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3808
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].over}
+		}
+	case 749:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3819
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 750:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3823
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 751:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3827
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 752:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3831
+		{
+			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
+		}
+	case 753:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3835
+		{
+			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
+		}
+	case 754:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3839
+		{
+			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
+		}
+	case 755:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:3843
+		{
+			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
+		}
+	case 756:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:3847
+		{
+			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
+		}
+	case 757:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:3851
+		{
+			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
+		}
+	case 758:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:3855
+		{
+			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
+		}
+	case 759:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:3859
+		{
+			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].str}
+		}
+	case 760:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3863
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 761:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:3867
+		{
+			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].str, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str}
+		}
+	case 762:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3871
+		{
+			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
+		}
+	case 763:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3875
+		{
+			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
+		}
+	case 764:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3885
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 765:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3889
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 766:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3893
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 767:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3898
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 768:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3903
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 769:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3908
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 770:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3914
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 771:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3919
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 772:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3923
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 773:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3928
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Fsp: yyDollar[2].expr}
+		}
+	case 774:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3932
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Fsp: yyDollar[2].expr}
+		}
+	case 775:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3936
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Fsp: yyDollar[2].expr}
+		}
+	case 776:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3941
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Fsp: yyDollar[2].expr}
+		}
+	case 777:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3946
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Fsp: yyDollar[2].expr}
+		}
+	case 778:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3951
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Fsp: yyDollar[2].expr}
+		}
+	case 779:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:3955
+		{
+			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
+		}
+	case 780:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:3959
+		{
+			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
+		}
+	case 783:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3970
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 784:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3980
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 785:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3984
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 786:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3988
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 787:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3992
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 788:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3996
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 789:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4000
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 790:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4006
+		{
+			yyVAL.str = ""
+		}
+	case 791:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4010
+		{
+			yyVAL.str = BooleanModeStr
+		}
+	case 792:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4014
+		{
+			yyVAL.str = NaturalLanguageModeStr
+		}
+	case 793:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:4018
+		{
+			yyVAL.str = NaturalLanguageModeWithQueryExpansionStr
+		}
+	case 794:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4022
+		{
+			yyVAL.str = QueryExpansionStr
+		}
+	case 795:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4028
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 796:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4032
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 797:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4038
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 798:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4042
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Operator: CharacterSetStr}
+		}
+	case 799:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4046
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: string(yyDollar[3].bytes)}
+		}
+	case 800:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4050
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 801:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4054
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 802:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4058
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 803:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4064
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 804:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4068
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 805:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4072
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 806:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4076
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 807:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4080
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 808:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4084
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 809:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4088
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 810:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4093
+		{
+			yyVAL.expr = nil
+		}
+	case 811:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4097
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 812:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4102
+		{
+			yyVAL.str = string("")
+		}
+	case 813:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4106
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 814:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4112
+		{
+			yyVAL.whens = []*When{yyDollar[1].when}
+		}
+	case 815:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4116
+		{
+			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
+		}
+	case 816:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4122
+		{
+			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
+		}
+	case 817:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4127
+		{
+			yyVAL.expr = nil
+		}
+	case 818:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4131
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 819:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4137
+		{
+			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
+		}
+	case 820:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4141
+		{
+			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
+		}
+	case 821:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4145
+		{
+			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
+		}
+	case 822:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4151
+		{
+			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
+		}
+	case 823:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4155
+		{
+			yyVAL.expr = NewHexVal(yyDollar[1].bytes)
+		}
+	case 824:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4159
+		{
+			yyVAL.expr = NewBitVal(yyDollar[1].bytes)
+		}
+	case 825:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4163
+		{
+			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
+		}
+	case 826:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4167
+		{
+			yyVAL.expr = NewFloatVal(yyDollar[1].bytes)
+		}
+	case 827:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4171
+		{
+			yyVAL.expr = NewHexNum(yyDollar[1].bytes)
+		}
+	case 828:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4175
+		{
+			yyVAL.expr = NewValArg(yyDollar[1].bytes)
+		}
+	case 829:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4179
+		{
+			yyVAL.expr = &NullVal{}
+		}
+	case 830:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4185
+		{
+			yyVAL.expr = NewIntVal([]byte("1"))
+		}
+	case 831:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4189
+		{
+			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
+		}
+	case 832:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4193
+		{
+			yyVAL.expr = NewValArg(yyDollar[1].bytes)
+		}
+	case 833:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4198
+		{
+			yyVAL.exprs = nil
+		}
+	case 834:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4202
+		{
+			yyVAL.exprs = yyDollar[3].exprs
+		}
+	case 835:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4208
+		{
+			yyVAL.exprs = Exprs{yyDollar[1].expr}
+		}
+	case 836:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4212
+		{
+			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
+		}
+	case 837:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4218
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 838:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4223
+		{
+			yyVAL.expr = nil
+		}
+	case 839:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4227
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 840:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4233
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 841:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4238
+		{
+			yyVAL.orderBy = nil
+		}
+	case 842:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4242
+		{
+			yyVAL.orderBy = yyDollar[3].orderBy
+		}
+	case 843:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4248
+		{
+			yyVAL.orderBy = OrderBy{yyDollar[1].order}
+		}
+	case 844:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4252
+		{
+			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
+		}
+	case 845:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4258
+		{
+			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].str}
+		}
+	case 846:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4263
+		{
+			yyVAL.str = AscScr
+		}
+	case 847:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4267
+		{
+			yyVAL.str = AscScr
+		}
+	case 848:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4271
+		{
+			yyVAL.str = DescScr
+		}
+	case 849:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4276
+		{
+			yyVAL.limit = nil
+		}
+	case 850:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4280
+		{
+			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
+		}
+	case 851:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4284
+		{
+			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
+		}
+	case 852:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4288
+		{
+			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
+		}
+	case 853:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4294
+		{
+			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
+		}
+	case 854:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4298
+		{
+			yyVAL.expr = NewValArg(yyDollar[1].bytes)
+		}
+	case 855:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4303
+		{
+			yyVAL.str = ""
+		}
+	case 856:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4307
+		{
+			yyVAL.str = ForUpdateStr
+		}
+	case 857:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4311
+		{
+			yyVAL.str = ShareModeStr
+		}
+	case 858:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4324
+		{
+			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
+		}
+	case 859:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4328
+		{
+			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
+		}
+	case 860:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4332
+		{
+			// Drop the redundant parenthesis.
+			yyVAL.ins = &Insert{Rows: yyDollar[2].selStmt}
+		}
+	case 861:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4337
+		{
+			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
+		}
+	case 862:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4341
+		{
+			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
+		}
+	case 863:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4345
+		{
+			// Drop the redundant parenthesis.
+			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].selStmt}
+		}
+	case 864:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4352
+		{
+			yyVAL.columns = Columns{yyDollar[1].colIdent}
+		}
+	case 865:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4356
+		{
+			yyVAL.columns = Columns{yyDollar[3].colIdent}
+		}
+	case 866:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4360
+		{
+			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
+		}
+	case 867:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4364
+		{
+			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
+		}
+	case 868:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4369
+		{
+			yyVAL.assignExprs = nil
+		}
+	case 869:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4373
+		{
+			yyVAL.assignExprs = yyDollar[5].assignExprs
+		}
+	case 870:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4379
+		{
+			yyVAL.values = Values{yyDollar[1].valTuple}
+		}
+	case 871:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4383
+		{
+			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
+		}
+	case 872:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4389
+		{
+			yyVAL.valTuple = yyDollar[1].valTuple
+		}
+	case 873:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4393
+		{
+			yyVAL.valTuple = ValTuple{}
+		}
+	case 874:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4399
+		{
+			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
+		}
+	case 875:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4405
+		{
+			if len(yyDollar[1].valTuple) == 1 {
+				yyVAL.expr = &ParenExpr{yyDollar[1].valTuple[0]}
+			} else {
+				yyVAL.expr = yyDollar[1].valTuple
+			}
+		}
+	case 876:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4415
+		{
+			yyVAL.assignExprs = AssignmentExprs{yyDollar[1].assignExpr}
+		}
+	case 877:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4419
+		{
+			yyVAL.assignExprs = append(yyDollar[1].assignExprs, yyDollar[3].assignExpr)
+		}
+	case 878:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4425
+		{
+			yyVAL.assignExpr = &AssignmentExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
+		}
+	case 879:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4431
+		{
+			yyVAL.setVarExprs = SetVarExprs{yyDollar[1].setVarExpr}
+		}
+	case 880:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4435
+		{
+			yyVAL.setVarExprs = append(yyDollar[1].setVarExprs, yyDollar[3].setVarExpr)
+		}
+	case 881:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4441
+		{
+			colName, scope, err := VarScopeForColName(yyDollar[1].setVarExpr.Name)
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyDollar[1].setVarExpr.Name = colName
+			yyDollar[1].setVarExpr.Scope = scope
+			yyVAL.setVarExpr = yyDollar[1].setVarExpr
+		}
+	case 882:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4452
+		{
+			_, scope, err := VarScopeForColName(yyDollar[2].setVarExpr.Name)
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			} else if scope != SetScope_None {
+				yylex.Error(fmt.Sprintf("invalid system variable name `%s`", yyDollar[2].setVarExpr.Name.Name.val))
+				return 1
+			}
+			yyDollar[2].setVarExpr.Scope = yyDollar[1].setScope
+			yyVAL.setVarExpr = yyDollar[2].setVarExpr
+		}
+	case 883:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4465
+		{
+			_, scope, err := VarScopeForColName(yyDollar[2].setVarExpr.Name)
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			} else if scope != SetScope_None {
+				yylex.Error(fmt.Sprintf("invalid system variable name `%s`", yyDollar[2].setVarExpr.Name.Name.val))
+				return 1
+			}
+			yyDollar[2].setVarExpr.Scope = yyDollar[1].setScope
+			yyVAL.setVarExpr = yyDollar[2].setVarExpr
+		}
+	case 884:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4478
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: NewColName(string(yyDollar[1].bytes)), Expr: yyDollar[2].expr, Scope: SetScope_Session}
+		}
+	case 885:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4484
+		{
+			yyVAL.setScope = SetScope_Global
+		}
+	case 886:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4488
+		{
+			yyVAL.setScope = SetScope_Session
+		}
+	case 887:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4494
+		{
+			yyVAL.setScope = SetScope_Session
+		}
+	case 888:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4498
+		{
+			yyVAL.setScope = SetScope_Persist
+		}
+	case 889:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4502
+		{
+			yyVAL.setScope = SetScope_PersistOnly
+		}
+	case 890:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4508
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: yyDollar[1].colName, Expr: NewStrVal(yyDollar[3].bytes), Scope: SetScope_None}
+		}
+	case 891:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4512
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: yyDollar[1].colName, Expr: NewStrVal(yyDollar[3].bytes), Scope: SetScope_None}
+		}
+	case 892:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4516
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr, Scope: SetScope_None}
+		}
+	case 894:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4523
+		{
+			yyVAL.bytes = []byte("charset")
+		}
+	case 896:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4530
+		{
+			yyVAL.expr = NewStrVal([]byte(yyDollar[1].colIdent.String()))
+		}
+	case 897:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4534
+		{
+			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
+		}
+	case 898:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4538
+		{
+			yyVAL.expr = &Default{}
+		}
+	case 901:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4547
+		{
+			yyVAL.byt = 0
+		}
+	case 902:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4549
+		{
+			yyVAL.byt = 1
+		}
+	case 903:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4552
+		{
+			yyVAL.byt = 0
+		}
+	case 904:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4554
+		{
+			yyVAL.byt = 1
+		}
+	case 905:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4557
+		{
+			yyVAL.byt = 0
+		}
+	case 906:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4559
+		{
+			yyVAL.byt = 1
+		}
+	case 907:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4562
+		{
+			yyVAL.str = ""
+		}
+	case 908:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4564
+		{
+			yyVAL.str = IgnoreStr
+		}
+	case 909:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4567
+		{
+			yyVAL.sqlVal = nil
+		}
+	case 910:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4569
+		{
+			yyVAL.sqlVal = NewIntVal(yyDollar[2].bytes)
+		}
+	case 911:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4573
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 912:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4575
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 913:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4577
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 914:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4579
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 915:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4581
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 916:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4583
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 917:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4585
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 918:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4587
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 919:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4591
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 920:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4593
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 921:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4596
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 922:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4598
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 923:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4600
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 924:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4604
+		{
+			yyVAL.str = UniqueStr
+		}
+	case 925:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4606
+		{
+			yyVAL.str = FulltextStr
+		}
+	case 926:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4608
+		{
+			yyVAL.str = SpatialStr
+		}
+	case 927:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4611
+		{
+			yyVAL.str = ""
+		}
+	case 928:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4613
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 929:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4616
+		{
+			yyVAL.colIdent = ColIdent{}
+		}
+	case 930:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4618
+		{
+			yyVAL.colIdent = yyDollar[2].colIdent
+		}
+	case 931:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4622
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 932:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4626
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 933:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4632
+		{
+			yyVAL.colIdents = []ColIdent{yyDollar[1].colIdent}
+		}
+	case 934:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4636
+		{
+			yyVAL.colIdents = append(yyVAL.colIdents, yyDollar[3].colIdent)
+		}
+	case 936:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4643
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 937:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4649
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 938:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4653
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 940:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4660
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 941:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4665
+		{
+			yyVAL.str = string("")
+		}
+	case 942:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4667
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 943:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4670
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 944:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4672
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 945:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4675
+		{
+			yyVAL.EnclosedBy = nil
+		}
+	case 946:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4679
+		{
+			yyVAL.EnclosedBy = &EnclosedBy{Optionally: yyDollar[1].boolVal, Delim: NewStrVal(yyDollar[4].bytes)}
+		}
+	case 947:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4684
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 948:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4688
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 949:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4693
+		{
+			yyVAL.sqlVal = nil
+		}
+	case 950:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4697
+		{
+			yyVAL.sqlVal = NewStrVal(yyDollar[3].bytes)
+		}
+	case 951:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4702
+		{
+			yyVAL.sqlVal = nil
+		}
+	case 952:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4706
+		{
+			yyVAL.sqlVal = NewStrVal(yyDollar[3].bytes)
+		}
+	case 953:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4711
+		{
+			yyVAL.Fields = nil
+		}
+	case 954:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4715
+		{
+			yyVAL.Fields = &Fields{TerminatedBy: yyDollar[2].sqlVal, EnclosedBy: yyDollar[3].EnclosedBy, EscapedBy: yyDollar[4].sqlVal}
+		}
+	case 955:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4720
+		{
+			yyVAL.Lines = nil
+		}
+	case 956:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4724
+		{
+			yyVAL.Lines = &Lines{StartingBy: yyDollar[2].sqlVal, TerminatedBy: yyDollar[3].sqlVal}
+		}
+	case 957:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4729
+		{
+			yyVAL.sqlVal = nil
+		}
+	case 958:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4733
+		{
+			yyVAL.sqlVal = NewStrVal(yyDollar[3].bytes)
+		}
+	case 959:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4739
+		{
+			yyVAL.statement = &LockTables{Tables: yyDollar[3].tableAndLockTypes}
+		}
+	case 960:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4745
+		{
+			yyVAL.tableAndLockTypes = TableAndLockTypes{yyDollar[1].tableAndLockType}
+		}
+	case 961:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4749
+		{
+			yyVAL.tableAndLockTypes = append(yyDollar[1].tableAndLockTypes, yyDollar[3].tableAndLockType)
+		}
+	case 962:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4755
+		{
+			yyVAL.tableAndLockType = &TableAndLockType{Table: &AliasedTableExpr{Expr: yyDollar[1].tableName}, Lock: yyDollar[2].lockType}
+		}
+	case 963:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4759
+		{
+			yyVAL.tableAndLockType = &TableAndLockType{Table: &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[3].tableIdent}, Lock: yyDollar[4].lockType}
+		}
+	case 964:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4765
+		{
+			yyVAL.lockType = LockRead
+		}
+	case 965:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4769
+		{
+			yyVAL.lockType = LockReadLocal
+		}
+	case 966:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4773
+		{
+			yyVAL.lockType = LockWrite
+		}
+	case 967:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4777
+		{
+			yyVAL.lockType = LockLowPriorityWrite
+		}
+	case 968:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4783
+		{
+			yyVAL.statement = &UnlockTables{}
+		}
+	case 1344:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5188
+		{
+			if incNesting(yylex) {
+				yylex.Error("max nesting level reached")
+				return 1
+			}
+		}
+	case 1345:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5197
+		{
+			decNesting(yylex)
+		}
+	case 1346:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5202
+		{
+			skipToEnd(yylex)
+		}
+	case 1347:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5207
+		{
+			skipToEnd(yylex)
+		}
+	case 1348:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5211
+		{
+			skipToEnd(yylex)
+		}
+	case 1349:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5215
+		{
+			skipToEnd(yylex)
+		}
+	}
+	goto yystack /* stack new state and value */
+}